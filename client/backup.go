@@ -0,0 +1,21 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeBackup = "backup"
+)
+
+// CreateBackup creates a verified online backup of the database of the node (and uploads it if configured).
+func (api *GoShimmerAPI) CreateBackup() (*jsonmodels.CreateBackupResponse, error) {
+	res := &jsonmodels.CreateBackupResponse{}
+	if err := api.do(http.MethodPost, routeBackup, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
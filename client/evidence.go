@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/evidence"
+)
+
+// GetWithEvidence executes a GET request on the given route (whose responses are signed by the node), verifies the
+// Evidence in the headers of the response and decodes the body of the response into resObj. The returned Evidence
+// (together with the route and the raw body) can be stored as verifiable proof of what the node reported.
+func (api *GoShimmerAPI) GetWithEvidence(ctx context.Context, route string, resObj interface{}) (responseEvidence *evidence.Evidence, body []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s%s", api.baseURL, api.routePrefix, strings.TrimPrefix(route, "/")), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if api.basicAuth.IsEnabled() {
+		req.SetBasicAuth(api.basicAuth.Credentials())
+	}
+
+	res, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if body, err = io.ReadAll(res.Body); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		return nil, body, interpretBody(res, resObj)
+	}
+
+	if responseEvidence, err = evidence.FromHeaders(res.Header); err != nil {
+		return nil, body, errors.Wrap(err, "failed to read evidence")
+	}
+	if err = responseEvidence.Verify(http.MethodGet, req.URL.RequestURI(), res.StatusCode, body); err != nil {
+		return nil, body, err
+	}
+
+	if resObj != nil {
+		if err = json.Unmarshal(body, resObj); err != nil {
+			return nil, body, errors.Wrap(err, "failed to decode response body")
+		}
+	}
+
+	return responseEvidence, body, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/app/faucet"
@@ -16,6 +17,7 @@ import (
 const (
 	routeFaucetRequestBroadcast = "faucetrequest"
 	routeFaucetRequestAPI       = "faucet"
+	routeFaucetCoSign           = "faucet/cosign"
 )
 
 var (
@@ -102,6 +104,31 @@ func (api *GoShimmerAPI) SendFaucetRequestAPI(base58EncodedAddr string, powTarge
 	return res, nil
 }
 
+// CoSignFaucetTransaction requests the signature of a co-signer of the faucet multisig for the essence of the funding
+// transaction of the given co-sign request.
+func (api *GoShimmerAPI) CoSignFaucetTransaction(ctx context.Context, request *faucet.CoSignRequest) (devnetvm.Signature, error) {
+	essenceBytes, err := request.Essence().Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not serialize essence")
+	}
+
+	faucetRequestBytes, err := request.FaucetRequest().Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not serialize faucet request")
+	}
+
+	res := &jsonmodels.FaucetCoSignResponse{}
+	if err = api.doWithContext(ctx, http.MethodPost, routeFaucetCoSign, &jsonmodels.FaucetCoSignRequest{
+		Essence:       base58.Encode(essenceBytes),
+		FaucetRequest: base58.Encode(faucetRequestBytes),
+		Signature:     request.Signature().Base58(),
+	}, res); err != nil {
+		return nil, err
+	}
+
+	return devnetvm.SignatureFromBase58EncodedString(res.Signature)
+}
+
 func computeFaucetPoW(address devnetvm.Address, aManaPledgeID, cManaPledgeID identity.ID, powTarget int) (nonce uint64, err error) {
 	if powTarget < 0 {
 		powTarget = defaultPOWTarget
@@ -1,14 +1,10 @@
 package client
 
 import (
-	"net/http"
-)
-
-const (
-	routeHealth = "healthz"
+	"context"
 )
 
 // HealthCheck checks whether the node is running and healthy.
 func (api *GoShimmerAPI) HealthCheck() error {
-	return api.do(http.MethodGet, routeHealth, nil, nil)
+	return api.SDK().HealthCheck(context.TODO())
 }
@@ -1,20 +1,12 @@
 package client
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 )
 
-const (
-	routeInfo = "info"
-)
-
 // Info gets the info of the node.
 func (api *GoShimmerAPI) Info() (*jsonmodels.InfoResponse, error) {
-	res := &jsonmodels.InfoResponse{}
-	if err := api.do(http.MethodGet, routeInfo, nil, res); err != nil {
-		return nil, err
-	}
-	return res, nil
+	return api.SDK().Info(context.TODO())
 }
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	routeJournal = "journal"
+)
+
+// GetJournalEntries gets at most limit entries of the journal of the node that start at the given sequence number.
+func (api *GoShimmerAPI) GetJournalEntries(fromSequence uint64, limit int) (*jsonmodels.GetJournalEntriesResponse, error) {
+	res := &jsonmodels.GetJournalEntriesResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?from=%d&limit=%d", routeJournal, fromSequence, limit)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
@@ -11,28 +13,49 @@ const (
 	routeGetAddresses     = "ledgerstate/addresses/"
 	routeGetConflicts     = "ledgerstate/conflicts/"
 	routeGetOutputs       = "ledgerstate/outputs/"
+	routePostOutputs      = "ledgerstate/outputs"
 	routeGetTransactions  = "ledgerstate/transactions/"
 	routePostTransactions = "ledgerstate/transactions"
+	routeDryRun           = "ledgerstate/transactions/dryrun"
+	routeAliases          = "ledgerstate/aliases"
+	routeCaches           = "ledgerstate/caches"
+	routeMempool          = "ledgerstate/mempool"
 
 	// route path modifiers.
 	pathUnspentOutputs = "/unspentOutputs"
+	pathUnspent        = "unspent"
 	pathChildren       = "/children"
 	pathConflicts      = "/conflicts"
 	pathConsumers      = "/consumers"
 	pathMetadata       = "/metadata"
+	pathProof          = "/proof"
 	pathVoters         = "/voters"
+	pathPending        = "pending"
 	pathAttachments    = "/attachments"
+	pathLiked          = "/liked"
 )
 
-// GetAddressOutputs gets the spent and unspent outputs of an address.
+// GetAddressOutputs gets the spent and unspent outputs of an address (the pages of the outputs are requested one after
+// another until all outputs were received).
 func (api *GoShimmerAPI) GetAddressOutputs(base58EncodedAddress string) (*jsonmodels.GetAddressResponse, error) {
-	res := &jsonmodels.GetAddressResponse{}
-	if err := api.do(http.MethodGet, func() string {
-		return routeGetAddresses + base58EncodedAddress
-	}(), nil, res); err != nil {
-		return nil, err
+	res := &jsonmodels.GetAddressResponse{
+		SpentOutputs:   make([]*jsonmodels.Output, 0),
+		UnspentOutputs: make([]*jsonmodels.Output, 0),
+	}
+	for cursor := ""; ; {
+		page, err := api.SDK().GetAddressOutputs(context.TODO(), base58EncodedAddress, cursor, 0, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Address = page.Address
+		res.SpentOutputs = append(res.SpentOutputs, page.SpentOutputs...)
+		res.UnspentOutputs = append(res.UnspentOutputs, page.UnspentOutputs...)
+
+		if cursor = page.Cursor; cursor == "" {
+			return res, nil
+		}
 	}
-	return res, nil
 }
 
 // PostAddressUnspentOutputs gets the unspent outputs of several addresses.
@@ -46,15 +69,9 @@ func (api *GoShimmerAPI) PostAddressUnspentOutputs(base58EncodedAddresses []stri
 	return res, nil
 }
 
-// GetConflict gets the conflict information.
-func (api *GoShimmerAPI) GetConflict(base58EncodedConflictID string) (*jsonmodels.Conflict, error) {
-	res := &jsonmodels.Conflict{}
-	if err := api.do(http.MethodGet, func() string {
-		return routeGetConflicts + base58EncodedConflictID
-	}(), nil, res); err != nil {
-		return nil, err
-	}
-	return res, nil
+// GetConflict gets the conflict information together with its approval weight.
+func (api *GoShimmerAPI) GetConflict(base58EncodedConflictID string) (*jsonmodels.ConflictWeight, error) {
+	return api.SDK().GetConflict(context.TODO(), base58EncodedConflictID)
 }
 
 // GetConflictChildren gets the children of a conflict.
@@ -79,6 +96,17 @@ func (api *GoShimmerAPI) GetConflictConflicts(base58EncodedConflictID string) (*
 	return res, nil
 }
 
+// GetConflictLiked gets the members of the conflict sets of the Conflict that are currently liked by the node.
+func (api *GoShimmerAPI) GetConflictLiked(base58EncodedConflictID string) (*jsonmodels.GetConflictLikedResponse, error) {
+	res := &jsonmodels.GetConflictLikedResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return routeGetConflicts + base58EncodedConflictID + pathLiked
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetConflictVoters gets the Voters of a conflict.
 func (api *GoShimmerAPI) GetConflictVoters(base58EncodedConflictID string) (*jsonmodels.GetConflictVotersResponse, error) {
 	res := &jsonmodels.GetConflictVotersResponse{}
@@ -90,6 +118,39 @@ func (api *GoShimmerAPI) GetConflictVoters(base58EncodedConflictID string) (*jso
 	return res, nil
 }
 
+// GetPendingConflicts gets a page of at most limit unresolved conflict sets that follow the given offset.
+func (api *GoShimmerAPI) GetPendingConflicts(offset, limit int) (*jsonmodels.GetPendingConflictsResponse, error) {
+	res := &jsonmodels.GetPendingConflictsResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s%s?offset=%d&limit=%d", routeGetConflicts, pathPending, offset, limit)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetMempool gets a page of at most limit pending transactions that follow the given offset. If an address is given,
+// only the pending transactions that create outputs for that address are returned.
+func (api *GoShimmerAPI) GetMempool(base58EncodedAddress string, offset, limit int) (*jsonmodels.GetMempoolResponse, error) {
+	res := &jsonmodels.GetMempoolResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?address=%s&offset=%d&limit=%d", routeMempool, base58EncodedAddress, offset, limit)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostOutputs gets the outputs corresponding to the given OutputIDs (and their metadata) in a single request.
+func (api *GoShimmerAPI) PostOutputs(base58EncodedOutputIDs []string) (*jsonmodels.PostOutputsResponse, error) {
+	res := &jsonmodels.PostOutputsResponse{}
+	if err := api.do(http.MethodPost, routePostOutputs,
+		&jsonmodels.PostOutputsRequest{OutputIDs: base58EncodedOutputIDs}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetOutput gets the output corresponding to OutputID.
 func (api *GoShimmerAPI) GetOutput(base58EncodedOutputID string) (*jsonmodels.Output, error) {
 	res := &jsonmodels.Output{}
@@ -101,6 +162,18 @@ func (api *GoShimmerAPI) GetOutput(base58EncodedOutputID string) (*jsonmodels.Ou
 	return res, nil
 }
 
+// GetLedgerUnspentOutputs gets a page of at most limit unspent outputs of the ledger that follow the given cursor (an
+// empty cursor starts at the first unspent output). The cursor of the response can be used to request the next page.
+func (api *GoShimmerAPI) GetLedgerUnspentOutputs(cursor string, limit int) (*jsonmodels.GetLedgerUnspentOutputsResponse, error) {
+	res := &jsonmodels.GetLedgerUnspentOutputsResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s%s?cursor=%s&limit=%d", routeGetOutputs, pathUnspent, cursor, limit)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetOutputConsumers gets the consumers of the output corresponding to OutputID.
 func (api *GoShimmerAPI) GetOutputConsumers(base58EncodedOutputID string) (*jsonmodels.GetOutputConsumersResponse, error) {
 	res := &jsonmodels.GetOutputConsumersResponse{}
@@ -123,6 +196,18 @@ func (api *GoShimmerAPI) GetOutputMetadata(base58EncodedOutputID string) (*jsonm
 	return res, nil
 }
 
+// GetOutputProof gets a proof that the output corresponding to OutputID is unspent in the ledger state of the latest
+// commitment (it can be verified with the outputproof package).
+func (api *GoShimmerAPI) GetOutputProof(base58EncodedOutputID string) (*jsonmodels.GetOutputProofResponse, error) {
+	res := &jsonmodels.GetOutputProofResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return routeGetOutputs + base58EncodedOutputID + pathProof
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetTransaction gets the transaction of the corresponding to TransactionID.
 func (api *GoShimmerAPI) GetTransaction(base58EncodedTransactionID string) (*jsonmodels.Transaction, error) {
 	res := &jsonmodels.Transaction{}
@@ -156,6 +241,29 @@ func (api *GoShimmerAPI) GetTransactionAttachments(base58EncodedTransactionID st
 	return res, nil
 }
 
+// GetTransactionAttachmentsWithGoF gets the attachments of the transaction corresponding to TransactionID together with
+// the confirmation and scheduler status of every attachment.
+func (api *GoShimmerAPI) GetTransactionAttachmentsWithGoF(base58EncodedTransactionID string) (*jsonmodels.GetTransactionAttachmentsResponse, error) {
+	res := &jsonmodels.GetTransactionAttachmentsResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return routeGetTransactions + base58EncodedTransactionID + pathAttachments + "?includeGoF=true"
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DryRunTransaction executes the transaction(bytes) against the ledger state of the node without issuing it and
+// returns its validity together with the outputs that it would create.
+func (api *GoShimmerAPI) DryRunTransaction(transactionBytes []byte) (*jsonmodels.DryRunTransactionResponse, error) {
+	res := &jsonmodels.DryRunTransactionResponse{}
+	if err := api.do(http.MethodPost, routeDryRun,
+		&jsonmodels.PostTransactionRequest{TransactionBytes: transactionBytes}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // PostTransaction sends the transaction(bytes) to the Tangle and returns its transaction ID.
 func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.PostTransactionResponse, error) {
 	res := &jsonmodels.PostTransactionResponse{}
@@ -166,3 +274,47 @@ func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.P
 
 	return res, nil
 }
+
+// GetAliases gets the human-readable aliases that are registered for transactions, conflicts and outputs.
+func (api *GoShimmerAPI) GetAliases() (*jsonmodels.GetAliasesResponse, error) {
+	res := &jsonmodels.GetAliasesResponse{}
+	if err := api.do(http.MethodGet, routeAliases, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RegisterAlias registers a human-readable alias for the transaction or output with the given base58 encoded ID (an
+// empty alias unregisters it).
+func (api *GoShimmerAPI) RegisterAlias(aliasType, base58EncodedID, alias string) (*jsonmodels.Alias, error) {
+	res := &jsonmodels.Alias{}
+	if err := api.do(http.MethodPost, routeAliases,
+		&jsonmodels.PostAliasRequest{Type: aliasType, ID: base58EncodedID, Alias: alias}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLedgerCaches gets the settings and statistics of the caches of the storages of the ledger.
+func (api *GoShimmerAPI) GetLedgerCaches() (*jsonmodels.GetLedgerCachesResponse, error) {
+	res := &jsonmodels.GetLedgerCachesResponse{}
+	if err := api.do(http.MethodGet, routeCaches, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpdateLedgerCache adjusts the cache time and size of the named storage of the ledger (an empty cacheTime or a nil
+// maxSize keep their current value).
+func (api *GoShimmerAPI) UpdateLedgerCache(storageName, cacheTime string, maxSize *int) (*jsonmodels.LedgerCache, error) {
+	res := &jsonmodels.LedgerCache{}
+	if err := api.do(http.MethodPut, routeCaches+"/"+storageName,
+		&jsonmodels.PutLedgerCacheRequest{CacheTime: cacheTime, MaxSize: maxSize}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
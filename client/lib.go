@@ -1,6 +1,8 @@
 // Package client implements a very simple wrapper for GoShimmer's web API.
 package client
 
+//go:generate go run ../tools/client-gen --output .
+
 import (
 	"bufio"
 	"bytes"
@@ -13,6 +15,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 )
 
 var (
@@ -34,6 +38,9 @@ const (
 	contentType     = "Content-Type"
 	contentTypeJSON = "application/json"
 	contentTypeCSV  = "text/csv"
+
+	// apiPrefix contains the path prefix of the version of the web API that is used by the client.
+	apiPrefix = "api/v1/"
 )
 
 // Option is a function which sets the given option.
@@ -64,6 +71,14 @@ func WithHTTPClient(c http.Client) Option {
 	}
 }
 
+// WithLegacyRoutes makes the client use the unversioned routes of the web API (required for nodes that do not serve
+// the versioned routes, yet).
+func WithLegacyRoutes() Option {
+	return func(g *GoShimmerAPI) {
+		g.routePrefix = ""
+	}
+}
+
 // IsEnabled returns the enabled state of a given BasicAuth.
 func (b BasicAuth) IsEnabled() bool {
 	return b.Enabled
@@ -77,7 +92,8 @@ func (b BasicAuth) Credentials() (username, password string) {
 // NewGoShimmerAPI returns a new *GoShimmerAPI with the given baseURL and options.
 func NewGoShimmerAPI(baseURL string, setters ...Option) *GoShimmerAPI {
 	g := &GoShimmerAPI{
-		baseURL: baseURL,
+		baseURL:     baseURL,
+		routePrefix: apiPrefix,
 	}
 	for _, setter := range setters {
 		setter(g)
@@ -87,13 +103,15 @@ func NewGoShimmerAPI(baseURL string, setters ...Option) *GoShimmerAPI {
 
 // GoShimmerAPI is an API wrapper over the web API of GoShimmer.
 type GoShimmerAPI struct {
-	baseURL    string
-	httpClient http.Client
-	basicAuth  BasicAuth
+	baseURL     string
+	routePrefix string
+	httpClient  http.Client
+	basicAuth   BasicAuth
 }
 
 type errorresponse struct {
-	Error string `json:"error"`
+	Error     string `json:"error"`
+	ErrorCode string `json:"errorCode"`
 }
 
 func interpretBody(res *http.Response, decodeTo interface{}) error {
@@ -119,23 +137,37 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 		return errors.Wrapf(err, "unable to read error from response body: %s", resBody)
 	}
 
+	err = statusError(res, errRes.Error)
+	if validationError, parseErr := vm.ValidationErrorFromString(errRes.ErrorCode); parseErr == nil {
+		return vm.WithValidationError(err, validationError)
+	}
+
+	return err
+}
+
+// statusError returns the error that corresponds to the status code of the given response.
+func statusError(res *http.Response, message string) error {
 	switch res.StatusCode {
 	case http.StatusInternalServerError:
-		return errors.WithMessage(ErrInternalServerError, errRes.Error)
+		return errors.WithMessage(ErrInternalServerError, message)
 	case http.StatusNotFound:
 		return errors.WithMessage(ErrNotFound, res.Request.URL.String())
 	case http.StatusBadRequest:
-		return errors.WithMessage(ErrBadRequest, errRes.Error)
+		return errors.WithMessage(ErrBadRequest, message)
 	case http.StatusUnauthorized:
-		return errors.WithMessage(ErrUnauthorized, errRes.Error)
+		return errors.WithMessage(ErrUnauthorized, message)
 	case http.StatusNotImplemented:
-		return errors.WithMessage(ErrNotImplemented, errRes.Error)
+		return errors.WithMessage(ErrNotImplemented, message)
 	}
 
-	return errors.WithMessage(ErrUnknownError, errRes.Error)
+	return errors.WithMessage(ErrUnknownError, message)
 }
 
 func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, resObj interface{}) error {
+	return api.doWithContext(context.TODO(), method, route, reqObj, resObj)
+}
+
+func (api *GoShimmerAPI) doWithContext(ctx context.Context, method string, route string, reqObj interface{}, resObj interface{}) error {
 	// marshal request object
 	var data []byte
 	if reqObj != nil {
@@ -145,9 +177,8 @@ func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, res
 			return err
 		}
 	}
-	ctx := context.TODO()
 	// construct request
-	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", api.baseURL, route), func() io.Reader {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s%s", api.baseURL, api.routePrefix, strings.TrimPrefix(route, "/")), func() io.Reader {
 		if data == nil {
 			return nil
 		}
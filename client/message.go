@@ -1,6 +1,7 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
@@ -10,6 +11,7 @@ import (
 const (
 	routeBlock         = "blocks/"
 	routeBlockMetadata = "/metadata"
+	routeBlockChildren = "/children"
 	routeSendPayload   = "blocks/payload"
 )
 
@@ -47,6 +49,24 @@ func (api *GoShimmerAPI) GetBlockMetadata(base58EncodedID string) (*retainer.Blo
 	return res, nil
 }
 
+// GetBlockChildren gets a page of at most limit approvers of the block that are reachable within the given depth and
+// follow the given offset. If a type (strong, weak or likedInstead) is given, only references of that type are
+// traversed.
+func (api *GoShimmerAPI) GetBlockChildren(base58EncodedID, childType string, depth, offset, limit int) (*jsonmodels.GetBlockChildrenResponse, error) {
+	res := &jsonmodels.GetBlockChildrenResponse{}
+
+	if err := api.do(
+		http.MethodGet,
+		fmt.Sprintf("%s%s%s?type=%s&depth=%d&offset=%d&limit=%d", routeBlock, base58EncodedID, routeBlockChildren, childType, depth, offset, limit),
+		nil,
+		res,
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // SendPayload send a block with the given payload.
 func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 	res := &jsonmodels.PostPayloadResponse{}
@@ -0,0 +1,720 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+// SDK is the typed client of the web API that is generated from the endpoint definitions in jsonmodels.Endpoints.
+type SDK struct {
+	api *GoShimmerAPI
+}
+
+// SDK returns the typed client SDK of the web API.
+func (api *GoShimmerAPI) SDK() *SDK {
+	return &SDK{api: api}
+}
+
+// Info gets the info of the node.
+func (s *SDK) Info(ctx context.Context) (*jsonmodels.InfoResponse, error) {
+	route := "info"
+
+	res := &jsonmodels.InfoResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// HealthCheck checks whether the node is running and healthy.
+func (s *SDK) HealthCheck(ctx context.Context) error {
+	route := "healthz"
+
+	return s.api.doWithContext(ctx, http.MethodGet, route, nil, nil)
+}
+
+// GetArtifacts gets the node-local artifacts that were created through the API together with their expiration time.
+func (s *SDK) GetArtifacts(ctx context.Context, kind string) (*jsonmodels.GetArtifactsResponse, error) {
+	route := "artifacts"
+
+	query := make(url.Values)
+	if kind != "" {
+		query.Set("kind", kind)
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetArtifactsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RateSetter gets the rate-setter estimate and the rate-setter info.
+func (s *SDK) RateSetter(ctx context.Context) (*jsonmodels.RateSetter, error) {
+	route := "ratesetter"
+
+	res := &jsonmodels.RateSetter{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetBlock gets the block with the given ID.
+func (s *SDK) GetBlock(ctx context.Context, blockID string) (*jsonmodels.Block, error) {
+	route := "blocks/" + url.PathEscape(blockID)
+
+	res := &jsonmodels.Block{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetBlockChildren gets a page of the blocks that approve the block with the given ID within the given depth.
+func (s *SDK) GetBlockChildren(ctx context.Context, blockID string, typeParam string, depth int, offset int, limit int) (*jsonmodels.GetBlockChildrenResponse, error) {
+	route := "blocks/" + url.PathEscape(blockID) + "/children"
+
+	query := make(url.Values)
+	if typeParam != "" {
+		query.Set("type", typeParam)
+	}
+	if depth != 0 {
+		query.Set("depth", strconv.Itoa(depth))
+	}
+	if offset != 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetBlockChildrenResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SendPayload issues a block with the given payload.
+func (s *SDK) SendPayload(ctx context.Context, request *jsonmodels.PostPayloadRequest) (*jsonmodels.PostPayloadResponse, error) {
+	route := "blocks/payload"
+
+	res := &jsonmodels.PostPayloadResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Data issues a block with the given data payload.
+func (s *SDK) Data(ctx context.Context, request *jsonmodels.DataRequest) (*jsonmodels.DataResponse, error) {
+	route := "data"
+
+	res := &jsonmodels.DataResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAddressOutputs gets a page of the spent and unspent outputs of an address and the cursor of the next page.
+func (s *SDK) GetAddressOutputs(ctx context.Context, address string, cursor string, limit int, spent string, includeMetadata bool) (*jsonmodels.GetAddressResponse, error) {
+	route := "ledgerstate/addresses/" + url.PathEscape(address)
+
+	query := make(url.Values)
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if spent != "" {
+		query.Set("spent", spent)
+	}
+	if includeMetadata {
+		query.Set("includeMetadata", strconv.FormatBool(includeMetadata))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetAddressResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAddressSpendableOutputs gets the unspent outputs that an address can unlock at the given time (taking timelocks and fallback conditions into account).
+func (s *SDK) GetAddressSpendableOutputs(ctx context.Context, address string, time int) (*jsonmodels.GetAddressSpendableOutputsResponse, error) {
+	route := "ledgerstate/addresses/" + url.PathEscape(address) + "/spendable"
+
+	query := make(url.Values)
+	if time != 0 {
+		query.Set("time", strconv.Itoa(time))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetAddressSpendableOutputsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAddressExpiredFallbackOutputs gets the unspent outputs whose fallback deadline passed and that can therefore be reclaimed by the given fallback address.
+func (s *SDK) GetAddressExpiredFallbackOutputs(ctx context.Context, address string) (*jsonmodels.GetAddressExpiredFallbackOutputsResponse, error) {
+	route := "ledgerstate/addresses/" + url.PathEscape(address) + "/expiredFallbackOutputs"
+
+	res := &jsonmodels.GetAddressExpiredFallbackOutputsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAddressBalances gets the balances of an address per color (including the pending incoming and outgoing amounts of transactions that are not accepted yet).
+func (s *SDK) GetAddressBalances(ctx context.Context, address string) (*jsonmodels.GetAddressBalancesResponse, error) {
+	route := "ledgerstate/addresses/" + url.PathEscape(address) + "/balances"
+
+	res := &jsonmodels.GetAddressBalancesResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PostAddressUnspentOutputs gets the unspent outputs of several addresses.
+func (s *SDK) PostAddressUnspentOutputs(ctx context.Context, request *jsonmodels.PostAddressesUnspentOutputsRequest) (*jsonmodels.PostAddressesUnspentOutputsResponse, error) {
+	route := "ledgerstate/addresses/unspentOutputs"
+
+	res := &jsonmodels.PostAddressesUnspentOutputsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetPendingConflicts gets a page of the unresolved conflict sets with the current approval weight of their members.
+func (s *SDK) GetPendingConflicts(ctx context.Context, offset int, limit int) (*jsonmodels.GetPendingConflictsResponse, error) {
+	route := "ledgerstate/conflicts/pending"
+
+	query := make(url.Values)
+	if offset != 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetPendingConflictsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetMempool gets a page of the transactions that were booked but neither accepted nor rejected yet (optionally only the ones that create outputs for the given address).
+func (s *SDK) GetMempool(ctx context.Context, address string, offset int, limit int) (*jsonmodels.GetMempoolResponse, error) {
+	route := "ledgerstate/mempool"
+
+	query := make(url.Values)
+	if address != "" {
+		query.Set("address", address)
+	}
+	if offset != 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetMempoolResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetConflict gets the conflict with the given ID together with its approval weight.
+func (s *SDK) GetConflict(ctx context.Context, conflictID string) (*jsonmodels.ConflictWeight, error) {
+	route := "ledgerstate/conflicts/" + url.PathEscape(conflictID)
+
+	res := &jsonmodels.ConflictWeight{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetConflictChildren gets the children of a conflict.
+func (s *SDK) GetConflictChildren(ctx context.Context, conflictID string) (*jsonmodels.GetConflictChildrenResponse, error) {
+	route := "ledgerstate/conflicts/" + url.PathEscape(conflictID) + "/children"
+
+	res := &jsonmodels.GetConflictChildrenResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetConflictConflicts gets the conflicting conflicts of a conflict.
+func (s *SDK) GetConflictConflicts(ctx context.Context, conflictID string) (*jsonmodels.GetConflictConflictsResponse, error) {
+	route := "ledgerstate/conflicts/" + url.PathEscape(conflictID) + "/conflicts"
+
+	res := &jsonmodels.GetConflictConflictsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetConflictLiked gets the members of the conflict sets of a conflict that are currently liked by the node.
+func (s *SDK) GetConflictLiked(ctx context.Context, conflictID string) (*jsonmodels.GetConflictLikedResponse, error) {
+	route := "ledgerstate/conflicts/" + url.PathEscape(conflictID) + "/liked"
+
+	res := &jsonmodels.GetConflictLikedResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetConflictVoters gets the voters of a conflict.
+func (s *SDK) GetConflictVoters(ctx context.Context, conflictID string) (*jsonmodels.GetConflictVotersResponse, error) {
+	route := "ledgerstate/conflicts/" + url.PathEscape(conflictID) + "/voters"
+
+	res := &jsonmodels.GetConflictVotersResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLedgerUnspentOutputs gets a page of the unspent outputs of the ledger and the cursor of the next page.
+func (s *SDK) GetLedgerUnspentOutputs(ctx context.Context, cursor string, address string, typeParam string, limit int) (*jsonmodels.GetLedgerUnspentOutputsResponse, error) {
+	route := "ledgerstate/outputs/unspent"
+
+	query := make(url.Values)
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if address != "" {
+		query.Set("address", address)
+	}
+	if typeParam != "" {
+		query.Set("type", typeParam)
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetLedgerUnspentOutputsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PostOutputs gets the outputs with the given IDs together with their metadata in a single request.
+func (s *SDK) PostOutputs(ctx context.Context, request *jsonmodels.PostOutputsRequest) (*jsonmodels.PostOutputsResponse, error) {
+	route := "ledgerstate/outputs"
+
+	res := &jsonmodels.PostOutputsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutput gets the output with the given ID.
+func (s *SDK) GetOutput(ctx context.Context, outputID string) (*jsonmodels.Output, error) {
+	route := "ledgerstate/outputs/" + url.PathEscape(outputID)
+
+	res := &jsonmodels.Output{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutputConsumers gets the consumers of the output with the given ID.
+func (s *SDK) GetOutputConsumers(ctx context.Context, outputID string) (*jsonmodels.GetOutputConsumersResponse, error) {
+	route := "ledgerstate/outputs/" + url.PathEscape(outputID) + "/consumers"
+
+	res := &jsonmodels.GetOutputConsumersResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutputMetadata gets the metadata of the output with the given ID.
+func (s *SDK) GetOutputMetadata(ctx context.Context, outputID string) (*jsonmodels.OutputMetadata, error) {
+	route := "ledgerstate/outputs/" + url.PathEscape(outputID) + "/metadata"
+
+	res := &jsonmodels.OutputMetadata{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutputProof gets a proof that the output with the given ID is unspent in the ledger state of the latest commitment.
+func (s *SDK) GetOutputProof(ctx context.Context, outputID string) (*jsonmodels.GetOutputProofResponse, error) {
+	route := "ledgerstate/outputs/" + url.PathEscape(outputID) + "/proof"
+
+	res := &jsonmodels.GetOutputProofResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutputVesting gets the amount of the vesting output with the given ID that is still locked at the given time.
+func (s *SDK) GetOutputVesting(ctx context.Context, outputID string, time int) (*jsonmodels.GetOutputVestingResponse, error) {
+	route := "ledgerstate/outputs/" + url.PathEscape(outputID) + "/vesting"
+
+	query := make(url.Values)
+	if time != 0 {
+		query.Set("time", strconv.Itoa(time))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetOutputVestingResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutputMinimumDeposit gets the minimum deposit in IOTA that the given prospective output has to hold according to the dust policy of the node.
+func (s *SDK) GetOutputMinimumDeposit(ctx context.Context, request *jsonmodels.Output) (*jsonmodels.GetOutputMinimumDepositResponse, error) {
+	route := "ledgerstate/outputs/minimum-deposit"
+
+	res := &jsonmodels.GetOutputMinimumDepositResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetTransaction gets the transaction with the given ID.
+func (s *SDK) GetTransaction(ctx context.Context, transactionID string) (*jsonmodels.Transaction, error) {
+	route := "ledgerstate/transactions/" + url.PathEscape(transactionID)
+
+	res := &jsonmodels.Transaction{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetTransactionMetadata gets the metadata of the transaction with the given ID.
+func (s *SDK) GetTransactionMetadata(ctx context.Context, transactionID string) (*jsonmodels.TransactionMetadata, error) {
+	route := "ledgerstate/transactions/" + url.PathEscape(transactionID) + "/metadata"
+
+	res := &jsonmodels.TransactionMetadata{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetTransactionAttachments gets the attachments of the transaction with the given ID.
+func (s *SDK) GetTransactionAttachments(ctx context.Context, transactionID string, includeGoF bool) (*jsonmodels.GetTransactionAttachmentsResponse, error) {
+	route := "ledgerstate/transactions/" + url.PathEscape(transactionID) + "/attachments"
+
+	query := make(url.Values)
+	if includeGoF {
+		query.Set("includeGoF", strconv.FormatBool(includeGoF))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.GetTransactionAttachmentsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SimulateTransactionOutcomes enumerates the possible resolutions of the pending conflicts of the transaction with the given ID together with the inputs and outputs that survive them.
+func (s *SDK) SimulateTransactionOutcomes(ctx context.Context, transactionID string, limit int) (*jsonmodels.SimulateTransactionOutcomesResponse, error) {
+	route := "ledgerstate/transactions/" + url.PathEscape(transactionID) + "/simulate-outcomes"
+
+	query := make(url.Values)
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.SimulateTransactionOutcomesResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PostTransaction issues the given transaction (and waits for the requested state of it if waitFor is set).
+func (s *SDK) PostTransaction(ctx context.Context, waitFor string, timeout string, request *jsonmodels.PostTransactionRequest) (*jsonmodels.PostTransactionResponse, error) {
+	route := "ledgerstate/transactions"
+
+	query := make(url.Values)
+	if waitFor != "" {
+		query.Set("waitFor", waitFor)
+	}
+	if timeout != "" {
+		query.Set("timeout", timeout)
+	}
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.PostTransactionResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DryRunTransaction executes the given transaction against the current ledger state without issuing or storing it.
+func (s *SDK) DryRunTransaction(ctx context.Context, request *jsonmodels.PostTransactionRequest) (*jsonmodels.DryRunTransactionResponse, error) {
+	route := "ledgerstate/transactions/dryrun"
+
+	res := &jsonmodels.DryRunTransactionResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAliases gets the human-readable aliases that are registered for transactions, conflicts and outputs.
+func (s *SDK) GetAliases(ctx context.Context) (*jsonmodels.GetAliasesResponse, error) {
+	route := "ledgerstate/aliases"
+
+	res := &jsonmodels.GetAliasesResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RegisterAlias registers a human-readable alias for a transaction or an output (an empty alias unregisters it).
+func (s *SDK) RegisterAlias(ctx context.Context, request *jsonmodels.Alias) (*jsonmodels.Alias, error) {
+	route := "ledgerstate/aliases"
+
+	res := &jsonmodels.Alias{}
+	if err := s.api.doWithContext(ctx, http.MethodPost, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLedgerCaches gets the settings and statistics of the caches of the storages of the ledger.
+func (s *SDK) GetLedgerCaches(ctx context.Context) (*jsonmodels.GetLedgerCachesResponse, error) {
+	route := "ledgerstate/caches"
+
+	res := &jsonmodels.GetLedgerCachesResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLedgerStats gets the statistics about the unspent outputs of the ledger state.
+func (s *SDK) GetLedgerStats(ctx context.Context) (*jsonmodels.GetLedgerStatsResponse, error) {
+	route := "ledgerstate/stats"
+
+	res := &jsonmodels.GetLedgerStatsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetColorSupplies gets the minted, melted and circulating supply of all known colors.
+func (s *SDK) GetColorSupplies(ctx context.Context) (*jsonmodels.GetColorSuppliesResponse, error) {
+	route := "ledgerstate/colors"
+
+	res := &jsonmodels.GetColorSuppliesResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAliasChain gets the ordered state and governance transitions of the chain of an alias.
+func (s *SDK) GetAliasChain(ctx context.Context, aliasAddress string) (*jsonmodels.GetAliasChainResponse, error) {
+	route := "ledgerstate/alias-chains/" + url.PathEscape(aliasAddress)
+
+	res := &jsonmodels.GetAliasChainResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpdateLedgerCache adjusts the cache time and size of the named storage of the ledger.
+func (s *SDK) UpdateLedgerCache(ctx context.Context, storageName string, request *jsonmodels.PutLedgerCacheRequest) (*jsonmodels.LedgerCache, error) {
+	route := "ledgerstate/caches/" + url.PathEscape(storageName)
+
+	res := &jsonmodels.LedgerCache{}
+	if err := s.api.doWithContext(ctx, http.MethodPut, route, request, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetCurrentSlotCommitment gets the latest slot commitment of the node.
+func (s *SDK) GetCurrentSlotCommitment(ctx context.Context) (*jsonmodels.SlotInfo, error) {
+	route := "sc"
+
+	res := &jsonmodels.SlotInfo{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlot gets the commitment of the slot with the given index.
+func (s *SDK) GetSlot(ctx context.Context, index string) (*jsonmodels.SlotInfo, error) {
+	route := "slots/" + url.PathEscape(index)
+
+	res := &jsonmodels.SlotInfo{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlotByCommitment gets the commitment of the slot with the given commitment ID.
+func (s *SDK) GetSlotByCommitment(ctx context.Context, commitment string) (*jsonmodels.SlotInfo, error) {
+	route := "slots/commitment/" + url.PathEscape(commitment)
+
+	res := &jsonmodels.SlotInfo{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlotUTXOs gets the outputs that were spent and created in the slot with the given index.
+func (s *SDK) GetSlotUTXOs(ctx context.Context, index string) (*jsonmodels.SlotUTXOsResponse, error) {
+	route := "slots/" + url.PathEscape(index) + "/utxos"
+
+	res := &jsonmodels.SlotUTXOsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlotBlocks gets the blocks that were accepted in the slot with the given index.
+func (s *SDK) GetSlotBlocks(ctx context.Context, index string) (*jsonmodels.SlotBlocksResponse, error) {
+	route := "slots/" + url.PathEscape(index) + "/blocks"
+
+	res := &jsonmodels.SlotBlocksResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlotTransactions gets the transactions that were accepted in the slot with the given index.
+func (s *SDK) GetSlotTransactions(ctx context.Context, index string) (*jsonmodels.SlotTransactionsResponse, error) {
+	route := "slots/" + url.PathEscape(index) + "/transactions"
+
+	res := &jsonmodels.SlotTransactionsResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSlotActivity gets the issuers that issued blocks in the slot with the given index together with their block counts and weights.
+func (s *SDK) GetSlotActivity(ctx context.Context, index string) (*jsonmodels.SlotActivityResponse, error) {
+	route := "slots/" + url.PathEscape(index) + "/activity"
+
+	res := &jsonmodels.SlotActivityResponse{}
+	if err := s.api.doWithContext(ctx, http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -32,3 +32,31 @@ func (api *GoShimmerAPI) ToggleSpammer(enable bool, rate int, unit, imif string)
 	}
 	return res, nil
 }
+
+// ToggleValueSpammer toggles the node internal spammer of value transactions. The conflictRate defines the probability
+// (in the range [0, 1]) with which a transaction is issued as a double spend.
+func (api *GoShimmerAPI) ToggleValueSpammer(enable bool, rate int, unit string, conflictRate float64) (*jsonmodels.SpammerResponse, error) {
+	// set default time unit in case of incorrect unit value
+	if unit != "TPM" {
+		unit = "TPS"
+	}
+	res := &jsonmodels.SpammerResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		if enable {
+			return fmt.Sprintf("%s?mode=value&cmd=start&rate=%d&unit=%s&conflictRate=%f", routeSpammer, rate, unit, conflictRate)
+		}
+		return fmt.Sprintf("%s?mode=value&cmd=stop", routeSpammer)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetValueSpammerStats returns the statistics of the node internal spammer of value transactions.
+func (api *GoShimmerAPI) GetValueSpammerStats() (*jsonmodels.ValueSpammerStats, error) {
+	res := &jsonmodels.SpammerResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s?mode=value&cmd=stats", routeSpammer), nil, res); err != nil {
+		return nil, err
+	}
+	return res.Stats, nil
+}
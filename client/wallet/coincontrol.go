@@ -0,0 +1,180 @@
+package wallet
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+)
+
+// region OutputSelection //////////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputSelection defines how an output is treated by the automatic input selection of the wallet.
+type OutputSelection uint8
+
+const (
+	// AutomaticSelection is the default OutputSelection of outputs that are selected as needed.
+	AutomaticSelection OutputSelection = iota
+
+	// PinnedSelection is the OutputSelection of outputs that are selected before any other output.
+	PinnedSelection
+
+	// ExcludedSelection is the OutputSelection of outputs that are never selected automatically.
+	ExcludedSelection
+)
+
+// String returns a human-readable version of the OutputSelection.
+func (o OutputSelection) String() string {
+	switch o {
+	case AutomaticSelection:
+		return "automatic"
+	case PinnedSelection:
+		return "pinned"
+	case ExcludedSelection:
+		return "excluded"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText returns the name of the OutputSelection.
+func (o OutputSelection) MarshalText() (text []byte, err error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText parses the name of an OutputSelection.
+func (o *OutputSelection) UnmarshalText(text []byte) (err error) {
+	for _, selection := range []OutputSelection{AutomaticSelection, PinnedSelection, ExcludedSelection} {
+		if selection.String() == string(text) {
+			*o = selection
+			return nil
+		}
+	}
+
+	return errors.Errorf("unknown output selection '%s'", text)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CoinControl //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CoinControl contains the labels of the outputs of the wallet and how they are treated by the automatic input
+// selection. It can be exported (as JSON) and imported (see ImportCoinControl), so that it survives a restart.
+type CoinControl struct {
+	labels     map[utxo.OutputID]string
+	selections map[utxo.OutputID]OutputSelection
+	mutex      sync.RWMutex
+}
+
+// NewCoinControl creates an empty CoinControl.
+func NewCoinControl() *CoinControl {
+	return &CoinControl{
+		labels:     make(map[utxo.OutputID]string),
+		selections: make(map[utxo.OutputID]OutputSelection),
+	}
+}
+
+// Label returns the label of the output with the given ID (an empty string if it is not labeled).
+func (c *CoinControl) Label(outputID utxo.OutputID) (label string) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.labels[outputID]
+}
+
+// SetLabel sets the label of the output with the given ID (an empty label removes it).
+func (c *CoinControl) SetLabel(outputID utxo.OutputID, label string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if label == "" {
+		delete(c.labels, outputID)
+		return
+	}
+
+	c.labels[outputID] = label
+}
+
+// Selection returns the OutputSelection of the output with the given ID.
+func (c *CoinControl) Selection(outputID utxo.OutputID) (selection OutputSelection) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.selections[outputID]
+}
+
+// SetSelection sets the OutputSelection of the output with the given ID.
+func (c *CoinControl) SetSelection(outputID utxo.OutputID, selection OutputSelection) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if selection == AutomaticSelection {
+		delete(c.selections, outputID)
+		return
+	}
+
+	c.selections[outputID] = selection
+}
+
+// MarshalJSON returns the labels and OutputSelections of the CoinControl keyed by the base58 encoded output IDs.
+func (c *CoinControl) MarshalJSON() (jsonBytes []byte, err error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	entries := make(map[string]*coinControlEntry)
+	entry := func(outputID utxo.OutputID) *coinControlEntry {
+		if _, exists := entries[outputID.Base58()]; !exists {
+			entries[outputID.Base58()] = new(coinControlEntry)
+		}
+
+		return entries[outputID.Base58()]
+	}
+
+	for outputID, label := range c.labels {
+		entry(outputID).Label = label
+	}
+	for outputID, selection := range c.selections {
+		entry(outputID).Selection = selection
+	}
+
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON restores the labels and OutputSelections of a CoinControl that was marshaled with MarshalJSON.
+func (c *CoinControl) UnmarshalJSON(jsonBytes []byte) (err error) {
+	entries := make(map[string]*coinControlEntry)
+	if err = json.Unmarshal(jsonBytes, &entries); err != nil {
+		return errors.Wrap(err, "failed to parse coin control")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.labels = make(map[utxo.OutputID]string)
+	c.selections = make(map[utxo.OutputID]OutputSelection)
+	for base58OutputID, entry := range entries {
+		var outputID utxo.OutputID
+		if err = outputID.FromBase58(base58OutputID); err != nil {
+			return errors.Wrapf(err, "failed to parse output ID %s", base58OutputID)
+		}
+
+		if entry.Label != "" {
+			c.labels[outputID] = entry.Label
+		}
+		if entry.Selection != AutomaticSelection {
+			c.selections[outputID] = entry.Selection
+		}
+	}
+
+	return nil
+}
+
+// coinControlEntry is the serialized form of the coin control settings of a single output.
+type coinControlEntry struct {
+	Label     string          `json:"label,omitempty"`
+	Selection OutputSelection `json:"selection,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
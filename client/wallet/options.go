@@ -28,6 +28,13 @@ func Import(seed *seed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.B
 	}
 }
 
+// ImportCoinControl restores the labels and the OutputSelections of the outputs of a wallet (see Wallet.CoinControl).
+func ImportCoinControl(coinControl *CoinControl) Option {
+	return func(wallet *Wallet) {
+		wallet.coinControl = coinControl
+	}
+}
+
 // ReusableAddress configures the wallet to run in "single address" mode where all the funds are always managed on a
 // single reusable address.
 func ReusableAddress(enabled bool) Option {
@@ -16,6 +16,11 @@ type Output struct {
 	ConfirmationStateReached bool
 	// Spent is a local wallet-only property that gets set once an output is spent from within the same wallet.
 	Spent bool
+	// Label is a local wallet-only label of the output (see CoinControl).
+	Label string
+	// Selection is a local wallet-only property that defines how the output is treated by the automatic input
+	// selection (see CoinControl).
+	Selection OutputSelection
 }
 
 // OutputMetadata is metadata about the output.
@@ -122,6 +127,28 @@ func (o OutputsByAddressAndOutputID) AliasOutputsOnly() OutputsByAddressAndOutpu
 	return result
 }
 
+// DustOutputsOnly filters out the outputs that hold at least the given amount of tokens (summed up over all colors).
+func (o OutputsByAddressAndOutputID) DustOutputsOnly(threshold uint64) OutputsByAddressAndOutputID {
+	result := NewAddressToOutputs()
+	for addy, IDToOutputMap := range o {
+		for outputID, output := range IDToOutputMap {
+			var totalBalance uint64
+			output.Object.Balances().ForEach(func(_ devnetvm.Color, balance uint64) bool {
+				totalBalance += balance
+				return true
+			})
+
+			if totalBalance < threshold {
+				if _, addressExists := result[addy]; !addressExists {
+					result[addy] = make(map[utxo.OutputID]*Output)
+				}
+				result[addy][outputID] = output
+			}
+		}
+	}
+	return result
+}
+
 // TotalFundsInOutputs returns the total funds present in the outputs.
 func (o OutputsByAddressAndOutputID) TotalFundsInOutputs() map[devnetvm.Color]uint64 {
 	result := make(map[devnetvm.Color]uint64)
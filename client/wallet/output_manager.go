@@ -10,16 +10,18 @@ type OutputManager struct {
 	addressManager *AddressManager
 	connector      Connector
 	unspentOutputs OutputsByAddressAndOutputID
+	coinControl    *CoinControl
 
 	optsStateless bool
 }
 
 // NewUnspentOutputManager creates a new UnspentOutputManager.
-func NewUnspentOutputManager(addressManager *AddressManager, connector Connector, stateless bool) (outputManager *OutputManager) {
+func NewUnspentOutputManager(addressManager *AddressManager, connector Connector, coinControl *CoinControl, stateless bool) (outputManager *OutputManager) {
 	outputManager = &OutputManager{
 		addressManager: addressManager,
 		connector:      connector,
 		unspentOutputs: NewAddressToOutputs(),
+		coinControl:    coinControl,
 		optsStateless:  stateless,
 	}
 
@@ -110,6 +112,10 @@ func (o *OutputManager) getOutputs(includePending bool, addresses ...address.Add
 				continue
 			}
 
+			// annotate the output with the coin control settings of the wallet
+			output.Label = o.coinControl.Label(output.Object.ID())
+			output.Selection = o.coinControl.Selection(output.Object.ID())
+
 			// store unspent outputs in result
 			if _, addressExists := unspentOutputs[addr]; !addressExists {
 				unspentOutputs[addr] = make(map[utxo.OutputID]*Output)
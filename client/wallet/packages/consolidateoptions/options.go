@@ -1,5 +1,9 @@
 package consolidateoptions
 
+import (
+	"github.com/pkg/errors"
+)
+
 // ConsolidateFundsOption is a function that provides options.
 type ConsolidateFundsOption func(options *ConsolidateFundsOptions) error
 
@@ -28,17 +32,42 @@ func ConsensusManaPledgeID(nodeID string) ConsolidateFundsOption {
 	}
 }
 
+// DustThreshold is an option for the consolidateFunds call that restricts the consolidation to the outputs that hold
+// less than the given amount of tokens (0 consolidates all outputs).
+func DustThreshold(threshold uint64) ConsolidateFundsOption {
+	return func(options *ConsolidateFundsOptions) error {
+		options.DustThreshold = threshold
+		return nil
+	}
+}
+
+// MinOutputCount is an option for the consolidateFunds call that defines the minimum number of outputs that need to be
+// available for a consolidation to take place.
+func MinOutputCount(count int) ConsolidateFundsOption {
+	return func(options *ConsolidateFundsOptions) error {
+		if count < 2 {
+			return errors.Errorf("minimum output count must be at least 2, got %d", count)
+		}
+		options.MinOutputCount = count
+		return nil
+	}
+}
+
 // ConsolidateFundsOptions is a struct that is used to aggregate the optional parameters in the consolidateFunds call.
 type ConsolidateFundsOptions struct {
 	AccessManaPledgeID    string
 	ConsensusManaPledgeID string
 	WaitForConfirmation   bool
+	DustThreshold         uint64
+	MinOutputCount        int
 }
 
 // Build build the options.
 func Build(options ...ConsolidateFundsOption) (result *ConsolidateFundsOptions, err error) {
 	// create options to collect the arguments provided
-	result = &ConsolidateFundsOptions{}
+	result = &ConsolidateFundsOptions{
+		MinOutputCount: 2,
+	}
 
 	// apply arguments to our options
 	for _, option := range options {
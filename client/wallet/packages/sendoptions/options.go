@@ -152,6 +152,22 @@ func Fallback(addy devnetvm.Address, deadline time.Time) SendFundsOption {
 	}
 }
 
+// Multisig is an option for SendFunds call that locks the created outputs to a ThresholdSigOutput. The outputs can only
+// be spent with valid signatures of at least threshold signers out of the destination address and the given cosigners.
+func Multisig(threshold uint8, cosigners ...devnetvm.Address) SendFundsOption {
+	return func(options *SendFundsOptions) error {
+		if threshold == 0 {
+			return errors.New("the multisig threshold needs to be larger than 0")
+		}
+		if int(threshold) > len(cosigners)+1 {
+			return errors.Errorf("invalid multisig threshold: %d is larger than the amount of signers %d", threshold, len(cosigners)+1)
+		}
+		options.MultisigThreshold = threshold
+		options.MultisigCosigners = cosigners
+		return nil
+	}
+}
+
 // SendFundsOptions is a struct that is used to aggregate the optional parameters provided in the SendFunds call.
 type SendFundsOptions struct {
 	Destinations          map[address.Address]map[devnetvm.Color]uint64
@@ -159,6 +175,8 @@ type SendFundsOptions struct {
 	LockUntil             time.Time
 	FallbackAddress       devnetvm.Address
 	FallbackDeadline      time.Time
+	MultisigThreshold     uint8
+	MultisigCosigners     []devnetvm.Address
 	AccessManaPledgeID    string
 	ConsensusManaPledgeID string
 	WaitForConfirmation   bool
@@ -202,6 +220,11 @@ func Build(options ...SendFundsOption) (result *SendFundsOptions, err error) {
 
 		return
 	}
+	if result.MultisigThreshold != 0 && (!result.LockUntil.IsZero() || result.FallbackAddress != nil) {
+		err = errors.New("multisig outputs can't be combined with timelocks or fallback options")
+
+		return
+	}
 
 	return
 }
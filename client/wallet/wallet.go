@@ -3,6 +3,7 @@ package wallet
 import (
 	"context"
 	"reflect"
+	"sort"
 	"time"
 	"unsafe"
 
@@ -47,6 +48,7 @@ var ErrTooManyOutputs = errors.New("number of outputs is more, than supported fo
 type Wallet struct {
 	addressManager *AddressManager
 	assetRegistry  *AssetRegistry
+	coinControl    *CoinControl
 	outputManager  *OutputManager
 	connector      Connector
 
@@ -87,13 +89,18 @@ func New(options ...Option) (wallet *Wallet) {
 		wallet.assetRegistry = NewAssetRegistry(DefaultAssetRegistryNetwork)
 	}
 
+	// initialize coin control if none was provided in the options.
+	if wallet.coinControl == nil {
+		wallet.coinControl = NewCoinControl()
+	}
+
 	// initialize wallet with default connector (server) if none was provided
 	if wallet.connector == nil {
 		panic("you need to provide a connector for your wallet")
 	}
 
 	// initialize output manager
-	wallet.outputManager = NewUnspentOutputManager(wallet.addressManager, wallet.connector, wallet.Stateless)
+	wallet.outputManager = NewUnspentOutputManager(wallet.addressManager, wallet.connector, wallet.coinControl, wallet.Stateless)
 	err := wallet.outputManager.Refresh(true)
 	if err != nil {
 		panic(err)
@@ -135,7 +142,10 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *dev
 	// aggregate all the funds we consume from inputs
 	totalConsumedFunds := consumedOutputs.TotalFundsInOutputs()
 	remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, sendOptions.RemainderAddress)
-	outputs := wallet.buildOutputs(sendOptions, totalConsumedFunds, remainderAddress)
+	outputs, err := wallet.buildOutputs(sendOptions, totalConsumedFunds, remainderAddress)
+	if err != nil {
+		return
+	}
 
 	txEssence := devnetvm.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
@@ -200,8 +210,7 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 	if err != nil && !errors.Is(err, ErrTooManyOutputs) {
 		return
 	}
-	if allOutputs.OutputCount() == 1 {
-		err = errors.New("can't consolidate funds, there is only one value output in wallet")
+	if allOutputs, err = consolidationOutputs(allOutputs, consolidateOptions); err != nil {
 		return
 	}
 	consumedOutputsSlice := allOutputs.SplitIntoChunksOfMaxInputCount()
@@ -637,7 +646,7 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 
 // region DestroyNFT ///////////////////////////////////////////////////////////////////////////////////////////////////
 
-// DestroyNFT destroys the given nft (alias).
+// DestroyNFT destroys the given nft (alias) and sends its remaining funds to the remainder address.
 func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption) (tx *devnetvm.Transaction, err error) {
 	destroyOptions, err := destroynftoptions.Build(options...)
 	if err != nil {
@@ -660,32 +669,16 @@ func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption)
 		return
 	}
 
-	// can only be destroyed when minimal funds are present (unless it is delegated)
-	if !alias.IsDelegated() && !devnetvm.IsExactDustMinimum(alias.Balances()) {
-		withdrawAmount := alias.Balances().Map()
-		withdrawAmount[devnetvm.ColorIOTA] -= devnetvm.DustThresholdAliasOutputIOTA
-		_, err = wallet.WithdrawFundsFromNFT(
-			withdrawfromnftoptions.Alias(destroyOptions.Alias.Base58()),
-			withdrawfromnftoptions.Amount(withdrawAmount),
-			withdrawfromnftoptions.WaitForConfirmation(true),
-		)
-		if err != nil {
-			return
-		}
-		walletAlias, err = wallet.findGovernedAliasOutputByAliasID(destroyOptions.Alias)
-		if err != nil {
-			return
+	// the remaining funds of the alias are routed to the recovery address in the same transaction
+	recoveryAddress := destroyOptions.RemainderAddress
+	if recoveryAddress == nil {
+		consumedOutputs := OutputsByAddressAndOutputID{
+			// we only consume the to-be-destroyed alias
+			walletAlias.Address: {walletAlias.Object.ID(): walletAlias},
 		}
-		alias = walletAlias.Object.(*devnetvm.AliasOutput)
+		recoveryAddress = wallet.chooseRemainderAddress(consumedOutputs, address.AddressEmpty).Address()
 	}
-
-	// determine where the remainder will go
-	consumedOutputs := OutputsByAddressAndOutputID{
-		// we only consume the to-be-destroyed alias
-		walletAlias.Address: {walletAlias.Object.ID(): walletAlias},
-	}
-	remainderAddy := wallet.chooseRemainderAddress(consumedOutputs, address.AddressEmpty)
-	remainderOutput := devnetvm.NewSigLockedColoredOutput(alias.Balances(), remainderAddy.Address())
+	remainderOutput := devnetvm.NewSigLockedColoredOutput(alias.Balances(), recoveryAddress)
 
 	inputs := devnetvm.Inputs{alias.Input()}
 	outputs := devnetvm.Outputs{remainderOutput}
@@ -1341,6 +1334,89 @@ func (wallet *Wallet) UnspentAliasOutputs(includePending bool) map[address.Addre
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region CoinControl //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CoinControl returns the labels and the OutputSelections of the outputs of the wallet (e.g. to persist them).
+func (wallet *Wallet) CoinControl() *CoinControl {
+	return wallet.coinControl
+}
+
+// LabelOutput labels the unspent output with the given ID (an empty label removes the label).
+func (wallet *Wallet) LabelOutput(outputID utxo.OutputID, label string) (err error) {
+	if _, err = wallet.unspentOutput(outputID); err != nil {
+		return err
+	}
+
+	wallet.coinControl.SetLabel(outputID, label)
+
+	return nil
+}
+
+// PinOutput makes the automatic input selection use the unspent output with the given ID before any other output.
+func (wallet *Wallet) PinOutput(outputID utxo.OutputID) (err error) {
+	return wallet.selectOutput(outputID, PinnedSelection)
+}
+
+// ExcludeOutput excludes the unspent output with the given ID from the automatic input selection (i.e. to keep a
+// colored or soon-expiring output from being spent by accident).
+func (wallet *Wallet) ExcludeOutput(outputID utxo.OutputID) (err error) {
+	return wallet.selectOutput(outputID, ExcludedSelection)
+}
+
+// ReleaseOutput resets a pinned or excluded output, so that it is selected automatically again.
+func (wallet *Wallet) ReleaseOutput(outputID utxo.OutputID) (err error) {
+	return wallet.selectOutput(outputID, AutomaticSelection)
+}
+
+// LabeledOutputs returns the unspent (including pending) outputs of the wallet together with their labels and
+// OutputSelections, ordered by their label. If labels are given, only the outputs with one of these labels are returned.
+func (wallet *Wallet) LabeledOutputs(labels ...string) (outputs []*Output) {
+	labelFilter := make(map[string]bool)
+	for _, label := range labels {
+		labelFilter[label] = true
+	}
+
+	outputs = make([]*Output, 0)
+	for _, output := range wallet.outputManager.UnspentOutputs(true).OutputsByID() {
+		if len(labelFilter) == 0 || labelFilter[output.Label] {
+			outputs = append(outputs, output)
+		}
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		if outputs[i].Label != outputs[j].Label {
+			return outputs[i].Label < outputs[j].Label
+		}
+
+		return outputs[i].Object.ID().Base58() < outputs[j].Object.ID().Base58()
+	})
+
+	return outputs
+}
+
+// selectOutput sets the OutputSelection of the unspent output with the given ID.
+func (wallet *Wallet) selectOutput(outputID utxo.OutputID, selection OutputSelection) (err error) {
+	if _, err = wallet.unspentOutput(outputID); err != nil {
+		return err
+	}
+
+	wallet.coinControl.SetSelection(outputID, selection)
+
+	return nil
+}
+
+// unspentOutput returns the unspent (including pending) output of the wallet with the given ID.
+func (wallet *Wallet) unspentOutput(outputID utxo.OutputID) (output *Output, err error) {
+	output, exists := wallet.outputManager.UnspentOutputs(true).OutputsByID()[outputID]
+	if !exists {
+		return nil, errors.Errorf("output %s is not an unspent output of the wallet", outputID.Base58())
+	}
+
+	return output, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region RequestFaucetFunds ///////////////////////////////////////////////////////////////////////////////////////////
 
 // RequestFaucetFunds requests some funds from the faucet for testing purposes.
@@ -1780,6 +1856,17 @@ func (wallet *Wallet) Seed() *seed.Seed {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region SignEssence //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SignEssence signs the given TransactionEssence with the key of the given wallet address. It allows the wallet to act as
+// a cosigner of a ThresholdSigOutput by contributing its Signature to a ThresholdSignatureUnlockBlock.
+func (wallet *Wallet) SignEssence(essence *devnetvm.TransactionEssence, addr address.Address) devnetvm.Signature {
+	keyPair := wallet.Seed().KeyPair(addr.Index)
+	return devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(lo.PanicOnErr(essence.Bytes())))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region AddressManager ///////////////////////////////////////////////////////////////////////////////////////////////
 
 // AddressManager returns the manager for the addresses of this wallet.
@@ -1839,6 +1926,22 @@ func (wallet *Wallet) WaitForTxAcceptance(txID utxo.TransactionID, optionalCtx .
 
 // region Internal Methods /////////////////////////////////////////////////////////////////////////////////////////////
 
+// consolidationOutputs selects the outputs that are consumed by a consolidation according to the given options.
+func consolidationOutputs(outputs OutputsByAddressAndOutputID, consolidateOptions *consolidateoptions.ConsolidateFundsOptions) (selectedOutputs OutputsByAddressAndOutputID, err error) {
+	selectedOutputs = outputs
+	if consolidateOptions.DustThreshold > 0 {
+		selectedOutputs = selectedOutputs.DustOutputsOnly(consolidateOptions.DustThreshold)
+	}
+	if selectedOutputs.OutputCount() == 1 {
+		return nil, errors.New("can't consolidate funds, there is only one value output in wallet")
+	}
+	if selectedOutputs.OutputCount() < consolidateOptions.MinOutputCount {
+		return nil, errors.Errorf("can't consolidate funds, there are only %d of the required %d outputs in wallet", selectedOutputs.OutputCount(), consolidateOptions.MinOutputCount)
+	}
+
+	return selectedOutputs, nil
+}
+
 // waitForBalanceConfirmation waits until the balance of the wallet changes compared to the provided argument.
 // (a transaction modifying the wallet balance got confirmed).
 func (wallet *Wallet) waitForBalanceConfirmation(prevConfirmedBalance map[devnetvm.Color]uint64) (err error) {
@@ -1978,33 +2081,39 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[devnetvm.Color
 	outputsToConsume := NewAddressToOutputs()
 	numOfCollectedOutputs := 0
 	now := time.Now()
-	for _, addy := range addresses {
-		for outputID, output := range unspentOutputs[addy] {
-			if output.Object.Type() == devnetvm.ExtendedLockedOutputType {
-				casted := output.Object.(*devnetvm.ExtendedLockedOutput)
-				if casted.TimeLockedNow(now) || !casted.UnlockAddressNow(now).Equals(addy.Address()) {
-					// skip the output because we wouldn't be able to unlock it
+	// pinned outputs are collected in a first pass before all other (not excluded) outputs
+	for _, selection := range []OutputSelection{PinnedSelection, AutomaticSelection} {
+		for _, addy := range addresses {
+			for outputID, output := range unspentOutputs[addy] {
+				if output.Selection != selection {
 					continue
 				}
-			}
-			contributingOutput := false
-			output.Object.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
-				_, has := fundingBalance[color]
-				if has {
-					collected[color] += balance
-					contributingOutput = true
-				}
-				return true
-			})
-			if contributingOutput {
-				// store the output in the outputs to use for the transfer
-				if _, addressEntryExists := outputsToConsume[addy]; !addressEntryExists {
-					outputsToConsume[addy] = make(map[utxo.OutputID]*Output)
+				if output.Object.Type() == devnetvm.ExtendedLockedOutputType {
+					casted := output.Object.(*devnetvm.ExtendedLockedOutput)
+					if casted.TimeLockedNow(now) || !casted.UnlockAddressNow(now).Equals(addy.Address()) {
+						// skip the output because we wouldn't be able to unlock it
+						continue
+					}
 				}
-				outputsToConsume[addy][outputID] = output
-				numOfCollectedOutputs++
-				if enoughCollected(collected, fundingBalance) && numOfCollectedOutputs <= devnetvm.MaxInputCount {
-					return outputsToConsume, nil
+				contributingOutput := false
+				output.Object.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+					_, has := fundingBalance[color]
+					if has {
+						collected[color] += balance
+						contributingOutput = true
+					}
+					return true
+				})
+				if contributingOutput {
+					// store the output in the outputs to use for the transfer
+					if _, addressEntryExists := outputsToConsume[addy]; !addressEntryExists {
+						outputsToConsume[addy] = make(map[utxo.OutputID]*Output)
+					}
+					outputsToConsume[addy][outputID] = output
+					numOfCollectedOutputs++
+					if enoughCollected(collected, fundingBalance) && numOfCollectedOutputs <= devnetvm.MaxInputCount {
+						return outputsToConsume, nil
+					}
 				}
 			}
 		}
@@ -2047,7 +2156,7 @@ func (wallet *Wallet) buildOutputs(
 	sendOptions *sendoptions.SendFundsOptions,
 	consumedFunds map[devnetvm.Color]uint64,
 	remainderAddress address.Address,
-) (outputs devnetvm.Outputs) {
+) (outputs devnetvm.Outputs, err error) {
 	// build outputs for destinations
 	outputsByColor := make(map[address.Address]map[devnetvm.Color]uint64)
 	for walletAddress, coloredBalances := range sendOptions.Destinations {
@@ -2082,7 +2191,13 @@ func (wallet *Wallet) buildOutputs(
 	for addr, outputBalanceMap := range outputsByColor {
 		coloredBalances := devnetvm.NewColoredBalances(outputBalanceMap)
 		var output devnetvm.Output
-		if !sendOptions.LockUntil.IsZero() || !sendOptions.FallbackDeadline.IsZero() || sendOptions.FallbackAddress != nil {
+		if sendOptions.MultisigThreshold != 0 {
+			thresholdOutput, thresholdErr := devnetvm.NewThresholdSigOutput(coloredBalances, sendOptions.MultisigThreshold, append([]devnetvm.Address{addr.Address()}, sendOptions.MultisigCosigners...)...)
+			if thresholdErr != nil {
+				return nil, errors.Wrap(thresholdErr, "failed to create multisig output")
+			}
+			output = thresholdOutput
+		} else if !sendOptions.LockUntil.IsZero() || !sendOptions.FallbackDeadline.IsZero() || sendOptions.FallbackAddress != nil {
 			extended := devnetvm.NewExtendedLockedOutput(outputBalanceMap, addr.Address())
 			if !sendOptions.LockUntil.IsZero() {
 				extended = extended.WithTimeLock(sendOptions.LockUntil)
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/consolidateoptions"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+func TestConsolidationOutputs_DustOutputsOnly(t *testing.T) {
+	outputs := testOutputs(10, 100, 1000, 20, 5000)
+
+	selectedOutputs, err := consolidationOutputs(outputs, buildConsolidateOptions(t,
+		consolidateoptions.DustThreshold(1000),
+		consolidateoptions.MinOutputCount(3),
+	))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{10, 100, 20}, outputBalances(selectedOutputs))
+
+	// outputs that hold exactly the threshold are not dust
+	selectedOutputs, err = consolidationOutputs(outputs, buildConsolidateOptions(t,
+		consolidateoptions.DustThreshold(100),
+		consolidateoptions.MinOutputCount(2),
+	))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{10, 20}, outputBalances(selectedOutputs))
+
+	_, err = consolidationOutputs(outputs, buildConsolidateOptions(t,
+		consolidateoptions.DustThreshold(20),
+		consolidateoptions.MinOutputCount(2),
+	))
+	require.ErrorContains(t, err, "only one value output")
+}
+
+func TestConsolidationOutputs_MinOutputCount(t *testing.T) {
+	outputs := testOutputs(10, 100, 1000, 20, 5000)
+
+	selectedOutputs, err := consolidationOutputs(outputs, buildConsolidateOptions(t, consolidateoptions.MinOutputCount(5)))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{10, 100, 1000, 20, 5000}, outputBalances(selectedOutputs))
+
+	_, err = consolidationOutputs(outputs, buildConsolidateOptions(t, consolidateoptions.MinOutputCount(6)))
+	require.ErrorContains(t, err, "only 5 of the required 6 outputs")
+
+	// the minimum is applied to the dust outputs only
+	_, err = consolidationOutputs(outputs, buildConsolidateOptions(t,
+		consolidateoptions.DustThreshold(1000),
+		consolidateoptions.MinOutputCount(4),
+	))
+	require.ErrorContains(t, err, "only 3 of the required 4 outputs")
+}
+
+func buildConsolidateOptions(t *testing.T, options ...consolidateoptions.ConsolidateFundsOption) *consolidateoptions.ConsolidateFundsOptions {
+	consolidateOptions, err := consolidateoptions.Build(options...)
+	require.NoError(t, err)
+
+	return consolidateOptions
+}
+
+func testOutputs(balances ...uint64) (outputs OutputsByAddressAndOutputID) {
+	outputs = NewAddressToOutputs()
+	for i, balance := range balances {
+		addr := address.Address{Index: uint64(i % 2)}
+		addr.AddressBytes[0] = byte(devnetvm.ED25519AddressType)
+		addr.AddressBytes[1] = byte(i % 2)
+
+		if _, exists := outputs[addr]; !exists {
+			outputs[addr] = make(map[utxo.OutputID]*Output)
+		}
+
+		outputID := utxo.NewOutputID(utxo.EmptyTransactionID, uint16(i))
+		outputs[addr][outputID] = &Output{
+			Address: addr,
+			Object:  devnetvm.NewSigLockedSingleOutput(balance, addr.Address()),
+		}
+	}
+
+	return outputs
+}
+
+func outputBalances(outputs OutputsByAddressAndOutputID) (balances []uint64) {
+	for _, output := range outputs.OutputsByID() {
+		balance, _ := output.Object.Balances().Get(devnetvm.ColorIOTA)
+		balances = append(balances, balance)
+	}
+
+	return balances
+}
@@ -249,6 +249,30 @@ module.exports = {
         id: 'apis/snapshot',
       },
 
+      {
+        type: 'doc',
+        label: 'Backup',
+        id: 'apis/backup',
+      },
+
+      {
+        type: 'doc',
+        label: 'Subscriptions',
+        id: 'apis/subscriptions',
+      },
+
+      {
+        type: 'doc',
+        label: 'GraphQL',
+        id: 'apis/graphql',
+      },
+
+      {
+        type: 'doc',
+        label: 'Journal',
+        id: 'apis/journal',
+      },
+
       {
         type: 'doc',
         label: 'Faucet',
@@ -0,0 +1,411 @@
+package addressfeed
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+var (
+	// ErrSubscriptionOverflow is returned if the consumer of a Subscription did not keep up with the activity of the
+	// address (the consumer has to subscribe again to resynchronize).
+	ErrSubscriptionOverflow = errors.New("subscription overflowed")
+
+	// ErrSubscriptionClosed is returned if a Subscription was closed by the consumer or the Feed.
+	ErrSubscriptionClosed = errors.New("subscription closed")
+)
+
+// SnapshotFunc is a function that returns the current unspent outputs of an address.
+type SnapshotFunc = func() (outputs devnetvm.Outputs, err error)
+
+// region Feed /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Feed distributes the activity of addresses to their Subscriptions. A Subscription starts with a snapshot of the
+// unspent outputs of its address that is followed by the incremental changes, so that consumers do not miss the
+// outputs that are created between their initial query and the start of their subscription.
+type Feed struct {
+	subscriptions  map[string]map[uint64]*Subscription
+	subscriptionID uint64
+	mutex          sync.RWMutex
+
+	optsMaxPendingEvents int
+}
+
+// New creates a new Feed.
+func New(opts ...options.Option[Feed]) *Feed {
+	return options.Apply(&Feed{
+		subscriptions:        make(map[string]map[uint64]*Subscription),
+		optsMaxPendingEvents: 1024,
+	}, opts)
+}
+
+// Subscribe subscribes to the activity of the given address. The subscription is registered before the snapshot is
+// retrieved, so that every change that happens while the snapshot is retrieved is delivered after it.
+func (f *Feed) Subscribe(address devnetvm.Address, snapshotFunc SnapshotFunc) (subscription *Subscription, err error) {
+	subscription = f.register(address)
+
+	outputs, err := snapshotFunc()
+	if err != nil {
+		subscription.Close()
+
+		return nil, errors.Wrapf(err, "failed to retrieve snapshot of address %s", address.Base58())
+	}
+
+	subscription.synchronize(outputs)
+
+	return subscription, nil
+}
+
+// OutputCreated delivers the creation of the given output to the Subscriptions of the given addresses.
+func (f *Feed) OutputCreated(output devnetvm.Output, addresses ...devnetvm.Address) {
+	f.deliver(OutputCreated, output, addresses)
+}
+
+// OutputRemoved delivers the removal (spending or rejection) of the given output to the Subscriptions of the given
+// addresses.
+func (f *Feed) OutputRemoved(output devnetvm.Output, addresses ...devnetvm.Address) {
+	f.deliver(OutputRemoved, output, addresses)
+}
+
+// FallbackExpired delivers the expiration of the fallback deadline of the given output to the Subscriptions of the
+// given (fallback) addresses, so that their consumers can reclaim it.
+func (f *Feed) FallbackExpired(output devnetvm.Output, addresses ...devnetvm.Address) {
+	f.deliver(FallbackExpired, output, addresses)
+}
+
+// SubscriptionCount returns the number of active Subscriptions.
+func (f *Feed) SubscriptionCount() (count int) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	for _, subscriptions := range f.subscriptions {
+		count += len(subscriptions)
+	}
+
+	return count
+}
+
+// Shutdown closes all Subscriptions.
+func (f *Feed) Shutdown() {
+	f.mutex.Lock()
+	subscriptions := make([]*Subscription, 0)
+	for _, addressSubscriptions := range f.subscriptions {
+		for _, subscription := range addressSubscriptions {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	f.mutex.Unlock()
+
+	for _, subscription := range subscriptions {
+		subscription.Close()
+	}
+}
+
+// register creates a new Subscription for the given address and adds it to the Feed.
+func (f *Feed) register(address devnetvm.Address) (subscription *Subscription) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.subscriptionID++
+	subscription = newSubscription(f, f.subscriptionID, address)
+
+	addressSubscriptions, exists := f.subscriptions[address.Base58()]
+	if !exists {
+		addressSubscriptions = make(map[uint64]*Subscription)
+		f.subscriptions[address.Base58()] = addressSubscriptions
+	}
+	addressSubscriptions[subscription.ID] = subscription
+
+	return subscription
+}
+
+// unregister removes the given Subscription from the Feed.
+func (f *Feed) unregister(subscription *Subscription) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	addressSubscriptions, exists := f.subscriptions[subscription.Address.Base58()]
+	if !exists {
+		return
+	}
+
+	delete(addressSubscriptions, subscription.ID)
+	if len(addressSubscriptions) == 0 {
+		delete(f.subscriptions, subscription.Address.Base58())
+	}
+}
+
+// deliver passes the given change to the Subscriptions of the given addresses.
+func (f *Feed) deliver(eventType EventType, output devnetvm.Output, addresses []devnetvm.Address) {
+	subscriptions := make([]*Subscription, 0)
+
+	f.mutex.RLock()
+	for _, address := range addresses {
+		for _, subscription := range f.subscriptions[address.Base58()] {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	f.mutex.RUnlock()
+
+	for _, subscription := range subscriptions {
+		subscription.process(eventType, output)
+	}
+}
+
+// WithMaxPendingEvents sets the maximum number of incremental Events that are queued for a Subscription before it is
+// closed with ErrSubscriptionOverflow.
+func WithMaxPendingEvents(maxPendingEvents int) options.Option[Feed] {
+	return func(f *Feed) {
+		f.optsMaxPendingEvents = maxPendingEvents
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Subscription /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscription is the subscription to the activity of a single address. Its Events are numbered with consecutive
+// sequence numbers (starting at 1), so that consumers can detect if they missed any of them.
+type Subscription struct {
+	// ID contains the identifier of the Subscription.
+	ID uint64
+
+	// Address contains the address whose activity is delivered.
+	Address devnetvm.Address
+
+	feed          *Feed
+	sequence      uint64
+	synchronized  bool
+	pendingEvents []*Event
+	queue         []*Event
+	queuedChanges int
+	knownOutputs  map[utxo.OutputID]bool
+	err           error
+	notify        chan struct{}
+	mutex         sync.Mutex
+}
+
+// newSubscription creates a new Subscription that waits for its snapshot.
+func newSubscription(feed *Feed, id uint64, address devnetvm.Address) *Subscription {
+	return &Subscription{
+		ID:           id,
+		Address:      address,
+		feed:         feed,
+		knownOutputs: make(map[utxo.OutputID]bool),
+		notify:       make(chan struct{}, 1),
+	}
+}
+
+// Next returns the next Event of the Subscription (it blocks until an Event is available, the Subscription is closed
+// or the context is canceled).
+func (s *Subscription) Next(ctx context.Context) (event *Event, err error) {
+	for {
+		s.mutex.Lock()
+		if len(s.queue) > 0 {
+			event, s.queue = s.queue[0], s.queue[1:]
+			if event.Type.IsChange() {
+				s.queuedChanges--
+			}
+			s.mutex.Unlock()
+
+			return event, nil
+		}
+		err = s.err
+		s.mutex.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close closes the Subscription (the Events that were already queued can still be retrieved).
+func (s *Subscription) Close() {
+	s.close(ErrSubscriptionClosed)
+}
+
+// synchronize queues the Events of the snapshot followed by the changes that happened while it was retrieved.
+func (s *Subscription) synchronize(outputs devnetvm.Outputs) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	for _, output := range outputs {
+		s.knownOutputs[output.ID()] = true
+		s.enqueue(SnapshotOutput, output)
+	}
+	s.enqueue(SnapshotCompleted, nil)
+
+	for _, pendingEvent := range s.pendingEvents {
+		s.apply(pendingEvent.Type, pendingEvent.Output)
+	}
+	s.pendingEvents = nil
+	s.synchronized = true
+}
+
+// process processes a change of the activity of the address.
+func (s *Subscription) process(eventType EventType, output devnetvm.Output) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	if !s.synchronized {
+		if len(s.pendingEvents) >= s.feed.optsMaxPendingEvents {
+			s.fail(ErrSubscriptionOverflow)
+			return
+		}
+
+		s.pendingEvents = append(s.pendingEvents, &Event{Type: eventType, Output: output})
+		return
+	}
+
+	s.apply(eventType, output)
+}
+
+// apply queues the given change unless it is already reflected in the Events that were delivered so far (i.e. the
+// creation of an output that is part of the snapshot or the removal or expiration of an output that was never
+// delivered).
+func (s *Subscription) apply(eventType EventType, output devnetvm.Output) {
+	switch eventType {
+	case OutputCreated:
+		if s.knownOutputs[output.ID()] {
+			return
+		}
+		s.knownOutputs[output.ID()] = true
+	case OutputRemoved:
+		if !s.knownOutputs[output.ID()] {
+			return
+		}
+		delete(s.knownOutputs, output.ID())
+	case FallbackExpired:
+		if !s.knownOutputs[output.ID()] {
+			return
+		}
+	}
+
+	if s.queuedChanges >= s.feed.optsMaxPendingEvents {
+		s.fail(ErrSubscriptionOverflow)
+		return
+	}
+
+	s.enqueue(eventType, output)
+}
+
+// enqueue adds a new Event to the queue of the Subscription.
+func (s *Subscription) enqueue(eventType EventType, output devnetvm.Output) {
+	s.sequence++
+	s.queue = append(s.queue, &Event{
+		Sequence: s.sequence,
+		Type:     eventType,
+		Output:   output,
+	})
+
+	if eventType.IsChange() {
+		s.queuedChanges++
+	}
+
+	s.signal()
+}
+
+// close unregisters the Subscription and sets the error that is returned after the queued Events were retrieved.
+func (s *Subscription) close(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.fail(err)
+}
+
+// fail unregisters the Subscription and sets the error that is returned after the queued Events were retrieved (it
+// expects the Subscription to be locked).
+func (s *Subscription) fail(err error) {
+	if s.err != nil {
+		return
+	}
+
+	s.err = err
+	s.pendingEvents = nil
+	s.signal()
+
+	s.feed.unregister(s)
+}
+
+// signal wakes up a consumer that is waiting for Events.
+func (s *Subscription) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Event ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Event is a single element of a Subscription.
+type Event struct {
+	// Sequence contains the sequence number of the Event within its Subscription.
+	Sequence uint64
+
+	// Type contains the type of the Event.
+	Type EventType
+
+	// Output contains the affected output (nil for SnapshotCompleted).
+	Output devnetvm.Output
+}
+
+// EventType represents the type of an Event.
+type EventType uint8
+
+const (
+	// SnapshotOutput is the type of the Events that contain the unspent outputs at the start of the Subscription.
+	SnapshotOutput EventType = iota
+
+	// SnapshotCompleted is the type of the Event that marks the end of the snapshot.
+	SnapshotCompleted
+
+	// OutputCreated is the type of the Events that contain the outputs that were created after the snapshot.
+	OutputCreated
+
+	// OutputRemoved is the type of the Events that contain the outputs that were spent or rejected after they were
+	// delivered.
+	OutputRemoved
+
+	// FallbackExpired is the type of the Events that contain the delivered outputs whose fallback deadline passed, so
+	// that they can be reclaimed by the fallback address.
+	FallbackExpired
+)
+
+// IsChange returns true if the EventType represents an incremental change.
+func (e EventType) IsChange() bool {
+	return e == OutputCreated || e == OutputRemoved || e == FallbackExpired
+}
+
+// String returns a human-readable representation of the EventType.
+func (e EventType) String() string {
+	return [...]string{
+		"snapshotOutput",
+		"snapshotCompleted",
+		"outputCreated",
+		"outputRemoved",
+		"fallbackExpired",
+	}[e]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
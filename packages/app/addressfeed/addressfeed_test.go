@@ -0,0 +1,137 @@
+package addressfeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+)
+
+func TestFeed(t *testing.T) {
+	address := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	otherAddress := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	snapshotOutput, raceOutput, spentBeforeSnapshot := newOutput(1, address), newOutput(2, address), newOutput(3, address)
+	feed := New()
+
+	subscription, err := feed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		// changes that happen while the snapshot is retrieved
+		feed.OutputCreated(raceOutput, address)
+		feed.OutputRemoved(spentBeforeSnapshot, address)
+		feed.OutputCreated(newOutput(4, otherAddress), otherAddress)
+
+		return devnetvm.Outputs{snapshotOutput, raceOutput}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, feed.SubscriptionCount())
+
+	feed.OutputCreated(raceOutput, address)
+	createdOutput := newOutput(5, address)
+	feed.OutputCreated(createdOutput, address)
+	feed.OutputRemoved(snapshotOutput, address)
+
+	assertEvents(t, subscription,
+		&Event{Sequence: 1, Type: SnapshotOutput, Output: snapshotOutput},
+		&Event{Sequence: 2, Type: SnapshotOutput, Output: raceOutput},
+		&Event{Sequence: 3, Type: SnapshotCompleted},
+		&Event{Sequence: 4, Type: OutputCreated, Output: createdOutput},
+		&Event{Sequence: 5, Type: OutputRemoved, Output: snapshotOutput},
+	)
+
+	subscription.Close()
+	_, err = subscription.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriptionClosed)
+	require.Equal(t, 0, feed.SubscriptionCount())
+
+	_, err = feed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		return nil, errors.New("storage walk failed")
+	})
+	require.Error(t, err)
+	require.Equal(t, 0, feed.SubscriptionCount())
+}
+
+func TestFeed_FallbackExpired(t *testing.T) {
+	address := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	feed := New()
+
+	knownOutput, unknownOutput := newOutput(1, address), newOutput(2, address)
+	subscription, err := feed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		return devnetvm.Outputs{knownOutput}, nil
+	})
+	require.NoError(t, err)
+
+	feed.FallbackExpired(unknownOutput, address)
+	feed.FallbackExpired(knownOutput, address)
+
+	assertEvents(t, subscription,
+		&Event{Sequence: 1, Type: SnapshotOutput, Output: knownOutput},
+		&Event{Sequence: 2, Type: SnapshotCompleted},
+		&Event{Sequence: 3, Type: FallbackExpired, Output: knownOutput},
+	)
+}
+
+func TestFeed_Overflow(t *testing.T) {
+	address := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	feed := New(WithMaxPendingEvents(2))
+
+	subscription, err := feed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		return devnetvm.Outputs{newOutput(1, address), newOutput(2, address), newOutput(3, address)}, nil
+	})
+	require.NoError(t, err)
+
+	for i := uint16(4); i < 7; i++ {
+		feed.OutputCreated(newOutput(i, address), address)
+	}
+	require.Equal(t, 0, feed.SubscriptionCount())
+
+	for i := 0; i < 6; i++ {
+		_, err = subscription.Next(context.Background())
+		require.NoError(t, err)
+	}
+	_, err = subscription.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriptionOverflow)
+}
+
+func TestSubscription_Next(t *testing.T) {
+	address := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	feed := New()
+
+	subscription, err := feed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		return devnetvm.Outputs{}, nil
+	})
+	require.NoError(t, err)
+	assertEvents(t, subscription, &Event{Sequence: 1, Type: SnapshotCompleted})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = subscription.Next(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	createdOutput := newOutput(1, address)
+	go feed.OutputCreated(createdOutput, address)
+	assertEvents(t, subscription, &Event{Sequence: 2, Type: OutputCreated, Output: createdOutput})
+}
+
+func assertEvents(t *testing.T, subscription *Subscription, expectedEvents ...*Event) {
+	for _, expectedEvent := range expectedEvents {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		event, err := subscription.Next(ctx)
+		cancel()
+
+		require.NoError(t, err)
+		require.Equal(t, expectedEvent, event)
+	}
+}
+
+func newOutput(index uint16, address devnetvm.Address) devnetvm.Output {
+	output := devnetvm.NewSigLockedSingleOutput(100, address)
+	output.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{1}), index))
+
+	return output
+}
@@ -0,0 +1,219 @@
+package addresswatch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// NotifyFunc is a function that delivers the Notifications of a Watcher (i.e. to a webhook).
+type NotifyFunc = func(notifications []*Notification)
+
+// region Watcher //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Watcher notifies about the confirmed outputs of a set of watched addresses. It either delivers a Notification per
+// confirmed output or (if a digest interval is configured) coalesces the confirmations of each address into periodic
+// digests that contain the number of confirmed outputs and the confirmed totals per color.
+type Watcher struct {
+	notifyFunc  NotifyFunc
+	addresses   map[string]bool
+	digests     map[string]*Notification
+	digestStart time.Time
+	mutex       sync.Mutex
+
+	running  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	optsDigestInterval time.Duration
+	optsTimeProvider   func() time.Time
+}
+
+// New creates a new Watcher that delivers its Notifications with the given function.
+func New(notifyFunc NotifyFunc, opts ...options.Option[Watcher]) *Watcher {
+	return options.Apply(&Watcher{
+		notifyFunc:       notifyFunc,
+		addresses:        make(map[string]bool),
+		digests:          make(map[string]*Notification),
+		shutdown:         make(chan struct{}),
+		optsTimeProvider: time.Now,
+	}, opts, func(w *Watcher) {
+		w.digestStart = w.optsTimeProvider()
+	})
+}
+
+// Watch adds the given address to the watched addresses.
+func (w *Watcher) Watch(address devnetvm.Address) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.addresses[address.Base58()] = true
+}
+
+// Unwatch removes the given address from the watched addresses (confirmations that were already added to a digest are
+// still delivered).
+func (w *Watcher) Unwatch(address devnetvm.Address) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.addresses, address.Base58())
+}
+
+// IsWatched returns true if the given address is watched.
+func (w *Watcher) IsWatched(address devnetvm.Address) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.addresses[address.Base58()]
+}
+
+// OutputConfirmed processes a confirmed output and notifies about it if its address is watched.
+func (w *Watcher) OutputConfirmed(output devnetvm.Output) {
+	address := output.Address().Base58()
+
+	w.mutex.Lock()
+	if !w.addresses[address] {
+		w.mutex.Unlock()
+		return
+	}
+
+	if w.optsDigestInterval > 0 {
+		digest, exists := w.digests[address]
+		if !exists {
+			digest = newNotification(address, true)
+			w.digests[address] = digest
+		}
+		digest.add(output)
+		w.mutex.Unlock()
+
+		return
+	}
+	w.mutex.Unlock()
+
+	notification := newNotification(address, false)
+	notification.add(output)
+	notification.To = w.optsTimeProvider()
+	notification.From = notification.To
+
+	w.notifyFunc([]*Notification{notification})
+}
+
+// FlushDigests delivers the digests of all addresses that received confirmations since the last flush.
+func (w *Watcher) FlushDigests() {
+	now := w.optsTimeProvider()
+
+	w.mutex.Lock()
+	digests := make([]*Notification, 0, len(w.digests))
+	for _, digest := range w.digests {
+		digest.From = w.digestStart
+		digest.To = now
+		digests = append(digests, digest)
+	}
+	w.digests = make(map[string]*Notification)
+	w.digestStart = now
+	w.mutex.Unlock()
+
+	if len(digests) == 0 {
+		return
+	}
+
+	sort.Slice(digests, func(i, j int) bool {
+		return digests[i].Address < digests[j].Address
+	})
+
+	w.notifyFunc(digests)
+}
+
+// Start starts the periodic job that delivers the digests (if a digest interval is configured).
+func (w *Watcher) Start() {
+	// only start if digests are enabled and the job is not yet running
+	if w.optsDigestInterval > 0 && w.running.CompareAndSwap(false, true) {
+		w.wg.Add(1)
+		go w.run()
+	}
+}
+
+// Shutdown shuts down the periodic job and delivers the pending digests.
+func (w *Watcher) Shutdown() {
+	if w.running.CompareAndSwap(true, false) {
+		w.shutdown <- struct{}{}
+	}
+
+	w.wg.Wait()
+}
+
+func (w *Watcher) run() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.optsDigestInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.shutdown:
+			w.FlushDigests()
+			return
+		case <-ticker.C:
+			w.FlushDigests()
+		}
+	}
+}
+
+// WithDigestInterval sets the interval in which the confirmations are delivered as digests (0 delivers a Notification
+// per confirmed output).
+func WithDigestInterval(digestInterval time.Duration) options.Option[Watcher] {
+	return func(w *Watcher) {
+		w.optsDigestInterval = digestInterval
+	}
+}
+
+// WithTimeProvider sets the function that provides the current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Watcher] {
+	return func(w *Watcher) {
+		w.optsTimeProvider = timeProvider
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Notification /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Notification is the JSON model of the confirmations of a watched address (either a single confirmed output or a
+// digest of all confirmed outputs since the previous digest).
+type Notification struct {
+	Address   string            `json:"address"`
+	Digest    bool              `json:"digest"`
+	From      time.Time         `json:"from"`
+	To        time.Time         `json:"to"`
+	Count     int               `json:"count"`
+	Totals    map[string]uint64 `json:"totals"`
+	OutputIDs []string          `json:"outputIDs"`
+}
+
+// newNotification creates a new empty Notification for the given address.
+func newNotification(address string, digest bool) *Notification {
+	return &Notification{
+		Address:   address,
+		Digest:    digest,
+		Totals:    make(map[string]uint64),
+		OutputIDs: make([]string, 0),
+	}
+}
+
+// add adds the given confirmed output to the Notification.
+func (n *Notification) add(output devnetvm.Output) {
+	n.Count++
+	n.OutputIDs = append(n.OutputIDs, output.ID().Base58())
+
+	output.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+		n.Totals[color.Base58()] += balance
+		return true
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,115 @@
+package addresswatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+)
+
+func TestWatcher(t *testing.T) {
+	watchedAddress := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	otherAddress := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	delivered := make([][]*Notification, 0)
+	w := New(func(notifications []*Notification) {
+		delivered = append(delivered, notifications)
+	})
+	w.Watch(watchedAddress)
+	require.True(t, w.IsWatched(watchedAddress))
+	require.False(t, w.IsWatched(otherAddress))
+
+	w.OutputConfirmed(newOutput(1, 100, watchedAddress))
+	w.OutputConfirmed(newOutput(2, 100, otherAddress))
+	require.Len(t, delivered, 1)
+	require.Len(t, delivered[0], 1)
+	require.False(t, delivered[0][0].Digest)
+	require.Equal(t, 1, delivered[0][0].Count)
+	require.EqualValues(t, 100, delivered[0][0].Totals[devnetvm.ColorIOTA.Base58()])
+
+	w.Unwatch(watchedAddress)
+	w.OutputConfirmed(newOutput(3, 100, watchedAddress))
+	require.Len(t, delivered, 1)
+}
+
+func TestWatcher_Digests(t *testing.T) {
+	firstAddress := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	secondAddress := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	start := time.Now()
+	now := start
+
+	delivered := make([][]*Notification, 0)
+	w := New(func(notifications []*Notification) {
+		delivered = append(delivered, notifications)
+	}, WithDigestInterval(30*time.Second), WithTimeProvider(func() time.Time {
+		return now
+	}))
+	w.Watch(firstAddress)
+	w.Watch(secondAddress)
+
+	w.OutputConfirmed(newOutput(1, 100, firstAddress))
+	w.OutputConfirmed(newOutput(2, 50, firstAddress))
+	w.OutputConfirmed(newOutput(3, 10, secondAddress))
+	require.Empty(t, delivered)
+
+	now = now.Add(30 * time.Second)
+	w.FlushDigests()
+	require.Len(t, delivered, 1)
+	require.Len(t, delivered[0], 2)
+
+	digests := make(map[string]*Notification)
+	for _, digest := range delivered[0] {
+		require.True(t, digest.Digest)
+		require.Equal(t, start, digest.From)
+		require.Equal(t, now, digest.To)
+		digests[digest.Address] = digest
+	}
+	require.Equal(t, 2, digests[firstAddress.Base58()].Count)
+	require.EqualValues(t, 150, digests[firstAddress.Base58()].Totals[devnetvm.ColorIOTA.Base58()])
+	require.Len(t, digests[firstAddress.Base58()].OutputIDs, 2)
+	require.Equal(t, 1, digests[secondAddress.Base58()].Count)
+	require.EqualValues(t, 10, digests[secondAddress.Base58()].Totals[devnetvm.ColorIOTA.Base58()])
+
+	// empty digests are not delivered
+	w.FlushDigests()
+	require.Len(t, delivered, 1)
+}
+
+func TestWebhook(t *testing.T) {
+	received := make(chan []*Notification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var notifications []*Notification
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&notifications))
+		received <- notifications
+	}))
+	defer server.Close()
+
+	notification := newNotification("address", true)
+	notification.Count = 3
+	require.NoError(t, NewWebhook(server.URL, time.Second).Deliver(context.Background(), []*Notification{notification}))
+
+	notifications := <-received
+	require.Len(t, notifications, 1)
+	require.Equal(t, "address", notifications[0].Address)
+	require.Equal(t, 3, notifications[0].Count)
+
+	require.Error(t, NewWebhook(server.URL+"/%zz", time.Second).Deliver(context.Background(), nil))
+}
+
+func newOutput(index byte, balance uint64, address devnetvm.Address) devnetvm.Output {
+	output := devnetvm.NewSigLockedSingleOutput(balance, address)
+
+	var outputID utxo.OutputID
+	outputID.TransactionID.Identifier[0] = index
+	output.SetID(outputID)
+
+	return output
+}
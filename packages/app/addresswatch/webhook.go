@@ -0,0 +1,51 @@
+package addresswatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Webhook delivers Notifications as JSON array in the body of a POST request to a URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a new Webhook that posts to the given URL (requests are cancelled after the given timeout).
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Deliver posts the given Notifications to the URL of the Webhook.
+func (w *Webhook) Deliver(ctx context.Context, notifications []*Notification) (err error) {
+	body, err := json.Marshal(notifications)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal notifications")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for %s", w.url)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post notifications to %s", w.url)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("webhook %s responded with status %d", w.url, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package artifacts
+
+import (
+	"time"
+)
+
+// Kind is the type of the node-local state that an Artifact represents.
+type Kind string
+
+const (
+	// KindLock represents the lock of the inputs of a submitted transaction.
+	KindLock Kind = "lock"
+
+	// KindAlias represents a human-readable alias of a transaction or an output.
+	KindAlias Kind = "alias"
+)
+
+// Artifact is a piece of node-local state that was created through the API and that is discarded once its time to live
+// elapsed.
+type Artifact struct {
+	// Kind contains the type of the Artifact.
+	Kind Kind
+
+	// ID contains the identifier of the Artifact (it is unique among the Artifacts of the same Kind).
+	ID string
+
+	// CreatedAt contains the time at which the Artifact was first tracked.
+	CreatedAt time.Time
+
+	// ExpiresAt contains the time after which the Artifact is discarded.
+	ExpiresAt time.Time
+
+	expireFunc ExpireFunc
+}
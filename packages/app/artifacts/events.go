@@ -0,0 +1,24 @@
+package artifacts
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// Events represents events happening on a Registry.
+type Events struct {
+	// ArtifactTracked is triggered when an artifact starts being tracked.
+	ArtifactTracked *event.Event1[*Artifact]
+
+	// ArtifactExpired is triggered when an artifact was removed because its time to live elapsed.
+	ArtifactExpired *event.Event1[*Artifact]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		ArtifactTracked: event.New1[*Artifact](),
+		ArtifactExpired: event.New1[*Artifact](),
+	}
+})
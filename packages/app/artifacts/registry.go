@@ -0,0 +1,271 @@
+package artifacts
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// ExpireFunc is a function that discards the state of an expired artifact.
+type ExpireFunc = func()
+
+// Registry keeps track of the node-local artifacts that were created through the API (e.g. locks, aliases or
+// subscriptions) and discards them once their time to live elapsed, so that long-running nodes do not accumulate stale
+// API state.
+type Registry struct {
+	// Events contains the Events of the Registry.
+	Events *Events
+
+	artifacts map[Kind]map[string]*Artifact
+	mutex     sync.RWMutex
+
+	expiredCount map[Kind]*atomic.Uint64
+	countMutex   sync.Mutex
+
+	running  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	optsTTL          time.Duration
+	optsKindTTLs     map[Kind]time.Duration
+	optsInterval     time.Duration
+	optsTimeProvider func() time.Time
+}
+
+// New creates a new Registry.
+func New(opts ...options.Option[Registry]) *Registry {
+	return options.Apply(&Registry{
+		Events:           NewEvents(),
+		artifacts:        make(map[Kind]map[string]*Artifact),
+		expiredCount:     make(map[Kind]*atomic.Uint64),
+		shutdown:         make(chan struct{}),
+		optsTTL:          time.Hour,
+		optsKindTTLs:     make(map[Kind]time.Duration),
+		optsInterval:     time.Minute,
+		optsTimeProvider: time.Now,
+	}, opts)
+}
+
+// Track starts tracking the artifact of the given kind and ID that is discarded by the given function once the time to
+// live elapsed. A ttl of 0 applies the time to live that is configured for the kind. Tracking an artifact that is
+// already tracked refreshes its time to live.
+func (r *Registry) Track(kind Kind, id string, ttl time.Duration, expireFunc ExpireFunc) (artifact *Artifact) {
+	if ttl == 0 {
+		ttl = r.ttl(kind)
+	}
+
+	now := r.optsTimeProvider()
+	artifact = &Artifact{
+		Kind:       kind,
+		ID:         id,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(ttl),
+		expireFunc: expireFunc,
+	}
+
+	r.mutex.Lock()
+	artifactsOfKind, exists := r.artifacts[kind]
+	if !exists {
+		artifactsOfKind = make(map[string]*Artifact)
+		r.artifacts[kind] = artifactsOfKind
+	}
+	if existingArtifact, exists := artifactsOfKind[id]; exists {
+		artifact.CreatedAt = existingArtifact.CreatedAt
+	}
+	artifactsOfKind[id] = artifact
+	r.mutex.Unlock()
+
+	r.Events.ArtifactTracked.Trigger(artifact)
+
+	return artifact
+}
+
+// Remove stops tracking the artifact of the given kind and ID without discarding it (it is used when the owner of the
+// artifact discarded it by itself).
+func (r *Registry) Remove(kind Kind, id string) (removed bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	artifactsOfKind, exists := r.artifacts[kind]
+	if !exists {
+		return false
+	}
+
+	if _, removed = artifactsOfKind[id]; removed {
+		delete(artifactsOfKind, id)
+		if len(artifactsOfKind) == 0 {
+			delete(r.artifacts, kind)
+		}
+	}
+
+	return removed
+}
+
+// Artifacts returns the tracked artifacts of the given kind (or of all kinds if kind is empty) ordered by their
+// expiration time.
+func (r *Registry) Artifacts(kind Kind) (artifacts []*Artifact) {
+	r.mutex.RLock()
+	for artifactKind, artifactsOfKind := range r.artifacts {
+		if kind != "" && artifactKind != kind {
+			continue
+		}
+
+		for _, artifact := range artifactsOfKind {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	r.mutex.RUnlock()
+
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].ExpiresAt.Before(artifacts[j].ExpiresAt)
+	})
+
+	return artifacts
+}
+
+// TrackedCount returns the number of tracked artifacts per kind.
+func (r *Registry) TrackedCount() (trackedCount map[Kind]int) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	trackedCount = make(map[Kind]int, len(r.artifacts))
+	for kind, artifactsOfKind := range r.artifacts {
+		trackedCount[kind] = len(artifactsOfKind)
+	}
+
+	return trackedCount
+}
+
+// ExpiredCount returns the total number of expired artifacts per kind.
+func (r *Registry) ExpiredCount() (expiredCount map[Kind]uint64) {
+	r.countMutex.Lock()
+	defer r.countMutex.Unlock()
+
+	expiredCount = make(map[Kind]uint64, len(r.expiredCount))
+	for kind, count := range r.expiredCount {
+		expiredCount[kind] = count.Load()
+	}
+
+	return expiredCount
+}
+
+// ExpireArtifacts discards all tracked artifacts whose time to live elapsed.
+func (r *Registry) ExpireArtifacts() {
+	now := r.optsTimeProvider()
+	expiredArtifacts := make([]*Artifact, 0)
+
+	r.mutex.Lock()
+	for kind, artifactsOfKind := range r.artifacts {
+		for id, artifact := range artifactsOfKind {
+			if now.Before(artifact.ExpiresAt) {
+				continue
+			}
+
+			delete(artifactsOfKind, id)
+			expiredArtifacts = append(expiredArtifacts, artifact)
+		}
+
+		if len(artifactsOfKind) == 0 {
+			delete(r.artifacts, kind)
+		}
+	}
+	r.mutex.Unlock()
+
+	for _, expiredArtifact := range expiredArtifacts {
+		if expiredArtifact.expireFunc != nil {
+			expiredArtifact.expireFunc()
+		}
+
+		r.expiredCounter(expiredArtifact.Kind).Inc()
+		r.Events.ArtifactExpired.Trigger(expiredArtifact)
+	}
+}
+
+// Start starts the periodic job that discards the expired artifacts.
+func (r *Registry) Start() {
+	// only start if not yet running
+	if r.running.CompareAndSwap(false, true) {
+		r.wg.Add(1)
+		go r.run()
+	}
+}
+
+// Shutdown shuts down the periodic job.
+func (r *Registry) Shutdown() {
+	if r.running.CompareAndSwap(true, false) {
+		r.shutdown <- struct{}{}
+	}
+
+	r.wg.Wait()
+}
+
+func (r *Registry) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.optsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-ticker.C:
+			r.ExpireArtifacts()
+		}
+	}
+}
+
+// ttl returns the time to live of the artifacts of the given kind.
+func (r *Registry) ttl(kind Kind) (ttl time.Duration) {
+	if ttl, exists := r.optsKindTTLs[kind]; exists {
+		return ttl
+	}
+
+	return r.optsTTL
+}
+
+// expiredCounter returns the counter of the expired artifacts of the given kind.
+func (r *Registry) expiredCounter(kind Kind) (counter *atomic.Uint64) {
+	r.countMutex.Lock()
+	defer r.countMutex.Unlock()
+
+	counter, exists := r.expiredCount[kind]
+	if !exists {
+		counter = atomic.NewUint64(0)
+		r.expiredCount[kind] = counter
+	}
+
+	return counter
+}
+
+// WithTTL sets the default time to live of the tracked artifacts.
+func WithTTL(ttl time.Duration) options.Option[Registry] {
+	return func(r *Registry) {
+		r.optsTTL = ttl
+	}
+}
+
+// WithKindTTL sets the time to live of the tracked artifacts of the given kind.
+func WithKindTTL(kind Kind, ttl time.Duration) options.Option[Registry] {
+	return func(r *Registry) {
+		r.optsKindTTLs[kind] = ttl
+	}
+}
+
+// WithInterval sets the interval in which the tracked artifacts are checked.
+func WithInterval(interval time.Duration) options.Option[Registry] {
+	return func(r *Registry) {
+		r.optsInterval = interval
+	}
+}
+
+// WithTimeProvider sets the function that is used to retrieve the current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Registry] {
+	return func(r *Registry) {
+		r.optsTimeProvider = timeProvider
+	}
+}
@@ -0,0 +1,50 @@
+package artifacts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	now := time.Now()
+	r := New(WithTTL(time.Hour), WithKindTTL(KindLock, 10*time.Second), WithTimeProvider(func() time.Time {
+		return now
+	}))
+
+	expired := make([]string, 0)
+	expireFunc := func(id string) ExpireFunc {
+		return func() {
+			expired = append(expired, id)
+		}
+	}
+
+	r.Track(KindLock, "lock1", 0, expireFunc("lock1"))
+	r.Track(KindLock, "lock2", time.Minute, expireFunc("lock2"))
+	r.Track(KindAlias, "alias1", 0, expireFunc("alias1"))
+	r.Track(KindAlias, "alias2", 0, expireFunc("alias2"))
+	require.Equal(t, map[Kind]int{KindLock: 2, KindAlias: 2}, r.TrackedCount())
+	require.Len(t, r.Artifacts(KindLock), 2)
+	require.Equal(t, "lock1", r.Artifacts("")[0].ID)
+
+	// removed artifacts are not discarded by the Registry
+	require.True(t, r.Remove(KindAlias, "alias2"))
+	require.False(t, r.Remove(KindAlias, "alias2"))
+
+	now = now.Add(10 * time.Second)
+	r.ExpireArtifacts()
+	require.Equal(t, []string{"lock1"}, expired)
+
+	// tracking an artifact again refreshes its time to live
+	createdAt := r.Artifacts(KindAlias)[0].CreatedAt
+	now = now.Add(50 * time.Minute)
+	r.Track(KindAlias, "alias1", 0, expireFunc("alias1"))
+	require.Equal(t, createdAt, r.Artifacts(KindAlias)[0].CreatedAt)
+
+	now = now.Add(30 * time.Minute)
+	r.ExpireArtifacts()
+	require.Equal(t, []string{"lock1", "lock2"}, expired)
+	require.Equal(t, map[Kind]int{KindAlias: 1}, r.TrackedCount())
+	require.Equal(t, map[Kind]uint64{KindLock: 2}, r.ExpiredCount())
+}
@@ -0,0 +1,54 @@
+package blockissuer
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+)
+
+// region Advisory /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Advisory contains the current congestion of the node and the resulting estimates of how long it takes until an
+// issued Block is scheduled and confirmed. It allows clients to set sensible timeouts instead of guessing them.
+type Advisory struct {
+	// SchedulerBacklog contains the number of Blocks in the buffer of the scheduler.
+	SchedulerBacklog int
+
+	// ReadyBlocks contains the number of Blocks in the buffer of the scheduler that are ready to be scheduled.
+	ReadyBlocks int
+
+	// IssuerQueueSize contains the number of Blocks of the node itself in the buffer of the scheduler.
+	IssuerQueueSize int
+
+	// EstimatedTimeToSchedule contains an upper bound of the time until an issued Block is scheduled (the time until the
+	// rate setter allows to issue plus the time to schedule all ready Blocks).
+	EstimatedTimeToSchedule time.Duration
+
+	// TimeToConfirmation contains the percentiles of the time between issuing and confirming the recent Blocks.
+	TimeToConfirmation *blockgadget.TimeToFinality
+
+	// SuggestedTimeout contains the time after which a client should consider an issued Block as lost (the estimated
+	// time to schedule plus the 99th percentile of the time to confirmation, zero if no Blocks were confirmed yet).
+	SuggestedTimeout time.Duration
+}
+
+// Advisory returns the current Advisory for Blocks that are issued by the BlockIssuer.
+func (i *BlockIssuer) Advisory() (advisory *Advisory) {
+	scheduler := i.protocol.CongestionControl.Scheduler()
+
+	advisory = &Advisory{
+		SchedulerBacklog:   scheduler.TotalBlocksCount(),
+		ReadyBlocks:        scheduler.ReadyBlocksCount(),
+		IssuerQueueSize:    scheduler.IssuerQueueSize(i.identity.ID()),
+		TimeToConfirmation: i.protocol.Engine().Consensus.BlockGadget().Stats().TimeToConfirmation(),
+	}
+	advisory.EstimatedTimeToSchedule = i.Estimate() + time.Duration(advisory.ReadyBlocks)*scheduler.Rate()
+
+	if advisory.TimeToConfirmation.Samples > 0 {
+		advisory.SuggestedTimeout = advisory.EstimatedTimeToSchedule + advisory.TimeToConfirmation.P99
+	}
+
+	return advisory
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,14 +2,17 @@ package blockfactory
 
 import (
 	"context"
+	"runtime"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/pow"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/crypto/identity"
@@ -33,9 +36,13 @@ type Factory struct {
 	tipSelector    TipSelector
 	referencesFunc ReferencesFunc
 	commitmentFunc CommitmentFunc
+	powWorker      *pow.Worker
 
 	optsTipSelectionTimeout       time.Duration
 	optsTipSelectionRetryInterval time.Duration
+	optsPoWDifficulty             int
+	optsWorkPolicy                models.WorkPolicy
+	optsUpgrades                  *upgrades.Schedule
 }
 
 // NewBlockFactory creates a new block factory.
@@ -48,6 +55,7 @@ func NewBlockFactory(localIdentity *identity.LocalIdentity, slotTimeProviderFunc
 		tipSelector:          tipSelector,
 		referencesFunc:       referencesFunc,
 		commitmentFunc:       commitmentFunc,
+		powWorker:            pow.New(runtime.GOMAXPROCS(0)),
 
 		optsTipSelectionTimeout:       10 * time.Second,
 		optsTipSelectionRetryInterval: 200 * time.Millisecond,
@@ -89,6 +97,10 @@ func (f *Factory) createBlockWithPayload(p payload.Payload, references models.Pa
 	}
 
 	if references.IsEmpty() {
+		if maxStrongParentsCount := f.optsUpgrades.MaxStrongParentsCount(f.slotTimeProviderFunc().IndexFromTime(time.Now()), models.MaxParentsCount); strongParentsCount > maxStrongParentsCount {
+			strongParentsCount = maxStrongParentsCount
+		}
+
 		references, err = f.tryGetReferences(p, strongParentsCount)
 		if err != nil {
 			return nil, errors.Wrap(err, "error while trying to get references")
@@ -110,6 +122,13 @@ func (f *Factory) createBlockWithPayload(p payload.Payload, references models.Pa
 		models.WithSignature(ed25519.EmptySignature), // placeholder will be set after signing
 	)
 
+	// do the PoW before signing, as the signature covers the nonce
+	if powDifficulty := f.optsUpgrades.PoWDifficulty(f.slotTimeProviderFunc().IndexFromTime(block.IssuingTime()), f.optsPoWDifficulty); powDifficulty > 0 {
+		if err = f.doPoW(block, powDifficulty); err != nil {
+			return nil, errors.Wrap(err, "PoW failed")
+		}
+	}
+
 	// create the signature
 	signature, err := f.sign(block)
 	if err != nil {
@@ -202,6 +221,22 @@ func (f *Factory) tips(p payload.Payload, parentsCount int) (parents models.Bloc
 	return parents
 }
 
+// doPoW mines the nonce of the given block so that its PoW reaches the difficulty that is required for its work.
+func (f *Factory) doPoW(block *models.Block, powDifficulty int) error {
+	powContent, err := block.PoWContent()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block content for PoW")
+	}
+
+	nonce, err := f.powWorker.Mine(context.Background(), powContent, f.optsWorkPolicy.PoWDifficulty(block, powDifficulty))
+	if err != nil {
+		return errors.Wrap(err, "failed to mine nonce")
+	}
+	block.SetNonce(nonce)
+
+	return nil
+}
+
 func (f *Factory) sign(block *models.Block) (ed25519.Signature, error) {
 	contentHash, err := block.ContentHash()
 	if err != nil {
@@ -259,4 +294,28 @@ func WithTipSelectionRetryInterval(interval time.Duration) options.Option[Factor
 	}
 }
 
+// WithPoWDifficulty sets the PoW difficulty (in leading zero bits) that is required for blocks with a single unit of
+// work (defaults to 0, which disables the PoW).
+func WithPoWDifficulty(difficulty int) options.Option[Factory] {
+	return func(factory *Factory) {
+		factory.optsPoWDifficulty = difficulty
+	}
+}
+
+// WithWorkPolicy sets the policy that is used to account the work of blocks when determining their required PoW
+// difficulty (defaults to CountWorkPolicy).
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[Factory] {
+	return func(factory *Factory) {
+		factory.optsWorkPolicy = workPolicy
+	}
+}
+
+// WithUpgrades sets the Schedule of parameter upgrades that determines the PoW difficulty and the maximum number of
+// strong parents of the created blocks (the configured values are used until the first upgrade activates).
+func WithUpgrades(schedule *upgrades.Schedule) options.Option[Factory] {
+	return func(factory *Factory) {
+		factory.optsUpgrades = schedule
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/pow"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
@@ -97,3 +98,43 @@ func TestFactory_IssuePayload(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, signatureValid)
 }
+
+func TestFactory_PoW(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+	slotTimeProvider := slot.NewTimeProvider(time.Now().Unix(), 10)
+
+	factory := NewBlockFactory(localIdentity,
+		func() *slot.TimeProvider {
+			return slotTimeProvider
+		},
+		func(blockID models.BlockID) (block *blockdag.Block, exists bool) {
+			return nil, false
+		},
+		func(countParents int) models.BlockIDs {
+			return models.NewBlockIDs(models.EmptyBlockID)
+		},
+		func(payload payload.Payload, strongParents models.BlockIDs) (references models.ParentBlockIDs, err error) {
+			return models.NewParentBlockIDs().AddAll(models.StrongParentType, strongParents), nil
+		},
+		func() (*commitment.Commitment, slot.Index, error) {
+			return commitment.New(0, commitment.ID{}, types.Identifier{}, 0), 0, nil
+		},
+		WithPoWDifficulty(4),
+		WithWorkPolicy(models.SizeWorkPolicy),
+	)
+
+	// a block with 3 units of work requires 2 additional bits of PoW
+	createdBlock, err := factory.CreateBlock(payload.NewGenericDataPayload(make([]byte, 2*models.WorkUnitSize)), 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, models.SizeWorkPolicy.Work(createdBlock))
+
+	powContent, err := createdBlock.PoWContent()
+	require.NoError(t, err)
+	leadingZeros, err := pow.New().LeadingZerosWithNonce(powContent, createdBlock.Nonce())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, leadingZeros, 6)
+
+	signatureValid, err := createdBlock.VerifySignature()
+	require.NoError(t, err)
+	require.True(t, signatureValid)
+}
@@ -54,6 +54,7 @@ type RateSetter struct {
 	optsInitialRate   float64
 	optsPause         time.Duration
 	optsSchedulerRate time.Duration
+	optsWorkPolicy    models.WorkPolicy
 }
 
 // New returns a new AIMD RateSetter.
@@ -67,11 +68,11 @@ func New(protocol *protocol.Protocol, selfIdentity identity.ID, opts ...options.
 		creditUpdateChan:      make(chan *models.Block),
 		ownRate:               atomic.NewFloat64(0),
 		issueCredits:          0.0,
-		maxCredits:            models.MaxBlockWork,
 		shutdownSignal:        make(chan struct{}),
 	}, opts, func(r *RateSetter) {
 		r.initialPauseUpdates = uint(r.optsPause / r.optsSchedulerRate)
 		r.maxRate = float64(time.Second / r.optsSchedulerRate)
+		r.maxCredits = float64(r.optsWorkPolicy.MaxWork())
 	}, func(r *RateSetter) {
 		go r.rateSetting()
 	}, (*RateSetter).setupEvents)
@@ -125,7 +126,7 @@ func (r *RateSetter) Estimate() time.Duration {
 		pauseUpdate = time.Duration(float64(r.pauseUpdates)) * r.optsSchedulerRate
 	}
 	// dummy estimate of work, replace with estimated work as argument.
-	estimatedWork := float64(models.MaxBlockWork)
+	estimatedWork := float64(r.optsWorkPolicy.MaxWork())
 	return lo.Max(time.Duration(0), pauseUpdate+time.Duration((r.getIssueCredits()-estimatedWork)/r.ownRate.Load()))
 }
 
@@ -207,4 +208,10 @@ func WithSchedulerRate(rate time.Duration) options.Option[RateSetter] {
 	}
 }
 
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[RateSetter] {
+	return func(rateSetter *RateSetter) {
+		rateSetter.optsWorkPolicy = workPolicy
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
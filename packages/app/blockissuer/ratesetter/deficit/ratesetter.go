@@ -26,6 +26,7 @@ type RateSetter struct {
 	maxRate           float64
 	initOnce          sync.Once
 	optsSchedulerRate time.Duration
+	optsWorkPolicy    models.WorkPolicy
 }
 
 func New(protocol *protocol.Protocol, selfIdentity identity.ID, opts ...options.Option[RateSetter]) *RateSetter {
@@ -61,7 +62,7 @@ func (r *RateSetter) Estimate() time.Duration {
 		return time.Duration(0)
 	} else {
 		// Note: this method needs to be updated to take the expected work of the incoming block as an argument.
-		expectedWork := models.MaxBlockWork
+		expectedWork := r.optsWorkPolicy.MaxWork()
 		return time.Duration(lo.Max(0.0, (float64(expectedWork)-r.getExcessDeficit())/r.Rate()))
 	}
 }
@@ -86,4 +87,10 @@ func WithSchedulerRate(rate time.Duration) options.Option[RateSetter] {
 	}
 }
 
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[RateSetter] {
+	return func(rateSetter *RateSetter) {
+		rateSetter.optsWorkPolicy = workPolicy
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
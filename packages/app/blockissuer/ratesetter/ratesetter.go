@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer/ratesetter/deficit"
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer/ratesetter/disabled"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/runtime/options"
 )
@@ -54,10 +55,12 @@ func New(localID identity.ID, protocol *protocol.Protocol, opts ...options.Optio
 			aimd.WithPause(rateSetterOpt.pause),
 			aimd.WithInitialRate(rateSetterOpt.initial),
 			aimd.WithSchedulerRate(rateSetterOpt.schedulerRate),
+			aimd.WithWorkPolicy(rateSetterOpt.workPolicy),
 		)
 	case DeficitMode:
 		rateSetter = deficit.New(protocol, localID,
 			deficit.WithSchedulerRate(rateSetterOpt.schedulerRate),
+			deficit.WithWorkPolicy(rateSetterOpt.workPolicy),
 		)
 	default:
 		rateSetter = disabled.New()
@@ -82,6 +85,7 @@ type Options struct {
 	pause         time.Duration
 	initial       float64
 	schedulerRate time.Duration
+	workPolicy    models.WorkPolicy
 }
 
 func WithMode(mode ModeType) options.Option[Options] {
@@ -108,4 +112,10 @@ func WithSchedulerRate(rate time.Duration) options.Option[Options] {
 	}
 }
 
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[Options] {
+	return func(o *Options) {
+		o.workPolicy = workPolicy
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,396 @@
+package confirmationfeed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+var (
+	// ErrEventsPruned is returned if a Subscription should resume after an Event that is no longer retained (the
+	// consumer has to query the current state to resynchronize).
+	ErrEventsPruned = errors.New("events pruned")
+
+	// ErrUnknownEventID is returned if a Subscription should resume after an Event that was not issued by this Feed
+	// (i.e. it was issued before the node restarted).
+	ErrUnknownEventID = errors.New("unknown event ID")
+
+	// ErrSubscriptionOverflow is returned if the consumer of a Subscription did not keep up with the confirmed
+	// transactions (the consumer has to resume from the last Event it processed).
+	ErrSubscriptionOverflow = errors.New("subscription overflowed")
+
+	// ErrSubscriptionClosed is returned if a Subscription was closed by the consumer or the Feed.
+	ErrSubscriptionClosed = errors.New("subscription closed")
+)
+
+// region Feed /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Feed retains the most recently confirmed transactions as a sequence of Events and distributes them to its
+// Subscriptions. Subscriptions can resume after the ID of the last Event they processed, as long as the following
+// Events are still retained.
+//
+// If the slot confirmation is required, accepted transactions are only confirmed once their inclusion slot is
+// confirmed, otherwise they are confirmed as soon as they are accepted.
+type Feed struct {
+	epoch          int64
+	sequence       uint64
+	events         []*Event
+	pendingEvents  map[slot.Index][]*Event
+	confirmedSlot  slot.Index
+	subscriptions  map[*Subscription]bool
+	mutex          sync.RWMutex
+	optsMaxEvents  int
+	optsMaxPending int
+
+	optsRequireSlotConfirmation bool
+	optsTimeProvider            func() time.Time
+}
+
+// New creates a new Feed.
+func New(opts ...options.Option[Feed]) *Feed {
+	return options.Apply(&Feed{
+		events:           make([]*Event, 0),
+		pendingEvents:    make(map[slot.Index][]*Event),
+		subscriptions:    make(map[*Subscription]bool),
+		optsMaxEvents:    10000,
+		optsMaxPending:   1024,
+		optsTimeProvider: time.Now,
+	}, opts, func(f *Feed) {
+		f.epoch = f.optsTimeProvider().UnixNano()
+	})
+}
+
+// TransactionAccepted processes the acceptance of the transaction with the given ID, inclusion slot and addresses.
+func (f *Feed) TransactionAccepted(transactionID utxo.TransactionID, inclusionSlot slot.Index, addresses []string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	event := &Event{
+		TransactionID: transactionID,
+		InclusionSlot: inclusionSlot,
+		Addresses:     addresses,
+	}
+
+	if f.optsRequireSlotConfirmation && inclusionSlot > f.confirmedSlot {
+		f.pendingEvents[inclusionSlot] = append(f.pendingEvents[inclusionSlot], event)
+		return
+	}
+
+	f.confirm(event)
+}
+
+// SlotConfirmed confirms the accepted transactions whose inclusion slot is not later than the given slot.
+func (f *Feed) SlotConfirmed(index slot.Index) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if index <= f.confirmedSlot {
+		return
+	}
+	f.confirmedSlot = index
+
+	confirmedSlots := make([]slot.Index, 0)
+	for inclusionSlot := range f.pendingEvents {
+		if inclusionSlot <= index {
+			confirmedSlots = append(confirmedSlots, inclusionSlot)
+		}
+	}
+	sort.Slice(confirmedSlots, func(i, j int) bool {
+		return confirmedSlots[i] < confirmedSlots[j]
+	})
+
+	for _, confirmedSlot := range confirmedSlots {
+		for _, event := range f.pendingEvents[confirmedSlot] {
+			f.confirm(event)
+		}
+		delete(f.pendingEvents, confirmedSlot)
+	}
+}
+
+// Subscribe subscribes to the confirmed transactions that concern at least one of the given addresses (all
+// transactions if no address is given). If the ID of the last processed Event is given, the Subscription starts with
+// the retained Events that followed it.
+func (f *Feed) Subscribe(lastEventID string, addresses ...string) (subscription *Subscription, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	subscription = newSubscription(f, addresses)
+
+	if lastEventID != "" {
+		lastSequence, parseErr := f.parseEventID(lastEventID)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		if len(f.events) > 0 && lastSequence+1 < f.events[0].Sequence {
+			return nil, errors.WithMessagef(ErrEventsPruned, "the oldest retained event is %s", f.events[0].ID)
+		}
+
+		for _, event := range f.events {
+			if event.Sequence > lastSequence {
+				subscription.deliver(event)
+			}
+		}
+	}
+
+	if subscription.err == nil {
+		f.subscriptions[subscription] = true
+	}
+
+	return subscription, nil
+}
+
+// SubscriptionCount returns the number of active Subscriptions.
+func (f *Feed) SubscriptionCount() (count int) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return len(f.subscriptions)
+}
+
+// Shutdown closes all Subscriptions.
+func (f *Feed) Shutdown() {
+	f.mutex.Lock()
+	subscriptions := make([]*Subscription, 0, len(f.subscriptions))
+	for subscription := range f.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	f.mutex.Unlock()
+
+	for _, subscription := range subscriptions {
+		subscription.Close()
+	}
+}
+
+// confirm assigns the next ID to the given Event, retains it and delivers it to the Subscriptions (it expects the Feed
+// to be locked).
+func (f *Feed) confirm(event *Event) {
+	f.sequence++
+	event.Sequence = f.sequence
+	event.ID = fmt.Sprintf("%d-%d", f.epoch, f.sequence)
+	event.Time = f.optsTimeProvider()
+
+	if f.events = append(f.events, event); len(f.events) > f.optsMaxEvents {
+		f.events = f.events[len(f.events)-f.optsMaxEvents:]
+	}
+
+	for subscription := range f.subscriptions {
+		subscription.deliver(event)
+	}
+}
+
+// parseEventID returns the sequence number of the Event with the given ID.
+func (f *Feed) parseEventID(eventID string) (sequence uint64, err error) {
+	epochString, sequenceString, found := strings.Cut(eventID, "-")
+	if !found {
+		return 0, errors.WithMessagef(ErrUnknownEventID, "malformed event ID '%s'", eventID)
+	}
+
+	if epoch, parseErr := strconv.ParseInt(epochString, 10, 64); parseErr != nil || epoch != f.epoch {
+		return 0, errors.WithMessagef(ErrUnknownEventID, "event %s was not issued by this node since its last restart", eventID)
+	}
+
+	if sequence, err = strconv.ParseUint(sequenceString, 10, 64); err != nil || sequence > f.sequence {
+		return 0, errors.WithMessagef(ErrUnknownEventID, "event %s was not issued yet", eventID)
+	}
+
+	return sequence, nil
+}
+
+// unregister removes the given Subscription from the Feed.
+func (f *Feed) unregister(subscription *Subscription) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.subscriptions, subscription)
+}
+
+// WithMaxEvents sets the maximum number of retained Events that Subscriptions can resume from.
+func WithMaxEvents(maxEvents int) options.Option[Feed] {
+	return func(f *Feed) {
+		f.optsMaxEvents = maxEvents
+	}
+}
+
+// WithMaxPendingEvents sets the maximum number of Events that are queued for a Subscription before it is closed with
+// ErrSubscriptionOverflow.
+func WithMaxPendingEvents(maxPendingEvents int) options.Option[Feed] {
+	return func(f *Feed) {
+		f.optsMaxPending = maxPendingEvents
+	}
+}
+
+// WithRequireSlotConfirmation sets whether accepted transactions are only confirmed once their inclusion slot is
+// confirmed.
+func WithRequireSlotConfirmation(requireSlotConfirmation bool) options.Option[Feed] {
+	return func(f *Feed) {
+		f.optsRequireSlotConfirmation = requireSlotConfirmation
+	}
+}
+
+// WithTimeProvider sets the function that provides the confirmation time of the Events and the epoch of their IDs.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Feed] {
+	return func(f *Feed) {
+		f.optsTimeProvider = timeProvider
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Subscription /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscription is the subscription to the confirmed transactions of a Feed.
+type Subscription struct {
+	feed      *Feed
+	addresses map[string]bool
+	queue     []*Event
+	err       error
+	notify    chan struct{}
+	mutex     sync.Mutex
+}
+
+// newSubscription creates a new Subscription that is filtered by the given addresses.
+func newSubscription(feed *Feed, addresses []string) *Subscription {
+	subscription := &Subscription{
+		feed:      feed,
+		addresses: make(map[string]bool),
+		notify:    make(chan struct{}, 1),
+	}
+
+	for _, address := range addresses {
+		subscription.addresses[address] = true
+	}
+
+	return subscription
+}
+
+// Next returns the next Event of the Subscription (it blocks until an Event is available, the Subscription is closed
+// or the context is canceled).
+func (s *Subscription) Next(ctx context.Context) (event *Event, err error) {
+	for {
+		s.mutex.Lock()
+		if len(s.queue) > 0 {
+			event, s.queue = s.queue[0], s.queue[1:]
+			s.mutex.Unlock()
+
+			return event, nil
+		}
+		err = s.err
+		s.mutex.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close closes the Subscription (the Events that were already queued can still be retrieved).
+func (s *Subscription) Close() {
+	s.close(ErrSubscriptionClosed)
+
+	s.feed.unregister(s)
+}
+
+// deliver queues the given Event if it matches the address filter of the Subscription (it is called while the Feed is
+// locked, so the Subscription can not unregister itself).
+func (s *Subscription) deliver(event *Event) {
+	if !s.matches(event) {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	if len(s.queue) >= s.feed.optsMaxPending {
+		s.err = ErrSubscriptionOverflow
+		s.signal()
+
+		delete(s.feed.subscriptions, s)
+		return
+	}
+
+	s.queue = append(s.queue, event)
+	s.signal()
+}
+
+// matches returns true if the given Event concerns one of the addresses of the Subscription (or if it has no
+// addresses).
+func (s *Subscription) matches(event *Event) bool {
+	if len(s.addresses) == 0 {
+		return true
+	}
+
+	for _, address := range event.Addresses {
+		if s.addresses[address] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// close sets the error that is returned after the queued Events were retrieved.
+func (s *Subscription) close(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err == nil {
+		s.err = err
+		s.signal()
+	}
+}
+
+// signal wakes up a consumer that is waiting for Events.
+func (s *Subscription) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Event ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Event is the confirmation of a transaction.
+type Event struct {
+	// ID contains the identifier of the Event that Subscriptions can resume after.
+	ID string
+
+	// Sequence contains the sequence number of the Event within its Feed.
+	Sequence uint64
+
+	// Time contains the time at which the transaction was confirmed.
+	Time time.Time
+
+	// TransactionID contains the ID of the confirmed transaction.
+	TransactionID utxo.TransactionID
+
+	// InclusionSlot contains the slot in which the transaction was included.
+	InclusionSlot slot.Index
+
+	// Addresses contains the base58 encoded addresses of the inputs and outputs of the transaction.
+	Addresses []string
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,132 @@
+package confirmationfeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/slot"
+)
+
+func TestFeed(t *testing.T) {
+	feed := New(WithTimeProvider(fixedTime))
+
+	all, err := feed.Subscribe("")
+	require.NoError(t, err)
+	filtered, err := feed.Subscribe("", "address2")
+	require.NoError(t, err)
+	require.Equal(t, 2, feed.SubscriptionCount())
+
+	feed.TransactionAccepted(transactionID(1), 1, []string{"address1"})
+	feed.TransactionAccepted(transactionID(2), 1, []string{"address1", "address2"})
+
+	assertTransactions(t, all, transactionID(1), transactionID(2))
+	assertTransactions(t, filtered, transactionID(2))
+
+	// resume after the first event
+	resumed, err := feed.Subscribe("0-1")
+	require.NoError(t, err)
+	assertTransactions(t, resumed, transactionID(2))
+
+	all.Close()
+	_, err = all.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriptionClosed)
+	require.Equal(t, 2, feed.SubscriptionCount())
+
+	feed.Shutdown()
+	require.Equal(t, 0, feed.SubscriptionCount())
+}
+
+func TestFeed_Resume(t *testing.T) {
+	feed := New(WithTimeProvider(fixedTime), WithMaxEvents(2))
+
+	for i := byte(1); i <= 3; i++ {
+		feed.TransactionAccepted(transactionID(i), 1, nil)
+	}
+
+	subscription, err := feed.Subscribe("0-1")
+	require.NoError(t, err)
+	assertTransactions(t, subscription, transactionID(2), transactionID(3))
+
+	_, err = feed.Subscribe("0-0")
+	require.ErrorIs(t, err, ErrEventsPruned)
+
+	for _, unknownEventID := range []string{"1-1", "0-4", "malformed"} {
+		_, err = feed.Subscribe(unknownEventID)
+		require.ErrorIs(t, err, ErrUnknownEventID)
+	}
+}
+
+func TestFeed_RequireSlotConfirmation(t *testing.T) {
+	feed := New(WithTimeProvider(fixedTime), WithRequireSlotConfirmation(true))
+
+	subscription, err := feed.Subscribe("")
+	require.NoError(t, err)
+
+	feed.TransactionAccepted(transactionID(1), 2, nil)
+	feed.TransactionAccepted(transactionID(2), 1, nil)
+	feed.TransactionAccepted(transactionID(3), 3, nil)
+
+	feed.SlotConfirmed(2)
+	assertTransactions(t, subscription, transactionID(2), transactionID(1))
+
+	// transactions of confirmed slots are confirmed right away
+	feed.TransactionAccepted(transactionID(4), 2, nil)
+	assertTransactions(t, subscription, transactionID(4))
+
+	feed.SlotConfirmed(3)
+	assertTransactions(t, subscription, transactionID(3))
+}
+
+func TestFeed_Overflow(t *testing.T) {
+	feed := New(WithTimeProvider(fixedTime), WithMaxPendingEvents(2))
+
+	subscription, err := feed.Subscribe("")
+	require.NoError(t, err)
+
+	for i := byte(1); i <= 3; i++ {
+		feed.TransactionAccepted(transactionID(i), 1, nil)
+	}
+	require.Equal(t, 0, feed.SubscriptionCount())
+
+	assertTransactions(t, subscription, transactionID(1), transactionID(2))
+	_, err = subscription.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriptionOverflow)
+}
+
+func TestSubscription_Next(t *testing.T) {
+	feed := New(WithTimeProvider(fixedTime))
+
+	subscription, err := feed.Subscribe("")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = subscription.Next(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go feed.TransactionAccepted(transactionID(1), slot.Index(1), []string{"address1"})
+	assertTransactions(t, subscription, transactionID(1))
+}
+
+func assertTransactions(t *testing.T, subscription *Subscription, expectedTransactionIDs ...utxo.TransactionID) {
+	for _, expectedTransactionID := range expectedTransactionIDs {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		event, err := subscription.Next(ctx)
+		cancel()
+
+		require.NoError(t, err)
+		require.Equal(t, expectedTransactionID, event.TransactionID)
+	}
+}
+
+func transactionID(index byte) utxo.TransactionID {
+	return utxo.NewTransactionID([]byte{index})
+}
+
+func fixedTime() time.Time {
+	return time.Unix(0, 0)
+}
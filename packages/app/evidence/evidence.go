@@ -0,0 +1,141 @@
+package evidence
+
+import (
+	"encoding/binary"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+)
+
+const (
+	// HeaderPublicKey contains the name of the header that announces the base58 encoded public key of the signer.
+	HeaderPublicKey = "X-Evidence-Public-Key"
+
+	// HeaderTimestamp contains the name of the header that announces the unix timestamp (in nanoseconds) of the
+	// signature.
+	HeaderTimestamp = "X-Evidence-Timestamp"
+
+	// HeaderSignature contains the name of the header that announces the base58 encoded signature of the response.
+	HeaderSignature = "X-Evidence-Signature"
+)
+
+// ErrInvalidSignature is returned if the signature of an Evidence does not match the response that it was issued for.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// region Signer ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Signer is the identity that signs the responses (i.e. the local identity of the node).
+type Signer interface {
+	// PublicKey returns the public key that verifies the signatures of the Signer.
+	PublicKey() ed25519.PublicKey
+
+	// Sign signs the given message.
+	Sign(message []byte) ed25519.Signature
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Evidence /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Evidence is a signed statement of a node that it served a response with a given body at a given time. It allows
+// downstream services to store verifiable proof of what the node reported.
+type Evidence struct {
+	// IssuerPublicKey contains the public key of the node that signed the response.
+	IssuerPublicKey ed25519.PublicKey
+
+	// Timestamp contains the time at which the response was signed.
+	Timestamp time.Time
+
+	// Signature contains the signature of the Digest of the response.
+	Signature ed25519.Signature
+}
+
+// New signs the response with the given request method, request URI (path and query), status code and body.
+func New(signer Signer, method, requestURI string, statusCode int, body []byte, timestamp time.Time) (evidence *Evidence) {
+	return &Evidence{
+		IssuerPublicKey: signer.PublicKey(),
+		Timestamp:       timestamp,
+		Signature:       signer.Sign(Digest(method, requestURI, statusCode, body, timestamp)),
+	}
+}
+
+// FromHeaders restores the Evidence that was announced in the given headers of a response.
+func FromHeaders(header http.Header) (evidence *Evidence, err error) {
+	if header.Get(HeaderSignature) == "" {
+		return nil, errors.New("response is not signed")
+	}
+
+	return Parse(header.Get(HeaderPublicKey), header.Get(HeaderSignature), header.Get(HeaderTimestamp))
+}
+
+// Parse restores an Evidence from the base58 encoded public key and signature and the unix timestamp (in nanoseconds)
+// that were announced in the headers of a response.
+func Parse(base58PublicKey, base58Signature, unixNanoTimestamp string) (evidence *Evidence, err error) {
+	publicKey, err := ed25519.PublicKeyFromString(base58PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+
+	signatureBytes, err := base58.Decode(base58Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+	signature, _, err := ed25519.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse signature")
+	}
+
+	unixNano, err := strconv.ParseInt(unixNanoTimestamp, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse timestamp")
+	}
+
+	return &Evidence{
+		IssuerPublicKey: publicKey,
+		Timestamp:       time.Unix(0, unixNano),
+		Signature:       signature,
+	}, nil
+}
+
+// WriteHeaders announces the Evidence in the given headers of a response.
+func (e *Evidence) WriteHeaders(header http.Header) {
+	header.Set(HeaderPublicKey, e.IssuerPublicKey.String())
+	header.Set(HeaderTimestamp, strconv.FormatInt(e.Timestamp.UnixNano(), 10))
+	header.Set(HeaderSignature, e.Signature.String())
+}
+
+// Verify checks if the Evidence was issued for the response with the given request method, request URI, status code
+// and body.
+func (e *Evidence) Verify(method, requestURI string, statusCode int, body []byte) (err error) {
+	if !e.IssuerPublicKey.VerifySignature(Digest(method, requestURI, statusCode, body, e.Timestamp), e.Signature) {
+		return errors.WithMessagef(ErrInvalidSignature, "%s %s", method, requestURI)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// Digest returns the hash of a response that is signed by an Evidence.
+func Digest(method, requestURI string, statusCode int, body []byte, timestamp time.Time) []byte {
+	fixedFields := make([]byte, 16)
+	binary.LittleEndian.PutUint64(fixedFields[:8], uint64(statusCode))
+	binary.LittleEndian.PutUint64(fixedFields[8:], uint64(timestamp.UnixNano()))
+
+	bodyHash := blake2b.Sum256(body)
+	requestHash := blake2b.Sum256([]byte(method + " " + requestURI))
+	digest := blake2b.Sum256(byteutils.ConcatBytes(requestHash[:], fixedFields, bodyHash[:]))
+
+	return digest[:]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
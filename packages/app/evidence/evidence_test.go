@@ -0,0 +1,38 @@
+package evidence
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestEvidence(t *testing.T) {
+	signer := identity.GenerateLocalIdentity()
+	body := []byte(`{"address":{"type":"AddressTypeED25519"}}`)
+
+	responseEvidence := New(signer, http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusOK, body, time.Now())
+	require.NoError(t, responseEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusOK, body))
+
+	header := make(http.Header)
+	responseEvidence.WriteHeaders(header)
+
+	restoredEvidence, err := FromHeaders(header)
+	require.NoError(t, err)
+	require.Equal(t, responseEvidence.IssuerPublicKey, restoredEvidence.IssuerPublicKey)
+	require.True(t, responseEvidence.Timestamp.Equal(restoredEvidence.Timestamp))
+	require.NoError(t, restoredEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusOK, body))
+
+	require.ErrorIs(t, restoredEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=20", http.StatusOK, body), ErrInvalidSignature)
+	require.ErrorIs(t, restoredEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusNotFound, body), ErrInvalidSignature)
+	require.ErrorIs(t, restoredEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusOK, []byte("{}")), ErrInvalidSignature)
+
+	restoredEvidence.Timestamp = restoredEvidence.Timestamp.Add(time.Second)
+	require.ErrorIs(t, restoredEvidence.Verify(http.MethodGet, "/api/v1/ledgerstate/addresses/abc?limit=10", http.StatusOK, body), ErrInvalidSignature)
+
+	_, err = FromHeaders(make(http.Header))
+	require.Error(t, err)
+}
@@ -0,0 +1,491 @@
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+)
+
+// region MultiSigPolicy ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// MultiSigPolicy defines the m-of-n multisig that controls the funding outputs of a faucet and the rules that a funding
+// transaction has to follow to be co-signed by the remote signers. Since every signer checks the rules independently, a
+// single compromised faucet host can not spend more than the funding amount per transaction.
+type MultiSigPolicy struct {
+	threshold        uint8
+	addresses        []devnetvm.Address
+	maxFundingAmount uint64
+}
+
+// NewMultiSigPolicy creates a new MultiSigPolicy that requires the signatures of threshold out of the given addresses
+// and that allows to send at most maxFundingAmount IOTA to a requester with a single transaction.
+func NewMultiSigPolicy(threshold uint8, maxFundingAmount uint64, addresses ...devnetvm.Address) (policy *MultiSigPolicy, err error) {
+	if maxFundingAmount == 0 {
+		return nil, errors.New("the maximum funding amount must be above zero")
+	}
+
+	// the eligible signers are validated by the output that they control
+	if _, err = devnetvm.NewThresholdSigOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 1}), threshold, addresses...); err != nil {
+		return nil, errors.Wrap(err, "invalid multisig")
+	}
+
+	return &MultiSigPolicy{
+		threshold:        threshold,
+		addresses:        addresses,
+		maxFundingAmount: maxFundingAmount,
+	}, nil
+}
+
+// Threshold returns the number of signatures that are required to spend the funding outputs.
+func (m *MultiSigPolicy) Threshold() uint8 {
+	return m.threshold
+}
+
+// Addresses returns the addresses of the eligible signers.
+func (m *MultiSigPolicy) Addresses() []devnetvm.Address {
+	return m.addresses
+}
+
+// MaxFundingAmount returns the maximum amount of IOTA that is sent to a requester with a single transaction.
+func (m *MultiSigPolicy) MaxFundingAmount() uint64 {
+	return m.maxFundingAmount
+}
+
+// FundingOutput returns a new output that holds the given IOTA balance and that is controlled by the multisig.
+func (m *MultiSigPolicy) FundingOutput(balance uint64) *devnetvm.ThresholdSigOutput {
+	return lo.PanicOnErr(devnetvm.NewThresholdSigOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: balance}), m.threshold, m.addresses...))
+}
+
+// IsFundingOutput returns true if the given output is controlled by the multisig.
+func (m *MultiSigPolicy) IsFundingOutput(output devnetvm.Output) bool {
+	thresholdSigOutput, isThresholdSigOutput := output.(*devnetvm.ThresholdSigOutput)
+	if !isThresholdSigOutput || thresholdSigOutput.Threshold() != m.threshold || len(thresholdSigOutput.Addresses()) != len(m.addresses) {
+		return false
+	}
+
+	for _, address := range thresholdSigOutput.Addresses() {
+		if !m.IsEligibleSigner(address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsEligibleSigner returns true if the given address belongs to one of the eligible signers of the multisig.
+func (m *MultiSigPolicy) IsEligibleSigner(address devnetvm.Address) bool {
+	for _, eligibleAddress := range m.addresses {
+		if eligibleAddress.Equals(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FundingTransactionEssence creates the essence of a transaction that sends the given amount from the given funding
+// outputs to the destination and that returns the remainder to the multisig.
+func (m *MultiSigPolicy) FundingTransactionEssence(fundingOutputs devnetvm.Outputs, destination devnetvm.Address, amount uint64, accessPledgeID, consensusPledgeID identity.ID) (essence *devnetvm.TransactionEssence, err error) {
+	if amount == 0 || amount > m.maxFundingAmount {
+		return nil, errors.Errorf("funding amount %d must be between 1 and %d", amount, m.maxFundingAmount)
+	}
+
+	var availableBalance uint64
+	inputs := make([]devnetvm.Input, 0, len(fundingOutputs))
+	for _, fundingOutput := range fundingOutputs {
+		if !m.IsFundingOutput(fundingOutput) {
+			return nil, errors.Errorf("output %s is not controlled by the multisig", fundingOutput.ID())
+		}
+
+		balance, _ := fundingOutput.Balances().Get(devnetvm.ColorIOTA)
+		availableBalance += balance
+		inputs = append(inputs, fundingOutput.Input())
+	}
+	if availableBalance < amount {
+		return nil, errors.Errorf("funding outputs hold %d IOTA which is not enough to send %d IOTA", availableBalance, amount)
+	}
+
+	outputs := []devnetvm.Output{devnetvm.NewSigLockedSingleOutput(amount, destination)}
+	if remainder := availableBalance - amount; remainder > 0 {
+		outputs = append(outputs, m.FundingOutput(remainder))
+	}
+
+	return devnetvm.NewTransactionEssence(0, time.Now(), accessPledgeID, consensusPledgeID, devnetvm.NewInputs(inputs...), devnetvm.NewOutputs(outputs...)), nil
+}
+
+// ValidateFundingTransaction checks if the transaction with the given essence (that spends the given inputs) follows
+// the rules of the multisig. The inputs have to be funding outputs that only hold IOTA, and apart from the remainder
+// that is returned to the multisig, the transaction may only send up to the maximum funding amount to a single
+// address that is not controlled by the multisig.
+func (m *MultiSigPolicy) ValidateFundingTransaction(essence *devnetvm.TransactionEssence, inputs devnetvm.Outputs) (err error) {
+	_, err = m.validateFundingTransaction(essence, inputs)
+
+	return err
+}
+
+// ValidateCoSignRequest checks if the given CoSignRequest can be co-signed by the given signer of the multisig. The
+// request has to be signed by another eligible signer of the multisig and its funding transaction has to follow the
+// rules of the multisig and fund the address of the contained faucet request.
+func (m *MultiSigPolicy) ValidateCoSignRequest(request *CoSignRequest, inputs devnetvm.Outputs, coSignerAddress devnetvm.Address) (err error) {
+	requester, err := m.requester(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to authenticate requester")
+	} else if requester.Equals(coSignerAddress) {
+		return errors.New("co-sign request is signed by the co-signer itself")
+	}
+
+	fundedAddress, err := m.validateFundingTransaction(request.Essence(), inputs)
+	if err != nil {
+		return errors.Wrap(err, "invalid funding transaction")
+	} else if fundedAddress == nil || !fundedAddress.Equals(request.FaucetRequest().Address()) {
+		return errors.Errorf("funding transaction does not fund the address %s of the faucet request", request.FaucetRequest().Address().Base58())
+	}
+
+	return nil
+}
+
+// requester returns the eligible signer of the multisig that signed the given CoSignRequest.
+func (m *MultiSigPolicy) requester(request *CoSignRequest) (requester devnetvm.Address, err error) {
+	message, err := coSignRequestMessage(request.Essence(), request.FaucetRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range m.addresses {
+		if request.Signature().AddressSignatureValid(address, message) {
+			return address, nil
+		}
+	}
+
+	return nil, errors.New("co-sign request is not signed by an eligible signer of the multisig")
+}
+
+// validateFundingTransaction checks if the transaction with the given essence follows the rules of the multisig and
+// returns the address that is funded by it (nil if the transaction only returns funds to the multisig).
+func (m *MultiSigPolicy) validateFundingTransaction(essence *devnetvm.TransactionEssence, inputs devnetvm.Outputs) (fundedAddress devnetvm.Address, err error) {
+	if len(inputs) != len(essence.Inputs()) {
+		return nil, errors.Errorf("expected %d inputs but got %d", len(essence.Inputs()), len(inputs))
+	}
+
+	for _, input := range inputs {
+		if !m.IsFundingOutput(input) {
+			return nil, errors.Errorf("input %s is not controlled by the multisig", input.ID())
+		}
+		if input.Balances().Size() != 1 {
+			return nil, errors.Errorf("input %s holds colored tokens", input.ID())
+		}
+	}
+
+	var fundedAmount uint64
+	for i, output := range essence.Outputs() {
+		if output.Balances().Size() != 1 {
+			return nil, errors.Errorf("output %d holds colored tokens", i)
+		}
+
+		if m.IsFundingOutput(output) {
+			continue
+		}
+
+		if output.Type() != devnetvm.SigLockedSingleOutputType {
+			return nil, errors.Errorf("output %d is an unsupported %s", i, output.Type())
+		}
+		if fundedAddress != nil {
+			return nil, errors.New("transaction funds more than one address")
+		}
+
+		fundedAddress = output.Address()
+		fundedAmount, _ = output.Balances().Get(devnetvm.ColorIOTA)
+	}
+
+	if fundedAmount > m.maxFundingAmount {
+		return nil, errors.Errorf("funded amount %d exceeds the maximum of %d", fundedAmount, m.maxFundingAmount)
+	}
+
+	return fundedAddress, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CoSignRequest ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CoSignRequest is the request of a faucet to co-sign the essence of a funding transaction. It contains the faucet
+// request that is fulfilled by the transaction (as issued by the requester, so that its PoW can be verified) and it is
+// signed by the requesting faucet with the key of its eligible signer address.
+type CoSignRequest struct {
+	essence       *devnetvm.TransactionEssence
+	faucetRequest *Payload
+	signature     devnetvm.Signature
+}
+
+// NewCoSignRequest creates a new CoSignRequest from the given essence, faucet request and signature of the requester.
+func NewCoSignRequest(essence *devnetvm.TransactionEssence, faucetRequest *Payload, signature devnetvm.Signature) *CoSignRequest {
+	return &CoSignRequest{
+		essence:       essence,
+		faucetRequest: faucetRequest,
+		signature:     signature,
+	}
+}
+
+// SignCoSignRequest creates a new CoSignRequest for the given essence and faucet request that is signed with the given
+// key pair.
+func SignCoSignRequest(essence *devnetvm.TransactionEssence, faucetRequest *Payload, keyPair ed25519.KeyPair) (request *CoSignRequest, err error) {
+	message, err := coSignRequestMessage(essence, faucetRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCoSignRequest(essence, faucetRequest, devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(message))), nil
+}
+
+// Essence returns the essence of the funding transaction that should be co-signed.
+func (c *CoSignRequest) Essence() *devnetvm.TransactionEssence {
+	return c.essence
+}
+
+// FaucetRequest returns the faucet request that is fulfilled by the funding transaction.
+func (c *CoSignRequest) FaucetRequest() *Payload {
+	return c.faucetRequest
+}
+
+// Signature returns the signature of the requesting faucet.
+func (c *CoSignRequest) Signature() devnetvm.Signature {
+	return c.signature
+}
+
+// coSignRequestMessage returns the message that is signed by the requesting faucet.
+func coSignRequestMessage(essence *devnetvm.TransactionEssence, faucetRequest *Payload) (message []byte, err error) {
+	essenceBytes, err := essence.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize essence")
+	}
+
+	faucetRequestBytes, err := faucetRequest.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize faucet request")
+	}
+
+	return byteutils.ConcatBytes(essenceBytes, faucetRequestBytes), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CoSignRecords ////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// coSignedInputPrefix is the storage prefix of the inputs of the co-signed funding transactions.
+	coSignedInputPrefix byte = iota
+
+	// coSignedFaucetRequestPrefix is the storage prefix of the faucet requests of the co-signed funding transactions.
+	coSignedFaucetRequestPrefix
+)
+
+// CoSignRecords persists the inputs and the faucet requests of the co-signed funding transactions, so that a co-signer
+// never signs two different transactions that spend the same input or fulfill the same faucet request (also not across
+// restarts).
+type CoSignRecords struct {
+	store kvstore.KVStore
+	mutex sync.Mutex
+}
+
+// NewCoSignRecords creates a new CoSignRecords instance that persists the records in the given store.
+func NewCoSignRecords(store kvstore.KVStore) *CoSignRecords {
+	return &CoSignRecords{
+		store: store,
+	}
+}
+
+// Register records the inputs and the faucet request of the given CoSignRequest. It returns an error if one of them
+// was already co-signed as part of a different transaction (registering the same transaction again is allowed, so that
+// the requesting faucet can retry).
+func (c *CoSignRecords) Register(request *CoSignRequest) (err error) {
+	essenceBytes, err := request.Essence().Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize essence")
+	}
+	faucetRequestBytes, err := request.FaucetRequest().Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize faucet request")
+	}
+
+	essenceHash := blake2b.Sum256(essenceBytes)
+	faucetRequestHash := blake2b.Sum256(faucetRequestBytes)
+	keys := []kvstore.Key{byteutils.ConcatBytes([]byte{coSignedFaucetRequestPrefix}, faucetRequestHash[:])}
+	for _, input := range request.Essence().Inputs() {
+		inputBytes, inputErr := input.Bytes()
+		if inputErr != nil {
+			return errors.Wrap(inputErr, "failed to serialize input")
+		}
+
+		keys = append(keys, byteutils.ConcatBytes([]byte{coSignedInputPrefix}, inputBytes))
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, key := range keys {
+		essenceHashOfRecord, getErr := c.store.Get(key)
+		if getErr != nil {
+			if errors.Is(getErr, kvstore.ErrKeyNotFound) {
+				continue
+			}
+
+			return errors.Wrap(getErr, "failed to read co-sign record")
+		}
+
+		if !bytes.Equal(essenceHashOfRecord, essenceHash[:]) {
+			return errors.New("input or faucet request was already co-signed as part of a different transaction")
+		}
+	}
+
+	for _, key := range keys {
+		if err = c.store.Set(key, essenceHash[:]); err != nil {
+			return errors.Wrap(err, "failed to store co-sign record")
+		}
+	}
+
+	return c.store.Flush()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CoSigner /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CoSigner is a (remote) signer of the multisig that controls the funding outputs of a faucet.
+type CoSigner interface {
+	// CoSign returns the signature of the signer for the essence of the funding transaction of the given request.
+	CoSign(ctx context.Context, request *CoSignRequest) (signature devnetvm.Signature, err error)
+}
+
+// CollectSignatures requests the signatures for the essence of the given CoSignRequest from the co-signers (in
+// parallel) until the threshold of the multisig is reached. The local signature (if not nil) counts towards the
+// threshold.
+func CollectSignatures(ctx context.Context, policy *MultiSigPolicy, request *CoSignRequest, localSignature devnetvm.Signature, coSigners ...CoSigner) (unlockBlock *devnetvm.ThresholdSignatureUnlockBlock, err error) {
+	essenceBytes, err := request.Essence().Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize essence")
+	}
+
+	collector := newSignatureCollector(policy, essenceBytes)
+	if localSignature != nil {
+		if err = collector.add(localSignature); err != nil {
+			return nil, errors.Wrap(err, "invalid local signature")
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan *coSignResult, len(coSigners))
+	for _, coSigner := range coSigners {
+		go func(coSigner CoSigner) {
+			signature, coSignErr := coSigner.CoSign(ctx, request)
+			results <- &coSignResult{signature: signature, err: coSignErr}
+		}(coSigner)
+	}
+
+	var failures []error
+	for pending := len(coSigners); !collector.complete() && pending > 0; pending-- {
+		select {
+		case result := <-results:
+			if result.err != nil {
+				failures = append(failures, result.err)
+			} else if addErr := collector.add(result.signature); addErr != nil {
+				failures = append(failures, addErr)
+			}
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "failed to collect signatures")
+		}
+	}
+
+	if !collector.complete() {
+		return nil, errors.Errorf("collected %d of %d required signatures: %v", len(collector.signatures), policy.Threshold(), failures)
+	}
+
+	return devnetvm.NewThresholdSignatureUnlockBlock(collector.signatures...), nil
+}
+
+// coSignResult contains the response of a CoSigner.
+type coSignResult struct {
+	signature devnetvm.Signature
+	err       error
+}
+
+// signatureCollector collects the valid signatures of distinct eligible signers.
+type signatureCollector struct {
+	policy       *MultiSigPolicy
+	essenceBytes []byte
+	signed       []bool
+	signatures   []devnetvm.Signature
+}
+
+// newSignatureCollector creates a new signatureCollector for the given essence.
+func newSignatureCollector(policy *MultiSigPolicy, essenceBytes []byte) *signatureCollector {
+	return &signatureCollector{
+		policy:       policy,
+		essenceBytes: essenceBytes,
+		signed:       make([]bool, len(policy.Addresses())),
+		signatures:   make([]devnetvm.Signature, 0, policy.Threshold()),
+	}
+}
+
+// add adds the given signature if it is valid and belongs to an eligible signer that did not sign, yet.
+func (s *signatureCollector) add(signature devnetvm.Signature) error {
+	for i, address := range s.policy.Addresses() {
+		if !s.signed[i] && signature.AddressSignatureValid(address, s.essenceBytes) {
+			s.signed[i] = true
+			s.signatures = append(s.signatures, signature)
+
+			return nil
+		}
+	}
+
+	return errors.New("signature does not belong to a remaining eligible signer")
+}
+
+// complete returns true if the threshold of the multisig is reached.
+func (s *signatureCollector) complete() bool {
+	return len(s.signatures) >= int(s.policy.Threshold())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utils ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SelectFundingOutputs returns the funding outputs (ordered by their IDs) that are required to send the given amount.
+func SelectFundingOutputs(fundingOutputs devnetvm.Outputs, amount uint64) (selectedOutputs devnetvm.Outputs, err error) {
+	sortedOutputs := lo.CopySlice(fundingOutputs)
+	sort.Slice(sortedOutputs, func(i, j int) bool {
+		return bytes.Compare(lo.PanicOnErr(sortedOutputs[i].ID().Bytes()), lo.PanicOnErr(sortedOutputs[j].ID().Bytes())) < 0
+	})
+
+	var selectedBalance uint64
+	for _, output := range sortedOutputs {
+		if selectedBalance >= amount {
+			break
+		}
+
+		balance, _ := output.Balances().Get(devnetvm.ColorIOTA)
+		selectedBalance += balance
+		selectedOutputs = append(selectedOutputs, output)
+	}
+
+	if selectedBalance < amount {
+		return nil, errors.Errorf("funding outputs hold %d IOTA which is not enough to send %d IOTA", selectedBalance, amount)
+	}
+
+	return selectedOutputs, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,203 @@
+package faucet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestMultiSigPolicy(t *testing.T) {
+	keyPairs, policy := newTestMultiSigPolicy(t, 2, 3)
+	requester := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	_, err := NewMultiSigPolicy(4, 1000, policy.Addresses()...)
+	require.Error(t, err)
+
+	fundingOutputs := devnetvm.NewOutputs(newTestFundingOutput(policy, 600, 0), newTestFundingOutput(policy, 800, 1))
+	require.True(t, policy.IsFundingOutput(fundingOutputs[0]))
+	require.False(t, policy.IsFundingOutput(devnetvm.NewSigLockedSingleOutput(600, requester)))
+	require.False(t, policy.IsFundingOutput(lo.PanicOnErr(devnetvm.NewThresholdSigOutput(fundingOutputs[0].Balances(), 1, policy.Addresses()...))))
+
+	selectedOutputs, err := SelectFundingOutputs(fundingOutputs, 1000)
+	require.NoError(t, err)
+	require.Len(t, selectedOutputs, 2)
+
+	_, err = SelectFundingOutputs(fundingOutputs, 2000)
+	require.Error(t, err)
+
+	essence, err := policy.FundingTransactionEssence(selectedOutputs, requester, 1000, identity.ID{}, identity.ID{})
+	require.NoError(t, err)
+	require.Len(t, essence.Outputs(), 2)
+	require.NoError(t, policy.ValidateFundingTransaction(essence, selectedOutputs))
+
+	_, err = policy.FundingTransactionEssence(selectedOutputs, requester, 1001, identity.ID{}, identity.ID{})
+	require.Error(t, err)
+
+	t.Run("CASE: funding exceeds the maximum", func(t *testing.T) {
+		drainingEssence := devnetvm.NewTransactionEssence(0, essence.Timestamp(), identity.ID{}, identity.ID{}, essence.Inputs(), devnetvm.NewOutputs(
+			devnetvm.NewSigLockedSingleOutput(1400, requester),
+		))
+		assert.Error(t, policy.ValidateFundingTransaction(drainingEssence, selectedOutputs))
+	})
+
+	t.Run("CASE: funding of several addresses", func(t *testing.T) {
+		splittingEssence := devnetvm.NewTransactionEssence(0, essence.Timestamp(), identity.ID{}, identity.ID{}, essence.Inputs(), devnetvm.NewOutputs(
+			devnetvm.NewSigLockedSingleOutput(700, requester),
+			devnetvm.NewSigLockedSingleOutput(700, devnetvm.NewED25519Address(keyPairs[0].PublicKey)),
+		))
+		assert.Error(t, policy.ValidateFundingTransaction(splittingEssence, selectedOutputs))
+	})
+
+	t.Run("CASE: inputs not controlled by the multisig", func(t *testing.T) {
+		foreignOutput := devnetvm.NewSigLockedSingleOutput(600, requester)
+		foreignOutput.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{1}), 2))
+		assert.Error(t, policy.ValidateFundingTransaction(essence, devnetvm.NewOutputs(selectedOutputs[0], foreignOutput)))
+	})
+}
+
+func TestCoSignRequest(t *testing.T) {
+	keyPairs, policy := newTestMultiSigPolicy(t, 2, 3)
+	coSigner := policy.Addresses()[1]
+	requester := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	faucetRequest := NewRequest(requester, identity.ID{}, identity.ID{}, 0)
+
+	fundingOutputs := devnetvm.NewOutputs(newTestFundingOutput(policy, 1500, 0))
+	essence := lo.PanicOnErr(policy.FundingTransactionEssence(fundingOutputs, requester, 1000, identity.ID{}, identity.ID{}))
+
+	request, err := SignCoSignRequest(essence, faucetRequest, keyPairs[0])
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateCoSignRequest(request, fundingOutputs, coSigner))
+
+	t.Run("CASE: requester is not an eligible signer", func(t *testing.T) {
+		foreignRequest, err := SignCoSignRequest(essence, faucetRequest, ed25519.GenerateKeyPair())
+		require.NoError(t, err)
+		assert.Error(t, policy.ValidateCoSignRequest(foreignRequest, fundingOutputs, coSigner))
+	})
+
+	t.Run("CASE: request signed by the co-signer itself", func(t *testing.T) {
+		selfSignedRequest, err := SignCoSignRequest(essence, faucetRequest, keyPairs[1])
+		require.NoError(t, err)
+		assert.Error(t, policy.ValidateCoSignRequest(selfSignedRequest, fundingOutputs, coSigner))
+	})
+
+	t.Run("CASE: signature does not cover the faucet request", func(t *testing.T) {
+		otherFaucetRequest := NewRequest(requester, identity.ID{}, identity.ID{}, 1)
+		assert.Error(t, policy.ValidateCoSignRequest(NewCoSignRequest(essence, otherFaucetRequest, request.Signature()), fundingOutputs, coSigner))
+	})
+
+	t.Run("CASE: transaction funds another address", func(t *testing.T) {
+		otherFaucetRequest := NewRequest(devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey), identity.ID{}, identity.ID{}, 0)
+		unboundRequest, err := SignCoSignRequest(essence, otherFaucetRequest, keyPairs[0])
+		require.NoError(t, err)
+		assert.Error(t, policy.ValidateCoSignRequest(unboundRequest, fundingOutputs, coSigner))
+	})
+}
+
+func TestCoSignRecords(t *testing.T) {
+	keyPairs, policy := newTestMultiSigPolicy(t, 2, 3)
+	requester := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	store := mapdb.NewMapDB()
+
+	fundingOutputs := devnetvm.Outputs{newTestFundingOutput(policy, 1500, 0), newTestFundingOutput(policy, 1600, 1)}
+	newRequest := func(fundingOutput devnetvm.Output, nonce uint64) *CoSignRequest {
+		essence := lo.PanicOnErr(policy.FundingTransactionEssence(devnetvm.NewOutputs(fundingOutput), requester, 1000, identity.ID{}, identity.ID{}))
+		return lo.PanicOnErr(SignCoSignRequest(essence, NewRequest(requester, identity.ID{}, identity.ID{}, nonce), keyPairs[0]))
+	}
+
+	request := newRequest(fundingOutputs[0], 0)
+	require.NoError(t, NewCoSignRecords(store).Register(request))
+
+	// the records are persisted and retries of the same transaction are allowed
+	records := NewCoSignRecords(store)
+	require.NoError(t, records.Register(request))
+
+	t.Run("CASE: input was already co-signed", func(t *testing.T) {
+		assert.Error(t, records.Register(newRequest(fundingOutputs[0], 1)))
+	})
+
+	t.Run("CASE: faucet request was already co-signed", func(t *testing.T) {
+		assert.Error(t, records.Register(newRequest(fundingOutputs[1], 0)))
+	})
+
+	require.NoError(t, records.Register(newRequest(fundingOutputs[1], 2)))
+}
+
+func TestCollectSignatures(t *testing.T) {
+	keyPairs, policy := newTestMultiSigPolicy(t, 3, 4)
+	fundingOutput := newTestFundingOutput(policy, 1000, 0)
+	requester := devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	essence := lo.PanicOnErr(policy.FundingTransactionEssence(devnetvm.NewOutputs(fundingOutput), requester, 1000, identity.ID{}, identity.ID{}))
+	request := lo.PanicOnErr(SignCoSignRequest(essence, NewRequest(requester, identity.ID{}, identity.ID{}, 0), keyPairs[0]))
+	localSignature := testSignature(keyPairs[0], essence)
+
+	t.Run("CASE: threshold reached", func(t *testing.T) {
+		unlockBlock, err := CollectSignatures(context.Background(), policy, request, localSignature,
+			&testCoSigner{keyPair: keyPairs[1]},
+			&testCoSigner{err: errors.New("unreachable")},
+			&testCoSigner{keyPair: keyPairs[3]},
+		)
+		require.NoError(t, err)
+		require.Len(t, unlockBlock.Signatures(), 3)
+
+		tx := devnetvm.NewTransaction(essence, devnetvm.UnlockBlocks{unlockBlock})
+		unlockValid, err := fundingOutput.UnlockValid(tx, unlockBlock, devnetvm.NewOutputs(fundingOutput))
+		require.NoError(t, err)
+		require.True(t, unlockValid)
+	})
+
+	t.Run("CASE: duplicate and foreign signatures", func(t *testing.T) {
+		_, err := CollectSignatures(context.Background(), policy, request, localSignature,
+			&testCoSigner{keyPair: keyPairs[0]},
+			&testCoSigner{keyPair: ed25519.GenerateKeyPair()},
+			&testCoSigner{keyPair: keyPairs[2]},
+		)
+		require.Error(t, err)
+	})
+}
+
+func newTestMultiSigPolicy(t *testing.T, threshold uint8, signerCount int) (keyPairs []ed25519.KeyPair, policy *MultiSigPolicy) {
+	addresses := make([]devnetvm.Address, signerCount)
+	for i := range addresses {
+		keyPairs = append(keyPairs, ed25519.GenerateKeyPair())
+		addresses[i] = devnetvm.NewED25519Address(keyPairs[i].PublicKey)
+	}
+
+	policy, err := NewMultiSigPolicy(threshold, 1000, addresses...)
+	require.NoError(t, err)
+
+	return keyPairs, policy
+}
+
+func newTestFundingOutput(policy *MultiSigPolicy, balance uint64, index uint16) devnetvm.Output {
+	output := policy.FundingOutput(balance)
+	output.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{1}), index))
+
+	return output
+}
+
+func testSignature(keyPair ed25519.KeyPair, essence *devnetvm.TransactionEssence) devnetvm.Signature {
+	return devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(lo.PanicOnErr(essence.Bytes())))
+}
+
+type testCoSigner struct {
+	keyPair ed25519.KeyPair
+	err     error
+}
+
+func (t *testCoSigner) CoSign(_ context.Context, request *CoSignRequest) (devnetvm.Signature, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	return testSignature(t.keyPair, request.Essence()), nil
+}
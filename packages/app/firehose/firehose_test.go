@@ -0,0 +1,93 @@
+package firehose
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestSink_Rotation(t *testing.T) {
+	now := time.Now()
+	sink := New(t.TempDir(), WithMaxFileSize(200), WithRotationInterval(time.Minute), WithMaxFiles(2), WithTimeProvider(func() time.Time {
+		return now
+	}))
+
+	record := &Record{Type: RecordTypeTransactionBooked, Time: now, TransactionID: "transaction"}
+	require.NoError(t, sink.Write(record, record))
+
+	files, err := sink.Files()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Len(t, readRecords(t, files[0]), 2)
+
+	// the file is rotated once it would exceed its maximum size
+	require.NoError(t, sink.Write(record))
+	files, err = sink.Files()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Len(t, readRecords(t, files[1]), 1)
+
+	// the file is rotated once it exceeds its maximum age and only the newest files are retained
+	now = now.Add(time.Minute)
+	require.NoError(t, sink.Write(record))
+	rotatedFiles, err := sink.Files()
+	require.NoError(t, err)
+	require.Len(t, rotatedFiles, 2)
+	require.Equal(t, files[1], rotatedFiles[0])
+
+	require.NoError(t, sink.Close())
+	require.NoError(t, sink.Close())
+}
+
+func TestTransactionAcceptedRecords(t *testing.T) {
+	var transactionID utxo.TransactionID
+	transactionID.Identifier[0] = 1
+
+	createdOutput := devnetvm.NewSigLockedSingleOutput(100, devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+	createdOutput.SetID(utxo.NewOutputID(transactionID, 0))
+
+	var spentOutputID utxo.OutputID
+	spentOutputID.TransactionID.Identifier[0] = 2
+	spentOutput := devnetvm.NewSigLockedSingleOutput(100, devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+	spentOutput.SetID(spentOutputID)
+
+	now := time.Now()
+	records := TransactionAcceptedRecords(&mempool.TransactionEvent{
+		Metadata:       mempool.NewTransactionMetadata(transactionID),
+		CreatedOutputs: []*mempool.OutputWithMetadata{mempool.NewOutputWithMetadata(1, createdOutput.ID(), createdOutput, identity.ID{}, identity.ID{})},
+		SpentOutputs:   []*mempool.OutputWithMetadata{mempool.NewOutputWithMetadata(1, spentOutput.ID(), spentOutput, identity.ID{}, identity.ID{})},
+	}, now)
+
+	require.Len(t, records, 3)
+	require.Equal(t, RecordTypeTransactionAccepted, records[0].Type)
+	require.Equal(t, []string{createdOutput.ID().Base58()}, records[0].OutputIDs)
+	require.Equal(t, []string{spentOutput.ID().Base58()}, records[0].SpentOutputIDs)
+	require.Equal(t, RecordTypeOutputCreated, records[1].Type)
+	require.Equal(t, createdOutput.ID().Base58(), records[1].Output.OutputID.Base58)
+	require.Equal(t, RecordTypeOutputSpent, records[2].Type)
+	require.Equal(t, transactionID.Base58(), records[2].TransactionID)
+}
+
+func readRecords(t *testing.T, path string) (records []*Record) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		record := new(Record)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -0,0 +1,102 @@
+package firehose
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+const (
+	// RecordTypeTransactionBooked is the type of the Records of booked transactions.
+	RecordTypeTransactionBooked = "transactionBooked"
+
+	// RecordTypeTransactionAccepted is the type of the Records of accepted transactions.
+	RecordTypeTransactionAccepted = "transactionAccepted"
+
+	// RecordTypeOutputCreated is the type of the Records of the outputs that were created by an accepted transaction.
+	RecordTypeOutputCreated = "outputCreated"
+
+	// RecordTypeOutputSpent is the type of the Records of the outputs that were spent by an accepted transaction.
+	RecordTypeOutputSpent = "outputSpent"
+)
+
+// region Record ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Record is the JSON model of an entry of the firehose.
+type Record struct {
+	Type           string             `json:"type"`
+	Time           time.Time          `json:"time"`
+	TransactionID  string             `json:"transactionID"`
+	InclusionSlot  int64              `json:"inclusionSlot,omitempty"`
+	OutputIDs      []string           `json:"outputIDs,omitempty"`
+	SpentOutputIDs []string           `json:"spentOutputIDs,omitempty"`
+	Output         *jsonmodels.Output `json:"output,omitempty"`
+}
+
+// TransactionBookedRecords returns the Records of the given TransactionBooked event.
+func TransactionBookedRecords(event *mempool.TransactionBookedEvent, now time.Time) (records []*Record) {
+	outputIDs := make([]string, 0)
+	if event.Outputs != nil {
+		for it := event.Outputs.IDs().Iterator(); it.HasNext(); {
+			outputIDs = append(outputIDs, it.Next().Base58())
+		}
+	}
+
+	return []*Record{{
+		Type:          RecordTypeTransactionBooked,
+		Time:          now,
+		TransactionID: event.TransactionID.Base58(),
+		OutputIDs:     outputIDs,
+	}}
+}
+
+// TransactionAcceptedRecords returns the Records of the given TransactionAccepted event (the accepted transaction and
+// the outputs that it created and spent).
+func TransactionAcceptedRecords(event *mempool.TransactionEvent, now time.Time) (records []*Record) {
+	transactionID := event.Metadata.ID().Base58()
+	inclusionSlot := int64(event.Metadata.InclusionSlot())
+
+	transactionRecord := &Record{
+		Type:           RecordTypeTransactionAccepted,
+		Time:           now,
+		TransactionID:  transactionID,
+		InclusionSlot:  inclusionSlot,
+		OutputIDs:      make([]string, 0, len(event.CreatedOutputs)),
+		SpentOutputIDs: make([]string, 0, len(event.SpentOutputs)),
+	}
+	records = append(records, transactionRecord)
+
+	for _, createdOutput := range event.CreatedOutputs {
+		transactionRecord.OutputIDs = append(transactionRecord.OutputIDs, createdOutput.Output().ID().Base58())
+		records = append(records, outputRecord(RecordTypeOutputCreated, now, transactionID, inclusionSlot, createdOutput.Output()))
+	}
+
+	for _, spentOutput := range event.SpentOutputs {
+		transactionRecord.SpentOutputIDs = append(transactionRecord.SpentOutputIDs, spentOutput.Output().ID().Base58())
+		records = append(records, outputRecord(RecordTypeOutputSpent, now, transactionID, inclusionSlot, spentOutput.Output()))
+	}
+
+	return records
+}
+
+// outputRecord returns the Record of an output that was created or spent by the given transaction.
+func outputRecord(recordType string, now time.Time, transactionID string, inclusionSlot int64, output utxo.Output) (record *Record) {
+	record = &Record{
+		Type:          recordType,
+		Time:          now,
+		TransactionID: transactionID,
+		InclusionSlot: inclusionSlot,
+		OutputIDs:     []string{output.ID().Base58()},
+	}
+
+	if devnetOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+		record.Output = jsonmodels.NewOutput(devnetOutput)
+	}
+
+	return record
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,209 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+const (
+	// filePrefix is the prefix of the names of the files that are written by a Sink.
+	filePrefix = "firehose-"
+
+	// fileExtension is the extension of the files that are written by a Sink.
+	fileExtension = ".ndjson"
+)
+
+// region Sink /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Sink appends Records as newline delimited JSON to files in a directory. The current file is rotated once it exceeds
+// the configured size or age, so that downstream consumers can tail the current file and process the finished ones.
+type Sink struct {
+	directory    string
+	file         *os.File
+	fileSize     int64
+	fileOpened   time.Time
+	filesCreated int
+	mutex        sync.Mutex
+
+	optsMaxFileSize      int64
+	optsRotationInterval time.Duration
+	optsMaxFiles         int
+	optsTimeProvider     func() time.Time
+}
+
+// New creates a new Sink that writes its files to the given directory.
+func New(directory string, opts ...options.Option[Sink]) *Sink {
+	return options.Apply(&Sink{
+		directory:        directory,
+		optsMaxFileSize:  100 << 20,
+		optsTimeProvider: time.Now,
+	}, opts)
+}
+
+// Write appends the given Records to the current file (records are written in a single write, so that a tailing consumer
+// never sees a partial batch).
+func (s *Sink) Write(records ...*Record) (err error) {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var data []byte
+	for _, record := range records {
+		encodedRecord, marshalErr := json.Marshal(record)
+		if marshalErr != nil {
+			return errors.Wrapf(marshalErr, "failed to marshal %s record", record.Type)
+		}
+		data = append(append(data, encodedRecord...), '\n')
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err = s.rotateIfNecessary(int64(len(data))); err != nil {
+		return err
+	}
+
+	written, err := s.file.Write(data)
+	s.fileSize += int64(written)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write to %s", s.file.Name())
+	}
+
+	return nil
+}
+
+// Files returns the paths of the files written by the Sink (ordered from the oldest to the newest file).
+func (s *Sink) Files() (files []string, err error) {
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %s", s.directory)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), filePrefix) && strings.HasSuffix(entry.Name(), fileExtension) {
+			files = append(files, filepath.Join(s.directory, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// Close closes the current file of the Sink.
+func (s *Sink) Close() (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.closeFile()
+}
+
+// rotateIfNecessary opens a new file if there is no current file or if the current file would exceed its limits.
+func (s *Sink) rotateIfNecessary(size int64) (err error) {
+	now := s.optsTimeProvider()
+
+	if s.file != nil {
+		exceedsSize := s.optsMaxFileSize > 0 && s.fileSize > 0 && s.fileSize+size > s.optsMaxFileSize
+		exceedsAge := s.optsRotationInterval > 0 && now.Sub(s.fileOpened) >= s.optsRotationInterval
+		if !exceedsSize && !exceedsAge {
+			return nil
+		}
+
+		if err = s.closeFile(); err != nil {
+			return err
+		}
+	}
+
+	if err = os.MkdirAll(s.directory, 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create directory %s", s.directory)
+	}
+
+	// the counter keeps the names unique and ordered if several files are created within the same timestamp
+	s.filesCreated++
+	fileName := fmt.Sprintf("%s%s-%06d%s", filePrefix, now.UTC().Format("20060102T150405.000000000Z"), s.filesCreated, fileExtension)
+	if s.file, err = os.OpenFile(filepath.Join(s.directory, fileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return errors.Wrapf(err, "failed to create file %s", fileName)
+	}
+	s.fileSize = 0
+	s.fileOpened = now
+
+	return s.removeOldFiles()
+}
+
+// removeOldFiles removes the oldest files if more than the configured number of files exist.
+func (s *Sink) removeOldFiles() (err error) {
+	if s.optsMaxFiles <= 0 {
+		return nil
+	}
+
+	files, err := s.Files()
+	if err != nil {
+		return err
+	}
+
+	for len(files) > s.optsMaxFiles {
+		if err = os.Remove(files[0]); err != nil {
+			return errors.Wrapf(err, "failed to remove file %s", files[0])
+		}
+		files = files[1:]
+	}
+
+	return nil
+}
+
+// closeFile syncs and closes the current file.
+func (s *Sink) closeFile() (err error) {
+	if s.file == nil {
+		return nil
+	}
+
+	defer func() {
+		s.file = nil
+	}()
+
+	if err = s.file.Sync(); err != nil {
+		_ = s.file.Close()
+		return errors.Wrapf(err, "failed to sync %s", s.file.Name())
+	}
+
+	return errors.Wrapf(s.file.Close(), "failed to close %s", s.file.Name())
+}
+
+// WithMaxFileSize sets the size in bytes after which the current file is rotated (0 disables size based rotation).
+func WithMaxFileSize(maxFileSize int64) options.Option[Sink] {
+	return func(s *Sink) {
+		s.optsMaxFileSize = maxFileSize
+	}
+}
+
+// WithRotationInterval sets the age after which the current file is rotated (0 disables time based rotation).
+func WithRotationInterval(rotationInterval time.Duration) options.Option[Sink] {
+	return func(s *Sink) {
+		s.optsRotationInterval = rotationInterval
+	}
+}
+
+// WithMaxFiles sets the number of files that are retained (0 retains all files).
+func WithMaxFiles(maxFiles int) options.Option[Sink] {
+	return func(s *Sink) {
+		s.optsMaxFiles = maxFiles
+	}
+}
+
+// WithTimeProvider sets the function that provides the current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Sink] {
+	return func(s *Sink) {
+		s.optsTimeProvider = timeProvider
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,171 @@
+package journal
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// ErrEntriesPruned is returned if entries are requested that were already removed from the Journal.
+var ErrEntriesPruned = ledger.ErrSequencePruned
+
+// region Journal //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Journal is an append-only log of Entries that are numbered with consecutive sequence numbers (starting at 1). It is
+// persisted by a ledger.EventJournal, so that consumers can replay the Entries from the last sequence number that they
+// processed, which provides at-least-once delivery across restarts of the node or the consumer.
+type Journal struct {
+	// Events contains the Events of the Journal.
+	Events *Events
+
+	eventJournal *ledger.EventJournal
+	mutex        sync.Mutex
+
+	optsEventJournal []options.Option[ledger.EventJournal]
+	optsTimeProvider func() time.Time
+}
+
+// New creates a new Journal that persists its Entries in the given store.
+func New(store kvstore.KVStore, opts ...options.Option[Journal]) (journal *Journal) {
+	return options.Apply(&Journal{
+		Events:           NewEvents(),
+		optsTimeProvider: time.Now,
+	}, opts, func(j *Journal) {
+		j.eventJournal = ledger.NewEventJournal(store, j.optsEventJournal...)
+	})
+}
+
+// Append appends a new Entry with the given type and payload to the Journal and returns its sequence number. The
+// EntryAppended event is triggered before Append returns, so callers that append from within event handlers (without
+// a worker pool) produce the Entries in the order of the events.
+func (j *Journal) Append(entryType string, payload any) (sequence uint64, err error) {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to marshal payload of %s entry", entryType)
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entry := &Entry{
+		Type:    entryType,
+		Time:    j.optsTimeProvider(),
+		Payload: encodedPayload,
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to marshal %s entry", entryType)
+	}
+
+	eventSequence, err := j.eventJournal.AppendPayload(ledger.ApplicationEntry, data)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to append %s entry", entryType)
+	}
+	entry.Sequence = eventSequence + 1
+
+	// the event is triggered while the Journal is locked, so that the consumers see the Entries in order
+	j.Events.EntryAppended.Trigger(entry)
+
+	return entry.Sequence, nil
+}
+
+// Replay passes the Entries with a sequence number greater or equal to the given one to the consumer (in order) until
+// the consumer returns false or all Entries that existed when the replay started were passed. It returns
+// ErrEntriesPruned if the requested Entries were already removed from the Journal.
+//
+// Consumers that need to process new Entries should hook to the EntryAppended event before they start the replay and
+// skip the Entries that they have already seen.
+func (j *Journal) Replay(fromSequence uint64, consumer func(entry *Entry) (continueReplay bool)) (err error) {
+	if fromSequence == 0 {
+		fromSequence = 1
+	}
+
+	if replayErr := j.eventJournal.Replay(fromSequence-1, func(journalEntry *ledger.JournalEntry) bool {
+		if journalEntry.Type != ledger.ApplicationEntry {
+			return true
+		}
+
+		entry := new(Entry)
+		if err = json.Unmarshal(journalEntry.Payload, entry); err != nil {
+			err = errors.Wrapf(err, "failed to unmarshal entry %d", journalEntry.Sequence+1)
+			return false
+		}
+		entry.Sequence = journalEntry.Sequence + 1
+
+		return consumer(entry)
+	}); replayErr != nil {
+		return replayErr
+	}
+
+	return err
+}
+
+// FirstSequence returns the sequence number of the oldest Entry that is retained by the Journal.
+func (j *Journal) FirstSequence() (firstSequence uint64) {
+	return j.eventJournal.FirstSequence() + 1
+}
+
+// LastSequence returns the sequence number of the latest Entry of the Journal (0 if the Journal is empty).
+func (j *Journal) LastSequence() (lastSequence uint64) {
+	return j.eventJournal.NextSequence()
+}
+
+// Close writes the buffered Entries to the store and closes the Journal.
+func (j *Journal) Close() {
+	j.eventJournal.Shutdown()
+}
+
+// WithEventJournalOptions sets the options of the ledger.EventJournal that persists the Entries (i.e. their retention).
+func WithEventJournalOptions(opts ...options.Option[ledger.EventJournal]) options.Option[Journal] {
+	return func(j *Journal) {
+		j.optsEventJournal = append(j.optsEventJournal, opts...)
+	}
+}
+
+// WithTimeProvider sets the function that provides the current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Journal] {
+	return func(j *Journal) {
+		j.optsTimeProvider = timeProvider
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Entry ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Entry is a single element of the Journal.
+type Entry struct {
+	Sequence uint64          `json:"sequence"`
+	Type     string          `json:"type"`
+	Time     time.Time       `json:"time"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events is a collection of events that can be triggered by the Journal.
+type Events struct {
+	// EntryAppended is triggered when a new Entry was appended to the Journal.
+	EntryAppended *event.Event1[*Entry]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		EntryAppended: event.New1[*Entry](),
+	}
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
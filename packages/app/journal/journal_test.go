@@ -0,0 +1,97 @@
+package journal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+)
+
+type testPayload struct {
+	Value int `json:"value"`
+}
+
+func TestJournal_AppendAndReplay(t *testing.T) {
+	journal := New(mapdb.NewMapDB())
+	defer journal.Close()
+
+	var appendedEntries []*Entry
+	journal.Events.EntryAppended.Hook(func(entry *Entry) {
+		appendedEntries = append(appendedEntries, entry)
+	})
+
+	require.Equal(t, uint64(0), journal.LastSequence())
+
+	for i := 1; i <= 10; i++ {
+		sequence, appendErr := journal.Append("test", &testPayload{Value: i})
+		require.NoError(t, appendErr)
+		require.Equal(t, uint64(i), sequence)
+	}
+
+	require.Len(t, appendedEntries, 10)
+	for i, entry := range appendedEntries {
+		require.Equal(t, uint64(i+1), entry.Sequence)
+	}
+	require.Equal(t, uint64(1), journal.FirstSequence())
+	require.Equal(t, uint64(10), journal.LastSequence())
+
+	require.Equal(t, []int{4, 5, 6, 7, 8, 9, 10}, replayValues(t, journal, 4, 0))
+	require.Equal(t, []int{1, 2, 3}, replayValues(t, journal, 0, 3))
+	require.Empty(t, replayValues(t, journal, 11, 0))
+}
+
+func TestJournal_Restart(t *testing.T) {
+	store := mapdb.NewMapDB()
+
+	journal := New(store)
+	for i := 1; i <= 3; i++ {
+		_, err := journal.Append("test", &testPayload{Value: i})
+		require.NoError(t, err)
+	}
+	journal.Close()
+
+	journal = New(store)
+	defer journal.Close()
+
+	require.Equal(t, uint64(3), journal.LastSequence())
+
+	sequence, err := journal.Append("test", &testPayload{Value: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sequence)
+
+	require.Equal(t, []int{1, 2, 3, 4}, replayValues(t, journal, 1, 0))
+}
+
+func TestJournal_Pruning(t *testing.T) {
+	journal := New(mapdb.NewMapDB(), WithEventJournalOptions(ledger.WithRetention(2)))
+	defer journal.Close()
+
+	for i := 1; i <= 5; i++ {
+		_, err := journal.Append("test", &testPayload{Value: i})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, uint64(4), journal.FirstSequence())
+	require.Equal(t, []int{4, 5}, replayValues(t, journal, 4, 0))
+
+	err := journal.Replay(2, func(entry *Entry) bool { return true })
+	require.True(t, errors.Is(err, ErrEntriesPruned))
+}
+
+func replayValues(t *testing.T, journal *Journal, fromSequence uint64, limit int) (values []int) {
+	require.NoError(t, journal.Replay(fromSequence, func(entry *Entry) bool {
+		payload := new(testPayload)
+		require.NoError(t, json.Unmarshal(entry.Payload, payload))
+		require.Equal(t, uint64(payload.Value), entry.Sequence)
+
+		values = append(values, payload.Value)
+
+		return limit == 0 || len(values) < limit
+	}))
+
+	return values
+}
@@ -2,8 +2,9 @@ package jsonmodels
 
 // DataResponse contains the ID of the block sent.
 type DataResponse struct {
-	ID    string `json:"id,omitempty"`
-	Error string `json:"error,omitempty"`
+	ID       string            `json:"id,omitempty"`
+	Advisory *IssuanceAdvisory `json:"advisory,omitempty"`
+	Error    string            `json:"error,omitempty"`
 }
 
 // DataRequest contains the data of the block to send.
@@ -0,0 +1,556 @@
+package jsonmodels
+
+import (
+	"net/http"
+)
+
+// region Endpoint /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Endpoint describes a route of the web API together with the JSON models of its request and response. The Endpoints
+// are the single source of truth for the typed client SDK and the OpenAPI specification that are generated by the
+// client-gen tool.
+type Endpoint struct {
+	// Name contains the name of the generated client method.
+	Name string
+
+	// Description contains the description of the endpoint (it completes a sentence that starts with the Name).
+	Description string
+
+	// Method contains the HTTP method of the endpoint.
+	Method string
+
+	// Route contains the path of the endpoint relative to the versioned API prefix (path parameters are prefixed with
+	// a colon).
+	Route string
+
+	// Parameters contains the path and query parameters of the endpoint.
+	Parameters []*Parameter
+
+	// Request contains an instance of the JSON model of the request body (nil if the endpoint has no request body).
+	Request any
+
+	// Response contains an instance of the JSON model of the response body (nil if the endpoint has no response body).
+	Response any
+}
+
+// Parameter describes a path or query parameter of an Endpoint.
+type Parameter struct {
+	// Name contains the name of the parameter.
+	Name string
+
+	// In contains the location of the parameter.
+	In ParameterLocation
+
+	// Type contains the type of the parameter.
+	Type ParameterType
+
+	// Description contains the description of the parameter.
+	Description string
+}
+
+// ParameterLocation defines where a Parameter is passed to an Endpoint.
+type ParameterLocation string
+
+const (
+	// ParameterInPath defines a Parameter that is part of the route of the Endpoint.
+	ParameterInPath ParameterLocation = "path"
+
+	// ParameterInQuery defines a Parameter that is passed in the query string of the Endpoint.
+	ParameterInQuery ParameterLocation = "query"
+)
+
+// ParameterType defines the type of a Parameter (using the names of the OpenAPI specification).
+type ParameterType string
+
+const (
+	// ParameterTypeString defines a string Parameter.
+	ParameterTypeString ParameterType = "string"
+
+	// ParameterTypeInteger defines an integer Parameter.
+	ParameterTypeInteger ParameterType = "integer"
+
+	// ParameterTypeBoolean defines a boolean Parameter.
+	ParameterTypeBoolean ParameterType = "boolean"
+)
+
+// pathParameter returns a string Parameter that is part of the route of an Endpoint.
+func pathParameter(name, description string) *Parameter {
+	return &Parameter{Name: name, In: ParameterInPath, Type: ParameterTypeString, Description: description}
+}
+
+// queryParameter returns a Parameter that is passed in the query string of an Endpoint.
+func queryParameter(name string, parameterType ParameterType, description string) *Parameter {
+	return &Parameter{Name: name, In: ParameterInQuery, Type: parameterType, Description: description}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Endpoints ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Endpoints contains the definitions of the endpoints of the web API that are covered by the typed client SDK.
+var Endpoints = []*Endpoint{
+	{
+		Name:        "Info",
+		Description: "gets the info of the node.",
+		Method:      http.MethodGet,
+		Route:       "info",
+		Response:    new(InfoResponse),
+	},
+	{
+		Name:        "HealthCheck",
+		Description: "checks whether the node is running and healthy.",
+		Method:      http.MethodGet,
+		Route:       "healthz",
+	},
+	{
+		Name:        "GetArtifacts",
+		Description: "gets the node-local artifacts that were created through the API together with their expiration time.",
+		Method:      http.MethodGet,
+		Route:       "artifacts",
+		Parameters: []*Parameter{
+			queryParameter("kind", ParameterTypeString, "the kind of the artifacts that are returned (all kinds if empty)"),
+		},
+		Response: new(GetArtifactsResponse),
+	},
+	{
+		Name:        "RateSetter",
+		Description: "gets the rate-setter estimate and the rate-setter info.",
+		Method:      http.MethodGet,
+		Route:       "ratesetter",
+		Response:    new(RateSetter),
+	},
+	{
+		Name:        "GetBlock",
+		Description: "gets the block with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "blocks/:blockID",
+		Parameters: []*Parameter{
+			pathParameter("blockID", "the base58 encoded ID of the block"),
+		},
+		Response: new(Block),
+	},
+	{
+		Name:        "GetBlockChildren",
+		Description: "gets a page of the blocks that approve the block with the given ID within the given depth.",
+		Method:      http.MethodGet,
+		Route:       "blocks/:blockID/children",
+		Parameters: []*Parameter{
+			pathParameter("blockID", "the base58 encoded ID of the block"),
+			queryParameter("type", ParameterTypeString, "the type of the traversed references (strong, weak or likedInstead - all types if empty)"),
+			queryParameter("depth", ParameterTypeInteger, "the maximum number of references between the block and its returned approvers (1 if empty)"),
+			queryParameter("offset", ParameterTypeInteger, "the number of approvers that are skipped"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of approvers that are returned"),
+		},
+		Response: new(GetBlockChildrenResponse),
+	},
+	{
+		Name:        "SendPayload",
+		Description: "issues a block with the given payload.",
+		Method:      http.MethodPost,
+		Route:       "blocks/payload",
+		Request:     new(PostPayloadRequest),
+		Response:    new(PostPayloadResponse),
+	},
+	{
+		Name:        "Data",
+		Description: "issues a block with the given data payload.",
+		Method:      http.MethodPost,
+		Route:       "data",
+		Request:     new(DataRequest),
+		Response:    new(DataResponse),
+	},
+	{
+		Name:        "GetAddressOutputs",
+		Description: "gets a page of the spent and unspent outputs of an address and the cursor of the next page.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/addresses/:address",
+		Parameters: []*Parameter{
+			pathParameter("address", "the base58 encoded address"),
+			queryParameter("cursor", ParameterTypeString, "the base58 encoded ID of the output after which the page starts"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of outputs that are returned"),
+			queryParameter("spent", ParameterTypeString, "only return spent (true) or unspent (false) outputs (all outputs if empty)"),
+			queryParameter("includeMetadata", ParameterTypeBoolean, "whether the metadata of the outputs is included"),
+		},
+		Response: new(GetAddressResponse),
+	},
+	{
+		Name:        "GetAddressSpendableOutputs",
+		Description: "gets the unspent outputs that an address can unlock at the given time (taking timelocks and fallback conditions into account).",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/addresses/:address/spendable",
+		Parameters: []*Parameter{
+			pathParameter("address", "the base58 encoded address"),
+			queryParameter("time", ParameterTypeInteger, "the unix timestamp (in seconds) of the evaluation (the current time if empty)"),
+		},
+		Response: new(GetAddressSpendableOutputsResponse),
+	},
+	{
+		Name:        "GetAddressExpiredFallbackOutputs",
+		Description: "gets the unspent outputs whose fallback deadline passed and that can therefore be reclaimed by the given fallback address.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/addresses/:address/expiredFallbackOutputs",
+		Parameters: []*Parameter{
+			pathParameter("address", "the base58 encoded fallback address"),
+		},
+		Response: new(GetAddressExpiredFallbackOutputsResponse),
+	},
+	{
+		Name:        "GetAddressBalances",
+		Description: "gets the balances of an address per color (including the pending incoming and outgoing amounts of transactions that are not accepted yet).",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/addresses/:address/balances",
+		Parameters: []*Parameter{
+			pathParameter("address", "the base58 encoded address"),
+		},
+		Response: new(GetAddressBalancesResponse),
+	},
+	{
+		Name:        "PostAddressUnspentOutputs",
+		Description: "gets the unspent outputs of several addresses.",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/addresses/unspentOutputs",
+		Request:     new(PostAddressesUnspentOutputsRequest),
+		Response:    new(PostAddressesUnspentOutputsResponse),
+	},
+	{
+		Name:        "GetPendingConflicts",
+		Description: "gets a page of the unresolved conflict sets with the current approval weight of their members.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/pending",
+		Parameters: []*Parameter{
+			queryParameter("offset", ParameterTypeInteger, "the number of conflict sets that are skipped"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of conflict sets that are returned"),
+		},
+		Response: new(GetPendingConflictsResponse),
+	},
+	{
+		Name:        "GetMempool",
+		Description: "gets a page of the transactions that were booked but neither accepted nor rejected yet (optionally only the ones that create outputs for the given address).",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/mempool",
+		Parameters: []*Parameter{
+			queryParameter("address", ParameterTypeString, "the base58 encoded address that the transactions are filtered by"),
+			queryParameter("offset", ParameterTypeInteger, "the number of transactions that are skipped"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of transactions that are returned"),
+		},
+		Response: new(GetMempoolResponse),
+	},
+	{
+		Name:        "GetConflict",
+		Description: "gets the conflict with the given ID together with its approval weight.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/:conflictID",
+		Parameters: []*Parameter{
+			pathParameter("conflictID", "the base58 encoded ID of the conflict"),
+		},
+		Response: new(ConflictWeight),
+	},
+	{
+		Name:        "GetConflictChildren",
+		Description: "gets the children of a conflict.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/:conflictID/children",
+		Parameters: []*Parameter{
+			pathParameter("conflictID", "the base58 encoded ID of the conflict"),
+		},
+		Response: new(GetConflictChildrenResponse),
+	},
+	{
+		Name:        "GetConflictConflicts",
+		Description: "gets the conflicting conflicts of a conflict.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/:conflictID/conflicts",
+		Parameters: []*Parameter{
+			pathParameter("conflictID", "the base58 encoded ID of the conflict"),
+		},
+		Response: new(GetConflictConflictsResponse),
+	},
+	{
+		Name:        "GetConflictLiked",
+		Description: "gets the members of the conflict sets of a conflict that are currently liked by the node.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/:conflictID/liked",
+		Parameters: []*Parameter{
+			pathParameter("conflictID", "the base58 encoded ID of the conflict"),
+		},
+		Response: new(GetConflictLikedResponse),
+	},
+	{
+		Name:        "GetConflictVoters",
+		Description: "gets the voters of a conflict.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/conflicts/:conflictID/voters",
+		Parameters: []*Parameter{
+			pathParameter("conflictID", "the base58 encoded ID of the conflict"),
+		},
+		Response: new(GetConflictVotersResponse),
+	},
+	{
+		Name:        "GetLedgerUnspentOutputs",
+		Description: "gets a page of the unspent outputs of the ledger and the cursor of the next page.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/unspent",
+		Parameters: []*Parameter{
+			queryParameter("cursor", ParameterTypeString, "the base58 encoded ID of the first output of the page"),
+			queryParameter("address", ParameterTypeString, "the base58 encoded address that the outputs are filtered by"),
+			queryParameter("type", ParameterTypeString, "the type that the outputs are filtered by"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of outputs that are returned"),
+		},
+		Response: new(GetLedgerUnspentOutputsResponse),
+	},
+	{
+		Name:        "PostOutputs",
+		Description: "gets the outputs with the given IDs together with their metadata in a single request.",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/outputs",
+		Request:     new(PostOutputsRequest),
+		Response:    new(PostOutputsResponse),
+	},
+	{
+		Name:        "GetOutput",
+		Description: "gets the output with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/:outputID",
+		Parameters: []*Parameter{
+			pathParameter("outputID", "the base58 encoded ID of the output"),
+		},
+		Response: new(Output),
+	},
+	{
+		Name:        "GetOutputConsumers",
+		Description: "gets the consumers of the output with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/:outputID/consumers",
+		Parameters: []*Parameter{
+			pathParameter("outputID", "the base58 encoded ID of the output"),
+		},
+		Response: new(GetOutputConsumersResponse),
+	},
+	{
+		Name:        "GetOutputMetadata",
+		Description: "gets the metadata of the output with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/:outputID/metadata",
+		Parameters: []*Parameter{
+			pathParameter("outputID", "the base58 encoded ID of the output"),
+		},
+		Response: new(OutputMetadata),
+	},
+	{
+		Name:        "GetOutputProof",
+		Description: "gets a proof that the output with the given ID is unspent in the ledger state of the latest commitment.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/:outputID/proof",
+		Parameters: []*Parameter{
+			pathParameter("outputID", "the base58 encoded ID of the output"),
+		},
+		Response: new(GetOutputProofResponse),
+	},
+	{
+		Name:        "GetOutputVesting",
+		Description: "gets the amount of the vesting output with the given ID that is still locked at the given time.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/outputs/:outputID/vesting",
+		Parameters: []*Parameter{
+			pathParameter("outputID", "the base58 encoded ID of the output"),
+			queryParameter("time", ParameterTypeInteger, "the unix timestamp (in seconds) of the evaluation (the current time if empty)"),
+		},
+		Response: new(GetOutputVestingResponse),
+	},
+	{
+		Name:        "GetOutputMinimumDeposit",
+		Description: "gets the minimum deposit in IOTA that the given prospective output has to hold according to the dust policy of the node.",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/outputs/minimum-deposit",
+		Request:     new(Output),
+		Response:    new(GetOutputMinimumDepositResponse),
+	},
+	{
+		Name:        "GetTransaction",
+		Description: "gets the transaction with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/transactions/:transactionID",
+		Parameters: []*Parameter{
+			pathParameter("transactionID", "the base58 encoded ID of the transaction"),
+		},
+		Response: new(Transaction),
+	},
+	{
+		Name:        "GetTransactionMetadata",
+		Description: "gets the metadata of the transaction with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/transactions/:transactionID/metadata",
+		Parameters: []*Parameter{
+			pathParameter("transactionID", "the base58 encoded ID of the transaction"),
+		},
+		Response: new(TransactionMetadata),
+	},
+	{
+		Name:        "GetTransactionAttachments",
+		Description: "gets the attachments of the transaction with the given ID.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/transactions/:transactionID/attachments",
+		Parameters: []*Parameter{
+			pathParameter("transactionID", "the base58 encoded ID of the transaction"),
+			queryParameter("includeGoF", ParameterTypeBoolean, "whether the confirmation and scheduler status of every attachment is included"),
+		},
+		Response: new(GetTransactionAttachmentsResponse),
+	},
+	{
+		Name:        "SimulateTransactionOutcomes",
+		Description: "enumerates the possible resolutions of the pending conflicts of the transaction with the given ID together with the inputs and outputs that survive them.",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/transactions/:transactionID/simulate-outcomes",
+		Parameters: []*Parameter{
+			pathParameter("transactionID", "the base58 encoded ID of the transaction"),
+			queryParameter("limit", ParameterTypeInteger, "the maximum number of outcomes that are returned"),
+		},
+		Response: new(SimulateTransactionOutcomesResponse),
+	},
+	{
+		Name:        "PostTransaction",
+		Description: "issues the given transaction (and waits for the requested state of it if waitFor is set).",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/transactions",
+		Parameters: []*Parameter{
+			queryParameter("waitFor", ParameterTypeString, "the state (booked, gofLow or gofHigh) that is awaited before the response is sent"),
+			queryParameter("timeout", ParameterTypeString, "the maximum duration (e.g. 30s) that is waited for the requested state"),
+		},
+		Request:  new(PostTransactionRequest),
+		Response: new(PostTransactionResponse),
+	},
+	{
+		Name:        "DryRunTransaction",
+		Description: "executes the given transaction against the current ledger state without issuing or storing it.",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/transactions/dryrun",
+		Request:     new(PostTransactionRequest),
+		Response:    new(DryRunTransactionResponse),
+	},
+	{
+		Name:        "GetAliases",
+		Description: "gets the human-readable aliases that are registered for transactions, conflicts and outputs.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/aliases",
+		Response:    new(GetAliasesResponse),
+	},
+	{
+		Name:        "RegisterAlias",
+		Description: "registers a human-readable alias for a transaction or an output (an empty alias unregisters it).",
+		Method:      http.MethodPost,
+		Route:       "ledgerstate/aliases",
+		Request:     new(PostAliasRequest),
+		Response:    new(Alias),
+	},
+	{
+		Name:        "GetLedgerCaches",
+		Description: "gets the settings and statistics of the caches of the storages of the ledger.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/caches",
+		Response:    new(GetLedgerCachesResponse),
+	},
+	{
+		Name:        "GetLedgerStats",
+		Description: "gets the statistics about the unspent outputs of the ledger state.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/stats",
+		Response:    new(GetLedgerStatsResponse),
+	},
+	{
+		Name:        "GetColorSupplies",
+		Description: "gets the minted, melted and circulating supply of all known colors.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/colors",
+		Response:    new(GetColorSuppliesResponse),
+	},
+	{
+		Name:        "GetAliasChain",
+		Description: "gets the ordered state and governance transitions of the chain of an alias.",
+		Method:      http.MethodGet,
+		Route:       "ledgerstate/alias-chains/:aliasAddress",
+		Parameters: []*Parameter{
+			pathParameter("aliasAddress", "the address of the alias in base58 encoding"),
+		},
+		Response: new(GetAliasChainResponse),
+	},
+	{
+		Name:        "UpdateLedgerCache",
+		Description: "adjusts the cache time and size of the named storage of the ledger.",
+		Method:      http.MethodPut,
+		Route:       "ledgerstate/caches/:storageName",
+		Parameters: []*Parameter{
+			pathParameter("storageName", "the name of the storage"),
+		},
+		Request:  new(PutLedgerCacheRequest),
+		Response: new(LedgerCache),
+	},
+	{
+		Name:        "GetCurrentSlotCommitment",
+		Description: "gets the latest slot commitment of the node.",
+		Method:      http.MethodGet,
+		Route:       "sc",
+		Response:    new(SlotInfo),
+	},
+	{
+		Name:        "GetSlot",
+		Description: "gets the commitment of the slot with the given index.",
+		Method:      http.MethodGet,
+		Route:       "slots/:index",
+		Parameters: []*Parameter{
+			pathParameter("index", "the index of the slot"),
+		},
+		Response: new(SlotInfo),
+	},
+	{
+		Name:        "GetSlotByCommitment",
+		Description: "gets the commitment of the slot with the given commitment ID.",
+		Method:      http.MethodGet,
+		Route:       "slots/commitment/:commitment",
+		Parameters: []*Parameter{
+			pathParameter("commitment", "the base58 encoded ID of the commitment"),
+		},
+		Response: new(SlotInfo),
+	},
+	{
+		Name:        "GetSlotUTXOs",
+		Description: "gets the outputs that were spent and created in the slot with the given index.",
+		Method:      http.MethodGet,
+		Route:       "slots/:index/utxos",
+		Parameters: []*Parameter{
+			pathParameter("index", "the index of the slot"),
+		},
+		Response: new(SlotUTXOsResponse),
+	},
+	{
+		Name:        "GetSlotBlocks",
+		Description: "gets the blocks that were accepted in the slot with the given index.",
+		Method:      http.MethodGet,
+		Route:       "slots/:index/blocks",
+		Parameters: []*Parameter{
+			pathParameter("index", "the index of the slot"),
+		},
+		Response: new(SlotBlocksResponse),
+	},
+	{
+		Name:        "GetSlotTransactions",
+		Description: "gets the transactions that were accepted in the slot with the given index.",
+		Method:      http.MethodGet,
+		Route:       "slots/:index/transactions",
+		Parameters: []*Parameter{
+			pathParameter("index", "the index of the slot"),
+		},
+		Response: new(SlotTransactionsResponse),
+	},
+	{
+		Name:        "GetSlotActivity",
+		Description: "gets the issuers that issued blocks in the slot with the given index together with their block counts and weights.",
+		Method:      http.MethodGet,
+		Route:       "slots/:index/activity",
+		Parameters: []*Parameter{
+			pathParameter("index", "the index of the slot"),
+		},
+		Response: new(SlotActivityResponse),
+	},
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
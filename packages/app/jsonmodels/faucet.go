@@ -19,3 +19,17 @@ type FaucetRequest struct {
 	ConsensusManaPledgeID string `json:"consensusManaPledgeID"`
 	Nonce                 uint64 `json:"nonce"`
 }
+
+// FaucetCoSignRequest contains the essence of a funding transaction that a co-signer of the faucet multisig is asked
+// to sign, the faucet request that it fulfills and the signature of the requesting faucet.
+type FaucetCoSignRequest struct {
+	Essence       string `json:"essence"`
+	FaucetRequest string `json:"faucetRequest"`
+	Signature     string `json:"signature"`
+}
+
+// FaucetCoSignResponse contains the signature of a co-signer of the faucet multisig.
+type FaucetCoSignResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
@@ -2,6 +2,8 @@ package jsonmodels
 
 import (
 	"time"
+
+	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 )
 
 // InfoResponse holds the response of the GET request.
@@ -28,6 +30,8 @@ type InfoResponse struct {
 	EnabledPlugins []string `json:"enabledPlugins,omitempty"`
 	// list if disabled plugins
 	DisabledPlugins []string `json:"disabledPlugins,omitempty"`
+	// Plugins contains the versions and capabilities of the enabled plugins.
+	Plugins []PluginInfo `json:"plugins,omitempty"`
 	// Mana values
 	Mana Mana `json:"mana,omitempty"`
 	// Scheduler is the scheduler.
@@ -40,6 +44,55 @@ type InfoResponse struct {
 	Error string `json:"error,omitempty"`
 }
 
+// Plugin returns the PluginInfo of the enabled plugin with the given name.
+func (i *InfoResponse) Plugin(name string) (pluginInfo PluginInfo, exists bool) {
+	for _, pluginInfo = range i.Plugins {
+		if pluginInfo.Name == name {
+			return pluginInfo, true
+		}
+	}
+
+	return PluginInfo{}, false
+}
+
+// SupportsEndpoint returns true if one of the enabled plugins exposes the given endpoint (e.g. "GET /info").
+func (i *InfoResponse) SupportsEndpoint(endpoint string) bool {
+	for _, pluginInfo := range i.Plugins {
+		for _, pluginEndpoint := range pluginInfo.Endpoints {
+			if pluginEndpoint == endpoint {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SupportsPayloadType returns true if one of the enabled plugins handles the payload type with the given name.
+func (i *InfoResponse) SupportsPayloadType(payloadType string) bool {
+	for _, pluginInfo := range i.Plugins {
+		for _, pluginPayloadType := range pluginInfo.PayloadTypes {
+			if pluginPayloadType == payloadType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PluginInfo contains the version and the capabilities of an enabled plugin.
+type PluginInfo struct {
+	// Name contains the name of the plugin.
+	Name string `json:"name"`
+	// Version contains the version of the plugin.
+	Version string `json:"version"`
+	// Endpoints contains the API endpoints that are exposed by the plugin (e.g. "GET /info").
+	Endpoints []string `json:"endpoints,omitempty"`
+	// PayloadTypes contains the payload types that are handled by the plugin (e.g. "TransactionType(1)").
+	PayloadTypes []string `json:"payloadTypes,omitempty"`
+}
+
 // TangleTime contains the TangleTime sync detailed status.
 type TangleTime struct {
 	AcceptedBlockID  string `json:"blockID"`
@@ -76,3 +129,41 @@ type RateSetter struct {
 	Rate     float64       `json:"rate"`
 	Estimate time.Duration `json:"estimate"`
 }
+
+// IssuanceAdvisory contains the congestion of the node at the time a block was issued and the resulting estimates of
+// how long it takes until the block is scheduled and confirmed (all durations are in nanoseconds).
+type IssuanceAdvisory struct {
+	SchedulerBacklog        int             `json:"schedulerBacklog"`
+	ReadyBlocks             int             `json:"readyBlocks"`
+	IssuerQueueSize         int             `json:"issuerQueueSize"`
+	EstimatedTimeToSchedule time.Duration   `json:"estimatedTimeToSchedule"`
+	TimeToConfirmation      *TimeToFinality `json:"timeToConfirmation"`
+	SuggestedTimeout        time.Duration   `json:"suggestedTimeout"`
+}
+
+// NewIssuanceAdvisory returns an IssuanceAdvisory from the given blockissuer.Advisory.
+func NewIssuanceAdvisory(advisory *blockissuer.Advisory) *IssuanceAdvisory {
+	return &IssuanceAdvisory{
+		SchedulerBacklog:        advisory.SchedulerBacklog,
+		ReadyBlocks:             advisory.ReadyBlocks,
+		IssuerQueueSize:         advisory.IssuerQueueSize,
+		EstimatedTimeToSchedule: advisory.EstimatedTimeToSchedule,
+		TimeToConfirmation: &TimeToFinality{
+			Samples: advisory.TimeToConfirmation.Samples,
+			P50:     advisory.TimeToConfirmation.P50,
+			P90:     advisory.TimeToConfirmation.P90,
+			P99:     advisory.TimeToConfirmation.P99,
+			Max:     advisory.TimeToConfirmation.Max,
+		},
+		SuggestedTimeout: advisory.SuggestedTimeout,
+	}
+}
+
+// TimeToFinality contains the percentiles of the time to finality of the recently finalized blocks.
+type TimeToFinality struct {
+	Samples int           `json:"samples"`
+	P50     time.Duration `json:"p50"`
+	P90     time.Duration `json:"p90"`
+	P99     time.Duration `json:"p99"`
+	Max     time.Duration `json:"max"`
+}
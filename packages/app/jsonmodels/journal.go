@@ -0,0 +1,13 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/journal"
+)
+
+// GetJournalEntriesResponse is the JSON model of a page of the entries of the journal.
+type GetJournalEntriesResponse struct {
+	Entries       []*journal.Entry `json:"entries"`
+	FirstSequence uint64           `json:"firstSequence"`
+	LastSequence  uint64           `json:"lastSequence"`
+	Error         string           `json:"error,omitempty"`
+}
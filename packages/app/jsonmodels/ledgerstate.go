@@ -63,82 +63,22 @@ func (o *Output) ToLedgerstateOutput() (devnetvm.Output, error) {
 		return nil, errors.Wrap(iErr, "failed to parse outputID")
 	}
 
-	switch outputType {
-	case devnetvm.SigLockedSingleOutputType:
-		s, uErr := UnmarshalSigLockedSingleOutputFromBytes(o.Output)
-		if uErr != nil {
-			return nil, uErr
-		}
-		res, tErr := s.ToLedgerStateOutput(id)
-		if tErr != nil {
-			return nil, tErr
-		}
-		return res, nil
-	case devnetvm.SigLockedColoredOutputType:
-		s, uErr := UnmarshalSigLockedColoredOutputFromBytes(o.Output)
-		if uErr != nil {
-			return nil, uErr
-		}
-		res, tErr := s.ToLedgerStateOutput(id)
-		if tErr != nil {
-			return nil, tErr
-		}
-		return res, nil
-	case devnetvm.AliasOutputType:
-		s, uErr := UnmarshalAliasOutputFromBytes(o.Output)
-		if uErr != nil {
-			return nil, uErr
-		}
-		res, tErr := s.ToLedgerStateOutput(id)
-		if tErr != nil {
-			return nil, tErr
-		}
-		return res, nil
-
-	case devnetvm.ExtendedLockedOutputType:
-		s, uErr := UnmarshalExtendedLockedOutputFromBytes(o.Output)
-		if uErr != nil {
-			return nil, uErr
-		}
-		res, tErr := s.ToLedgerStateOutput(id)
-		if tErr != nil {
-			return nil, tErr
-		}
-		return res, nil
-	default:
+	outputModel, exists := outputModels[outputType]
+	if !exists {
 		return nil, errors.Errorf("not supported output type: %d", outputType)
 	}
+
+	return outputModel.toLedgerstate(o.Output, id)
 }
 
 // MarshalOutput uses the json marshaller to marshal a ledgerstate.Output into bytes.
 func MarshalOutput(output devnetvm.Output) []byte {
-	var res interface{}
-	switch output.Type() {
-	case devnetvm.SigLockedSingleOutputType:
-		var err error
-		res, err = SigLockedSingleOutputFromLedgerstate(output)
-		if err != nil {
-			return nil
-		}
-	case devnetvm.SigLockedColoredOutputType:
-		var err error
-		res, err = SigLockedColoredOutputFromLedgerstate(output)
-		if err != nil {
-			return nil
-		}
-	case devnetvm.AliasOutputType:
-		var err error
-		res, err = AliasOutputFromLedgerstate(output)
-		if err != nil {
-			return nil
-		}
-	case devnetvm.ExtendedLockedOutputType:
-		var err error
-		res, err = ExtendedLockedOutputFromLedgerstate(output)
-		if err != nil {
-			return nil
-		}
-	default:
+	outputModel, exists := outputModels[output.Type()]
+	if !exists {
+		return nil
+	}
+	res, err := outputModel.fromLedgerstate(output)
+	if err != nil {
 		return nil
 	}
 	byteResult, mErr := json.Marshal(res)
@@ -149,6 +89,49 @@ func MarshalOutput(output devnetvm.Output) []byte {
 	return byteResult
 }
 
+// RegisterOutputModel registers the JSON model of an OutputType that was registered in the devnetvm, so that its
+// Outputs can be marshaled by MarshalOutput and converted back by Output.ToLedgerstateOutput.
+func RegisterOutputModel[M LedgerstateOutputModel](outputType devnetvm.OutputType, fromLedgerstate func(output devnetvm.Output) (M, error), unmarshal func(data []byte) (M, error)) {
+	outputModels[outputType] = &outputModel{
+		fromLedgerstate: func(output devnetvm.Output) (any, error) {
+			return fromLedgerstate(output)
+		},
+		toLedgerstate: func(data []byte, id utxo.OutputID) (devnetvm.Output, error) {
+			model, err := unmarshal(data)
+			if err != nil {
+				return nil, err
+			}
+
+			return model.ToLedgerStateOutput(id)
+		},
+	}
+}
+
+// LedgerstateOutputModel is the interface of the JSON models of the Outputs.
+type LedgerstateOutputModel interface {
+	// ToLedgerStateOutput converts the JSON model into the Output with the given id.
+	ToLedgerStateOutput(id utxo.OutputID) (devnetvm.Output, error)
+}
+
+// outputModels contains the JSON models of the OutputTypes indexed by their type.
+var outputModels = make(map[devnetvm.OutputType]*outputModel)
+
+// outputModel contains the conversions between an Output and its JSON model.
+type outputModel struct {
+	fromLedgerstate func(output devnetvm.Output) (any, error)
+	toLedgerstate   func(data []byte, id utxo.OutputID) (devnetvm.Output, error)
+}
+
+func init() {
+	RegisterOutputModel(devnetvm.SigLockedSingleOutputType, SigLockedSingleOutputFromLedgerstate, UnmarshalSigLockedSingleOutputFromBytes)
+	RegisterOutputModel(devnetvm.SigLockedColoredOutputType, SigLockedColoredOutputFromLedgerstate, UnmarshalSigLockedColoredOutputFromBytes)
+	RegisterOutputModel(devnetvm.AliasOutputType, AliasOutputFromLedgerstate, UnmarshalAliasOutputFromBytes)
+	RegisterOutputModel(devnetvm.ExtendedLockedOutputType, ExtendedLockedOutputFromLedgerstate, UnmarshalExtendedLockedOutputFromBytes)
+	RegisterOutputModel(devnetvm.ThresholdSigOutputType, ThresholdSigOutputFromLedgerstate, UnmarshalThresholdSigOutputFromBytes)
+	RegisterOutputModel(devnetvm.NFTOutputType, NFTOutputFromLedgerstate, UnmarshalNFTOutputFromBytes)
+	RegisterOutputModel(devnetvm.VestingOutputType, VestingOutputFromLedgerstate, UnmarshalVestingOutputFromBytes)
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region SigLockedSingleOutput ////////////////////////////////////////////////////////////////////////////////////////
@@ -459,6 +442,203 @@ func UnmarshalExtendedLockedOutputFromBytes(data []byte) (*ExtendedLockedOutput,
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region ThresholdSigOutput ///////////////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdSigOutput is the JSON model of a ledgerstate.ThresholdSigOutput.
+type ThresholdSigOutput struct {
+	Balances  map[string]uint64 `json:"balances"`
+	Threshold uint8             `json:"threshold"`
+	Addresses []string          `json:"addresses"`
+}
+
+// ToLedgerStateOutput builds a ledgerstate.Output from ThresholdSigOutput with the given outputID.
+func (t *ThresholdSigOutput) ToLedgerStateOutput(id utxo.OutputID) (devnetvm.Output, error) {
+	addresses := make([]devnetvm.Address, len(t.Addresses))
+	for i, base58Address := range t.Addresses {
+		addy, err := devnetvm.AddressFromBase58EncodedString(base58Address)
+		if err != nil {
+			return nil, errors.Wrap(err, "wrong address in ThresholdSigOutput")
+		}
+		addresses[i] = addy
+	}
+	balances, bErr := getColoredBalances(t.Balances)
+	if bErr != nil {
+		return nil, errors.Wrap(bErr, "failed to parse colored balances")
+	}
+
+	res, err := devnetvm.NewThresholdSigOutput(balances, t.Threshold, addresses...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ThresholdSigOutput")
+	}
+	res.SetID(id)
+	return res, nil
+}
+
+// ThresholdSigOutputFromLedgerstate creates a JSON compatible representation of a ledgerstate output.
+func ThresholdSigOutputFromLedgerstate(output devnetvm.Output) (*ThresholdSigOutput, error) {
+	if output.Type() != devnetvm.ThresholdSigOutputType {
+		return nil, errors.Errorf("wrong output type: %s", output.Type().String())
+	}
+	castedOutput := output.(*devnetvm.ThresholdSigOutput)
+	res := &ThresholdSigOutput{
+		Balances:  getStringBalances(output),
+		Threshold: castedOutput.Threshold(),
+		Addresses: lo.Map(castedOutput.Addresses(), func(address devnetvm.Address) string { return address.Base58() }),
+	}
+	return res, nil
+}
+
+// UnmarshalThresholdSigOutputFromBytes uses the json unmarshaler to unmarshal data into a ThresholdSigOutput.
+func UnmarshalThresholdSigOutputFromBytes(data []byte) (*ThresholdSigOutput, error) {
+	marshalledOutput := &ThresholdSigOutput{}
+	err := json.Unmarshal(data, marshalledOutput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ThresholdSigOutput")
+	}
+	return marshalledOutput, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NFTOutput ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NFTOutput is the JSON model of a ledgerstate.NFTOutput.
+type NFTOutput struct {
+	Balances       map[string]uint64 `json:"balances"`
+	NFTID          string            `json:"nftID"`
+	Owner          string            `json:"owner"`
+	Issuer         string            `json:"issuer"`
+	Metadata       []byte            `json:"metadata,omitempty"`
+	RoyaltyAddress string            `json:"royaltyAddress,omitempty"`
+	RoyaltyAmount  uint64            `json:"royaltyAmount,omitempty"`
+}
+
+// ToLedgerStateOutput builds a ledgerstate.Output from NFTOutput with the given outputID.
+func (n *NFTOutput) ToLedgerStateOutput(id utxo.OutputID) (devnetvm.Output, error) {
+	balances, bErr := getColoredBalances(n.Balances)
+	if bErr != nil {
+		return nil, errors.Wrap(bErr, "failed to parse colored balances")
+	}
+	owner, err := devnetvm.AddressFromBase58EncodedString(n.Owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "wrong owner address in NFTOutput")
+	}
+	issuer, err := devnetvm.AddressFromBase58EncodedString(n.Issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "wrong issuer address in NFTOutput")
+	}
+	var royaltyAddress devnetvm.Address
+	if n.RoyaltyAddress != "" {
+		if royaltyAddress, err = devnetvm.AddressFromBase58EncodedString(n.RoyaltyAddress); err != nil {
+			return nil, errors.Wrap(err, "wrong royalty address in NFTOutput")
+		}
+	}
+
+	res, err := devnetvm.NewNFTOutput(balances, owner, issuer, n.Metadata, royaltyAddress, n.RoyaltyAmount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create NFTOutput")
+	}
+	res.SetID(id)
+	return res, nil
+}
+
+// NFTOutputFromLedgerstate creates a JSON compatible representation of a ledgerstate output.
+func NFTOutputFromLedgerstate(output devnetvm.Output) (*NFTOutput, error) {
+	if output.Type() != devnetvm.NFTOutputType {
+		return nil, errors.Errorf("wrong output type: %s", output.Type().String())
+	}
+	castedOutput := output.(*devnetvm.NFTOutput)
+	res := &NFTOutput{
+		Balances: getStringBalances(output),
+		NFTID:    castedOutput.NFTID().Base58(),
+		Owner:    castedOutput.Address().Base58(),
+		Issuer:   castedOutput.Issuer().Base58(),
+		Metadata: castedOutput.Metadata(),
+	}
+	if royaltyAddress, royaltyAmount := castedOutput.Royalty(); royaltyAddress != nil {
+		res.RoyaltyAddress = royaltyAddress.Base58()
+		res.RoyaltyAmount = royaltyAmount
+	}
+	return res, nil
+}
+
+// UnmarshalNFTOutputFromBytes uses the json unmarshaler to unmarshal data into an NFTOutput.
+func UnmarshalNFTOutputFromBytes(data []byte) (*NFTOutput, error) {
+	marshalledOutput := &NFTOutput{}
+	err := json.Unmarshal(data, marshalledOutput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal NFTOutput")
+	}
+	return marshalledOutput, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VestingOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// VestingOutput is the JSON model of a ledgerstate.VestingOutput.
+type VestingOutput struct {
+	Balances map[string]uint64 `json:"balances"`
+	Address  string            `json:"address"`
+	Schedule []*VestingTranche `json:"schedule"`
+}
+
+// VestingTranche is the JSON model of a tranche of the schedule of a VestingOutput.
+type VestingTranche struct {
+	Time   int64  `json:"time"`
+	Amount uint64 `json:"amount"`
+}
+
+// ToLedgerStateOutput builds a ledgerstate.Output from VestingOutput with the given outputID.
+func (v *VestingOutput) ToLedgerStateOutput(id utxo.OutputID) (devnetvm.Output, error) {
+	balances, bErr := getColoredBalances(v.Balances)
+	if bErr != nil {
+		return nil, errors.Wrap(bErr, "failed to parse colored balances")
+	}
+	address, err := devnetvm.AddressFromBase58EncodedString(v.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "wrong address in VestingOutput")
+	}
+	schedule := lo.Map(v.Schedule, func(tranche *VestingTranche) devnetvm.VestingTranche {
+		return devnetvm.VestingTranche{Time: time.Unix(tranche.Time, 0), Amount: tranche.Amount}
+	})
+
+	res, err := devnetvm.NewVestingOutput(balances, address, schedule...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create VestingOutput")
+	}
+	res.SetID(id)
+	return res, nil
+}
+
+// VestingOutputFromLedgerstate creates a JSON compatible representation of a ledgerstate output.
+func VestingOutputFromLedgerstate(output devnetvm.Output) (*VestingOutput, error) {
+	if output.Type() != devnetvm.VestingOutputType {
+		return nil, errors.Errorf("wrong output type: %s", output.Type().String())
+	}
+	castedOutput := output.(*devnetvm.VestingOutput)
+	res := &VestingOutput{
+		Balances: getStringBalances(output),
+		Address:  castedOutput.Address().Base58(),
+		Schedule: lo.Map(castedOutput.Schedule(), func(tranche devnetvm.VestingTranche) *VestingTranche {
+			return &VestingTranche{Time: tranche.Time.Unix(), Amount: tranche.Amount}
+		}),
+	}
+	return res, nil
+}
+
+// UnmarshalVestingOutputFromBytes uses the json unmarshaler to unmarshal data into a VestingOutput.
+func UnmarshalVestingOutputFromBytes(data []byte) (*VestingOutput, error) {
+	marshalledOutput := &VestingOutput{}
+	err := json.Unmarshal(data, marshalledOutput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal VestingOutput")
+	}
+	return marshalledOutput, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region OutputID /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // OutputID represents the JSON model of a ledgerstate.OutputID.
@@ -475,6 +655,28 @@ func NewOutputID(outputID utxo.OutputID) *OutputID {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region UnspentOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// UnspentOutput represents the JSON model of an unspent Output of the ledger.
+type UnspentOutput struct {
+	Output                *Output `json:"output"`
+	InclusionSlot         uint64  `json:"inclusionSlot"`
+	ConsensusManaPledgeID string  `json:"consensusManaPledgeID"`
+	AccessManaPledgeID    string  `json:"accessManaPledgeID"`
+}
+
+// NewUnspentOutput returns an UnspentOutput from the given mempool.OutputWithMetadata.
+func NewUnspentOutput(outputWithMetadata *mempool.OutputWithMetadata) *UnspentOutput {
+	return &UnspentOutput{
+		Output:                NewOutput(outputWithMetadata.Output().(devnetvm.Output)),
+		InclusionSlot:         uint64(outputWithMetadata.Index()),
+		ConsensusManaPledgeID: outputWithMetadata.ConsensusManaPledgeID().EncodeBase58(),
+		AccessManaPledgeID:    outputWithMetadata.AccessManaPledgeID().EncodeBase58(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region OutputMetadata ///////////////////////////////////////////////////////////////////////////////////////////////
 
 // OutputMetadata represents the JSON model of the mempool.OutputMetadata.
@@ -487,15 +689,21 @@ type OutputMetadata struct {
 	ConfirmationStateTime int64              `json:"confirmationStateTime"`
 }
 
-// NewOutputMetadata returns the OutputMetadata from the given mempool.OutputMetadata.
+// NewOutputMetadata returns the OutputMetadata from the given mempool.OutputMetadata and the ID of the accepted
+// Transaction that spent the Output (see mempool.Utils.ConfirmedConsumer).
 func NewOutputMetadata(outputMetadata *mempool.OutputMetadata, confirmedConsumerID utxo.TransactionID) *OutputMetadata {
+	var confirmedConsumer string
+	if confirmedConsumerID != utxo.EmptyTransactionID {
+		confirmedConsumer = confirmedConsumerID.Base58()
+	}
+
 	return &OutputMetadata{
 		OutputID: NewOutputID(outputMetadata.ID()),
 		ConflictIDs: lo.Map(lo.Map(outputMetadata.ConflictIDs().Slice(), func(t utxo.TransactionID) []byte {
 			return lo.PanicOnErr(t.Bytes())
 		}), base58.Encode),
 		FirstConsumer:         outputMetadata.FirstConsumer().Base58(),
-		ConfirmedConsumer:     confirmedConsumerID.Base58(),
+		ConfirmedConsumer:     confirmedConsumer,
 		ConfirmationState:     outputMetadata.ConfirmationState(),
 		ConfirmationStateTime: outputMetadata.ConfirmationStateTime().Unix(),
 	}
@@ -689,6 +897,7 @@ type UnlockBlock struct {
 	SignatureType   devnetvm.SignatureType `json:"signatureType,omitempty"`
 	PublicKey       string                 `json:"publicKey,omitempty"`
 	Signature       string                 `json:"signature,omitempty"`
+	Signatures      []*Signature           `json:"signatures,omitempty"`
 }
 
 // NewUnlockBlock returns an UnlockBlock from the given ledgerstate.UnlockBlock.
@@ -700,20 +909,42 @@ func NewUnlockBlock(unlockBlock devnetvm.UnlockBlock) *UnlockBlock {
 	switch unlockBlock.Type() {
 	case devnetvm.SignatureUnlockBlockType:
 		signature, _, _ := devnetvm.SignatureFromBytes(lo.PanicOnErr(unlockBlock.Bytes()))
-		result.SignatureType = signature.Type()
-		switch signature.Type() {
-		case devnetvm.ED25519SignatureType:
-			signature, _, _ := devnetvm.ED25519SignatureFromBytes(signature.Bytes())
-			result.PublicKey = signature.PublicKey.String()
-			result.Signature = signature.Signature.String()
-
-		case devnetvm.BLSSignatureType:
-			signature, _, _ := devnetvm.BLSSignatureFromBytes(signature.Bytes())
-			result.Signature = signature.Signature.String()
-		}
+		jsonSignature := NewSignature(signature)
+		result.SignatureType = jsonSignature.SignatureType
+		result.PublicKey = jsonSignature.PublicKey
+		result.Signature = jsonSignature.Signature
 	case devnetvm.ReferenceUnlockBlockType:
 		referenceUnlockBlock, _, _ := devnetvm.ReferenceUnlockBlockFromBytes(lo.PanicOnErr(unlockBlock.Bytes()))
 		result.ReferencedIndex = referenceUnlockBlock.ReferencedIndex()
+	case devnetvm.ThresholdSignatureUnlockBlockType:
+		result.Signatures = lo.Map(unlockBlock.(*devnetvm.ThresholdSignatureUnlockBlock).Signatures(), NewSignature)
+	}
+
+	return result
+}
+
+// Signature represents the JSON model of a ledgerstate.Signature.
+type Signature struct {
+	SignatureType devnetvm.SignatureType `json:"signatureType"`
+	PublicKey     string                 `json:"publicKey,omitempty"`
+	Signature     string                 `json:"signature"`
+}
+
+// NewSignature returns a Signature from the given ledgerstate.Signature.
+func NewSignature(signature devnetvm.Signature) *Signature {
+	result := &Signature{
+		SignatureType: signature.Type(),
+	}
+
+	switch signature.Type() {
+	case devnetvm.ED25519SignatureType:
+		signature, _, _ := devnetvm.ED25519SignatureFromBytes(signature.Bytes())
+		result.PublicKey = signature.PublicKey.String()
+		result.Signature = signature.Signature.String()
+
+	case devnetvm.BLSSignatureType:
+		signature, _, _ := devnetvm.BLSSignatureFromBytes(signature.Bytes())
+		result.Signature = signature.Signature.String()
 	}
 
 	return result
@@ -731,6 +962,7 @@ type TransactionMetadata struct {
 	BookedTime            int64              `json:"bookedTime"`
 	ConfirmationState     confirmation.State `json:"confirmationState"`
 	ConfirmationStateTime int64              `json:"confirmationStateTime"`
+	RejectionReason       string             `json:"rejectionReason,omitempty"`
 }
 
 // NewTransactionMetadata returns the TransactionMetadata from the given mempool.TransactionMetadata.
@@ -742,6 +974,7 @@ func NewTransactionMetadata(transactionMetadata *mempool.TransactionMetadata) *T
 		BookedTime:            transactionMetadata.BookingTime().Unix(),
 		ConfirmationState:     transactionMetadata.ConfirmationState(),
 		ConfirmationStateTime: transactionMetadata.ConfirmationStateTime().Unix(),
+		RejectionReason:       lo.Cond(transactionMetadata.RejectionReason() != mempool.NoRejectionReason, transactionMetadata.RejectionReason().String(), ""),
 	}
 }
 
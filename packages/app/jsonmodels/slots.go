@@ -48,3 +48,21 @@ type SlotTransactionsResponse struct {
 	Transactions []string `json:"transactions"`
 	Error        string   `json:"error,omitempty"`
 }
+
+// SlotActivityResponse contains the issuers that were active in a slot.
+type SlotActivityResponse struct {
+	Index            uint64            `json:"index"`
+	Blocks           uint64            `json:"blocks"`
+	ActiveWeight     int64             `json:"activeWeight"`
+	WeightedActivity uint64            `json:"weightedActivity"`
+	Issuers          []*IssuerActivity `json:"issuers"`
+	Error            string            `json:"error,omitempty"`
+}
+
+// IssuerActivity contains the number of blocks that an issuer issued in a slot and its weight at that time.
+type IssuerActivity struct {
+	IssuerID         string `json:"issuerID"`
+	Blocks           uint64 `json:"blocks"`
+	Weight           int64  `json:"weight"`
+	WeightedActivity uint64 `json:"weightedActivity"`
+}
@@ -2,15 +2,31 @@ package jsonmodels
 
 // SpammerResponse is the HTTP response of a spammer request.
 type SpammerResponse struct {
-	Block string `json:"block"`
-	Error string `json:"error"`
+	Block string             `json:"block"`
+	Stats *ValueSpammerStats `json:"stats,omitempty"`
+	Error string             `json:"error"`
 }
 
 // SpammerRequest contains the parameters of a spammer request.
 type SpammerRequest struct {
-	Cmd         string `query:"cmd"`
-	IMIF        string `query:"imif"`
-	Rate        int    `query:"rate"`
-	Unit        string `query:"unit"`
-	PayloadSize uint64 `query:"payloadSize"`
+	Cmd          string  `query:"cmd"`
+	Mode         string  `query:"mode"`
+	IMIF         string  `query:"imif"`
+	Rate         int     `query:"rate"`
+	Unit         string  `query:"unit"`
+	PayloadSize  uint64  `query:"payloadSize"`
+	ConflictRate float64 `query:"conflictRate"`
+}
+
+// ValueSpammerStats contains the statistics of the value spammer.
+type ValueSpammerStats struct {
+	Running     bool    `json:"running"`
+	Issued      uint64  `json:"issued"`
+	Booked      uint64  `json:"booked"`
+	Accepted    uint64  `json:"accepted"`
+	Rejected    uint64  `json:"rejected"`
+	Conflicts   uint64  `json:"conflicts"`
+	IssuedTPS   float64 `json:"issuedTPS"`
+	BookedTPS   float64 `json:"bookedTPS"`
+	AcceptedTPS float64 `json:"acceptedTPS"`
 }
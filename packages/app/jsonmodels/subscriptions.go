@@ -0,0 +1,95 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/subscriptions"
+)
+
+const (
+	// SubscriptionActionSubscribe is the action of a SubscriptionRequest that adds topics.
+	SubscriptionActionSubscribe = "subscribe"
+
+	// SubscriptionActionUnsubscribe is the action of a SubscriptionRequest that removes topics.
+	SubscriptionActionUnsubscribe = "unsubscribe"
+
+	// SubscriptionMessageTypeTopics is the type of the SubscriptionMessage that acknowledges a SubscriptionRequest.
+	SubscriptionMessageTypeTopics = "topics"
+
+	// SubscriptionMessageTypeError is the type of the SubscriptionMessage that reports an error.
+	SubscriptionMessageTypeError = "error"
+)
+
+// region SubscriptionRequest //////////////////////////////////////////////////////////////////////////////////////////
+
+// SubscriptionRequest represents the JSON model of a request that is sent by the client of the /ws/subscriptions
+// endpoint to change its topics.
+type SubscriptionRequest struct {
+	Action         string   `json:"action"`
+	Addresses      []string `json:"addresses,omitempty"`
+	TransactionIDs []string `json:"transactionIDs,omitempty"`
+	ConflictIDs    []string `json:"conflictIDs,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SubscriptionMessage //////////////////////////////////////////////////////////////////////////////////////////
+
+// SubscriptionMessage represents the JSON model of a message that is sent by the /ws/subscriptions endpoint.
+type SubscriptionMessage struct {
+	Sequence      uint64               `json:"sequence,omitempty"`
+	Type          string               `json:"type"`
+	TransactionID string               `json:"transactionID,omitempty"`
+	Topics        []*SubscriptionTopic `json:"topics,omitempty"`
+	Error         string               `json:"error,omitempty"`
+}
+
+// NewSubscriptionMessage returns a SubscriptionMessage from the given subscriptions.Message.
+func NewSubscriptionMessage(message *subscriptions.Message) *SubscriptionMessage {
+	return &SubscriptionMessage{
+		Sequence:      message.Sequence,
+		Type:          message.Notification.Type.String(),
+		TransactionID: message.Notification.TransactionID.Base58(),
+		Topics:        NewSubscriptionTopics(message.Topics),
+	}
+}
+
+// NewSubscriptionTopicsMessage returns the SubscriptionMessage that acknowledges a SubscriptionRequest with the
+// resulting topics of the subscriber.
+func NewSubscriptionTopicsMessage(topics []subscriptions.Topic) *SubscriptionMessage {
+	return &SubscriptionMessage{
+		Type:   SubscriptionMessageTypeTopics,
+		Topics: NewSubscriptionTopics(topics),
+	}
+}
+
+// NewSubscriptionErrorMessage returns the SubscriptionMessage that reports the given error.
+func NewSubscriptionErrorMessage(err error) *SubscriptionMessage {
+	return &SubscriptionMessage{
+		Type:  SubscriptionMessageTypeError,
+		Error: err.Error(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SubscriptionTopic ////////////////////////////////////////////////////////////////////////////////////////////
+
+// SubscriptionTopic represents the JSON model of a subscriptions.Topic.
+type SubscriptionTopic struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// NewSubscriptionTopics returns the SubscriptionTopics of the given subscriptions.Topics.
+func NewSubscriptionTopics(topics []subscriptions.Topic) (subscriptionTopics []*SubscriptionTopic) {
+	subscriptionTopics = make([]*SubscriptionTopic, 0, len(topics))
+	for _, topic := range topics {
+		subscriptionTopics = append(subscriptionTopics, &SubscriptionTopic{
+			Type: topic.Type.String(),
+			ID:   topic.ID,
+		})
+	}
+
+	return subscriptionTopics
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,5 +1,10 @@
 package jsonmodels
 
+import (
+	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
 // region Block ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Block represents the JSON model of a tangleold.Block.
@@ -26,3 +31,65 @@ type Block struct {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetBlockChildrenResponse /////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// ChildTypeStrong is the name of the children that reference a block as a strong parent.
+	ChildTypeStrong = "strong"
+
+	// ChildTypeWeak is the name of the children that reference a block as a weak parent.
+	ChildTypeWeak = "weak"
+
+	// ChildTypeLikedInstead is the name of the children that reference a block as a shallow like parent.
+	ChildTypeLikedInstead = "likedInstead"
+)
+
+// GetBlockChildrenResponse represents the JSON model of a response from the GetBlockChildren endpoint.
+type GetBlockChildrenResponse struct {
+	BlockID  string        `json:"blockID"`
+	Children []*BlockChild `json:"children"`
+	HasMore  bool          `json:"hasMore"`
+}
+
+// BlockChild represents the JSON model of a block that (directly or transitively) approves another block.
+type BlockChild struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Depth int    `json:"depth"`
+}
+
+// NewGetBlockChildrenResponse returns a GetBlockChildrenResponse from the given details.
+func NewGetBlockChildrenResponse(blockID models.BlockID, children []*retainer.Child, hasMore bool) *GetBlockChildrenResponse {
+	response := &GetBlockChildrenResponse{
+		BlockID:  blockID.Base58(),
+		Children: make([]*BlockChild, 0, len(children)),
+		HasMore:  hasMore,
+	}
+
+	for _, child := range children {
+		response.Children = append(response.Children, &BlockChild{
+			ID:    child.ID.Base58(),
+			Type:  ChildTypeName(child.Type),
+			Depth: child.Depth,
+		})
+	}
+
+	return response
+}
+
+// ChildTypeName returns the name of the children that reference a block with the given type of parent.
+func ChildTypeName(parentType models.ParentsType) string {
+	switch parentType {
+	case models.StrongParentType:
+		return ChildTypeStrong
+	case models.WeakParentType:
+		return ChildTypeWeak
+	case models.ShallowLikeParentType:
+		return ChildTypeLikedInstead
+	default:
+		return ""
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,28 +2,44 @@ package jsonmodels
 
 import (
 	"strconv"
+	"time"
 
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/addressfeed"
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
+	"github.com/iotaledger/goshimmer/packages/app/confirmationfeed"
+	"github.com/iotaledger/goshimmer/packages/app/mempoolview"
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/core/outputproof"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/lo"
 )
 
 // region GetAddressResponse ///////////////////////////////////////////////////////////////////////////////////////////
 
 // GetAddressResponse represents the JSON model of a response from the GetAddress endpoint.
 type GetAddressResponse struct {
-	Address        *Address  `json:"address"`
-	SpentOutputs   []*Output `json:"spentOutputs"`
-	UnspentOutputs []*Output `json:"unspentOutputs"`
+	Address         *Address                   `json:"address"`
+	SpentOutputs    []*Output                  `json:"spentOutputs"`
+	UnspentOutputs  []*Output                  `json:"unspentOutputs"`
+	OutputsMetadata map[string]*OutputMetadata `json:"outputsMetadata,omitempty"`
+	Cursor          string                     `json:"cursor,omitempty"`
 }
 
-// NewGetAddressResponse returns a GetAddressResponse from the given details.
-func NewGetAddressResponse(address devnetvm.Address, spent, unspent devnetvm.Outputs) *GetAddressResponse {
+// NewGetAddressResponse returns a GetAddressResponse from the given details (the metadata is optional and keyed by the
+// base58 encoded IDs of the outputs, and the cursor is omitted if the page is the last one).
+func NewGetAddressResponse(address devnetvm.Address, spent, unspent devnetvm.Outputs, outputsMetadata []*mempool.OutputMetadata, confirmedConsumer func(utxo.OutputID) utxo.TransactionID, cursor utxo.OutputID) *GetAddressResponse {
 	mappedOutput := func(outputs devnetvm.Outputs) (mappedOutputs []*Output) {
 		mappedOutputs = make([]*Output, 0)
 		for _, output := range outputs {
@@ -35,15 +51,154 @@ func NewGetAddressResponse(address devnetvm.Address, spent, unspent devnetvm.Out
 		return
 	}
 
-	return &GetAddressResponse{
+	response := &GetAddressResponse{
 		Address:        NewAddress(address),
 		SpentOutputs:   mappedOutput(spent),
 		UnspentOutputs: mappedOutput(unspent),
 	}
+
+	if len(outputsMetadata) > 0 {
+		response.OutputsMetadata = make(map[string]*OutputMetadata, len(outputsMetadata))
+		for _, outputMetadata := range outputsMetadata {
+			response.OutputsMetadata[outputMetadata.ID().Base58()] = NewOutputMetadata(outputMetadata, confirmedConsumer(outputMetadata.ID()))
+		}
+	}
+
+	if cursor != utxo.EmptyOutputID {
+		response.Cursor = cursor.Base58()
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressSpendableOutputsResponse ///////////////////////////////////////////////////////////////////////////
+
+// GetAddressSpendableOutputsResponse represents the JSON model of a response from the GetAddressSpendableOutputs
+// endpoint.
+type GetAddressSpendableOutputsResponse struct {
+	Address *Address  `json:"address"`
+	Time    int64     `json:"time"`
+	Outputs []*Output `json:"outputs"`
+}
+
+// NewGetAddressSpendableOutputsResponse returns a GetAddressSpendableOutputsResponse from the given details.
+func NewGetAddressSpendableOutputsResponse(address devnetvm.Address, nowis time.Time, outputs devnetvm.Outputs) *GetAddressSpendableOutputsResponse {
+	response := &GetAddressSpendableOutputsResponse{
+		Address: NewAddress(address),
+		Time:    nowis.Unix(),
+		Outputs: make([]*Output, 0, len(outputs)),
+	}
+	for _, output := range outputs {
+		response.Outputs = append(response.Outputs, NewOutput(output))
+	}
+
+	return response
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetAddressExpiredFallbackOutputsResponse /////////////////////////////////////////////////////////////////////
+
+// GetAddressExpiredFallbackOutputsResponse represents the JSON model of a response from the
+// GetAddressExpiredFallbackOutputs endpoint.
+type GetAddressExpiredFallbackOutputsResponse struct {
+	Address *Address  `json:"address"`
+	Outputs []*Output `json:"outputs"`
+}
+
+// NewGetAddressExpiredFallbackOutputsResponse returns a GetAddressExpiredFallbackOutputsResponse from the given details.
+func NewGetAddressExpiredFallbackOutputsResponse(address devnetvm.Address, outputs []*devnetvm.ExtendedLockedOutput) *GetAddressExpiredFallbackOutputsResponse {
+	response := &GetAddressExpiredFallbackOutputsResponse{
+		Address: NewAddress(address),
+		Outputs: make([]*Output, 0, len(outputs)),
+	}
+	for _, output := range outputs {
+		response.Outputs = append(response.Outputs, NewOutput(output))
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressBalancesResponse ///////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressBalancesResponse represents the JSON model of a response from the GetAddressBalances endpoint.
+type GetAddressBalancesResponse struct {
+	Address  *Address        `json:"address"`
+	Balances []*ColorBalance `json:"balances"`
+}
+
+// ColorBalance represents the JSON model of the balance of a color on an address.
+type ColorBalance struct {
+	Color      string `json:"color"`
+	Balance    uint64 `json:"balance"`
+	PendingIn  uint64 `json:"pendingIn"`
+	PendingOut uint64 `json:"pendingOut"`
+}
+
+// NewGetAddressBalancesResponse returns a GetAddressBalancesResponse from the given balances.
+func NewGetAddressBalancesResponse(address devnetvm.Address, balances *indexer.AddressBalances) *GetAddressBalancesResponse {
+	return &GetAddressBalancesResponse{
+		Address: NewAddress(address),
+		Balances: lo.Map(balances.Colors(), func(color devnetvm.Color) *ColorBalance {
+			return &ColorBalance{
+				Color:      color.Base58(),
+				Balance:    balances.Balances[color],
+				PendingIn:  balances.PendingIn[color],
+				PendingOut: balances.PendingOut[color],
+			}
+		}),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AddressActivityEvent /////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressActivityEvent represents the JSON model of an event that is streamed by the
+// /ledgerstate/addresses/:address/subscribe endpoint.
+type AddressActivityEvent struct {
+	Sequence uint64  `json:"sequence"`
+	Type     string  `json:"type"`
+	Output   *Output `json:"output,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// NewAddressActivityEvent returns an AddressActivityEvent from the given addressfeed.Event.
+func NewAddressActivityEvent(event *addressfeed.Event) *AddressActivityEvent {
+	activityEvent := &AddressActivityEvent{
+		Sequence: event.Sequence,
+		Type:     event.Type.String(),
+	}
+	if event.Output != nil {
+		activityEvent.Output = NewOutput(event.Output)
+	}
+
+	return activityEvent
+}
+
+// ConfirmedTransactionEvent represents the JSON model of an event that is streamed by the
+// /ledgerstate/transactions/confirmed/stream endpoint.
+type ConfirmedTransactionEvent struct {
+	TransactionID string   `json:"transactionID"`
+	InclusionSlot uint64   `json:"inclusionSlot"`
+	ConfirmedAt   int64    `json:"confirmedAt"`
+	Addresses     []string `json:"addresses"`
+}
+
+// NewConfirmedTransactionEvent returns a ConfirmedTransactionEvent from the given confirmationfeed.Event.
+func NewConfirmedTransactionEvent(event *confirmationfeed.Event) *ConfirmedTransactionEvent {
+	return &ConfirmedTransactionEvent{
+		TransactionID: event.TransactionID.Base58(),
+		InclusionSlot: uint64(event.InclusionSlot),
+		ConfirmedAt:   event.Time.Unix(),
+		Addresses:     event.Addresses,
+	}
+}
+
 // region PostAddressesUnspentOutputsRequest
 
 // PostAddressesUnspentOutputsRequest is a the request object for the /ledgerstate/addresses/unspentOutputs endpoint.
@@ -112,6 +267,26 @@ func NewGetConflictConflictsResponse(conflictID utxo.TransactionID, conflictIDsP
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetConflictLikedResponse /////////////////////////////////////////////////////////////////////////////////////
+
+// GetConflictLikedResponse represents the JSON model of a response from the GetConflictLiked endpoint.
+type GetConflictLikedResponse struct {
+	ConflictID   string              `json:"conflictID"`
+	Liked        bool                `json:"liked"`
+	ConflictSets []*LikedConflictSet `json:"conflictSets"`
+}
+
+// LikedConflictSet represents the JSON model of the member of a conflict set that is currently liked by the node. A
+// liked member that is not final is only the likely winner of the conflict set and can still be rejected.
+type LikedConflictSet struct {
+	ConflictSetID     string             `json:"conflictSetID"`
+	LikedConflictID   string             `json:"likedConflictID,omitempty"`
+	ConfirmationState confirmation.State `json:"confirmationState"`
+	Final             bool               `json:"final"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetConflictVotersResponse //////////////////////////////////////////////////////////////////////////////////////
 
 // GetConflictVotersResponse represents the JSON model of a response from the GetConflictVoters endpoint.
@@ -137,6 +312,251 @@ func NewGetConflictVotersResponse(conflictID utxo.TransactionID, voters *sybilpr
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetPendingConflictsResponse ///////////////////////////////////////////////////////////////////////////////////
+
+// GetPendingConflictsResponse represents the JSON model of a response from the GetPendingConflicts endpoint.
+type GetPendingConflictsResponse struct {
+	ConflictSets []*PendingConflictSet `json:"conflictSets"`
+	Total        int                   `json:"total"`
+}
+
+// PendingConflictSet represents the JSON model of an unresolved ConflictSet.
+type PendingConflictSet struct {
+	ConflictSetID string                      `json:"conflictSetID"`
+	CreationTime  int64                       `json:"creationTime"`
+	Members       []*PendingConflictSetMember `json:"members"`
+}
+
+// PendingConflictSetMember represents the JSON model of a member of an unresolved ConflictSet.
+type PendingConflictSetMember struct {
+	ConflictID        string             `json:"conflictID"`
+	ConfirmationState confirmation.State `json:"confirmationState"`
+	Weight            int64              `json:"weight"`
+}
+
+// NewGetPendingConflictsResponse returns a GetPendingConflictsResponse from the given details.
+func NewGetPendingConflictsResponse(pendingConflictSets []*conflictdag.PendingConflictSet[utxo.TransactionID, utxo.OutputID], total int) *GetPendingConflictsResponse {
+	return &GetPendingConflictsResponse{
+		ConflictSets: func() (mappedConflictSets []*PendingConflictSet) {
+			mappedConflictSets = make([]*PendingConflictSet, 0, len(pendingConflictSets))
+			for _, pendingConflictSet := range pendingConflictSets {
+				mappedConflictSet := &PendingConflictSet{
+					ConflictSetID: pendingConflictSet.ID.Base58(),
+					CreationTime:  pendingConflictSet.CreationTime.Unix(),
+					Members:       make([]*PendingConflictSetMember, 0, len(pendingConflictSet.Members)),
+				}
+				for _, member := range pendingConflictSet.Members {
+					mappedConflictSet.Members = append(mappedConflictSet.Members, &PendingConflictSetMember{
+						ConflictID:        member.ID.Base58(),
+						ConfirmationState: member.ConfirmationState,
+						Weight:            member.Weight,
+					})
+				}
+				mappedConflictSets = append(mappedConflictSets, mappedConflictSet)
+			}
+
+			return
+		}(),
+		Total: total,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetMempoolResponse ///////////////////////////////////////////////////////////////////////////////////////////
+
+// GetMempoolResponse represents the JSON model of a response from the GetMempool endpoint.
+type GetMempoolResponse struct {
+	Transactions []*MempoolTransaction `json:"transactions"`
+	Total        int                   `json:"total"`
+	Metrics      *MempoolMetrics       `json:"metrics"`
+}
+
+// MempoolTransaction represents the JSON model of a Transaction that was booked but neither accepted nor rejected yet.
+type MempoolTransaction struct {
+	TransactionID string       `json:"transactionID"`
+	BookedTime    int64        `json:"bookedTime"`
+	Addresses     []string     `json:"addresses"`
+	Transaction   *Transaction `json:"transaction"`
+}
+
+// MempoolMetrics represents the JSON model of the metrics of the view of the pending Transactions.
+type MempoolMetrics struct {
+	Size     int    `json:"size"`
+	MaxSize  int    `json:"maxSize"`
+	Added    uint64 `json:"added"`
+	Accepted uint64 `json:"accepted"`
+	Rejected uint64 `json:"rejected"`
+	Orphaned uint64 `json:"orphaned"`
+	Evicted  uint64 `json:"evicted"`
+}
+
+// NewGetMempoolResponse returns a GetMempoolResponse from the given details.
+func NewGetMempoolResponse(pendingTransactions []*mempoolview.PendingTransaction, total int, metrics mempoolview.Metrics) *GetMempoolResponse {
+	response := &GetMempoolResponse{
+		Transactions: make([]*MempoolTransaction, 0, len(pendingTransactions)),
+		Total:        total,
+		Metrics: &MempoolMetrics{
+			Size:     metrics.Size,
+			MaxSize:  metrics.MaxSize,
+			Added:    metrics.Added,
+			Accepted: metrics.Accepted,
+			Rejected: metrics.Rejected,
+			Orphaned: metrics.Orphaned,
+			Evicted:  metrics.Evicted,
+		},
+	}
+
+	for _, pendingTransaction := range pendingTransactions {
+		mempoolTransaction := &MempoolTransaction{
+			TransactionID: pendingTransaction.ID.Base58(),
+			BookedTime:    pendingTransaction.BookedTime.Unix(),
+			Addresses:     make([]string, 0, len(pendingTransaction.Addresses)),
+			Transaction:   NewTransaction(pendingTransaction.Transaction),
+		}
+		for _, address := range pendingTransaction.Addresses {
+			mempoolTransaction.Addresses = append(mempoolTransaction.Addresses, address.Base58())
+		}
+
+		response.Transactions = append(response.Transactions, mempoolTransaction)
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetLedgerCachesResponse //////////////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerCachesResponse represents the JSON model of a response from the GetLedgerCaches endpoint.
+type GetLedgerCachesResponse struct {
+	Caches map[string]*LedgerCache `json:"caches"`
+	Error  string                  `json:"error,omitempty"`
+}
+
+// LedgerCache represents the JSON model of the settings and statistics of the cache of a storage of the ledger.
+type LedgerCache struct {
+	CacheTime string  `json:"cacheTime"`
+	MaxSize   int     `json:"maxSize"`
+	Size      int     `json:"size"`
+	Lookups   uint64  `json:"lookups"`
+	Hits      uint64  `json:"hits"`
+	HitRate   float64 `json:"hitRate"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// NewLedgerCache returns a LedgerCache from the given CacheStatistics.
+func NewLedgerCache(cacheStatistics mempool.CacheStatistics) *LedgerCache {
+	return &LedgerCache{
+		CacheTime: cacheStatistics.CacheTime.String(),
+		MaxSize:   cacheStatistics.MaxSize,
+		Size:      cacheStatistics.Size,
+		Lookups:   cacheStatistics.Lookups,
+		Hits:      cacheStatistics.Hits,
+		HitRate:   cacheStatistics.HitRate(),
+	}
+}
+
+// PutLedgerCacheRequest represents the JSON model of a request to the PutLedgerCache endpoint (omitted fields keep
+// their current value).
+type PutLedgerCacheRequest struct {
+	CacheTime string `json:"cacheTime,omitempty"`
+	MaxSize   *int   `json:"maxSize,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetColorSuppliesResponse /////////////////////////////////////////////////////////////////////////////////////
+
+// GetColorSuppliesResponse represents the JSON model of a response from the GetColorSupplies endpoint.
+type GetColorSuppliesResponse struct {
+	ColorSupplies []*ColorSupply `json:"colorSupplies"`
+}
+
+// ColorSupply represents the JSON model of the minted and melted supply of a color.
+type ColorSupply struct {
+	Color         string `json:"color"`
+	Minted        uint64 `json:"minted"`
+	Melted        uint64 `json:"melted"`
+	Circulating   uint64 `json:"circulating"`
+	PendingMinted uint64 `json:"pendingMinted"`
+	PendingMelted uint64 `json:"pendingMelted"`
+}
+
+// NewGetColorSuppliesResponse returns a GetColorSuppliesResponse from the given supplies.
+func NewGetColorSuppliesResponse(supplies []indexer.ColorSupply) *GetColorSuppliesResponse {
+	return &GetColorSuppliesResponse{
+		ColorSupplies: lo.Map(supplies, func(supply indexer.ColorSupply) *ColorSupply {
+			return &ColorSupply{
+				Color:         supply.Color.Base58(),
+				Minted:        supply.Minted,
+				Melted:        supply.Melted,
+				Circulating:   supply.Circulating(),
+				PendingMinted: supply.PendingMinted,
+				PendingMelted: supply.PendingMelted,
+			}
+		}),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAliasChainResponse ////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAliasChainResponse represents the JSON model of a response from the GetAliasChain endpoint.
+type GetAliasChainResponse struct {
+	AliasAddress string             `json:"aliasAddress"`
+	Transitions  []*AliasTransition `json:"transitions"`
+}
+
+// AliasTransition represents the JSON model of a state or governance transition of the chain of an alias.
+type AliasTransition struct {
+	OutputID           string             `json:"outputID"`
+	PreviousOutputID   string             `json:"previousOutputID,omitempty"`
+	Position           int                `json:"position"`
+	StateIndex         uint32             `json:"stateIndex"`
+	IsGovernanceUpdate bool               `json:"isGovernanceUpdate"`
+	ConfirmationState  confirmation.State `json:"confirmationState"`
+	Output             *Output            `json:"output"`
+}
+
+// NewGetAliasChainResponse returns a GetAliasChainResponse from the given details.
+func NewGetAliasChainResponse(aliasAddress *devnetvm.AliasAddress, transitions []*indexer.AliasTransition) *GetAliasChainResponse {
+	return &GetAliasChainResponse{
+		AliasAddress: aliasAddress.Base58(),
+		Transitions: lo.Map(transitions, func(transition *indexer.AliasTransition) *AliasTransition {
+			aliasTransition := &AliasTransition{
+				OutputID:           transition.OutputID.Base58(),
+				Position:           transition.Position,
+				StateIndex:         transition.Output.GetStateIndex(),
+				IsGovernanceUpdate: transition.IsGovernanceUpdate(),
+				ConfirmationState:  transition.ConfirmationState,
+				Output:             NewOutput(transition.Output),
+			}
+			if transition.PreviousOutputID != utxo.EmptyOutputID {
+				aliasTransition.PreviousOutputID = transition.PreviousOutputID.Base58()
+			}
+
+			return aliasTransition
+		}),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetLedgerStatsResponse ///////////////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerStatsResponse represents the JSON model of a response from the GetLedgerStats endpoint.
+type GetLedgerStatsResponse struct {
+	UnspentOutputs  uint64            `json:"unspentOutputs"`
+	DustOutputs     uint64            `json:"dustOutputs"`
+	BalancesByColor map[string]uint64 `json:"balancesByColor"`
+	DailyGrowthRate float64           `json:"dailyGrowthRate"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetOutputConsumersResponse ///////////////////////////////////////////////////////////////////////////////////
 
 // GetOutputConsumersResponse represents the JSON model of a response from the GetOutputConsumers endpoint.
@@ -164,12 +584,84 @@ func NewGetOutputConsumersResponse(outputID utxo.OutputID, consumers []*mempool.
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetOutputProofResponse ///////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputProofResponse represents the JSON model of a response from the GetOutputProof endpoint.
+type GetOutputProofResponse struct {
+	OutputID     string `json:"outputID"`
+	CommitmentID string `json:"commitmentID"`
+	StateRoot    string `json:"stateRoot"`
+	Proof        string `json:"proof"`
+}
+
+// NewGetOutputProofResponse returns a GetOutputProofResponse from the given Proof.
+func NewGetOutputProofResponse(proof *outputproof.Proof) (response *GetOutputProofResponse, err error) {
+	proofBytes, err := proof.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize proof")
+	}
+
+	return &GetOutputProofResponse{
+		OutputID:     proof.OutputID().Base58(),
+		CommitmentID: proof.Commitment().ID().Base58(),
+		StateRoot:    proof.Roots().StateRoot().Base58(),
+		Proof:        base58.Encode(proofBytes),
+	}, nil
+}
+
+// OutputProof decodes the Proof of the GetOutputProofResponse.
+func (g *GetOutputProofResponse) OutputProof() (proof *outputproof.Proof, err error) {
+	proofBytes, err := base58.Decode(g.Proof)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode proof")
+	}
+
+	proof = new(outputproof.Proof)
+	if _, err = proof.FromBytes(proofBytes); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proof")
+	}
+
+	return proof, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetOutputVestingResponse /////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputVestingResponse represents the JSON model of a response from the GetOutputVesting endpoint.
+type GetOutputVestingResponse struct {
+	OutputID       string          `json:"outputID"`
+	Time           int64           `json:"time"`
+	LockedAmount   uint64          `json:"lockedAmount"`
+	UnlockedAmount uint64          `json:"unlockedAmount"`
+	NextUnlock     *VestingTranche `json:"nextUnlock,omitempty"`
+}
+
+// NewGetOutputVestingResponse returns a GetOutputVestingResponse of the given VestingOutput at the given time.
+func NewGetOutputVestingResponse(output *devnetvm.VestingOutput, nowis time.Time) *GetOutputVestingResponse {
+	iotaBalance, _ := output.Balances().Get(devnetvm.ColorIOTA)
+	response := &GetOutputVestingResponse{
+		OutputID:       output.ID().Base58(),
+		Time:           nowis.Unix(),
+		LockedAmount:   output.LockedAmount(nowis),
+		UnlockedAmount: iotaBalance - output.LockedAmount(nowis),
+	}
+	if nextUnlock, exists := output.NextUnlock(nowis); exists {
+		response.NextUnlock = &VestingTranche{Time: nextUnlock.Time.Unix(), Amount: nextUnlock.Amount}
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetTransactionAttachmentsResponse ////////////////////////////////////////////////////////////////////////////
 
 // GetTransactionAttachmentsResponse represents the JSON model of a response from the GetTransactionAttachments endpoint.
 type GetTransactionAttachmentsResponse struct {
-	TransactionID string   `json:"transactionID"`
-	BlockIDs      []string `json:"blockIDs"`
+	TransactionID string                   `json:"transactionID"`
+	BlockIDs      []string                 `json:"blockIDs"`
+	Attachments   []*TransactionAttachment `json:"attachments,omitempty"`
 }
 
 // NewGetTransactionAttachmentsResponse returns a GetTransactionAttachmentsResponse from the given details.
@@ -185,6 +677,102 @@ func NewGetTransactionAttachmentsResponse(transactionID utxo.TransactionID, bloc
 	}
 }
 
+// TransactionAttachment represents the JSON model of the status of a block that contains a transaction.
+type TransactionAttachment struct {
+	BlockID           string             `json:"blockID"`
+	ConfirmationState confirmation.State `json:"confirmationState"`
+	SchedulerStatus   string             `json:"schedulerStatus"`
+	Orphaned          bool               `json:"orphaned"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SimulateTransactionOutcomesResponse //////////////////////////////////////////////////////////////////////////
+
+// SimulateTransactionOutcomesResponse represents the JSON model of a response from the SimulateTransactionOutcomes
+// endpoint.
+type SimulateTransactionOutcomesResponse struct {
+	TransactionID string                `json:"transactionID"`
+	Outcomes      []*TransactionOutcome `json:"outcomes"`
+	Truncated     bool                  `json:"truncated"`
+}
+
+// TransactionOutcome represents the JSON model of a possible resolution of the conflicts of a transaction.
+type TransactionOutcome struct {
+	Accepted       bool                       `json:"accepted"`
+	Winners        []*ConflictSetWinner       `json:"winners"`
+	Inputs         []*TransactionOutcomeInput `json:"inputs"`
+	CreatedOutputs []string                   `json:"createdOutputs"`
+}
+
+// ConflictSetWinner represents the JSON model of the member of a pending conflict set that wins in a TransactionOutcome.
+type ConflictSetWinner struct {
+	ConflictSetID string `json:"conflictSetID"`
+	ConflictID    string `json:"conflictID"`
+	Weight        int64  `json:"weight"`
+}
+
+// TransactionOutcomeInput represents the JSON model of the fate of an input of a transaction in a TransactionOutcome.
+type TransactionOutcomeInput struct {
+	OutputID string `json:"outputID"`
+	Exists   bool   `json:"exists"`
+	SpentBy  string `json:"spentBy,omitempty"`
+}
+
+// NewSimulateTransactionOutcomesResponse returns a SimulateTransactionOutcomesResponse from the given details. The
+// outcomes are expected to report the survival of the conflicts of the transaction first, followed by the survival of
+// the conflicts of each of the inputs (in the given order).
+func NewSimulateTransactionOutcomesResponse(transactionID utxo.TransactionID, inputIDs []utxo.OutputID, outputIDs utxo.OutputIDs, outcomes []*conflictdag.ResolutionOutcome[utxo.TransactionID, utxo.OutputID], truncated bool) *SimulateTransactionOutcomesResponse {
+	response := &SimulateTransactionOutcomesResponse{
+		TransactionID: transactionID.Base58(),
+		Outcomes:      make([]*TransactionOutcome, 0, len(outcomes)),
+		Truncated:     truncated,
+	}
+
+	for _, outcome := range outcomes {
+		transactionOutcome := &TransactionOutcome{
+			Accepted:       outcome.Survives[0],
+			Winners:        make([]*ConflictSetWinner, 0, len(outcome.Winners)),
+			Inputs:         make([]*TransactionOutcomeInput, 0, len(inputIDs)),
+			CreatedOutputs: make([]string, 0),
+		}
+
+		winners := make(map[utxo.OutputID]utxo.TransactionID)
+		for _, winner := range outcome.Winners {
+			winners[winner.ConflictSetID] = winner.ConflictID
+			transactionOutcome.Winners = append(transactionOutcome.Winners, &ConflictSetWinner{
+				ConflictSetID: winner.ConflictSetID.Base58(),
+				ConflictID:    winner.ConflictID.Base58(),
+				Weight:        winner.Weight,
+			})
+		}
+
+		for i, inputID := range inputIDs {
+			input := &TransactionOutcomeInput{
+				OutputID: inputID.Base58(),
+				Exists:   outcome.Survives[i+1],
+			}
+			if winnerID, contested := winners[inputID]; transactionOutcome.Accepted {
+				input.SpentBy = transactionID.Base58()
+			} else if contested && input.Exists {
+				input.SpentBy = winnerID.Base58()
+			}
+
+			transactionOutcome.Inputs = append(transactionOutcome.Inputs, input)
+		}
+
+		if transactionOutcome.Accepted {
+			for it := outputIDs.Iterator(); it.HasNext(); {
+				transactionOutcome.CreatedOutputs = append(transactionOutcome.CreatedOutputs, it.Next().Base58())
+			}
+		}
+
+		response.Outcomes = append(response.Outcomes, transactionOutcome)
+	}
+
+	return response
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region PostPayloadRequest ///////////////////////////////////////////////////////////////////////////////////////////
@@ -200,7 +788,8 @@ type PostPayloadRequest struct {
 
 // PostPayloadResponse represents the JSON model of a PostPayload response.
 type PostPayloadResponse struct {
-	ID string `json:"id"`
+	ID       string            `json:"id"`
+	Advisory *IssuanceAdvisory `json:"advisory,omitempty"`
 }
 
 // NewPostPayloadResponse returns a PostPayloadResponse from the given tangleold.Block.
@@ -212,6 +801,26 @@ func NewPostPayloadResponse(block *models.Block) *PostPayloadResponse {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetOutputMinimumDepositResponse /////////////////////////////////////////////////////////////////////////////
+
+// GetOutputMinimumDepositResponse is the HTTP response containing the minimum deposit of a prospective output.
+type GetOutputMinimumDepositResponse struct {
+	MinimumDeposit uint64 `json:"minimumDeposit"`
+	Deposit        uint64 `json:"deposit"`
+	Sufficient     bool   `json:"sufficient"`
+}
+
+// NewGetOutputMinimumDepositResponse returns a GetOutputMinimumDepositResponse for the given deposit of an output.
+func NewGetOutputMinimumDepositResponse(minimumDeposit, deposit uint64) *GetOutputMinimumDepositResponse {
+	return &GetOutputMinimumDepositResponse{
+		MinimumDeposit: minimumDeposit,
+		Deposit:        deposit,
+		Sufficient:     deposit >= minimumDeposit,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostTransaction Req/Resp /////////////////////////////////////////////////////////////////////////////////////
 
 // PostTransactionRequest holds the transaction object(bytes) to send.
@@ -221,9 +830,234 @@ type PostTransactionRequest struct {
 
 // PostTransactionResponse is the HTTP response from sending transaction.
 type PostTransactionResponse struct {
-	TransactionID string `json:"transaction_id,omitempty"`
-	BlockID       string `json:"block_id,omitempty"`
-	Error         string `json:"error,omitempty"`
+	TransactionID   string            `json:"transaction_id,omitempty"`
+	BlockID         string            `json:"block_id,omitempty"`
+	Advisory        *IssuanceAdvisory `json:"advisory,omitempty"`
+	State           string            `json:"state,omitempty"`
+	RejectionReason string            `json:"rejectionReason,omitempty"`
+	TimedOut        bool              `json:"timedOut,omitempty"`
+	Error           string            `json:"error,omitempty"`
+	ErrorCode       string            `json:"errorCode,omitempty"`
+}
+
+// NewPostTransactionErrorResponse returns a PostTransactionResponse for the given error (it contains the name of the
+// vm.ValidationError if the transaction was found to be invalid).
+func NewPostTransactionErrorResponse(err error) *PostTransactionResponse {
+	response := &PostTransactionResponse{Error: err.Error()}
+	if validationError, exists := vm.ValidationErrorFromError(err); exists {
+		response.ErrorCode = validationError.String()
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostOutputs Req/Resp /////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOutputsRequest is the request object for the /ledgerstate/outputs endpoint.
+type PostOutputsRequest struct {
+	OutputIDs []string `json:"outputIDs"`
+}
+
+// PostOutputsResponse is the response object for the /ledgerstate/outputs endpoint.
+type PostOutputsResponse struct {
+	Outputs map[string]*OutputWithMetadata `json:"outputs"`
+	Missing []string                       `json:"missing"`
+}
+
+// OutputWithMetadata represents the JSON model of an output together with its metadata.
+type OutputWithMetadata struct {
+	Output   *Output         `json:"output"`
+	Metadata *OutputMetadata `json:"metadata,omitempty"`
+}
+
+// NewPostOutputsResponse returns a PostOutputsResponse that contains the found outputs keyed by their ID and lists the
+// requested IDs that are unknown.
+func NewPostOutputsResponse(outputIDs []utxo.OutputID, outputs *utxo.Outputs, outputsMetadata *mempool.OutputsMetadata, confirmedConsumer func(utxo.OutputID) utxo.TransactionID) *PostOutputsResponse {
+	response := &PostOutputsResponse{
+		Outputs: make(map[string]*OutputWithMetadata),
+		Missing: make([]string, 0),
+	}
+
+	for _, outputID := range outputIDs {
+		output, exists := outputs.Get(outputID)
+		if !exists {
+			response.Missing = append(response.Missing, outputID.Base58())
+			continue
+		}
+
+		outputWithMetadata := &OutputWithMetadata{Output: NewOutput(output.(devnetvm.Output))}
+		if outputMetadata, metadataExists := outputsMetadata.Get(outputID); metadataExists {
+			outputWithMetadata.Metadata = NewOutputMetadata(outputMetadata, confirmedConsumer(outputID))
+		}
+
+		response.Outputs[outputID.Base58()] = outputWithMetadata
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DryRunTransactionResponse ////////////////////////////////////////////////////////////////////////////////////
+
+// DryRunTransactionResponse represents the JSON model of a response from the DryRunTransaction endpoint.
+type DryRunTransactionResponse struct {
+	TransactionID    string            `json:"transactionID"`
+	Solid            bool              `json:"solid"`
+	Valid            bool              `json:"valid"`
+	Inputs           []*DryRunInput    `json:"inputs"`
+	Outputs          []*Output         `json:"outputs"`
+	ConsumedBalances map[string]uint64 `json:"consumedBalances"`
+	CreatedBalances  map[string]uint64 `json:"createdBalances"`
+	Cost             *ExecutionCost    `json:"cost"`
+	Error            string            `json:"error,omitempty"`
+	ErrorCode        string            `json:"errorCode,omitempty"`
+}
+
+// ExecutionCost represents the JSON model of the resources that were consumed by the execution of a transaction.
+type ExecutionCost struct {
+	Gas  uint64 `json:"gas"`
+	Size uint64 `json:"size"`
+}
+
+// DryRunInput represents the JSON model of an input of a transaction that was executed without being stored.
+type DryRunInput struct {
+	OutputID string  `json:"outputID"`
+	Output   *Output `json:"output,omitempty"`
+	Spent    bool    `json:"spent"`
+}
+
+// NewDryRunTransactionResponse returns a DryRunTransactionResponse from the given result of a dry run.
+func NewDryRunTransactionResponse(tx *devnetvm.Transaction, result *mempool.DryRunResult) *DryRunTransactionResponse {
+	response := &DryRunTransactionResponse{
+		TransactionID:    tx.ID().Base58(),
+		Solid:            result.Solid(),
+		Valid:            result.Valid(),
+		Inputs:           make([]*DryRunInput, 0, result.InputIDs.Size()),
+		Outputs:          make([]*Output, 0),
+		ConsumedBalances: make(map[string]uint64),
+		CreatedBalances:  make(map[string]uint64),
+		Cost:             &ExecutionCost{Gas: result.Cost.Gas, Size: result.Cost.Size},
+	}
+
+	if result.Err != nil {
+		errorResponse := NewPostTransactionErrorResponse(result.Err)
+		response.Error, response.ErrorCode = errorResponse.Error, errorResponse.ErrorCode
+	}
+
+	for it := result.InputIDs.Iterator(); it.HasNext(); {
+		inputID := it.Next()
+
+		input := &DryRunInput{OutputID: inputID.Base58()}
+		if result.Inputs != nil {
+			if output, exists := result.Inputs.Get(inputID); exists {
+				input.Output = NewOutput(output.(devnetvm.Output))
+				addStringBalances(response.ConsumedBalances, output.(devnetvm.Output))
+			}
+		}
+		if result.InputsMetadata != nil {
+			if outputMetadata, exists := result.InputsMetadata.Get(inputID); exists {
+				input.Spent = outputMetadata.IsSpent()
+			}
+		}
+
+		response.Inputs = append(response.Inputs, input)
+	}
+
+	if result.Outputs != nil {
+		_ = result.Outputs.ForEach(func(output utxo.Output) error {
+			response.Outputs = append(response.Outputs, NewOutput(output.(devnetvm.Output)))
+			addStringBalances(response.CreatedBalances, output.(devnetvm.Output))
+
+			return nil
+		})
+	}
+
+	return response
+}
+
+// addStringBalances adds the colored balances of the given output to the given map[string]uint64.
+func addStringBalances(stringBalances map[string]uint64, output devnetvm.Output) {
+	for color, balance := range getStringBalances(output) {
+		stringBalances[color] += balance
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Aliases Req/Resp /////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// AliasTypeTransaction is the type of the aliases of transactions (and the conflicts that they create).
+	AliasTypeTransaction = "transaction"
+
+	// AliasTypeOutput is the type of the aliases of outputs (and the conflict sets that they create).
+	AliasTypeOutput = "output"
+)
+
+// Alias represents the JSON model of a human-readable alias of a transaction or output.
+type Alias struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Alias string `json:"alias"`
+}
+
+// GetAliasesResponse represents the JSON model of a response from the GetAliases endpoint.
+type GetAliasesResponse struct {
+	Aliases []*Alias `json:"aliases"`
+}
+
+// NewGetAliasesResponse returns a GetAliasesResponse that contains all registered aliases.
+func NewGetAliasesResponse() *GetAliasesResponse {
+	aliases := make([]*Alias, 0, utxo.TransactionIDAliases.Size()+utxo.OutputIDAliases.Size())
+	utxo.TransactionIDAliases.ForEach(func(txID utxo.TransactionID, alias string) bool {
+		aliases = append(aliases, &Alias{Type: AliasTypeTransaction, ID: txID.Base58(), Alias: alias})
+		return true
+	})
+	utxo.OutputIDAliases.ForEach(func(outputID utxo.OutputID, alias string) bool {
+		aliases = append(aliases, &Alias{Type: AliasTypeOutput, ID: outputID.Base58(), Alias: alias})
+		return true
+	})
+
+	return &GetAliasesResponse{
+		Aliases: aliases,
+	}
+}
+
+// PostAliasRequest represents the JSON model of a request that registers an alias (an empty alias unregisters it).
+type PostAliasRequest = Alias
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetArtifactsResponse /////////////////////////////////////////////////////////////////////////////////////////
+
+// GetArtifactsResponse represents the JSON model of a response from the GetArtifacts endpoint.
+type GetArtifactsResponse struct {
+	Artifacts []*Artifact `json:"artifacts"`
+}
+
+// Artifact represents the JSON model of a node-local artifact that was created through the API.
+type Artifact struct {
+	Kind      string `json:"kind"`
+	ID        string `json:"id"`
+	CreatedAt int64  `json:"createdAt"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// NewGetArtifactsResponse returns a GetArtifactsResponse from the given artifacts.
+func NewGetArtifactsResponse(trackedArtifacts []*artifacts.Artifact) *GetArtifactsResponse {
+	return &GetArtifactsResponse{
+		Artifacts: lo.Map(trackedArtifacts, func(artifact *artifacts.Artifact) *Artifact {
+			return &Artifact{
+				Kind:      string(artifact.Kind),
+				ID:        artifact.ID,
+				CreatedAt: artifact.CreatedAt.Unix(),
+				ExpiresAt: artifact.ExpiresAt.Unix(),
+			}
+		}),
+	}
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -254,7 +1088,55 @@ type GetUnspentOutputResponse struct {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetLedgerUnspentOutputsResponse //////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerUnspentOutputsResponse represents the JSON model of a response from the GetLedgerUnspentOutputs endpoint.
+type GetLedgerUnspentOutputsResponse struct {
+	Outputs []*UnspentOutput `json:"outputs"`
+	Cursor  string           `json:"cursor,omitempty"`
+}
+
+// NewGetLedgerUnspentOutputsResponse returns a GetLedgerUnspentOutputsResponse from the given details.
+func NewGetLedgerUnspentOutputsResponse(outputs []*UnspentOutput, cursor utxo.OutputID) *GetLedgerUnspentOutputsResponse {
+	response := &GetLedgerUnspentOutputsResponse{
+		Outputs: outputs,
+	}
+
+	if cursor != utxo.EmptyOutputID {
+		response.Cursor = cursor.Base58()
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // GetSnapshotRequest represents the JSON model of a GetSnapshot request.
 type GetSnapshotRequest struct {
 	SlotIndex uint64 `query:"index"`
+	Target    string `query:"target"`
 }
+
+// GetSnapshotResponse represents the JSON model of a GetSnapshot response (if the snapshot was written to a target).
+type GetSnapshotResponse struct {
+	Target string `json:"target,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// CreateBackupResponse represents the JSON model of a CreateBackup response.
+type CreateBackupResponse struct {
+	Directory string `json:"directory,omitempty"`
+	Location  string `json:"location,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// region GraphQLRequest ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GraphQLRequest represents the JSON model of a request to the /graphql endpoint.
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
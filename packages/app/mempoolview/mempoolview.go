@@ -0,0 +1,240 @@
+package mempoolview
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// region View /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// View keeps track of the Transactions that were booked but that were neither accepted nor rejected yet. It indexes
+// them by the addresses of their outputs, so that the recipients (i.e. exchanges) can track incoming deposits before
+// they are accepted.
+//
+// The View is bounded: if it reaches its maximum size, the Transactions that have been pending for the longest time
+// are evicted from the View (they stay in the MemPool).
+type View struct {
+	transactions map[utxo.TransactionID]*list.Element
+	order        *list.List
+	addresses    map[string]map[utxo.TransactionID]*PendingTransaction
+	metrics      Metrics
+	mutex        sync.RWMutex
+
+	optsMaxSize int
+}
+
+// New creates a new View.
+func New(opts ...options.Option[View]) *View {
+	return options.Apply(&View{
+		transactions: make(map[utxo.TransactionID]*list.Element),
+		order:        list.New(),
+		addresses:    make(map[string]map[utxo.TransactionID]*PendingTransaction),
+		optsMaxSize:  10000,
+	}, opts)
+}
+
+// Add adds the given booked Transaction to the View.
+func (v *View) Add(transaction *devnetvm.Transaction, bookedTime time.Time) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if _, exists := v.transactions[transaction.ID()]; exists {
+		return
+	}
+
+	if v.order.Len() >= v.optsMaxSize {
+		v.remove(v.order.Front().Value.(*PendingTransaction).ID)
+		v.metrics.Evicted++
+	}
+
+	pendingTransaction := newPendingTransaction(transaction, bookedTime)
+	v.transactions[pendingTransaction.ID] = v.order.PushBack(pendingTransaction)
+	for _, address := range pendingTransaction.Addresses {
+		addressTransactions, exists := v.addresses[address.Base58()]
+		if !exists {
+			addressTransactions = make(map[utxo.TransactionID]*PendingTransaction)
+			v.addresses[address.Base58()] = addressTransactions
+		}
+		addressTransactions[pendingTransaction.ID] = pendingTransaction
+	}
+
+	v.metrics.Added++
+}
+
+// Accepted removes the given Transaction from the View because it was accepted.
+func (v *View) Accepted(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.remove(transactionID) {
+		v.metrics.Accepted++
+	}
+}
+
+// Rejected removes the given Transaction from the View because it was rejected.
+func (v *View) Rejected(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.remove(transactionID) {
+		v.metrics.Rejected++
+	}
+}
+
+// Orphaned removes the given Transaction from the View because it was orphaned.
+func (v *View) Orphaned(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.remove(transactionID) {
+		v.metrics.Orphaned++
+	}
+}
+
+// Transactions returns the pending Transactions in the order in which they were booked (starting at the given offset)
+// together with the total number of pending Transactions.
+func (v *View) Transactions(offset, limit int) (transactions []*PendingTransaction, total int) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	transactions = make([]*PendingTransaction, 0)
+	index := 0
+	for element := v.order.Front(); element != nil && len(transactions) < limit; element = element.Next() {
+		if index >= offset {
+			transactions = append(transactions, element.Value.(*PendingTransaction))
+		}
+		index++
+	}
+
+	return transactions, v.order.Len()
+}
+
+// TransactionsByAddress returns the pending Transactions that create outputs for the given address in the order in
+// which they were booked.
+func (v *View) TransactionsByAddress(address devnetvm.Address) (transactions []*PendingTransaction) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	transactions = make([]*PendingTransaction, 0)
+	addressTransactions := v.addresses[address.Base58()]
+	for element := v.order.Front(); element != nil && len(transactions) < len(addressTransactions); element = element.Next() {
+		if pendingTransaction := element.Value.(*PendingTransaction); addressTransactions[pendingTransaction.ID] != nil {
+			transactions = append(transactions, pendingTransaction)
+		}
+	}
+
+	return transactions
+}
+
+// Metrics returns the Metrics of the View.
+func (v *View) Metrics() (metrics Metrics) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	metrics = v.metrics
+	metrics.Size = v.order.Len()
+	metrics.MaxSize = v.optsMaxSize
+
+	return metrics
+}
+
+// remove removes the given Transaction from the View and returns true if it was contained.
+func (v *View) remove(transactionID utxo.TransactionID) (removed bool) {
+	element, exists := v.transactions[transactionID]
+	if !exists {
+		return false
+	}
+
+	pendingTransaction := v.order.Remove(element).(*PendingTransaction)
+	delete(v.transactions, transactionID)
+
+	for _, address := range pendingTransaction.Addresses {
+		if addressTransactions, addressExists := v.addresses[address.Base58()]; addressExists {
+			if delete(addressTransactions, transactionID); len(addressTransactions) == 0 {
+				delete(v.addresses, address.Base58())
+			}
+		}
+	}
+
+	return true
+}
+
+// WithMaxSize sets the maximum number of Transactions that are kept in the View.
+func WithMaxSize(maxSize int) options.Option[View] {
+	return func(v *View) {
+		v.optsMaxSize = maxSize
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PendingTransaction ///////////////////////////////////////////////////////////////////////////////////////////
+
+// PendingTransaction is a Transaction that was booked but neither accepted nor rejected yet.
+type PendingTransaction struct {
+	// ID is the ID of the Transaction.
+	ID utxo.TransactionID
+
+	// Transaction is the pending Transaction.
+	Transaction *devnetvm.Transaction
+
+	// BookedTime is the time at which the Transaction was booked.
+	BookedTime time.Time
+
+	// Addresses contains the (distinct) addresses of the outputs of the Transaction.
+	Addresses []devnetvm.Address
+}
+
+// newPendingTransaction creates a new PendingTransaction from the given Transaction.
+func newPendingTransaction(transaction *devnetvm.Transaction, bookedTime time.Time) (pendingTransaction *PendingTransaction) {
+	pendingTransaction = &PendingTransaction{
+		ID:          transaction.ID(),
+		Transaction: transaction,
+		BookedTime:  bookedTime,
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, output := range transaction.Essence().Outputs() {
+		if address := output.Address(); !seenAddresses[address.Base58()] {
+			seenAddresses[address.Base58()] = true
+			pendingTransaction.Addresses = append(pendingTransaction.Addresses, address)
+		}
+	}
+
+	return pendingTransaction
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Metrics //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Metrics contains the size of the View and the number of Transactions that were added and removed from it.
+type Metrics struct {
+	// Size is the number of Transactions that are currently pending.
+	Size int
+
+	// MaxSize is the maximum number of Transactions that are kept in the View.
+	MaxSize int
+
+	// Added is the number of Transactions that were added to the View.
+	Added uint64
+
+	// Accepted is the number of Transactions that were removed from the View because they were accepted.
+	Accepted uint64
+
+	// Rejected is the number of Transactions that were removed from the View because they were rejected.
+	Rejected uint64
+
+	// Orphaned is the number of Transactions that were removed from the View because they were orphaned.
+	Orphaned uint64
+
+	// Evicted is the number of Transactions that were evicted from the View because it reached its maximum size.
+	Evicted uint64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,79 @@
+package mempoolview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestView(t *testing.T) {
+	view := New(WithMaxSize(2))
+
+	exchange, other := randAddress(), randAddress()
+	deposit := newTransaction(t, exchange, other)
+	transfer := newTransaction(t, other)
+	secondDeposit := newTransaction(t, exchange)
+
+	view.Add(deposit, time.Now())
+	view.Add(transfer, time.Now())
+	view.Add(deposit, time.Now())
+
+	require.Equal(t, []utxo.TransactionID{deposit.ID()}, pendingTransactionIDs(view.TransactionsByAddress(exchange)))
+	require.Equal(t, []utxo.TransactionID{deposit.ID(), transfer.ID()}, pendingTransactionIDs(view.TransactionsByAddress(other)))
+
+	// the oldest transaction is evicted if the view reaches its maximum size
+	view.Add(secondDeposit, time.Now())
+	require.Equal(t, []utxo.TransactionID{secondDeposit.ID()}, pendingTransactionIDs(view.TransactionsByAddress(exchange)))
+	require.Equal(t, []utxo.TransactionID{transfer.ID()}, pendingTransactionIDs(view.TransactionsByAddress(other)))
+
+	transactions, total := view.Transactions(1, 10)
+	require.Equal(t, 2, total)
+	require.Equal(t, []utxo.TransactionID{secondDeposit.ID()}, pendingTransactionIDs(transactions))
+
+	view.Accepted(secondDeposit.ID())
+	view.Rejected(transfer.ID())
+	view.Orphaned(deposit.ID())
+
+	require.Empty(t, view.TransactionsByAddress(exchange))
+	require.Empty(t, view.TransactionsByAddress(other))
+	require.Equal(t, Metrics{
+		MaxSize:  2,
+		Added:    3,
+		Accepted: 1,
+		Rejected: 1,
+		Evicted:  1,
+	}, view.Metrics())
+}
+
+func newTransaction(t *testing.T, addresses ...devnetvm.Address) *devnetvm.Transaction {
+	var inputID utxo.OutputID
+	require.NoError(t, inputID.FromRandomness())
+
+	outputs := make([]devnetvm.Output, 0)
+	for _, address := range addresses {
+		outputs = append(outputs, devnetvm.NewSigLockedSingleOutput(100, address))
+	}
+
+	essence := devnetvm.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, devnetvm.NewInputs(devnetvm.NewUTXOInput(inputID)), devnetvm.NewOutputs(outputs...))
+
+	return devnetvm.NewTransaction(essence, devnetvm.UnlockBlocks{devnetvm.NewReferenceUnlockBlock(0)})
+}
+
+func randAddress() devnetvm.Address {
+	return devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+}
+
+func pendingTransactionIDs(transactions []*PendingTransaction) (transactionIDs []utxo.TransactionID) {
+	transactionIDs = make([]utxo.TransactionID, 0)
+	for _, transaction := range transactions {
+		transactionIDs = append(transactionIDs, transaction.ID)
+	}
+
+	return transactionIDs
+}
@@ -0,0 +1,38 @@
+package rebroadcaster
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// Events represents events happening on a Rebroadcaster.
+type Events struct {
+	// BlockRebroadcast is triggered when an own block was advertised again because it was not referenced in time.
+	BlockRebroadcast *event.Event1[*BlockRebroadcastEvent]
+
+	// BlockRecovered is triggered when a rebroadcast block was referenced by another node.
+	BlockRecovered *event.Event1[*models.Block]
+
+	// BlockLost is triggered when a block was not referenced after the maximum number of rebroadcast attempts.
+	BlockLost *event.Event1[*models.Block]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		BlockRebroadcast: event.New1[*BlockRebroadcastEvent](),
+		BlockRecovered:   event.New1[*models.Block](),
+		BlockLost:        event.New1[*models.Block](),
+	}
+})
+
+// BlockRebroadcastEvent is the event that is triggered when a block was rebroadcast.
+type BlockRebroadcastEvent struct {
+	// Block contains the block that was rebroadcast.
+	Block *models.Block
+
+	// Attempt contains the number of the rebroadcast attempt (starting at 1).
+	Attempt int
+}
@@ -0,0 +1,228 @@
+package rebroadcaster
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// SendBlockFunc is a function which advertises a block to the neighbors.
+type SendBlockFunc = func(block *models.Block)
+
+// Rebroadcaster keeps track of the recently issued own blocks and advertises them again if they are not referenced by
+// any other node within a timeout (which indicates that they were lost during gossip).
+type Rebroadcaster struct {
+	// Events contains the Events of the Rebroadcaster.
+	Events *Events
+
+	localIdentityID identity.ID
+	sendBlockFunc   SendBlockFunc
+	trackedBlocks   map[models.BlockID]*trackedBlock
+	mutex           sync.Mutex
+
+	rebroadcastCount atomic.Uint64
+	recoveredCount   atomic.Uint64
+	lostCount        atomic.Uint64
+
+	running  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	optsTimeout      time.Duration
+	optsInterval     time.Duration
+	optsMaxAttempts  int
+	optsTimeProvider func() time.Time
+}
+
+// New creates a new Rebroadcaster that advertises the blocks of the given identity with the given function.
+func New(localIdentityID identity.ID, sendBlockFunc SendBlockFunc, opts ...options.Option[Rebroadcaster]) *Rebroadcaster {
+	return options.Apply(&Rebroadcaster{
+		Events:           NewEvents(),
+		localIdentityID:  localIdentityID,
+		sendBlockFunc:    sendBlockFunc,
+		trackedBlocks:    make(map[models.BlockID]*trackedBlock),
+		shutdown:         make(chan struct{}),
+		optsTimeout:      10 * time.Second,
+		optsInterval:     time.Second,
+		optsMaxAttempts:  3,
+		optsTimeProvider: time.Now,
+	}, opts)
+}
+
+// Track starts tracking the given own block until it is referenced by another node.
+func (r *Rebroadcaster) Track(block *models.Block) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.trackedBlocks[block.ID()]; !exists {
+		r.trackedBlocks[block.ID()] = &trackedBlock{
+			block:         block,
+			lastBroadcast: r.optsTimeProvider(),
+		}
+	}
+}
+
+// Observe processes a block that was attached to the Tangle and stops tracking the own blocks that it references.
+func (r *Rebroadcaster) Observe(block *models.Block) {
+	if block.IssuerID() == r.localIdentityID {
+		return
+	}
+
+	recoveredBlocks := make([]*models.Block, 0)
+
+	r.mutex.Lock()
+	for _, parentID := range block.Parents() {
+		if referencedBlock, exists := r.trackedBlocks[parentID]; exists {
+			delete(r.trackedBlocks, parentID)
+
+			if referencedBlock.attempts > 0 {
+				recoveredBlocks = append(recoveredBlocks, referencedBlock.block)
+			}
+		}
+	}
+	r.mutex.Unlock()
+
+	for _, recoveredBlock := range recoveredBlocks {
+		r.recoveredCount.Inc()
+		r.Events.BlockRecovered.Trigger(recoveredBlock)
+	}
+}
+
+// RebroadcastExpired advertises all tracked blocks again that were not referenced within the timeout and drops the
+// blocks that exceeded the maximum number of attempts.
+func (r *Rebroadcaster) RebroadcastExpired() {
+	now := r.optsTimeProvider()
+	rebroadcastBlocks := make([]*BlockRebroadcastEvent, 0)
+	lostBlocks := make([]*models.Block, 0)
+
+	r.mutex.Lock()
+	for blockID, tracked := range r.trackedBlocks {
+		if now.Sub(tracked.lastBroadcast) < r.optsTimeout {
+			continue
+		}
+
+		if tracked.attempts >= r.optsMaxAttempts {
+			delete(r.trackedBlocks, blockID)
+			lostBlocks = append(lostBlocks, tracked.block)
+
+			continue
+		}
+
+		tracked.attempts++
+		tracked.lastBroadcast = now
+		rebroadcastBlocks = append(rebroadcastBlocks, &BlockRebroadcastEvent{
+			Block:   tracked.block,
+			Attempt: tracked.attempts,
+		})
+	}
+	r.mutex.Unlock()
+
+	for _, rebroadcastEvent := range rebroadcastBlocks {
+		r.sendBlockFunc(rebroadcastEvent.Block)
+
+		r.rebroadcastCount.Inc()
+		r.Events.BlockRebroadcast.Trigger(rebroadcastEvent)
+	}
+
+	for _, lostBlock := range lostBlocks {
+		r.lostCount.Inc()
+		r.Events.BlockLost.Trigger(lostBlock)
+	}
+}
+
+// TrackedCount returns the number of own blocks that were not referenced, yet.
+func (r *Rebroadcaster) TrackedCount() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.trackedBlocks)
+}
+
+// RebroadcastCount returns the total number of rebroadcast attempts.
+func (r *Rebroadcaster) RebroadcastCount() uint64 {
+	return r.rebroadcastCount.Load()
+}
+
+// RecoveredCount returns the total number of blocks that were referenced after being rebroadcast.
+func (r *Rebroadcaster) RecoveredCount() uint64 {
+	return r.recoveredCount.Load()
+}
+
+// LostCount returns the total number of blocks that were not referenced after the maximum number of attempts.
+func (r *Rebroadcaster) LostCount() uint64 {
+	return r.lostCount.Load()
+}
+
+// Start starts the periodic job that rebroadcasts the expired blocks.
+func (r *Rebroadcaster) Start() {
+	// only start if not yet running
+	if r.running.CompareAndSwap(false, true) {
+		r.wg.Add(1)
+		go r.run()
+	}
+}
+
+// Shutdown shuts down the periodic job.
+func (r *Rebroadcaster) Shutdown() {
+	if r.running.CompareAndSwap(true, false) {
+		r.shutdown <- struct{}{}
+	}
+
+	r.wg.Wait()
+}
+
+func (r *Rebroadcaster) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.optsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-ticker.C:
+			r.RebroadcastExpired()
+		}
+	}
+}
+
+// trackedBlock contains the rebroadcast details of a tracked block.
+type trackedBlock struct {
+	block         *models.Block
+	lastBroadcast time.Time
+	attempts      int
+}
+
+// WithTimeout sets the time after which a block that was not referenced is rebroadcast.
+func WithTimeout(timeout time.Duration) options.Option[Rebroadcaster] {
+	return func(r *Rebroadcaster) {
+		r.optsTimeout = timeout
+	}
+}
+
+// WithInterval sets the interval in which the tracked blocks are checked.
+func WithInterval(interval time.Duration) options.Option[Rebroadcaster] {
+	return func(r *Rebroadcaster) {
+		r.optsInterval = interval
+	}
+}
+
+// WithMaxAttempts sets the maximum number of times a block is rebroadcast before it is considered lost.
+func WithMaxAttempts(maxAttempts int) options.Option[Rebroadcaster] {
+	return func(r *Rebroadcaster) {
+		r.optsMaxAttempts = maxAttempts
+	}
+}
+
+// WithTimeProvider sets the function that is used to retrieve the current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Rebroadcaster] {
+	return func(r *Rebroadcaster) {
+		r.optsTimeProvider = timeProvider
+	}
+}
@@ -0,0 +1,68 @@
+package rebroadcaster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestRebroadcaster(t *testing.T) {
+	localKeyPair := ed25519.GenerateKeyPair()
+	now := time.Now()
+
+	sentBlocks := make([]models.BlockID, 0)
+	r := New(identity.NewID(localKeyPair.PublicKey), func(block *models.Block) {
+		sentBlocks = append(sentBlocks, block.ID())
+	}, WithTimeout(10*time.Second), WithMaxAttempts(2), WithTimeProvider(func() time.Time {
+		return now
+	}))
+
+	referencedBlock := newBlock(t, localKeyPair.PublicKey)
+	recoveredBlock := newBlock(t, localKeyPair.PublicKey)
+	lostBlock := newBlock(t, localKeyPair.PublicKey)
+	r.Track(referencedBlock)
+	r.Track(recoveredBlock)
+	r.Track(lostBlock)
+	require.Equal(t, 3, r.TrackedCount())
+
+	// blocks of the local node do not count as references
+	r.Observe(newBlock(t, localKeyPair.PublicKey, referencedBlock.ID()))
+	require.Equal(t, 3, r.TrackedCount())
+
+	r.Observe(newBlock(t, ed25519.GenerateKeyPair().PublicKey, referencedBlock.ID()))
+	require.Equal(t, 2, r.TrackedCount())
+
+	now = now.Add(5 * time.Second)
+	r.RebroadcastExpired()
+	require.Empty(t, sentBlocks)
+
+	now = now.Add(5 * time.Second)
+	r.RebroadcastExpired()
+	require.ElementsMatch(t, []models.BlockID{recoveredBlock.ID(), lostBlock.ID()}, sentBlocks)
+	require.EqualValues(t, 2, r.RebroadcastCount())
+
+	r.Observe(newBlock(t, ed25519.GenerateKeyPair().PublicKey, recoveredBlock.ID()))
+	require.EqualValues(t, 1, r.RecoveredCount())
+	require.Equal(t, 1, r.TrackedCount())
+
+	now = now.Add(10 * time.Second)
+	r.RebroadcastExpired()
+	require.EqualValues(t, 3, r.RebroadcastCount())
+
+	now = now.Add(10 * time.Second)
+	r.RebroadcastExpired()
+	require.EqualValues(t, 1, r.LostCount())
+	require.Equal(t, 0, r.TrackedCount())
+}
+
+func newBlock(t *testing.T, issuer ed25519.PublicKey, strongParents ...models.BlockID) *models.Block {
+	var blockID models.BlockID
+	require.NoError(t, blockID.FromRandomness())
+
+	return models.NewEmptyBlock(blockID, models.WithIssuer(issuer), models.WithStrongParents(models.NewBlockIDs(strongParents...)))
+}
@@ -11,12 +11,14 @@ type CollectionLogEvents struct {
 	// TangleTimeSyncChanged defines the local sync status change event based on tangle time.
 	TangleTimeSyncChanged *event.Event1[*TangleTimeSyncChangedEvent]
 	SchedulerQuery        *event.Event1[*SchedulerQueryEvent]
+	SaturationQuery       *event.Event1[*SaturationQueryEvent]
 }
 
 func newCollectionLogEvents() *CollectionLogEvents {
 	return &CollectionLogEvents{
 		TangleTimeSyncChanged: event.New1[*TangleTimeSyncChangedEvent](),
 		SchedulerQuery:        event.New1[*SchedulerQueryEvent](),
+		SaturationQuery:       event.New1[*SaturationQueryEvent](),
 	}
 }
 
@@ -49,6 +51,11 @@ type SchedulerQueryEvent struct {
 	Time time.Time
 }
 
+// SaturationQueryEvent is used to trigger worker pool and GC saturation sampling for remote metric monitoring.
+type SaturationQueryEvent struct {
+	Time time.Time
+}
+
 // BlockFinalizedMetrics defines the transaction metrics record that is sent to remote logger.
 type BlockFinalizedMetrics struct {
 	Type                 string    `json:"type" bson:"type"`
@@ -158,3 +165,35 @@ type ConflictCountUpdate struct {
 	InitialFinalizedConflictCount    uint64 `json:"initialFinalizedConflictCount" bson:"initialFinalizedConflictCount"`
 	FinalizedConflictCountSinceStart uint64 `json:"finalizedConflictCountSinceStart" bson:"finalizedConflictCountSinceStart"`
 }
+
+// SaturationMetrics defines the worker pool and garbage collector saturation record that is sent to the remote logger.
+type SaturationMetrics struct {
+	Type         string `json:"type" bson:"type"`
+	NodeID       string `json:"nodeID" bson:"nodeID"`
+	Synced       bool   `json:"synced" bson:"synced"`
+	MetricsLevel uint8  `json:"metricsLevel" bson:"metricsLevel"`
+	// EventLoopQueueLength is the number of tasks waiting in the queues of all worker pools of the protocol.
+	EventLoopQueueLength uint32 `json:"eventLoopQueueLength" bson:"eventLoopQueueLength"`
+	// EventLoopPendingTasks is the number of tasks that are either queued or being executed by the protocol.
+	EventLoopPendingTasks uint32 `json:"eventLoopPendingTasks" bson:"eventLoopPendingTasks"`
+	// Components contains the saturation of the worker pools of the booker, the solidifier and the scheduler.
+	Components map[string]*WorkerPoolSaturation `json:"components" bson:"components"`
+	// NumGC is the number of completed GC cycles since the node started.
+	NumGC int64 `json:"numGC" bson:"numGC"`
+	// GCPauseTotal is the accumulated GC pause time in nanoseconds.
+	GCPauseTotal int64 `json:"gcPauseTotal" bson:"gcPauseTotal"`
+	// GCPauseLast is the duration of the last GC pause in nanoseconds.
+	GCPauseLast int64 `json:"gcPauseLast" bson:"gcPauseLast"`
+	// GCPauseMax is the longest of the recently recorded GC pauses in nanoseconds.
+	GCPauseMax int64     `json:"gcPauseMax" bson:"gcPauseMax"`
+	Timestamp  time.Time `json:"timestamp" bson:"timestamp"`
+}
+
+// WorkerPoolSaturation defines the saturation of the worker pools belonging to a single component.
+type WorkerPoolSaturation struct {
+	WorkerCount  uint32 `json:"workerCount" bson:"workerCount"`
+	PendingTasks uint32 `json:"pendingTasks" bson:"pendingTasks"`
+	QueueLength  uint32 `json:"queueLength" bson:"queueLength"`
+	// Utilization is the share of workers that are currently busy, in the range [0, 1].
+	Utilization float64 `json:"utilization" bson:"utilization"`
+}
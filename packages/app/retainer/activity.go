@@ -0,0 +1,183 @@
+package retainer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/objectstorage/generic/model"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
+)
+
+// region IssuerActivityID /////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerActivityID identifies the activity of an issuer in a slot.
+type IssuerActivityID struct {
+	SlotIndex slot.Index  `serix:"0"`
+	IssuerID  identity.ID `serix:"1"`
+}
+
+// NewIssuerActivityID returns a new IssuerActivityID for the given slot and issuer.
+func NewIssuerActivityID(index slot.Index, issuerID identity.ID) IssuerActivityID {
+	return IssuerActivityID{
+		SlotIndex: index,
+		IssuerID:  issuerID,
+	}
+}
+
+// Index returns the slot index of the IssuerActivityID.
+func (i IssuerActivityID) Index() slot.Index {
+	return i.SlotIndex
+}
+
+// Bytes returns a serialized version of the IssuerActivityID.
+func (i IssuerActivityID) Bytes() (serialized []byte, err error) {
+	return serix.DefaultAPI.Encode(context.Background(), i, serix.WithValidation())
+}
+
+// FromBytes deserializes an IssuerActivityID from a byte slice.
+func (i *IssuerActivityID) FromBytes(serialized []byte) (consumedBytes int, err error) {
+	return serix.DefaultAPI.Decode(context.Background(), serialized, i, serix.WithValidation())
+}
+
+// String returns a human-readable version of the IssuerActivityID.
+func (i IssuerActivityID) String() string {
+	return fmt.Sprintf("IssuerActivityID(%d, %s)", i.SlotIndex, i.IssuerID)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IssuerActivity ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerActivity contains the number of blocks that an issuer issued in a slot and the weight (consensus mana) of the
+// issuer at that time.
+type IssuerActivity struct {
+	model.Storable[IssuerActivityID, IssuerActivity, *IssuerActivity, issuerActivityModel] `serix:"0"`
+}
+
+type issuerActivityModel struct {
+	ID     IssuerActivityID `serix:"0"`
+	Blocks uint64           `serix:"1"`
+	Weight int64            `serix:"2"`
+}
+
+func newIssuerActivity(id IssuerActivityID, blocks uint64, weight int64) (i *IssuerActivity) {
+	i = model.NewStorable[IssuerActivityID, IssuerActivity](&issuerActivityModel{
+		ID:     id,
+		Blocks: blocks,
+		Weight: weight,
+	})
+	i.SetID(id)
+
+	return i
+}
+
+// WeightedActivity returns the weight of the issuer multiplied by the number of blocks it issued in the slot.
+func (i *IssuerActivity) WeightedActivity() uint64 {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.M.Weight <= 0 {
+		return 0
+	}
+
+	return uint64(i.M.Weight) * i.M.Blocks
+}
+
+func (i *IssuerActivity) Encode() ([]byte, error) {
+	return serix.DefaultAPI.Encode(context.Background(), i.M)
+}
+
+func (i *IssuerActivity) Decode(bytes []byte) (int, error) {
+	return serix.DefaultAPI.Decode(context.Background(), bytes, &i.M)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Retainer /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerActivities returns the activities of all issuers that issued blocks in the slot with the given index.
+func (r *Retainer) IssuerActivities(index slot.Index) (activities []*IssuerActivity) {
+	r.metadataEvictionLock.RLock(index)
+	defer r.metadataEvictionLock.RUnlock(index)
+
+	if slotStorage := r.cachedActivity.Get(index, false); slotStorage != nil {
+		slotStorage.ForEach(func(issuerID identity.ID, activity *cachedIssuerActivity) bool {
+			activities = append(activities, activity.storable(NewIssuerActivityID(index, issuerID)))
+			return true
+		})
+
+		return activities
+	}
+
+	_ = r.activityStorage.Iterate(index, func(id IssuerActivityID, activity IssuerActivity) bool {
+		activity.SetID(activity.M.ID)
+		activities = append(activities, &activity)
+		return true
+	})
+
+	return activities
+}
+
+// markIssuerActive records a block of the given issuer in the activity log of the given slot.
+func (r *Retainer) markIssuerActive(index slot.Index, issuerID identity.ID) {
+	r.metadataEvictionLock.RLock(index)
+	defer r.metadataEvictionLock.RUnlock(index)
+
+	if index < r.protocol.Engine().EvictionState.LastEvictedSlot() {
+		return
+	}
+
+	var weight int64
+	if issuerWeight, exists := r.protocol.Engine().SybilProtection.Weights().Get(issuerID); exists {
+		weight = issuerWeight.Value
+	}
+
+	activity, _ := r.cachedActivity.Get(index, true).GetOrCreate(issuerID, newCachedIssuerActivity)
+	activity.increase(weight)
+}
+
+func (r *Retainer) storeIssuerActivities(index slot.Index) {
+	for _, activity := range r.IssuerActivities(index) {
+		if err := r.activityStorage.Set(activity.ID(), *activity); err != nil {
+			panic(errors.Wrapf(err, "could not save %s to activity storage", activity.ID()))
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region cachedIssuerActivity /////////////////////////////////////////////////////////////////////////////////////////
+
+// cachedIssuerActivity is the in-memory version of an IssuerActivity that is used for slots that were not evicted yet.
+type cachedIssuerActivity struct {
+	blocks uint64
+	weight int64
+
+	sync.RWMutex
+}
+
+func newCachedIssuerActivity() *cachedIssuerActivity {
+	return &cachedIssuerActivity{}
+}
+
+func (c *cachedIssuerActivity) increase(weight int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.blocks++
+	c.weight = weight
+}
+
+func (c *cachedIssuerActivity) storable(id IssuerActivityID) *IssuerActivity {
+	c.RLock()
+	defer c.RUnlock()
+
+	return newIssuerActivity(id, c.blocks, c.weight)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
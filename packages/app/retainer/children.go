@@ -0,0 +1,109 @@
+package retainer
+
+import (
+	"sort"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
+// region Child ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Child is a block that approves a block by referencing it (or one of the blocks in its future cone) as a parent.
+type Child struct {
+	// ID is the ID of the approving block.
+	ID models.BlockID
+
+	// Type is the type of the reference through which the block was reached first.
+	Type models.ParentsType
+
+	// Depth is the number of references between the approved and the approving block (1 for direct children).
+	Depth int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Children /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Children returns a page of at most limit approvers of the block with the given ID that are reachable via at most
+// maxDepth references of the given types (all types if none are given) and that follow the given offset.
+//
+// The approvers are traversed in breadth-first order and every level is ordered by slot index and ID, so that the
+// pages of subsequent calls don't overlap. The traversal stops as soon as the requested page is complete, so hasMore
+// indicates whether there are further approvers after the returned page. Approvers whose metadata is no longer
+// retained are returned, but their own approvers are not traversed.
+func (r *Retainer) Children(blockID models.BlockID, maxDepth, offset, limit int, childTypes ...models.ParentsType) (children []*Child, hasMore bool, exists bool) {
+	metadata, exists := r.BlockMetadata(blockID)
+	if !exists || maxDepth < 1 || limit < 1 {
+		return nil, false, exists
+	}
+
+	if len(childTypes) == 0 {
+		childTypes = []models.ParentsType{models.StrongParentType, models.WeakParentType, models.ShallowLikeParentType}
+	}
+
+	visited := models.NewBlockIDs(blockID)
+	currentLevel := []*BlockMetadata{metadata}
+	for depth := 1; depth <= maxDepth && len(currentLevel) != 0; depth++ {
+		levelChildren := make([]*Child, 0)
+		for _, levelMetadata := range currentLevel {
+			for _, childType := range childTypes {
+				for childID := range levelMetadata.childrenByType(childType) {
+					if visited.Contains(childID) {
+						continue
+					}
+					visited.Add(childID)
+
+					levelChildren = append(levelChildren, &Child{ID: childID, Type: childType, Depth: depth})
+				}
+			}
+		}
+		sortChildren(levelChildren)
+
+		nextLevel := make([]*BlockMetadata, 0)
+		for _, child := range levelChildren {
+			if offset > 0 {
+				offset--
+			} else if len(children) == limit {
+				return children, true, true
+			} else {
+				children = append(children, child)
+			}
+
+			if depth < maxDepth {
+				if childMetadata, childExists := r.BlockMetadata(child.ID); childExists {
+					nextLevel = append(nextLevel, childMetadata)
+				}
+			}
+		}
+		currentLevel = nextLevel
+	}
+
+	return children, false, true
+}
+
+// childrenByType returns the children of the block that reference it with the given type.
+func (b *BlockMetadata) childrenByType(childType models.ParentsType) (children models.BlockIDs) {
+	switch childType {
+	case models.StrongParentType:
+		return b.M.StrongChildren
+	case models.WeakParentType:
+		return b.M.WeakChildren
+	case models.ShallowLikeParentType:
+		return b.M.LikedInsteadChildren
+	default:
+		return nil
+	}
+}
+
+// sortChildren orders the given children by their slot index and ID.
+func sortChildren(children []*Child) {
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].ID.Index() != children[j].ID.Index() {
+			return children[i].ID.Index() < children[j].ID.Index()
+		}
+
+		return children[i].ID.CompareTo(children[j].ID) < 0
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
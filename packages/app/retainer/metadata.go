@@ -66,6 +66,12 @@ func (c *cachedMetadata) setConfirmationBlock(block *blockgadget.Block) {
 	c.Confirmation = newBlockWithTime(block)
 }
 
+func (c *cachedMetadata) resetConfirmationBlock() {
+	c.Lock()
+	defer c.Unlock()
+	c.Confirmation = nil
+}
+
 func (c *cachedMetadata) setSchedulerBlock(block *scheduler.Block) {
 	c.Lock()
 	defer c.Unlock()
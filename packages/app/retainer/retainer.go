@@ -15,6 +15,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/core/memstorage"
 	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/runtime/event"
@@ -27,6 +28,8 @@ const (
 	prefixBlockMetadataStorage byte = iota
 
 	prefixCommitmentDetailsStorage
+
+	prefixIssuerActivityStorage
 )
 
 type Retainer struct {
@@ -37,6 +40,8 @@ type Retainer struct {
 	cachedMetadata       *memstorage.SlotStorage[models.BlockID, *cachedMetadata]
 	blockStorage         *database.PersistentSlotStorage[models.BlockID, BlockMetadata, *models.BlockID, *BlockMetadata]
 	commitmentStorage    *database.PersistentSlotStorage[commitment.ID, CommitmentDetails, *commitment.ID, *CommitmentDetails]
+	cachedActivity       *memstorage.SlotStorage[identity.ID, *cachedIssuerActivity]
+	activityStorage      *database.PersistentSlotStorage[IssuerActivityID, IssuerActivity, *IssuerActivityID, *IssuerActivity]
 
 	dbManager            *database.Manager
 	protocol             *protocol.Protocol
@@ -51,12 +56,14 @@ func NewRetainer(workers *workerpool.Group, protocol *protocol.Protocol, dbManag
 		blockWorkerPool:      workers.CreatePool("RetainerBlock", 2),
 		commitmentWorkerPool: workers.CreatePool("RetainerCommitment", 1),
 		cachedMetadata:       memstorage.NewSlotStorage[models.BlockID, *cachedMetadata](),
+		cachedActivity:       memstorage.NewSlotStorage[identity.ID, *cachedIssuerActivity](),
 		protocol:             protocol,
 		dbManager:            dbManager,
 		optsRealm:            []byte("retainer"),
 	}, opts, (*Retainer).setupEvents, func(r *Retainer) {
 		r.blockStorage = database.NewPersistentSlotStorage[models.BlockID, BlockMetadata](dbManager, append(r.optsRealm, []byte{prefixBlockMetadataStorage}...))
 		r.commitmentStorage = database.NewPersistentSlotStorage[commitment.ID, CommitmentDetails](dbManager, append(r.optsRealm, []byte{prefixCommitmentDetailsStorage}...))
+		r.activityStorage = database.NewPersistentSlotStorage[IssuerActivityID, IssuerActivity](dbManager, append(r.optsRealm, []byte{prefixIssuerActivityStorage}...))
 		r.metadataEvictionLock = syncutils.NewDAGMutex[slot.Index]()
 	})
 }
@@ -160,6 +167,8 @@ func (r *Retainer) setupEvents() {
 		if cm := r.createOrGetCachedMetadata(block.ID()); cm != nil {
 			cm.setBlockDAGBlock(block)
 		}
+
+		r.markIssuerActive(block.ID().Index(), block.IssuerID())
 	}, event.WithWorkerPool(r.blockWorkerPool))
 
 	r.protocol.Events.Engine.Tangle.Booker.BlockBooked.Hook(func(evt *booker.BlockBookedEvent) {
@@ -197,6 +206,12 @@ func (r *Retainer) setupEvents() {
 		}
 	}, event.WithWorkerPool(r.blockWorkerPool))
 
+	r.protocol.Events.Engine.Consensus.BlockGadget.BlockUnconfirmed.Hook(func(block *blockgadget.Block) {
+		if cm := r.createOrGetCachedMetadata(block.ID()); cm != nil {
+			cm.resetConfirmationBlock()
+		}
+	}, event.WithWorkerPool(r.blockWorkerPool))
+
 	r.protocol.Events.Engine.EvictionState.SlotEvicted.Hook(r.storeAndEvictSlot, event.WithWorkerPool(r.blockWorkerPool))
 
 	r.protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(e *notarization.SlotCommittedDetails) {
@@ -229,12 +244,14 @@ func (r *Retainer) storeAndEvictSlot(index slot.Index) {
 
 	// Now we store it to disk (slow).
 	r.storeBlockMetadata(metas)
+	r.storeIssuerActivities(index)
 
 	// Once everything is stored to disk, we evict it from cache.
 	// Therefore, we make sure that we can always first try to read BlockMetadata from cache and if it's not in cache
 	// anymore it is already written to disk.
 	r.metadataEvictionLock.Lock(index)
 	r.cachedMetadata.Evict(index)
+	r.cachedActivity.Evict(index)
 	r.metadataEvictionLock.Unlock(index)
 }
 
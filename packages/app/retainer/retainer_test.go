@@ -19,6 +19,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
@@ -188,6 +189,138 @@ func TestRetainer_BlockMetadata_Evicted(t *testing.T) {
 	require.Equal(t, meta.M.Accepted, false)
 }
 
+func TestRetainer_Children(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := protocol.NewTestFramework(t, workers.CreateGroup("ProtocolTestFramework"), new(devnetvm.VM))
+	tf.Instance.Run()
+
+	retainer := NewRetainer(workers.CreateGroup("Retainer"), tf.Instance, database.NewManager(0))
+
+	t.Cleanup(func() {
+		retainer.Shutdown()
+	})
+
+	tf.Engine.BlockDAG.CreateBlock("A")
+	tf.Engine.BlockDAG.CreateBlock("B", models.WithStrongParents(tf.Engine.BlockDAG.BlockIDs("A")))
+	tf.Engine.BlockDAG.CreateBlock("C", models.WithStrongParents(tf.Engine.BlockDAG.BlockIDs("A")))
+	tf.Engine.BlockDAG.CreateBlock("D", models.WithStrongParents(tf.Engine.BlockDAG.BlockIDs("B")), models.WithWeakParents(tf.Engine.BlockDAG.BlockIDs("C")))
+	tf.Engine.BlockDAG.CreateBlock("E", models.WithStrongParents(tf.Engine.BlockDAG.BlockIDs("D")))
+	tf.Engine.BlockDAG.IssueBlocks("A", "B", "C", "D", "E")
+
+	workers.WaitChildren()
+
+	require.Eventuallyf(t, func() bool {
+		meta, exists := retainer.BlockMetadata(tf.Engine.BlockDAG.Block("D").ID())
+
+		return exists && meta.M.StrongChildren.Contains(tf.Engine.BlockDAG.Block("E").ID())
+	}, 5*time.Second, 10*time.Millisecond, "block metadata should be available")
+
+	childIDs := func(children []*Child) (ids models.BlockIDs) {
+		ids = models.NewBlockIDs()
+		for _, child := range children {
+			ids.Add(child.ID)
+		}
+
+		return ids
+	}
+
+	children, hasMore, exists := retainer.Children(tf.Engine.BlockDAG.Block("A").ID(), 1, 0, 10)
+	require.True(t, exists)
+	require.False(t, hasMore)
+	require.Equal(t, tf.Engine.BlockDAG.BlockIDs("B", "C"), childIDs(children))
+
+	children, hasMore, exists = retainer.Children(tf.Engine.BlockDAG.Block("C").ID(), 1, 0, 10, models.StrongParentType)
+	require.True(t, exists)
+	require.False(t, hasMore)
+	require.Empty(t, children)
+
+	children, _, _ = retainer.Children(tf.Engine.BlockDAG.Block("C").ID(), 1, 0, 10, models.WeakParentType)
+	require.Len(t, children, 1)
+	require.Equal(t, tf.Engine.BlockDAG.Block("D").ID(), children[0].ID)
+	require.Equal(t, models.WeakParentType, children[0].Type)
+
+	allChildren, hasMore, _ := retainer.Children(tf.Engine.BlockDAG.Block("A").ID(), 5, 0, 10)
+	require.False(t, hasMore)
+	require.Len(t, allChildren, 4)
+	require.Equal(t, tf.Engine.BlockDAG.BlockIDs("B", "C"), childIDs(allChildren[:2]))
+	require.Equal(t, tf.Engine.BlockDAG.Block("D").ID(), allChildren[2].ID)
+	require.Equal(t, 2, allChildren[2].Depth)
+	require.Equal(t, tf.Engine.BlockDAG.Block("E").ID(), allChildren[3].ID)
+	require.Equal(t, 3, allChildren[3].Depth)
+
+	children, hasMore, _ = retainer.Children(tf.Engine.BlockDAG.Block("A").ID(), 2, 0, 10)
+	require.False(t, hasMore)
+	require.Equal(t, allChildren[:3], children)
+
+	firstPage, hasMore, _ := retainer.Children(tf.Engine.BlockDAG.Block("A").ID(), 5, 0, 3)
+	require.True(t, hasMore)
+	secondPage, hasMore, _ := retainer.Children(tf.Engine.BlockDAG.Block("A").ID(), 5, 3, 3)
+	require.False(t, hasMore)
+	require.Equal(t, allChildren, append(firstPage, secondPage...))
+
+	_, _, exists = retainer.Children(models.EmptyBlockID, 1, 0, 10)
+	require.False(t, exists)
+}
+
+func TestRetainer_IssuerActivity_Serialization(t *testing.T) {
+	activity := newIssuerActivity(NewIssuerActivityID(5, identity.GenerateIdentity().ID()), 3, 100)
+
+	serializedBytes, err := activity.Bytes()
+	require.NoError(t, err)
+
+	activityDeserialized := new(IssuerActivity)
+	decodedBytes, err := activityDeserialized.FromBytes(serializedBytes)
+	require.NoError(t, err)
+	require.Equal(t, len(serializedBytes), decodedBytes)
+	require.Equal(t, activity.M, activityDeserialized.M)
+	require.EqualValues(t, 300, activityDeserialized.WeightedActivity())
+
+	serializedID, err := activity.ID().Bytes()
+	require.NoError(t, err)
+
+	var idDeserialized IssuerActivityID
+	_, err = idDeserialized.FromBytes(serializedID)
+	require.NoError(t, err)
+	require.Equal(t, activity.ID(), idDeserialized)
+}
+
+func TestRetainer_IssuerActivities(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := protocol.NewTestFramework(t, workers.CreateGroup("ProtocolTestFramework"), new(devnetvm.VM))
+	tf.Instance.Run()
+
+	retainer := NewRetainer(workers.CreateGroup("Retainer"), tf.Instance, database.NewManager(0))
+
+	t.Cleanup(func() {
+		retainer.Shutdown()
+	})
+
+	issuingTime := tf.Instance.SlotTimeProvider().GenesisTime().Add(70 * time.Second)
+	issuer := identity.GenerateIdentity().PublicKey()
+	tf.Engine.BlockDAG.CreateBlock("A", models.WithIssuingTime(issuingTime), models.WithIssuer(issuer))
+	tf.Engine.BlockDAG.CreateBlock("B", models.WithStrongParents(tf.Engine.BlockDAG.BlockIDs("A")), models.WithIssuingTime(issuingTime), models.WithIssuer(issuer))
+	tf.Engine.BlockDAG.IssueBlocks("A", "B")
+
+	workers.WaitChildren()
+
+	index := tf.Instance.SlotTimeProvider().IndexFromTime(issuingTime)
+	validateActivities := func() {
+		activities := retainer.IssuerActivities(index)
+		require.Len(t, activities, 1)
+		require.Equal(t, NewIssuerActivityID(index, identity.NewID(issuer)), activities[0].ID())
+		require.EqualValues(t, 2, activities[0].M.Blocks)
+	}
+
+	validateActivities()
+	require.Empty(t, retainer.IssuerActivities(index+1))
+
+	// Trigger eviction through commitment creation
+	tf.Engine.Instance.Notarization.(*slotnotarization.Manager).SetAcceptanceTime(tf.Instance.SlotTimeProvider().EndTime(index + 8))
+	workers.WaitChildren()
+
+	validateActivities()
+}
+
 func validateDeserialized(t *testing.T, meta *BlockMetadata, metaDeserialized *BlockMetadata) {
 	require.Equal(t, meta.M.Missing, metaDeserialized.M.Missing)
 	require.Equal(t, meta.M.Solid, metaDeserialized.M.Solid)
@@ -57,6 +57,11 @@ func (s *Spammer) Start(rate int, payloadSize uint64, timeUnit time.Duration, im
 	}
 }
 
+// IsRunning returns true if the spammer is currently spamming blocks.
+func (s *Spammer) IsRunning() bool {
+	return s.running.Load()
+}
+
 // Shutdown shuts down the spammer.
 func (s *Spammer) Shutdown() {
 	s.signalShutdown()
@@ -0,0 +1,485 @@
+package spammer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// RequestFundsFunc is a function which requests funds from the faucet for the given address.
+type RequestFundsFunc = func(address devnetvm.Address) error
+
+// OutputsFunc is a function which returns the unspent outputs of the given address.
+type OutputsFunc = func(address devnetvm.Address) (outputs devnetvm.Outputs, err error)
+
+// region ValueSpammer /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ValueSpammer spams value transactions that exercise the ledger. It funds a set of addresses from the faucet and then
+// continuously cycles the funds among them, optionally injecting double spends at a configurable rate.
+type ValueSpammer struct {
+	issuePayloadFunc IssuePayloadFunc
+	requestFundsFunc RequestFundsFunc
+	outputsFunc      OutputsFunc
+	estimateFunc     EstimateFunc
+	log              *logger.Logger
+
+	seed               *ed25519.Seed
+	addressIndexes     map[string]uint64
+	availableOutputs   []devnetvm.Output
+	conflictingOutputs map[utxo.TransactionID]devnetvm.Output
+	issuedTransactions map[utxo.TransactionID]bool
+	stats              *ValueSpammerStats
+	mutex              sync.Mutex
+
+	running  atomic.Bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	optsAddressCount   uint64
+	optsFundingTimeout time.Duration
+	optsPledgeID       identity.ID
+}
+
+// NewValueSpammer creates a new ValueSpammer.
+func NewValueSpammer(issuePayloadFunc IssuePayloadFunc, requestFundsFunc RequestFundsFunc, outputsFunc OutputsFunc, estimateFunc EstimateFunc, log *logger.Logger, opts ...options.Option[ValueSpammer]) *ValueSpammer {
+	return options.Apply(&ValueSpammer{
+		issuePayloadFunc:   issuePayloadFunc,
+		requestFundsFunc:   requestFundsFunc,
+		outputsFunc:        outputsFunc,
+		estimateFunc:       estimateFunc,
+		log:                log,
+		seed:               ed25519.NewSeed(),
+		addressIndexes:     make(map[string]uint64),
+		conflictingOutputs: make(map[utxo.TransactionID]devnetvm.Output),
+		issuedTransactions: make(map[utxo.TransactionID]bool),
+		stats:              new(ValueSpammerStats),
+		shutdown:           make(chan struct{}),
+		optsAddressCount:   10,
+		optsFundingTimeout: time.Minute,
+	}, opts, func(v *ValueSpammer) {
+		for i := uint64(0); i < v.optsAddressCount; i++ {
+			v.addressIndexes[v.address(i).Base58()] = i
+		}
+	})
+}
+
+// Start starts the ValueSpammer to issue the given number of transactions per time unit. Each transaction is a double
+// spend with the given probability (0 disables the conflict injection).
+func (v *ValueSpammer) Start(rate int, timeUnit time.Duration, conflictRate float64) {
+	// only start if not yet running
+	if v.running.CompareAndSwap(false, true) {
+		v.mutex.Lock()
+		v.stats = &ValueSpammerStats{StartTime: time.Now()}
+		v.mutex.Unlock()
+
+		v.wg.Add(1)
+		go v.run(rate, timeUnit, conflictRate)
+	}
+}
+
+// Shutdown shuts down the ValueSpammer.
+func (v *ValueSpammer) Shutdown() {
+	v.signalShutdown()
+	v.wg.Wait()
+}
+
+// Stats returns the statistics of the current (or last) run of the ValueSpammer.
+func (v *ValueSpammer) Stats() (stats ValueSpammerStats) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	stats = *v.stats
+	stats.Running = v.running.Load()
+	if stats.EndTime.IsZero() {
+		stats.EndTime = time.Now()
+	}
+
+	return stats
+}
+
+// TransactionBooked counts the booking of a transaction (if it was issued by the ValueSpammer).
+func (v *ValueSpammer) TransactionBooked(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.issuedTransactions[transactionID] {
+		v.stats.Booked++
+	}
+}
+
+// TransactionAccepted counts the acceptance of a transaction (if it was issued by the ValueSpammer) and releases the
+// output of an accepted double spend for further spending.
+func (v *ValueSpammer) TransactionAccepted(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if !v.issuedTransactions[transactionID] {
+		return
+	}
+	delete(v.issuedTransactions, transactionID)
+	v.stats.Accepted++
+
+	if output, exists := v.conflictingOutputs[transactionID]; exists {
+		delete(v.conflictingOutputs, transactionID)
+		v.availableOutputs = append(v.availableOutputs, output)
+	}
+}
+
+// TransactionRejected counts the rejection of a transaction (if it was issued by the ValueSpammer).
+func (v *ValueSpammer) TransactionRejected(transactionID utxo.TransactionID) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if !v.issuedTransactions[transactionID] {
+		return
+	}
+	delete(v.issuedTransactions, transactionID)
+	delete(v.conflictingOutputs, transactionID)
+	v.stats.Rejected++
+}
+
+func (v *ValueSpammer) signalShutdown() {
+	if v.running.CompareAndSwap(true, false) {
+		v.shutdown <- struct{}{}
+	}
+}
+
+func (v *ValueSpammer) run(rate int, timeUnit time.Duration, conflictRate float64) {
+	defer v.wg.Done()
+	defer func() {
+		v.mutex.Lock()
+		v.stats.EndTime = time.Now()
+		v.mutex.Unlock()
+	}()
+
+	if err := v.fund(); err != nil {
+		v.log.Errorf("stopped spamming value transactions: %s", err)
+		v.running.Store(false)
+		return
+	}
+
+	ticker := time.NewTicker(timeUnit / time.Duration(rate))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-v.shutdown:
+			return
+		case <-ticker.C:
+			if v.estimateFunc() > 0 {
+				// the rate setter can not keep up, so we skip the transaction instead of queueing it
+				break
+			}
+
+			if err := v.spam(rand.Float64() < conflictRate); err != nil {
+				if errors.Is(err, blockissuer.ErrNotBootstraped) {
+					v.log.Info("Stopped spamming value transactions because node lost sync")
+					v.running.Store(false)
+					return
+				}
+
+				v.log.Warnf("could not issue value spam: %s", err)
+			}
+		}
+	}
+}
+
+// fund requests funds from the faucet and splits them among the addresses (unless there are still outputs of a
+// previous run).
+func (v *ValueSpammer) fund() (err error) {
+	if v.mutex.Lock(); len(v.availableOutputs) != 0 {
+		v.mutex.Unlock()
+		return nil
+	}
+	v.mutex.Unlock()
+
+	fundingAddress := v.address(0)
+	if err = v.requestFundsFunc(fundingAddress); err != nil {
+		return errors.Wrap(err, "failed to request funds from the faucet")
+	}
+
+	fundingOutputs, err := v.awaitFunds(fundingAddress)
+	if err != nil {
+		return err
+	}
+
+	var totalBalance uint64
+	for _, fundingOutput := range fundingOutputs {
+		balance, _ := fundingOutput.Balances().Get(devnetvm.ColorIOTA)
+		totalBalance += balance
+	}
+	if totalBalance < v.optsAddressCount {
+		return errors.Errorf("received %d IOTA which can not be split among %d addresses", totalBalance, v.optsAddressCount)
+	}
+
+	outputs := make([]devnetvm.Output, v.optsAddressCount)
+	for i := range outputs {
+		balance := totalBalance / v.optsAddressCount
+		if i == 0 {
+			balance += totalBalance % v.optsAddressCount
+		}
+		outputs[i] = devnetvm.NewSigLockedSingleOutput(balance, v.address(uint64(i)))
+	}
+
+	transaction, err := v.transaction(fundingOutputs, outputs...)
+	if err != nil {
+		return errors.Wrap(err, "failed to create funding transaction")
+	}
+	if err = v.issue(transaction); err != nil {
+		return errors.Wrap(err, "failed to issue funding transaction")
+	}
+
+	v.mutex.Lock()
+	v.availableOutputs = append(v.availableOutputs, transaction.Essence().Outputs()...)
+	v.mutex.Unlock()
+
+	return nil
+}
+
+// awaitFunds waits until the given address received funds from the faucet.
+func (v *ValueSpammer) awaitFunds(address devnetvm.Address) (outputs devnetvm.Outputs, err error) {
+	timeout := time.NewTimer(v.optsFundingTimeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-v.shutdown:
+			return nil, errors.New("shut down while waiting for funds")
+		case <-timeout.C:
+			return nil, errors.Errorf("did not receive funds within %s", v.optsFundingTimeout)
+		case <-ticker.C:
+			if outputs, err = v.outputsFunc(address); err != nil {
+				return nil, errors.Wrapf(err, "failed to retrieve outputs of %s", address.Base58())
+			}
+
+			if len(outputs) != 0 {
+				return outputs, nil
+			}
+		}
+	}
+}
+
+// spam issues a transaction that moves an available output to the next address (or two conflicting transactions that
+// move it to different addresses).
+func (v *ValueSpammer) spam(injectConflict bool) (err error) {
+	input, exists := v.nextOutput()
+	if !exists {
+		return errors.New("no funds available (waiting for double spends to be resolved)")
+	}
+
+	transactions := make([]*devnetvm.Transaction, 0, 2)
+	for i := 1; i <= lo.Cond(injectConflict, 2, 1); i++ {
+		balance, _ := input.Balances().Get(devnetvm.ColorIOTA)
+		destination := v.address((v.addressIndexes[input.Address().Base58()] + uint64(i)) % v.optsAddressCount)
+
+		transaction, transactionErr := v.transaction(devnetvm.Outputs{input}, devnetvm.NewSigLockedSingleOutput(balance, destination))
+		if transactionErr != nil {
+			v.releaseOutput(input)
+
+			return errors.Wrap(transactionErr, "failed to create transaction")
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	issued := false
+	for _, transaction := range transactions {
+		if issueErr := v.issue(transaction); issueErr != nil {
+			err = issueErr
+			continue
+		}
+		issued = true
+
+		v.mutex.Lock()
+		if injectConflict {
+			v.conflictingOutputs[transaction.ID()] = transaction.Essence().Outputs()[0]
+			v.stats.Conflicts++
+		} else {
+			v.availableOutputs = append(v.availableOutputs, transaction.Essence().Outputs()[0])
+		}
+		v.mutex.Unlock()
+	}
+
+	if !issued {
+		v.releaseOutput(input)
+	}
+
+	return err
+}
+
+// issue issues the given transaction and registers it for the statistics.
+func (v *ValueSpammer) issue(transaction *devnetvm.Transaction) (err error) {
+	v.mutex.Lock()
+	v.issuedTransactions[transaction.ID()] = true
+	v.mutex.Unlock()
+
+	if _, err = v.issuePayloadFunc(transaction); err != nil {
+		v.mutex.Lock()
+		delete(v.issuedTransactions, transaction.ID())
+		v.mutex.Unlock()
+
+		return err
+	}
+
+	v.mutex.Lock()
+	v.stats.Issued++
+	v.mutex.Unlock()
+
+	return nil
+}
+
+// transaction creates a signed transaction that spends the given inputs into the given outputs.
+func (v *ValueSpammer) transaction(inputs devnetvm.Outputs, outputs ...devnetvm.Output) (transaction *devnetvm.Transaction, err error) {
+	inputsByID := make(map[utxo.OutputID]devnetvm.Output)
+	for _, input := range inputs {
+		inputsByID[input.ID()] = input
+	}
+
+	essence := devnetvm.NewTransactionEssence(0, time.Now(), v.optsPledgeID, v.optsPledgeID, devnetvm.NewInputs(lo.Map(inputs, devnetvm.Output.Input)...), devnetvm.NewOutputs(outputs...))
+	essenceBytes, err := essence.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize essence")
+	}
+
+	unlockBlocks := make(devnetvm.UnlockBlocks, len(essence.Inputs()))
+	signatureUnlockBlocks := make(map[string]uint16)
+	for i, input := range essence.Inputs() {
+		address := inputsByID[input.(*devnetvm.UTXOInput).ReferencedOutputID()].Address()
+
+		if signatureIndex, exists := signatureUnlockBlocks[address.Base58()]; exists {
+			unlockBlocks[i] = devnetvm.NewReferenceUnlockBlock(signatureIndex)
+			continue
+		}
+
+		addressIndex, exists := v.addressIndexes[address.Base58()]
+		if !exists {
+			return nil, errors.Errorf("input %d is not owned by the spammer", i)
+		}
+
+		keyPair := v.seed.KeyPair(addressIndex)
+		unlockBlocks[i] = devnetvm.NewSignatureUnlockBlock(devnetvm.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essenceBytes)))
+		signatureUnlockBlocks[address.Base58()] = uint16(i)
+	}
+
+	return devnetvm.NewTransaction(essence, unlockBlocks), nil
+}
+
+// nextOutput removes the oldest available output from the pool.
+func (v *ValueSpammer) nextOutput() (output devnetvm.Output, exists bool) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if len(v.availableOutputs) == 0 {
+		return nil, false
+	}
+
+	output, v.availableOutputs = v.availableOutputs[0], v.availableOutputs[1:]
+
+	return output, true
+}
+
+// releaseOutput returns an output that could not be spent to the pool.
+func (v *ValueSpammer) releaseOutput(output devnetvm.Output) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.availableOutputs = append(v.availableOutputs, output)
+}
+
+// address returns the address with the given index.
+func (v *ValueSpammer) address(index uint64) devnetvm.Address {
+	return devnetvm.NewED25519Address(v.seed.KeyPair(index).PublicKey)
+}
+
+// WithAddressCount sets the number of addresses that the funds are cycled among.
+func WithAddressCount(addressCount uint64) options.Option[ValueSpammer] {
+	return func(v *ValueSpammer) {
+		v.optsAddressCount = addressCount
+	}
+}
+
+// WithFundingTimeout sets the time that the ValueSpammer waits for the funds of the faucet.
+func WithFundingTimeout(fundingTimeout time.Duration) options.Option[ValueSpammer] {
+	return func(v *ValueSpammer) {
+		v.optsFundingTimeout = fundingTimeout
+	}
+}
+
+// WithPledgeID sets the identity that the mana of the spammed transactions is pledged to.
+func WithPledgeID(pledgeID identity.ID) options.Option[ValueSpammer] {
+	return func(v *ValueSpammer) {
+		v.optsPledgeID = pledgeID
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ValueSpammerStats ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ValueSpammerStats contains the statistics of a run of the ValueSpammer.
+type ValueSpammerStats struct {
+	// Running contains true if the ValueSpammer is currently running.
+	Running bool
+
+	// StartTime contains the time when the run started.
+	StartTime time.Time
+
+	// EndTime contains the time when the run ended (or the current time if it is still running).
+	EndTime time.Time
+
+	// Issued contains the number of issued transactions.
+	Issued uint64
+
+	// Booked contains the number of issued transactions that were booked.
+	Booked uint64
+
+	// Accepted contains the number of issued transactions that were accepted.
+	Accepted uint64
+
+	// Rejected contains the number of issued transactions that were rejected.
+	Rejected uint64
+
+	// Conflicts contains the number of issued transactions that were double spends.
+	Conflicts uint64
+}
+
+// IssuedTPS returns the achieved rate of issued transactions per second.
+func (v ValueSpammerStats) IssuedTPS() float64 {
+	return v.perSecond(v.Issued)
+}
+
+// BookedTPS returns the achieved rate of booked transactions per second.
+func (v ValueSpammerStats) BookedTPS() float64 {
+	return v.perSecond(v.Booked)
+}
+
+// AcceptedTPS returns the achieved rate of accepted transactions per second.
+func (v ValueSpammerStats) AcceptedTPS() float64 {
+	return v.perSecond(v.Accepted)
+}
+
+// perSecond returns the given count divided by the duration of the run in seconds.
+func (v ValueSpammerStats) perSecond(count uint64) float64 {
+	duration := v.EndTime.Sub(v.StartTime).Seconds()
+	if duration <= 0 {
+		return 0
+	}
+
+	return float64(count) / duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
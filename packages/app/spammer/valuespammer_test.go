@@ -0,0 +1,99 @@
+package spammer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+	"github.com/iotaledger/hive.go/logger"
+)
+
+func TestValueSpammer(t *testing.T) {
+	var (
+		fundedAddress devnetvm.Address
+		transactions  []*devnetvm.Transaction
+		outputs       = make(map[utxo.OutputID]devnetvm.Output)
+		mutex         sync.Mutex
+	)
+
+	faucetOutput := devnetvm.NewSigLockedSingleOutput(1000, nil)
+	faucetOutput.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{1}), 0))
+
+	valueSpammer := NewValueSpammer(func(p payload.Payload, _ ...int) (*models.Block, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		transaction := p.(*devnetvm.Transaction)
+		for _, output := range transaction.Essence().Outputs() {
+			outputs[output.ID()] = output
+		}
+		transactions = append(transactions, transaction)
+
+		return nil, nil
+	}, func(address devnetvm.Address) error {
+		fundedAddress = address
+		faucetOutput = devnetvm.NewSigLockedSingleOutput(1000, address)
+		faucetOutput.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{1}), 0))
+		outputs[faucetOutput.ID()] = faucetOutput
+
+		return nil
+	}, func(address devnetvm.Address) (devnetvm.Outputs, error) {
+		return devnetvm.Outputs{faucetOutput}, nil
+	}, func() time.Duration {
+		return 0
+	}, logger.NewNopLogger(), WithAddressCount(3))
+
+	valueSpammer.Start(100, time.Second, 1)
+	require.Eventually(t, func() bool {
+		return valueSpammer.Stats().Issued >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	valueSpammer.Shutdown()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// the funds of the faucet are split among the addresses
+	require.Equal(t, fundedAddress, valueSpammer.address(0))
+	require.Len(t, transactions[0].Essence().Outputs(), 3)
+
+	// every transaction spends the outputs of the spammer
+	for _, transaction := range transactions {
+		inputs := make(devnetvm.Outputs, 0)
+		for _, input := range transaction.Essence().Inputs() {
+			inputs = append(inputs, outputs[input.(*devnetvm.UTXOInput).ReferencedOutputID()])
+		}
+		assert.True(t, devnetvm.UnlockBlocksValid(inputs, transaction))
+	}
+
+	// the conflicting transactions spend the same output
+	require.Equal(t, transactions[1].Essence().Inputs(), transactions[2].Essence().Inputs())
+	require.NotEqual(t, transactions[1].ID(), transactions[2].ID())
+
+	stats := valueSpammer.Stats()
+	require.False(t, stats.Running)
+	require.EqualValues(t, stats.Issued-1, stats.Conflicts)
+
+	// only the output of the accepted double spend is spent again
+	valueSpammer.TransactionBooked(transactions[1].ID())
+	valueSpammer.TransactionAccepted(transactions[1].ID())
+	valueSpammer.TransactionRejected(transactions[2].ID())
+
+	stats = valueSpammer.Stats()
+	require.EqualValues(t, 1, stats.Booked)
+	require.EqualValues(t, 1, stats.Accepted)
+	require.EqualValues(t, 1, stats.Rejected)
+
+	availableOutputs := make(map[utxo.OutputID]bool)
+	for output, exists := valueSpammer.nextOutput(); exists; output, exists = valueSpammer.nextOutput() {
+		availableOutputs[output.ID()] = true
+	}
+	require.True(t, availableOutputs[transactions[1].Essence().Outputs()[0].ID()])
+	require.False(t, availableOutputs[transactions[2].Essence().Outputs()[0].ID()])
+}
@@ -0,0 +1,475 @@
+package subscriptions
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+var (
+	// ErrSubscriberOverflow is returned if a Subscriber did not keep up with its Notifications (the consumer has to
+	// reconnect and query the current state to resynchronize).
+	ErrSubscriberOverflow = errors.New("subscriber overflowed")
+
+	// ErrSubscriberClosed is returned if a Subscriber was closed by the consumer or the Hub.
+	ErrSubscriberClosed = errors.New("subscriber closed")
+
+	// ErrTooManyTopics is returned if a Subscriber tries to subscribe to more than the maximum number of Topics.
+	ErrTooManyTopics = errors.New("too many topics")
+)
+
+// region Hub //////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Hub distributes Notifications to the Subscribers of the Topics that they concern. In contrast to the address feed,
+// a Subscriber can subscribe to an arbitrary (but limited) set of Topics that can be changed while it is connected.
+type Hub struct {
+	subscribers  map[Topic]map[uint64]*Subscriber
+	subscriberID uint64
+	mutex        sync.RWMutex
+
+	optsMaxPendingNotifications int
+	optsMaxTopics               int
+}
+
+// New creates a new Hub.
+func New(opts ...options.Option[Hub]) *Hub {
+	return options.Apply(&Hub{
+		subscribers:                 make(map[Topic]map[uint64]*Subscriber),
+		optsMaxPendingNotifications: 1024,
+		optsMaxTopics:               1000,
+	}, opts)
+}
+
+// Connect creates a new Subscriber that is not subscribed to any Topic yet.
+func (h *Hub) Connect() (subscriber *Subscriber) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.subscriberID++
+
+	return newSubscriber(h, h.subscriberID)
+}
+
+// Publish delivers the given Notification to the Subscribers of its Topics (a Subscriber that is subscribed to several
+// of them receives the Notification only once).
+func (h *Hub) Publish(notification *Notification) {
+	matchedTopics := make(map[*Subscriber][]Topic)
+
+	h.mutex.RLock()
+	for _, topic := range notification.Topics {
+		for _, subscriber := range h.subscribers[topic] {
+			matchedTopics[subscriber] = append(matchedTopics[subscriber], topic)
+		}
+	}
+	h.mutex.RUnlock()
+
+	for subscriber, topics := range matchedTopics {
+		subscriber.deliver(notification, topics)
+	}
+}
+
+// SubscriberCount returns the number of Subscribers that are subscribed to at least one Topic.
+func (h *Hub) SubscriberCount() (count int) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	subscribers := make(map[uint64]bool)
+	for _, topicSubscribers := range h.subscribers {
+		for id := range topicSubscribers {
+			subscribers[id] = true
+		}
+	}
+
+	return len(subscribers)
+}
+
+// Shutdown closes all Subscribers that are subscribed to at least one Topic.
+func (h *Hub) Shutdown() {
+	h.mutex.Lock()
+	subscribers := make(map[uint64]*Subscriber)
+	for _, topicSubscribers := range h.subscribers {
+		for id, subscriber := range topicSubscribers {
+			subscribers[id] = subscriber
+		}
+	}
+	h.mutex.Unlock()
+
+	for _, subscriber := range subscribers {
+		subscriber.Close()
+	}
+}
+
+// register adds the given Subscriber to the given Topics.
+func (h *Hub) register(subscriber *Subscriber, topics []Topic) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, topic := range topics {
+		topicSubscribers, exists := h.subscribers[topic]
+		if !exists {
+			topicSubscribers = make(map[uint64]*Subscriber)
+			h.subscribers[topic] = topicSubscribers
+		}
+		topicSubscribers[subscriber.ID] = subscriber
+	}
+}
+
+// unregister removes the given Subscriber from the given Topics.
+func (h *Hub) unregister(subscriber *Subscriber, topics []Topic) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, topic := range topics {
+		topicSubscribers, exists := h.subscribers[topic]
+		if !exists {
+			continue
+		}
+
+		delete(topicSubscribers, subscriber.ID)
+		if len(topicSubscribers) == 0 {
+			delete(h.subscribers, topic)
+		}
+	}
+}
+
+// WithMaxPendingNotifications sets the maximum number of Notifications that are queued for a Subscriber before it is
+// closed with ErrSubscriberOverflow.
+func WithMaxPendingNotifications(maxPendingNotifications int) options.Option[Hub] {
+	return func(h *Hub) {
+		h.optsMaxPendingNotifications = maxPendingNotifications
+	}
+}
+
+// WithMaxTopics sets the maximum number of Topics that a single Subscriber can subscribe to.
+func WithMaxTopics(maxTopics int) options.Option[Hub] {
+	return func(h *Hub) {
+		h.optsMaxTopics = maxTopics
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Subscriber ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscriber is a consumer of the Notifications of a set of Topics. Its Messages are numbered with consecutive
+// sequence numbers (starting at 1), so that consumers can detect if they missed any of them.
+type Subscriber struct {
+	// ID contains the identifier of the Subscriber.
+	ID uint64
+
+	hub      *Hub
+	topics   map[Topic]bool
+	sequence uint64
+	queue    []*Message
+	err      error
+	notify   chan struct{}
+	mutex    sync.Mutex
+}
+
+// newSubscriber creates a new Subscriber without Topics.
+func newSubscriber(hub *Hub, id uint64) *Subscriber {
+	return &Subscriber{
+		ID:     id,
+		hub:    hub,
+		topics: make(map[Topic]bool),
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Subscribe adds the given Topics to the Topics of the Subscriber (it fails with ErrTooManyTopics without changing
+// the Topics if the maximum number of Topics would be exceeded).
+func (s *Subscriber) Subscribe(topics ...Topic) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	newTopics := make([]Topic, 0)
+	for _, topic := range topics {
+		if !s.topics[topic] && !containsTopic(newTopics, topic) {
+			newTopics = append(newTopics, topic)
+		}
+	}
+
+	if len(s.topics)+len(newTopics) > s.hub.optsMaxTopics {
+		return errors.WithMessagef(ErrTooManyTopics, "subscribing would exceed the limit of %d topics", s.hub.optsMaxTopics)
+	}
+
+	for _, topic := range newTopics {
+		s.topics[topic] = true
+	}
+	s.hub.register(s, newTopics)
+
+	return nil
+}
+
+// Unsubscribe removes the given Topics from the Topics of the Subscriber.
+func (s *Subscriber) Unsubscribe(topics ...Topic) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	removedTopics := make([]Topic, 0)
+	for _, topic := range topics {
+		if s.topics[topic] {
+			delete(s.topics, topic)
+			removedTopics = append(removedTopics, topic)
+		}
+	}
+	s.hub.unregister(s, removedTopics)
+}
+
+// Topics returns the Topics of the Subscriber (sorted by their type and ID).
+func (s *Subscriber) Topics() (topics []Topic) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	topics = make([]Topic, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	sortTopics(topics)
+
+	return topics
+}
+
+// Next returns the next Message of the Subscriber (it blocks until a Message is available, the Subscriber is closed or
+// the context is canceled).
+func (s *Subscriber) Next(ctx context.Context) (message *Message, err error) {
+	for {
+		s.mutex.Lock()
+		if len(s.queue) > 0 {
+			message, s.queue = s.queue[0], s.queue[1:]
+			s.mutex.Unlock()
+
+			return message, nil
+		}
+		err = s.err
+		s.mutex.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close closes the Subscriber (the Messages that were already queued can still be retrieved).
+func (s *Subscriber) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.fail(ErrSubscriberClosed)
+}
+
+// deliver queues a Message that contains the given Notification and the matched Topics of the Subscriber.
+func (s *Subscriber) deliver(notification *Notification, topics []Topic) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	// the Topics might have been unsubscribed after the Hub matched them
+	subscribedTopics := make([]Topic, 0, len(topics))
+	for _, topic := range topics {
+		if s.topics[topic] {
+			subscribedTopics = append(subscribedTopics, topic)
+		}
+	}
+	if topics = subscribedTopics; len(topics) == 0 {
+		return
+	}
+
+	if len(s.queue) >= s.hub.optsMaxPendingNotifications {
+		s.fail(ErrSubscriberOverflow)
+		return
+	}
+
+	sortTopics(topics)
+
+	s.sequence++
+	s.queue = append(s.queue, &Message{
+		Sequence:     s.sequence,
+		Topics:       topics,
+		Notification: notification,
+	})
+	s.signal()
+}
+
+// fail unregisters the Subscriber from all of its Topics and sets the error that is returned after the queued Messages
+// were retrieved (it expects the Subscriber to be locked).
+func (s *Subscriber) fail(err error) {
+	if s.err != nil {
+		return
+	}
+
+	s.err = err
+	s.signal()
+
+	topics := make([]Topic, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	s.topics = make(map[Topic]bool)
+	s.hub.unregister(s, topics)
+}
+
+// signal wakes up a consumer that is waiting for Messages.
+func (s *Subscriber) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Message //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Message is a Notification as it is delivered to a single Subscriber.
+type Message struct {
+	// Sequence contains the sequence number of the Message within its Subscriber.
+	Sequence uint64
+
+	// Topics contains the Topics of the Subscriber that the Notification concerns.
+	Topics []Topic
+
+	// Notification contains the delivered Notification.
+	Notification *Notification
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Notification /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Notification is a change of the state of a transaction or a conflict that is published to the Subscribers of the
+// Topics that it concerns.
+type Notification struct {
+	// Type contains the type of the Notification.
+	Type NotificationType
+
+	// TransactionID contains the ID of the affected transaction (the ID of the conflict for conflict Notifications).
+	TransactionID utxo.TransactionID
+
+	// Topics contains the Topics that the Notification concerns.
+	Topics []Topic
+}
+
+// NotificationType represents the type of a Notification.
+type NotificationType uint8
+
+const (
+	// TransactionBooked is the type of the Notifications of booked transactions.
+	TransactionBooked NotificationType = iota
+
+	// TransactionAccepted is the type of the Notifications of accepted (confirmed) transactions.
+	TransactionAccepted
+
+	// TransactionRejected is the type of the Notifications of rejected transactions.
+	TransactionRejected
+
+	// ConflictAccepted is the type of the Notifications of accepted (confirmed) conflicts.
+	ConflictAccepted
+
+	// ConflictRejected is the type of the Notifications of rejected conflicts.
+	ConflictRejected
+)
+
+// String returns a human-readable representation of the NotificationType.
+func (n NotificationType) String() string {
+	return [...]string{
+		"transactionBooked",
+		"transactionAccepted",
+		"transactionRejected",
+		"conflictAccepted",
+		"conflictRejected",
+	}[n]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Topic ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Topic is a subject that Subscribers can subscribe to.
+type Topic struct {
+	// Type contains the type of the subject.
+	Type TopicType
+
+	// ID contains the base58 encoded identifier of the subject.
+	ID string
+}
+
+// AddressTopic returns the Topic of the address with the given base58 encoded ID.
+func AddressTopic(address string) Topic {
+	return Topic{Type: Address, ID: address}
+}
+
+// TransactionTopic returns the Topic of the transaction with the given ID.
+func TransactionTopic(transactionID utxo.TransactionID) Topic {
+	return Topic{Type: Transaction, ID: transactionID.Base58()}
+}
+
+// ConflictTopic returns the Topic of the conflict with the given ID.
+func ConflictTopic(conflictID utxo.TransactionID) Topic {
+	return Topic{Type: Conflict, ID: conflictID.Base58()}
+}
+
+// TopicType represents the type of the subject of a Topic.
+type TopicType uint8
+
+const (
+	// Address is the type of the Topics of addresses.
+	Address TopicType = iota
+
+	// Transaction is the type of the Topics of transactions.
+	Transaction
+
+	// Conflict is the type of the Topics of conflicts.
+	Conflict
+)
+
+// String returns a human-readable representation of the TopicType.
+func (t TopicType) String() string {
+	return [...]string{
+		"address",
+		"transaction",
+		"conflict",
+	}[t]
+}
+
+// containsTopic returns true if the given Topics contain the given Topic.
+func containsTopic(topics []Topic, topic Topic) bool {
+	for _, existingTopic := range topics {
+		if existingTopic == topic {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortTopics sorts the given Topics by their type and ID.
+func sortTopics(topics []Topic) {
+	sort.Slice(topics, func(i, j int) bool {
+		if topics[i].Type != topics[j].Type {
+			return topics[i].Type < topics[j].Type
+		}
+
+		return topics[i].ID < topics[j].ID
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,87 @@
+package subscriptions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+)
+
+func TestHub(t *testing.T) {
+	hub := New()
+
+	transactionID := newTransactionID(1)
+	address, otherAddress := AddressTopic("address"), AddressTopic("otherAddress")
+
+	subscriber := hub.Connect()
+	require.NoError(t, subscriber.Subscribe(address, TransactionTopic(transactionID), address))
+	require.Equal(t, []Topic{address, TransactionTopic(transactionID)}, subscriber.Topics())
+	require.Equal(t, 1, hub.SubscriberCount())
+
+	booked := &Notification{Type: TransactionBooked, TransactionID: transactionID, Topics: []Topic{TransactionTopic(transactionID), otherAddress, address}}
+	hub.Publish(booked)
+	hub.Publish(&Notification{Type: TransactionBooked, TransactionID: newTransactionID(2), Topics: []Topic{otherAddress}})
+
+	subscriber.Unsubscribe(address)
+	accepted := &Notification{Type: TransactionAccepted, TransactionID: transactionID, Topics: []Topic{address, TransactionTopic(transactionID)}}
+	hub.Publish(accepted)
+	hub.Publish(&Notification{Type: TransactionAccepted, TransactionID: newTransactionID(3), Topics: []Topic{address}})
+
+	assertMessages(t, subscriber,
+		&Message{Sequence: 1, Topics: []Topic{address, TransactionTopic(transactionID)}, Notification: booked},
+		&Message{Sequence: 2, Topics: []Topic{TransactionTopic(transactionID)}, Notification: accepted},
+	)
+
+	subscriber.Close()
+	_, err := subscriber.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriberClosed)
+	require.Equal(t, 0, hub.SubscriberCount())
+	require.ErrorIs(t, subscriber.Subscribe(address), ErrSubscriberClosed)
+}
+
+func TestHub_Limits(t *testing.T) {
+	hub := New(WithMaxTopics(2), WithMaxPendingNotifications(2))
+
+	subscriber := hub.Connect()
+	require.NoError(t, subscriber.Subscribe(AddressTopic("address")))
+	require.ErrorIs(t, subscriber.Subscribe(ConflictTopic(newTransactionID(1)), ConflictTopic(newTransactionID(2))), ErrTooManyTopics)
+	require.Equal(t, []Topic{AddressTopic("address")}, subscriber.Topics())
+
+	for i := 0; i < 3; i++ {
+		hub.Publish(&Notification{Type: TransactionBooked, Topics: []Topic{AddressTopic("address")}})
+	}
+	require.Equal(t, 0, hub.SubscriberCount())
+
+	for i := 0; i < 2; i++ {
+		_, err := subscriber.Next(context.Background())
+		require.NoError(t, err)
+	}
+	_, err := subscriber.Next(context.Background())
+	require.ErrorIs(t, err, ErrSubscriberOverflow)
+}
+
+func assertMessages(t *testing.T, subscriber *Subscriber, expectedMessages ...*Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, expectedMessage := range expectedMessages {
+		message, err := subscriber.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expectedMessage, message)
+	}
+
+	emptyCtx, emptyCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer emptyCancel()
+
+	_, err := subscriber.Next(emptyCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func newTransactionID(index byte) (transactionID utxo.TransactionID) {
+	transactionID.Identifier[0] = index
+
+	return transactionID
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// Backup writes a checkpoint of the permanent DB and of all DB instances that were not pruned, yet, to the given
+// directory (using the same layout as the base directory of the Manager) without interrupting the node. Every checkpoint
+// is verified by opening and reading it. Pruning is paused while the backup is written and the DB instances are neither
+// opened nor closed by the node while their checkpoints are taken.
+func (m *Manager) Backup(targetDir string) (err error) {
+	m.pruningMutex.Lock()
+	defer m.pruningMutex.Unlock()
+
+	if err = m.checkpointDB(m.permanentDB, filepath.Join(targetDir, "permanent")); err != nil {
+		return errors.Wrap(err, "failed to backup permanent storage")
+	} else if err = m.verifyCheckpoint(filepath.Join(targetDir, "permanent"), dbVersionKey); err != nil {
+		return errors.Wrap(err, "failed to verify backup of permanent storage")
+	}
+
+	m.openDBsMutex.Lock()
+	defer m.openDBsMutex.Unlock()
+
+	openDBs := make(map[slot.Index]*dbInstance)
+	m.openDBs.Each(func(baseIndex slot.Index, db *dbInstance) {
+		openDBs[baseIndex] = db
+	})
+
+	for _, baseIndex := range m.unprunedDBBaseIndexes(openDBs) {
+		checkpointDir := dbPathFromIndex(filepath.Join(targetDir, "pruned"), baseIndex)
+
+		if err = m.checkpointDBInstance(openDBs[baseIndex], baseIndex, checkpointDir); err != nil {
+			return errors.Wrapf(err, "failed to backup db instance %d", baseIndex)
+		} else if err = m.verifyCheckpoint(checkpointDir); err != nil {
+			return errors.Wrapf(err, "failed to verify backup of db instance %d", baseIndex)
+		}
+	}
+
+	return nil
+}
+
+// checkpointDBInstance writes a checkpoint of the DB instance with the given base index to the given directory. DB
+// instances that are not open are opened (and closed again) without adding them to the open DBs of the Manager.
+func (m *Manager) checkpointDBInstance(openDB *dbInstance, baseIndex slot.Index, targetDir string) (err error) {
+	if openDB != nil {
+		return m.checkpointDB(openDB.instance, targetDir)
+	}
+
+	db, err := m.optsDBProvider(dbPathFromIndex(m.bucketedBaseDir, baseIndex))
+	if err != nil {
+		return errors.Wrap(err, "failed to open db instance")
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil && err == nil {
+			err = errors.Wrap(closeErr, "failed to close db instance")
+		}
+	}()
+
+	return m.checkpointDB(db, targetDir)
+}
+
+// checkpointDB writes a checkpoint of the given DB to the given directory (which must not exist, yet).
+func (m *Manager) checkpointDB(db DB, targetDir string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(targetDir), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create directory %s", filepath.Dir(targetDir))
+	}
+
+	return db.Checkpoint(targetDir)
+}
+
+// verifyCheckpoint opens the checkpoint in the given directory, reads all of its entries and checks that the given keys
+// exist.
+func (m *Manager) verifyCheckpoint(checkpointDir string, requiredKeys ...kvstore.Key) (err error) {
+	db, err := m.optsDBProvider(checkpointDir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open checkpoint in %s", checkpointDir)
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil && err == nil {
+			err = errors.Wrapf(closeErr, "failed to close checkpoint in %s", checkpointDir)
+		}
+	}()
+
+	store := db.NewStore()
+	if err = store.IterateKeys(kvstore.EmptyPrefix, func(kvstore.Key) bool {
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to read checkpoint in %s", checkpointDir)
+	}
+
+	for _, requiredKey := range requiredKeys {
+		if has, hasErr := store.Has(requiredKey); hasErr != nil {
+			return errors.Wrapf(hasErr, "failed to read checkpoint in %s", checkpointDir)
+		} else if !has {
+			return errors.Errorf("checkpoint in %s is missing key %s", checkpointDir, requiredKey)
+		}
+	}
+
+	return nil
+}
+
+// unprunedDBBaseIndexes returns the base indexes of all DB instances (opened or on disk) that were not pruned, yet.
+func (m *Manager) unprunedDBBaseIndexes(openDBs map[slot.Index]*dbInstance) (baseIndexes []slot.Index) {
+	maxPruned := m.MaxPrunedSlot()
+	seen := make(map[slot.Index]bool)
+	addBaseIndex := func(baseIndex slot.Index) {
+		if baseIndex > maxPruned && !seen[baseIndex] {
+			seen[baseIndex] = true
+			baseIndexes = append(baseIndexes, baseIndex)
+		}
+	}
+
+	for baseIndex := range openDBs {
+		addBaseIndex(baseIndex)
+	}
+
+	if _, err := os.Stat(m.bucketedBaseDir); err == nil {
+		for _, dbInfo := range getSortedDBInstancesFromDisk(m.bucketedBaseDir) {
+			addBaseIndex(dbInfo.baseIndex)
+		}
+	}
+
+	return baseIndexes
+}
@@ -20,8 +20,14 @@ func NewCacheTimeProvider(forceCacheTime time.Duration) *CacheTimeProvider {
 
 // CacheTime returns a CacheTime option. Duration may be overridden if CacheTimeProvider parameter is a non-negative integer.
 func (m *CacheTimeProvider) CacheTime(duration time.Duration) objectstorage.Option {
+	return objectstorage.CacheTime(m.Duration(duration))
+}
+
+// Duration returns the given cache duration or the forced cache time if the CacheTimeProvider overrides it.
+func (m *CacheTimeProvider) Duration(duration time.Duration) time.Duration {
 	if m.forceCacheTime >= 0 {
-		duration = m.forceCacheTime
+		return m.forceCacheTime
 	}
-	return objectstorage.CacheTime(duration)
+
+	return duration
 }
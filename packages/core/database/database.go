@@ -10,6 +10,8 @@ type DB interface {
 	NewStore() kvstore.KVStore
 	// Close closes a DB.
 	Close() error
+	// Checkpoint writes a consistent copy of the DB to the given (not yet existing) directory without blocking writes.
+	Checkpoint(targetDir string) error
 
 	// RequiresGC returns whether the database requires a call of GC() to clean deleted items.
 	RequiresGC() bool
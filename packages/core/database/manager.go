@@ -22,6 +22,7 @@ import (
 // region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type Manager struct {
+	permanentDB      DB
 	permanentStorage kvstore.KVStore
 	permanentBaseDir string
 
@@ -33,6 +34,9 @@ type Manager struct {
 	maxPruned      slot.Index
 	maxPrunedMutex sync.RWMutex
 
+	// pruningMutex is used to pause pruning while a backup is written.
+	pruningMutex sync.Mutex
+
 	// The granularity of the DB instances (i.e. how many buckets/slots are stored in one DB).
 	optsGranularity int64
 	optsBaseDir     string
@@ -54,6 +58,7 @@ func NewManager(version Version, opts ...options.Option[Manager]) *Manager {
 		if err != nil {
 			panic(err)
 		}
+		m.permanentDB = db
 		m.permanentStorage = db.NewStore()
 
 		m.openDBs = cache.New[slot.Index, *dbInstance](m.optsMaxOpenDBs)
@@ -189,6 +194,9 @@ func (m *Manager) Flush(index slot.Index) {
 }
 
 func (m *Manager) PruneUntilSlot(index slot.Index) {
+	m.pruningMutex.Lock()
+	defer m.pruningMutex.Unlock()
+
 	var baseIndexToPrune slot.Index
 	if m.computeDBBaseIndex(index)+slot.Index(m.optsGranularity)-1 == index {
 		// Upper bound of the DB instance should be pruned. So we can delete the entire DB file.
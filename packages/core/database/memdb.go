@@ -1,6 +1,8 @@
 package database
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 )
@@ -23,6 +25,11 @@ func (db *memDB) Close() error {
 	return nil
 }
 
+// Checkpoint returns an error, as an in-memory DB has no content on disk that could be used as a checkpoint.
+func (db *memDB) Checkpoint(string) error {
+	return errors.New("in-memory DBs do not support checkpoints")
+}
+
 func (db *memDB) RequiresGC() bool {
 	return false
 }
@@ -9,4 +9,7 @@ const (
 
 	// PrefixIndexer defines the storage prefix for the indexer package.
 	PrefixIndexer
+
+	// PrefixFaucet defines the storage prefix for the faucet plugin.
+	PrefixFaucet
 )
@@ -0,0 +1,35 @@
+//go:build rocksdb
+
+package database
+
+import (
+	"unsafe"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/grocksdb"
+	"github.com/iotaledger/hive.go/kvstore/rocksdb"
+)
+
+// Checkpoint writes a consistent copy of the DB to the given (not yet existing) directory without blocking writes. The
+// immutable files of the DB are hard-linked into the checkpoint (if both are located on the same filesystem).
+func (db *rocksDB) Checkpoint(targetDir string) (err error) {
+	checkpoint, err := grocksdbInstance(db.RocksDB).NewCheckpoint()
+	if err != nil {
+		return errors.Wrap(err, "failed to create checkpoint object")
+	}
+	defer checkpoint.Destroy()
+
+	// the memtables are always flushed (logSizeForFlush = 0), as the WAL is disabled and would not contain them
+	if err = checkpoint.CreateCheckpoint(targetDir, 0); err != nil {
+		return errors.Wrapf(err, "failed to create checkpoint in %s", targetDir)
+	}
+
+	return nil
+}
+
+// grocksdbInstance returns the grocksdb.DB that is wrapped by the given RocksDB (the wrapper does not expose it, but it
+// is the first field of its struct).
+func grocksdbInstance(db *rocksdb.RocksDB) *grocksdb.DB {
+	return (*struct{ db *grocksdb.DB })(unsafe.Pointer(db)).db
+}
@@ -0,0 +1,8 @@
+//go:build !rocksdb
+
+package database
+
+// Checkpoint panics, as RocksDB is not supported without the rocksdb build tag.
+func (db *rocksDB) Checkpoint(string) error {
+	panic("For RocksDB support please compile with '-tags rocksdb'")
+}
@@ -0,0 +1,208 @@
+package socks5
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/transport"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+// ErrUnsupportedAddress is returned when a multiaddress can not be dialed through the SOCKS5 proxy.
+var ErrUnsupportedAddress = errors.New("address can not be dialed through the SOCKS5 proxy")
+
+// region Transport ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Transport is a libp2p transport that routes all outgoing TCP connections through a SOCKS5 proxy (e.g. the SOCKS port
+// of a Tor daemon). Host names and onion addresses are resolved by the proxy, so no DNS requests leak to the local
+// resolver. Incoming connections are accepted on a regular TCP listener (e.g. the local target of an onion service).
+type Transport struct {
+	upgrader transport.Upgrader
+	rcmgr    network.ResourceManager
+	dialer   proxy.ContextDialer
+}
+
+// NewTransport returns a constructor for a Transport that dials through the SOCKS5 proxy at the given address, which
+// can be passed to libp2p.Transport. The credentials are only used if the username is not empty.
+func NewTransport(proxyAddress, username, password string) (constructor func(upgrader transport.Upgrader, rcmgr network.ResourceManager) (*Transport, error)) {
+	return func(upgrader transport.Upgrader, rcmgr network.ResourceManager) (*Transport, error) {
+		var auth *proxy.Auth
+		if username != "" {
+			auth = &proxy.Auth{User: username, Password: password}
+		}
+
+		dialer, err := proxy.SOCKS5("tcp", proxyAddress, auth, proxy.Direct)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create SOCKS5 dialer for proxy %s", proxyAddress)
+		}
+
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return nil, errors.Errorf("SOCKS5 dialer for proxy %s does not support contexts", proxyAddress)
+		}
+
+		if rcmgr == nil {
+			rcmgr = &network.NullResourceManager{}
+		}
+
+		return &Transport{
+			upgrader: upgrader,
+			rcmgr:    rcmgr,
+			dialer:   contextDialer,
+		}, nil
+	}
+}
+
+// CanDial returns true if the given multiaddress can be dialed through the proxy.
+func (t *Transport) CanDial(addr ma.Multiaddr) bool {
+	_, err := DialAddress(addr)
+	return err == nil
+}
+
+// Dial dials the peer at the remote address through the proxy.
+func (t *Transport) Dial(ctx context.Context, raddr ma.Multiaddr, p peer.ID) (conn transport.CapableConn, err error) {
+	dialAddress, err := DialAddress(raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	connScope, err := t.rcmgr.OpenConnection(network.DirOutbound, true, raddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resource manager blocked outgoing connection to %s", raddr)
+	}
+	defer func() {
+		if err != nil {
+			connScope.Done()
+		}
+	}()
+
+	if err = connScope.SetPeer(p); err != nil {
+		return nil, errors.Wrapf(err, "resource manager blocked outgoing connection to peer %s", p)
+	}
+
+	netConn, err := t.dialer.DialContext(ctx, "tcp", dialAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial %s through SOCKS5 proxy", dialAddress)
+	}
+
+	localAddr, err := manet.FromNetAddr(netConn.LocalAddr())
+	if err != nil {
+		_ = netConn.Close()
+		return nil, errors.Wrap(err, "failed to convert local address")
+	}
+
+	if conn, err = t.upgrader.Upgrade(ctx, t, &proxiedConn{Conn: netConn, localAddr: localAddr, remoteAddr: raddr}, network.DirOutbound, p, connScope); err != nil {
+		return nil, errors.Wrapf(err, "failed to upgrade connection to %s", raddr)
+	}
+
+	return conn, nil
+}
+
+// Listen listens for incoming connections on the given (local) TCP multiaddress.
+func (t *Transport) Listen(laddr ma.Multiaddr) (transport.Listener, error) {
+	listener, err := manet.Listen(laddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen on %s", laddr)
+	}
+
+	return t.upgrader.UpgradeListener(t, listener), nil
+}
+
+// Protocols returns the list of terminal protocols this transport can dial.
+func (t *Transport) Protocols() []int {
+	return []int{ma.P_TCP, ma.P_ONION3}
+}
+
+// Proxy returns true as all connections are routed through the SOCKS5 proxy.
+func (t *Transport) Proxy() bool {
+	return true
+}
+
+// String returns a human-readable version of the Transport.
+func (t *Transport) String() string {
+	return "SOCKS5"
+}
+
+var _ transport.Transport = new(Transport)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DialAddress //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DialAddress converts the given multiaddress into the host:port form that is sent to the proxy. Supported are
+// /ip4, /ip6, /dns, /dns4 and /dns6 addresses followed by /tcp as well as /onion3 addresses.
+func DialAddress(addr ma.Multiaddr) (dialAddress string, err error) {
+	protocols := addr.Protocols()
+	switch {
+	case len(protocols) == 1 && protocols[0].Code == ma.P_ONION3:
+		onionAddress, valueErr := addr.ValueForProtocol(ma.P_ONION3)
+		if valueErr != nil {
+			return "", errors.Wrapf(ErrUnsupportedAddress, "invalid onion address %s: %s", addr, valueErr)
+		}
+
+		host, port, found := strings.Cut(onionAddress, ":")
+		if !found {
+			return "", errors.Wrapf(ErrUnsupportedAddress, "onion address %s has no port", addr)
+		}
+
+		return net.JoinHostPort(strings.ToLower(host)+".onion", port), nil
+	case len(protocols) == 2 && protocols[1].Code == ma.P_TCP:
+		switch protocols[0].Code {
+		case ma.P_IP4, ma.P_IP6, ma.P_DNS, ma.P_DNS4, ma.P_DNS6:
+		default:
+			return "", errors.Wrapf(ErrUnsupportedAddress, "unsupported network protocol in %s", addr)
+		}
+
+		host, valueErr := addr.ValueForProtocol(protocols[0].Code)
+		if valueErr != nil {
+			return "", errors.Wrapf(ErrUnsupportedAddress, "invalid host in %s: %s", addr, valueErr)
+		}
+
+		port, valueErr := addr.ValueForProtocol(ma.P_TCP)
+		if valueErr != nil {
+			return "", errors.Wrapf(ErrUnsupportedAddress, "invalid port in %s: %s", addr, valueErr)
+		}
+
+		if _, parseErr := strconv.ParseUint(port, 10, 16); parseErr != nil {
+			return "", errors.Wrapf(ErrUnsupportedAddress, "invalid port in %s: %s", addr, parseErr)
+		}
+
+		return net.JoinHostPort(host, port), nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedAddress, "%s", addr)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region proxiedConn //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// proxiedConn is a net.Conn to the proxy that reports the dialed multiaddress (instead of the proxy) as its remote
+// address.
+type proxiedConn struct {
+	net.Conn
+
+	localAddr  ma.Multiaddr
+	remoteAddr ma.Multiaddr
+}
+
+// LocalMultiaddr returns the local multiaddress of the connection to the proxy.
+func (p *proxiedConn) LocalMultiaddr() ma.Multiaddr {
+	return p.localAddr
+}
+
+// RemoteMultiaddr returns the multiaddress that was dialed through the proxy.
+func (p *proxiedConn) RemoteMultiaddr() ma.Multiaddr {
+	return p.remoteAddr
+}
+
+var _ manet.Conn = new(proxiedConn)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,31 @@
+package socks5
+
+import (
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialAddress(t *testing.T) {
+	for addr, expected := range map[string]string{
+		"/ip4/1.2.3.4/tcp/14666":           "1.2.3.4:14666",
+		"/ip6/::1/tcp/14666":               "[::1]:14666",
+		"/dns4/node.example.com/tcp/14666": "node.example.com:14666",
+		"/dns/node.example.com/tcp/14666":  "node.example.com:14666",
+		"/onion3/vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd:14666": "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd.onion:14666",
+	} {
+		dialAddress, err := DialAddress(ma.StringCast(addr))
+		require.NoError(t, err, addr)
+		require.Equal(t, expected, dialAddress, addr)
+	}
+
+	for _, addr := range []string{
+		"/ip4/1.2.3.4/udp/14626",
+		"/ip4/1.2.3.4",
+		"/ip4/1.2.3.4/tcp/14666/ws",
+	} {
+		_, err := DialAddress(ma.StringCast(addr))
+		require.ErrorIs(t, err, ErrUnsupportedAddress, addr)
+	}
+}
@@ -0,0 +1,129 @@
+package outputproof
+
+import (
+	"github.com/celestiaorg/smt"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/model"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+var (
+	// ErrUntrustedCommitment is returned if a Proof refers to a different commitment than the trusted one.
+	ErrUntrustedCommitment = errors.New("proof does not refer to the trusted commitment")
+
+	// ErrRootsMismatch is returned if the roots of a Proof do not belong to its commitment.
+	ErrRootsMismatch = errors.New("roots do not match the commitment")
+
+	// ErrInvalidProof is returned if the merkle proof of a Proof does not prove the inclusion of the output.
+	ErrInvalidProof = errors.New("output is not included in the state root")
+)
+
+// unspentLeafValue is the value of the leaves of the sparse merkle tree of the unspent outputs (see ads.Set).
+var unspentLeafValue = []byte{1}
+
+// region Proof ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Proof is a compact proof that an output is unspent in the ledger state of a commitment. It contains the commitment,
+// the roots that the commitment commits to and a compact merkle proof of the output against the state root, so that
+// it can be verified by light clients that only know the ID of the commitment.
+type Proof struct {
+	model.Immutable[Proof, *Proof, proof] `serix:"0"`
+}
+
+type proof struct {
+	Commitment   *commitment.Commitment `serix:"0"`
+	Roots        *commitment.Roots      `serix:"1"`
+	OutputID     utxo.OutputID          `serix:"2"`
+	SideNodes    []types.Identifier     `serix:"3,lengthPrefixType=uint16"`
+	BitMask      []byte                 `serix:"4,lengthPrefixType=uint16"`
+	NumSideNodes uint16                 `serix:"5"`
+}
+
+// New creates a new Proof from the given commitment, its roots and the compact merkle proof of the output.
+func New(commitment *commitment.Commitment, roots *commitment.Roots, outputID utxo.OutputID, merkleProof smt.SparseCompactMerkleProof) (newProof *Proof, err error) {
+	if merkleProof.NonMembershipLeafData != nil {
+		return nil, errors.Errorf("merkle proof of %s is a non-membership proof", outputID)
+	}
+
+	sideNodes := make([]types.Identifier, len(merkleProof.SideNodes))
+	for i, sideNode := range merkleProof.SideNodes {
+		if len(sideNode) != len(types.Identifier{}) {
+			return nil, errors.Errorf("side node %d of the merkle proof of %s has an invalid length of %d", i, outputID, len(sideNode))
+		}
+
+		copy(sideNodes[i][:], sideNode)
+	}
+
+	return model.NewImmutable[Proof](&proof{
+		Commitment:   commitment,
+		Roots:        roots,
+		OutputID:     outputID,
+		SideNodes:    sideNodes,
+		BitMask:      merkleProof.BitMask,
+		NumSideNodes: uint16(merkleProof.NumSideNodes),
+	}), nil
+}
+
+// Commitment returns the commitment that the Proof refers to.
+func (p *Proof) Commitment() (commitment *commitment.Commitment) {
+	return p.M.Commitment
+}
+
+// Roots returns the roots of the commitment that the Proof refers to.
+func (p *Proof) Roots() (roots *commitment.Roots) {
+	return p.M.Roots
+}
+
+// OutputID returns the ID of the output whose inclusion is proven.
+func (p *Proof) OutputID() (outputID utxo.OutputID) {
+	return p.M.OutputID
+}
+
+// MerkleProof returns the compact merkle proof of the output against the state root.
+func (p *Proof) MerkleProof() (merkleProof smt.SparseCompactMerkleProof) {
+	merkleProof = smt.SparseCompactMerkleProof{
+		SideNodes:    make([][]byte, len(p.M.SideNodes)),
+		BitMask:      p.M.BitMask,
+		NumSideNodes: int(p.M.NumSideNodes),
+	}
+	for i, sideNode := range p.M.SideNodes {
+		merkleProof.SideNodes[i] = lo.CopySlice(sideNode[:])
+	}
+
+	return merkleProof
+}
+
+// Verify checks that the Proof refers to the commitment with the given (trusted) ID and that the output is unspent in
+// the ledger state of that commitment.
+func (p *Proof) Verify(trustedCommitmentID commitment.ID) (err error) {
+	if p.M.Commitment == nil || p.M.Roots == nil {
+		return errors.WithMessage(ErrInvalidProof, "proof is incomplete")
+	}
+
+	if commitmentID := p.M.Commitment.ID(); commitmentID != trustedCommitmentID {
+		return errors.WithMessagef(ErrUntrustedCommitment, "expected %s but got %s", trustedCommitmentID, commitmentID)
+	}
+
+	if p.M.Roots.ID() != p.M.Commitment.RootsID() {
+		return errors.WithMessagef(ErrRootsMismatch, "roots of %s", trustedCommitmentID)
+	}
+
+	outputIDBytes, err := p.M.OutputID.Bytes()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize %s", p.M.OutputID)
+	}
+
+	stateRoot := p.M.Roots.StateRoot()
+	if !smt.VerifyCompactProof(p.MerkleProof(), stateRoot[:], outputIDBytes, unspentLeafValue, lo.PanicOnErr(blake2b.New256(nil))) {
+		return errors.WithMessagef(ErrInvalidProof, "%s is not unspent in %s", p.M.OutputID, trustedCommitmentID)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,105 @@
+package outputproof
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/smt"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestProof(t *testing.T) {
+	store := mapdb.NewMapDB()
+	unspentOutputIDs := ads.NewSet[utxo.OutputID](store)
+
+	outputIDs := make([]utxo.OutputID, 10)
+	for i := range outputIDs {
+		require.NoError(t, outputIDs[i].FromRandomness())
+		unspentOutputIDs.Add(outputIDs[i])
+	}
+
+	roots := commitment.NewRoots(randomIdentifier(t), randomIdentifier(t), randomIdentifier(t), unspentOutputIDs.Root(), randomIdentifier(t))
+	committed := commitment.New(1, commitment.ID{}, roots.ID(), 10)
+
+	proof, err := New(committed, roots, outputIDs[3], proveInclusion(t, store, unspentOutputIDs.Root(), outputIDs[3]))
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, proof.Verify(committed.ID()))
+	})
+
+	t.Run("Serialization", func(t *testing.T) {
+		proofBytes, err := proof.Bytes()
+		require.NoError(t, err)
+
+		decodedProof := new(Proof)
+		consumedBytes, err := decodedProof.FromBytes(proofBytes)
+		require.NoError(t, err)
+		require.Equal(t, len(proofBytes), consumedBytes)
+		require.Equal(t, outputIDs[3], decodedProof.OutputID())
+		require.NoError(t, decodedProof.Verify(committed.ID()))
+	})
+
+	t.Run("UntrustedCommitment", func(t *testing.T) {
+		otherCommitment := commitment.New(1, commitment.ID{}, roots.ID(), 11)
+		require.ErrorIs(t, proof.Verify(otherCommitment.ID()), ErrUntrustedCommitment)
+	})
+
+	t.Run("RootsMismatch", func(t *testing.T) {
+		otherRoots := commitment.NewRoots(randomIdentifier(t), randomIdentifier(t), randomIdentifier(t), unspentOutputIDs.Root(), randomIdentifier(t))
+		tamperedProof, err := New(committed, otherRoots, outputIDs[3], proof.MerkleProof())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, tamperedProof.Verify(committed.ID()), ErrRootsMismatch)
+	})
+
+	t.Run("OtherOutput", func(t *testing.T) {
+		tamperedProof, err := New(committed, roots, outputIDs[4], proof.MerkleProof())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, tamperedProof.Verify(committed.ID()), ErrInvalidProof)
+	})
+
+	t.Run("SpentOutput", func(t *testing.T) {
+		stateRoot := unspentOutputIDs.Root()
+		staleProof := proveInclusion(t, store, stateRoot, outputIDs[5])
+
+		unspentOutputIDs.Delete(outputIDs[5])
+		spentRoots := commitment.NewRoots(roots.TangleRoot(), roots.StateMutationRoot(), roots.ActivityRoot(), unspentOutputIDs.Root(), roots.ManaRoot())
+		spentCommitment := commitment.New(2, committed.ID(), spentRoots.ID(), 10)
+
+		tamperedProof, err := New(spentCommitment, spentRoots, outputIDs[5], staleProof)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, tamperedProof.Verify(spentCommitment.ID()), ErrInvalidProof)
+	})
+}
+
+// proveInclusion creates a compact merkle proof of the given output in the ads.Set that is stored in the given store.
+func proveInclusion(t *testing.T, store kvstore.KVStore, root types.Identifier, outputID utxo.OutputID) (proof smt.SparseCompactMerkleProof) {
+	tree := smt.ImportSparseMerkleTree(
+		lo.PanicOnErr(store.WithExtendedRealm([]byte{ads.PrefixSMTKeysStorage})),
+		lo.PanicOnErr(store.WithExtendedRealm([]byte{ads.PrefixSMTValuesStorage})),
+		lo.PanicOnErr(blake2b.New256(nil)),
+		root[:],
+	)
+
+	proof, err := tree.ProveCompact(lo.PanicOnErr(outputID.Bytes()))
+	require.NoError(t, err)
+
+	return proof
+}
+
+func randomIdentifier(t *testing.T) (identifier types.Identifier) {
+	require.NoError(t, identifier.FromRandomness())
+
+	return identifier
+}
@@ -0,0 +1,76 @@
+package ratecounter
+
+import (
+	"sync"
+	"time"
+)
+
+// RateCounter is a thread safe counter that determines the number of events within a sliding window. It counts the
+// events in buckets of one second, so its memory usage is bounded by the length of the window.
+type RateCounter struct {
+	// buckets contains the number of events that happened in the second denoted by the corresponding timestamp.
+	buckets []uint64
+
+	// timestamps contains the unix timestamps (in seconds) that the buckets correspond to.
+	timestamps []int64
+
+	// mutex is used to synchronize access to the buckets.
+	mutex sync.Mutex
+}
+
+// New creates a new RateCounter with the given window (rounded up to full seconds).
+func New(window time.Duration) *RateCounter {
+	windowSeconds := int((window + time.Second - 1) / time.Second)
+	if windowSeconds < 1 {
+		windowSeconds = 1
+	}
+
+	return &RateCounter{
+		buckets:    make([]uint64, windowSeconds),
+		timestamps: make([]int64, windowSeconds),
+	}
+}
+
+// Add records the given number of events that happened at the given time.
+func (r *RateCounter) Add(now time.Time, count int) {
+	if count <= 0 {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	second := now.Unix()
+	index := int(second % int64(len(r.buckets)))
+	if r.timestamps[index] != second {
+		r.timestamps[index] = second
+		r.buckets[index] = 0
+	}
+
+	r.buckets[index] += uint64(count)
+}
+
+// Increment records a single event that happened at the given time.
+func (r *RateCounter) Increment(now time.Time) {
+	r.Add(now, 1)
+}
+
+// Count returns the number of events within the window that ends at the given time.
+func (r *RateCounter) Count(now time.Time) (count int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	windowStart := now.Unix() - int64(len(r.buckets))
+	for index, timestamp := range r.timestamps {
+		if timestamp > windowStart {
+			count += int(r.buckets[index])
+		}
+	}
+
+	return count
+}
+
+// Rate returns the average number of events per second within the window that ends at the given time.
+func (r *RateCounter) Rate(now time.Time) float64 {
+	return float64(r.Count(now)) / float64(len(r.buckets))
+}
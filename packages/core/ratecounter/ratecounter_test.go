@@ -0,0 +1,34 @@
+package ratecounter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateCounter(t *testing.T) {
+	now := time.Now()
+
+	rateCounter := New(time.Minute)
+	rateCounter.Add(now, 2)
+	rateCounter.Add(now.Add(30*time.Second), 3)
+	rateCounter.Add(now.Add(40*time.Second), 0)
+
+	require.Equal(t, 5, rateCounter.Count(now.Add(59*time.Second)))
+	require.Equal(t, 3, rateCounter.Count(now.Add(time.Minute)))
+	require.Equal(t, 0, rateCounter.Count(now.Add(90*time.Second)))
+}
+
+func TestRateCounter_Rate(t *testing.T) {
+	now := time.Now()
+
+	rateCounter := New(10 * time.Second)
+	for i := 0; i < 20; i++ {
+		rateCounter.Increment(now.Add(time.Duration(i) * time.Second))
+	}
+
+	require.Equal(t, 10, rateCounter.Count(now.Add(19*time.Second)))
+	require.Equal(t, 1.0, rateCounter.Rate(now.Add(19*time.Second)))
+	require.Zero(t, rateCounter.Rate(now.Add(time.Minute)))
+}
@@ -0,0 +1,129 @@
+package remotestorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+)
+
+// ObjectReader is an io.ReadSeekCloser that streams an object from a remote object storage by requesting consecutive
+// byte ranges of the object.
+type ObjectReader struct {
+	ctx           context.Context
+	client        client
+	bucket        string
+	key           string
+	size          int64
+	offset        int64
+	buffer        []byte
+	bufferOffset  int64
+	readAheadSize int64
+}
+
+// newObjectReader creates a new ObjectReader for the given object.
+func newObjectReader(ctx context.Context, objectClient client, bucket, key string, readAheadSize int64) (reader *ObjectReader, err error) {
+	head, err := objectClient.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve object %s/%s", bucket, key)
+	}
+
+	return &ObjectReader{
+		ctx:           ctx,
+		client:        objectClient,
+		bucket:        bucket,
+		key:           key,
+		size:          aws.Int64Value(head.ContentLength),
+		readAheadSize: readAheadSize,
+	}, nil
+}
+
+// Read reads up to len(p) bytes from the current offset of the object.
+func (o *ObjectReader) Read(p []byte) (n int, err error) {
+	if o.offset >= o.size {
+		return 0, io.EOF
+	}
+
+	if o.offset < o.bufferOffset || o.offset >= o.bufferOffset+int64(len(o.buffer)) {
+		if err = o.fill(); err != nil {
+			return 0, err
+		}
+	}
+
+	n = copy(p, o.buffer[o.offset-o.bufferOffset:])
+	o.offset += int64(n)
+
+	return n, nil
+}
+
+// Seek sets the offset for the next Read.
+func (o *ObjectReader) Seek(offset int64, whence int) (newOffset int64, err error) {
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = o.offset + offset
+	case io.SeekEnd:
+		newOffset = o.size + offset
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, errors.Errorf("negative offset %d", newOffset)
+	}
+	o.offset = newOffset
+
+	return newOffset, nil
+}
+
+// Size returns the size of the object.
+func (o *ObjectReader) Size() int64 {
+	return o.size
+}
+
+// Close releases the buffered data of the ObjectReader.
+func (o *ObjectReader) Close() error {
+	o.buffer = nil
+
+	return nil
+}
+
+// fill requests the next byte range (starting at the current offset) of the object.
+func (o *ObjectReader) fill() (err error) {
+	end := o.offset + o.readAheadSize
+	if end > o.size {
+		end = o.size
+	}
+
+	object, err := o.client.GetObjectWithContext(o.ctx, &s3.GetObjectInput{
+		Bucket: aws.String(o.bucket),
+		Key:    aws.String(o.key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", o.offset, end-1)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to read bytes %d-%d of object %s/%s", o.offset, end-1, o.bucket, o.key)
+	}
+	defer object.Body.Close()
+
+	buffer := o.buffer[:0]
+	if cap(buffer) < int(end-o.offset) {
+		buffer = make([]byte, 0, end-o.offset)
+	}
+
+	buffer = buffer[:end-o.offset]
+	if _, err = io.ReadFull(object.Body, buffer); err != nil {
+		return errors.Wrapf(err, "failed to read bytes %d-%d of object %s/%s", o.offset, end-1, o.bucket, o.key)
+	}
+
+	o.buffer = buffer
+	o.bufferOffset = o.offset
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package remotestorage
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+const (
+	// SchemeS3 is the URL scheme of objects that are stored in an S3-compatible object storage.
+	SchemeS3 = "s3"
+
+	// SchemeGCS is the URL scheme of objects that are stored in Google Cloud Storage.
+	SchemeGCS = "gs"
+
+	// gcsEndpoint is the endpoint of the S3-compatible (XML) API of Google Cloud Storage.
+	gcsEndpoint = "https://storage.googleapis.com"
+
+	// minPartSize is the minimum size of the parts of a multipart upload (except for the last part).
+	minPartSize = 5 << 20
+
+	// maxParts is the maximum number of parts of a multipart upload.
+	maxParts = 10000
+)
+
+// ErrUnsupportedURL is returned if a URL does not refer to an object in a supported object storage.
+var ErrUnsupportedURL = errors.New("unsupported object storage url")
+
+// region Storage //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Storage provides streaming access to objects in remote object storages (s3:// and gs:// URLs), so that large files
+// (e.g. snapshots) can be read and written without staging them on a local disk.
+type Storage struct {
+	clients      map[string]client
+	clientsMutex sync.Mutex
+
+	optsS3Endpoint       string
+	optsS3Region         string
+	optsS3ForcePathStyle bool
+	optsS3Credentials    *credentials.Credentials
+	optsGCSCredentials   *credentials.Credentials
+	optsPartSize         int64
+	optsReadAheadSize    int64
+}
+
+// New creates a new Storage (credentials that are not configured explicitly are resolved by the default credential
+// chain of the AWS SDK, i.e. from the environment or the shared credentials file).
+func New(opts ...options.Option[Storage]) *Storage {
+	return options.Apply(&Storage{
+		clients: make(map[string]client),
+
+		optsS3Region:      "us-east-1",
+		optsPartSize:      16 << 20,
+		optsReadAheadSize: 8 << 20,
+	}, opts)
+}
+
+// Open opens the object with the given URL for reading.
+func (s *Storage) Open(ctx context.Context, objectURL string) (reader *ObjectReader, err error) {
+	scheme, bucket, key, err := ParseURL(objectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	objectClient, err := s.client(scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return newObjectReader(ctx, objectClient, bucket, key, s.optsReadAheadSize)
+}
+
+// Create creates (or replaces) the object with the given URL and opens it for writing. The object only becomes visible
+// after the returned ObjectWriter was closed successfully.
+func (s *Storage) Create(ctx context.Context, objectURL string) (writer *ObjectWriter, err error) {
+	scheme, bucket, key, err := ParseURL(objectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	objectClient, err := s.client(scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return newObjectWriter(ctx, objectClient, bucket, key, s.optsPartSize)
+}
+
+// client returns the (lazily created) client for the object storage with the given scheme.
+func (s *Storage) client(scheme string) (objectClient client, err error) {
+	s.clientsMutex.Lock()
+	defer s.clientsMutex.Unlock()
+
+	if objectClient, exists := s.clients[scheme]; exists {
+		return objectClient, nil
+	}
+
+	config := aws.NewConfig().WithRegion(s.optsS3Region)
+	switch scheme {
+	case SchemeS3:
+		config = config.WithS3ForcePathStyle(s.optsS3ForcePathStyle)
+		if s.optsS3Endpoint != "" {
+			config = config.WithEndpoint(s.optsS3Endpoint)
+		}
+		if s.optsS3Credentials != nil {
+			config = config.WithCredentials(s.optsS3Credentials)
+		}
+	case SchemeGCS:
+		config = config.WithEndpoint(gcsEndpoint)
+		if s.optsGCSCredentials != nil {
+			config = config.WithCredentials(s.optsGCSCredentials)
+		}
+	}
+
+	storageSession, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create session for %s://", scheme)
+	}
+
+	objectClient = s3.New(storageSession)
+	s.clients[scheme] = objectClient
+
+	return objectClient, nil
+}
+
+// WithS3Endpoint sets the endpoint of the S3-compatible object storage (empty uses AWS S3).
+func WithS3Endpoint(endpoint string) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsS3Endpoint = endpoint
+	}
+}
+
+// WithS3Region sets the region of the S3-compatible object storage.
+func WithS3Region(region string) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsS3Region = region
+	}
+}
+
+// WithS3ForcePathStyle enables path-style addressing of buckets (required by most self-hosted object storages).
+func WithS3ForcePathStyle(forcePathStyle bool) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsS3ForcePathStyle = forcePathStyle
+	}
+}
+
+// WithS3Credentials sets static credentials for the S3-compatible object storage.
+func WithS3Credentials(accessKeyID, secretAccessKey string) options.Option[Storage] {
+	return func(s *Storage) {
+		if accessKeyID != "" {
+			s.optsS3Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
+		}
+	}
+}
+
+// WithGCSCredentials sets the HMAC keys that are used to access Google Cloud Storage.
+func WithGCSCredentials(accessKeyID, secretAccessKey string) options.Option[Storage] {
+	return func(s *Storage) {
+		if accessKeyID != "" {
+			s.optsGCSCredentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
+		}
+	}
+}
+
+// WithPartSize sets the size of the parts that are uploaded by an ObjectWriter (at least 5 MiB).
+func WithPartSize(partSize int64) options.Option[Storage] {
+	return func(s *Storage) {
+		if partSize < minPartSize {
+			partSize = minPartSize
+		}
+
+		s.optsPartSize = partSize
+	}
+}
+
+// WithReadAheadSize sets the number of bytes that an ObjectReader requests at once.
+func WithReadAheadSize(readAheadSize int64) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsReadAheadSize = readAheadSize
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region URLs /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IsRemoteURL returns true if the given path refers to an object in a supported object storage.
+func IsRemoteURL(path string) bool {
+	return strings.HasPrefix(path, SchemeS3+"://") || strings.HasPrefix(path, SchemeGCS+"://")
+}
+
+// ParseURL splits the given object URL (i.e. s3://bucket/key) into its scheme, bucket and key.
+func ParseURL(objectURL string) (scheme, bucket, key string, err error) {
+	parsedURL, err := url.Parse(objectURL)
+	if err != nil {
+		return "", "", "", errors.WithMessagef(ErrUnsupportedURL, "failed to parse %s: %s", objectURL, err.Error())
+	}
+
+	if parsedURL.Scheme != SchemeS3 && parsedURL.Scheme != SchemeGCS {
+		return "", "", "", errors.WithMessagef(ErrUnsupportedURL, "unknown scheme in %s", objectURL)
+	} else if parsedURL.Host == "" {
+		return "", "", "", errors.WithMessagef(ErrUnsupportedURL, "missing bucket in %s", objectURL)
+	} else if key = strings.TrimPrefix(parsedURL.Path, "/"); key == "" {
+		return "", "", "", errors.WithMessagef(ErrUnsupportedURL, "missing object key in %s", objectURL)
+	}
+
+	return parsedURL.Scheme, parsedURL.Host, key, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region client ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// client is the subset of the S3 API that is used to stream objects (it is implemented by *s3.S3).
+type client interface {
+	HeadObjectWithContext(ctx aws.Context, input *s3.HeadObjectInput, opts ...request.Option) (*s3.HeadObjectOutput, error)
+	GetObjectWithContext(ctx aws.Context, input *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error)
+	CreateMultipartUploadWithContext(ctx aws.Context, input *s3.CreateMultipartUploadInput, opts ...request.Option) (*s3.CreateMultipartUploadOutput, error)
+	UploadPartWithContext(ctx aws.Context, input *s3.UploadPartInput, opts ...request.Option) (*s3.UploadPartOutput, error)
+	CompleteMultipartUploadWithContext(ctx aws.Context, input *s3.CompleteMultipartUploadInput, opts ...request.Option) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUploadWithContext(ctx aws.Context, input *s3.AbortMultipartUploadInput, opts ...request.Option) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
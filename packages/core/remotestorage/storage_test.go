@@ -0,0 +1,224 @@
+package remotestorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/stream"
+)
+
+func TestParseURL(t *testing.T) {
+	scheme, bucket, key, err := ParseURL("s3://snapshots/devnet/snapshot.bin")
+	require.NoError(t, err)
+	require.Equal(t, SchemeS3, scheme)
+	require.Equal(t, "snapshots", bucket)
+	require.Equal(t, "devnet/snapshot.bin", key)
+
+	scheme, _, _, err = ParseURL("gs://snapshots/snapshot.bin")
+	require.NoError(t, err)
+	require.Equal(t, SchemeGCS, scheme)
+
+	for _, invalidURL := range []string{"./snapshot.bin", "https://snapshots/snapshot.bin", "s3:///snapshot.bin", "s3://snapshots/"} {
+		_, _, _, err = ParseURL(invalidURL)
+		require.ErrorIs(t, err, ErrUnsupportedURL, invalidURL)
+	}
+
+	require.True(t, IsRemoteURL("gs://snapshots/snapshot.bin"))
+	require.False(t, IsRemoteURL("/tmp/snapshot.bin"))
+}
+
+func TestObjectWriter(t *testing.T) {
+	objectClient := newMockedClient()
+
+	writer, err := newObjectWriter(context.Background(), objectClient, "bucket", "snapshot.bin", 16)
+	require.NoError(t, err)
+
+	expected := new(bytes.Buffer)
+	require.NoError(t, stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
+		for i := 0; i < 10; i++ {
+			blob := []byte(fmt.Sprintf("element-%d", i))
+			if err = stream.WriteBlob(writer, blob); err != nil {
+				return 0, err
+			}
+			require.NoError(t, stream.WriteBlob(&writeSeekBuffer{Buffer: expected}, blob))
+			elementsCount++
+		}
+
+		// only the part with the placeholder of the elements count is kept in memory
+		require.Len(t, writer.pendingParts, 2)
+
+		return elementsCount, nil
+	}))
+	require.NoError(t, writer.Close())
+
+	object, exists := objectClient.object("bucket", "snapshot.bin")
+	require.True(t, exists)
+	require.Equal(t, uint64(10), uint64(object[0]))
+	require.Equal(t, expected.Bytes(), object[8:])
+
+	reader, err := newObjectReader(context.Background(), objectClient, "bucket", "snapshot.bin", 7)
+	require.NoError(t, err)
+	require.EqualValues(t, len(object), reader.Size())
+
+	readBytes, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, object, readBytes)
+
+	_, err = reader.Seek(8, io.SeekStart)
+	require.NoError(t, err)
+	blob, err := stream.ReadBlob(reader)
+	require.NoError(t, err)
+	require.Equal(t, []byte("element-0"), blob)
+	require.NoError(t, reader.Close())
+}
+
+func TestObjectWriter_Errors(t *testing.T) {
+	objectClient := newMockedClient()
+
+	writer, err := newObjectWriter(context.Background(), objectClient, "bucket", "gap.bin", 16)
+	require.NoError(t, err)
+	_, err = stream.Skip(writer, 20)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("data"))
+	require.NoError(t, err)
+	require.Error(t, writer.Close())
+	_, exists := objectClient.object("bucket", "gap.bin")
+	require.False(t, exists)
+	require.Empty(t, objectClient.uploads)
+
+	writer, err = newObjectWriter(context.Background(), objectClient, "bucket", "overwrite.bin", 16)
+	require.NoError(t, err)
+	_, err = writer.Write(make([]byte, 20))
+	require.NoError(t, err)
+	_, err = stream.GoTo(writer, 0)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("data"))
+	require.ErrorIs(t, err, ErrRegionUploaded)
+	require.NoError(t, writer.Abort())
+	require.Empty(t, objectClient.uploads)
+}
+
+// region mockedClient /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// mockedClient is an in-memory implementation of the client interface.
+type mockedClient struct {
+	objects map[string][]byte
+	uploads map[string]map[int64][]byte
+	mutex   sync.Mutex
+}
+
+func newMockedClient() *mockedClient {
+	return &mockedClient{
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int64][]byte),
+	}
+}
+
+func (m *mockedClient) object(bucket, key string) (object []byte, exists bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	object, exists = m.objects[bucket+"/"+key]
+
+	return object, exists
+}
+
+func (m *mockedClient) HeadObjectWithContext(_ aws.Context, input *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+	object, exists := m.object(*input.Bucket, *input.Key)
+	if !exists {
+		return nil, errors.New("object does not exist")
+	}
+
+	return &s3.HeadObjectOutput{ContentLength: aws.Int64(int64(len(object)))}, nil
+}
+
+func (m *mockedClient) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	object, exists := m.object(*input.Bucket, *input.Key)
+	if !exists {
+		return nil, errors.New("object does not exist")
+	}
+
+	var start, end int
+	if _, err := fmt.Sscanf(*input.Range, "bytes=%d-%d", &start, &end); err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(object[start : end+1]))}, nil
+}
+
+func (m *mockedClient) CreateMultipartUploadWithContext(_ aws.Context, input *s3.CreateMultipartUploadInput, _ ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	uploadID := fmt.Sprintf("%s/%s", *input.Bucket, *input.Key)
+	m.uploads[uploadID] = make(map[int64][]byte)
+
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil
+}
+
+func (m *mockedClient) UploadPartWithContext(_ aws.Context, input *s3.UploadPartInput, _ ...request.Option) (*s3.UploadPartOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.uploads[*input.UploadId][*input.PartNumber] = data
+
+	return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("%d", *input.PartNumber))}, nil
+}
+
+func (m *mockedClient) CompleteMultipartUploadWithContext(_ aws.Context, input *s3.CompleteMultipartUploadInput, _ ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	parts := input.MultipartUpload.Parts
+	if !sort.SliceIsSorted(parts, func(i, j int) bool { return *parts[i].PartNumber < *parts[j].PartNumber }) {
+		return nil, errors.New("parts are not sorted")
+	}
+
+	object := make([]byte, 0)
+	for _, part := range parts {
+		object = append(object, m.uploads[*input.UploadId][*part.PartNumber]...)
+	}
+	m.objects[*input.Bucket+"/"+*input.Key] = object
+	delete(m.uploads, *input.UploadId)
+
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (m *mockedClient) AbortMultipartUploadWithContext(_ aws.Context, input *s3.AbortMultipartUploadInput, _ ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.uploads, *input.UploadId)
+
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region writeSeekBuffer //////////////////////////////////////////////////////////////////////////////////////////////
+
+// writeSeekBuffer is a bytes.Buffer that can be used as an (append-only) io.WriteSeeker.
+type writeSeekBuffer struct {
+	*bytes.Buffer
+}
+
+func (w *writeSeekBuffer) Seek(int64, int) (int64, error) {
+	return int64(w.Len()), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,276 @@
+package remotestorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/bits"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+)
+
+// ErrRegionUploaded is returned if an ObjectWriter is asked to overwrite bytes of a part that was already uploaded.
+var ErrRegionUploaded = errors.New("region was already uploaded")
+
+// region ObjectWriter /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ObjectWriter is an io.WriteSeeker that streams an object to a remote object storage using a multipart upload.
+//
+// Parts are uploaded as soon as all of their bytes were written, so that only the parts that still contain skipped
+// bytes (i.e. placeholders that are filled in later by seeking back) are kept in memory.
+type ObjectWriter struct {
+	ctx            context.Context
+	client         client
+	bucket         string
+	key            string
+	uploadID       *string
+	partSize       int64
+	offset         int64
+	size           int64
+	pendingParts   map[int64]*pendingPart
+	completedParts []*s3.CompletedPart
+	uploadedParts  map[int64]bool
+	closed         bool
+}
+
+// newObjectWriter creates a new ObjectWriter that starts a multipart upload of the given object.
+func newObjectWriter(ctx context.Context, objectClient client, bucket, key string, partSize int64) (writer *ObjectWriter, err error) {
+	upload, err := objectClient.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to start upload of object %s/%s", bucket, key)
+	}
+
+	return &ObjectWriter{
+		ctx:           ctx,
+		client:        objectClient,
+		bucket:        bucket,
+		key:           key,
+		uploadID:      upload.UploadId,
+		partSize:      partSize,
+		pendingParts:  make(map[int64]*pendingPart),
+		uploadedParts: make(map[int64]bool),
+	}, nil
+}
+
+// Write writes the given bytes at the current offset of the object.
+func (o *ObjectWriter) Write(p []byte) (n int, err error) {
+	if o.closed {
+		return 0, errors.New("writer is closed")
+	}
+
+	for n < len(p) {
+		partIndex, partOffset := o.offset/o.partSize, o.offset%o.partSize
+		if o.uploadedParts[partIndex] {
+			return n, errors.WithMessagef(ErrRegionUploaded, "failed to write at offset %d", o.offset)
+		}
+
+		part, exists := o.pendingParts[partIndex]
+		if !exists {
+			part = newPendingPart(o.partSize)
+			o.pendingParts[partIndex] = part
+		}
+
+		written := part.write(partOffset, p[n:])
+		n += written
+		if o.offset += int64(written); o.offset > o.size {
+			o.size = o.offset
+		}
+
+		if part.filled == o.partSize {
+			if err = o.uploadPart(partIndex, part.data); err != nil {
+				return n, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// Seek sets the offset for the next Write (seeking beyond the end of the object leaves a gap that has to be written
+// before the ObjectWriter is closed).
+func (o *ObjectWriter) Seek(offset int64, whence int) (newOffset int64, err error) {
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = o.offset + offset
+	case io.SeekEnd:
+		newOffset = o.size + offset
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+
+	if newOffset < 0 {
+		return 0, errors.Errorf("negative offset %d", newOffset)
+	}
+	o.offset = newOffset
+
+	return newOffset, nil
+}
+
+// Close uploads the remaining parts and completes the upload (the upload is aborted if it can not be completed).
+func (o *ObjectWriter) Close() (err error) {
+	if o.closed {
+		return nil
+	}
+
+	if err = o.uploadRemainingParts(); err != nil {
+		return withAbortError(err, o.Abort())
+	}
+	o.closed = true
+
+	sort.Slice(o.completedParts, func(i, j int) bool {
+		return aws.Int64Value(o.completedParts[i].PartNumber) < aws.Int64Value(o.completedParts[j].PartNumber)
+	})
+
+	if _, err = o.client.CompleteMultipartUploadWithContext(o.ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(o.bucket),
+		Key:             aws.String(o.key),
+		UploadId:        o.uploadID,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: o.completedParts},
+	}); err != nil {
+		return withAbortError(errors.Wrapf(err, "failed to complete upload of object %s/%s", o.bucket, o.key), o.abort())
+	}
+
+	return nil
+}
+
+// Abort cancels the upload and discards the uploaded parts.
+func (o *ObjectWriter) Abort() (err error) {
+	if o.closed {
+		return nil
+	}
+	o.closed = true
+
+	return o.abort()
+}
+
+// abort cancels the multipart upload.
+func (o *ObjectWriter) abort() (err error) {
+	o.pendingParts = nil
+
+	if _, err = o.client.AbortMultipartUploadWithContext(o.ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(o.bucket),
+		Key:      aws.String(o.key),
+		UploadId: o.uploadID,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to abort upload of object %s/%s", o.bucket, o.key)
+	}
+
+	return nil
+}
+
+// uploadRemainingParts uploads the pending parts (the last part of the object may be smaller than the part size).
+func (o *ObjectWriter) uploadRemainingParts() (err error) {
+	if o.size == 0 {
+		return o.uploadPart(0, nil)
+	}
+
+	lastPartIndex := (o.size - 1) / o.partSize
+	for partIndex := int64(0); partIndex <= lastPartIndex; partIndex++ {
+		if o.uploadedParts[partIndex] {
+			continue
+		}
+
+		expectedSize := o.partSize
+		if partIndex == lastPartIndex {
+			expectedSize = o.size - partIndex*o.partSize
+		}
+
+		part, exists := o.pendingParts[partIndex]
+		if !exists || part.filled != expectedSize {
+			return errors.Errorf("bytes of part %d were skipped but never written", partIndex+1)
+		}
+
+		if err = o.uploadPart(partIndex, part.data[:expectedSize]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uploadPart uploads the part with the given index and releases its data.
+func (o *ObjectWriter) uploadPart(partIndex int64, data []byte) (err error) {
+	if partIndex >= maxParts {
+		return errors.Errorf("object exceeds the maximum number of %d parts", maxParts)
+	}
+
+	partNumber := aws.Int64(partIndex + 1)
+	uploadedPart, err := o.client.UploadPartWithContext(o.ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(o.bucket),
+		Key:        aws.String(o.key),
+		UploadId:   o.uploadID,
+		PartNumber: partNumber,
+		Body:       bytes.NewReader(data),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to upload part %d of object %s/%s", partIndex+1, o.bucket, o.key)
+	}
+
+	o.completedParts = append(o.completedParts, &s3.CompletedPart{ETag: uploadedPart.ETag, PartNumber: partNumber})
+	o.uploadedParts[partIndex] = true
+	delete(o.pendingParts, partIndex)
+
+	return nil
+}
+
+// withAbortError adds the error of a failed abort to the error that caused the abort.
+func withAbortError(err, abortErr error) error {
+	if abortErr != nil {
+		return errors.WithMessagef(err, "%s after", abortErr.Error())
+	}
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region pendingPart //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// pendingPart is a part of an ObjectWriter that was not uploaded, yet.
+type pendingPart struct {
+	data    []byte
+	written []uint64
+	filled  int64
+}
+
+// newPendingPart creates a new empty pendingPart of the given size.
+func newPendingPart(partSize int64) *pendingPart {
+	return &pendingPart{
+		data:    make([]byte, partSize),
+		written: make([]uint64, (partSize+63)/64),
+	}
+}
+
+// write copies the given bytes to the given offset of the part and returns the number of bytes that fit into the part.
+func (p *pendingPart) write(offset int64, data []byte) (written int) {
+	written = copy(p.data[offset:], data)
+
+	for i := offset; i < offset+int64(written); {
+		word, bit := i/64, uint(i%64)
+		if bit == 0 && i+64 <= offset+int64(written) {
+			p.filled += int64(64 - bits.OnesCount64(p.written[word]))
+			p.written[word] = ^uint64(0)
+			i += 64
+
+			continue
+		}
+
+		if mask := uint64(1) << bit; p.written[word]&mask == 0 {
+			p.written[word] |= mask
+			p.filled++
+		}
+		i++
+	}
+
+	return written
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,26 @@
+package shutdown
+
+// The components that are provided and consumed by the plugins. The plugins declare these dependencies with
+// node.Plugin.Provides and node.Plugin.Consumes to derive the order in which they are started and shut down.
+const (
+	// ComponentPeerDatabase defines the database of the known peers.
+	ComponentPeerDatabase = "PeerDatabase"
+	// ComponentProtocol defines the protocol (including the storage and the ledger).
+	ComponentProtocol = "Protocol"
+	// ComponentWarpsync defines the warpsync protocol.
+	ComponentWarpsync = "Warpsync"
+	// ComponentP2P defines the p2p manager that connects the node to its neighbors.
+	ComponentP2P = "P2P"
+	// ComponentAutopeering defines the autopeering.
+	ComponentAutopeering = "Autopeering"
+	// ComponentManualpeering defines the manualpeering.
+	ComponentManualpeering = "Manualpeering"
+	// ComponentFaucet defines the faucet.
+	ComponentFaucet = "Faucet"
+	// ComponentRemoteLog defines the connection to the remote log server.
+	ComponentRemoteLog = "RemoteLog"
+	// ComponentWebAPI defines the web API server.
+	ComponentWebAPI = "WebAPI"
+	// ComponentDashboardMetrics defines the metrics that are shown by the dashboard.
+	ComponentDashboardMetrics = "DashboardMetrics"
+)
@@ -1,8 +1,13 @@
 package shutdown
 
+// The explicit shutdown priorities of the plugins (higher = earlier). They are used as the shutdown order of the plugins
+// that do not declare their dependencies with node.Plugin.Provides and node.Plugin.Consumes, and as the lower bound of
+// the order that is derived from the dependencies otherwise (see node.Plugin.ShutdownPriority).
 const (
 	// PriorityDatabase defines the shutdown priority for the database.
 	PriorityDatabase = iota
+	// PriorityBackup defines the shutdown priority for the backup plugin.
+	PriorityBackup
 	// PriorityPeerDatabase defines the shutdown priority for the peer database.
 	PriorityPeerDatabase
 	// PriorityMana defines the shutdown priority for the mana plugin.
@@ -41,6 +46,14 @@ const (
 	PrioritySynchronization
 	// PriorityActivity defines the shutdown priority for the activity plugin.
 	PriorityActivity
+	// PriorityRebroadcaster defines the shutdown priority for the rebroadcaster.
+	PriorityRebroadcaster
+	// PriorityAddressWatch defines the shutdown priority for the address watch plugin.
+	PriorityAddressWatch
+	// PriorityFirehose defines the shutdown priority for the firehose plugin.
+	PriorityFirehose
+	// PriorityJournal defines the shutdown priority for the journal plugin.
+	PriorityJournal
 	// PrioritySpammer defines the shutdown priority for spammer.
 	PrioritySpammer
 	// PriorityBootstrap defines the shutdown priority for bootstrap.
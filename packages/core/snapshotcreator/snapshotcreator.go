@@ -179,17 +179,14 @@ func (m *Options) createPledgeMap() (nodesToPledge *orderedmap.OrderedMap[*ident
 var outputCounter uint16 = 1
 
 func createOutput(ledgerVM vm.VM, publicKey ed25519.PublicKey, tokenAmount uint64, pledgeID identity.ID, includedInSlot slot.Index) (output utxo.Output, outputMetadata *mempool.OutputMetadata, err error) {
-	switch ledgerVM.(type) {
-	case *mockedvm.MockedVM:
+	if _, isMockedVM := vm.Lookup[*mockedvm.MockedVM](ledgerVM); isMockedVM {
 		output = mockedvm.NewMockedOutput(utxo.EmptyTransactionID, outputCounter, tokenAmount)
-
-	case *devnetvm.VM:
+	} else if _, isDevnetVM := vm.Lookup[*devnetvm.VM](ledgerVM); isDevnetVM {
 		output = devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{
 			devnetvm.ColorIOTA: tokenAmount,
 		}), devnetvm.NewED25519Address(publicKey))
 		output.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, outputCounter))
-
-	default:
+	} else {
 		return nil, nil, errors.Errorf("cannot create snapshot output for VM of type '%v'", ledgerVM)
 	}
 
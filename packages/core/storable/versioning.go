@@ -0,0 +1,114 @@
+package storable
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Version is the version of the serialized form of a stored model.
+type Version = byte
+
+// Unversioned is the Version of the records that were written before their model was versioned (they carry no version
+// prefix).
+const Unversioned Version = 0
+
+// Upgrade converts the serialized form of a model from one version to the next one (e.g. by appending the default value
+// of a field that was added in the next version).
+type Upgrade func(bytes []byte) (upgradedBytes []byte, err error)
+
+// Versioning prefixes the serialized form of a model with a version byte and upgrades records that were written by
+// older versions of the model to its current layout before they are decoded.
+type Versioning struct {
+	version  Version
+	upgrades map[Version]Upgrade
+}
+
+// NewVersioning creates a new Versioning for a model whose serialized form has the given current version.
+func NewVersioning(version Version) (newVersioning *Versioning) {
+	if version == Unversioned {
+		panic("the version of a stored model must be greater than 0")
+	}
+
+	return &Versioning{
+		version:  version,
+		upgrades: make(map[Version]Upgrade),
+	}
+}
+
+// WithUpgrade registers the Upgrade that converts the serialized form of the given version to the next version (an
+// Upgrade from Unversioned makes the records readable that were written before the model was versioned).
+func (v *Versioning) WithUpgrade(fromVersion Version, upgrade Upgrade) *Versioning {
+	if fromVersion >= v.version {
+		panic(errors.Errorf("can not register upgrade from version %d to current version %d", fromVersion, v.version))
+	}
+
+	v.upgrades[fromVersion] = upgrade
+
+	return v
+}
+
+// Version returns the current version of the serialized form of the model.
+func (v *Versioning) Version() Version {
+	return v.version
+}
+
+// Encode prefixes the given serialized form of the model with the current version.
+func (v *Versioning) Encode(bytes []byte) (versionedBytes []byte) {
+	versionedBytes = make([]byte, 1, len(bytes)+1)
+	versionedBytes[0] = v.version
+
+	return append(versionedBytes, bytes...)
+}
+
+// Decode upgrades the given stored record to the current layout of the model and passes it to the given decode
+// function, which has to consume all bytes of the record.
+//
+// As the records of an Unversioned layout carry no prefix, their first byte can look like a version. A record is
+// therefore only treated as Unversioned if it can not be decoded as a versioned record.
+func (v *Versioning) Decode(record []byte, decode func(bytes []byte) error) (err error) {
+	if len(record) == 0 {
+		return errors.Errorf("not enough bytes to read version")
+	}
+
+	if version := record[0]; version != Unversioned && version <= v.version {
+		if err = v.decode(version, record[1:], decode); err == nil {
+			return nil
+		}
+	} else {
+		err = errors.Errorf("unsupported version %d (current version is %d)", version, v.version)
+	}
+
+	if _, exists := v.upgrades[Unversioned]; exists {
+		if unversionedErr := v.decode(Unversioned, record, decode); unversionedErr == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// decode upgrades the given serialized form of the model from the given version to the current version and decodes it.
+func (v *Versioning) decode(version Version, bytes []byte, decode func(bytes []byte) error) (err error) {
+	for ; version < v.version; version++ {
+		upgrade, exists := v.upgrades[version]
+		if !exists {
+			return errors.Errorf("no upgrade registered from version %d to version %d", version, version+1)
+		}
+
+		if bytes, err = upgrade(bytes); err != nil {
+			return errors.Wrapf(err, "failed to upgrade from version %d to version %d", version, version+1)
+		}
+	}
+
+	return decode(bytes)
+}
+
+// AppendBytes returns an Upgrade that appends the given bytes (i.e. the serialized default value of a field that was
+// added at the end of the model) to the serialized form of the model.
+func AppendBytes(suffix []byte) Upgrade {
+	return func(bytes []byte) (upgradedBytes []byte, err error) {
+		upgradedBytes = make([]byte, 0, len(bytes)+len(suffix))
+		upgradedBytes = append(upgradedBytes, bytes...)
+
+		return append(upgradedBytes, suffix...), nil
+	}
+}
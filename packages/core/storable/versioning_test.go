@@ -0,0 +1,53 @@
+package storable_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/storable"
+)
+
+func TestVersioning(t *testing.T) {
+	versioning := storable.NewVersioning(2).
+		WithUpgrade(storable.Unversioned, storable.AppendBytes([]byte{1})).
+		WithUpgrade(1, storable.AppendBytes([]byte{2, 2}))
+
+	versionedBytes := versioning.Encode([]byte{7, 7, 1, 2, 2})
+	require.Equal(t, []byte{2, 7, 7, 1, 2, 2}, versionedBytes)
+	require.Equal(t, []byte{7, 7, 1, 2, 2}, decode(t, versioning, versionedBytes))
+
+	// records of older versions are upgraded step by step
+	require.Equal(t, []byte{7, 7, 1, 2, 2}, decode(t, versioning, []byte{1, 7, 7, 1}))
+	require.Equal(t, []byte{7, 7, 1, 2, 2}, decode(t, versioning, []byte{7, 7}))
+
+	// unversioned records whose first byte looks like a version
+	require.Equal(t, []byte{1, 7, 1, 2, 2}, decode(t, versioning, []byte{1, 7}))
+
+	// records that can not be decoded in any version are rejected
+	require.Error(t, versioning.Decode([]byte{3, 7, 7, 1, 2}, decodeModel))
+	require.Error(t, versioning.Decode(nil, decodeModel))
+
+	// unversioned records are rejected if no upgrade was registered for them
+	require.Error(t, storable.NewVersioning(1).Decode([]byte{7, 7, 1, 2, 2}, decodeModel))
+}
+
+// decodeModel decodes a model that consists of two bytes followed by the suffixes of the versions 1 and 2.
+func decodeModel(bytes []byte) error {
+	if len(bytes) != 5 || bytes[2] != 1 || bytes[3] != 2 {
+		return errors.Errorf("invalid model %v", bytes)
+	}
+
+	return nil
+}
+
+func decode(t *testing.T, versioning *storable.Versioning, record []byte) (decodedBytes []byte) {
+	require.NoError(t, versioning.Decode(record, func(bytes []byte) error {
+		decodedBytes = bytes
+
+		return decodeModel(bytes)
+	}))
+
+	return decodedBytes
+}
@@ -0,0 +1,135 @@
+package verifier
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrVerificationFailed is returned if at least one of the verified elements is invalid.
+var ErrVerificationFailed = errors.New("verification failed")
+
+// maxReportedFailures contains the maximum number of failures that are listed in the error message.
+const maxReportedFailures = 10
+
+// region Verifier /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Verifier verifies a stream of elements using a fixed number of parallel workers. The failures are aggregated by the
+// position of the elements in the stream, so that the resulting error does not depend on the scheduling of the workers.
+type Verifier[T any] struct {
+	verifyFunc    func(element T) (err error)
+	tasks         chan *task[T]
+	failures      []*Failure
+	failuresMutex sync.Mutex
+	workers       sync.WaitGroup
+	verified      int
+}
+
+// New creates a new Verifier that verifies the submitted elements with the given number of workers.
+func New[T any](workerCount int, verifyFunc func(element T) (err error)) (newVerifier *Verifier[T]) {
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	newVerifier = &Verifier[T]{
+		verifyFunc: verifyFunc,
+		tasks:      make(chan *task[T], 2*workerCount),
+	}
+
+	newVerifier.workers.Add(workerCount)
+	for i := 0; i < workerCount; i++ {
+		go newVerifier.worker()
+	}
+
+	return newVerifier
+}
+
+// Verify schedules the verification of the given element (it blocks if all workers are busy).
+func (v *Verifier[T]) Verify(element T) {
+	v.tasks <- &task[T]{index: v.verified, element: element}
+	v.verified++
+}
+
+// Wait waits until all scheduled elements were verified and returns an error that lists the failures in the order of
+// the elements.
+func (v *Verifier[T]) Wait() (err error) {
+	close(v.tasks)
+	v.workers.Wait()
+
+	if len(v.failures) == 0 {
+		return nil
+	}
+
+	sort.Slice(v.failures, func(i, j int) bool {
+		return v.failures[i].Index < v.failures[j].Index
+	})
+
+	var failureMessages strings.Builder
+	for i, failure := range v.failures {
+		if i == maxReportedFailures {
+			failureMessages.WriteString(fmt.Sprintf("; and %d more", len(v.failures)-maxReportedFailures))
+			break
+		}
+
+		if i != 0 {
+			failureMessages.WriteString("; ")
+		}
+		failureMessages.WriteString(failure.String())
+	}
+
+	return errors.WithMessagef(ErrVerificationFailed, "%d of %d elements are invalid: %s", len(v.failures), v.verified, failureMessages.String())
+}
+
+// Failures returns the failures in the order of the elements (it should only be called after Wait).
+func (v *Verifier[T]) Failures() (failures []*Failure) {
+	v.failuresMutex.Lock()
+	defer v.failuresMutex.Unlock()
+
+	return append(failures, v.failures...)
+}
+
+// worker verifies the scheduled elements until the Verifier is closed.
+func (v *Verifier[T]) worker() {
+	defer v.workers.Done()
+
+	for task := range v.tasks {
+		if err := v.verifyFunc(task.element); err != nil {
+			v.failuresMutex.Lock()
+			v.failures = append(v.failures, &Failure{Index: task.index, Err: err})
+			v.failuresMutex.Unlock()
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Failure //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Failure contains the error of an element that failed the verification.
+type Failure struct {
+	// Index contains the position of the element in the verified stream.
+	Index int
+
+	// Err contains the reason why the verification failed.
+	Err error
+}
+
+// String returns a human-readable version of the Failure.
+func (f *Failure) String() string {
+	return fmt.Sprintf("element %d: %s", f.Index, f.Err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region task /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// task is a single element that is scheduled for verification.
+type task[T any] struct {
+	index   int
+	element T
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,42 @@
+package verifier
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifier(t *testing.T) {
+	verifyEven := func(element int) (err error) {
+		if element%2 != 0 {
+			return errors.Errorf("%d is odd", element)
+		}
+
+		return nil
+	}
+
+	validVerifier := New(4, verifyEven)
+	for i := 0; i < 100; i++ {
+		validVerifier.Verify(2 * i)
+	}
+	require.NoError(t, validVerifier.Wait())
+
+	for i := 0; i < 10; i++ {
+		invalidVerifier := New(4, verifyEven)
+		for j := 0; j < 100; j++ {
+			invalidVerifier.Verify(j)
+		}
+
+		err := invalidVerifier.Wait()
+		require.ErrorIs(t, err, ErrVerificationFailed)
+		require.Contains(t, err.Error(), "50 of 100 elements are invalid: element 1: 1 is odd; element 3: 3 is odd")
+		require.Contains(t, err.Error(), "and 40 more")
+
+		failures := invalidVerifier.Failures()
+		require.Len(t, failures, 50)
+		for j, failure := range failures {
+			require.Equal(t, 2*j+1, failure.Index)
+		}
+	}
+}
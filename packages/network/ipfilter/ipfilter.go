@@ -0,0 +1,100 @@
+package ipfilter
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Filter decides whether a remote IP is allowed to connect to the node. An IP is rejected if it is part of one of the
+// denied networks or if allowed networks are configured and the IP is part of none of them.
+type Filter struct {
+	allowed []*net.IPNet
+	denied  []*net.IPNet
+	mutex   sync.RWMutex
+}
+
+// New creates a new Filter from the given lists of IPs and CIDR networks.
+func New(allowed, denied []string) (filter *Filter, err error) {
+	filter = new(Filter)
+	if err = filter.Update(allowed, denied); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// Update replaces the allowed and denied networks of the Filter. The Filter is left untouched if any of the given
+// entries is invalid.
+func (f *Filter) Update(allowed, denied []string) (err error) {
+	allowedNetworks, err := parseNetworks(allowed)
+	if err != nil {
+		return errors.Wrap(err, "invalid allowed network")
+	}
+	deniedNetworks, err := parseNetworks(denied)
+	if err != nil {
+		return errors.Wrap(err, "invalid denied network")
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.allowed = allowedNetworks
+	f.denied = deniedNetworks
+
+	return nil
+}
+
+// Allowed returns true if the given IP is allowed to connect to the node.
+func (f *Filter) Allowed(ip net.IP) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	for _, network := range f.denied {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	if len(f.allowed) == 0 {
+		return true
+	}
+
+	for _, network := range f.allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNetworks parses a list of CIDR networks. Plain IPs are interpreted as networks containing only that IP.
+func parseNetworks(entries []string) (networks []*net.IPNet, err error) {
+	networks = make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, errors.Errorf("'%s' is neither an IP nor a CIDR network", entry)
+			}
+
+			if ipv4 := ip.To4(); ipv4 != nil {
+				networks = append(networks, &net.IPNet{IP: ipv4, Mask: net.CIDRMask(8*net.IPv4len, 8*net.IPv4len)})
+			} else {
+				networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(8*net.IPv6len, 8*net.IPv6len)})
+			}
+			continue
+		}
+
+		_, network, parseErr := net.ParseCIDR(entry)
+		if parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "'%s' is neither an IP nor a CIDR network", entry)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
@@ -0,0 +1,40 @@
+package ipfilter
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Allowed(t *testing.T) {
+	filter, err := New(nil, nil)
+	require.NoError(t, err)
+	assert.True(t, filter.Allowed(net.ParseIP("192.0.2.1")))
+
+	require.NoError(t, filter.Update(nil, []string{"192.0.2.0/24", "2001:db8::1"}))
+	assert.False(t, filter.Allowed(net.ParseIP("192.0.2.1")))
+	assert.False(t, filter.Allowed(net.ParseIP("2001:db8::1")))
+	assert.True(t, filter.Allowed(net.ParseIP("2001:db8::2")))
+	assert.True(t, filter.Allowed(net.ParseIP("198.51.100.1")))
+
+	require.NoError(t, filter.Update([]string{"198.51.100.0/24", "203.0.113.7"}, []string{"198.51.100.13"}))
+	assert.True(t, filter.Allowed(net.ParseIP("198.51.100.1")))
+	assert.True(t, filter.Allowed(net.ParseIP("203.0.113.7")))
+	assert.False(t, filter.Allowed(net.ParseIP("203.0.113.8")))
+	assert.False(t, filter.Allowed(net.ParseIP("198.51.100.13")))
+	assert.False(t, filter.Allowed(net.ParseIP("192.0.2.1")))
+}
+
+func TestFilter_UpdateInvalid(t *testing.T) {
+	filter, err := New([]string{"198.51.100.0/24"}, nil)
+	require.NoError(t, err)
+
+	require.Error(t, filter.Update([]string{"198.51.100.0/33"}, nil))
+	require.Error(t, filter.Update(nil, []string{"not-an-ip"}))
+
+	// the previous configuration stays in place
+	assert.True(t, filter.Allowed(net.ParseIP("198.51.100.1")))
+	assert.False(t, filter.Allowed(net.ParseIP("192.0.2.1")))
+}
@@ -0,0 +1,6 @@
+package node
+
+// OrderPlugins orders the Plugins of the given options like the Node does when it is started.
+func OrderPlugins(options ...NodeOption) (orderedPlugins []*Plugin, err error) {
+	return orderPlugins(newNodeOptions(options).plugins)
+}
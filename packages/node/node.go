@@ -82,6 +82,7 @@ func (node *Node) init(plugins ...*Plugin) {
 }
 
 func (node *Node) configure(plugins ...*Plugin) {
+	enabledPlugins := make([]*Plugin, 0, len(plugins))
 	for _, plugin := range plugins {
 		if IsSkipped(plugin) {
 			node.Logger.Infof("Skipping Plugin: %s", plugin.Name)
@@ -89,6 +90,16 @@ func (node *Node) configure(plugins ...*Plugin) {
 			continue
 		}
 
+		enabledPlugins = append(enabledPlugins, plugin)
+	}
+
+	// configure and run the plugins in the order of their dependencies
+	orderedPlugins, err := orderPlugins(enabledPlugins)
+	if err != nil {
+		panic(fmt.Errorf("unable to order plugins: %w", err))
+	}
+
+	for _, plugin := range orderedPlugins {
 		plugin.Node = node
 
 		if plugin.deps != nil {
@@ -135,8 +146,9 @@ func (node *Node) Run() {
 
 	daemon.Run()
 
-	for _, plugin := range node.loadedPlugins {
-		plugin.WorkerPool.Shutdown()
+	// shut down the plugins in the reverse order of their startup
+	for i := len(node.loadedPlugins) - 1; i >= 0; i-- {
+		node.loadedPlugins[i].WorkerPool.Shutdown()
 	}
 
 	node.Logger.Info("Shutdown complete!")
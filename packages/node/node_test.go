@@ -37,3 +37,19 @@ func TestDependencyInjection(t *testing.T) {
 	require.NoError(t, logger.InitGlobalLogger(configuration.New()))
 	node.Run(node.Plugins(pluginA, pluginB))
 }
+
+func TestPlugin_Capabilities(t *testing.T) {
+	plugin := node.NewPlugin("Capabilities", nil, node.Disabled)
+	require.Equal(t, "github.com/iotaledger/goshimmer/packages/node_test", plugin.PackagePath())
+
+	plugin.AddEndpoints("POST /b", "GET /a")
+	plugin.AddPayloadTypes("TransactionType(1)")
+	require.Equal(t, node.Capabilities{
+		Endpoints:    []string{"GET /a", "POST /b"},
+		PayloadTypes: []string{"TransactionType(1)"},
+	}, plugin.Capabilities())
+
+	require.Equal(t, "github.com/iotaledger/goshimmer/plugins/webapi/info", node.FunctionPackagePath("github.com/iotaledger/goshimmer/plugins/webapi/info.getInfo"))
+	require.Equal(t, "github.com/iotaledger/goshimmer/plugins/webapi/block", node.FunctionPackagePath("github.com/iotaledger/goshimmer/plugins/webapi/block.run.func1"))
+	require.Equal(t, "github.com/labstack/echo/v4", node.FunctionPackagePath("github.com/labstack/echo/v4.(*Echo).Static.func1"))
+}
@@ -0,0 +1,152 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllComponents can be consumed by a Plugin to be started after and shut down before all other Plugins (e.g. a health
+// endpoint that should only report the node as healthy while the other Plugins are running).
+const AllComponents = "*"
+
+// orderPlugins returns the given Plugins ordered by their dependencies, so that every Plugin is started after the
+// Plugins that provide the components that it consumes (Plugins that do not depend on each other keep their relative
+// order). It also assigns the shutdown order of the Plugins (see assignShutdownOrders).
+func orderPlugins(plugins []*Plugin) (orderedPlugins []*Plugin, err error) {
+	providers := make(map[string][]*Plugin)
+	for _, plugin := range plugins {
+		for _, component := range plugin.provides {
+			providers[component] = append(providers[component], plugin)
+		}
+	}
+
+	dependencies := make(map[*Plugin][]*Plugin)
+	for _, plugin := range plugins {
+		dependencies[plugin] = pluginDependencies(plugin, plugins, providers)
+	}
+
+	ordered := make(map[*Plugin]bool)
+	for len(orderedPlugins) < len(plugins) {
+		nextPlugin := nextStartablePlugin(plugins, dependencies, ordered)
+		if nextPlugin == nil {
+			return nil, fmt.Errorf("circular dependency between plugins %s", strings.Join(unorderedPluginNames(plugins, ordered), ", "))
+		}
+
+		ordered[nextPlugin] = true
+		orderedPlugins = append(orderedPlugins, nextPlugin)
+	}
+
+	assignShutdownOrders(orderedPlugins, dependencies)
+
+	return orderedPlugins, nil
+}
+
+// assignShutdownOrders assigns the shutdown order of the given Plugins (ordered by their dependencies), so that
+// consumers are shut down before their providers (the explicit shutdown priority of a Plugin is used as the lower bound
+// of its order). Plugins that do not declare any dependencies use their explicit shutdown priority or are shut down
+// before all Plugins that provide components (they might use any of them), and Plugins that consume AllComponents are
+// shut down before all other Plugins.
+func assignShutdownOrders(orderedPlugins []*Plugin, dependencies map[*Plugin][]*Plugin) {
+	var maxProviderOrder int
+	for _, plugin := range orderedPlugins {
+		if !plugin.declaresDependencies() || plugin.consumesAllComponents() {
+			continue
+		}
+
+		plugin.shutdownOrder = 1
+		if plugin.hasShutdownPriority && plugin.shutdownPriority > plugin.shutdownOrder {
+			plugin.shutdownOrder = plugin.shutdownPriority
+		}
+
+		for _, dependency := range dependencies[plugin] {
+			if dependency.shutdownOrder >= plugin.shutdownOrder {
+				plugin.shutdownOrder = dependency.shutdownOrder + 1
+			}
+		}
+
+		if len(plugin.provides) != 0 && plugin.shutdownOrder > maxProviderOrder {
+			maxProviderOrder = plugin.shutdownOrder
+		}
+	}
+
+	var maxOrder int
+	for _, plugin := range orderedPlugins {
+		if plugin.consumesAllComponents() {
+			continue
+		}
+
+		if !plugin.declaresDependencies() {
+			if plugin.shutdownOrder = maxProviderOrder + 1; plugin.hasShutdownPriority {
+				plugin.shutdownOrder = plugin.shutdownPriority
+			}
+		}
+
+		if plugin.shutdownOrder > maxOrder {
+			maxOrder = plugin.shutdownOrder
+		}
+	}
+
+	for _, plugin := range orderedPlugins {
+		if plugin.consumesAllComponents() {
+			plugin.shutdownOrder = maxOrder + 1
+		}
+	}
+}
+
+// pluginDependencies returns the Plugins that provide the components that are consumed by the given Plugin.
+func pluginDependencies(plugin *Plugin, plugins []*Plugin, providers map[string][]*Plugin) (dependencies []*Plugin) {
+	for _, component := range plugin.consumes {
+		if component != AllComponents {
+			for _, provider := range providers[component] {
+				if provider != plugin {
+					dependencies = append(dependencies, provider)
+				}
+			}
+
+			continue
+		}
+
+		for _, otherPlugin := range plugins {
+			if otherPlugin != plugin && !otherPlugin.consumesAllComponents() {
+				dependencies = append(dependencies, otherPlugin)
+			}
+		}
+	}
+
+	return dependencies
+}
+
+// nextStartablePlugin returns the first Plugin that was not ordered, yet, and whose dependencies were all ordered
+// already (or nil if no such Plugin exists).
+func nextStartablePlugin(plugins []*Plugin, dependencies map[*Plugin][]*Plugin, ordered map[*Plugin]bool) *Plugin {
+	for _, plugin := range plugins {
+		if ordered[plugin] {
+			continue
+		}
+
+		startable := true
+		for _, dependency := range dependencies[plugin] {
+			if !ordered[dependency] {
+				startable = false
+				break
+			}
+		}
+
+		if startable {
+			return plugin
+		}
+	}
+
+	return nil
+}
+
+// unorderedPluginNames returns the names of the Plugins that were not ordered, yet.
+func unorderedPluginNames(plugins []*Plugin, ordered map[*Plugin]bool) (names []string) {
+	for _, plugin := range plugins {
+		if !ordered[plugin] {
+			names = append(names, plugin.Name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,36 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderPlugins(t *testing.T) {
+	health := NewPlugin("OrderHealth", nil, Enabled).Consumes(AllComponents)
+	api := NewPlugin("OrderAPI", nil, Enabled).Provides("API").Consumes("Network", "Storage", "Faucet")
+	network := NewPlugin("OrderNetwork", nil, Enabled).Provides("Network").Consumes("Storage")
+	logging := NewPlugin("OrderLogging", nil, Enabled)
+	profiling := NewPlugin("OrderProfiling", nil, Enabled).ShutdownPriority(10)
+	storage := NewPlugin("OrderStorage", nil, Enabled).Provides("Storage")
+
+	orderedPlugins, err := orderPlugins([]*Plugin{health, api, network, logging, profiling, storage})
+	require.NoError(t, err)
+	require.Equal(t, []*Plugin{logging, profiling, storage, network, api, health}, orderedPlugins)
+
+	require.Equal(t, 1, storage.ShutdownOrder())
+	require.Equal(t, 2, network.ShutdownOrder())
+	require.Equal(t, 3, api.ShutdownOrder())
+
+	// plugins without declared dependencies are shut down before all providers or according to their explicit priority
+	require.Equal(t, 4, logging.ShutdownOrder())
+	require.Equal(t, 10, profiling.ShutdownOrder())
+
+	require.Equal(t, 11, health.ShutdownOrder())
+
+	cyclicA := NewPlugin("OrderCyclicA", nil, Enabled).Provides("A").Consumes("B")
+	cyclicB := NewPlugin("OrderCyclicB", nil, Enabled).Provides("B").Consumes("A")
+
+	_, err = orderPlugins([]*Plugin{logging, cyclicA, cyclicB})
+	require.ErrorContains(t, err, "OrderCyclicA, OrderCyclicB")
+}
@@ -3,6 +3,8 @@ package node
 import (
 	"fmt"
 	"os"
+	"runtime"
+	"sort"
 	"strings"
 	"sync"
 
@@ -26,6 +28,19 @@ type Plugin struct {
 	logOnce    sync.Once
 	deps       interface{}
 	WorkerPool *workerpool.WorkerPool
+
+	// Version contains the version of the Plugin (an empty Version means that the Plugin shares the node version).
+	Version string
+
+	packagePath       string
+	capabilities      Capabilities
+	capabilitiesMutex sync.RWMutex
+
+	provides            []string
+	consumes            []string
+	shutdownPriority    int
+	hasShutdownPriority bool
+	shutdownOrder       int
 }
 
 // NewPlugin creates a new plugin with the given name, default status and callbacks.
@@ -39,6 +54,10 @@ func NewPlugin(name string, deps interface{}, status int, callbacks ...Callback)
 		WorkerPool: workerpool.New(fmt.Sprintf("Plugin-%s", name), 1),
 	}
 
+	if pc, _, _, ok := runtime.Caller(1); ok {
+		plugin.packagePath = FunctionPackagePath(runtime.FuncForPC(pc).Name())
+	}
+
 	AddPlugin(plugin)
 
 	switch len(callbacks) {
@@ -60,6 +79,99 @@ func GetPluginIdentifier(name string) string {
 	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
 }
 
+// FunctionPackagePath returns the import path of the package that contains the function with the given fully qualified
+// name (e.g. "github.com/iotaledger/goshimmer/plugins/webapi/info.getInfo").
+func FunctionPackagePath(functionName string) (packagePath string) {
+	lastSlash := strings.LastIndex(functionName, "/")
+	if dot := strings.Index(functionName[lastSlash+1:], "."); dot != -1 {
+		return functionName[:lastSlash+1+dot]
+	}
+
+	return functionName
+}
+
+// PackagePath returns the import path of the package that created the Plugin.
+func (p *Plugin) PackagePath() string {
+	return p.packagePath
+}
+
+// Provides declares the components (e.g. "Protocol") that are provided by the Plugin to other Plugins.
+func (p *Plugin) Provides(components ...string) *Plugin {
+	p.provides = append(p.provides, components...)
+
+	return p
+}
+
+// Consumes declares the components that are used by the Plugin. The Plugin is started after and shut down before the
+// loaded Plugins that provide these components (components that are not provided by a loaded Plugin are ignored).
+func (p *Plugin) Consumes(components ...string) *Plugin {
+	p.consumes = append(p.consumes, components...)
+
+	return p
+}
+
+// ShutdownPriority sets the explicit shutdown priority (see the shutdown package) that is used as the shutdown order of
+// the Plugin if it does not declare any dependencies (and as the lower bound of the order that is derived from its
+// dependencies otherwise).
+func (p *Plugin) ShutdownPriority(priority int) *Plugin {
+	p.shutdownPriority = priority
+	p.hasShutdownPriority = true
+
+	return p
+}
+
+// ShutdownOrder returns the order in which the background workers of the Plugin are shut down (higher = earlier). It
+// is derived from the declared dependencies once the Plugin is loaded by the Node. Plugins that do not declare any
+// dependencies use their explicit ShutdownPriority or are shut down before all Plugins that provide components.
+func (p *Plugin) ShutdownOrder() int {
+	return p.shutdownOrder
+}
+
+// declaresDependencies returns true if the Plugin declared the components that it provides or consumes.
+func (p *Plugin) declaresDependencies() bool {
+	return len(p.provides) != 0 || len(p.consumes) != 0
+}
+
+// consumesAllComponents returns true if the Plugin depends on all other Plugins.
+func (p *Plugin) consumesAllComponents() bool {
+	for _, component := range p.consumes {
+		if component == AllComponents {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddEndpoints registers endpoints (e.g. "GET /info") that are exposed by the Plugin.
+func (p *Plugin) AddEndpoints(endpoints ...string) {
+	p.capabilitiesMutex.Lock()
+	defer p.capabilitiesMutex.Unlock()
+
+	p.capabilities.Endpoints = append(p.capabilities.Endpoints, endpoints...)
+}
+
+// AddPayloadTypes registers the names of payload types that are handled by the Plugin.
+func (p *Plugin) AddPayloadTypes(payloadTypes ...string) {
+	p.capabilitiesMutex.Lock()
+	defer p.capabilitiesMutex.Unlock()
+
+	p.capabilities.PayloadTypes = append(p.capabilities.PayloadTypes, payloadTypes...)
+}
+
+// Capabilities returns a sorted copy of the capabilities that were registered by the Plugin.
+func (p *Plugin) Capabilities() (capabilities Capabilities) {
+	p.capabilitiesMutex.RLock()
+	defer p.capabilitiesMutex.RUnlock()
+
+	capabilities.Endpoints = append(capabilities.Endpoints, p.capabilities.Endpoints...)
+	capabilities.PayloadTypes = append(capabilities.PayloadTypes, p.capabilities.PayloadTypes...)
+	sort.Strings(capabilities.Endpoints)
+	sort.Strings(capabilities.PayloadTypes)
+
+	return capabilities
+}
+
 // LogDebug uses fmt.Sprint to construct and log a message.
 func (p *Plugin) LogDebug(args ...interface{}) {
 	p.Logger().Debug(args...)
@@ -142,3 +254,12 @@ func (p *Plugin) Logger() *logger.Logger {
 
 	return p.log
 }
+
+// Capabilities contains the machine-readable features that are exposed by a Plugin.
+type Capabilities struct {
+	// Endpoints contains the API endpoints of the Plugin (e.g. "GET /info").
+	Endpoints []string
+
+	// PayloadTypes contains the names of the payload types that are handled by the Plugin.
+	PayloadTypes []string
+}
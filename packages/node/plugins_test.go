@@ -0,0 +1,32 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins"
+	"github.com/iotaledger/goshimmer/plugins/peer"
+	"github.com/iotaledger/goshimmer/plugins/protocol"
+	"github.com/iotaledger/goshimmer/plugins/webapi/healthz"
+)
+
+func TestOrderPlugins_NodePlugins(t *testing.T) {
+	orderedPlugins, err := node.OrderPlugins(plugins.Core, plugins.Research, plugins.UI, plugins.WebAPI)
+	require.NoError(t, err)
+
+	// the plugins that own the databases are shut down after all other plugins
+	for _, plugin := range orderedPlugins {
+		if plugin == protocol.Plugin || plugin == peer.Plugin {
+			continue
+		}
+
+		require.Greater(t, plugin.ShutdownOrder(), protocol.Plugin.ShutdownOrder(), "%s is shut down after the protocol", plugin.Name)
+		require.Greater(t, plugin.ShutdownOrder(), peer.Plugin.ShutdownOrder(), "%s is shut down after the peer database", plugin.Name)
+
+		if plugin != healthz.Plugin {
+			require.Less(t, plugin.ShutdownOrder(), healthz.Plugin.ShutdownOrder(), "%s is shut down before the health endpoint", plugin.Name)
+		}
+	}
+}
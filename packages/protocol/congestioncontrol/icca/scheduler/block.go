@@ -15,6 +15,7 @@ type Block struct {
 	scheduled bool
 	skipped   bool
 	dropped   bool
+	work      int
 
 	*booker.Block
 }
@@ -23,6 +24,7 @@ type Block struct {
 func NewBlock(virtualVotingBlock *booker.Block, opts ...options.Option[Block]) (newBlock *Block) {
 	return options.Apply(&Block{
 		Block: virtualVotingBlock,
+		work:  virtualVotingBlock.Work(),
 	}, opts)
 }
 
@@ -84,6 +86,11 @@ func (b *Block) IsSkipped() bool {
 	return b.skipped
 }
 
+// Work returns the units of work that are accounted for scheduling the Block.
+func (b *Block) Work() int {
+	return b.work
+}
+
 // SetSkipped sets the skipped flag of the Block.
 func (b *Block) SetSkipped() (wasUpdated bool) {
 	b.Lock()
@@ -121,6 +128,13 @@ func WithSkipped(skipped bool) options.Option[Block] {
 	}
 }
 
+// WithWork sets the units of work that are accounted for scheduling the Block.
+func WithWork(work int) options.Option[Block] {
+	return func(b *Block) {
+		b.work = work
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Blocks ///////////////////////////////////////////////////////////////////////////////////////////////////////
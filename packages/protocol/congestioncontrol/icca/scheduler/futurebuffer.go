@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/ds/generalheap"
+	"github.com/iotaledger/hive.go/runtime/timed"
+)
+
+// region FutureBuffer /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// FutureBuffer keeps the blocks with an issuing time in the future until their issuing time is reached.
+type FutureBuffer struct {
+	maxSize  int
+	inbox    generalheap.Heap[timed.HeapKey, *futureBlock]
+	elements map[models.BlockID]*generalheap.HeapElement[timed.HeapKey, *futureBlock]
+	maxWait  time.Duration
+}
+
+// NewFutureBuffer returns a new FutureBuffer that keeps at most maxSize blocks.
+func NewFutureBuffer(maxSize int) *FutureBuffer {
+	return &FutureBuffer{
+		maxSize:  maxSize,
+		elements: make(map[models.BlockID]*generalheap.HeapElement[timed.HeapKey, *futureBlock]),
+	}
+}
+
+// Size returns the number of blocks in the FutureBuffer.
+func (f *FutureBuffer) Size() int {
+	return f.inbox.Len()
+}
+
+// MaxSize returns the maximum number of blocks in the FutureBuffer.
+func (f *FutureBuffer) MaxSize() int {
+	return f.maxSize
+}
+
+// MaxWait returns the longest time that a block had to wait in the FutureBuffer before it was released.
+func (f *FutureBuffer) MaxWait() time.Duration {
+	return f.maxWait
+}
+
+// Add adds the given block to the FutureBuffer. If the FutureBuffer is full, the block that is furthest in the future
+// (which might be the added block itself) is dropped and returned.
+func (f *FutureBuffer) Add(block *Block, now time.Time) (droppedBlock *Block) {
+	if _, exists := f.elements[block.ID()]; exists {
+		return nil
+	}
+
+	if f.inbox.Len() >= f.maxSize {
+		latestElement := f.latestElement()
+		if latestElement == nil || !block.IssuingTime().Before(time.Time(latestElement.Key)) {
+			return block
+		}
+
+		droppedBlock = f.remove(latestElement)
+	}
+
+	element := &generalheap.HeapElement[timed.HeapKey, *futureBlock]{
+		Value: &futureBlock{Block: block, bufferedAt: now},
+		Key:   timed.HeapKey(block.IssuingTime()),
+	}
+	heap.Push(&f.inbox, element)
+	f.elements[block.ID()] = element
+
+	return droppedBlock
+}
+
+// Remove removes the given block from the FutureBuffer and returns true if it was buffered.
+func (f *FutureBuffer) Remove(block *Block) (removed bool) {
+	element, exists := f.elements[block.ID()]
+	if !exists {
+		return false
+	}
+
+	f.remove(element)
+
+	return true
+}
+
+// Release removes and returns the blocks whose issuing time is not after the given time (ordered by their issuing time).
+func (f *FutureBuffer) Release(now time.Time) (releasedBlocks []*Block) {
+	for f.inbox.Len() > 0 && !time.Time(f.inbox[0].Key).After(now) {
+		element := heap.Pop(&f.inbox).(*generalheap.HeapElement[timed.HeapKey, *futureBlock])
+		delete(f.elements, element.Value.ID())
+
+		if wait := now.Sub(element.Value.bufferedAt); wait > f.maxWait {
+			f.maxWait = wait
+		}
+
+		releasedBlocks = append(releasedBlocks, element.Value.Block)
+	}
+
+	return releasedBlocks
+}
+
+// IDs returns the IDs of all blocks in the FutureBuffer.
+func (f *FutureBuffer) IDs() (ids []models.BlockID) {
+	ids = make([]models.BlockID, 0, len(f.elements))
+	for id := range f.elements {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// latestElement returns the element of the block that is furthest in the future.
+func (f *FutureBuffer) latestElement() (latestElement *generalheap.HeapElement[timed.HeapKey, *futureBlock]) {
+	for _, element := range f.inbox {
+		if latestElement == nil || element.Key.CompareTo(latestElement.Key) > 0 {
+			latestElement = element
+		}
+	}
+
+	return latestElement
+}
+
+// remove removes the given element from the FutureBuffer and returns its block.
+func (f *FutureBuffer) remove(element *generalheap.HeapElement[timed.HeapKey, *futureBlock]) (block *Block) {
+	heap.Remove(&f.inbox, element.Index())
+	delete(f.elements, element.Value.ID())
+
+	return element.Value.Block
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region futureBlock //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// futureBlock is a Block that is kept in the FutureBuffer together with the time at which it was buffered.
+type futureBlock struct {
+	*Block
+
+	bufferedAt time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
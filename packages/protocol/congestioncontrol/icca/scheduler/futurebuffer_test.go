@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+)
+
+func TestFutureBuffer_Release(t *testing.T) {
+	now := time.Now()
+	f := NewFutureBuffer(10)
+
+	later := newTestBlock(models.WithIssuingTime(now.Add(2 * time.Second)))
+	sooner := newTestBlock(models.WithIssuingTime(now.Add(1 * time.Second)))
+	assert.Nil(t, f.Add(later, now))
+	assert.Nil(t, f.Add(sooner, now))
+	assert.Nil(t, f.Add(sooner, now))
+	assert.Equal(t, 2, f.Size())
+
+	assert.Empty(t, f.Release(now))
+	assert.Equal(t, []*Block{sooner}, f.Release(now.Add(1500*time.Millisecond)))
+	assert.Equal(t, 1500*time.Millisecond, f.MaxWait())
+
+	assert.Equal(t, []*Block{later}, f.Release(now.Add(3*time.Second)))
+	assert.Equal(t, 3*time.Second, f.MaxWait())
+	assert.Zero(t, f.Size())
+	assert.Empty(t, f.IDs())
+}
+
+func TestFutureBuffer_Drop(t *testing.T) {
+	now := time.Now()
+	f := NewFutureBuffer(2)
+
+	blocks := make([]*Block, 3)
+	for i := range blocks {
+		blocks[i] = newTestBlock(models.WithIssuingTime(now.Add(time.Duration(i+1) * time.Second)))
+	}
+
+	require.Nil(t, f.Add(blocks[0], now))
+	require.Nil(t, f.Add(blocks[2], now))
+
+	// the block that is furthest in the future is dropped
+	assert.Equal(t, blocks[2], f.Add(blocks[1], now))
+	assert.Equal(t, blocks[2], f.Add(blocks[2], now))
+	assert.ElementsMatch(t, []models.BlockID{blocks[0].ID(), blocks[1].ID()}, f.IDs())
+
+	assert.True(t, f.Remove(blocks[0]))
+	assert.False(t, f.Remove(blocks[0]))
+	assert.Equal(t, []*Block{blocks[1]}, f.Release(now.Add(5*time.Second)))
+}
@@ -42,6 +42,8 @@ type Scheduler struct {
 	blocks        *memstorage.SlotStorage[models.BlockID, *Block]
 	bufferMutex   sync.RWMutex
 	buffer        *BufferQueue
+	futureMutex   sync.Mutex
+	futureBuffer  *FutureBuffer
 	deficitsMutex sync.RWMutex
 	deficits      *shrinkingmap.ShrinkingMap[identity.ID, *big.Rat]
 	evictionState *eviction.State
@@ -53,8 +55,10 @@ type Scheduler struct {
 
 	optsRate                           time.Duration
 	optsMaxBufferSize                  int
+	optsMaxFutureBufferSize            int
 	optsAcceptedBlockScheduleThreshold time.Duration
 	optsMaxDeficit                     *big.Rat
+	optsWorkPolicy                     models.WorkPolicy
 
 	running        atomic.Bool
 	shutdownSignal chan struct{}
@@ -74,11 +78,18 @@ func New(evictionState *eviction.State, slotTimeProvider *slot.TimeProvider, isB
 		deficits:                           shrinkingmap.New[identity.ID, *big.Rat](),
 		blocks:                             memstorage.NewSlotStorage[models.BlockID, *Block](),
 		optsMaxBufferSize:                  300,
+		optsMaxFutureBufferSize:            100,
 		optsAcceptedBlockScheduleThreshold: 5 * time.Minute,
 		optsRate:                           5 * time.Millisecond,      // measured in time per unit work
-		optsMaxDeficit:                     new(big.Rat).SetInt64(10), // must be >= max block work, but work is currently=1 for all blocks
+		optsMaxDeficit:                     new(big.Rat).SetInt64(10), // must be >= max block work
 	}, opts, func(s *Scheduler) {
+		// blocks that require more work than the max deficit could never be scheduled
+		if maxBlockWork := new(big.Rat).SetInt64(int64(s.optsWorkPolicy.MaxWork())); s.optsMaxDeficit.Cmp(maxBlockWork) < 0 {
+			s.optsMaxDeficit = maxBlockWork
+		}
+
 		s.buffer = NewBufferQueue(s.optsMaxBufferSize)
+		s.futureBuffer = NewFutureBuffer(s.optsMaxFutureBufferSize)
 	}, (*Scheduler).setupEvents)
 }
 
@@ -161,6 +172,30 @@ func (s *Scheduler) BufferSize() int {
 	return s.buffer.Size()
 }
 
+// FutureBufferSize returns the number of blocks that wait for their issuing time to be reached.
+func (s *Scheduler) FutureBufferSize() int {
+	s.futureMutex.Lock()
+	defer s.futureMutex.Unlock()
+
+	return s.futureBuffer.Size()
+}
+
+// MaxFutureBufferSize returns the max number of blocks that can wait for their issuing time to be reached.
+func (s *Scheduler) MaxFutureBufferSize() int {
+	s.futureMutex.Lock()
+	defer s.futureMutex.Unlock()
+
+	return s.futureBuffer.MaxSize()
+}
+
+// FutureBufferMaxWait returns the longest time that a block had to wait for its issuing time to be reached.
+func (s *Scheduler) FutureBufferMaxWait() time.Duration {
+	s.futureMutex.Lock()
+	defer s.futureMutex.Unlock()
+
+	return s.futureBuffer.MaxWait()
+}
+
 // ReadyBlocksCount returns the number of blocks that are ready to be scheduled.
 func (s *Scheduler) ReadyBlocksCount() int {
 	s.evictionMutex.RLock()
@@ -223,20 +258,13 @@ func (s *Scheduler) AddBlock(sourceBlock *booker.Block) {
 
 	block, _ := s.GetOrRegisterBlock(sourceBlock)
 
-	if block.IsOrphaned() {
-		if block.SetDropped() {
-			s.Events.BlockDropped.Trigger(block)
-		}
-
+	// blocks from the future are only submitted once their issuing time was reached
+	if now := time.Now(); block.IssuingTime().After(now) && !block.IsOrphaned() {
+		s.bufferFutureBlock(block, now)
 		return
 	}
 
-	if err := s.Submit(block); err != nil {
-		if !errors.Is(err, ErrInsufficientMana) {
-			s.Events.Error.Trigger(errors.Wrap(err, "failed to submit to scheduler"))
-		}
-	}
-	s.tryReady(block)
+	s.addBlock(block)
 }
 
 func (s *Scheduler) HandleOrphanedBlock(orphanedBlock *blockdag.Block) {
@@ -247,6 +275,7 @@ func (s *Scheduler) HandleOrphanedBlock(orphanedBlock *blockdag.Block) {
 		return
 	}
 
+	s.unbufferFutureBlock(block)
 	s.Unsubmit(block)
 	if block.SetDropped() {
 		s.Events.BlockDropped.Trigger(block)
@@ -363,6 +392,59 @@ func (s *Scheduler) updateChildren(block *Block) {
 	}
 }
 
+// addBlock submits the given block and tries to mark it as ready (orphaned blocks are dropped).
+func (s *Scheduler) addBlock(block *Block) {
+	if block.IsOrphaned() {
+		if block.SetDropped() {
+			s.Events.BlockDropped.Trigger(block)
+		}
+
+		return
+	}
+
+	if err := s.Submit(block); err != nil {
+		if !errors.Is(err, ErrInsufficientMana) {
+			s.Events.Error.Trigger(errors.Wrap(err, "failed to submit to scheduler"))
+		}
+	}
+	s.tryReady(block)
+}
+
+// bufferFutureBlock adds the given block to the FutureBuffer (a block that does not fit into the buffer is dropped).
+func (s *Scheduler) bufferFutureBlock(block *Block, now time.Time) {
+	s.futureMutex.Lock()
+	droppedBlock := s.futureBuffer.Add(block, now)
+	s.futureMutex.Unlock()
+
+	if droppedBlock != nil && droppedBlock.SetDropped() {
+		s.Events.BlockDropped.Trigger(droppedBlock)
+	}
+}
+
+// unbufferFutureBlock removes the given block from the FutureBuffer.
+func (s *Scheduler) unbufferFutureBlock(block *Block) {
+	s.futureMutex.Lock()
+	defer s.futureMutex.Unlock()
+
+	s.futureBuffer.Remove(block)
+}
+
+// releaseFutureBlocks submits the blocks of the FutureBuffer whose issuing time was reached.
+func (s *Scheduler) releaseFutureBlocks(now time.Time) {
+	s.evictionMutex.RLock()
+	defer s.evictionMutex.RUnlock()
+
+	s.futureMutex.Lock()
+	releasedBlocks := s.futureBuffer.Release(now)
+	s.futureMutex.Unlock()
+
+	for _, block := range releasedBlocks {
+		if !block.IsDropped() {
+			s.addBlock(block)
+		}
+	}
+}
+
 func (s *Scheduler) submit(block *Block) error {
 	if !s.IsRunning() {
 		return ErrNotRunning
@@ -427,6 +509,7 @@ loop:
 			if !s.IsRunning() {
 				break loop
 			}
+			s.releaseFutureBlocks(time.Now())
 			if block := s.schedule(); block != nil {
 				// TODO: make this operate in units of work, with a variable pause between scheduling depending on work scheduled.
 				// TODO: don't use a ticker. Switch to a simple timer instead, and use a flag when ready to schedule something if there is nothing ready to be scheduled yet.
@@ -606,7 +689,7 @@ func (s *Scheduler) GetOrRegisterBlock(virtualVotingBlock *booker.Block) (block
 	blockStorage := s.blocks.Get(virtualVotingBlock.ID().Index(), true)
 
 	block, _ = blockStorage.GetOrCreate(virtualVotingBlock.ID(), func() *Block {
-		return NewBlock(virtualVotingBlock)
+		return NewBlock(virtualVotingBlock, WithWork(s.optsWorkPolicy.Work(virtualVotingBlock.ModelsBlock)))
 	})
 
 	return block, nil
@@ -647,6 +730,16 @@ func (s *Scheduler) evictSlot(index slot.Index) {
 		}
 	}
 
+	s.futureMutex.Lock()
+	for _, blockID := range s.futureBuffer.IDs() {
+		if blockID.Index() == index {
+			if block, exists := s.block(blockID); exists {
+				s.futureBuffer.Remove(block)
+			}
+		}
+	}
+	s.futureMutex.Unlock()
+
 	s.blocks.Evict(index)
 }
 
@@ -678,6 +771,13 @@ func WithMaxBufferSize(maxBufferSize int) options.Option[Scheduler] {
 	}
 }
 
+// WithMaxFutureBufferSize sets the maximum number of blocks that can wait for their issuing time to be reached.
+func WithMaxFutureBufferSize(maxFutureBufferSize int) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsMaxFutureBufferSize = maxFutureBufferSize
+	}
+}
+
 func WithRate(rate time.Duration) options.Option[Scheduler] {
 	return func(s *Scheduler) {
 		s.optsRate = rate
@@ -690,6 +790,13 @@ func WithMaxDeficit(maxDef int) options.Option[Scheduler] {
 	}
 }
 
+// WithWorkPolicy sets the policy that is used to account the work of the scheduled blocks (defaults to CountWorkPolicy).
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsWorkPolicy = workPolicy
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func minRat(x, y *big.Rat) *big.Rat {
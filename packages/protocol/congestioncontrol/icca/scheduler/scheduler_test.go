@@ -13,6 +13,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/lo"
@@ -195,6 +196,40 @@ func TestScheduler_Schedule(t *testing.T) {
 	}, 1*time.Second, 10*time.Millisecond)
 }
 
+func TestScheduler_SizeWorkPolicy(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := NewTestFramework(t, workers.CreateGroup("SchedulerTestFramework"), WithWorkPolicy(models.SizeWorkPolicy))
+
+	tf.CreateIssuer("peer", 10)
+
+	// the max deficit is raised so that blocks of the maximum size can still be scheduled
+	require.Equal(t, models.SizeWorkPolicy.MaxWork(), int(lo.Return1(tf.Scheduler.optsMaxDeficit.Float64())))
+
+	smallBlock := tf.CreateSchedulerBlock(models.WithIssuer(tf.Issuer("peer").PublicKey()))
+	largeBlock := tf.CreateSchedulerBlock(models.WithIssuer(tf.Issuer("peer").PublicKey()), models.WithPayload(payload.NewGenericDataPayload(make([]byte, 3*models.WorkUnitSize))))
+	require.Equal(t, 1, smallBlock.Work())
+	require.Equal(t, 4, largeBlock.Work())
+
+	blockScheduled := make(chan models.BlockID, 2)
+	tf.Scheduler.Events.BlockScheduled.Hook(func(block *Block) {
+		blockScheduled <- block.ID()
+	})
+
+	tf.Scheduler.Start()
+
+	require.NoError(t, tf.Scheduler.Submit(smallBlock))
+	require.NoError(t, tf.Scheduler.Submit(largeBlock))
+	require.Equal(t, 5, tf.Scheduler.issuerQueueWork(tf.Issuer("peer").ID()))
+
+	tf.Scheduler.Ready(smallBlock)
+	tf.Scheduler.Ready(largeBlock)
+
+	require.Eventually(t, func() bool {
+		return len(blockScheduled) == 2
+	}, 1*time.Second, 10*time.Millisecond)
+	require.Equal(t, 0, tf.Scheduler.issuerQueueWork(tf.Issuer("peer").ID()))
+}
+
 func TestScheduler_HandleOrphanedBlock_Ready(t *testing.T) {
 	workers := workerpool.NewGroup(t.Name())
 	tf := NewTestFramework(t, workers.CreateGroup("SchedulerTestFramework"))
@@ -435,6 +470,39 @@ func TestScheduler_Time(t *testing.T) {
 	require.Equal(t, models.NewBlockIDs(nowBlock.ID(), futureBlock.ID()), scheduledIDs)
 }
 
+func TestScheduler_FutureBuffer(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := NewTestFramework(t, workers.CreateGroup("SchedulerTestFramework"))
+
+	tf.CreateIssuer("peer", 10)
+
+	blockScheduled := make(chan *Block, 1)
+	tf.Scheduler.Events.BlockScheduled.Hook(func(block *Block) {
+		blockScheduled <- block
+	})
+
+	tf.Scheduler.Start()
+
+	futureBlock := tf.CreateSchedulerBlock(models.WithIssuer(tf.Issuer("peer").PublicKey()), models.WithStrongParents(tf.Tangle.BlockDAG.BlockIDs("Genesis")), models.WithIssuingTime(time.Now().Add(500*time.Millisecond)))
+	tf.Scheduler.AddBlock(futureBlock.Block)
+
+	// the block is kept out of the scheduler buffer until its issuing time was reached
+	require.Equal(t, 1, tf.Scheduler.FutureBufferSize())
+	require.Zero(t, tf.Scheduler.TotalBlocksCount())
+
+	select {
+	case block := <-blockScheduled:
+		require.Equal(t, futureBlock.ID(), block.ID())
+		require.False(t, time.Now().Before(block.IssuingTime()))
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "future block was not scheduled")
+	}
+
+	require.Zero(t, tf.Scheduler.FutureBufferSize())
+	require.GreaterOrEqual(t, tf.Scheduler.FutureBufferMaxWait(), 400*time.Millisecond)
+	tf.AssertBlocksDropped(0)
+}
+
 func TestScheduler_Issue(t *testing.T) {
 	debug.SetEnabled(true)
 	defer debug.SetEnabled(false)
@@ -1,6 +1,8 @@
 package blockgadget
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/core/slot"
@@ -17,6 +19,8 @@ type Block struct {
 	weaklyConfirmed    bool
 	acceptanceQueued   bool
 	confirmationQueued bool
+	acceptanceTime     time.Time
+	confirmationTime   time.Time
 
 	*booker.Block
 }
@@ -55,7 +59,7 @@ func (b *Block) SetAccepted(weakly bool) (wasUpdated bool) {
 		}
 
 		// return true only if block was neither strongly and weakly accepted before
-		return wasUpdated && !b.accepted
+		return b.updateAcceptanceTime(wasUpdated && !b.accepted)
 	}
 
 	if wasUpdated = !b.accepted; wasUpdated {
@@ -63,7 +67,15 @@ func (b *Block) SetAccepted(weakly bool) (wasUpdated bool) {
 	}
 
 	// return true only if block was neither strongly and weakly accepted before
-	return wasUpdated && !b.weaklyAccepted
+	return b.updateAcceptanceTime(wasUpdated && !b.weaklyAccepted)
+}
+
+// AcceptanceTime returns the time at which the Block was accepted (zero if it was not accepted by the gadget).
+func (b *Block) AcceptanceTime() time.Time {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.acceptanceTime
 }
 
 func (b *Block) IsConfirmed() bool {
@@ -90,7 +102,7 @@ func (b *Block) SetConfirmed(weakly bool) (wasUpdated bool) {
 		}
 
 		// return true only if block was neither strongly and weakly confirmed before
-		return wasUpdated && !b.confirmed
+		return b.updateConfirmationTime(wasUpdated && !b.confirmed)
 	}
 
 	if wasUpdated = !b.confirmed; wasUpdated {
@@ -98,7 +110,30 @@ func (b *Block) SetConfirmed(weakly bool) (wasUpdated bool) {
 	}
 
 	// return true only if block was neither strongly and weakly confirmed before
-	return wasUpdated && !b.weaklyConfirmed
+	return b.updateConfirmationTime(wasUpdated && !b.weaklyConfirmed)
+}
+
+// ConfirmationTime returns the time at which the Block was confirmed (zero if it is not confirmed by the gadget).
+func (b *Block) ConfirmationTime() time.Time {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.confirmationTime
+}
+
+// SetUnconfirmed removes the (strong and weak) confirmation of the Block so that it can be confirmed again later.
+func (b *Block) SetUnconfirmed() (wasUpdated bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if wasUpdated = b.confirmed || b.weaklyConfirmed; wasUpdated {
+		b.confirmed = false
+		b.weaklyConfirmed = false
+		b.confirmationQueued = false
+		b.confirmationTime = time.Time{}
+	}
+
+	return wasUpdated
 }
 
 func (b *Block) IsAcceptanceQueued() bool {
@@ -137,6 +172,24 @@ func (b *Block) SetConfirmationQueued() (wasUpdated bool) {
 	return
 }
 
+// updateAcceptanceTime records the current time as the acceptance time if the Block was accepted.
+func (b *Block) updateAcceptanceTime(wasAccepted bool) bool {
+	if wasAccepted {
+		b.acceptanceTime = time.Now()
+	}
+
+	return wasAccepted
+}
+
+// updateConfirmationTime records the current time as the confirmation time if the Block was confirmed.
+func (b *Block) updateConfirmationTime(wasConfirmed bool) bool {
+	if wasConfirmed {
+		b.confirmationTime = time.Now()
+	}
+
+	return wasConfirmed
+}
+
 func NewRootBlock(blockID models.BlockID, slotTimeProvider *slot.TimeProvider) *Block {
 	virtualVotingBlock := booker.NewRootBlock(blockID, slotTimeProvider)
 
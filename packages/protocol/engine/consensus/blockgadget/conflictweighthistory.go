@@ -0,0 +1,146 @@
+package blockgadget
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+// DefaultConflictWeightHistorySize contains the default number of approval weight samples that are retained per
+// Conflict.
+const DefaultConflictWeightHistorySize = 100
+
+// region ConflictWeightHistory ////////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictWeightHistory records the approval weight of the active Conflicts over time, so that the race between
+// competing Conflicts can be reconstructed instead of only its final outcome.
+type ConflictWeightHistory struct {
+	windows *shrinkingmap.ShrinkingMap[utxo.TransactionID, *conflictWeightWindow]
+	size    int
+	mutex   sync.RWMutex
+}
+
+// NewConflictWeightHistory creates a new ConflictWeightHistory that retains the given number of samples per Conflict.
+func NewConflictWeightHistory(size int) (newConflictWeightHistory *ConflictWeightHistory) {
+	if size < 1 {
+		size = 1
+	}
+
+	return &ConflictWeightHistory{
+		windows: shrinkingmap.New[utxo.TransactionID, *conflictWeightWindow](),
+		size:    size,
+	}
+}
+
+// Track hooks the ConflictWeightHistory to the vote events of the given Booker and to the archival of resolved
+// ConflictSets of the given ConflictDAG, and returns a function that stops the tracking.
+func (c *ConflictWeightHistory) Track(bookerInstance booker.Booker, conflictDAG *conflictdag.ConflictDAG[utxo.TransactionID, utxo.OutputID]) (unhook func()) {
+	recordWeight := func(evt *conflicttracker.VoterEvent[utxo.TransactionID]) {
+		c.Record(evt.ConflictID, bookerInstance.VirtualVoting().ConflictVotersTotalWeight(evt.ConflictID), time.Now())
+	}
+
+	return lo.Batch(
+		bookerInstance.Events().VirtualVoting.ConflictTracker.VoterAdded.Hook(recordWeight).Unhook,
+		bookerInstance.Events().VirtualVoting.ConflictTracker.VoterRemoved.Hook(recordWeight).Unhook,
+		conflictDAG.Events.ConflictSetArchived.Hook(func(archivedConflictSet *conflictdag.ArchivedConflictSet[utxo.TransactionID, utxo.OutputID]) {
+			if winner, exists := archivedConflictSet.Winner(); exists {
+				c.Evict(winner)
+			}
+			for it := archivedConflictSet.Losers().Iterator(); it.HasNext(); {
+				c.Evict(it.Next())
+			}
+		}).Unhook,
+	)
+}
+
+// Record adds a sample with the given approval weight of the given Conflict (replacing the oldest sample of the
+// Conflict if its history is full).
+func (c *ConflictWeightHistory) Record(conflictID utxo.TransactionID, weight int64, sampleTime time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	window, _ := c.windows.GetOrCreate(conflictID, func() *conflictWeightWindow {
+		return newConflictWeightWindow(c.size)
+	})
+	window.Add(&ConflictWeightSample{Time: sampleTime, Weight: weight})
+}
+
+// History returns the recorded approval weight samples of the given Conflict, ordered from the oldest to the newest.
+func (c *ConflictWeightHistory) History(conflictID utxo.TransactionID) (samples []*ConflictWeightSample) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	window, exists := c.windows.Get(conflictID)
+	if !exists {
+		return nil
+	}
+
+	return window.Samples()
+}
+
+// Evict removes the recorded samples of the given Conflict.
+func (c *ConflictWeightHistory) Evict(conflictID utxo.TransactionID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.windows.Delete(conflictID)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictWeightSample /////////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictWeightSample contains the approval weight of a Conflict at a given point in time.
+type ConflictWeightSample struct {
+	// Time contains the time at which the sample was taken.
+	Time time.Time
+
+	// Weight contains the total weight of the Validators voting for the Conflict.
+	Weight int64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region conflictWeightWindow /////////////////////////////////////////////////////////////////////////////////////////
+
+// conflictWeightWindow is a ring buffer that contains the most recent approval weight samples of a Conflict.
+type conflictWeightWindow struct {
+	samples []*ConflictWeightSample
+	next    int
+	full    bool
+}
+
+// newConflictWeightWindow creates a new conflictWeightWindow with the given size.
+func newConflictWeightWindow(size int) *conflictWeightWindow {
+	return &conflictWeightWindow{
+		samples: make([]*ConflictWeightSample, size),
+	}
+}
+
+// Add adds the given sample to the window (replacing the oldest sample if the window is full).
+func (c *conflictWeightWindow) Add(sample *ConflictWeightSample) {
+	c.samples[c.next] = sample
+	if c.next = (c.next + 1) % len(c.samples); c.next == 0 {
+		c.full = true
+	}
+}
+
+// Samples returns the samples in the window, ordered from the oldest to the newest.
+func (c *conflictWeightWindow) Samples() (samples []*ConflictWeightSample) {
+	if !c.full {
+		return append(samples, c.samples[:c.next]...)
+	}
+
+	samples = make([]*ConflictWeightSample, 0, len(c.samples))
+	samples = append(samples, c.samples[c.next:]...)
+
+	return append(samples, c.samples[:c.next]...)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
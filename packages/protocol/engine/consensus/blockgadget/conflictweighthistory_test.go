@@ -0,0 +1,39 @@
+package blockgadget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+)
+
+func TestConflictWeightHistory(t *testing.T) {
+	history := NewConflictWeightHistory(3)
+
+	conflictID := utxo.NewTransactionID([]byte("conflict"))
+	require.Empty(t, history.History(conflictID))
+
+	sampleTime := time.Unix(0, 0)
+	for i := 1; i <= 2; i++ {
+		history.Record(conflictID, int64(i*10), sampleTime.Add(time.Duration(i)*time.Second))
+	}
+	require.Equal(t, []*ConflictWeightSample{
+		{Time: sampleTime.Add(1 * time.Second), Weight: 10},
+		{Time: sampleTime.Add(2 * time.Second), Weight: 20},
+	}, history.History(conflictID))
+
+	// the oldest samples are replaced once the history of the conflict is full
+	for i := 3; i <= 5; i++ {
+		history.Record(conflictID, int64(i*10), sampleTime.Add(time.Duration(i)*time.Second))
+	}
+	require.Equal(t, []*ConflictWeightSample{
+		{Time: sampleTime.Add(3 * time.Second), Weight: 30},
+		{Time: sampleTime.Add(4 * time.Second), Weight: 40},
+		{Time: sampleTime.Add(5 * time.Second), Weight: 50},
+	}, history.History(conflictID))
+
+	history.Evict(conflictID)
+	require.Empty(t, history.History(conflictID))
+}
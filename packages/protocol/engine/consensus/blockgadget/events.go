@@ -7,10 +7,11 @@ import (
 )
 
 type Events struct {
-	BlockAccepted  *event.Event1[*Block]
-	BlockConfirmed *event.Event1[*Block]
-	SlotClosed     *event.Event1[*shrinkingmap.ShrinkingMap[models.BlockID, *Block]]
-	Error          *event.Event1[error]
+	BlockAccepted    *event.Event1[*Block]
+	BlockConfirmed   *event.Event1[*Block]
+	BlockUnconfirmed *event.Event1[*Block]
+	SlotClosed       *event.Event1[*shrinkingmap.ShrinkingMap[models.BlockID, *Block]]
+	Error            *event.Event1[error]
 
 	event.Group[Events, *Events]
 }
@@ -18,9 +19,10 @@ type Events struct {
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		BlockAccepted:  event.New1[*Block](),
-		BlockConfirmed: event.New1[*Block](),
-		SlotClosed:     event.New1[*shrinkingmap.ShrinkingMap[models.BlockID, *Block]](),
-		Error:          event.New1[error](),
+		BlockAccepted:    event.New1[*Block](),
+		BlockConfirmed:   event.New1[*Block](),
+		BlockUnconfirmed: event.New1[*Block](),
+		SlotClosed:       event.New1[*shrinkingmap.ShrinkingMap[models.BlockID, *Block]](),
+		Error:            event.New1[error](),
 	}
 })
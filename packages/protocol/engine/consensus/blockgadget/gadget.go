@@ -19,5 +19,11 @@ type Gadget interface {
 
 	FirstUnacceptedIndex(sequenceID markers.SequenceID) (firstUnacceptedIndex markers.Index)
 
+	// Stats returns the rolling time to finality statistics of the blocks that were accepted and confirmed.
+	Stats() *Stats
+
+	// ConflictWeightHistory returns the recorded approval weights of the active conflicts.
+	ConflictWeightHistory() *ConflictWeightHistory
+
 	module.Interface
 }
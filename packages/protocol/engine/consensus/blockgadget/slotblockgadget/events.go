@@ -0,0 +1,38 @@
+package slotblockgadget
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// Events contains the events that are triggered when whole slots are accepted or confirmed by the Gadget.
+type Events struct {
+	// SlotAccepted is triggered when a slot (and all of its known blocks) was accepted.
+	SlotAccepted *event.Event1[*Slot]
+
+	// SlotConfirmed is triggered when a slot (and all of its known blocks) was confirmed.
+	SlotConfirmed *event.Event1[*Slot]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		SlotAccepted:  event.New1[*Slot](),
+		SlotConfirmed: event.New1[*Slot](),
+	}
+})
+
+// Slot contains the details of a slot that was finalized by the Gadget as a whole.
+type Slot struct {
+	// Index contains the index of the slot.
+	Index slot.Index
+
+	// Weight contains the cumulative weight of the validators that issued blocks committing to the slot (or a later one).
+	Weight int64
+
+	// Blocks contains the blocks of the slot that were known when the slot was finalized.
+	Blocks []*blockgadget.Block
+}
@@ -0,0 +1,531 @@
+package slotblockgadget
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
+	"github.com/iotaledger/goshimmer/packages/core/votes/slottracker"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/eviction"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
+	"github.com/iotaledger/goshimmer/packages/protocol/markers"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/causalorder"
+	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+// region Gadget ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Gadget is a block gadget that accepts and confirms whole slots of blocks at once. A slot is accepted (confirmed) as
+// soon as the validators that issued blocks committing to the slot (or a later one) hold enough weight - all blocks of
+// the slot are then accepted (confirmed) together, without propagating the approval weight of individual markers.
+type Gadget struct {
+	events     *blockgadget.Events
+	slotEvents *Events
+	stats      *blockgadget.Stats
+
+	conflictWeightHistory *blockgadget.ConflictWeightHistory
+
+	booker   booker.Booker
+	blockDAG blockdag.BlockDAG
+	memPool  mempool.MemPool
+
+	blocks           *memstorage.SlotStorage[models.BlockID, *blockgadget.Block]
+	evictionState    *eviction.State
+	evictionMutex    sync.RWMutex
+	slotTimeProvider *slot.TimeProvider
+
+	workers             *workerpool.Group
+	validators          *sybilprotection.WeightedSet
+	totalWeightCallback func() int64
+
+	lastAcceptedSlot  slot.Index
+	lastConfirmedSlot slot.Index
+	slotMutex         sync.RWMutex
+	acceptanceOrder   *causalorder.CausalOrder[models.BlockID, *blockgadget.Block]
+	confirmationOrder *causalorder.CausalOrder[models.BlockID, *blockgadget.Block]
+
+	lastAcceptedMarker      *shrinkingmap.ShrinkingMap[markers.SequenceID, markers.Index]
+	lastAcceptedMarkerMutex sync.Mutex
+
+	optsSlotAcceptanceThreshold     float64
+	optsSlotConfirmationThreshold   float64
+	optsConflictAcceptanceThreshold float64
+	optsStatsWindowSize             int
+	optsConflictWeightHistorySize   int
+
+	module.Module
+}
+
+func NewProvider(opts ...options.Option[Gadget]) module.Provider[*engine.Engine, blockgadget.Gadget] {
+	return module.Provide(func(e *engine.Engine) blockgadget.Gadget {
+		g := New(e.Workers.CreateGroup("BlockGadget"), e.Tangle.Booker(), e.Tangle.BlockDAG(), e.Ledger.MemPool(), e.EvictionState, opts...)
+
+		e.SybilProtection.HookInitialized(func() {
+			g.Initialize(e.SlotTimeProvider(), e.SybilProtection.Validators(), e.SybilProtection.Weights().TotalWeightWithoutZeroIdentity)
+		})
+
+		return g
+	})
+}
+
+func New(workers *workerpool.Group, bookerInstance booker.Booker, blockDAG blockdag.BlockDAG, memPool mempool.MemPool, evictionState *eviction.State, opts ...options.Option[Gadget]) *Gadget {
+	return options.Apply(&Gadget{
+		events:             blockgadget.NewEvents(),
+		slotEvents:         NewEvents(),
+		blocks:             memstorage.NewSlotStorage[models.BlockID, *blockgadget.Block](),
+		lastAcceptedMarker: shrinkingmap.New[markers.SequenceID, markers.Index](),
+
+		workers:       workers,
+		booker:        bookerInstance,
+		blockDAG:      blockDAG,
+		memPool:       memPool,
+		evictionState: evictionState,
+
+		optsSlotAcceptanceThreshold:     0.67,
+		optsSlotConfirmationThreshold:   0.67,
+		optsConflictAcceptanceThreshold: 0.67,
+		optsStatsWindowSize:             blockgadget.DefaultStatsWindowSize,
+		optsConflictWeightHistorySize:   blockgadget.DefaultConflictWeightHistorySize,
+	}, opts,
+		func(g *Gadget) {
+			g.stats = blockgadget.NewStats(g.optsStatsWindowSize)
+			g.stats.Track(g.events)
+
+			g.conflictWeightHistory = blockgadget.NewConflictWeightHistory(g.optsConflictWeightHistorySize)
+			g.conflictWeightHistory.Track(g.booker, g.memPool.ConflictDAG())
+
+			// the blocks need to be registered before their votes are tracked, so the hook must not use a worker pool
+			g.booker.Events().BlockBooked.Hook(func(evt *booker.BlockBookedEvent) {
+				g.registerBookedBlock(evt.Block)
+			})
+
+			g.booker.Events().VirtualVoting.SlotTracker.VotersUpdated.Hook(func(evt *slottracker.VoterUpdatedEvent) {
+				g.RefreshSlots(evt.NewLatestSlotIndex)
+			}, event.WithWorkerPool(g.workers.CreatePool("Gadget", 1)))
+
+			g.booker.Events().VirtualVoting.ConflictTracker.VoterAdded.Hook(func(evt *conflicttracker.VoterEvent[utxo.TransactionID]) {
+				g.RefreshConflictAcceptance(evt.ConflictID)
+			})
+
+			g.booker.Events().SequenceEvicted.Hook(g.evictSequence)
+		},
+		(*Gadget).TriggerConstructed,
+	)
+}
+
+func (g *Gadget) Initialize(slotTimeProvider *slot.TimeProvider, validators *sybilprotection.WeightedSet, totalWeightCallback func() int64) {
+	g.slotTimeProvider = slotTimeProvider
+	g.validators = validators
+	g.totalWeightCallback = totalWeightCallback
+
+	g.acceptanceOrder = causalorder.New(g.workers.CreatePool("AcceptanceOrder", 2), g.orderedBlock, (*blockgadget.Block).IsStronglyAccepted, g.markAsAccepted, g.acceptanceFailed, (*blockgadget.Block).StrongParents)
+	g.confirmationOrder = causalorder.New(g.workers.CreatePool("ConfirmationOrder", 2), g.orderedBlock, (*blockgadget.Block).IsStronglyConfirmed, g.markAsConfirmed, g.confirmationFailed, (*blockgadget.Block).StrongParents)
+
+	g.evictionState.Events.SlotEvicted.Hook(g.EvictUntil, event.WithWorkerPool(g.workers.CreatePool("Eviction", 1)))
+
+	g.TriggerInitialized()
+}
+
+func (g *Gadget) Events() *blockgadget.Events {
+	return g.events
+}
+
+// Stats returns the rolling time to finality statistics of the blocks that were accepted and confirmed.
+func (g *Gadget) Stats() *blockgadget.Stats {
+	return g.stats
+}
+
+// ConflictWeightHistory returns the recorded approval weights of the active conflicts.
+func (g *Gadget) ConflictWeightHistory() *blockgadget.ConflictWeightHistory {
+	return g.conflictWeightHistory
+}
+
+// SlotEvents returns the events that are triggered when whole slots are accepted or confirmed.
+func (g *Gadget) SlotEvents() *Events {
+	return g.slotEvents
+}
+
+// LastAcceptedSlot returns the index of the last accepted slot.
+func (g *Gadget) LastAcceptedSlot() (index slot.Index) {
+	g.slotMutex.RLock()
+	defer g.slotMutex.RUnlock()
+
+	return g.lastAcceptedSlot
+}
+
+// LastConfirmedSlot returns the index of the last confirmed slot.
+func (g *Gadget) LastConfirmedSlot() (index slot.Index) {
+	g.slotMutex.RLock()
+	defer g.slotMutex.RUnlock()
+
+	return g.lastConfirmedSlot
+}
+
+// IsMarkerAccepted returns whether the given marker is accepted.
+func (g *Gadget) IsMarkerAccepted(marker markers.Marker) (accepted bool) {
+	if marker.Index() == 0 {
+		return true
+	}
+
+	lastAcceptedIndex, exists := g.lastAcceptedMarker.Get(marker.SequenceID())
+	return exists && lastAcceptedIndex >= marker.Index()
+}
+
+func (g *Gadget) FirstUnacceptedIndex(sequenceID markers.SequenceID) (firstUnacceptedIndex markers.Index) {
+	lastAcceptedIndex, exists := g.lastAcceptedMarker.Get(sequenceID)
+	if !exists {
+		return 1
+	}
+
+	return lastAcceptedIndex + 1
+}
+
+// IsBlockAccepted returns whether the given block is accepted.
+func (g *Gadget) IsBlockAccepted(blockID models.BlockID) (accepted bool) {
+	g.evictionMutex.RLock()
+	defer g.evictionMutex.RUnlock()
+
+	block, exists := g.block(blockID)
+	return exists && block.IsAccepted()
+}
+
+func (g *Gadget) IsBlockConfirmed(blockID models.BlockID) bool {
+	g.evictionMutex.RLock()
+	defer g.evictionMutex.RUnlock()
+
+	block, exists := g.block(blockID)
+	return exists && block.IsConfirmed()
+}
+
+// Block retrieves a Block with metadata from the in-memory storage of the Gadget.
+func (g *Gadget) Block(id models.BlockID) (block *blockgadget.Block, exists bool) {
+	g.evictionMutex.RLock()
+	defer g.evictionMutex.RUnlock()
+
+	return g.block(id)
+}
+
+// RefreshSlots accepts (confirms) the slots up to the given slot whose cumulative slot weight (the weight of the
+// validators that issued blocks committing to the slot or a later one) reached the acceptance (confirmation) threshold.
+func (g *Gadget) RefreshSlots(latestSlotIndex slot.Index) {
+	// the weights are determined before locking the Gadget, so that we never wait for the VirtualVoting while holding
+	// the lock that is also acquired when the Booker books new blocks
+	lastAcceptedSlot, lastConfirmedSlot := g.LastAcceptedSlot(), g.LastConfirmedSlot()
+	totalWeight := g.totalWeightCallback()
+
+	slotWeights := make(map[slot.Index]int64)
+	acceptedSlot, confirmedSlot := lastAcceptedSlot, lastConfirmedSlot
+	for slotIndex := lastConfirmedSlot + 1; slotIndex <= latestSlotIndex; slotIndex++ {
+		slotWeights[slotIndex] = g.booker.VirtualVoting().SlotVotersTotalWeight(slotIndex)
+
+		accepted, confirmed := g.tryConfirmOrAccept(totalWeight, slotWeights[slotIndex])
+		if confirmed && confirmedSlot == slotIndex-1 {
+			confirmedSlot = slotIndex
+		}
+		if accepted && acceptedSlot == slotIndex-1 {
+			acceptedSlot = slotIndex
+		}
+
+		// the slots are finalized in order, so we can stop at the first slot that is neither accepted nor confirmed
+		if confirmedSlot < slotIndex && acceptedSlot < slotIndex {
+			break
+		}
+	}
+
+	g.evictionMutex.RLock()
+	g.slotMutex.Lock()
+
+	acceptedSlots := g.finalizeSlots(&g.lastAcceptedSlot, acceptedSlot, slotWeights)
+	confirmedSlots := g.finalizeSlots(&g.lastConfirmedSlot, confirmedSlot, slotWeights)
+
+	g.slotMutex.Unlock()
+	g.evictionMutex.RUnlock()
+
+	for _, slotDetails := range acceptedSlots {
+		for _, block := range slotDetails.Blocks {
+			g.acceptanceOrder.Queue(block)
+		}
+
+		g.slotEvents.SlotAccepted.Trigger(slotDetails)
+	}
+
+	for _, slotDetails := range confirmedSlots {
+		for _, block := range slotDetails.Blocks {
+			g.confirmationOrder.Queue(block)
+		}
+
+		g.slotEvents.SlotConfirmed.Trigger(slotDetails)
+	}
+}
+
+// tryConfirmOrAccept checks if there is enough active weight to confirm slots and then checks if the slot has
+// accumulated enough weight to be both accepted and confirmed. If there is not enough online weight to achieve
+// confirmation, then the acceptance is evaluated based on the total weight of the validators.
+func (g *Gadget) tryConfirmOrAccept(totalWeight, slotWeight int64) (accepted, confirmed bool) {
+	if IsThresholdReached(totalWeight, g.validators.TotalWeight(), g.optsSlotConfirmationThreshold) {
+		if IsThresholdReached(totalWeight, slotWeight, g.optsSlotConfirmationThreshold) {
+			return true, true
+		}
+	}
+
+	return IsThresholdReached(g.validators.TotalWeight(), slotWeight, g.optsSlotAcceptanceThreshold), false
+}
+
+// finalizeSlots advances the given last finalized slot to the target slot and returns the Slots that were finalized.
+func (g *Gadget) finalizeSlots(lastFinalizedSlot *slot.Index, targetSlot slot.Index, slotWeights map[slot.Index]int64) (finalizedSlots []*Slot) {
+	for ; *lastFinalizedSlot < targetSlot; *lastFinalizedSlot++ {
+		finalizedSlots = append(finalizedSlots, g.slot(*lastFinalizedSlot+1, slotWeights[*lastFinalizedSlot+1]))
+	}
+
+	return finalizedSlots
+}
+
+// slot returns the Slot with the given index that contains the blocks that are known to the Gadget.
+func (g *Gadget) slot(index slot.Index, weight int64) (slotDetails *Slot) {
+	slotDetails = &Slot{
+		Index:  index,
+		Weight: weight,
+		Blocks: make([]*blockgadget.Block, 0),
+	}
+
+	if storage := g.blocks.Get(index, false); storage != nil {
+		storage.ForEach(func(_ models.BlockID, block *blockgadget.Block) bool {
+			slotDetails.Blocks = append(slotDetails.Blocks, block)
+			return true
+		})
+	}
+
+	return slotDetails
+}
+
+func (g *Gadget) EvictUntil(index slot.Index) {
+	g.acceptanceOrder.EvictUntil(index)
+	g.confirmationOrder.EvictUntil(index)
+
+	g.evictionMutex.Lock()
+	defer g.evictionMutex.Unlock()
+
+	if evictedStorage := g.blocks.Evict(index); evictedStorage != nil {
+		g.events.SlotClosed.Trigger(evictedStorage)
+	}
+}
+
+// registerBookedBlock registers a booked block. Blocks that are booked after their slot was already accepted (confirmed)
+// are accepted (confirmed) right away, as their slot was finalized as a whole.
+func (g *Gadget) registerBookedBlock(bookerBlock *booker.Block) {
+	if bookerBlock.IsSubjectivelyInvalid() {
+		return
+	}
+
+	g.evictionMutex.RLock()
+	g.slotMutex.RLock()
+
+	block, err := g.registerBlock(bookerBlock)
+	slotAccepted, slotConfirmed := block != nil && block.ID().Index() <= g.lastAcceptedSlot, block != nil && block.ID().Index() <= g.lastConfirmedSlot
+
+	g.slotMutex.RUnlock()
+	g.evictionMutex.RUnlock()
+
+	if err != nil {
+		g.events.Error.Trigger(errors.Wrapf(err, "could not register block %s", bookerBlock.ID()))
+		return
+	}
+
+	if slotAccepted {
+		g.acceptanceOrder.Queue(block)
+	}
+	if slotConfirmed {
+		g.confirmationOrder.Queue(block)
+	}
+}
+
+func (g *Gadget) registerBlock(bookerBlock *booker.Block) (block *blockgadget.Block, err error) {
+	if g.evictionState.InEvictedSlot(bookerBlock.ID()) {
+		return nil, errors.Errorf("block %s belongs to an evicted slot", bookerBlock.ID())
+	}
+
+	blockStorage := g.blocks.Get(bookerBlock.ID().Index(), true)
+	block, _ = blockStorage.GetOrCreate(bookerBlock.ID(), func() *blockgadget.Block {
+		return blockgadget.NewBlock(bookerBlock)
+	})
+
+	return block, nil
+}
+
+func (g *Gadget) block(id models.BlockID) (block *blockgadget.Block, exists bool) {
+	if g.evictionState.IsRootBlock(id) {
+		return blockgadget.NewRootBlock(id, g.slotTimeProvider), true
+	}
+
+	storage := g.blocks.Get(id.Index(), false)
+	if storage == nil {
+		return nil, false
+	}
+
+	return storage.Get(id)
+}
+
+// orderedBlock retrieves the blocks for the causal orders (the blocks of evicted slots are final).
+func (g *Gadget) orderedBlock(id models.BlockID) (block *blockgadget.Block, exists bool) {
+	g.evictionMutex.RLock()
+	defer g.evictionMutex.RUnlock()
+
+	if g.evictionState.InEvictedSlot(id) {
+		return blockgadget.NewRootBlock(id, g.slotTimeProvider), true
+	}
+
+	return g.block(id)
+}
+
+func (g *Gadget) markAsAccepted(block *blockgadget.Block) (err error) {
+	if g.evictionState.InEvictedSlot(block.ID()) {
+		return errors.Errorf("block with %s belongs to an evicted slot", block.ID())
+	}
+
+	if block.SetAccepted(false) {
+		// If block has been orphaned before acceptance, remove the flag from the block.
+		if block.IsOrphaned() {
+			g.blockDAG.SetOrphaned(block.Block.Block, false)
+		}
+
+		if structureDetails := block.StructureDetails(); structureDetails != nil && structureDetails.IsPastMarker() {
+			g.setMarkerAccepted(structureDetails.PastMarkers().Marker())
+		}
+
+		g.events.BlockAccepted.Trigger(block)
+
+		// set ConfirmationState of payload (applicable only to transactions)
+		if tx, ok := block.Transaction(); ok {
+			g.memPool.SetTransactionInclusionSlot(tx.ID(), g.slotTimeProvider.IndexFromTime(block.IssuingTime()))
+		}
+	}
+
+	return nil
+}
+
+func (g *Gadget) markAsConfirmed(block *blockgadget.Block) (err error) {
+	if g.evictionState.InEvictedSlot(block.ID()) {
+		return errors.Errorf("block with %s belongs to an evicted slot", block.ID())
+	}
+
+	if block.SetConfirmed(false) {
+		g.events.BlockConfirmed.Trigger(block)
+	}
+
+	return nil
+}
+
+func (g *Gadget) setMarkerAccepted(marker markers.Marker) {
+	g.lastAcceptedMarkerMutex.Lock()
+	defer g.lastAcceptedMarkerMutex.Unlock()
+
+	if index, exists := g.lastAcceptedMarker.Get(marker.SequenceID()); !exists || index < marker.Index() {
+		g.lastAcceptedMarker.Set(marker.SequenceID(), marker.Index())
+	}
+}
+
+func (g *Gadget) acceptanceFailed(block *blockgadget.Block, err error) {
+	g.events.Error.Trigger(errors.Wrapf(err, "could not mark block %s as accepted", block.ID()))
+}
+
+func (g *Gadget) confirmationFailed(block *blockgadget.Block, err error) {
+	g.events.Error.Trigger(errors.Wrapf(err, "could not mark block %s as confirmed", block.ID()))
+}
+
+func (g *Gadget) evictSequence(sequenceID markers.SequenceID) {
+	g.lastAcceptedMarker.Delete(sequenceID)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Conflict Acceptance //////////////////////////////////////////////////////////////////////////////////////////
+
+func (g *Gadget) RefreshConflictAcceptance(conflictID utxo.TransactionID) {
+	conflict, exists := g.memPool.ConflictDAG().Conflict(conflictID)
+	if !exists {
+		return
+	}
+
+	conflictWeight := g.booker.VirtualVoting().ConflictVotersTotalWeight(conflictID)
+
+	if !IsThresholdReached(g.totalWeightCallback(), conflictWeight, g.optsConflictAcceptanceThreshold) {
+		return
+	}
+
+	markAsAccepted := true
+
+	conflict.ForEachConflictingConflict(func(conflictingConflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) bool {
+		conflictingConflictWeight := g.booker.VirtualVoting().ConflictVotersTotalWeight(conflictingConflict.ID())
+
+		// if the conflict is less than 66% ahead, then don't mark as accepted
+		if !IsThresholdReached(g.totalWeightCallback(), conflictWeight-conflictingConflictWeight, g.optsConflictAcceptanceThreshold) {
+			markAsAccepted = false
+		}
+		return markAsAccepted
+	})
+
+	if markAsAccepted {
+		g.memPool.ConflictDAG().SetConflictAccepted(conflictID)
+	}
+}
+
+func IsThresholdReached(weight, otherWeight int64, threshold float64) bool {
+	return otherWeight > int64(float64(weight)*threshold)
+}
+
+var _ blockgadget.Gadget = new(Gadget)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithSlotAcceptanceThreshold sets the share of the total weight that needs to have moved past a slot to accept it.
+func WithSlotAcceptanceThreshold(acceptanceThreshold float64) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsSlotAcceptanceThreshold = acceptanceThreshold
+	}
+}
+
+// WithSlotConfirmationThreshold sets the share of the online weight that needs to have moved past a slot to confirm it.
+func WithSlotConfirmationThreshold(confirmationThreshold float64) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsSlotConfirmationThreshold = confirmationThreshold
+	}
+}
+
+// WithStatsWindowSize sets the number of most recently finalized blocks that the time to finality statistics cover.
+func WithStatsWindowSize(windowSize int) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsStatsWindowSize = windowSize
+	}
+}
+
+// WithConflictWeightHistorySize sets the number of approval weight samples that are retained per conflict.
+func WithConflictWeightHistorySize(size int) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsConflictWeightHistorySize = size
+	}
+}
+
+func WithConflictAcceptanceThreshold(acceptanceThreshold float64) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsConflictAcceptanceThreshold = acceptanceThreshold
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
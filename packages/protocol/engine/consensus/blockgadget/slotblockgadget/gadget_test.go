@@ -0,0 +1,119 @@
+package slotblockgadget_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget/slotblockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/testtangle"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func NewDefaultTestFramework(t *testing.T, workers *workerpool.Group, memPool mempool.MemPool, slotTimeProvider *slot.TimeProvider, optsGadget ...options.Option[slotblockgadget.Gadget]) (*blockgadget.TestFramework, *slotblockgadget.Gadget) {
+	tangleTF := testtangle.NewDefaultTestFramework(t, workers.CreateGroup("TangleTestFramework"), memPool, slotTimeProvider)
+
+	gadget := slotblockgadget.New(workers.CreateGroup("BlockGadget"),
+		tangleTF.Instance.Booker(),
+		tangleTF.Instance.BlockDAG(),
+		memPool,
+		tangleTF.Instance.(*testtangle.TestTangle).EvictionState(),
+		optsGadget...,
+	)
+
+	gadget.Initialize(slotTimeProvider, tangleTF.Votes.Validators, tangleTF.Votes.Validators.TotalWeight)
+
+	return blockgadget.NewTestFramework(t, gadget, tangleTF), gadget
+}
+
+func TestGadget_SlotAcceptance(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	slotTimeProvider := slot.NewTimeProvider(time.Now().Unix(), 10)
+
+	tf, gadget := NewDefaultTestFramework(t,
+		workers.CreateGroup("BlockGadgetTestFramework"),
+		realitiesledger.NewTestLedger(t, workers.CreateGroup("Ledger")),
+		slotTimeProvider,
+	)
+
+	var acceptedSlotsMutex sync.Mutex
+	acceptedSlots := make(map[slot.Index]int)
+	gadget.SlotEvents().SlotAccepted.Hook(func(acceptedSlot *slotblockgadget.Slot) {
+		acceptedSlotsMutex.Lock()
+		defer acceptedSlotsMutex.Unlock()
+
+		acceptedSlots[acceptedSlot.Index] = len(acceptedSlot.Blocks)
+	})
+
+	tf.VirtualVoting.CreateIdentity("A", 30)
+	tf.VirtualVoting.CreateIdentity("B", 30)
+	tf.VirtualVoting.CreateIdentity("C", 40)
+
+	genesisCommitment := commitment.New(0, commitment.ID{}, types.Identifier{}, 0)
+	commitment1 := commitment.New(1, genesisCommitment.ID(), types.Identifier{1}, 0)
+	require.NoError(t, tf.Tangle.Instance.(*testtangle.TestTangle).Storage().Commitments.Store(genesisCommitment))
+	require.NoError(t, tf.Tangle.Instance.(*testtangle.TestTangle).Storage().Commitments.Store(commitment1))
+
+	// ISSUE Block1 and Block2 in slot 1
+	tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()), models.WithIssuingTime(slotTimeProvider.StartTime(1)))
+	tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithIssuer(tf.VirtualVoting.Identity("B").PublicKey()), models.WithIssuingTime(slotTimeProvider.StartTime(1)))
+	tf.BlockDAG.IssueBlocks("Block1", "Block2")
+	workers.WaitChildren()
+
+	require.Equal(t, slot.Index(0), gadget.LastAcceptedSlot())
+	tf.AssertBlockAccepted(0)
+
+	// ISSUE Block3 in slot 2 committing to slot 1 (not enough weight committed to slot 1)
+	tf.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()), models.WithIssuingTime(slotTimeProvider.StartTime(2)), models.WithCommitment(commitment1))
+	tf.BlockDAG.IssueBlocks("Block3")
+	workers.WaitChildren()
+
+	require.Equal(t, slot.Index(0), gadget.LastAcceptedSlot())
+	tf.AssertBlockAccepted(0)
+
+	// ISSUE Block4 in slot 2 committing to slot 1 (slot 1 is accepted and confirmed as a whole)
+	tf.BlockDAG.CreateBlock("Block4", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block3")), models.WithIssuer(tf.VirtualVoting.Identity("C").PublicKey()), models.WithIssuingTime(slotTimeProvider.StartTime(2)), models.WithCommitment(commitment1))
+	tf.BlockDAG.IssueBlocks("Block4")
+	workers.WaitChildren()
+
+	require.Equal(t, slot.Index(1), gadget.LastAcceptedSlot())
+	require.Equal(t, slot.Index(1), gadget.LastConfirmedSlot())
+	tf.ValidateAcceptedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": true,
+		"Block3": false,
+		"Block4": false,
+	})
+	tf.ValidateConfirmedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": true,
+		"Block3": false,
+		"Block4": false,
+	})
+	tf.AssertBlockAccepted(2)
+	tf.AssertBlockConfirmed(2)
+
+	acceptedSlotsMutex.Lock()
+	require.Equal(t, map[slot.Index]int{1: 2}, acceptedSlots)
+	acceptedSlotsMutex.Unlock()
+
+	// ISSUE Block5 late into the already accepted slot 1
+	tf.BlockDAG.CreateBlock("Block5", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")), models.WithIssuer(tf.VirtualVoting.Identity("B").PublicKey()), models.WithIssuingTime(slotTimeProvider.StartTime(1)))
+	tf.BlockDAG.IssueBlocks("Block5")
+	workers.WaitChildren()
+
+	tf.ValidateAcceptedBlocks(map[string]bool{
+		"Block5": true,
+	})
+	tf.AssertBlockAccepted(3)
+}
@@ -0,0 +1,158 @@
+package blockgadget
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/lo"
+)
+
+// DefaultStatsWindowSize contains the default number of blocks that the rolling time to finality percentiles are
+// computed over.
+const DefaultStatsWindowSize = 1000
+
+// region Stats ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Stats maintains rolling percentiles of the time to finality (the time that passes between issuing a Block and
+// accepting or confirming it) of the most recently finalized Blocks of a Gadget.
+type Stats struct {
+	timesToAcceptance   *timeToFinalityWindow
+	timesToConfirmation *timeToFinalityWindow
+}
+
+// NewStats creates a new Stats instance that computes the percentiles over the given number of most recent Blocks.
+func NewStats(windowSize int) (newStats *Stats) {
+	return &Stats{
+		timesToAcceptance:   newTimeToFinalityWindow(windowSize),
+		timesToConfirmation: newTimeToFinalityWindow(windowSize),
+	}
+}
+
+// Track hooks the Stats to the given Events and returns a function that stops the tracking.
+func (s *Stats) Track(events *Events) (unhook func()) {
+	return lo.Batch(
+		events.BlockAccepted.Hook(func(block *Block) {
+			s.timesToAcceptance.Add(block.IssuingTime(), block.AcceptanceTime())
+		}).Unhook,
+		events.BlockConfirmed.Hook(func(block *Block) {
+			s.timesToConfirmation.Add(block.IssuingTime(), block.ConfirmationTime())
+		}).Unhook,
+	)
+}
+
+// TimeToAcceptance returns the percentiles of the time that passed between issuing and accepting the recent Blocks.
+func (s *Stats) TimeToAcceptance() (timeToAcceptance *TimeToFinality) {
+	return s.timesToAcceptance.TimeToFinality()
+}
+
+// TimeToConfirmation returns the percentiles of the time that passed between issuing and confirming the recent Blocks.
+func (s *Stats) TimeToConfirmation() (timeToConfirmation *TimeToFinality) {
+	return s.timesToConfirmation.TimeToFinality()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TimeToFinality ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// TimeToFinality contains the percentiles of the time to finality of a window of Blocks.
+type TimeToFinality struct {
+	// Samples contains the number of Blocks that the percentiles were computed over.
+	Samples int
+
+	// P50 contains the median time to finality.
+	P50 time.Duration
+
+	// P90 contains the 90th percentile of the time to finality.
+	P90 time.Duration
+
+	// P99 contains the 99th percentile of the time to finality.
+	P99 time.Duration
+
+	// Max contains the longest time to finality.
+	Max time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region timeToFinalityWindow /////////////////////////////////////////////////////////////////////////////////////////
+
+// timeToFinalityWindow is a ring buffer that contains the times to finality of the most recently finalized Blocks.
+type timeToFinalityWindow struct {
+	samples []time.Duration
+	next    int
+	full    bool
+	mutex   sync.RWMutex
+}
+
+// newTimeToFinalityWindow creates a new timeToFinalityWindow with the given size.
+func newTimeToFinalityWindow(size int) *timeToFinalityWindow {
+	if size < 1 {
+		size = 1
+	}
+
+	return &timeToFinalityWindow{
+		samples: make([]time.Duration, size),
+	}
+}
+
+// Add adds the time that passed between the given issuing and finalization time to the window (replacing the oldest
+// sample if the window is full). Blocks without a finalization time (e.g. root blocks) are ignored.
+func (t *timeToFinalityWindow) Add(issuingTime, finalizationTime time.Time) {
+	if finalizationTime.IsZero() {
+		return
+	}
+
+	timeToFinality := finalizationTime.Sub(issuingTime)
+	if timeToFinality < 0 {
+		timeToFinality = 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.samples[t.next] = timeToFinality
+	if t.next = (t.next + 1) % len(t.samples); t.next == 0 {
+		t.full = true
+	}
+}
+
+// TimeToFinality computes the percentiles of the samples in the window.
+func (t *timeToFinalityWindow) TimeToFinality() (timeToFinality *TimeToFinality) {
+	t.mutex.RLock()
+	sorted := make([]time.Duration, t.size())
+	copy(sorted, t.samples[:len(sorted)])
+	t.mutex.RUnlock()
+
+	timeToFinality = &TimeToFinality{Samples: len(sorted)}
+	if len(sorted) == 0 {
+		return timeToFinality
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	timeToFinality.P50 = percentile(sorted, 50)
+	timeToFinality.P90 = percentile(sorted, 90)
+	timeToFinality.P99 = percentile(sorted, 99)
+	timeToFinality.Max = sorted[len(sorted)-1]
+
+	return timeToFinality
+}
+
+// size returns the number of samples in the window.
+func (t *timeToFinalityWindow) size() int {
+	if t.full {
+		return len(t.samples)
+	}
+
+	return t.next
+}
+
+// percentile returns the given percentile of the sorted samples (using the nearest-rank method).
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+
+	return sorted[rank-1]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
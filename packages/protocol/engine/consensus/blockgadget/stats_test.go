@@ -0,0 +1,45 @@
+package blockgadget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeToFinalityWindow(t *testing.T) {
+	window := newTimeToFinalityWindow(100)
+	require.Equal(t, &TimeToFinality{}, window.TimeToFinality())
+
+	issuingTime := time.Unix(0, 0)
+	for i := 1; i <= 100; i++ {
+		window.Add(issuingTime, issuingTime.Add(time.Duration(i)*time.Second))
+	}
+
+	require.Equal(t, &TimeToFinality{
+		Samples: 100,
+		P50:     50 * time.Second,
+		P90:     90 * time.Second,
+		P99:     99 * time.Second,
+		Max:     100 * time.Second,
+	}, window.TimeToFinality())
+
+	// blocks that were not finalized by the gadget are ignored and the oldest samples are replaced once the window is full
+	window.Add(issuingTime, time.Time{})
+	for i := 0; i < 50; i++ {
+		window.Add(issuingTime, issuingTime.Add(time.Second))
+	}
+
+	require.Equal(t, &TimeToFinality{
+		Samples: 100,
+		P50:     time.Second,
+		P90:     90 * time.Second,
+		P99:     99 * time.Second,
+		Max:     100 * time.Second,
+	}, window.TimeToFinality())
+
+	// blocks with an issuing time in the future have a time to finality of zero
+	futureWindow := newTimeToFinalityWindow(1)
+	futureWindow.Add(issuingTime.Add(time.Hour), issuingTime)
+	require.Equal(t, &TimeToFinality{Samples: 1}, futureWindow.TimeToFinality())
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/runtime/debug"
+	"github.com/iotaledger/hive.go/runtime/options"
 )
 
 // region TestFramework //////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -34,14 +35,17 @@ type TestFramework struct {
 	BlockDAG      *blockdag.TestFramework
 	Votes         *votes.TestFramework
 
+	// WeightInjector is used to inject synthetic approval weights (it is only set if the gadget uses it as its Booker).
+	WeightInjector *WeightInjector
+
 	acceptedBlocks    uint32
 	confirmedBlocks   uint32
 	conflictsAccepted uint32
 	conflictsRejected uint32
 }
 
-func NewTestFramework(test *testing.T, gadget Gadget, tangleTF *tangle.TestFramework) *TestFramework {
-	t := &TestFramework{
+func NewTestFramework(test *testing.T, gadget Gadget, tangleTF *tangle.TestFramework, opts ...options.Option[TestFramework]) *TestFramework {
+	t := options.Apply(&TestFramework{
 		test:          test,
 		Gadget:        gadget,
 		Tangle:        tangleTF,
@@ -49,7 +53,7 @@ func NewTestFramework(test *testing.T, gadget Gadget, tangleTF *tangle.TestFrame
 		MemPool:       tangleTF.MemPool,
 		BlockDAG:      tangleTF.BlockDAG,
 		Votes:         tangleTF.Votes,
-	}
+	}, opts)
 
 	t.setupEvents()
 	return t
@@ -88,6 +92,23 @@ func (t *TestFramework) setupEvents() {
 	})
 }
 
+// InjectMarkerWeight injects the approval weight of the marker of the given block (which needs to be a marker block).
+func (t *TestFramework) InjectMarkerWeight(blockAlias string, weight int64) {
+	require.NotNil(t.test, t.WeightInjector, "the gadget does not use a WeightInjector")
+
+	structureDetails := t.Tangle.Booker.Block(blockAlias).StructureDetails()
+	require.True(t.test, structureDetails.IsPastMarker(), "Block %s is not a marker block", blockAlias)
+
+	t.WeightInjector.SetMarkerWeight(structureDetails.PastMarkers().Marker(), weight)
+}
+
+// InjectConflictWeight injects the approval weight of the conflict that is created by the given transaction.
+func (t *TestFramework) InjectConflictWeight(txAlias string, weight int64) {
+	require.NotNil(t.test, t.WeightInjector, "the gadget does not use a WeightInjector")
+
+	t.WeightInjector.SetConflictWeight(t.MemPool.Transaction(txAlias).ID(), weight)
+}
+
 func (t *TestFramework) AssertBlockAccepted(blocksAccepted uint32) {
 	require.Equal(t.test, blocksAccepted, atomic.LoadUint32(&t.acceptedBlocks), "expected %d blocks to be accepted but got %d", blocksAccepted, atomic.LoadUint32(&t.acceptedBlocks))
 }
@@ -136,11 +157,20 @@ func (t *TestFramework) ValidateConflictAcceptance(expectedConflictIDs map[strin
 
 // region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// WithWeightInjector sets the WeightInjector that is used by the gadget to inject synthetic approval weights.
+func WithWeightInjector(weightInjector *WeightInjector) options.Option[TestFramework] {
+	return func(t *TestFramework) {
+		t.WeightInjector = weightInjector
+	}
+}
+
 // MockBlockGadget mocks a block gadget marking all blocks as confirmed.
 type MockBlockGadget struct {
 	events          *Events
 	AcceptedBlocks  models.BlockIDs
 	AcceptedMarkers *markers.Markers
+	stats           *Stats
+	weightHistory   *ConflictWeightHistory
 
 	mutex sync.RWMutex
 
@@ -154,7 +184,10 @@ func NewMockAcceptanceGadget() *MockBlockGadget {
 		events:          NewEvents(),
 		AcceptedBlocks:  models.NewBlockIDs(),
 		AcceptedMarkers: markers.NewMarkers(),
+		stats:           NewStats(DefaultStatsWindowSize),
+		weightHistory:   NewConflictWeightHistory(DefaultConflictWeightHistorySize),
 	}
+	g.stats.Track(g.events)
 	g.TriggerConstructed()
 	g.TriggerInitialized()
 	return g
@@ -164,6 +197,14 @@ func (m *MockBlockGadget) Events() *Events {
 	return m.events
 }
 
+func (m *MockBlockGadget) Stats() *Stats {
+	return m.stats
+}
+
+func (m *MockBlockGadget) ConflictWeightHistory() *ConflictWeightHistory {
+	return m.weightHistory
+}
+
 func (m *MockBlockGadget) IsBlockConfirmed(blockID models.BlockID) bool {
 	// If a block is accepted, then it is automatically confirmed
 	return m.IsBlockAccepted(blockID)
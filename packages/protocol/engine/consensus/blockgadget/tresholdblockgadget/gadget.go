@@ -19,9 +19,11 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/hive.go/core/causalorder"
 	"github.com/iotaledger/hive.go/core/memstorage"
 	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/ds/walker"
 	"github.com/iotaledger/hive.go/lo"
@@ -34,6 +36,9 @@ import (
 
 type Gadget struct {
 	events *blockgadget.Events
+	stats  *blockgadget.Stats
+
+	conflictWeightHistory *blockgadget.ConflictWeightHistory
 
 	booker   booker.Booker
 	blockDAG blockdag.BlockDAG
@@ -58,6 +63,11 @@ type Gadget struct {
 	confirmationOrder               *causalorder.CausalOrder[models.BlockID, *blockgadget.Block]
 
 	optsConflictAcceptanceThreshold float64
+	optsWeakParentPropagation       bool
+	optsConfirmationDowngrade       bool
+	optsStatsWindowSize             int
+	optsConflictWeightHistorySize   int
+	optsUpgrades                    *upgrades.Schedule
 
 	module.Module
 }
@@ -90,8 +100,17 @@ func New(workers *workerpool.Group, booker booker.Booker, blockDAG blockdag.Bloc
 		optsMarkerAcceptanceThreshold:   0.67,
 		optsMarkerConfirmationThreshold: 0.67,
 		optsConflictAcceptanceThreshold: 0.67,
+		optsWeakParentPropagation:       true,
+		optsStatsWindowSize:             blockgadget.DefaultStatsWindowSize,
+		optsConflictWeightHistorySize:   blockgadget.DefaultConflictWeightHistorySize,
 	}, opts,
 		func(g *Gadget) {
+			g.stats = blockgadget.NewStats(g.optsStatsWindowSize)
+			g.stats.Track(g.events)
+
+			g.conflictWeightHistory = blockgadget.NewConflictWeightHistory(g.optsConflictWeightHistorySize)
+			g.conflictWeightHistory.Track(g.booker, g.memPool.ConflictDAG())
+
 			wp := g.workers.CreatePool("Gadget", 2)
 
 			g.booker.Events().VirtualVoting.SequenceTracker.VotersUpdated.Hook(func(evt *sequencetracker.VoterUpdatedEvent) {
@@ -127,6 +146,12 @@ func (g *Gadget) Initialize(slotTimeProvider *slot.TimeProvider, validators *syb
 
 	g.evictionState.Events.SlotEvicted.Hook(g.EvictUntil, event.WithWorkerPool(g.workers.CreatePool("Eviction", 1)))
 
+	if g.optsConfirmationDowngrade {
+		validators.Weights.Events.WeightsUpdated.Hook(func(*sybilprotection.WeightsBatch) {
+			g.RefreshConfirmation()
+		}, event.WithWorkerPool(g.workers.CreatePool("ConfirmationRefresh", 1)))
+	}
+
 	g.TriggerInitialized()
 }
 
@@ -134,6 +159,16 @@ func (g *Gadget) Events() *blockgadget.Events {
 	return g.events
 }
 
+// Stats returns the rolling time to finality statistics of the blocks that were accepted and confirmed.
+func (g *Gadget) Stats() *blockgadget.Stats {
+	return g.stats
+}
+
+// ConflictWeightHistory returns the recorded approval weights of the active conflicts.
+func (g *Gadget) ConflictWeightHistory() *blockgadget.ConflictWeightHistory {
+	return g.conflictWeightHistory
+}
+
 // IsMarkerAccepted returns whether the given marker is accepted.
 func (g *Gadget) IsMarkerAccepted(marker markers.Marker) (accepted bool) {
 	g.evictionMutex.RLock()
@@ -253,17 +288,67 @@ func (g *Gadget) RefreshSequence(sequenceID markers.SequenceID, newMaxSupportedI
 	}
 }
 
+// RefreshConfirmation re-evaluates the confirmation of the markers that are not final yet (their blocks were not
+// evicted) against the current weights of the validators. Markers whose approval weight dropped below the confirmation
+// threshold are unconfirmed together with the blocks that are no longer in the past cone of a confirmed marker, while
+// accepted markers that (again) reached the confirmation threshold are confirmed.
+func (g *Gadget) RefreshConfirmation() {
+	g.evictionMutex.Lock()
+
+	var confirmedBlocks, unconfirmedBlocks []*blockgadget.Block
+	var confirmationDowngraded bool
+
+	totalWeight := g.totalWeightCallback()
+
+	for _, sequenceID := range g.lastAcceptedMarker.Keys() {
+		lastConfirmedIndex, _ := g.lastConfirmedMarker.Get(sequenceID)
+		if confirmedIndex := g.confirmedIndex(totalWeight, sequenceID, lastConfirmedIndex); confirmedIndex < lastConfirmedIndex {
+			g.setLastConfirmedIndex(sequenceID, confirmedIndex)
+			confirmationDowngraded = true
+
+			continue
+		}
+
+		lastAcceptedIndex, _ := g.lastAcceptedMarker.Get(sequenceID)
+		for markerIndex := lastConfirmedIndex + 1; markerIndex <= lastAcceptedIndex; markerIndex++ {
+			marker, markerExists := g.booker.BlockCeiling(markers.NewMarker(sequenceID, markerIndex))
+			if !markerExists {
+				break
+			}
+
+			_, blocksToConfirm := g.tryConfirmOrAccept(totalWeight, marker)
+			confirmedBlocks = append(confirmedBlocks, blocksToConfirm...)
+
+			markerIndex = marker.Index()
+		}
+	}
+
+	if confirmationDowngraded {
+		unconfirmedBlocks = g.unconfirmBlocks()
+	}
+
+	g.evictionMutex.Unlock()
+
+	for _, block := range unconfirmedBlocks {
+		g.events.BlockUnconfirmed.Trigger(block)
+	}
+	for _, block := range confirmedBlocks {
+		g.confirmationOrder.Queue(block)
+	}
+}
+
 // tryConfirmOrAccept checks if there is enough active weight to confirm blocks and then checks
 // if the marker has accumulated enough witness weight to be both accepted and confirmed.
 // Acceptance and Confirmation use the same threshold if confirmation is possible.
 // If there is not enough online weight to achieve confirmation, then acceptance condition is evaluated based on total active weight.
 func (g *Gadget) tryConfirmOrAccept(totalWeight int64, marker markers.Marker) (blocksToAccept, blocksToConfirm []*blockgadget.Block) {
 	markerTotalWeight := g.booker.VirtualVoting().MarkerVotersTotalWeight(marker)
+	acceptanceThreshold, confirmationThreshold := g.markerThresholds(marker)
 
 	// check if enough weight is online to confirm based on total weight
-	if IsThresholdReached(totalWeight, g.validators.TotalWeight(), g.optsMarkerConfirmationThreshold) {
+	if IsThresholdReached(totalWeight, g.validators.TotalWeight(), confirmationThreshold) {
 		// check if marker weight has enough weight to be confirmed
-		if IsThresholdReached(totalWeight, markerTotalWeight, g.optsMarkerConfirmationThreshold) {
+		if IsThresholdReached(totalWeight, markerTotalWeight, confirmationThreshold) {
 			// need to mark outside 'if' statement, otherwise only the first condition would be executed due to lazy evaluation
 			markerAccepted := g.setMarkerAccepted(marker)
 			markerConfirmed := g.setMarkerConfirmed(marker)
@@ -271,13 +356,23 @@ func (g *Gadget) tryConfirmOrAccept(totalWeight int64, marker markers.Marker) (b
 				return g.propagateAcceptanceConfirmation(marker, true)
 			}
 		}
-	} else if IsThresholdReached(g.validators.TotalWeight(), markerTotalWeight, g.optsMarkerAcceptanceThreshold) && g.setMarkerAccepted(marker) {
+	} else if IsThresholdReached(g.validators.TotalWeight(), markerTotalWeight, acceptanceThreshold) && g.setMarkerAccepted(marker) {
 		return g.propagateAcceptanceConfirmation(marker, false)
 	}
 
 	return
 }
 
+// markerThresholds returns the acceptance and confirmation thresholds that are active in the slot of the given marker.
+func (g *Gadget) markerThresholds(marker markers.Marker) (acceptanceThreshold, confirmationThreshold float64) {
+	markerBlock, exists := g.booker.BlockFromMarker(marker)
+	if !exists {
+		return g.optsMarkerAcceptanceThreshold, g.optsMarkerConfirmationThreshold
+	}
+
+	return g.optsUpgrades.MarkerAcceptanceThreshold(markerBlock.ID().Index(), g.optsMarkerAcceptanceThreshold), g.optsUpgrades.MarkerConfirmationThreshold(markerBlock.ID().Index(), g.optsMarkerConfirmationThreshold)
+}
+
 func (g *Gadget) EvictUntil(index slot.Index) {
 	g.acceptanceOrder.EvictUntil(index)
 	g.confirmationOrder.EvictUntil(index)
@@ -348,6 +443,10 @@ func (g *Gadget) propagateAcceptanceConfirmation(marker markers.Marker, confirme
 		// Mark weak and shallow like parents as accepted/confirmed. Acceptance is not propagated past those parents,
 		// therefore acceptance monotonicity can be broken, and that's why those blocks are marked as (weakly) accepted here.
 		// If those blocks later become accepted through their strong children, BlockAcceptance will not be triggered again.
+		if !g.optsWeakParentPropagation {
+			continue
+		}
+
 		for _, parentBlockID := range append(walkerBlock.ParentsByType(models.WeakParentType).Slice(), walkerBlock.ParentsByType(models.ShallowLikeParentType).Slice()...) {
 			parentBlock, parentExists := g.getOrRegisterBlock(parentBlockID)
 			if parentExists {
@@ -425,6 +524,86 @@ func (g *Gadget) setMarkerConfirmed(marker markers.Marker) (wasUpdated bool) {
 	return false
 }
 
+// confirmedIndex returns the highest index (up to the given last confirmed index) of the markers of the sequence that
+// are still confirmed. The markers are evaluated downwards and the evaluation stops at the first final marker.
+func (g *Gadget) confirmedIndex(totalWeight int64, sequenceID markers.SequenceID, lastConfirmedIndex markers.Index) (confirmedIndex markers.Index) {
+	for confirmedIndex = lastConfirmedIndex; confirmedIndex > 0; confirmedIndex-- {
+		marker := markers.NewMarker(sequenceID, confirmedIndex)
+
+		markerBlock, markerBlockExists := g.booker.BlockFromMarker(marker)
+		if !markerBlockExists || g.evictionState.InEvictedSlot(markerBlock.ID()) {
+			return confirmedIndex
+		}
+
+		if IsThresholdReached(totalWeight, g.booker.VirtualVoting().MarkerVotersTotalWeight(marker), g.optsUpgrades.MarkerConfirmationThreshold(markerBlock.ID().Index(), g.optsMarkerConfirmationThreshold)) {
+			return confirmedIndex
+		}
+	}
+
+	return confirmedIndex
+}
+
+func (g *Gadget) setLastConfirmedIndex(sequenceID markers.SequenceID, index markers.Index) {
+	g.lastConfirmedMarkerMutex.Lock()
+	defer g.lastConfirmedMarkerMutex.Unlock()
+
+	g.lastConfirmedMarker.Set(sequenceID, index)
+}
+
+// unconfirmBlocks unconfirms the blocks that are not final and that are not in the past cone of a confirmed marker.
+func (g *Gadget) unconfirmBlocks() (unconfirmedBlocks []*blockgadget.Block) {
+	confirmedBlockIDs := advancedset.New[models.BlockID]()
+
+	g.lastConfirmedMarker.ForEach(func(sequenceID markers.SequenceID, lastConfirmedIndex markers.Index) bool {
+		if lastConfirmedIndex == 0 {
+			return true
+		}
+
+		markerBlock, markerBlockExists := g.booker.BlockFromMarker(markers.NewMarker(sequenceID, lastConfirmedIndex))
+		if !markerBlockExists {
+			return true
+		}
+
+		block, blockExists := g.block(markerBlock.ID())
+		if !blockExists {
+			return true
+		}
+
+		for pastConeWalker := walker.New[*blockgadget.Block](false).Push(block); pastConeWalker.HasNext(); {
+			walkerBlock := pastConeWalker.Next()
+			if !confirmedBlockIDs.Add(walkerBlock.ID()) || g.evictionState.IsRootBlock(walkerBlock.ID()) {
+				continue
+			}
+
+			for parentBlockID := range walkerBlock.ParentsByType(models.StrongParentType) {
+				if parentBlock, parentExists := g.block(parentBlockID); parentExists {
+					pastConeWalker.Push(parentBlock)
+				}
+			}
+
+			if g.optsWeakParentPropagation {
+				for _, parentBlockID := range append(walkerBlock.ParentsByType(models.WeakParentType).Slice(), walkerBlock.ParentsByType(models.ShallowLikeParentType).Slice()...) {
+					confirmedBlockIDs.Add(parentBlockID)
+				}
+			}
+		}
+
+		return true
+	})
+
+	g.blocks.ForEach(func(_ slot.Index, storage *shrinkingmap.ShrinkingMap[models.BlockID, *blockgadget.Block]) {
+		storage.ForEach(func(blockID models.BlockID, block *blockgadget.Block) bool {
+			if !confirmedBlockIDs.Has(blockID) && block.SetUnconfirmed() {
+				unconfirmedBlocks = append(unconfirmedBlocks, block)
+			}
+
+			return true
+		})
+	})
+
+	return unconfirmedBlocks
+}
+
 func (g *Gadget) acceptanceFailed(block *blockgadget.Block, err error) {
 	g.events.Error.Trigger(errors.Wrapf(err, "could not mark block %s as accepted", block.ID()))
 }
@@ -521,6 +700,28 @@ func WithMarkerAcceptanceThreshold(acceptanceThreshold float64) options.Option[G
 	}
 }
 
+// WithStatsWindowSize sets the number of most recently finalized blocks that the time to finality statistics cover.
+func WithStatsWindowSize(windowSize int) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsStatsWindowSize = windowSize
+	}
+}
+
+// WithConflictWeightHistorySize sets the number of approval weight samples that are retained per conflict.
+func WithConflictWeightHistorySize(size int) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsConflictWeightHistorySize = size
+	}
+}
+
+// WithUpgrades sets the Schedule of parameter upgrades that determines the marker acceptance and confirmation
+// thresholds of the slots of the markers (the configured thresholds are used until the first upgrade activates).
+func WithUpgrades(schedule *upgrades.Schedule) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsUpgrades = schedule
+	}
+}
+
 func WithConflictAcceptanceThreshold(acceptanceThreshold float64) options.Option[Gadget] {
 	return func(gadget *Gadget) {
 		gadget.optsConflictAcceptanceThreshold = acceptanceThreshold
@@ -533,4 +734,20 @@ func WithConfirmationThreshold(confirmationThreshold float64) options.Option[Gad
 	}
 }
 
+// WithConfirmationDowngrade configures whether the confirmation of blocks that are not final yet is reverted if their
+// approval weight drops below the confirmation threshold after the weights of the validators were updated.
+func WithConfirmationDowngrade(enabled bool) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsConfirmationDowngrade = enabled
+	}
+}
+
+// WithWeakParentPropagation configures whether weak and shallow like parents of accepted (confirmed) blocks are marked
+// as weakly accepted (confirmed) without walking their past cone.
+func WithWeakParentPropagation(enabled bool) options.Option[Gadget] {
+	return func(gadget *Gadget) {
+		gadget.optsWeakParentPropagation = enabled
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
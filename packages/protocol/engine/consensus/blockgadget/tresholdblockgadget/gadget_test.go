@@ -4,11 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget/tresholdblockgadget"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker/markerbooker"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker/markerbooker/markermanager"
@@ -851,3 +854,217 @@ func TestGadget_unorphan(t *testing.T) {
 		tf.BlockDAG.AssertOrphanedCount(0)
 	}
 }
+
+func TestGadget_weakParentPropagation(t *testing.T) {
+	for _, propagateToWeakParents := range []bool{true, false} {
+		propagateToWeakParents := propagateToWeakParents
+
+		t.Run(lo.Cond(propagateToWeakParents, "enabled", "disabled"), func(t *testing.T) {
+			workers := workerpool.NewGroup(t.Name())
+
+			tf := NewDefaultTestFramework(t,
+				workers.CreateGroup("BlockGadgetTestFramework"),
+				realitiesledger.NewTestLedger(t, workers.CreateGroup("Ledger")),
+				tresholdblockgadget.WithMarkerAcceptanceThreshold(0.66),
+				tresholdblockgadget.WithConfirmationThreshold(0.66),
+				tresholdblockgadget.WithWeakParentPropagation(propagateToWeakParents),
+			)
+
+			tf.VirtualVoting.CreateIdentity("A", 30)
+			tf.VirtualVoting.CreateIdentity("B", 70)
+
+			tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()))
+			tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()))
+			tf.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()))
+			tf.BlockDAG.IssueBlocks("Block1", "Block2", "Block3")
+
+			tf.ValidateAcceptedBlocks(map[string]bool{
+				"Block1": false,
+				"Block2": false,
+				"Block3": false,
+			})
+
+			// Block4 strongly approves Block1 and weakly approves Block3, whose past cone is not approved by B.
+			tf.BlockDAG.CreateBlock("Block4", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithWeakParents(tf.BlockDAG.BlockIDs("Block3")), models.WithIssuer(tf.VirtualVoting.Identity("B").PublicKey()))
+			tf.BlockDAG.IssueBlocks("Block4")
+
+			expectedBlocks := map[string]bool{
+				"Block1": true,
+				"Block2": false,
+				"Block3": propagateToWeakParents,
+				"Block4": true,
+			}
+			tf.ValidateAcceptedBlocks(expectedBlocks)
+			tf.ValidateConfirmedBlocks(expectedBlocks)
+
+			tf.AssertBlockAccepted(lo.Cond(propagateToWeakParents, uint32(3), uint32(2)))
+			tf.AssertBlockConfirmed(lo.Cond(propagateToWeakParents, uint32(3), uint32(2)))
+		})
+	}
+}
+
+func TestGadget_confirmationDowngrade(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+
+	tf := NewDefaultTestFramework(t,
+		workers.CreateGroup("BlockGadgetTestFramework"),
+		realitiesledger.NewTestLedger(t, workers.CreateGroup("Ledger")),
+		tresholdblockgadget.WithMarkerAcceptanceThreshold(0.66),
+		tresholdblockgadget.WithConfirmationThreshold(0.66),
+		tresholdblockgadget.WithConfirmationDowngrade(true),
+	)
+
+	unconfirmedBlocks := make(map[string]bool)
+	tf.Gadget.Events().BlockUnconfirmed.Hook(func(block *blockgadget.Block) {
+		unconfirmedBlocks[block.ID().Alias()] = true
+	})
+
+	tf.VirtualVoting.CreateIdentity("A", 30)
+	tf.VirtualVoting.CreateIdentity("B", 40)
+	tf.VirtualVoting.CreateIdentity("C", 30)
+
+	tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithIssuer(tf.VirtualVoting.Identity("A").PublicKey()))
+	tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithIssuer(tf.VirtualVoting.Identity("B").PublicKey()))
+	tf.BlockDAG.IssueBlocks("Block1", "Block2")
+
+	tf.ValidateAcceptedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": false,
+	})
+	tf.ValidateConfirmedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": false,
+	})
+
+	// the weight of B drops, so that Block1 is only approved by 35 of 65 units of weight
+	weightsBatch := sybilprotection.NewWeightsBatch(1)
+	weightsBatch.Update(tf.VirtualVoting.Identity("B").ID(), -35)
+	tf.Votes.Validators.Weights.BatchUpdate(weightsBatch)
+	workers.WaitChildren()
+
+	require.Equal(t, map[string]bool{"Block1": true}, unconfirmedBlocks)
+	tf.ValidateAcceptedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": false,
+	})
+	tf.ValidateConfirmedBlocks(map[string]bool{
+		"Block1": false,
+		"Block2": false,
+	})
+	require.False(t, tf.Gadget.(*tresholdblockgadget.Gadget).IsMarkerConfirmed(markers.NewMarker(0, 1)))
+
+	// the weight of B is restored, so that Block1 is confirmed again
+	weightsBatch = sybilprotection.NewWeightsBatch(2)
+	weightsBatch.Update(tf.VirtualVoting.Identity("B").ID(), 35)
+	tf.Votes.Validators.Weights.BatchUpdate(weightsBatch)
+	workers.WaitChildren()
+
+	tf.ValidateConfirmedBlocks(map[string]bool{
+		"Block1": true,
+		"Block2": false,
+	})
+	tf.AssertBlockConfirmed(2)
+}
+
+func NewWeightInjectionTestFramework(t *testing.T, workers *workerpool.Group, memPool mempool.MemPool, optsGadget ...options.Option[tresholdblockgadget.Gadget]) *blockgadget.TestFramework {
+	tangleTF := testtangle.NewDefaultTestFramework(t, workers.CreateGroup("TangleTestFramework"),
+		memPool,
+		slot.NewTimeProvider(time.Now().Unix(), 10),
+		markerbooker.WithMarkerManagerOptions(
+			markermanager.WithSequenceManagerOptions[models.BlockID, *booker.Block](markers.WithMaxPastMarkerDistance(3)),
+		),
+	)
+
+	weightInjector := blockgadget.NewWeightInjector(tangleTF.Instance.Booker())
+
+	gadget := tresholdblockgadget.New(workers.CreateGroup("BlockGadget"),
+		weightInjector,
+		tangleTF.Instance.BlockDAG(),
+		memPool,
+		tangleTF.Instance.(*testtangle.TestTangle).EvictionState(),
+		optsGadget...,
+	)
+
+	gadget.Initialize(tangleTF.Instance.(*testtangle.TestTangle).SlotTimeProvider(), tangleTF.Votes.Validators, tangleTF.Votes.Validators.TotalWeight)
+
+	return blockgadget.NewTestFramework(t,
+		gadget,
+		tangleTF,
+		blockgadget.WithWeightInjector(weightInjector),
+	)
+}
+
+func TestGadget_injectedWeights(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+
+	tf := NewWeightInjectionTestFramework(t,
+		workers.CreateGroup("BlockGadgetTestFramework"),
+		realitiesledger.NewTestLedger(t, workers.CreateGroup("Ledger")),
+	)
+
+	// the validators only contribute to the total weight, their votes are injected instead of issuing their blocks
+	tf.VirtualVoting.CreateIdentity("A", 30)
+	tf.VirtualVoting.CreateIdentity("B", 70)
+
+	tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")))
+	tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.MemPool.CreateTransaction("Tx1", 1, "Genesis")))
+	tf.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.MemPool.CreateTransaction("Tx2", 1, "Genesis")))
+	tf.BlockDAG.CreateBlock("Block4", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")))
+	tf.BlockDAG.CreateBlock("Block5", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block4")))
+	tf.BlockDAG.IssueBlocks("Block1", "Block2", "Block3", "Block4", "Block5")
+	workers.WaitChildren()
+
+	tf.ValidateAcceptedBlocks(map[string]bool{
+		"Block1": false,
+		"Block2": false,
+		"Block3": false,
+		"Block4": false,
+		"Block5": false,
+	})
+
+	// the injected weight is not enough to accept the marker
+	{
+		tf.InjectMarkerWeight("Block4", 50)
+		workers.WaitChildren()
+
+		tf.AssertBlockAccepted(0)
+		tf.AssertBlockConfirmed(0)
+	}
+
+	// the injected weight of the marker also supports the previous markers of the sequence
+	{
+		tf.InjectMarkerWeight("Block4", 70)
+		workers.WaitChildren()
+
+		expectedFinalizedBlocks := map[string]bool{
+			"Block1": true,
+			"Block2": true,
+			"Block3": false,
+			"Block4": true,
+			"Block5": false,
+		}
+		tf.ValidateAcceptedBlocks(expectedFinalizedBlocks)
+		tf.ValidateConfirmedBlocks(expectedFinalizedBlocks)
+		tf.ValidateAcceptedMarker(map[markers.Marker]bool{
+			markers.NewMarker(0, 1): true,
+			markers.NewMarker(0, 2): true,
+			markers.NewMarker(0, 3): true,
+			markers.NewMarker(0, 4): false,
+		})
+		tf.AssertBlockAccepted(3)
+		tf.AssertBlockConfirmed(3)
+	}
+
+	// the injected weight of a conflict accepts the conflict and rejects its conflicting conflicts
+	{
+		tf.InjectConflictWeight("Tx1", 70)
+		workers.WaitChildren()
+
+		tf.ValidateConflictAcceptance(map[string]confirmation.State{
+			"Tx1": confirmation.Accepted,
+			"Tx2": confirmation.Rejected,
+		})
+		tf.AssertConflictsAccepted(1)
+		tf.AssertConflictsRejected(1)
+	}
+}
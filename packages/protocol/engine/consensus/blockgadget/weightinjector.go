@@ -0,0 +1,138 @@
+package blockgadget
+
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
+	"github.com/iotaledger/goshimmer/packages/core/votes/sequencetracker"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
+	"github.com/iotaledger/goshimmer/packages/protocol/markers"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+// region WeightInjector ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// WeightInjector is a Booker that allows tests to inject synthetic approval weights for markers and conflicts. It uses
+// the wrapped Booker to maintain the structure of the Tangle, but the gadgets see the injected weights (if they exceed
+// the weights of the actual voters), so the blocks that carry the votes of the validators don't need to be issued.
+type WeightInjector struct {
+	virtualVoting *injectedVirtualVoting
+
+	booker.Booker
+}
+
+// NewWeightInjector creates a new WeightInjector that wraps the given Booker.
+func NewWeightInjector(wrappedBooker booker.Booker) (weightInjector *WeightInjector) {
+	return &WeightInjector{
+		virtualVoting: &injectedVirtualVoting{
+			VirtualVoting:   wrappedBooker.VirtualVoting(),
+			sequenceWeights: make(map[markers.SequenceID]map[markers.Index]int64),
+			conflictWeights: make(map[utxo.TransactionID]int64),
+		},
+		Booker: wrappedBooker,
+	}
+}
+
+// VirtualVoting returns the VirtualVoting of the wrapped Booker that reports the injected weights.
+func (w *WeightInjector) VirtualVoting() booker.VirtualVoting {
+	return w.virtualVoting
+}
+
+// SetMarkerWeight injects the approval weight of the given marker. Like the votes of the validators, the weight also
+// supports all previous markers of the same sequence. The gadgets are notified about the update, so they re-evaluate
+// the acceptance and confirmation of the sequence.
+func (w *WeightInjector) SetMarkerWeight(marker markers.Marker, weight int64) {
+	previousMaxIndex := w.virtualVoting.setMarkerWeight(marker, weight)
+
+	newMaxIndex, prevMaxIndex := marker.Index(), previousMaxIndex
+	if prevMaxIndex > newMaxIndex {
+		newMaxIndex, prevMaxIndex = prevMaxIndex, newMaxIndex
+	}
+
+	w.Booker.Events().VirtualVoting.SequenceTracker.VotersUpdated.Trigger(&sequencetracker.VoterUpdatedEvent{
+		SequenceID:            marker.SequenceID(),
+		NewMaxSupportedIndex:  newMaxIndex,
+		PrevMaxSupportedIndex: prevMaxIndex,
+	})
+}
+
+// SetConflictWeight injects the approval weight of the given conflict. The gadgets are notified about the update, so
+// they re-evaluate the acceptance of the conflict.
+func (w *WeightInjector) SetConflictWeight(conflictID utxo.TransactionID, weight int64) {
+	w.virtualVoting.setConflictWeight(conflictID, weight)
+
+	w.Booker.Events().VirtualVoting.ConflictTracker.VoterAdded.Trigger(&conflicttracker.VoterEvent[utxo.TransactionID]{
+		ConflictID: conflictID,
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region injectedVirtualVoting ////////////////////////////////////////////////////////////////////////////////////////
+
+// injectedVirtualVoting is a VirtualVoting that reports the injected weights if they exceed the weights of the actual
+// voters.
+type injectedVirtualVoting struct {
+	sequenceWeights map[markers.SequenceID]map[markers.Index]int64
+	conflictWeights map[utxo.TransactionID]int64
+	mutex           sync.RWMutex
+
+	booker.VirtualVoting
+}
+
+// MarkerVotersTotalWeight returns the total weight of the voters of the given marker.
+func (i *injectedVirtualVoting) MarkerVotersTotalWeight(marker markers.Marker) (totalWeight int64) {
+	totalWeight = i.VirtualVoting.MarkerVotersTotalWeight(marker)
+
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	for index, weight := range i.sequenceWeights[marker.SequenceID()] {
+		if index >= marker.Index() {
+			totalWeight = lo.Max(totalWeight, weight)
+		}
+	}
+
+	return totalWeight
+}
+
+// ConflictVotersTotalWeight returns the total weight of the voters of the given conflict.
+func (i *injectedVirtualVoting) ConflictVotersTotalWeight(conflictID utxo.TransactionID) (totalWeight int64) {
+	totalWeight = i.VirtualVoting.ConflictVotersTotalWeight(conflictID)
+
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return lo.Max(totalWeight, i.conflictWeights[conflictID])
+}
+
+// setMarkerWeight stores the injected weight of the given marker and returns the highest marker index of the sequence
+// that has an injected weight before the update.
+func (i *injectedVirtualVoting) setMarkerWeight(marker markers.Marker, weight int64) (previousMaxIndex markers.Index) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	sequenceWeights, exists := i.sequenceWeights[marker.SequenceID()]
+	if !exists {
+		sequenceWeights = make(map[markers.Index]int64)
+		i.sequenceWeights[marker.SequenceID()] = sequenceWeights
+	}
+
+	for index := range sequenceWeights {
+		previousMaxIndex = lo.Max(previousMaxIndex, index)
+	}
+	sequenceWeights[marker.Index()] = weight
+
+	return previousMaxIndex
+}
+
+// setConflictWeight stores the injected weight of the given conflict.
+func (i *injectedVirtualVoting) setConflictWeight(conflictID utxo.TransactionID, weight int64) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.conflictWeights[conflictID] = weight
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
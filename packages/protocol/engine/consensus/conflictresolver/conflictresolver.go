@@ -3,10 +3,12 @@ package conflictresolver
 import (
 	"bytes"
 	"sort"
+	"sync"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/hive.go/ds/set"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/ds/walker"
 	"github.com/iotaledger/hive.go/lo"
 )
@@ -16,16 +18,110 @@ type WeightFunc func(conflictID utxo.TransactionID) (weight int64)
 // ConflictResolver is a generalized form of Nakamoto consensus for the parallel-reality-based ledger state where the
 // heaviest conflict according to approval weight is liked by any given node.
 type ConflictResolver struct {
+	// Events contains the Events of the ConflictResolver.
+	Events *Events
+
 	conflictDAG *conflictdag.ConflictDAG[utxo.TransactionID, utxo.OutputID]
 	weightFunc  WeightFunc
+
+	// likedConflicts contains the last announced liked member of the unresolved ConflictSets.
+	likedConflicts      *shrinkingmap.ShrinkingMap[utxo.OutputID, utxo.TransactionID]
+	likedConflictsMutex sync.Mutex
 }
 
 // New is the constructor for ConflictResolver.
 func New(conflictDAG *conflictdag.ConflictDAG[utxo.TransactionID, utxo.OutputID], weightFunc WeightFunc) *ConflictResolver {
 	return &ConflictResolver{
-		conflictDAG: conflictDAG,
-		weightFunc:  weightFunc,
+		Events:         NewEvents(),
+		conflictDAG:    conflictDAG,
+		weightFunc:     weightFunc,
+		likedConflicts: shrinkingmap.New[utxo.OutputID, utxo.TransactionID](),
+	}
+}
+
+// LikedConflict returns the member of the ConflictSet with the given ID that is currently liked by the node. The liked
+// member is the likely winner of the ConflictSet, but it can still change until it is accepted.
+func (o *ConflictResolver) LikedConflict(conflictSetID utxo.OutputID) (likedConflictID utxo.TransactionID, exists bool) {
+	conflictSet, exists := o.conflictDAG.ConflictSet(conflictSetID)
+	if !exists {
+		return utxo.EmptyTransactionID, false
+	}
+
+	return o.likedConflictSetMember(conflictSet)
+}
+
+// UpdateLikedConflicts re-evaluates the liked members of the ConflictSets that are connected to the Conflict with the
+// given ID and triggers the LikedConflictChanged event for every ConflictSet whose liked member changed.
+func (o *ConflictResolver) UpdateLikedConflicts(conflictID utxo.TransactionID) {
+	conflict, exists := o.conflictDAG.Conflict(conflictID)
+	if !exists {
+		return
+	}
+
+	// resolved ConflictSets are skipped, so that they are not announced again after they were forgotten
+	conflictSets := set.New[*conflictdag.ConflictSet[utxo.TransactionID, utxo.OutputID]]()
+	o.conflictDAG.ForEachConnectedConflictingConflictID(conflict, func(connectedConflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		for it := connectedConflict.ConflictSets().Iterator(); it.HasNext(); {
+			if conflictSet := it.Next(); hasPendingMember(conflictSet) {
+				conflictSets.Add(conflictSet)
+			}
+		}
+	})
+
+	o.likedConflictsMutex.Lock()
+	defer o.likedConflictsMutex.Unlock()
+
+	conflictSets.ForEach(func(conflictSet *conflictdag.ConflictSet[utxo.TransactionID, utxo.OutputID]) {
+		likedConflictID, _ := o.likedConflictSetMember(conflictSet)
+
+		previousLikedConflictID, _ := o.likedConflicts.Get(conflictSet.ID())
+		if likedConflictID == previousLikedConflictID {
+			return
+		}
+
+		if likedConflictID.IsEmpty() {
+			o.likedConflicts.Delete(conflictSet.ID())
+		} else {
+			o.likedConflicts.Set(conflictSet.ID(), likedConflictID)
+		}
+
+		o.Events.LikedConflictChanged.Trigger(&LikedConflictChangedEvent{
+			ConflictSetID:           conflictSet.ID(),
+			PreviousLikedConflictID: previousLikedConflictID,
+			LikedConflictID:         likedConflictID,
+		})
+	})
+}
+
+// ForgetConflictSet removes the announced liked member of the ConflictSet with the given ID (it is called once the
+// ConflictSet is resolved).
+func (o *ConflictResolver) ForgetConflictSet(conflictSetID utxo.OutputID) {
+	o.likedConflictsMutex.Lock()
+	defer o.likedConflictsMutex.Unlock()
+
+	o.likedConflicts.Delete(conflictSetID)
+}
+
+// hasPendingMember returns true if any member of the given ConflictSet is still pending.
+func hasPendingMember(conflictSet *conflictdag.ConflictSet[utxo.TransactionID, utxo.OutputID]) (hasPending bool) {
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		if it.Next().ConfirmationState().IsPending() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// likedConflictSetMember returns the member of the given ConflictSet that is in the liked reality.
+func (o *ConflictResolver) likedConflictSetMember(conflictSet *conflictdag.ConflictSet[utxo.TransactionID, utxo.OutputID]) (likedConflictID utxo.TransactionID, exists bool) {
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		if member := it.Next(); !member.ConfirmationState().IsRejected() && o.ConflictLiked(member) {
+			return member.ID(), true
+		}
 	}
+
+	return utxo.EmptyTransactionID, false
 }
 
 // likedConflictMember returns the liked ConflictID across the members of its conflict sets.
@@ -832,6 +832,58 @@ func TestOnTangleVoting_LikedInstead(t *testing.T) {
 	}
 }
 
+func TestConflictResolver_LikedConflict(t *testing.T) {
+	tf := conflictdag.NewDefaultTestFramework(t)
+
+	conflictSetID := newConflictID()
+	tf.RegisterConflictSetIDAlias("1", conflictSetID)
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+
+	weights := map[utxo.TransactionID]int64{
+		tf.ConflictID("A"): 6,
+		tf.ConflictID("B"): 3,
+	}
+	conflictResolver := New(tf.Instance, func(conflictID utxo.TransactionID) (weight int64) {
+		return weights[conflictID]
+	})
+
+	var likedConflictChangedEvents []*LikedConflictChangedEvent
+	conflictResolver.Events.LikedConflictChanged.Hook(func(event *LikedConflictChangedEvent) {
+		likedConflictChangedEvents = append(likedConflictChangedEvents, event)
+	})
+
+	likedConflictID, exists := conflictResolver.LikedConflict(conflictSetID)
+	require.True(t, exists)
+	require.Equal(t, tf.ConflictID("A"), likedConflictID)
+
+	conflictResolver.UpdateLikedConflicts(tf.ConflictID("B"))
+	require.Len(t, likedConflictChangedEvents, 1)
+	require.Equal(t, conflictSetID, likedConflictChangedEvents[0].ConflictSetID)
+	require.Equal(t, utxo.EmptyTransactionID, likedConflictChangedEvents[0].PreviousLikedConflictID)
+	require.Equal(t, tf.ConflictID("A"), likedConflictChangedEvents[0].LikedConflictID)
+
+	// unchanged opinions are not announced again
+	conflictResolver.UpdateLikedConflicts(tf.ConflictID("A"))
+	require.Len(t, likedConflictChangedEvents, 1)
+
+	weights[tf.ConflictID("B")] = 10
+	conflictResolver.UpdateLikedConflicts(tf.ConflictID("A"))
+	require.Len(t, likedConflictChangedEvents, 2)
+	require.Equal(t, tf.ConflictID("A"), likedConflictChangedEvents[1].PreviousLikedConflictID)
+	require.Equal(t, tf.ConflictID("B"), likedConflictChangedEvents[1].LikedConflictID)
+
+	// resolved ConflictSets are not announced anymore
+	tf.SetConflictAccepted("B")
+	conflictResolver.ForgetConflictSet(conflictSetID)
+	conflictResolver.UpdateLikedConflicts(tf.ConflictID("B"))
+	require.Len(t, likedConflictChangedEvents, 2)
+
+	likedConflictID, exists = conflictResolver.LikedConflict(conflictSetID)
+	require.True(t, exists)
+	require.Equal(t, tf.ConflictID("B"), likedConflictID)
+}
+
 // region test helpers /////////////////////////////////////////////////////////////////////////////////////////////////
 
 // ConflictMeta describes a conflict in a conflictDAG with its conflicts and approval weight.
@@ -0,0 +1,42 @@
+package conflictresolver
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events is a container that acts as a dictionary for the events of a ConflictResolver.
+type Events struct {
+	// LikedConflictChanged is an event that gets triggered whenever the liked member of a ConflictSet changes.
+	LikedConflictChanged *event.Event1[*LikedConflictChangedEvent]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		LikedConflictChanged: event.New1[*LikedConflictChangedEvent](),
+	}
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region LikedConflictChangedEvent ////////////////////////////////////////////////////////////////////////////////////
+
+// LikedConflictChangedEvent is a container that acts as a dictionary for the LikedConflictChanged event related
+// parameters.
+type LikedConflictChangedEvent struct {
+	// ConflictSetID contains the identifier of the ConflictSet whose liked member changed.
+	ConflictSetID utxo.OutputID
+
+	// PreviousLikedConflictID contains the previously liked member (it is empty if no member was liked before).
+	PreviousLikedConflictID utxo.TransactionID
+
+	// LikedConflictID contains the currently liked member (it is empty if no member is liked anymore).
+	LikedConflictID utxo.TransactionID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
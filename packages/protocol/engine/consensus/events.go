@@ -2,13 +2,15 @@ package consensus
 
 import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/conflictresolver"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/hive.go/runtime/event"
 )
 
 type Events struct {
-	BlockGadget *blockgadget.Events
-	SlotGadget  *slotgadget.Events
+	BlockGadget      *blockgadget.Events
+	SlotGadget       *slotgadget.Events
+	ConflictResolver *conflictresolver.Events
 
 	event.Group[Events, *Events]
 }
@@ -16,7 +18,8 @@ type Events struct {
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		BlockGadget: blockgadget.NewEvents(),
-		SlotGadget:  slotgadget.NewEvents(),
+		BlockGadget:      blockgadget.NewEvents(),
+		SlotGadget:       slotgadget.NewEvents(),
+		ConflictResolver: conflictresolver.NewEvents(),
 	}
 })
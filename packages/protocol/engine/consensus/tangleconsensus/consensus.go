@@ -2,6 +2,7 @@ package tangleconsensus
 
 import (
 	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
@@ -9,6 +10,9 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/conflictresolver"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/slotgadget"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/slotgadget/totalweightslotgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/options"
 )
 
@@ -42,6 +46,25 @@ func NewProvider(opts ...options.Option[Consensus]) module.Provider[*engine.Engi
 
 			e.HookConstructed(func() {
 				c.conflictResolver = conflictresolver.New(e.Ledger.MemPool().ConflictDAG(), e.Tangle.Booker().VirtualVoting().ConflictVotersTotalWeight)
+				c.events.ConflictResolver.LinkTo(c.conflictResolver.Events)
+
+				// a single worker keeps the LikedConflictChanged events of a ConflictSet in order
+				wp := e.Workers.CreatePool("ConflictResolver", 1)
+				e.Events.Tangle.Booker.VirtualVoting.ConflictTracker.VoterAdded.Hook(func(evt *conflicttracker.VoterEvent[utxo.TransactionID]) {
+					c.conflictResolver.UpdateLikedConflicts(evt.ConflictID)
+				}, event.WithWorkerPool(wp))
+				e.Events.Tangle.Booker.VirtualVoting.ConflictTracker.VoterRemoved.Hook(func(evt *conflicttracker.VoterEvent[utxo.TransactionID]) {
+					c.conflictResolver.UpdateLikedConflicts(evt.ConflictID)
+				}, event.WithWorkerPool(wp))
+				e.Events.Ledger.MemPool.ConflictDAG.ConflictCreated.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+					c.conflictResolver.UpdateLikedConflicts(conflict.ID())
+				}, event.WithWorkerPool(wp))
+				e.Events.Ledger.MemPool.ConflictDAG.ConflictRejected.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+					c.conflictResolver.UpdateLikedConflicts(conflict.ID())
+				}, event.WithWorkerPool(wp))
+				e.Events.Ledger.MemPool.ConflictDAG.ConflictSetResolved.Hook(func(evt *conflictdag.ConflictSetResolvedEvent[utxo.OutputID]) {
+					c.conflictResolver.ForgetConflictSet(evt.ConflictSetID)
+				}, event.WithWorkerPool(wp))
 
 				e.Events.Consensus.LinkTo(c.events)
 
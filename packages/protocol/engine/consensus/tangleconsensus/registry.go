@@ -0,0 +1,72 @@
+package tangleconsensus
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget/slotblockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget/tresholdblockgadget"
+)
+
+// DefaultBlockGadget is the name of the Gadget implementation that is used if no other implementation is selected.
+const DefaultBlockGadget = "threshold"
+
+var (
+	// blockGadgetProviders contains the registered Providers of Gadget implementations (indexed by their name).
+	blockGadgetProviders = make(map[string]module.Provider[*engine.Engine, blockgadget.Gadget])
+
+	// blockGadgetProvidersMutex is used to synchronize access to the blockGadgetProviders.
+	blockGadgetProvidersMutex sync.RWMutex
+)
+
+func init() {
+	RegisterBlockGadgetProvider(DefaultBlockGadget, tresholdblockgadget.NewProvider())
+	RegisterBlockGadgetProvider("slot", slotblockgadget.NewProvider())
+}
+
+// RegisterBlockGadgetProvider registers the Provider of a Gadget implementation under the given name (a previously
+// registered Provider with the same name is replaced).
+func RegisterBlockGadgetProvider(name string, provider module.Provider[*engine.Engine, blockgadget.Gadget]) {
+	blockGadgetProvidersMutex.Lock()
+	defer blockGadgetProvidersMutex.Unlock()
+
+	blockGadgetProviders[name] = provider
+}
+
+// BlockGadgetProvider returns the Provider of the Gadget implementation that was registered under the given name.
+func BlockGadgetProvider(name string) (provider module.Provider[*engine.Engine, blockgadget.Gadget], err error) {
+	blockGadgetProvidersMutex.RLock()
+	defer blockGadgetProvidersMutex.RUnlock()
+
+	provider, exists := blockGadgetProviders[name]
+	if !exists {
+		return nil, errors.Errorf("unknown block gadget '%s' (registered block gadgets: %s)", name, strings.Join(blockGadgetProviderNames(), ", "))
+	}
+
+	return provider, nil
+}
+
+// BlockGadgetProviderNames returns the sorted names of the registered Gadget implementations.
+func BlockGadgetProviderNames() (names []string) {
+	blockGadgetProvidersMutex.RLock()
+	defer blockGadgetProvidersMutex.RUnlock()
+
+	return blockGadgetProviderNames()
+}
+
+// blockGadgetProviderNames returns the sorted names of the registered Gadget implementations (without locking).
+func blockGadgetProviderNames() (names []string) {
+	names = make([]string, 0, len(blockGadgetProviders))
+	for name := range blockGadgetProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -1,15 +1,18 @@
 package engine
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/remotestorage"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/clock"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
@@ -56,9 +59,15 @@ type Engine struct {
 	isBootstrapped      bool
 	isBootstrappedMutex sync.Mutex
 
+	// importedManaVectors contains the ManaVectors of the imported snapshot (nil if the snapshot did not contain them).
+	importedManaVectors *ManaVectors
+
 	optsBootstrappedThreshold time.Duration
 	optsEntryPointsDepth      int
 	optsSnapshotDepth         int
+	optsSnapshotVerification  int
+	optsSnapshotSignatures    bool
+	optsSnapshotStorage       *remotestorage.Storage
 	optsTSCManagerOptions     []options.Option[tsc.Manager]
 	optsBlockRequester        []options.Option[eventticker.EventTicker[models.BlockID]]
 
@@ -87,6 +96,8 @@ func New(
 
 			optsBootstrappedThreshold: 10 * time.Second,
 			optsSnapshotDepth:         5,
+			optsSnapshotVerification:  runtime.NumCPU(),
+			optsSnapshotStorage:       remotestorage.New(),
 		}, opts, func(e *Engine) {
 			e.Ledger = ledger(e)
 			e.Clock = clockProvider(e)
@@ -195,11 +206,17 @@ func (e *Engine) Initialize(snapshot ...string) (err error) {
 	return
 }
 
+// WriteSnapshot writes a snapshot of the given slot (or the latest commitment) to the given file path (s3:// and gs://
+// URLs are streamed directly to the object storage).
 func (e *Engine) WriteSnapshot(filePath string, targetSlot ...slot.Index) (err error) {
 	if len(targetSlot) == 0 {
 		targetSlot = append(targetSlot, e.Storage.Settings.LatestCommitment().Index())
 	}
 
+	if remotestorage.IsRemoteURL(filePath) {
+		return e.uploadSnapshot(filePath, targetSlot[0])
+	}
+
 	if fileHandle, err := os.Create(filePath); err != nil {
 		return errors.Wrap(err, "failed to create snapshot file")
 	} else if err = e.Export(fileHandle, targetSlot[0]); err != nil {
@@ -211,6 +228,26 @@ func (e *Engine) WriteSnapshot(filePath string, targetSlot ...slot.Index) (err e
 	return
 }
 
+// uploadSnapshot streams a snapshot of the given slot to the object with the given URL.
+func (e *Engine) uploadSnapshot(objectURL string, targetSlot slot.Index) (err error) {
+	writer, err := e.optsSnapshotStorage.Create(context.Background(), objectURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to create snapshot object")
+	}
+
+	if err = e.Export(writer, targetSlot); err != nil {
+		if abortErr := writer.Abort(); abortErr != nil {
+			return errors.Wrapf(err, "failed to write snapshot (%s)", abortErr)
+		}
+
+		return errors.Wrap(err, "failed to write snapshot")
+	} else if err = writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to upload snapshot")
+	}
+
+	return nil
+}
+
 func (e *Engine) Import(reader io.ReadSeeker) (err error) {
 	if err = e.Storage.Settings.Import(reader); err != nil {
 		return errors.Wrap(err, "failed to import settings")
@@ -222,6 +259,8 @@ func (e *Engine) Import(reader io.ReadSeeker) (err error) {
 		return errors.Wrap(err, "failed to import eviction state")
 	} else if err = e.Notarization.Import(reader); err != nil {
 		return errors.Wrap(err, "failed to import notarization state")
+	} else if err = e.importManaVectors(reader); err != nil {
+		return errors.Wrap(err, "failed to import mana vectors")
 	}
 
 	return
@@ -238,11 +277,23 @@ func (e *Engine) Export(writer io.WriteSeeker, targetSlot slot.Index) (err error
 		return errors.Wrap(err, "failed to export eviction state")
 	} else if err = e.Notarization.Export(writer, targetSlot); err != nil {
 		return errors.Wrap(err, "failed to export notarization state")
+	} else if err = e.exportManaVectors(writer, targetSlot); err != nil {
+		return errors.Wrap(err, "failed to export mana vectors")
 	}
 
 	return
 }
 
+// Backup writes a verified copy of the storage of the engine to the given directory while no slot is committed, so that
+// the latest commitment of the backup matches the ledger state in its databases.
+func (e *Engine) Backup(targetDir string) (err error) {
+	e.Notarization.PerformLocked(func(notarization.Notarization) {
+		err = e.Storage.Backup(targetDir)
+	})
+
+	return err
+}
+
 // RemoveFromFilesystem removes the directory of the engine from the filesystem.
 func (e *Engine) RemoveFromFilesystem() error {
 	return os.RemoveAll(e.Storage.Directory)
@@ -324,7 +375,7 @@ func (e *Engine) setupBlockRequester() {
 }
 
 func (e *Engine) readSnapshot(filePath string) (err error) {
-	file, err := os.Open(filePath)
+	file, err := e.openSnapshot(filePath)
 	if err != nil {
 		return errors.Wrap(err, "failed to open snapshot file")
 	}
@@ -336,6 +387,8 @@ func (e *Engine) readSnapshot(filePath string) (err error) {
 
 	if err = e.Import(file); err != nil {
 		return errors.Wrap(err, "failed to import snapshot")
+	} else if err = e.verifySnapshot(); err != nil {
+		return errors.Wrap(err, "failed to verify snapshot")
 	} else if err = e.Storage.Settings.SetSnapshotImported(true); err != nil {
 		return errors.Wrap(err, "failed to set snapshot imported flag")
 	}
@@ -343,6 +396,24 @@ func (e *Engine) readSnapshot(filePath string) (err error) {
 	return
 }
 
+// openSnapshot opens the snapshot at the given file path (s3:// and gs:// URLs are streamed from the object storage).
+func (e *Engine) openSnapshot(filePath string) (file io.ReadSeekCloser, err error) {
+	if remotestorage.IsRemoteURL(filePath) {
+		return e.optsSnapshotStorage.Open(context.Background(), filePath)
+	}
+
+	return os.Open(filePath)
+}
+
+// verifySnapshot verifies the imported snapshot using the configured number of parallel workers (if enabled).
+func (e *Engine) verifySnapshot() (err error) {
+	if e.optsSnapshotVerification <= 0 {
+		return nil
+	}
+
+	return newSnapshotVerification(e, e.optsSnapshotVerification, e.optsSnapshotSignatures).Verify()
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -371,6 +442,28 @@ func WithSnapshotDepth(depth int) options.Option[Engine] {
 	}
 }
 
+// WithSnapshotVerification sets the number of parallel workers that verify an imported snapshot (0 disables the
+// verification).
+func WithSnapshotVerification(workerCount int) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsSnapshotVerification = workerCount
+	}
+}
+
+// WithSnapshotSignatureVerification enables the verification of the attestation signatures of an imported snapshot.
+func WithSnapshotSignatureVerification(enabled bool) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsSnapshotSignatures = enabled
+	}
+}
+
+// WithSnapshotStorage sets the Storage that is used to read and write snapshots in remote object storages.
+func WithSnapshotStorage(snapshotStorage *remotestorage.Storage) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsSnapshotStorage = snapshotStorage
+	}
+}
+
 func WithRequesterOptions(opts ...options.Option[eventticker.EventTicker[models.BlockID]]) options.Option[Engine] {
 	return func(e *Engine) {
 		e.optsBlockRequester = append(e.optsBlockRequester, opts...)
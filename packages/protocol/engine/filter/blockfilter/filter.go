@@ -6,9 +6,11 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/pow"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/runtime/event"
@@ -20,15 +22,22 @@ var (
 	ErrorsBlockTimeTooFarAheadInFuture = errors.New("a block cannot be too far ahead in the future")
 	ErrorsInvalidSignature             = errors.New("block has invalid signature")
 	ErrorsSignatureValidationFailed    = errors.New("error validating block signature")
+	ErrorsInsufficientPoW              = errors.New("block has insufficient PoW")
+	ErrorsPoWValidationFailed          = errors.New("error validating block PoW")
+	ErrorsTooManyStrongParents         = errors.New("block has too many strong parents")
 )
 
 // Filter filters blocks.
 type Filter struct {
-	events *filter.Events
+	events      *filter.Events
+	powVerifier *pow.Worker
 
 	optsMaxAllowedWallClockDrift time.Duration
 	optsMinCommittableSlotAge    slot.Index
 	optsSignatureValidation      bool
+	optsPoWDifficulty            int
+	optsWorkPolicy               models.WorkPolicy
+	optsUpgrades                 *upgrades.Schedule
 
 	module.Module
 }
@@ -55,6 +64,7 @@ var _ filter.Filter = new(Filter)
 func New(opts ...options.Option[Filter]) *Filter {
 	return options.Apply(&Filter{
 		events:                  filter.NewEvents(),
+		powVerifier:             pow.New(),
 		optsSignatureValidation: true,
 	}, opts,
 		(*Filter).TriggerConstructed,
@@ -106,9 +116,48 @@ func (f *Filter) ProcessReceivedBlock(block *models.Block, source identity.ID) {
 		}
 	}
 
+	// Verify that the block does not exceed the number of strong parents of its slot
+	if maxStrongParentsCount := f.optsUpgrades.MaxStrongParentsCount(block.ID().Index(), models.MaxParentsCount); int(block.ParentsCountByType(models.StrongParentType)) > maxStrongParentsCount {
+		f.events.BlockFiltered.Trigger(&filter.BlockFilteredEvent{
+			Block:  block,
+			Reason: errors.WithMessagef(ErrorsTooManyStrongParents, "%d strong parents vs %d allowed", block.ParentsCountByType(models.StrongParentType), maxStrongParentsCount),
+		})
+		return
+	}
+
+	if powDifficulty := f.optsUpgrades.PoWDifficulty(block.ID().Index(), f.optsPoWDifficulty); powDifficulty > 0 {
+		// Verify the block PoW (the required difficulty depends on the work of the block)
+		if err := f.verifyPoW(block, powDifficulty); err != nil {
+			f.events.BlockFiltered.Trigger(&filter.BlockFilteredEvent{
+				Block:  block,
+				Reason: err,
+			})
+			return
+		}
+	}
+
 	f.events.BlockAllowed.Trigger(block)
 }
 
+// verifyPoW checks if the PoW of the Block reaches the difficulty that is required for its work.
+func (f *Filter) verifyPoW(block *models.Block, powDifficulty int) (err error) {
+	powContent, err := block.PoWContent()
+	if err != nil {
+		return errors.WithMessagef(ErrorsPoWValidationFailed, "error: %s", err.Error())
+	}
+
+	leadingZeros, err := f.powVerifier.LeadingZerosWithNonce(powContent, block.Nonce())
+	if err != nil {
+		return errors.WithMessagef(ErrorsPoWValidationFailed, "error: %s", err.Error())
+	}
+
+	if requiredDifficulty := f.optsWorkPolicy.PoWDifficulty(block, powDifficulty); leadingZeros < requiredDifficulty {
+		return errors.WithMessagef(ErrorsInsufficientPoW, "PoW difficulty %d vs %d required", leadingZeros, requiredDifficulty)
+	}
+
+	return nil
+}
+
 // WithMinCommittableSlotAge specifies how old a slot has to be for it to be committable.
 func WithMinCommittableSlotAge(age slot.Index) options.Option[Filter] {
 	return func(filter *Filter) {
@@ -129,3 +178,27 @@ func WithSignatureValidation(validation bool) options.Option[Filter] {
 		filter.optsSignatureValidation = validation
 	}
 }
+
+// WithPoWDifficulty specifies the PoW difficulty (in leading zero bits) that is required for blocks with a single unit
+// of work (defaults to 0, which disables the PoW validation).
+func WithPoWDifficulty(difficulty int) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsPoWDifficulty = difficulty
+	}
+}
+
+// WithWorkPolicy specifies how the work of blocks is accounted when determining their required PoW difficulty
+// (defaults to CountWorkPolicy).
+func WithWorkPolicy(workPolicy models.WorkPolicy) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsWorkPolicy = workPolicy
+	}
+}
+
+// WithUpgrades specifies the Schedule of parameter upgrades that determines the PoW difficulty and the maximum number of
+// strong parents of the slots of the blocks (defaults to nil, which keeps the configured values for all slots).
+func WithUpgrades(schedule *upgrades.Schedule) options.Option[Filter] {
+	return func(filter *Filter) {
+		filter.optsUpgrades = schedule
+	}
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/pow"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/crypto/identity"
@@ -76,6 +79,45 @@ func (t *TestFramework) IssueSigned(alias string) {
 	t.processBlock(alias, block)
 }
 
+// IssueUnsignedBlockWithPoW issues a block with a data payload of the given size whose PoW has exactly the given
+// difficulty.
+func (t *TestFramework) IssueUnsignedBlockWithPoW(alias string, payloadSize int, difficulty int) {
+	block := models.NewBlock(
+		models.WithStrongParents(models.NewBlockIDs(models.EmptyBlockID)),
+		models.WithIssuingTime(time.Now()),
+		models.WithPayload(payload.NewGenericDataPayload(make([]byte, payloadSize))),
+	)
+
+	powContent, err := block.PoWContent()
+	require.NoError(t.Test, err)
+
+	for nonce := uint64(0); ; nonce++ {
+		if leadingZeros, _ := pow.New().LeadingZerosWithNonce(powContent, nonce); leadingZeros == difficulty {
+			block.SetNonce(nonce)
+			break
+		}
+	}
+
+	t.processBlock(alias, block)
+}
+
+// IssueUnsignedBlockWithStrongParents issues a block in the given slot that references the given number of (random)
+// strong parents.
+func (t *TestFramework) IssueUnsignedBlockWithStrongParents(alias string, index slot.Index, strongParentsCount int) {
+	strongParents := models.NewBlockIDs()
+	for i := 0; i < strongParentsCount; i++ {
+		var parentID models.BlockID
+		require.NoError(t.Test, parentID.FromRandomness(index-1))
+		strongParents.Add(parentID)
+	}
+
+	block := models.NewBlock(
+		models.WithStrongParents(strongParents),
+		models.WithIssuingTime(t.SlotTimeProvider.StartTime(index)),
+	)
+	t.processBlock(alias, block)
+}
+
 func TestFilter_WithMaxAllowedWallClockDrift(t *testing.T) {
 	allowedDrift := 3 * time.Second
 
@@ -154,3 +196,66 @@ func TestFilter_MinCommittableSlotAge(t *testing.T) {
 	tf.IssueUnsignedBlockAtSlot("invalid-5-5", 5, 5)
 	tf.IssueUnsignedBlockAtSlot("invalid-5-6", 5, 6)
 }
+
+func TestFilter_WithPoWDifficulty(t *testing.T) {
+	tf := NewTestFramework(t,
+		slot.NewTimeProvider(time.Now().Unix(), 10),
+		WithSignatureValidation(false),
+		WithPoWDifficulty(2),
+		WithWorkPolicy(models.SizeWorkPolicy),
+	)
+
+	tf.Filter.Events().BlockAllowed.Hook(func(block *models.Block) {
+		require.True(t, strings.HasPrefix(block.ID().Alias(), "valid"))
+	})
+
+	tf.Filter.Events().BlockFiltered.Hook(func(event *filter.BlockFilteredEvent) {
+		require.True(t, strings.HasPrefix(event.Block.ID().Alias(), "invalid"))
+		require.True(t, errors.Is(event.Reason, ErrorsInsufficientPoW))
+	})
+
+	tf.IssueUnsignedBlockWithPoW("invalid-small", 10, 1)
+	tf.IssueUnsignedBlockWithPoW("valid-small", 10, 2)
+
+	// a block with 5 units of work requires 3 additional bits of PoW
+	tf.IssueUnsignedBlockWithPoW("invalid-large", 4*models.WorkUnitSize, 4)
+	tf.IssueUnsignedBlockWithPoW("valid-large", 4*models.WorkUnitSize, 5)
+}
+
+func TestFilter_WithUpgrades(t *testing.T) {
+	slotTimeProvider := slot.NewTimeProvider(time.Now().Add(-5*time.Minute).Unix(), 10)
+	currentSlot := slotTimeProvider.IndexFromTime(time.Now())
+
+	schedule, err := upgrades.NewSchedule(
+		&upgrades.Upgrade{Slot: 10, MaxStrongParentsCount: ptr(2)},
+		&upgrades.Upgrade{Slot: currentSlot, PoWDifficulty: ptr(2)},
+		&upgrades.Upgrade{Slot: currentSlot + 100, PoWDifficulty: ptr(0)},
+	)
+	require.NoError(t, err)
+
+	tf := NewTestFramework(t,
+		slotTimeProvider,
+		WithSignatureValidation(false),
+		WithUpgrades(schedule),
+	)
+
+	tf.Filter.Events().BlockAllowed.Hook(func(block *models.Block) {
+		require.True(t, strings.HasPrefix(block.ID().Alias(), "valid"))
+	})
+
+	tf.Filter.Events().BlockFiltered.Hook(func(event *filter.BlockFilteredEvent) {
+		require.True(t, strings.HasPrefix(event.Block.ID().Alias(), "invalid"))
+	})
+
+	// the PoW difficulty only applies from the slot of the second upgrade on
+	tf.IssueUnsignedBlockWithStrongParents("valid-3-parents-before-upgrade", 9, 3)
+	tf.IssueUnsignedBlockWithStrongParents("valid-2-parents-after-upgrade", 10, 2)
+	tf.IssueUnsignedBlockWithStrongParents("invalid-3-parents-after-upgrade", 10, 3)
+
+	tf.IssueUnsignedBlockWithPoW("invalid-insufficient-pow", 10, 1)
+	tf.IssueUnsignedBlockWithPoW("valid-sufficient-pow", 10, 2)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,504 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+var (
+	// ErrSequencePruned is returned if a subscriber requests entries that were already removed from the EventJournal.
+	ErrSequencePruned = errors.New("sequence was pruned from the event journal")
+
+	// ErrSequenceUnknown is returned if a subscriber requests entries that were not written to the EventJournal, yet.
+	ErrSequenceUnknown = errors.New("sequence is unknown to the event journal")
+
+	// ErrJournalClosed is returned if the EventJournal was shut down.
+	ErrJournalClosed = errors.New("event journal was closed")
+
+	// ErrJournalUnavailable is returned if the Events that are subscribed to are not backed by an EventJournal (i.e.
+	// if they are only linked to the Events of a Ledger).
+	ErrJournalUnavailable = errors.New("events are not backed by an event journal")
+
+	// errUnsubscribed is used internally to terminate a JournalSubscription that was unsubscribed.
+	errUnsubscribed = errors.New("subscription was cancelled")
+)
+
+// region EventJournal /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EventJournal is a persistent log of the ledger events that allows late subscribers (e.g. external indexers) to replay
+// the events that they missed, even across restarts of the node.
+//
+// Appending an entry only assigns its sequence number and buffers it, so that the ledger does not wait for the storage.
+// The buffered entries are written (and the entries that exceed the retention are pruned) in batches by a background
+// worker, and are delivered to subscribers from the buffer until they are written.
+type EventJournal struct {
+	store             kvstore.KVStore
+	firstSequence     uint64
+	nextSequence      uint64
+	storedSequence    uint64
+	firstStoredEntry  uint64
+	bufferedEntries   []*JournalEntry
+	closed            bool
+	appended          *sync.Cond
+	flushRequested    chan struct{}
+	flusherTerminated chan struct{}
+	mutex             sync.Mutex
+
+	optsRetention    uint64
+	optsErrorHandler func(err error)
+}
+
+// NewEventJournal creates a new EventJournal that persists its entries in the given store.
+func NewEventJournal(store kvstore.KVStore, opts ...options.Option[EventJournal]) (newEventJournal *EventJournal) {
+	return options.Apply(&EventJournal{
+		store:             store,
+		bufferedEntries:   make([]*JournalEntry, 0),
+		flushRequested:    make(chan struct{}, 1),
+		flusherTerminated: make(chan struct{}),
+		optsErrorHandler:  func(error) {},
+	}, opts, func(e *EventJournal) {
+		e.appended = sync.NewCond(&e.mutex)
+
+		if err := e.store.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+			e.firstSequence = binary.BigEndian.Uint64(key)
+			return false
+		}); err != nil {
+			panic(errors.Wrap(err, "failed to read the first sequence of the event journal"))
+		}
+
+		if err := e.store.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+			e.nextSequence = binary.BigEndian.Uint64(key) + 1
+			return false
+		}, kvstore.IterDirectionBackward); err != nil {
+			panic(errors.Wrap(err, "failed to read the last sequence of the event journal"))
+		}
+
+		e.storedSequence = e.nextSequence
+		e.firstStoredEntry = e.firstSequence
+
+		go e.runFlusher()
+	})
+}
+
+// Append adds a new entry of the given type to the EventJournal and returns its sequence number (the entry is written
+// to the store asynchronously).
+func (e *EventJournal) Append(entryType JournalEntryType, transactionID utxo.TransactionID) (sequence uint64, err error) {
+	return e.append(&JournalEntry{Type: entryType, TransactionID: transactionID})
+}
+
+// AppendPayload adds a new entry of the given type that carries the given payload to the EventJournal and returns its
+// sequence number (the entry is written to the store asynchronously).
+func (e *EventJournal) AppendPayload(entryType JournalEntryType, payload []byte) (sequence uint64, err error) {
+	return e.append(&JournalEntry{Type: entryType, Payload: payload})
+}
+
+// Replay passes the entries starting at the given sequence number to the consumer (in order) until the consumer returns
+// false or all entries were passed that existed when the replay started.
+func (e *EventJournal) Replay(fromSequence uint64, consumer func(entry *JournalEntry) (continueReplay bool)) (err error) {
+	e.mutex.Lock()
+	firstSequence, nextSequence := e.firstSequence, e.nextSequence
+	e.mutex.Unlock()
+
+	if fromSequence < firstSequence {
+		return errors.WithMessagef(ErrSequencePruned, "first retained sequence is %d, but %d was requested", firstSequence, fromSequence)
+	}
+
+	for sequence := fromSequence; sequence < nextSequence; sequence++ {
+		entry, entryErr := e.entry(sequence)
+		if entryErr != nil {
+			return entryErr
+		}
+
+		if !consumer(entry) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// append assigns the next sequence number to the given entry and buffers it until it is written to the store.
+func (e *EventJournal) append(entry *JournalEntry) (sequence uint64, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.closed {
+		return 0, ErrJournalClosed
+	}
+
+	entry.Sequence = e.nextSequence
+	e.bufferedEntries = append(e.bufferedEntries, entry)
+	e.nextSequence++
+
+	if e.optsRetention != 0 && e.nextSequence-e.firstSequence > e.optsRetention {
+		e.firstSequence = e.nextSequence - e.optsRetention
+	}
+
+	e.appended.Broadcast()
+
+	select {
+	case e.flushRequested <- struct{}{}:
+	default:
+	}
+
+	return entry.Sequence, nil
+}
+
+// Subscribe replays the entries starting at the given sequence number to the callback and keeps delivering the new
+// entries afterwards. The entries are delivered in order by a dedicated goroutine, so that slow subscribers do not block
+// the ledger.
+func (e *EventJournal) Subscribe(fromSequence uint64, callback func(entry *JournalEntry)) (subscription *JournalSubscription, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.closed {
+		return nil, ErrJournalClosed
+	} else if fromSequence < e.firstSequence {
+		return nil, errors.WithMessagef(ErrSequencePruned, "first retained sequence is %d, but %d was requested", e.firstSequence, fromSequence)
+	} else if fromSequence > e.nextSequence {
+		return nil, errors.WithMessagef(ErrSequenceUnknown, "next sequence is %d, but %d was requested", e.nextSequence, fromSequence)
+	}
+
+	subscription = newJournalSubscription(e, fromSequence, callback)
+	go subscription.run()
+
+	return subscription, nil
+}
+
+// FirstSequence returns the sequence number of the oldest entry that is retained by the EventJournal.
+func (e *EventJournal) FirstSequence() (sequence uint64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.firstSequence
+}
+
+// NextSequence returns the sequence number that is assigned to the next entry of the EventJournal.
+func (e *EventJournal) NextSequence() (sequence uint64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.nextSequence
+}
+
+// Shutdown closes the EventJournal, writes the buffered entries and terminates all of its subscriptions.
+func (e *EventJournal) Shutdown() {
+	e.mutex.Lock()
+	if e.closed {
+		e.mutex.Unlock()
+		return
+	}
+	e.closed = true
+	e.appended.Broadcast()
+	close(e.flushRequested)
+	e.mutex.Unlock()
+
+	<-e.flusherTerminated
+}
+
+// runFlusher writes the buffered entries to the store until the EventJournal is shut down.
+func (e *EventJournal) runFlusher() {
+	defer close(e.flusherTerminated)
+
+	for range e.flushRequested {
+		if err := e.flush(); err != nil {
+			e.optsErrorHandler(err)
+		}
+	}
+
+	if err := e.flush(); err != nil {
+		e.optsErrorHandler(err)
+	}
+}
+
+// flush writes the buffered entries and prunes the entries that exceed the retention in a single batch.
+func (e *EventJournal) flush() (err error) {
+	e.mutex.Lock()
+	entries, firstSequence, firstStoredEntry, storedSequence := e.bufferedEntries, e.firstSequence, e.firstStoredEntry, e.storedSequence
+	e.mutex.Unlock()
+
+	if len(entries) == 0 && firstStoredEntry >= firstSequence {
+		return nil
+	}
+
+	batch, err := e.store.Batched()
+	if err != nil {
+		return errors.Wrap(err, "failed to create batch")
+	}
+
+	for sequence := firstStoredEntry; sequence < firstSequence && sequence < storedSequence; sequence++ {
+		if err = batch.Delete(sequenceKey(sequence)); err != nil {
+			batch.Cancel()
+			return errors.Wrapf(err, "failed to prune entry %d", sequence)
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.Sequence < firstSequence {
+			continue
+		}
+
+		if err = batch.Set(sequenceKey(entry.Sequence), entry.Bytes()); err != nil {
+			batch.Cancel()
+			return errors.Wrapf(err, "failed to store entry %d", entry.Sequence)
+		}
+	}
+
+	if err = batch.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit batch")
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.bufferedEntries = e.bufferedEntries[len(entries):]
+	e.storedSequence += uint64(len(entries))
+	if firstSequence > e.firstStoredEntry {
+		e.firstStoredEntry = firstSequence
+	}
+
+	return nil
+}
+
+// waitForEntries blocks until the EventJournal contains entries with a sequence number of at least the given one and
+// returns the sequence number of the next entry (it returns an error if the subscription should terminate).
+func (e *EventJournal) waitForEntries(subscription *JournalSubscription, sequence uint64) (nextSequence uint64, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for sequence >= e.nextSequence && !e.closed && !subscription.cancelled {
+		e.appended.Wait()
+	}
+
+	if subscription.cancelled {
+		return 0, errUnsubscribed
+	} else if e.closed {
+		return 0, ErrJournalClosed
+	} else if sequence < e.firstSequence {
+		return 0, errors.WithMessagef(ErrSequencePruned, "subscriber fell behind the retention of the event journal at sequence %d", sequence)
+	}
+
+	return e.nextSequence, nil
+}
+
+// entry returns the entry with the given sequence number from the buffer or the store.
+func (e *EventJournal) entry(sequence uint64) (entry *JournalEntry, err error) {
+	e.mutex.Lock()
+	if sequence < e.firstSequence {
+		e.mutex.Unlock()
+		return nil, errors.WithMessagef(ErrSequencePruned, "entry %d was pruned", sequence)
+	} else if sequence >= e.storedSequence {
+		entry = e.bufferedEntries[sequence-e.storedSequence]
+		e.mutex.Unlock()
+
+		return entry, nil
+	}
+	e.mutex.Unlock()
+
+	entryBytes, err := e.store.Get(sequenceKey(sequence))
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, errors.WithMessagef(ErrSequencePruned, "entry %d was pruned", sequence)
+		}
+
+		return nil, errors.Wrapf(err, "failed to load entry %d", sequence)
+	}
+
+	entry = new(JournalEntry)
+	if err = entry.FromBytes(entryBytes); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse entry %d", sequence)
+	}
+
+	return entry, nil
+}
+
+// WithRetention sets the number of entries that are retained by the EventJournal (0 retains all entries).
+func WithRetention(retention uint64) options.Option[EventJournal] {
+	return func(e *EventJournal) {
+		e.optsRetention = retention
+	}
+}
+
+// WithErrorHandler sets the function that is called if the buffered entries can not be written to the store.
+func WithErrorHandler(errorHandler func(err error)) options.Option[EventJournal] {
+	return func(e *EventJournal) {
+		e.optsErrorHandler = errorHandler
+	}
+}
+
+// sequenceKey returns the storage key of the given sequence number (big endian, so that the keys are sorted).
+func sequenceKey(sequence uint64) (key []byte) {
+	return binary.BigEndian.AppendUint64(nil, sequence)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region JournalSubscription //////////////////////////////////////////////////////////////////////////////////////////
+
+// JournalSubscription is a subscription to the entries of an EventJournal.
+type JournalSubscription struct {
+	journal      *EventJournal
+	nextSequence uint64
+	callback     func(entry *JournalEntry)
+	cancelled    bool
+	err          error
+	done         chan struct{}
+}
+
+// newJournalSubscription creates a new JournalSubscription that starts at the given sequence number.
+func newJournalSubscription(journal *EventJournal, fromSequence uint64, callback func(entry *JournalEntry)) *JournalSubscription {
+	return &JournalSubscription{
+		journal:      journal,
+		nextSequence: fromSequence,
+		callback:     callback,
+		done:         make(chan struct{}),
+	}
+}
+
+// Unsubscribe stops the delivery of entries and waits until the subscription terminated (it must not be called from
+// within the callback).
+func (s *JournalSubscription) Unsubscribe() {
+	s.journal.mutex.Lock()
+	s.cancelled = true
+	s.journal.appended.Broadcast()
+	s.journal.mutex.Unlock()
+
+	<-s.done
+}
+
+// Done returns a channel that is closed when the subscription terminated.
+func (s *JournalSubscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason why the subscription terminated (it returns nil if it was unsubscribed or is still running).
+func (s *JournalSubscription) Err() (err error) {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// run delivers the entries of the EventJournal until the subscription is terminated.
+func (s *JournalSubscription) run() {
+	defer close(s.done)
+
+	for {
+		availableSequence, err := s.journal.waitForEntries(s, s.nextSequence)
+		if err != nil {
+			if !errors.Is(err, errUnsubscribed) {
+				s.err = err
+			}
+
+			return
+		}
+
+		for ; s.nextSequence < availableSequence; s.nextSequence++ {
+			entry, entryErr := s.journal.entry(s.nextSequence)
+			if entryErr != nil {
+				s.err = entryErr
+				return
+			}
+
+			s.callback(entry)
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region JournalEntry /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// JournalEntry is a single event that was written to the EventJournal.
+type JournalEntry struct {
+	// Sequence contains the position of the entry in the EventJournal.
+	Sequence uint64
+
+	// Type contains the type of the event.
+	Type JournalEntryType
+
+	// TransactionID contains the identifier of the Transaction (or the Conflict) that the event refers to.
+	TransactionID utxo.TransactionID
+
+	// Payload contains the encoded details of the event (it is only set for the entries that are not written by the
+	// ledger).
+	Payload []byte
+}
+
+// Bytes returns a serialized version of the JournalEntry.
+func (j *JournalEntry) Bytes() (serialized []byte) {
+	serialized = append(binary.BigEndian.AppendUint64(nil, j.Sequence), byte(j.Type))
+	serialized = append(serialized, lo.PanicOnErr(j.TransactionID.Bytes())...)
+
+	return append(serialized, j.Payload...)
+}
+
+// FromBytes unmarshals the JournalEntry from a sequence of bytes.
+func (j *JournalEntry) FromBytes(serialized []byte) (err error) {
+	if len(serialized) < 9 {
+		return errors.Errorf("not enough bytes to parse entry (%d)", len(serialized))
+	}
+
+	j.Sequence = binary.BigEndian.Uint64(serialized)
+	j.Type = JournalEntryType(serialized[8])
+
+	consumedBytes, err := j.TransactionID.FromBytes(serialized[9:])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse transaction id")
+	}
+
+	if payload := serialized[9+consumedBytes:]; len(payload) != 0 {
+		j.Payload = payload
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region JournalEntryType /////////////////////////////////////////////////////////////////////////////////////////////
+
+// JournalEntryType is the type of the events that are written to the EventJournal.
+type JournalEntryType uint8
+
+const (
+	// TransactionBookedEntry is written whenever a Transaction is booked.
+	TransactionBookedEntry JournalEntryType = iota
+
+	// ConflictCreatedEntry is written whenever a new Conflict is created.
+	ConflictCreatedEntry
+
+	// TransactionAcceptedEntry is written whenever a Transaction is accepted.
+	TransactionAcceptedEntry
+
+	// ApplicationEntry is written by the components outside the ledger that use an EventJournal as their persistent
+	// log (the Payload contains their encoded event).
+	ApplicationEntry
+)
+
+// String returns a human-readable version of the JournalEntryType.
+func (j JournalEntryType) String() string {
+	switch j {
+	case TransactionBookedEntry:
+		return "TransactionBooked"
+	case ConflictCreatedEntry:
+		return "ConflictCreated"
+	case TransactionAcceptedEntry:
+		return "TransactionAccepted"
+	case ApplicationEntry:
+		return "Application"
+	default:
+		return "Unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
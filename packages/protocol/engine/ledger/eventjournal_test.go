@@ -0,0 +1,164 @@
+package ledger
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+)
+
+func TestEventJournal(t *testing.T) {
+	store := mapdb.NewMapDB()
+
+	transactionIDs := make([]utxo.TransactionID, 6)
+	for i := range transactionIDs {
+		transactionIDs[i].Identifier[0] = byte(i + 1)
+	}
+
+	journal := NewEventJournal(store)
+	for i, transactionID := range transactionIDs[:4] {
+		sequence, err := journal.Append(JournalEntryType(i%3), transactionID)
+		require.NoError(t, err)
+		require.EqualValues(t, i, sequence)
+	}
+	journal.Shutdown()
+
+	// the journal continues after a restart
+	journal = NewEventJournal(store, WithRetention(5))
+	require.EqualValues(t, 0, journal.FirstSequence())
+	require.EqualValues(t, 4, journal.NextSequence())
+
+	entries := newEntryCollector()
+	subscription, err := journal.Subscribe(1, entries.add)
+	require.NoError(t, err)
+	entries.waitFor(t, 3)
+
+	for _, transactionID := range transactionIDs[4:] {
+		_, err = journal.Append(TransactionAcceptedEntry, transactionID)
+		require.NoError(t, err)
+	}
+	entries.waitFor(t, 5)
+	subscription.Unsubscribe()
+	require.NoError(t, subscription.Err())
+
+	for i, entry := range entries.get() {
+		require.EqualValues(t, i+1, entry.Sequence)
+		require.Equal(t, transactionIDs[i+1], entry.TransactionID)
+	}
+	require.Equal(t, ConflictCreatedEntry, entries.get()[0].Type)
+	require.Equal(t, TransactionAcceptedEntry, entries.get()[4].Type)
+
+	// the oldest entry was pruned by the retention
+	require.EqualValues(t, 1, journal.FirstSequence())
+	_, err = journal.Subscribe(0, func(*JournalEntry) {})
+	require.ErrorIs(t, err, ErrSequencePruned)
+	_, err = journal.Subscribe(7, func(*JournalEntry) {})
+	require.ErrorIs(t, err, ErrSequenceUnknown)
+
+	// subscribers are terminated when the journal shuts down
+	subscription, err = journal.Subscribe(6, func(*JournalEntry) {})
+	require.NoError(t, err)
+	journal.Shutdown()
+	<-subscription.Done()
+	require.ErrorIs(t, subscription.Err(), ErrJournalClosed)
+
+	// the buffered entries were written and the pruned entry was removed from the store during the shutdown
+	has, err := store.Has(sequenceKey(0))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = store.Has(sequenceKey(5))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestEvents_Subscribe(t *testing.T) {
+	events := NewEvents()
+	_, err := events.Subscribe(0, func(*JournalEntry) {})
+	require.ErrorIs(t, err, ErrJournalUnavailable)
+
+	journal := NewEventJournal(mapdb.NewMapDB())
+	defer journal.Shutdown()
+	events.AttachJournal(journal)
+
+	entries := newEntryCollector()
+	subscription, err := events.Subscribe(0, entries.add)
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	for i := 0; i < 100; i++ {
+		_, err = journal.Append(TransactionBookedEntry, utxo.EmptyTransactionID)
+		require.NoError(t, err)
+	}
+
+	// the entries are delivered in order, regardless of whether they were already written to the store
+	entries.waitFor(t, 100)
+	for i, entry := range entries.get() {
+		require.EqualValues(t, i, entry.Sequence)
+	}
+}
+
+// entryCollector collects the entries that are delivered to a JournalSubscription.
+type entryCollector struct {
+	entries []*JournalEntry
+	mutex   sync.Mutex
+}
+
+func newEntryCollector() *entryCollector {
+	return new(entryCollector)
+}
+
+func (e *entryCollector) add(entry *JournalEntry) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.entries = append(e.entries, entry)
+}
+
+func (e *entryCollector) get() []*JournalEntry {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return append(make([]*JournalEntry, 0), e.entries...)
+}
+
+func (e *entryCollector) waitFor(t *testing.T, count int) {
+	require.Eventually(t, func() bool {
+		return len(e.get()) == count
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestEventJournal_Replay(t *testing.T) {
+	store := mapdb.NewMapDB()
+
+	journal := NewEventJournal(store, WithRetention(3))
+	for i := 0; i < 4; i++ {
+		_, err := journal.AppendPayload(ApplicationEntry, []byte{byte(i)})
+		require.NoError(t, err)
+	}
+
+	replayedPayloads := func(fromSequence uint64, limit int) (payloads [][]byte) {
+		require.NoError(t, journal.Replay(fromSequence, func(entry *JournalEntry) bool {
+			require.Equal(t, ApplicationEntry, entry.Type)
+			payloads = append(payloads, entry.Payload)
+
+			return len(payloads) < limit
+		}))
+
+		return payloads
+	}
+
+	// the entries are replayed from the buffer before and from the store after they were written
+	require.Equal(t, [][]byte{{1}, {2}}, replayedPayloads(1, 2))
+	journal.Shutdown()
+
+	journal = NewEventJournal(store, WithRetention(3))
+	defer journal.Shutdown()
+
+	require.Equal(t, [][]byte{{2}, {3}}, replayedPayloads(2, 10))
+	require.Empty(t, replayedPayloads(4, 10))
+	require.ErrorIs(t, journal.Replay(0, func(*JournalEntry) bool { return true }), ErrSequencePruned)
+}
@@ -10,9 +10,29 @@ type Events struct {
 	// MemPool contains all mempool related events.
 	MemPool *mempool.Events
 
+	// journal contains the EventJournal that allows subscribers to replay the events that they missed.
+	journal *EventJournal
+
 	event.Group[Events, *Events]
 }
 
+// Subscribe replays the journaled ledger events (booked transactions, created conflicts and accepted transactions)
+// starting at the given sequence number to the callback and keeps delivering the new events afterwards. Subscribers
+// that persist the sequence number of the last processed event can resume after a restart of the node.
+func (e *Events) Subscribe(fromSequence uint64, callback func(entry *JournalEntry)) (subscription *JournalSubscription, err error) {
+	if e.journal == nil {
+		return nil, ErrJournalUnavailable
+	}
+
+	return e.journal.Subscribe(fromSequence, callback)
+}
+
+// AttachJournal attaches the EventJournal that the Subscriptions of the Events replay (it is called by the Ledger that
+// owns the Events).
+func (e *Events) AttachJournal(journal *EventJournal) {
+	e.journal = journal
+}
+
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
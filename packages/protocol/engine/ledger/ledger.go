@@ -5,17 +5,21 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/module"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/metrics"
 	"github.com/iotaledger/hive.go/core/slot"
 )
 
 // Ledger is an engine module that provides access to the persistent ledger state.
 type Ledger interface {
-	// Events is a dictionary for Ledger related events.
+	// Events is a dictionary for Ledger related events (its Subscribe method replays the journaled events).
 	Events() *Events
 
 	// MemPool returns the MemPool implementation used by this ledger.
 	MemPool() mempool.MemPool
 
+	// Metrics returns the Metrics that are collected about the processing of Transactions.
+	Metrics() *metrics.Metrics
+
 	// UnspentOutputs returns the unspent outputs of the ledger state.
 	UnspentOutputs() UnspentOutputs
 
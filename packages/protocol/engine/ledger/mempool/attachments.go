@@ -0,0 +1,158 @@
+package mempool
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/set"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// region Attachments //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Attachments is an index of the (non-orphaned) blocks that contain a Transaction. The references are provided by the
+// tangle layer and allow the MemPool to reason about transactions that were attached multiple times.
+type Attachments struct {
+	attachments *shrinkingmap.ShrinkingMap[utxo.TransactionID, *shrinkingmap.ShrinkingMap[models.BlockID, time.Time]]
+	evictionMap *shrinkingmap.ShrinkingMap[slot.Index, set.Set[utxo.TransactionID]]
+
+	mutex *syncutils.DAGMutex[utxo.TransactionID]
+}
+
+// NewAttachments creates a new empty Attachments index.
+func NewAttachments() (newAttachments *Attachments) {
+	return &Attachments{
+		attachments: shrinkingmap.New[utxo.TransactionID, *shrinkingmap.ShrinkingMap[models.BlockID, time.Time]](),
+		evictionMap: shrinkingmap.New[slot.Index, set.Set[utxo.TransactionID]](),
+		mutex:       syncutils.NewDAGMutex[utxo.TransactionID](),
+	}
+}
+
+// Register adds the block with the given ID and issuing time as an attachment of the named Transaction. It returns true
+// if the attachment was not registered before.
+func (a *Attachments) Register(txID utxo.TransactionID, blockID models.BlockID, issuingTime time.Time) (registered bool) {
+	a.mutex.Lock(txID)
+	defer a.mutex.Unlock(txID)
+
+	attachmentsOfTx, _ := a.attachments.GetOrCreate(txID, func() *shrinkingmap.ShrinkingMap[models.BlockID, time.Time] {
+		return shrinkingmap.New[models.BlockID, time.Time]()
+	})
+
+	if _, registered = attachmentsOfTx.GetOrCreate(blockID, func() time.Time { return issuingTime }); registered {
+		txIDs, _ := a.evictionMap.GetOrCreate(blockID.SlotIndex, func() set.Set[utxo.TransactionID] {
+			return set.New[utxo.TransactionID](true)
+		})
+		txIDs.Add(txID)
+	}
+
+	return registered
+}
+
+// Unregister removes the block with the given ID from the attachments of the named Transaction (e.g. after it was
+// orphaned). It returns true if the attachment was registered before.
+func (a *Attachments) Unregister(txID utxo.TransactionID, blockID models.BlockID) (unregistered bool) {
+	a.mutex.Lock(txID)
+	defer a.mutex.Unlock(txID)
+
+	attachmentsOfTx, exists := a.attachments.Get(txID)
+	if !exists {
+		return false
+	}
+
+	if unregistered = attachmentsOfTx.Delete(blockID); unregistered && attachmentsOfTx.Size() == 0 {
+		a.attachments.Delete(txID)
+	}
+
+	return unregistered
+}
+
+// Get returns the IDs of the blocks that are registered as attachments of the named Transaction.
+func (a *Attachments) Get(txID utxo.TransactionID) (blockIDs models.BlockIDs) {
+	a.mutex.RLock(txID)
+	defer a.mutex.RUnlock(txID)
+
+	blockIDs = models.NewBlockIDs()
+	if attachmentsOfTx, exists := a.attachments.Get(txID); exists {
+		attachmentsOfTx.ForEach(func(blockID models.BlockID, _ time.Time) bool {
+			blockIDs.Add(blockID)
+			return true
+		})
+	}
+
+	return blockIDs
+}
+
+// Count returns the number of registered attachments of the named Transaction.
+func (a *Attachments) Count(txID utxo.TransactionID) (count int) {
+	a.mutex.RLock(txID)
+	defer a.mutex.RUnlock(txID)
+
+	if attachmentsOfTx, exists := a.attachments.Get(txID); exists {
+		return attachmentsOfTx.Size()
+	}
+
+	return 0
+}
+
+// EarliestAttachmentTime returns the issuing time of the earliest registered attachment of the named Transaction.
+func (a *Attachments) EarliestAttachmentTime(txID utxo.TransactionID) (earliestAttachmentTime time.Time, exists bool) {
+	a.mutex.RLock(txID)
+	defer a.mutex.RUnlock(txID)
+
+	attachmentsOfTx, exists := a.attachments.Get(txID)
+	if !exists {
+		return earliestAttachmentTime, false
+	}
+
+	attachmentsOfTx.ForEach(func(_ models.BlockID, issuingTime time.Time) bool {
+		if earliestAttachmentTime.IsZero() || issuingTime.Before(earliestAttachmentTime) {
+			earliestAttachmentTime = issuingTime
+		}
+		return true
+	})
+
+	return earliestAttachmentTime, !earliestAttachmentTime.IsZero()
+}
+
+// Delete removes all attachments of the named Transaction (e.g. after it was pruned).
+func (a *Attachments) Delete(txID utxo.TransactionID) {
+	a.mutex.Lock(txID)
+	defer a.mutex.Unlock(txID)
+
+	a.attachments.Delete(txID)
+}
+
+// Evict removes all attachments that belong to the given slot.
+func (a *Attachments) Evict(slotIndex slot.Index) {
+	txIDs, exists := a.evictionMap.Get(slotIndex)
+	if !exists {
+		return
+	}
+	a.evictionMap.Delete(slotIndex)
+
+	txIDs.ForEach(func(txID utxo.TransactionID) {
+		a.mutex.Lock(txID)
+		defer a.mutex.Unlock(txID)
+
+		attachmentsOfTx, attachmentsExist := a.attachments.Get(txID)
+		if !attachmentsExist {
+			return
+		}
+
+		attachmentsOfTx.ForEach(func(blockID models.BlockID, _ time.Time) bool {
+			if blockID.SlotIndex == slotIndex {
+				attachmentsOfTx.Delete(blockID)
+			}
+			return true
+		})
+
+		if attachmentsOfTx.Size() == 0 {
+			a.attachments.Delete(txID)
+		}
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
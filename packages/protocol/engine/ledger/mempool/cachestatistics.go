@@ -0,0 +1,65 @@
+package mempool
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// region CacheStatistics //////////////////////////////////////////////////////////////////////////////////////////////
+
+// CacheStatistics contains the number of lookups and cache hits of an object storage of the MemPool.
+type CacheStatistics struct {
+	// Lookups contains the number of lookups that were performed on the storage.
+	Lookups uint64
+
+	// Hits contains the number of lookups that were answered by the cache of the storage.
+	Hits uint64
+
+	// Size contains the number of objects that are currently held in the cache of the storage.
+	Size int
+
+	// MaxSize contains the maximum number of released objects that stay cached (0 means unlimited).
+	MaxSize int
+
+	// CacheTime contains the duration that released objects stay cached.
+	CacheTime time.Duration
+}
+
+// HitRate returns the ratio of cache hits to lookups (or 0 if no lookups were performed, yet).
+func (c CacheStatistics) HitRate() float64 {
+	if c.Lookups == 0 {
+		return 0
+	}
+
+	return float64(c.Hits) / float64(c.Lookups)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CacheStatisticsCounter ///////////////////////////////////////////////////////////////////////////////////////
+
+// CacheStatisticsCounter is a thread safe counter that can be used by Storage implementations to collect their
+// CacheStatistics.
+type CacheStatisticsCounter struct {
+	lookups atomic.Uint64
+	hits    atomic.Uint64
+}
+
+// Record records a single lookup and whether it was answered by the cache.
+func (c *CacheStatisticsCounter) Record(hit bool) {
+	c.lookups.Add(1)
+
+	if hit {
+		c.hits.Add(1)
+	}
+}
+
+// Statistics returns the CacheStatistics that were collected so far.
+func (c *CacheStatisticsCounter) Statistics() CacheStatistics {
+	return CacheStatistics{
+		Lookups: c.lookups.Load(),
+		Hits:    c.hits.Load(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
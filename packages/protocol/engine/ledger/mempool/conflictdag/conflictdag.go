@@ -3,8 +3,10 @@ package conflictdag
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/ds/set"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
@@ -19,9 +21,28 @@ type ConflictDAG[ConflictIDType, ResourceIDType comparable] struct {
 	// Events contains the Events of the ConflictDAG.
 	Events *Events[ConflictIDType, ResourceIDType]
 
+	// Utils contains the utility related API of the ConflictDAG.
+	Utils *Utils[ConflictIDType, ResourceIDType]
+
+	// Metrics contains the metrics related API of the ConflictDAG.
+	Metrics *Metrics
+
 	conflicts    *shrinkingmap.ShrinkingMap[ConflictIDType, *Conflict[ConflictIDType, ResourceIDType]]
 	conflictSets *shrinkingmap.ShrinkingMap[ResourceIDType, *ConflictSet[ConflictIDType, ResourceIDType]]
 
+	// archivedConflictSets contains the compacted versions of the resolved ConflictSets.
+	archivedConflictSets *shrinkingmap.ShrinkingMap[ResourceIDType, *ArchivedConflictSet[ConflictIDType, ResourceIDType]]
+
+	// archivedConflicts contains the final ConfirmationState of the Conflicts that were removed during compaction.
+	archivedConflicts *shrinkingmap.ShrinkingMap[ConflictIDType, confirmation.State]
+
+	// resolutionTimeouts contains the unresolved ConflictSets indexed by the slot at whose commitment their resolution
+	// times out.
+	resolutionTimeouts *shrinkingmap.ShrinkingMap[slot.Index, *advancedset.AdvancedSet[*ConflictSet[ConflictIDType, ResourceIDType]]]
+
+	// shutdown is true if the ConflictDAG was shut down (no further resolution timeouts are scheduled).
+	shutdown bool
+
 	// mutex is a mutex that prevents that two processes simultaneously update the ConflictDAG.
 	mutex *syncutils.StarvingMutex
 
@@ -32,17 +53,44 @@ type ConflictDAG[ConflictIDType, ResourceIDType comparable] struct {
 	WeightsMutex sync.RWMutex
 
 	optsMergeToMaster bool
+
+	optsCompactResolvedConflicts bool
+
+	optsConflictWeightProvider func(conflictID ConflictIDType) (weight int64)
+
+	optsConflictSlotProvider func(conflictID ConflictIDType) (index slot.Index)
+
+	optsResolutionTimeBuckets []time.Duration
+
+	optsResolutionTimeout slot.Index
+
+	optsResolutionTimeoutPolicy ResolutionTimeoutPolicy
+
+	optsMaxConflictDepth int
+
+	optsStreamBufferSize int
 }
 
 // New is the constructor for the BlockDAG and creates a new BlockDAG instance.
 func New[ConflictIDType, ResourceIDType comparable](opts ...options.Option[ConflictDAG[ConflictIDType, ResourceIDType]]) (c *ConflictDAG[ConflictIDType, ResourceIDType]) {
 	return options.Apply(&ConflictDAG[ConflictIDType, ResourceIDType]{
-		Events:            NewEvents[ConflictIDType, ResourceIDType](),
-		conflicts:         shrinkingmap.New[ConflictIDType, *Conflict[ConflictIDType, ResourceIDType]](),
-		conflictSets:      shrinkingmap.New[ResourceIDType, *ConflictSet[ConflictIDType, ResourceIDType]](),
-		mutex:             syncutils.NewStarvingMutex(),
-		optsMergeToMaster: true,
-	}, opts)
+		Events:               NewEvents[ConflictIDType, ResourceIDType](),
+		conflicts:            shrinkingmap.New[ConflictIDType, *Conflict[ConflictIDType, ResourceIDType]](),
+		conflictSets:         shrinkingmap.New[ResourceIDType, *ConflictSet[ConflictIDType, ResourceIDType]](),
+		archivedConflictSets: shrinkingmap.New[ResourceIDType, *ArchivedConflictSet[ConflictIDType, ResourceIDType]](),
+		archivedConflicts:    shrinkingmap.New[ConflictIDType, confirmation.State](),
+		resolutionTimeouts:   shrinkingmap.New[slot.Index, *advancedset.AdvancedSet[*ConflictSet[ConflictIDType, ResourceIDType]]](),
+		mutex:                syncutils.NewStarvingMutex(),
+		optsMergeToMaster:    true,
+		optsStreamBufferSize: 1024,
+		optsResolutionTimeBuckets: []time.Duration{
+			time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+			time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 30 * time.Minute,
+		},
+	}, opts, func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.Utils = newUtils(c)
+		c.Metrics = newMetrics(c.optsResolutionTimeBuckets)
+	})
 }
 
 func (c *ConflictDAG[ConflictIDType, ResourceIDType]) Conflict(conflictID ConflictIDType) (conflict *Conflict[ConflictIDType, ResourceIDType], exists bool) {
@@ -59,6 +107,14 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) ConflictSet(resourceID Res
 	return c.conflictSets.Get(resourceID)
 }
 
+// ArchivedConflictSet returns the compacted version of the resolved ConflictSet with the given resource ID.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) ArchivedConflictSet(resourceID ResourceIDType) (archivedConflictSet *ArchivedConflictSet[ConflictIDType, ResourceIDType], exists bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.archivedConflictSets.Get(resourceID)
+}
+
 // CreateConflict creates a new Conflict in the ConflictDAG and returns true if the Conflict was created.
 func (c *ConflictDAG[ConflictIDType, ResourceIDType]) CreateConflict(id ConflictIDType, parentIDs *advancedset.AdvancedSet[ConflictIDType], conflictingResourceIDs *advancedset.AdvancedSet[ResourceIDType], confirmationState confirmation.State) (created bool) {
 	c.mutex.Lock()
@@ -75,8 +131,15 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) CreateConflict(id Conflict
 		conflictParents.Add(parent)
 	}
 
+	// compacted parents and resources have to be checked before GetOrCreate locks the conflicts map.
+	archivedParentOrResourceRejected := c.anyArchivedParentRejected(parentIDs) || c.anyArchivedConflictSetAccepted(conflictingResourceIDs)
+
+	depth := conflictDepth(conflictParents)
+	depthExceeded := c.optsMaxConflictDepth > 0 && depth > c.optsMaxConflictDepth
+
 	conflict, created := c.conflicts.GetOrCreate(id, func() (newConflict *Conflict[ConflictIDType, ResourceIDType]) {
 		newConflict = NewConflict(id, parentIDs, advancedset.New[*ConflictSet[ConflictIDType, ResourceIDType]](), confirmationState)
+		newConflict.setDepth(depth)
 
 		c.registerConflictWithConflictSet(newConflict, conflictingResourceIDs)
 
@@ -85,7 +148,7 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) CreateConflict(id Conflict
 			it.Next().addChild(newConflict)
 		}
 
-		if c.anyParentRejected(conflictParents) || c.anyConflictingConflictAccepted(newConflict) {
+		if depthExceeded || archivedParentOrResourceRejected || c.anyParentRejected(conflictParents) || c.anyConflictingConflictAccepted(newConflict) {
 			newConflict.setConfirmationState(confirmation.Rejected)
 		}
 
@@ -93,7 +156,25 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) CreateConflict(id Conflict
 	})
 
 	if created {
+		c.Metrics.conflictCreated(time.Now())
+
 		c.Events.ConflictCreated.Trigger(conflict)
+
+		if depthExceeded {
+			c.Events.ConflictDepthExceeded.Trigger(&ConflictDepthExceededEvent[ConflictIDType]{
+				ConflictID: id,
+				Depth:      depth,
+				MaxDepth:   c.optsMaxConflictDepth,
+			})
+		}
+
+		if conflict.ConfirmationState().IsRejected() {
+			c.Events.ConflictRejected.Trigger(conflict)
+
+			c.registerResolvedConflicts(advancedset.New(conflict))
+		} else {
+			c.updateConflictSetResolution(advancedset.New(conflict), time.Now())
+		}
 	}
 
 	return created
@@ -118,14 +199,19 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) UpdateConflictParents(id C
 	parentConflictIDs.DeleteAll(removedConflictIDs)
 
 	conflict.setParents(parentConflictIDs)
+	c.Utils.resetAncestors()
 	updated = true
 
 	// create child reference in new parent
 	if addedParent, parentExists := c.conflicts.Get(addedConflictID); parentExists {
 		addedParent.addChild(conflict)
 
-		if addedParent.ConfirmationState().IsRejected() && conflict.setConfirmationState(confirmation.Rejected) {
-			c.Events.ConflictRejected.Trigger(conflict)
+		if depth := addedParent.Depth() + 1; depth > conflict.Depth() {
+			conflict.setDepth(depth)
+		}
+
+		if addedParent.ConfirmationState().IsRejected() {
+			c.registerResolvedConflicts(c.rejectConflictsWithFutureCone(advancedset.New(conflict)))
 		}
 	}
 
@@ -163,6 +249,8 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) UpdateConflictingResources
 
 	if updated {
 		c.Events.ConflictUpdated.Trigger(conflict)
+
+		c.updateConflictSetResolution(advancedset.New(conflict), time.Now())
 	}
 
 	return updated
@@ -194,7 +282,14 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) SetConflictAccepted(confli
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	return c.setConflictAccepted(conflictID)
+}
+
+// setConflictAccepted sets the ConfirmationState of the given Conflict to be Accepted and rejects its conflicting
+// conflicts (it is guarded by the mutex of the ConflictDAG).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) setConflictAccepted(conflictID ConflictIDType) (modified bool) {
 	conflictsToReject := advancedset.New[*Conflict[ConflictIDType, ResourceIDType]]()
+	acceptedConflicts := advancedset.New[*Conflict[ConflictIDType, ResourceIDType]]()
 
 	for confirmationWalker := advancedset.New(conflictID).Iterator(); confirmationWalker.HasNext(); {
 		currentConflictID := confirmationWalker.Next()
@@ -209,6 +304,7 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) SetConflictAccepted(confli
 			}
 
 			modified = true
+			acceptedConflicts.Add(conflict)
 
 			c.Events.ConflictAccepted.Trigger(conflict)
 		}
@@ -221,39 +317,21 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) SetConflictAccepted(confli
 		})
 	}
 
-	modified = c.rejectConflictsWithFutureCone(conflictsToReject) || modified
-
-	// // Delete all resolved ConflictSets (don't have a pending conflict anymore).
-	// for it := conflictSets.Iterator(); it.HasNext(); {
-	//	conflictSet := it.Next()
-	//
-	//	pendingConflicts := false
-	//	for itConflict := conflictSet.Conflicts().Iterator(); itConflict.HasNext(); {
-	//		conflict := itConflict.Next()
-	//		if conflict.ConfirmationState() == confirmation.Pending {
-	//			pendingConflicts = true
-	//			continue
-	//		}
-	//		conflict.deleteConflictSet(conflictSet)
-	//	}
-	//
-	//	if !pendingConflicts {
-	//		c.conflictSets.Delete(conflictSet.ID())
-	//	}
-	// }
-	//
-	// // Delete all resolved Conflicts that are not part of any ConflictSet anymore.
-	// for it := conflicts.Iterator(); it.HasNext(); {
-	//	conflict := it.Next()
-	//	if conflict.ConflictSets().Size() == 0 {
-	//		c.conflicts.Delete(conflict.ID())
-	//	}
-	// }
+	rejectedConflicts := c.rejectConflictsWithFutureCone(conflictsToReject)
+	modified = !rejectedConflicts.IsEmpty() || modified
+
+	acceptedConflicts.AddAll(rejectedConflicts)
+	c.registerResolvedConflicts(acceptedConflicts)
+	c.compactResolvedConflictSets(acceptedConflicts)
 
 	return modified
 }
 
-func (c *ConflictDAG[ConflictIDType, ResourceIDType]) rejectConflictsWithFutureCone(initialConflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) (modified bool) {
+// rejectConflictsWithFutureCone rejects the given Conflicts and their future cone and returns the Conflicts whose
+// ConfirmationState was modified.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) rejectConflictsWithFutureCone(initialConflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) (rejectedConflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) {
+	rejectedConflicts = advancedset.New[*Conflict[ConflictIDType, ResourceIDType]]()
+
 	rejectionWalker := walker.New[*Conflict[ConflictIDType, ResourceIDType]]().PushAll(initialConflicts.Slice()...)
 	for rejectionWalker.HasNext() {
 		conflict := rejectionWalker.Next()
@@ -261,13 +339,13 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) rejectConflictsWithFutureC
 			continue
 		}
 
-		modified = true
+		rejectedConflicts.Add(conflict)
 
 		c.Events.ConflictRejected.Trigger(conflict)
 		rejectionWalker.PushAll(conflict.Children().Slice()...)
 	}
 
-	return modified
+	return rejectedConflicts
 }
 
 // ConfirmationState returns the ConfirmationState of the given ConflictIDs.
@@ -390,6 +468,34 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) anyParentRejected(parents
 	return false
 }
 
+// anyArchivedParentRejected checks if any of the given parents was removed during compaction after being Rejected.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) anyArchivedParentRejected(parentIDs *advancedset.AdvancedSet[ConflictIDType]) (rejected bool) {
+	for it := parentIDs.Iterator(); it.HasNext(); {
+		if confirmationState, exists := c.archivedConflicts.Get(it.Next()); exists && confirmationState.IsRejected() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// anyArchivedConflictSetAccepted checks if any of the given resources belongs to an archived ConflictSet that was won by
+// an Accepted/Confirmed Conflict.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) anyArchivedConflictSetAccepted(resourceIDs *advancedset.AdvancedSet[ResourceIDType]) (accepted bool) {
+	for it := resourceIDs.Iterator(); it.HasNext(); {
+		archivedConflictSet, exists := c.archivedConflictSets.Get(it.Next())
+		if !exists {
+			continue
+		}
+
+		if winner, hasWinner := archivedConflictSet.Winner(); hasWinner && c.confirmationState(winner).IsAccepted() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // anyConflictingConflictAccepted checks if any conflicting Conflict is Accepted/Confirmed.
 func (c *ConflictDAG[ConflictIDType, ResourceIDType]) anyConflictingConflictAccepted(conflict *Conflict[ConflictIDType, ResourceIDType]) (anyAccepted bool) {
 	conflict.ForEachConflictingConflict(func(conflictingConflict *Conflict[ConflictIDType, ResourceIDType]) bool {
@@ -405,10 +511,13 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) registerConflictWithConfli
 		conflictSetID := it.Next()
 
 		conflictSet, _ := c.conflictSets.GetOrCreate(conflictSetID, func() *ConflictSet[ConflictIDType, ResourceIDType] {
+			c.Metrics.conflictSetCreated()
+
 			return NewConflictSet[ConflictIDType](conflictSetID)
 		})
 		if conflict.addConflictSet(conflictSet) {
 			conflictSet.AddConflictMember(conflict)
+			c.scheduleResolutionTimeout(conflictSet)
 			added = true
 		}
 	}
@@ -419,7 +528,11 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) registerConflictWithConfli
 // confirmationState returns the ConfirmationState of the Conflict with the given ConflictID.
 func (c *ConflictDAG[ConflictIDType, ResourceIDType]) confirmationState(conflictID ConflictIDType) (confirmationState confirmation.State) {
 	if conflict, exists := c.conflicts.Get(conflictID); exists {
-		confirmationState = conflict.ConfirmationState()
+		return conflict.ConfirmationState()
+	}
+
+	if archivedConfirmationState, exists := c.archivedConflicts.Get(conflictID); exists {
+		return archivedConfirmationState
 	}
 
 	return confirmationState
@@ -474,7 +587,11 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) HandleOrphanedConflict(con
 		return
 	}
 
-	c.rejectConflictsWithFutureCone(advancedset.New(initialConflict))
+	resolvedConflicts := c.rejectConflictsWithFutureCone(advancedset.New(initialConflict))
+	defer func() {
+		c.registerResolvedConflicts(resolvedConflicts)
+		c.compactResolvedConflictSets(resolvedConflicts)
+	}()
 
 	// iterate conflict's conflictSets. if only one conflict is pending, then mark it appropriately
 	for it := initialConflict.conflictSets.Iterator(); it.HasNext(); {
@@ -498,11 +615,148 @@ func (c *ConflictDAG[ConflictIDType, ResourceIDType]) HandleOrphanedConflict(con
 		// if pendingConflict does not belong to any pending conflict sets, mark it as NotConflicting.
 		if !nonResolvedConflictSets {
 			pendingConflict.setConfirmationState(confirmation.NotConflicting)
+			resolvedConflicts.Add(pendingConflict)
 			c.Events.ConflictNotConflicting.Trigger(pendingConflict)
 		}
 	}
 }
 
+// registerResolvedConflicts updates the Metrics and the ConflictSets of the given Conflicts whose pending
+// ConfirmationState was resolved.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) registerResolvedConflicts(resolvedConflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) {
+	if resolvedConflicts.IsEmpty() {
+		return
+	}
+
+	now := time.Now()
+	c.Metrics.conflictsResolved(now, resolvedConflicts.Size())
+	c.updateConflictSetResolution(resolvedConflicts, now)
+}
+
+// updateConflictSetResolution marks the ConflictSets of the given Conflicts as resolved once none of their members is
+// pending anymore (and as open again if a resolved ConflictSet received a new pending member).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) updateConflictSetResolution(conflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]], now time.Time) {
+	conflictSets := advancedset.New[*ConflictSet[ConflictIDType, ResourceIDType]]()
+	for it := conflicts.Iterator(); it.HasNext(); {
+		conflictSets.AddAll(it.Next().ConflictSets())
+	}
+
+	for it := conflictSets.Iterator(); it.HasNext(); {
+		conflictSet := it.Next()
+
+		resolved := !c.hasPendingConflict(conflictSet)
+		if resolved == conflictSet.resolved {
+			continue
+		}
+
+		if conflictSet.resolved = resolved; !resolved {
+			c.Metrics.conflictSetReopened()
+			c.scheduleResolutionTimeout(conflictSet)
+			continue
+		}
+
+		c.cancelResolutionTimeout(conflictSet)
+
+		c.Metrics.conflictSetResolved(now.Sub(conflictSet.CreationTime()))
+
+		c.Events.ConflictSetResolved.Trigger(&ConflictSetResolvedEvent[ResourceIDType]{
+			ConflictSetID:  conflictSet.ID(),
+			CreationTime:   conflictSet.CreationTime(),
+			ResolutionTime: now,
+		})
+	}
+}
+
+// compactResolvedConflictSets archives the ConflictSets of the given Conflicts that do not have any pending members
+// anymore and removes the members that are not part of any other ConflictSet (including their parent/child references)
+// from the ConflictDAG.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) compactResolvedConflictSets(resolvedConflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) {
+	if !c.optsCompactResolvedConflicts {
+		return
+	}
+
+	conflictSets := advancedset.New[*ConflictSet[ConflictIDType, ResourceIDType]]()
+	for it := resolvedConflicts.Iterator(); it.HasNext(); {
+		conflictSets.AddAll(it.Next().ConflictSets())
+	}
+
+	for it := conflictSets.Iterator(); it.HasNext(); {
+		if conflictSet := it.Next(); !c.hasPendingConflict(conflictSet) {
+			c.archiveConflictSet(conflictSet)
+		}
+	}
+}
+
+// archiveConflictSet replaces the given resolved ConflictSet with its compact ArchivedConflictSet.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) archiveConflictSet(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) {
+	c.cancelResolutionTimeout(conflictSet)
+
+	archivedConflictSet := NewArchivedConflictSet[ConflictIDType](conflictSet.ID(), time.Now())
+
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		member := it.Next()
+
+		var weight int64
+		if c.optsConflictWeightProvider != nil {
+			weight = c.optsConflictWeightProvider(member.ID())
+		}
+		archivedConflictSet.addMember(member.ID(), member.ConfirmationState(), weight)
+
+		if member.deleteConflictSet(conflictSet) && member.ConflictSets().IsEmpty() {
+			c.deleteConflict(member)
+		}
+	}
+
+	c.conflictSets.Delete(conflictSet.ID())
+	c.archivedConflictSets.Set(conflictSet.ID(), archivedConflictSet)
+
+	c.Events.ConflictSetArchived.Trigger(archivedConflictSet)
+}
+
+// deleteConflict removes the given resolved Conflict and its parent/child references from the ConflictDAG while
+// retaining its final ConfirmationState (Accepted Conflicts are merged into the master branch).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) deleteConflict(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+	for it := conflict.Parents().Iterator(); it.HasNext(); {
+		if parent, exists := c.conflicts.Get(it.Next()); exists {
+			parent.deleteChild(conflict)
+		}
+	}
+
+	for it := conflict.Children().Iterator(); it.HasNext(); {
+		it.Next().deleteParent(conflict.ID())
+	}
+
+	c.conflicts.Delete(conflict.ID())
+	c.archivedConflicts.Set(conflict.ID(), conflict.ConfirmationState())
+	c.Utils.resetAncestors()
+
+	if conflict.ConfirmationState().IsAccepted() {
+		c.Events.ConflictMerged.Trigger(conflict)
+	}
+}
+
+// conflictDepth returns the depth of a Conflict with the given parents.
+func conflictDepth[ConflictIDType, ResourceIDType comparable](parents *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]) (depth int) {
+	for it := parents.Iterator(); it.HasNext(); {
+		if parentDepth := it.Next().Depth(); parentDepth > depth {
+			depth = parentDepth
+		}
+	}
+
+	return depth + 1
+}
+
+// hasPendingConflict returns true if any member of the given ConflictSet is still pending.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) hasPendingConflict(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) (hasPending bool) {
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		if it.Next().ConfirmationState().IsPending() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getLastPendingConflict returns last pending Conflict from the ConflictSet or returns nil if zero or more than one pending conflicts left.
 func (c *ConflictDAG[ConflictIDType, ResourceIDType]) getLastPendingConflict(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) (pendingConflict *Conflict[ConflictIDType, ResourceIDType]) {
 	pendingConflictsCount := 0
@@ -531,4 +785,65 @@ func MergeToMaster[ConflictIDType, ResourceIDType comparable](mergeToMaster bool
 	}
 }
 
+// CompactResolvedConflicts is an Option for the ConflictDAG that replaces resolved ConflictSets with their compact
+// ArchivedConflictSet and removes the resolved Conflicts (and their parent/child references) from the ConflictDAG.
+func CompactResolvedConflicts[ConflictIDType, ResourceIDType comparable](compact bool) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsCompactResolvedConflicts = compact
+	}
+}
+
+// ConflictSlotProvider is an Option for the ConflictDAG that sets the function that is used to determine the slot of a
+// Conflict (e.g. the slot of the timestamp of its Transaction), which defines the deadline of the resolution timeout.
+func ConflictSlotProvider[ConflictIDType, ResourceIDType comparable](slotProvider func(conflictID ConflictIDType) (index slot.Index)) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsConflictSlotProvider = slotProvider
+	}
+}
+
+// ConflictWeightProvider is an Option for the ConflictDAG that sets the function that is used to determine the final
+// weights of the members of a resolved ConflictSet when it is archived.
+func ConflictWeightProvider[ConflictIDType, ResourceIDType comparable](weightProvider func(conflictID ConflictIDType) (weight int64)) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsConflictWeightProvider = weightProvider
+	}
+}
+
+// MaxConflictDepth is an Option for the ConflictDAG that limits the depth of nested Conflicts. Conflicts that would
+// exceed the given depth are created as Rejected and trigger the ConflictDepthExceeded event, which protects the node
+// from conflict spam that creates deeply nested branches (0 disables the limit).
+func MaxConflictDepth[ConflictIDType, ResourceIDType comparable](maxDepth int) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsMaxConflictDepth = maxDepth
+	}
+}
+
+// ResolutionTimeBuckets is an Option for the ConflictDAG that sets the (ascending) upper bounds of the buckets of the
+// histogram of the times from the creation to the resolution of the ConflictSets.
+func ResolutionTimeBuckets[ConflictIDType, ResourceIDType comparable](buckets ...time.Duration) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsResolutionTimeBuckets = buckets
+	}
+}
+
+// ResolutionTimeout is an Option for the ConflictDAG that forces a deterministic decision on the ConflictSets that are
+// still unresolved when the slot that lies the given number of slots after the slot of their latest member is committed
+// (0 disables the timeout). The given policy defines the decision and every forced decision triggers the
+// ConflictSetResolutionTimedOut event, which bounds the settlement time of transactions in networks that can not rely
+// on the votes of the validators alone (e.g. private networks).
+func ResolutionTimeout[ConflictIDType, ResourceIDType comparable](timeout slot.Index, policy ResolutionTimeoutPolicy) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsResolutionTimeout = timeout
+		c.optsResolutionTimeoutPolicy = policy
+	}
+}
+
+// StreamBufferSize is an Option for the ConflictDAG that sets the number of ConflictEvents that are buffered for each
+// Stream before the ConflictDAG waits for the consumer.
+func StreamBufferSize[ConflictIDType, ResourceIDType comparable](bufferSize int) options.Option[ConflictDAG[ConflictIDType, ResourceIDType]] {
+	return func(c *ConflictDAG[ConflictIDType, ResourceIDType]) {
+		c.optsStreamBufferSize = bufferSize
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
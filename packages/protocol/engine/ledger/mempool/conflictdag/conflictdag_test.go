@@ -1,9 +1,19 @@
 package conflictdag
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/lo"
 )
 
@@ -176,6 +186,50 @@ func TestConflictDAG_UpdateConflictParents(t *testing.T) {
 	})
 }
 
+func TestConflictDAG_RejectionPropagation(t *testing.T) {
+	tf := NewDefaultTestFramework(t)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("B"), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs("D"), "3")
+	tf.CreateConflict("F", tf.ConflictIDs("E"), "4")
+	tf.CreateConflict("G", tf.ConflictIDs(), "4")
+
+	// accepting a Conflict rejects all other members of its ConflictSets and their future cone
+	tf.SetConflictAccepted("A")
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Accepted,
+		"B": confirmation.Rejected,
+		"C": confirmation.Rejected,
+		"D": confirmation.Pending,
+		"E": confirmation.Pending,
+		"F": confirmation.Pending,
+		"G": confirmation.Pending,
+	})
+	require.EqualValues(t, 2, atomic.LoadInt32(&tf.conflictRejected))
+
+	// Conflicts that are created as rejected trigger the rejection event
+	tf.CreateConflict("H", tf.ConflictIDs(), "1")
+	tf.CreateConflict("I", tf.ConflictIDs("C"), "5")
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"H": confirmation.Rejected,
+		"I": confirmation.Rejected,
+	})
+	require.EqualValues(t, 4, atomic.LoadInt32(&tf.conflictRejected))
+
+	// Conflicts that are moved below a rejected Conflict are rejected together with their future cone
+	tf.UpdateConflictParents("E", "B", "D")
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"D": confirmation.Pending,
+		"E": confirmation.Rejected,
+		"F": confirmation.Rejected,
+		"G": confirmation.Pending,
+	})
+	require.EqualValues(t, 6, atomic.LoadInt32(&tf.conflictRejected))
+}
+
 func TestConflictDAG_SetNotConflicting_1(t *testing.T) {
 	tf := NewDefaultTestFramework(t)
 
@@ -338,3 +392,512 @@ func TestConflictDAG_SetNotConflicting_3(t *testing.T) {
 		"F": confirmation.Rejected,
 	})
 }
+
+func TestConflictDAG_CompactResolvedConflicts(t *testing.T) {
+	var archivedConflictSets int32
+	weights := map[string]int64{"A": 70, "B": 30, "C": 60, "D": 40}
+
+	tf := NewDefaultTestFramework(t, CompactResolvedConflicts[utxo.TransactionID, utxo.OutputID](true), ConflictWeightProvider[utxo.TransactionID, utxo.OutputID](func(conflictID utxo.TransactionID) int64 {
+		return weights[conflictID.Alias()]
+	}))
+	tf.Instance.Events.ConflictSetArchived.Hook(func(*ArchivedConflictSet[utxo.TransactionID, utxo.OutputID]) {
+		atomic.AddInt32(&archivedConflictSets, 1)
+	})
+	mergedConflicts := advancedset.New[utxo.TransactionID]()
+	tf.Instance.Events.ConflictMerged.Hook(func(conflict *Conflict[utxo.TransactionID, utxo.OutputID]) {
+		mergedConflicts.Add(conflict.ID())
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs("B"), "3")
+	tf.CreateConflict("F", tf.ConflictIDs(), "3")
+
+	tf.SetConflictAccepted("A")
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Accepted,
+		"B": confirmation.Rejected,
+		"C": confirmation.Pending,
+		"D": confirmation.Pending,
+		"E": confirmation.Rejected,
+		"F": confirmation.Pending,
+	})
+
+	// the ConflictSet 1 is resolved and its members are removed (together with their parent/child references)
+	archivedConflictSet, exists := tf.Instance.ArchivedConflictSet(tf.ConflictSetID("1"))
+	require.True(t, exists)
+	winner, hasWinner := archivedConflictSet.Winner()
+	require.True(t, hasWinner)
+	require.Equal(t, tf.ConflictID("A"), winner)
+	require.True(t, archivedConflictSet.Losers().Equal(advancedset.New(tf.ConflictID("B"))))
+	require.EqualValues(t, 70, archivedConflictSet.Weight(tf.ConflictID("A")))
+	require.EqualValues(t, 30, archivedConflictSet.Weight(tf.ConflictID("B")))
+	require.EqualValues(t, 1, atomic.LoadInt32(&archivedConflictSets))
+
+	// only the accepted member of the resolved ConflictSet is merged into the master branch
+	require.True(t, mergedConflicts.Equal(tf.ConflictIDs("A")))
+
+	for _, conflictAlias := range []string{"A", "B"} {
+		_, exists = tf.Instance.Conflict(tf.ConflictID(conflictAlias))
+		require.False(t, exists, "conflict %s should have been compacted", conflictAlias)
+	}
+	_, exists = tf.Instance.ConflictSet(tf.ConflictSetID("1"))
+	require.False(t, exists)
+	_, exists = tf.Instance.ConflictSet(tf.ConflictSetID("3"))
+	require.True(t, exists)
+
+	conflictC, exists := tf.Instance.Conflict(tf.ConflictID("C"))
+	require.True(t, exists)
+	require.True(t, conflictC.Parents().IsEmpty())
+	conflictE, exists := tf.Instance.Conflict(tf.ConflictID("E"))
+	require.True(t, exists)
+	require.True(t, conflictE.Parents().IsEmpty())
+
+	// the final ConfirmationState of compacted conflicts is retained
+	require.Equal(t, confirmation.Accepted, tf.ConfirmationState("A"))
+	require.Equal(t, confirmation.Rejected, tf.ConfirmationState("B"))
+	require.True(t, tf.UnconfirmedConflicts("A", "C").Equal(tf.ConflictIDs("C")))
+
+	// new conflicts that depend on compacted losers or double spend a resolved resource are rejected
+	tf.CreateConflict("G", tf.ConflictIDs("B"), "4")
+	tf.CreateConflict("H", tf.ConflictIDs(), "1")
+	require.Equal(t, confirmation.Rejected, tf.ConfirmationState("G"))
+	require.Equal(t, confirmation.Rejected, tf.ConfirmationState("H"))
+
+	tf.SetConflictAccepted("C")
+	require.EqualValues(t, 2, atomic.LoadInt32(&archivedConflictSets))
+
+	archivedConflictSet, exists = tf.Instance.ArchivedConflictSet(tf.ConflictSetID("2"))
+	require.True(t, exists)
+	winner, _ = archivedConflictSet.Winner()
+	require.Equal(t, tf.ConflictID("C"), winner)
+	require.True(t, archivedConflictSet.Losers().Equal(advancedset.New(tf.ConflictID("D"))))
+	require.False(t, archivedConflictSet.ResolutionTime().IsZero())
+	require.True(t, mergedConflicts.Equal(tf.ConflictIDs("A", "C")))
+}
+
+func TestConflictDAG_PendingConflicts(t *testing.T) {
+	weights := make(map[utxo.TransactionID]int64)
+	tf := NewDefaultTestFramework(t, ConflictWeightProvider[utxo.TransactionID, utxo.OutputID](func(conflictID utxo.TransactionID) (weight int64) {
+		return weights[conflictID]
+	}))
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs(), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs("C"), "3")
+	tf.CreateConflict("F", tf.ConflictIDs(), "3")
+	weights[tf.ConflictID("C")] = 10
+	weights[tf.ConflictID("D")] = 20
+
+	// resolved ConflictSets are not listed
+	tf.SetConflictAccepted("A")
+
+	pendingConflictSets, total := tf.Instance.Utils.PendingConflicts(0, 0)
+	require.Equal(t, 2, total)
+	require.Len(t, pendingConflictSets, 2)
+	require.False(t, pendingConflictSets[1].CreationTime.Before(pendingConflictSets[0].CreationTime))
+
+	conflictSetIDs := utxo.NewOutputIDs()
+	for offset := 0; offset < total; offset++ {
+		page, pageTotal := tf.Instance.Utils.PendingConflicts(offset, 1)
+		require.Equal(t, total, pageTotal)
+		require.Len(t, page, 1)
+		require.Equal(t, pendingConflictSets[offset].ID, page[0].ID)
+		conflictSetIDs.Add(page[0].ID)
+	}
+	require.True(t, conflictSetIDs.Equal(tf.ConflictSetIDs("2", "3")))
+
+	page, total := tf.Instance.Utils.PendingConflicts(5, 1)
+	require.Equal(t, 2, total)
+	require.Empty(t, page)
+
+	for _, pendingConflictSet := range pendingConflictSets {
+		if pendingConflictSet.ID != tf.ConflictSetID("2") {
+			continue
+		}
+
+		// members are ordered by their weight
+		require.Len(t, pendingConflictSet.Members, 2)
+		require.Equal(t, tf.ConflictID("D"), pendingConflictSet.Members[0].ID)
+		require.EqualValues(t, 20, pendingConflictSet.Members[0].Weight)
+		require.Equal(t, tf.ConflictID("C"), pendingConflictSet.Members[1].ID)
+		require.Equal(t, confirmation.Pending, pendingConflictSet.Members[1].ConfirmationState)
+	}
+}
+
+func TestConflictDAG_ResolutionOutcomes(t *testing.T) {
+	tf := NewDefaultTestFramework(t)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs(), "3")
+	tf.CreateConflict("F", tf.ConflictIDs(), "3")
+	tf.SetConflictAccepted("E")
+
+	outcomes, truncated := tf.Instance.Utils.ResolutionOutcomes(10, advancedset.New(tf.ConflictID("C")), advancedset.New(tf.ConflictID("B")))
+	require.False(t, truncated)
+	require.Len(t, outcomes, 4)
+
+	survivingOutcomes := 0
+	for _, outcome := range outcomes {
+		require.Len(t, outcome.Winners, 2)
+
+		winners := advancedset.New[utxo.TransactionID]()
+		for _, winner := range outcome.Winners {
+			winners.Add(winner.ConflictID)
+		}
+
+		// C only survives if it and its parent A win, which rules out B
+		require.Equal(t, winners.Has(tf.ConflictID("A")) && winners.Has(tf.ConflictID("C")), outcome.Survives[0])
+		require.Equal(t, winners.Has(tf.ConflictID("B")), outcome.Survives[1])
+		if outcome.Survives[0] {
+			survivingOutcomes++
+		}
+	}
+	require.Equal(t, 1, survivingOutcomes)
+
+	outcomes, truncated = tf.Instance.Utils.ResolutionOutcomes(3, advancedset.New(tf.ConflictID("C")))
+	require.True(t, truncated)
+	require.Len(t, outcomes, 3)
+
+	// resolved ConflictSets have a single outcome
+	outcomes, truncated = tf.Instance.Utils.ResolutionOutcomes(10, advancedset.New(tf.ConflictID("E")), advancedset.New(tf.ConflictID("F")))
+	require.False(t, truncated)
+	require.Len(t, outcomes, 1)
+	require.Empty(t, outcomes[0].Winners)
+	require.Equal(t, []bool{true, false}, outcomes[0].Survives)
+}
+
+func TestConflictDAG_ExportDOT(t *testing.T) {
+	tf := NewDefaultTestFramework(t)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.SetConflictAccepted("D")
+
+	conflictNode := func(alias string) string {
+		return dotConflictNode(fmt.Sprint(tf.ConflictID(alias)))
+	}
+	conflictSetNode := func(alias string) string {
+		return dotConflictSetNode(fmt.Sprint(tf.ConflictSetID(alias)))
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, tf.Instance.ExportDOT(&buffer))
+	dot := buffer.String()
+	require.Contains(t, dot, "digraph ConflictDAG {")
+	for _, alias := range []string{"A", "B", "C", "D"} {
+		require.Contains(t, dot, conflictNode(alias)+" [label=")
+	}
+	require.Contains(t, dot, conflictNode("C")+" -> "+conflictNode("A")+";")
+	require.Contains(t, dot, conflictNode("D")+" [label="+fmt.Sprintf("%q", fmt.Sprint(tf.ConflictID("D")))+", fillcolor=palegreen]")
+	require.Contains(t, dot, conflictNode("B")+" -> "+conflictSetNode("1")+" [style=dashed, arrowhead=none];")
+
+	// the export can be restricted to the future cone of a set of roots
+	buffer.Reset()
+	require.NoError(t, tf.Instance.ExportDOT(&buffer, tf.ConflictID("A")))
+	dot = buffer.String()
+	require.Contains(t, dot, conflictNode("A")+" [label=")
+	require.Contains(t, dot, conflictNode("C")+" -> "+conflictNode("A")+";")
+	require.Contains(t, dot, conflictSetNode("2")+" [label=")
+	require.NotContains(t, dot, conflictNode("B"))
+	require.NotContains(t, dot, conflictNode("D"))
+}
+
+func TestConflictDAG_StructuralQueries(t *testing.T) {
+	tf := NewDefaultTestFramework(t)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("D", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("E", tf.ConflictIDs("C", "B"), "3")
+	tf.CreateConflict("F", tf.ConflictIDs("D"), "3")
+
+	utils := tf.Instance.Utils
+	require.True(t, utils.IsDescendantOf(tf.ConflictID("E"), tf.ConflictID("A")))
+	require.True(t, utils.IsDescendantOf(tf.ConflictID("E"), tf.ConflictID("B")))
+	require.False(t, utils.IsDescendantOf(tf.ConflictID("A"), tf.ConflictID("E")))
+	require.False(t, utils.IsDescendantOf(tf.ConflictID("F"), tf.ConflictID("C")))
+	require.False(t, utils.IsDescendantOf(tf.ConflictID("A"), tf.ConflictID("A")))
+
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs("E", "F")).Equal(tf.ConflictIDs("A")))
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs("E", "C")).Equal(tf.ConflictIDs("C")))
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs("E")).Equal(tf.ConflictIDs("E")))
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs("F", "B")).IsEmpty())
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs()).IsEmpty())
+
+	// the memoized ancestors are updated when the parents of a Conflict change
+	tf.UpdateConflictParents("F", "C", "D")
+	require.True(t, utils.IsDescendantOf(tf.ConflictID("F"), tf.ConflictID("C")))
+	require.False(t, utils.IsDescendantOf(tf.ConflictID("F"), tf.ConflictID("D")))
+	require.True(t, utils.LowestCommonAncestors(tf.ConflictIDs("E", "F")).Equal(tf.ConflictIDs("C")))
+}
+
+func TestConflictDAG_Metrics(t *testing.T) {
+	tf := NewDefaultTestFramework(t, ResolutionTimeBuckets[utxo.TransactionID, utxo.OutputID](time.Minute))
+
+	resolvedConflictSets := utxo.NewOutputIDs()
+	tf.Instance.Events.ConflictSetResolved.Hook(func(event *ConflictSetResolvedEvent[utxo.OutputID]) {
+		require.False(t, event.ResolutionTime.Before(event.CreationTime))
+		resolvedConflictSets.Add(event.ConflictSetID)
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs(), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs("C"), "3")
+	tf.CreateConflict("F", tf.ConflictIDs(), "3")
+
+	require.Equal(t, 3, tf.Instance.Metrics.OpenConflictSets())
+	require.Equal(t, 6, tf.Instance.Metrics.ConflictsCreatedPerMinute())
+	require.Equal(t, 0, tf.Instance.Metrics.ConflictsResolvedPerMinute())
+
+	tf.SetConflictAccepted("A")
+	require.Equal(t, 2, tf.Instance.Metrics.OpenConflictSets())
+	require.Equal(t, 2, tf.Instance.Metrics.ConflictsResolvedPerMinute())
+
+	// the rejection of C propagates to E, which leaves F as the last pending member of ConflictSet 3
+	tf.SetConflictAccepted("D")
+	require.Equal(t, 1, tf.Instance.Metrics.OpenConflictSets())
+	require.Equal(t, 5, tf.Instance.Metrics.ConflictsResolvedPerMinute())
+	require.True(t, resolvedConflictSets.Equal(tf.ConflictSetIDs("1", "2")))
+
+	resolutionTimes := tf.Instance.Metrics.ResolutionTimes()
+	require.EqualValues(t, 2, resolutionTimes.Count)
+	require.Equal(t, []time.Duration{time.Minute}, resolutionTimes.Buckets)
+	require.Equal(t, []uint64{2, 0}, resolutionTimes.Counts)
+	require.Equal(t, []uint64{2}, resolutionTimes.CumulativeCounts())
+
+	tf.Instance.HandleOrphanedConflict(tf.ConflictID("F"))
+	require.Equal(t, 0, tf.Instance.Metrics.OpenConflictSets())
+	require.Equal(t, 6, tf.Instance.Metrics.ConflictsResolvedPerMinute())
+
+	// a new pending member reopens a resolved ConflictSet while a new rejected member does not
+	tf.CreateConflict("G", tf.ConflictIDs(), "3")
+	require.Equal(t, 1, tf.Instance.Metrics.OpenConflictSets())
+	tf.CreateConflict("H", tf.ConflictIDs("E"), "1")
+	require.Equal(t, 1, tf.Instance.Metrics.OpenConflictSets())
+	require.Equal(t, 7, tf.Instance.Metrics.ConflictsResolvedPerMinute())
+	require.EqualValues(t, 3, tf.Instance.Metrics.ResolutionTimes().Count)
+}
+
+func TestConflictDAG_MaxConflictDepth(t *testing.T) {
+	tf := NewDefaultTestFramework(t, MaxConflictDepth[utxo.TransactionID, utxo.OutputID](2))
+
+	var depthExceededEvents []*ConflictDepthExceededEvent[utxo.TransactionID]
+	tf.Instance.Events.ConflictDepthExceeded.Hook(func(event *ConflictDepthExceededEvent[utxo.TransactionID]) {
+		depthExceededEvents = append(depthExceededEvents, event)
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs("A"), "2")
+	tf.CreateConflict("C", tf.ConflictIDs("B"), "3")
+	tf.CreateConflict("D", tf.ConflictIDs("A"), "3")
+
+	require.Equal(t, 1, lo.Return1(tf.Instance.Conflict(tf.ConflictID("A"))).Depth())
+	require.Equal(t, 2, lo.Return1(tf.Instance.Conflict(tf.ConflictID("B"))).Depth())
+	require.Equal(t, 3, lo.Return1(tf.Instance.Conflict(tf.ConflictID("C"))).Depth())
+
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Pending,
+		"B": confirmation.Pending,
+		"C": confirmation.Rejected,
+		"D": confirmation.Pending,
+	})
+
+	require.Len(t, depthExceededEvents, 1)
+	require.Equal(t, tf.ConflictID("C"), depthExceededEvents[0].ConflictID)
+	require.Equal(t, 3, depthExceededEvents[0].Depth)
+	require.Equal(t, 2, depthExceededEvents[0].MaxDepth)
+}
+
+func TestConflictDAG_Stream(t *testing.T) {
+	tf := NewDefaultTestFramework(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := tf.Instance.Stream(ctx)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs(), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.UpdateConflictParents("D", "A")
+	tf.SetConflictAccepted("A")
+
+	expectedEvents := []struct {
+		eventType  ConflictEventType
+		conflictID string
+	}{
+		{ConflictEventCreated, "A"},
+		{ConflictEventCreated, "B"},
+		{ConflictEventCreated, "C"},
+		{ConflictEventCreated, "D"},
+		{ConflictEventParentsUpdated, "D"},
+		{ConflictEventAccepted, "A"},
+		{ConflictEventRejected, "B"},
+	}
+
+	for _, expectedEvent := range expectedEvents {
+		conflictEvent := <-stream
+		require.Equal(t, expectedEvent.eventType, conflictEvent.Type, "unexpected event for %s", expectedEvent.conflictID)
+		require.Equal(t, tf.ConflictID(expectedEvent.conflictID), conflictEvent.ConflictID)
+	}
+
+	cancel()
+
+	require.Eventually(t, func() bool {
+		_, open := <-stream
+		return !open
+	}, time.Second, time.Millisecond)
+
+	// events after the cancellation are no longer delivered, and the ConflictDAG is not blocked by the closed stream
+	tf.CreateConflict("E", tf.ConflictIDs(), "3")
+}
+
+func TestConflictDAG_StreamBackpressure(t *testing.T) {
+	tf := NewDefaultTestFramework(t, StreamBufferSize[utxo.TransactionID, utxo.OutputID](1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := tf.Instance.Stream(ctx)
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+
+	var created atomic.Bool
+	go func() {
+		tf.CreateConflict("B", tf.ConflictIDs(), "1")
+		created.Store(true)
+	}()
+
+	require.Never(t, created.Load, 100*time.Millisecond, 10*time.Millisecond)
+	require.Equal(t, ConflictEventCreated, (<-stream).Type)
+	require.Eventually(t, created.Load, time.Second, time.Millisecond)
+	require.Equal(t, tf.ConflictID("B"), (<-stream).ConflictID)
+}
+
+func TestConflictDAG_ResolutionTimeout(t *testing.T) {
+	weights := map[string]int64{"A": 70, "B": 30, "C": 50, "D": 50, "E": 10, "F": 90}
+	slots := map[string]slot.Index{"A": 1, "B": 2, "C": 1, "D": 1, "E": 1, "F": 1}
+
+	tf := NewDefaultTestFramework(t,
+		ResolutionTimeout[utxo.TransactionID, utxo.OutputID](2, AcceptHeaviestConflict),
+		ConflictSlotProvider[utxo.TransactionID, utxo.OutputID](func(conflictID utxo.TransactionID) slot.Index {
+			return slots[conflictID.Alias()]
+		}),
+		ConflictWeightProvider[utxo.TransactionID, utxo.OutputID](func(conflictID utxo.TransactionID) int64 {
+			return weights[conflictID.Alias()]
+		}),
+	)
+
+	timedOutConflictSets := make(map[utxo.OutputID]*ConflictSetResolutionTimedOutEvent[utxo.TransactionID, utxo.OutputID])
+	tf.Instance.Events.ConflictSetResolutionTimedOut.Hook(func(event *ConflictSetResolutionTimedOutEvent[utxo.TransactionID, utxo.OutputID]) {
+		require.Equal(t, AcceptHeaviestConflict, event.Policy)
+		timedOutConflictSets[event.ConflictSetID] = event
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs(), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.CreateConflict("E", tf.ConflictIDs(), "3")
+	tf.CreateConflict("F", tf.ConflictIDs(), "3")
+
+	// ConflictSets that are resolved before the timeout expires are not affected
+	tf.SetConflictAccepted("E")
+
+	tf.Instance.ResolveTimedOutConflictSets(2)
+	require.Empty(t, timedOutConflictSets)
+
+	// the deadline of a ConflictSet is derived from the slot of its latest member (B)
+	tf.Instance.ResolveTimedOutConflictSets(3)
+	require.Len(t, timedOutConflictSets, 1)
+	require.Contains(t, timedOutConflictSets, tf.ConflictSetID("2"))
+
+	tf.Instance.ResolveTimedOutConflictSets(4)
+	require.Len(t, timedOutConflictSets, 2)
+
+	// the heaviest member is accepted
+	require.True(t, timedOutConflictSets[tf.ConflictSetID("1")].HasAcceptedConflict)
+	require.Equal(t, tf.ConflictID("A"), timedOutConflictSets[tf.ConflictSetID("1")].AcceptedConflictID)
+	require.True(t, timedOutConflictSets[tf.ConflictSetID("1")].RejectedConflictIDs.Equal(tf.ConflictIDs("B")))
+
+	// members with equal weights are all rejected because there is no deterministic winner
+	require.False(t, timedOutConflictSets[tf.ConflictSetID("2")].HasAcceptedConflict)
+	require.True(t, timedOutConflictSets[tf.ConflictSetID("2")].RejectedConflictIDs.Equal(tf.ConflictIDs("C", "D")))
+
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Accepted,
+		"B": confirmation.Rejected,
+		"C": confirmation.Rejected,
+		"D": confirmation.Rejected,
+		"E": confirmation.Accepted,
+		"F": confirmation.Rejected,
+	})
+	require.Zero(t, tf.Instance.Metrics.OpenConflictSets())
+	require.Zero(t, tf.Instance.resolutionTimeouts.Size())
+}
+
+func TestConflictDAG_ResolutionTimeoutRejectAll(t *testing.T) {
+	tf := NewDefaultTestFramework(t, ResolutionTimeout[utxo.TransactionID, utxo.OutputID](1, RejectAllConflicts))
+
+	var timedOutEvents []*ConflictSetResolutionTimedOutEvent[utxo.TransactionID, utxo.OutputID]
+	tf.Instance.Events.ConflictSetResolutionTimedOut.Hook(func(event *ConflictSetResolutionTimedOutEvent[utxo.TransactionID, utxo.OutputID]) {
+		require.Equal(t, RejectAllConflicts, event.Policy)
+		require.False(t, event.HasAcceptedConflict)
+
+		timedOutEvents = append(timedOutEvents, event)
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	tf.CreateConflict("C", tf.ConflictIDs("A"), "2")
+
+	tf.Instance.ResolveTimedOutConflictSets(1)
+
+	// C is rejected by the timeout of its own ConflictSet or by the rejection of its parent (whichever comes first)
+	require.NotEmpty(t, timedOutEvents)
+	require.Equal(t, tf.ConflictSetID("1"), timedOutEvents[0].ConflictSetID)
+	require.True(t, timedOutEvents[0].RejectedConflictIDs.Equal(tf.ConflictIDs("A", "B")))
+
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Rejected,
+		"B": confirmation.Rejected,
+		"C": confirmation.Rejected,
+	})
+}
+
+func TestConflictDAG_ResolutionTimeoutShutdown(t *testing.T) {
+	tf := NewDefaultTestFramework(t, ResolutionTimeout[utxo.TransactionID, utxo.OutputID](1, RejectAllConflicts))
+
+	tf.Instance.Events.ConflictSetResolutionTimedOut.Hook(func(event *ConflictSetResolutionTimedOutEvent[utxo.TransactionID, utxo.OutputID]) {
+		require.FailNow(t, "resolution timeout expired after shutdown")
+	})
+
+	tf.CreateConflict("A", tf.ConflictIDs(), "1")
+	tf.CreateConflict("B", tf.ConflictIDs(), "1")
+	require.Equal(t, 1, tf.Instance.resolutionTimeouts.Size())
+
+	tf.Instance.Shutdown()
+	require.Zero(t, tf.Instance.resolutionTimeouts.Size())
+
+	tf.CreateConflict("C", tf.ConflictIDs(), "2")
+	tf.CreateConflict("D", tf.ConflictIDs(), "2")
+	tf.Instance.ResolveTimedOutConflictSets(10)
+
+	tf.AssertConfirmationState(map[string]confirmation.State{
+		"A": confirmation.Pending,
+		"B": confirmation.Pending,
+		"C": confirmation.Pending,
+		"D": confirmation.Pending,
+	})
+}
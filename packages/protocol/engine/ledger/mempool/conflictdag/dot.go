@@ -0,0 +1,166 @@
+package conflictdag
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/ds/walker"
+)
+
+// ExportDOT writes a graphviz (DOT) representation of the Conflicts, their parent references and the ConflictSets they
+// are part of to the given writer. If root ConflictIDs are given, the export is restricted to the Conflicts that are
+// reachable from these roots (i.e. the roots and their future cone).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) ExportDOT(w io.Writer, rootConflictIDs ...ConflictIDType) (err error) {
+	c.mutex.RLock()
+	conflicts := c.exportedConflicts(rootConflictIDs...)
+	c.mutex.RUnlock()
+
+	conflictIDs := make(map[ConflictIDType]string)
+	conflictSets := make(map[ResourceIDType]*ConflictSet[ConflictIDType, ResourceIDType])
+	for _, conflict := range conflicts {
+		conflictIDs[conflict.ID()] = fmt.Sprint(conflict.ID())
+
+		for it := conflict.ConflictSets().Iterator(); it.HasNext(); {
+			conflictSet := it.Next()
+			conflictSets[conflictSet.ID()] = conflictSet
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflictIDs[conflicts[i].ID()] < conflictIDs[conflicts[j].ID()]
+	})
+
+	conflictSetIDs := make([]string, 0, len(conflictSets))
+	conflictSetsByID := make(map[string]*ConflictSet[ConflictIDType, ResourceIDType])
+	for resourceID, conflictSet := range conflictSets {
+		conflictSetID := fmt.Sprint(resourceID)
+		conflictSetIDs = append(conflictSetIDs, conflictSetID)
+		conflictSetsByID[conflictSetID] = conflictSet
+	}
+	sort.Strings(conflictSetIDs)
+
+	buffer := bufio.NewWriter(w)
+	dotWriter := &dotWriter{writer: buffer}
+
+	dotWriter.printf("digraph ConflictDAG {\n")
+	dotWriter.printf("\trankdir=BT;\n")
+	dotWriter.printf("\tnode [shape=ellipse, style=filled];\n")
+
+	for _, conflict := range conflicts {
+		dotWriter.printf("\t%s [label=%s, fillcolor=%s];\n", dotConflictNode(conflictIDs[conflict.ID()]), strconv.Quote(conflictIDs[conflict.ID()]), dotColor(conflict.ConfirmationState()))
+	}
+
+	for _, conflict := range conflicts {
+		parentIDs := make([]string, 0)
+		for it := conflict.Parents().Iterator(); it.HasNext(); {
+			if parentID, exported := conflictIDs[it.Next()]; exported {
+				parentIDs = append(parentIDs, parentID)
+			}
+		}
+		sort.Strings(parentIDs)
+
+		for _, parentID := range parentIDs {
+			dotWriter.printf("\t%s -> %s;\n", dotConflictNode(conflictIDs[conflict.ID()]), dotConflictNode(parentID))
+		}
+	}
+
+	for _, conflictSetID := range conflictSetIDs {
+		dotWriter.printf("\t%s [label=%s, shape=box, style=dashed];\n", dotConflictSetNode(conflictSetID), strconv.Quote(conflictSetID))
+
+		memberIDs := make([]string, 0)
+		for it := conflictSetsByID[conflictSetID].Conflicts().Iterator(); it.HasNext(); {
+			if memberID, exported := conflictIDs[it.Next().ID()]; exported {
+				memberIDs = append(memberIDs, memberID)
+			}
+		}
+		sort.Strings(memberIDs)
+
+		for _, memberID := range memberIDs {
+			dotWriter.printf("\t%s -> %s [style=dashed, arrowhead=none];\n", dotConflictNode(memberID), dotConflictSetNode(conflictSetID))
+		}
+	}
+
+	dotWriter.printf("}\n")
+
+	if dotWriter.err != nil {
+		return errors.Wrap(dotWriter.err, "failed to write DOT representation")
+	}
+
+	return errors.Wrap(buffer.Flush(), "failed to flush DOT representation")
+}
+
+// exportedConflicts returns the Conflicts that are part of a DOT export (all Conflicts if no roots are given).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) exportedConflicts(rootConflictIDs ...ConflictIDType) (conflicts []*Conflict[ConflictIDType, ResourceIDType]) {
+	if len(rootConflictIDs) == 0 {
+		c.conflicts.ForEach(func(_ ConflictIDType, conflict *Conflict[ConflictIDType, ResourceIDType]) bool {
+			conflicts = append(conflicts, conflict)
+			return true
+		})
+
+		return conflicts
+	}
+
+	traversedConflicts := advancedset.New[*Conflict[ConflictIDType, ResourceIDType]]()
+	conflictWalker := walker.New[*Conflict[ConflictIDType, ResourceIDType]]()
+	for _, rootConflictID := range rootConflictIDs {
+		if rootConflict, exists := c.conflicts.Get(rootConflictID); exists {
+			conflictWalker.Push(rootConflict)
+		}
+	}
+
+	for conflictWalker.HasNext() {
+		conflict := conflictWalker.Next()
+		if traversedConflicts.Add(conflict) {
+			conflictWalker.PushAll(conflict.Children().Slice()...)
+		}
+	}
+
+	return traversedConflicts.Slice()
+}
+
+// dotConflictNode returns the DOT identifier of the node of the given Conflict.
+func dotConflictNode(conflictID string) string {
+	return strconv.Quote("conflict:" + conflictID)
+}
+
+// dotConflictSetNode returns the DOT identifier of the node of the given ConflictSet.
+func dotConflictSetNode(conflictSetID string) string {
+	return strconv.Quote("conflictset:" + conflictSetID)
+}
+
+// dotColor returns the fill color of a Conflict with the given ConfirmationState.
+func dotColor(confirmationState confirmation.State) string {
+	switch {
+	case confirmationState.IsAccepted():
+		return "palegreen"
+	case confirmationState.IsRejected():
+		return "lightcoral"
+	default:
+		return "lightgoldenrod"
+	}
+}
+
+// region dotWriter ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// dotWriter is a writer that remembers the first error, so that the DOT representation can be written without checking
+// the error of every statement.
+type dotWriter struct {
+	writer io.Writer
+	err    error
+}
+
+// printf writes the formatted statement unless a previous write failed.
+func (d *dotWriter) printf(format string, args ...any) {
+	if d.err == nil {
+		_, d.err = fmt.Fprintf(d.writer, format, args...)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
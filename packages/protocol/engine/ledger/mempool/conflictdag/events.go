@@ -1,6 +1,8 @@
 package conflictdag
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/runtime/event"
 )
@@ -27,6 +29,24 @@ type Events[ConflictIDType, ResourceIDType comparable] struct {
 	// ConflictNotConflicting is an event that gets triggered whenever all conflicting conflits have been orphaned and rejected..
 	ConflictNotConflicting *event.Event1[*Conflict[ConflictIDType, ResourceIDType]]
 
+	// ConflictDepthExceeded is an event that gets triggered whenever a Conflict is rejected because it exceeds the
+	// maximum conflict depth.
+	ConflictDepthExceeded *event.Event1[*ConflictDepthExceededEvent[ConflictIDType]]
+
+	// ConflictSetResolved is an event that gets triggered whenever the last pending member of a ConflictSet is resolved.
+	ConflictSetResolved *event.Event1[*ConflictSetResolvedEvent[ResourceIDType]]
+
+	// ConflictSetResolutionTimedOut is an event that gets triggered whenever a decision was forced on a ConflictSet that
+	// remained unresolved for longer than the resolution timeout.
+	ConflictSetResolutionTimedOut *event.Event1[*ConflictSetResolutionTimedOutEvent[ConflictIDType, ResourceIDType]]
+
+	// ConflictSetArchived is an event that gets triggered whenever a resolved ConflictSet is compacted.
+	ConflictSetArchived *event.Event1[*ArchivedConflictSet[ConflictIDType, ResourceIDType]]
+
+	// ConflictMerged is an event that gets triggered whenever an Accepted Conflict is removed during compaction (the
+	// entities that were booked into it belong to the master branch from now on).
+	ConflictMerged *event.Event1[*Conflict[ConflictIDType, ResourceIDType]]
+
 	event.Group[Events[ConflictIDType, ResourceIDType], *Events[ConflictIDType, ResourceIDType]]
 }
 
@@ -34,12 +54,17 @@ type Events[ConflictIDType, ResourceIDType comparable] struct {
 func NewEvents[ConflictIDType, ResourceIDType comparable](optsLinkTarget ...*Events[ConflictIDType, ResourceIDType]) (events *Events[ConflictIDType, ResourceIDType]) {
 	return event.CreateGroupConstructor(func() (self *Events[ConflictIDType, ResourceIDType]) {
 		return &Events[ConflictIDType, ResourceIDType]{
-			ConflictCreated:        event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
-			ConflictUpdated:        event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
-			ConflictParentsUpdated: event.New1[*ConflictParentsUpdatedEvent[ConflictIDType, ResourceIDType]](),
-			ConflictAccepted:       event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
-			ConflictRejected:       event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
-			ConflictNotConflicting: event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictCreated:               event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictUpdated:               event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictParentsUpdated:        event.New1[*ConflictParentsUpdatedEvent[ConflictIDType, ResourceIDType]](),
+			ConflictAccepted:              event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictRejected:              event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictNotConflicting:        event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
+			ConflictDepthExceeded:         event.New1[*ConflictDepthExceededEvent[ConflictIDType]](),
+			ConflictSetResolved:           event.New1[*ConflictSetResolvedEvent[ResourceIDType]](),
+			ConflictSetResolutionTimedOut: event.New1[*ConflictSetResolutionTimedOutEvent[ConflictIDType, ResourceIDType]](),
+			ConflictSetArchived:           event.New1[*ArchivedConflictSet[ConflictIDType, ResourceIDType]](),
+			ConflictMerged:                event.New1[*Conflict[ConflictIDType, ResourceIDType]](),
 		}
 	})(optsLinkTarget...)
 }
@@ -65,3 +90,63 @@ type ConflictParentsUpdatedEvent[ConflictIDType, ResourceIDType comparable] stru
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictDepthExceededEvent ///////////////////////////////////////////////////////////////////////////////////
+
+// ConflictDepthExceededEvent is a container that acts as a dictionary for the ConflictDepthExceeded event related
+// parameters.
+type ConflictDepthExceededEvent[ConflictIDType comparable] struct {
+	// ConflictID contains the identifier of the rejected Conflict.
+	ConflictID ConflictIDType
+
+	// Depth contains the depth that the Conflict would have had.
+	Depth int
+
+	// MaxDepth contains the configured maximum conflict depth.
+	MaxDepth int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictSetResolvedEvent /////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictSetResolvedEvent is a container that acts as a dictionary for the ConflictSetResolved event related
+// parameters.
+type ConflictSetResolvedEvent[ResourceIDType comparable] struct {
+	// ConflictSetID contains the identifier of the resolved ConflictSet.
+	ConflictSetID ResourceIDType
+
+	// CreationTime contains the time at which the ConflictSet was created.
+	CreationTime time.Time
+
+	// ResolutionTime contains the time at which the last pending member of the ConflictSet was resolved.
+	ResolutionTime time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictSetResolutionTimedOutEvent ///////////////////////////////////////////////////////////////////////////
+
+// ConflictSetResolutionTimedOutEvent is a container that acts as a dictionary for the ConflictSetResolutionTimedOut
+// event related parameters.
+type ConflictSetResolutionTimedOutEvent[ConflictIDType, ResourceIDType comparable] struct {
+	// ConflictSetID contains the identifier of the ConflictSet whose resolution timed out.
+	ConflictSetID ResourceIDType
+
+	// CreationTime contains the time at which the ConflictSet was created.
+	CreationTime time.Time
+
+	// Policy contains the ResolutionTimeoutPolicy that determined the decision.
+	Policy ResolutionTimeoutPolicy
+
+	// AcceptedConflictID contains the identifier of the Conflict that was accepted (if HasAcceptedConflict is true).
+	AcceptedConflictID ConflictIDType
+
+	// HasAcceptedConflict is true if one of the pending members was accepted.
+	HasAcceptedConflict bool
+
+	// RejectedConflictIDs contains the identifiers of the pending members that were rejected.
+	RejectedConflictIDs *advancedset.AdvancedSet[ConflictIDType]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
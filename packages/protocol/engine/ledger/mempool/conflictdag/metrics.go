@@ -0,0 +1,184 @@
+package conflictdag
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/core/ratecounter"
+)
+
+// metricsRateWindow is the time window that is used to determine the rates of created and resolved Conflicts.
+const metricsRateWindow = time.Minute
+
+// region Metrics //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Metrics is a ConflictDAG component that keeps track of the number of open ConflictSets, the rates at which Conflicts
+// are created and resolved and the time it takes to resolve a ConflictSet.
+type Metrics struct {
+	// openConflictSets contains the number of ConflictSets that still have pending members.
+	openConflictSets int
+
+	// createdConflicts contains the creation times of the Conflicts that were created within the rate window.
+	createdConflicts *ratecounter.RateCounter
+
+	// resolvedConflicts contains the resolution times of the Conflicts that were resolved within the rate window.
+	resolvedConflicts *ratecounter.RateCounter
+
+	// resolutionTimes contains the histogram of the times from the creation to the resolution of the ConflictSets.
+	resolutionTimes *ResolutionTimeHistogram
+
+	mutex sync.RWMutex
+}
+
+// newMetrics returns a new Metrics instance that uses the given buckets for its ResolutionTimeHistogram.
+func newMetrics(resolutionTimeBuckets []time.Duration) *Metrics {
+	return &Metrics{
+		createdConflicts:  ratecounter.New(metricsRateWindow),
+		resolvedConflicts: ratecounter.New(metricsRateWindow),
+		resolutionTimes:   newResolutionTimeHistogram(resolutionTimeBuckets),
+	}
+}
+
+// OpenConflictSets returns the number of ConflictSets that still have pending members.
+func (m *Metrics) OpenConflictSets() (openConflictSets int) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.openConflictSets
+}
+
+// ConflictsCreatedPerMinute returns the number of Conflicts that were created within the last minute.
+func (m *Metrics) ConflictsCreatedPerMinute() (createdConflicts int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.createdConflicts.Count(time.Now())
+}
+
+// ConflictsResolvedPerMinute returns the number of Conflicts that were accepted, rejected or marked as not conflicting
+// within the last minute.
+func (m *Metrics) ConflictsResolvedPerMinute() (resolvedConflicts int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.resolvedConflicts.Count(time.Now())
+}
+
+// ResolutionTimes returns a snapshot of the histogram of the times from the creation to the resolution of the
+// ConflictSets.
+func (m *Metrics) ResolutionTimes() (resolutionTimes *ResolutionTimeHistogram) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.resolutionTimes.clone()
+}
+
+// conflictSetCreated registers the creation of a ConflictSet.
+func (m *Metrics) conflictSetCreated() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.openConflictSets++
+}
+
+// conflictSetResolved registers the resolution of a ConflictSet that took the given duration.
+func (m *Metrics) conflictSetResolved(resolutionTime time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.openConflictSets--
+	m.resolutionTimes.observe(resolutionTime)
+}
+
+// conflictSetReopened registers that a resolved ConflictSet received a new pending member.
+func (m *Metrics) conflictSetReopened() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.openConflictSets++
+}
+
+// conflictCreated registers the creation of a Conflict at the given time.
+func (m *Metrics) conflictCreated(now time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.createdConflicts.Add(now, 1)
+}
+
+// conflictsResolved registers the resolution of the given number of Conflicts at the given time.
+func (m *Metrics) conflictsResolved(now time.Time, count int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.resolvedConflicts.Add(now, count)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ResolutionTimeHistogram //////////////////////////////////////////////////////////////////////////////////////
+
+// ResolutionTimeHistogram is a histogram of the times from the creation to the resolution of ConflictSets.
+type ResolutionTimeHistogram struct {
+	// Buckets contains the (ascending) upper bounds of the buckets of the histogram.
+	Buckets []time.Duration
+
+	// Counts contains the number of observations that fall into the bucket with the same index (the last element
+	// contains the observations that exceed the largest bucket).
+	Counts []uint64
+
+	// Count contains the total number of observations.
+	Count uint64
+
+	// Sum contains the sum of all observations.
+	Sum time.Duration
+}
+
+// newResolutionTimeHistogram returns a new ResolutionTimeHistogram with the given buckets.
+func newResolutionTimeHistogram(buckets []time.Duration) *ResolutionTimeHistogram {
+	return &ResolutionTimeHistogram{
+		Buckets: buckets,
+		Counts:  make([]uint64, len(buckets)+1),
+	}
+}
+
+// CumulativeCounts returns the number of observations that are less than or equal to the upper bound of the bucket
+// with the same index.
+func (r *ResolutionTimeHistogram) CumulativeCounts() (cumulativeCounts []uint64) {
+	cumulativeCounts = make([]uint64, len(r.Buckets))
+
+	var count uint64
+	for i := range r.Buckets {
+		count += r.Counts[i]
+		cumulativeCounts[i] = count
+	}
+
+	return cumulativeCounts
+}
+
+// observe adds the given resolution time to the histogram.
+func (r *ResolutionTimeHistogram) observe(resolutionTime time.Duration) {
+	bucketIndex := len(r.Buckets)
+	for i, upperBound := range r.Buckets {
+		if resolutionTime <= upperBound {
+			bucketIndex = i
+			break
+		}
+	}
+
+	r.Counts[bucketIndex]++
+	r.Count++
+	r.Sum += resolutionTime
+}
+
+// clone returns a copy of the histogram.
+func (r *ResolutionTimeHistogram) clone() (cloned *ResolutionTimeHistogram) {
+	return &ResolutionTimeHistogram{
+		Buckets: append([]time.Duration{}, r.Buckets...),
+		Counts:  append([]uint64{}, r.Counts...),
+		Count:   r.Count,
+		Sum:     r.Sum,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,8 +2,10 @@ package conflictdag
 
 import (
 	"sync"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 )
 
@@ -19,6 +21,10 @@ type Conflict[ConflictIDType, ResourceIDType comparable] struct {
 
 	confirmationState confirmation.State
 
+	// depth contains the number of nested Conflicts that this Conflict is based on (Conflicts without parents have a
+	// depth of 1).
+	depth int
+
 	m sync.RWMutex
 }
 
@@ -46,6 +52,14 @@ func (c *Conflict[ConflictIDType, ResourceIDType]) Parents() (parents *advanceds
 	return c.parents.Clone()
 }
 
+// deleteParent removes the given ConflictID from the parents of this Conflict.
+func (c *Conflict[ConflictIDType, ResourceIDType]) deleteParent(parentID ConflictIDType) (deleted bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return c.parents.Delete(parentID)
+}
+
 // SetParents updates the parent ConflictIDs that this Conflict depends on. It returns true if the Conflict was modified.
 func (c *Conflict[ConflictIDType, ResourceIDType]) setParents(parents *advancedset.AdvancedSet[ConflictIDType]) {
 	c.m.Lock()
@@ -54,6 +68,23 @@ func (c *Conflict[ConflictIDType, ResourceIDType]) setParents(parents *advanceds
 	c.parents = parents
 }
 
+// Depth returns the number of nested Conflicts that this Conflict is based on (Conflicts without parents have a depth of
+// 1).
+func (c *Conflict[ConflictIDType, ResourceIDType]) Depth() (depth int) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	return c.depth
+}
+
+// setDepth sets the depth of the Conflict.
+func (c *Conflict[ConflictIDType, ResourceIDType]) setDepth(depth int) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.depth = depth
+}
+
 // ConflictSets returns the identifiers of the conflict sets that this Conflict is part of.
 func (c *Conflict[ConflictIDType, ResourceIDType]) ConflictSets() (conflictSets *advancedset.AdvancedSet[*ConflictSet[ConflictIDType, ResourceIDType]]) {
 	c.m.RLock()
@@ -141,16 +172,26 @@ func (c *Conflict[ConflictIDType, ResourceIDType]) deleteConflictSet(conflictSet
 // region ConflictSet //////////////////////////////////////////////////////////////////////////////////////////////////
 
 type ConflictSet[ConflictIDType, ResourceIDType comparable] struct {
-	id        ResourceIDType
-	conflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]
+	id           ResourceIDType
+	conflicts    *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]]
+	creationTime time.Time
+
+	// resolved is true if none of the members of the ConflictSet is pending anymore (it is guarded by the mutex of the
+	// ConflictDAG).
+	resolved bool
+
+	// resolutionDeadline contains the slot at whose commitment a decision is forced on the ConflictSet (0 if no
+	// resolution timeout is pending - it is guarded by the mutex of the ConflictDAG).
+	resolutionDeadline slot.Index
 
 	m sync.RWMutex
 }
 
 func NewConflictSet[ConflictIDType comparable, ResourceIDType comparable](id ResourceIDType) (c *ConflictSet[ConflictIDType, ResourceIDType]) {
 	return &ConflictSet[ConflictIDType, ResourceIDType]{
-		id:        id,
-		conflicts: advancedset.New[*Conflict[ConflictIDType, ResourceIDType]](),
+		id:           id,
+		conflicts:    advancedset.New[*Conflict[ConflictIDType, ResourceIDType]](),
+		creationTime: time.Now(),
 	}
 }
 
@@ -158,6 +199,11 @@ func (c *ConflictSet[ConflictIDType, ResourceIDType]) ID() (id ResourceIDType) {
 	return c.id
 }
 
+// CreationTime returns the time at which the ConflictSet was created.
+func (c *ConflictSet[ConflictIDType, ResourceIDType]) CreationTime() (creationTime time.Time) {
+	return c.creationTime
+}
+
 func (c *ConflictSet[ConflictIDType, ResourceIDType]) Conflicts() *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]] {
 	c.m.RLock()
 	defer c.m.RUnlock()
@@ -173,3 +219,66 @@ func (c *ConflictSet[ConflictIDType, ResourceIDType]) AddConflictMember(conflict
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ArchivedConflictSet //////////////////////////////////////////////////////////////////////////////////////////
+
+// ArchivedConflictSet is the compact representation of a resolved ConflictSet. It replaces the ConflictSet and the
+// parent/child references of its members once none of them is pending anymore.
+type ArchivedConflictSet[ConflictIDType, ResourceIDType comparable] struct {
+	id             ResourceIDType
+	winner         ConflictIDType
+	hasWinner      bool
+	losers         *advancedset.AdvancedSet[ConflictIDType]
+	weights        map[ConflictIDType]int64
+	resolutionTime time.Time
+}
+
+// NewArchivedConflictSet creates a new ArchivedConflictSet for the ConflictSet with the given ID.
+func NewArchivedConflictSet[ConflictIDType, ResourceIDType comparable](id ResourceIDType, resolutionTime time.Time) (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) {
+	return &ArchivedConflictSet[ConflictIDType, ResourceIDType]{
+		id:             id,
+		losers:         advancedset.New[ConflictIDType](),
+		weights:        make(map[ConflictIDType]int64),
+		resolutionTime: resolutionTime,
+	}
+}
+
+// ID returns the identifier of the resource that the members of the ConflictSet were competing for.
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) ID() (id ResourceIDType) {
+	return a.id
+}
+
+// Winner returns the Conflict that won the ConflictSet (it returns false if all members were rejected).
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) Winner() (winner ConflictIDType, exists bool) {
+	return a.winner, a.hasWinner
+}
+
+// Losers returns the Conflicts that were rejected.
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) Losers() (losers *advancedset.AdvancedSet[ConflictIDType]) {
+	return a.losers.Clone()
+}
+
+// Weight returns the weight that the given member had at the time of the resolution.
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) Weight(conflictID ConflictIDType) (weight int64) {
+	return a.weights[conflictID]
+}
+
+// ResolutionTime returns the time at which the ConflictSet was archived.
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) ResolutionTime() (resolutionTime time.Time) {
+	return a.resolutionTime
+}
+
+// addMember records the outcome of a member of the ConflictSet.
+func (a *ArchivedConflictSet[ConflictIDType, ResourceIDType]) addMember(conflictID ConflictIDType, confirmationState confirmation.State, weight int64) {
+	a.weights[conflictID] = weight
+
+	if confirmationState.IsRejected() {
+		a.losers.Add(conflictID)
+		return
+	}
+
+	a.winner = conflictID
+	a.hasWinner = true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,245 @@
+package conflictdag
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+)
+
+// region ResolutionTimeoutPolicy //////////////////////////////////////////////////////////////////////////////////////
+
+// ResolutionTimeoutPolicy defines the deterministic decision that is forced on a ConflictSet that is still unresolved
+// when its resolution timeout expires.
+type ResolutionTimeoutPolicy uint8
+
+const (
+	// AcceptHeaviestConflict accepts the pending member with the highest weight at the time of the timeout (all pending
+	// members are rejected if there is no unique heaviest member).
+	AcceptHeaviestConflict ResolutionTimeoutPolicy = iota
+
+	// RejectAllConflicts rejects all pending members of the ConflictSet.
+	RejectAllConflicts
+)
+
+// String returns a human-readable representation of the ResolutionTimeoutPolicy.
+func (r ResolutionTimeoutPolicy) String() string {
+	switch r {
+	case AcceptHeaviestConflict:
+		return "heaviest"
+	case RejectAllConflicts:
+		return "reject-all"
+	default:
+		return "unknown"
+	}
+}
+
+// ResolutionTimeoutPolicyFromString parses a string and returns the ResolutionTimeoutPolicy it defines.
+func ResolutionTimeoutPolicyFromString(policy string) (ResolutionTimeoutPolicy, error) {
+	switch policy {
+	case "heaviest":
+		return AcceptHeaviestConflict, nil
+	case "reject-all":
+		return RejectAllConflicts, nil
+	default:
+		return 0, errors.Errorf("unknown resolution timeout policy %q", policy)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictDAG //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ResolveTimedOutConflictSets forces a decision on the unresolved ConflictSets whose resolution timeout expired at the
+// given slot. It is called when the slot is committed, so that the decisions do not depend on the local time of the node
+// and use the weights that are known at the time of the commitment.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) ResolveTimedOutConflictSets(index slot.Index) {
+	for _, conflictSet := range c.timedOutConflictSets(index) {
+		c.forceResolution(conflictSet)
+	}
+}
+
+// Shutdown cancels the pending resolution timeouts and prevents that new ones are scheduled.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) Shutdown() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.shutdown = true
+	c.resolutionTimeouts.ForEach(func(_ slot.Index, conflictSets *advancedset.AdvancedSet[*ConflictSet[ConflictIDType, ResourceIDType]]) bool {
+		_ = conflictSets.ForEach(func(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) error {
+			conflictSet.resolutionDeadline = 0
+			return nil
+		})
+		return true
+	})
+	c.resolutionTimeouts.Clear()
+}
+
+// scheduleResolutionTimeout registers the slot at whose commitment a decision is forced on the given ConflictSet. The
+// deadline is derived from the slot of the latest member, so that all nodes that know the same members use the same
+// deadline (it is guarded by the mutex of the ConflictDAG).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) scheduleResolutionTimeout(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) {
+	if c.optsResolutionTimeout == 0 || c.shutdown || conflictSet.resolved {
+		return
+	}
+
+	deadline := c.resolutionDeadline(conflictSet)
+	if deadline == conflictSet.resolutionDeadline {
+		return
+	}
+
+	c.cancelResolutionTimeout(conflictSet)
+
+	conflictSet.resolutionDeadline = deadline
+	conflictSets, _ := c.resolutionTimeouts.GetOrCreate(deadline, func() *advancedset.AdvancedSet[*ConflictSet[ConflictIDType, ResourceIDType]] {
+		return advancedset.New[*ConflictSet[ConflictIDType, ResourceIDType]]()
+	})
+	conflictSets.Add(conflictSet)
+}
+
+// cancelResolutionTimeout removes the deadline of the given ConflictSet (it is guarded by the mutex of the ConflictDAG).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) cancelResolutionTimeout(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) {
+	if conflictSet.resolutionDeadline == 0 {
+		return
+	}
+
+	if conflictSets, exists := c.resolutionTimeouts.Get(conflictSet.resolutionDeadline); exists && conflictSets.Delete(conflictSet) && conflictSets.IsEmpty() {
+		c.resolutionTimeouts.Delete(conflictSet.resolutionDeadline)
+	}
+	conflictSet.resolutionDeadline = 0
+}
+
+// resolutionDeadline returns the slot at whose commitment the resolution of the given ConflictSet times out (it is
+// guarded by the mutex of the ConflictDAG).
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) resolutionDeadline(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) (deadline slot.Index) {
+	var latestSlot slot.Index
+	if c.optsConflictSlotProvider != nil {
+		for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+			if memberSlot := c.optsConflictSlotProvider(it.Next().ID()); memberSlot > latestSlot {
+				latestSlot = memberSlot
+			}
+		}
+	}
+
+	return latestSlot + c.optsResolutionTimeout
+}
+
+// timedOutConflictSets removes the ConflictSets whose deadline is smaller or equal to the given slot from the pending
+// resolution timeouts and returns them ordered by their deadline.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) timedOutConflictSets(index slot.Index) (conflictSets []*ConflictSet[ConflictIDType, ResourceIDType]) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	deadlines := make([]slot.Index, 0)
+	c.resolutionTimeouts.ForEachKey(func(deadline slot.Index) bool {
+		if deadline <= index {
+			deadlines = append(deadlines, deadline)
+		}
+		return true
+	})
+	sort.Slice(deadlines, func(i, j int) bool {
+		return deadlines[i] < deadlines[j]
+	})
+
+	for _, deadline := range deadlines {
+		timedOutConflictSets, _ := c.resolutionTimeouts.Get(deadline)
+		c.resolutionTimeouts.Delete(deadline)
+
+		for it := timedOutConflictSets.Iterator(); it.HasNext(); {
+			conflictSet := it.Next()
+			conflictSet.resolutionDeadline = 0
+			conflictSets = append(conflictSets, conflictSet)
+		}
+	}
+
+	return conflictSets
+}
+
+// forceResolution applies the configured ResolutionTimeoutPolicy to the pending members of the given ConflictSet.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) forceResolution(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) {
+	// the weights are retrieved before locking the ConflictDAG, because the weight provider might access it as well
+	weights := c.pendingConflictWeights(conflictSet)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// the ConflictSet is skipped if it was removed or if a new member postponed its deadline in the meantime
+	if currentConflictSet, exists := c.conflictSets.Get(conflictSet.ID()); c.shutdown || !exists || currentConflictSet != conflictSet || conflictSet.resolutionDeadline != 0 {
+		return
+	}
+
+	pendingConflicts := advancedset.New[*Conflict[ConflictIDType, ResourceIDType]]()
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		if member := it.Next(); member.ConfirmationState().IsPending() {
+			pendingConflicts.Add(member)
+		}
+	}
+
+	if pendingConflicts.IsEmpty() {
+		return
+	}
+
+	timedOutEvent := &ConflictSetResolutionTimedOutEvent[ConflictIDType, ResourceIDType]{
+		ConflictSetID:       conflictSet.ID(),
+		CreationTime:        conflictSet.CreationTime(),
+		Policy:              c.optsResolutionTimeoutPolicy,
+		RejectedConflictIDs: advancedset.New[ConflictIDType](),
+	}
+
+	if c.optsResolutionTimeoutPolicy == AcceptHeaviestConflict {
+		timedOutEvent.AcceptedConflictID, timedOutEvent.HasAcceptedConflict = heaviestConflict(pendingConflicts, weights)
+	}
+
+	if timedOutEvent.HasAcceptedConflict {
+		c.setConflictAccepted(timedOutEvent.AcceptedConflictID)
+	} else {
+		rejectedConflicts := c.rejectConflictsWithFutureCone(pendingConflicts)
+		c.registerResolvedConflicts(rejectedConflicts)
+		c.compactResolvedConflictSets(rejectedConflicts)
+	}
+
+	for it := pendingConflicts.Iterator(); it.HasNext(); {
+		if member := it.Next(); member.ConfirmationState().IsRejected() {
+			timedOutEvent.RejectedConflictIDs.Add(member.ID())
+		}
+	}
+
+	c.Events.ConflictSetResolutionTimedOut.Trigger(timedOutEvent)
+}
+
+// pendingConflictWeights returns the weights of the pending members of the given ConflictSet.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) pendingConflictWeights(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) (weights map[ConflictIDType]int64) {
+	weights = make(map[ConflictIDType]int64)
+	if c.optsConflictWeightProvider == nil {
+		return weights
+	}
+
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		if member := it.Next(); member.ConfirmationState().IsPending() {
+			weights[member.ID()] = c.optsConflictWeightProvider(member.ID())
+		}
+	}
+
+	return weights
+}
+
+// heaviestConflict returns the Conflict with the highest weight (if there is a unique one).
+func heaviestConflict[ConflictIDType, ResourceIDType comparable](conflicts *advancedset.AdvancedSet[*Conflict[ConflictIDType, ResourceIDType]], weights map[ConflictIDType]int64) (heaviestConflictID ConflictIDType, unique bool) {
+	var heaviestWeight int64
+	for first, it := true, conflicts.Iterator(); it.HasNext(); first = false {
+		conflictID := it.Next().ID()
+
+		switch weight := weights[conflictID]; {
+		case first || weight > heaviestWeight:
+			heaviestConflictID, heaviestWeight, unique = conflictID, weight, true
+		case weight == heaviestWeight:
+			unique = false
+		}
+	}
+
+	return heaviestConflictID, unique
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
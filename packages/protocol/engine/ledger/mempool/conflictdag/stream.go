@@ -0,0 +1,162 @@
+package conflictdag
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+)
+
+// Stream returns a channel that delivers the changes of the ConflictDAG as a single ordered stream of ConflictEvents
+// until the given context is canceled (the channel is closed afterwards).
+//
+// The events are buffered up to the configured stream buffer size. Once the buffer is full, the ConflictDAG blocks
+// until the consumer catches up (or the context is canceled), so consumers must not wait for the ConflictDAG while
+// processing the stream.
+func (c *ConflictDAG[ConflictIDType, ResourceIDType]) Stream(ctx context.Context) <-chan *ConflictEvent[ConflictIDType, ResourceIDType] {
+	stream := make(chan *ConflictEvent[ConflictIDType, ResourceIDType], c.optsStreamBufferSize)
+
+	var closed bool
+	var closedMutex sync.RWMutex
+	publish := func(eventType ConflictEventType, conflict *Conflict[ConflictIDType, ResourceIDType]) {
+		closedMutex.RLock()
+		defer closedMutex.RUnlock()
+
+		if closed {
+			return
+		}
+
+		select {
+		case stream <- newConflictEvent(eventType, conflict):
+		case <-ctx.Done():
+		}
+	}
+
+	hooks := []interface{ Unhook() }{
+		c.Events.ConflictCreated.Hook(func(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+			publish(ConflictEventCreated, conflict)
+		}),
+		c.Events.ConflictUpdated.Hook(func(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+			publish(ConflictEventConflictingResourcesUpdated, conflict)
+		}),
+		c.Events.ConflictParentsUpdated.Hook(func(event *ConflictParentsUpdatedEvent[ConflictIDType, ResourceIDType]) {
+			// the event is triggered while the ConflictDAG is locked, so the Conflict can not be evicted in the meantime
+			if conflict, exists := c.conflicts.Get(event.ConflictID); exists {
+				publish(ConflictEventParentsUpdated, conflict)
+			}
+		}),
+		c.Events.ConflictAccepted.Hook(func(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+			publish(ConflictEventAccepted, conflict)
+		}),
+		c.Events.ConflictRejected.Hook(func(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+			publish(ConflictEventRejected, conflict)
+		}),
+		c.Events.ConflictNotConflicting.Hook(func(conflict *Conflict[ConflictIDType, ResourceIDType]) {
+			publish(ConflictEventNotConflicting, conflict)
+		}),
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		for _, hook := range hooks {
+			hook.Unhook()
+		}
+
+		closedMutex.Lock()
+		defer closedMutex.Unlock()
+
+		closed = true
+		close(stream)
+	}()
+
+	return stream
+}
+
+// region ConflictEvent ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictEvent is a change of the ConflictDAG that is delivered by the Stream of the ConflictDAG.
+type ConflictEvent[ConflictIDType, ResourceIDType comparable] struct {
+	// Type contains the type of the change.
+	Type ConflictEventType
+
+	// ConflictID contains the identifier of the changed Conflict.
+	ConflictID ConflictIDType
+
+	// ParentConflictIDs contains the parents of the Conflict at the time of the change.
+	ParentConflictIDs *advancedset.AdvancedSet[ConflictIDType]
+
+	// ConflictSetIDs contains the ConflictSets of the Conflict at the time of the change.
+	ConflictSetIDs *advancedset.AdvancedSet[ResourceIDType]
+
+	// ConfirmationState contains the ConfirmationState of the Conflict at the time of the change.
+	ConfirmationState confirmation.State
+}
+
+// newConflictEvent creates a new ConflictEvent of the given type that captures the current state of the Conflict.
+func newConflictEvent[ConflictIDType, ResourceIDType comparable](eventType ConflictEventType, conflict *Conflict[ConflictIDType, ResourceIDType]) (conflictEvent *ConflictEvent[ConflictIDType, ResourceIDType]) {
+	conflictSetIDs := advancedset.New[ResourceIDType]()
+	for it := conflict.ConflictSets().Iterator(); it.HasNext(); {
+		conflictSetIDs.Add(it.Next().ID())
+	}
+
+	return &ConflictEvent[ConflictIDType, ResourceIDType]{
+		Type:              eventType,
+		ConflictID:        conflict.ID(),
+		ParentConflictIDs: conflict.Parents(),
+		ConflictSetIDs:    conflictSetIDs,
+		ConfirmationState: conflict.ConfirmationState(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictEventType ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictEventType is the type of a ConflictEvent.
+type ConflictEventType uint8
+
+const (
+	// ConflictEventCreated is the type of the ConflictEvents of newly created Conflicts.
+	ConflictEventCreated ConflictEventType = iota
+
+	// ConflictEventConflictingResourcesUpdated is the type of the ConflictEvents of Conflicts that joined additional
+	// ConflictSets.
+	ConflictEventConflictingResourcesUpdated
+
+	// ConflictEventParentsUpdated is the type of the ConflictEvents of Conflicts whose parents were updated.
+	ConflictEventParentsUpdated
+
+	// ConflictEventAccepted is the type of the ConflictEvents of accepted Conflicts.
+	ConflictEventAccepted
+
+	// ConflictEventRejected is the type of the ConflictEvents of rejected Conflicts.
+	ConflictEventRejected
+
+	// ConflictEventNotConflicting is the type of the ConflictEvents of Conflicts whose conflicting Conflicts were all
+	// rejected.
+	ConflictEventNotConflicting
+)
+
+// String returns a human-readable representation of the ConflictEventType.
+func (c ConflictEventType) String() (humanReadable string) {
+	switch c {
+	case ConflictEventCreated:
+		return "ConflictCreated"
+	case ConflictEventConflictingResourcesUpdated:
+		return "ConflictingResourcesUpdated"
+	case ConflictEventParentsUpdated:
+		return "ParentsUpdated"
+	case ConflictEventAccepted:
+		return "Accepted"
+	case ConflictEventRejected:
+		return "Rejected"
+	case ConflictEventNotConflicting:
+		return "NotConflicting"
+	default:
+		return "Unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
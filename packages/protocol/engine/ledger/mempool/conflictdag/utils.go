@@ -0,0 +1,382 @@
+package conflictdag
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+)
+
+// Utils is a ConflictDAG component that bundles utility related API to simplify common interactions with the ConflictDAG.
+type Utils[ConflictIDType, ResourceIDType comparable] struct {
+	// conflictDAG contains a reference to the ConflictDAG that created the Utils.
+	conflictDAG *ConflictDAG[ConflictIDType, ResourceIDType]
+
+	// ancestors memoizes the transitive parents of the Conflicts (it is reset whenever the parents of a Conflict change).
+	ancestors      map[ConflictIDType]*advancedset.AdvancedSet[ConflictIDType]
+	ancestorsMutex sync.Mutex
+}
+
+// newUtils returns a new Utils instance for the given ConflictDAG.
+func newUtils[ConflictIDType, ResourceIDType comparable](conflictDAG *ConflictDAG[ConflictIDType, ResourceIDType]) *Utils[ConflictIDType, ResourceIDType] {
+	return &Utils[ConflictIDType, ResourceIDType]{
+		conflictDAG: conflictDAG,
+		ancestors:   make(map[ConflictIDType]*advancedset.AdvancedSet[ConflictIDType]),
+	}
+}
+
+// IsDescendantOf returns true if the Conflict with the given ancestorID is a (direct or indirect) parent of the Conflict
+// with the given childID.
+func (u *Utils[ConflictIDType, ResourceIDType]) IsDescendantOf(childID, ancestorID ConflictIDType) (isDescendant bool) {
+	u.conflictDAG.mutex.RLock()
+	defer u.conflictDAG.mutex.RUnlock()
+	u.ancestorsMutex.Lock()
+	defer u.ancestorsMutex.Unlock()
+
+	return u.ancestorsOf(childID).Has(ancestorID)
+}
+
+// LowestCommonAncestors returns the Conflicts that are ancestors (or members) of all given Conflicts and that are not
+// themselves ancestors of another common ancestor. An empty result means that the Conflicts only share the master branch.
+func (u *Utils[ConflictIDType, ResourceIDType]) LowestCommonAncestors(conflictIDs *advancedset.AdvancedSet[ConflictIDType]) (lowestCommonAncestors *advancedset.AdvancedSet[ConflictIDType]) {
+	lowestCommonAncestors = advancedset.New[ConflictIDType]()
+	if conflictIDs.IsEmpty() {
+		return lowestCommonAncestors
+	}
+
+	u.conflictDAG.mutex.RLock()
+	defer u.conflictDAG.mutex.RUnlock()
+	u.ancestorsMutex.Lock()
+	defer u.ancestorsMutex.Unlock()
+
+	var commonAncestors *advancedset.AdvancedSet[ConflictIDType]
+	for it := conflictIDs.Iterator(); it.HasNext(); {
+		conflictID := it.Next()
+
+		ancestorsOrSelf := u.ancestorsOf(conflictID).Clone()
+		ancestorsOrSelf.Add(conflictID)
+
+		if commonAncestors == nil {
+			commonAncestors = ancestorsOrSelf
+		} else {
+			commonAncestors = commonAncestors.Intersect(ancestorsOrSelf)
+		}
+	}
+
+	// the common ancestors that are ancestors of other common ancestors are not the lowest ones
+	redundantAncestors := advancedset.New[ConflictIDType]()
+	for it := commonAncestors.Iterator(); it.HasNext(); {
+		redundantAncestors.AddAll(u.ancestorsOf(it.Next()))
+	}
+
+	for it := commonAncestors.Iterator(); it.HasNext(); {
+		if commonAncestor := it.Next(); !redundantAncestors.Has(commonAncestor) {
+			lowestCommonAncestors.Add(commonAncestor)
+		}
+	}
+
+	return lowestCommonAncestors
+}
+
+// ancestorsOf returns the memoized transitive parents of the Conflict with the given ID (the returned set must not be
+// modified).
+func (u *Utils[ConflictIDType, ResourceIDType]) ancestorsOf(conflictID ConflictIDType) (ancestors *advancedset.AdvancedSet[ConflictIDType]) {
+	ancestors, _ = u.memoizedAncestorsOf(conflictID)
+
+	return ancestors
+}
+
+// memoizedAncestorsOf determines the transitive parents of the Conflict with the given ID. The result is only memoized
+// if all ancestors are known, so that Conflicts that are created later are not missing in the memoized ancestors.
+func (u *Utils[ConflictIDType, ResourceIDType]) memoizedAncestorsOf(conflictID ConflictIDType) (ancestors *advancedset.AdvancedSet[ConflictIDType], complete bool) {
+	if ancestors, exists := u.ancestors[conflictID]; exists {
+		return ancestors, true
+	}
+
+	ancestors = advancedset.New[ConflictIDType]()
+	conflict, exists := u.conflictDAG.conflicts.Get(conflictID)
+	if !exists {
+		return ancestors, false
+	}
+
+	complete = true
+	for it := conflict.Parents().Iterator(); it.HasNext(); {
+		parentID := it.Next()
+
+		parentAncestors, parentComplete := u.memoizedAncestorsOf(parentID)
+		ancestors.Add(parentID)
+		ancestors.AddAll(parentAncestors)
+		complete = complete && parentComplete
+	}
+
+	if complete {
+		u.ancestors[conflictID] = ancestors
+	}
+
+	return ancestors, complete
+}
+
+// resetAncestors discards the memoized ancestors (it is called whenever the parent references of the ConflictDAG change).
+func (u *Utils[ConflictIDType, ResourceIDType]) resetAncestors() {
+	u.ancestorsMutex.Lock()
+	defer u.ancestorsMutex.Unlock()
+
+	if len(u.ancestors) != 0 {
+		u.ancestors = make(map[ConflictIDType]*advancedset.AdvancedSet[ConflictIDType])
+	}
+}
+
+// PendingConflicts returns a page of the ConflictSets that still have pending members (ordered by their creation time)
+// together with the total number of unresolved ConflictSets.
+func (u *Utils[ConflictIDType, ResourceIDType]) PendingConflicts(offset, limit int) (pendingConflictSets []*PendingConflictSet[ConflictIDType, ResourceIDType], total int) {
+	u.conflictDAG.mutex.RLock()
+	unresolvedConflictSets := make([]*ConflictSet[ConflictIDType, ResourceIDType], 0)
+	u.conflictDAG.conflictSets.ForEach(func(_ ResourceIDType, conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) bool {
+		if u.conflictDAG.hasPendingConflict(conflictSet) {
+			unresolvedConflictSets = append(unresolvedConflictSets, conflictSet)
+		}
+
+		return true
+	})
+	u.conflictDAG.mutex.RUnlock()
+
+	sort.Slice(unresolvedConflictSets, func(i, j int) bool {
+		return unresolvedConflictSets[i].CreationTime().Before(unresolvedConflictSets[j].CreationTime())
+	})
+
+	total = len(unresolvedConflictSets)
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+
+	pendingConflictSets = make([]*PendingConflictSet[ConflictIDType, ResourceIDType], 0, end-offset)
+	for _, conflictSet := range unresolvedConflictSets[offset:end] {
+		pendingConflictSets = append(pendingConflictSets, u.pendingConflictSet(conflictSet))
+	}
+
+	return pendingConflictSets, total
+}
+
+// pendingConflictSet creates the PendingConflictSet of the given ConflictSet (members are ordered by descending weight).
+func (u *Utils[ConflictIDType, ResourceIDType]) pendingConflictSet(conflictSet *ConflictSet[ConflictIDType, ResourceIDType]) (pendingConflictSet *PendingConflictSet[ConflictIDType, ResourceIDType]) {
+	pendingConflictSet = &PendingConflictSet[ConflictIDType, ResourceIDType]{
+		ID:           conflictSet.ID(),
+		CreationTime: conflictSet.CreationTime(),
+		Members:      make([]*PendingConflictSetMember[ConflictIDType], 0),
+	}
+
+	for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+		member := it.Next()
+
+		var weight int64
+		if u.conflictDAG.optsConflictWeightProvider != nil {
+			weight = u.conflictDAG.optsConflictWeightProvider(member.ID())
+		}
+
+		pendingConflictSet.Members = append(pendingConflictSet.Members, &PendingConflictSetMember[ConflictIDType]{
+			ID:                member.ID(),
+			ConfirmationState: member.ConfirmationState(),
+			Weight:            weight,
+		})
+	}
+
+	sort.SliceStable(pendingConflictSet.Members, func(i, j int) bool {
+		return pendingConflictSet.Members[i].Weight > pendingConflictSet.Members[j].Weight
+	})
+
+	return pendingConflictSet
+}
+
+// ResolutionOutcomes enumerates the ways in which the pending ConflictSets that the given sets of Conflicts (and their
+// ancestors) depend on can be resolved. Every outcome elects one winner per pending ConflictSet and reports for each of
+// the given sets of Conflicts whether all of its members survive. At most maxOutcomes outcomes are returned (truncated
+// is true if there are more).
+func (u *Utils[ConflictIDType, ResourceIDType]) ResolutionOutcomes(maxOutcomes int, conflictIDSets ...*advancedset.AdvancedSet[ConflictIDType]) (outcomes []*ResolutionOutcome[ConflictIDType, ResourceIDType], truncated bool) {
+	u.conflictDAG.mutex.RLock()
+	defer u.conflictDAG.mutex.RUnlock()
+
+	pendingConflictSets := u.pendingConflictSetsOf(conflictIDSets...)
+	candidates := make([][]*Conflict[ConflictIDType, ResourceIDType], len(pendingConflictSets))
+	for i, conflictSet := range pendingConflictSets {
+		for it := conflictSet.Conflicts().Iterator(); it.HasNext(); {
+			if member := it.Next(); !member.ConfirmationState().IsRejected() {
+				candidates[i] = append(candidates[i], member)
+			}
+		}
+	}
+
+	outcomes = make([]*ResolutionOutcome[ConflictIDType, ResourceIDType], 0)
+	for choices := make([]int, len(pendingConflictSets)); ; {
+		if len(outcomes) == maxOutcomes {
+			return outcomes, true
+		}
+
+		winners := make(map[ResourceIDType]ConflictIDType, len(pendingConflictSets))
+		for i, conflictSet := range pendingConflictSets {
+			winners[conflictSet.ID()] = candidates[i][choices[i]].ID()
+		}
+		outcomes = append(outcomes, u.resolutionOutcome(pendingConflictSets, winners, conflictIDSets))
+
+		// advance the choices like an odometer and stop once every combination was visited
+		i := len(choices) - 1
+		for ; i >= 0; i-- {
+			if choices[i]++; choices[i] < len(candidates[i]) {
+				break
+			}
+			choices[i] = 0
+		}
+		if i < 0 {
+			return outcomes, false
+		}
+	}
+}
+
+// pendingConflictSetsOf returns the pending ConflictSets of the given Conflicts and their ancestors (ordered by their
+// creation time).
+func (u *Utils[ConflictIDType, ResourceIDType]) pendingConflictSetsOf(conflictIDSets ...*advancedset.AdvancedSet[ConflictIDType]) (pendingConflictSets []*ConflictSet[ConflictIDType, ResourceIDType]) {
+	visitedConflicts := advancedset.New[ConflictIDType]()
+	visitedConflictSets := advancedset.New[*ConflictSet[ConflictIDType, ResourceIDType]]()
+
+	var visit func(conflictID ConflictIDType)
+	visit = func(conflictID ConflictIDType) {
+		if !visitedConflicts.Add(conflictID) {
+			return
+		}
+
+		conflict, exists := u.conflictDAG.conflicts.Get(conflictID)
+		if !exists {
+			return
+		}
+
+		for it := conflict.ConflictSets().Iterator(); it.HasNext(); {
+			if conflictSet := it.Next(); visitedConflictSets.Add(conflictSet) && u.conflictDAG.hasPendingConflict(conflictSet) {
+				pendingConflictSets = append(pendingConflictSets, conflictSet)
+			}
+		}
+
+		for it := conflict.Parents().Iterator(); it.HasNext(); {
+			visit(it.Next())
+		}
+	}
+
+	for _, conflictIDs := range conflictIDSets {
+		for it := conflictIDs.Iterator(); it.HasNext(); {
+			visit(it.Next())
+		}
+	}
+
+	sort.SliceStable(pendingConflictSets, func(i, j int) bool {
+		return pendingConflictSets[i].CreationTime().Before(pendingConflictSets[j].CreationTime())
+	})
+
+	return pendingConflictSets
+}
+
+// resolutionOutcome creates the ResolutionOutcome that results from electing the given winners.
+func (u *Utils[ConflictIDType, ResourceIDType]) resolutionOutcome(pendingConflictSets []*ConflictSet[ConflictIDType, ResourceIDType], winners map[ResourceIDType]ConflictIDType, conflictIDSets []*advancedset.AdvancedSet[ConflictIDType]) (outcome *ResolutionOutcome[ConflictIDType, ResourceIDType]) {
+	outcome = &ResolutionOutcome[ConflictIDType, ResourceIDType]{
+		Winners:  make([]*ResolutionOutcomeWinner[ConflictIDType, ResourceIDType], 0, len(pendingConflictSets)),
+		Survives: make([]bool, len(conflictIDSets)),
+	}
+
+	for _, conflictSet := range pendingConflictSets {
+		winner := &ResolutionOutcomeWinner[ConflictIDType, ResourceIDType]{
+			ConflictSetID: conflictSet.ID(),
+			ConflictID:    winners[conflictSet.ID()],
+		}
+		if u.conflictDAG.optsConflictWeightProvider != nil {
+			winner.Weight = u.conflictDAG.optsConflictWeightProvider(winner.ConflictID)
+		}
+
+		outcome.Winners = append(outcome.Winners, winner)
+	}
+
+	survivingConflicts := make(map[ConflictIDType]bool)
+	var survives func(conflictID ConflictIDType) bool
+	survives = func(conflictID ConflictIDType) (survived bool) {
+		if survived, evaluated := survivingConflicts[conflictID]; evaluated {
+			return survived
+		}
+		defer func() { survivingConflicts[conflictID] = survived }()
+
+		conflict, exists := u.conflictDAG.conflicts.Get(conflictID)
+		if !exists {
+			archivedConfirmationState, archived := u.conflictDAG.archivedConflicts.Get(conflictID)
+			return !archived || !archivedConfirmationState.IsRejected()
+		}
+
+		if conflict.ConfirmationState().IsRejected() {
+			return false
+		}
+
+		for it := conflict.ConflictSets().Iterator(); it.HasNext(); {
+			if winnerID, pending := winners[it.Next().ID()]; pending && winnerID != conflictID {
+				return false
+			}
+		}
+
+		for it := conflict.Parents().Iterator(); it.HasNext(); {
+			if !survives(it.Next()) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for i, conflictIDs := range conflictIDSets {
+		outcome.Survives[i] = true
+		for it := conflictIDs.Iterator(); it.HasNext() && outcome.Survives[i]; {
+			outcome.Survives[i] = survives(it.Next())
+		}
+	}
+
+	return outcome
+}
+
+// region PendingConflictSet ///////////////////////////////////////////////////////////////////////////////////////////
+
+// PendingConflictSet is a snapshot of an unresolved ConflictSet that is returned by Utils.PendingConflicts.
+type PendingConflictSet[ConflictIDType, ResourceIDType comparable] struct {
+	ID           ResourceIDType
+	CreationTime time.Time
+	Members      []*PendingConflictSetMember[ConflictIDType]
+}
+
+// PendingConflictSetMember is a snapshot of a member of an unresolved ConflictSet.
+type PendingConflictSetMember[ConflictIDType comparable] struct {
+	ID                ConflictIDType
+	ConfirmationState confirmation.State
+	Weight            int64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ResolutionOutcome ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ResolutionOutcome is a possible resolution of pending ConflictSets that is returned by Utils.ResolutionOutcomes.
+type ResolutionOutcome[ConflictIDType, ResourceIDType comparable] struct {
+	// Winners contains the elected member of every pending ConflictSet.
+	Winners []*ResolutionOutcomeWinner[ConflictIDType, ResourceIDType]
+
+	// Survives contains for every requested set of Conflicts whether all of its members survive the outcome.
+	Survives []bool
+}
+
+// ResolutionOutcomeWinner is the member of a pending ConflictSet that wins in a ResolutionOutcome.
+type ResolutionOutcomeWinner[ConflictIDType, ResourceIDType comparable] struct {
+	ConflictSetID ResourceIDType
+	ConflictID    ConflictIDType
+	Weight        int64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
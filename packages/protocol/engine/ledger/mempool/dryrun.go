@@ -0,0 +1,45 @@
+package mempool
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+)
+
+// region DryRunResult /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DryRunResult contains the outcome of the validation and execution of a Transaction that was not stored in the
+// MemPool.
+type DryRunResult struct {
+	// InputIDs contains the OutputIDs that are referenced by the Inputs of the Transaction.
+	InputIDs utxo.OutputIDs
+
+	// Inputs contains the Outputs that are consumed by the Transaction (only the available ones if it is unsolid).
+	Inputs *utxo.Outputs
+
+	// InputsMetadata contains the metadata of the consumed Outputs (nil if the Transaction is unsolid).
+	InputsMetadata *OutputsMetadata
+
+	// Outputs contains the Outputs that the Transaction would create (nil if it is invalid).
+	Outputs *utxo.Outputs
+
+	// Cost contains the resources that were consumed by the execution of the Transaction (zero if it was not executed).
+	Cost vm.Cost
+
+	// Trace contains the resolved inputs, the validation steps and the created Outputs of the Transaction.
+	Trace *vm.Trace
+
+	// Err contains the reason why the Transaction would not be booked (nil if it would be booked).
+	Err error
+}
+
+// Solid returns true if all Outputs that are consumed by the Transaction are available.
+func (d *DryRunResult) Solid() (solid bool) {
+	return d.Inputs != nil && d.Inputs.Size() == d.InputIDs.Size()
+}
+
+// Valid returns true if the Transaction would be booked.
+func (d *DryRunResult) Valid() (valid bool) {
+	return d.Err == nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,12 +2,22 @@ package mempool
 
 import (
 	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 )
 
 var (
-	// ErrTransactionInvalid is returned if a Transaction is found to be invalid.
-	ErrTransactionInvalid = errors.New("transaction invalid")
+	// ErrTransactionInvalid is returned if a Transaction is found to be invalid (the reason is described by the
+	// vm.ValidationError in the chain of the error).
+	ErrTransactionInvalid = vm.ErrTransactionInvalid
 
 	// ErrTransactionUnsolid is returned if a Transaction consumes unsolid Outputs..
 	ErrTransactionUnsolid = errors.New("transaction unsolid")
+
+	// ErrBundleInvalid is returned if the Transactions of a bundle can not be booked together (e.g. due to duplicates).
+	ErrBundleInvalid = errors.New("bundle invalid")
+
+	// ErrSnapshotOverflow is returned if a StorageSnapshot recorded more changes than allowed, so that it no longer
+	// provides a consistent view of the storage.
+	ErrSnapshotOverflow = errors.New("snapshot overflowed")
 )
@@ -38,6 +38,10 @@ type Events struct {
 	// TransactionConflictIDUpdated is an event that gets triggered whenever the Conflict of a Transaction is updated.
 	TransactionConflictIDUpdated *event.Event1[*TransactionConflictIDUpdatedEvent]
 
+	// TransactionConflictIDsMerged is an event that gets triggered whenever a merged Conflict is removed from the
+	// ConflictIDs of a Transaction.
+	TransactionConflictIDsMerged *event.Event1[*TransactionConflictIDsMergedEvent]
+
 	// TransactionInvalid is an event that gets triggered whenever a Transaction is found to be invalid.
 	TransactionInvalid *event.Event1[*TransactionInvalidEvent]
 
@@ -64,6 +68,7 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		TransactionRejected:          event.New1[*TransactionMetadata](),
 		TransactionForked:            event.New1[*TransactionForkedEvent](),
 		TransactionConflictIDUpdated: event.New1[*TransactionConflictIDUpdatedEvent](),
+		TransactionConflictIDsMerged: event.New1[*TransactionConflictIDsMergedEvent](),
 		TransactionInvalid:           event.New1[*TransactionInvalidEvent](),
 		OutputCreated:                event.New1[utxo.OutputID](),
 		OutputSpent:                  event.New1[utxo.OutputID](),
@@ -158,6 +163,23 @@ type TransactionConflictIDUpdatedEvent struct {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region TransactionConflictIDsMergedEvent ///////////////////////////////////////////////////////////////////////////
+
+// TransactionConflictIDsMergedEvent is a container that acts as a dictionary for the TransactionConflictIDsMerged event
+// related parameters.
+type TransactionConflictIDsMergedEvent struct {
+	// TransactionID contains the identifier of the Transaction whose ConflictIDs were updated.
+	TransactionID utxo.TransactionID
+
+	// MergedConflictID contains the identifier of the Conflict that was merged into the master branch.
+	MergedConflictID utxo.TransactionID
+
+	// ConflictIDs contains the ConflictIDs of the Transaction after the merged Conflict was removed.
+	ConflictIDs *advancedset.AdvancedSet[utxo.TransactionID]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region TransactionInvalidEvent //////////////////////////////////////////////////////////////////////////////////////
 
 // TransactionInvalidEvent is a container that acts as a dictionary for the TransactionInvalid event related parameters.
@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"context"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/core/module"
@@ -29,16 +30,27 @@ type MemPool interface {
 	// StoreAndProcessTransaction stores and processes the given Transaction.
 	StoreAndProcessTransaction(ctx context.Context, tx utxo.Transaction) (err error)
 
+	// StoreAndProcessAtomically stores and processes the given interdependent Transactions as a bundle (either all of
+	// them are booked or none of them).
+	StoreAndProcessAtomically(ctx context.Context, txs []utxo.Transaction) (err error)
+
 	// PruneTransaction removes a Transaction from the MemPool (e.g. after it was orphaned or found to be invalid). If the
 	// pruneFutureCone flag is true, then we do not just remove the named Transaction but also its future cone.
 	PruneTransaction(txID utxo.TransactionID, pruneFutureCone bool)
 
+	// Attachments is an index of the blocks that contain the Transactions of this MemPool.
+	Attachments() *Attachments
+
 	// SetTransactionInclusionSlot sets the inclusion timestamp of a Transaction.
 	SetTransactionInclusionSlot(id utxo.TransactionID, inclusionSlot slot.Index)
 
 	// CheckTransaction checks the validity of a Transaction.
 	CheckTransaction(ctx context.Context, tx utxo.Transaction) (err error)
 
+	// DryRun resolves the inputs of a Transaction and executes it without storing anything, so that the validity and
+	// the created Outputs of a Transaction can be determined before it is issued.
+	DryRun(ctx context.Context, tx utxo.Transaction) (result *DryRunResult)
+
 	// VM is the vm used for transaction validation.
 	VM() vm.VM
 
@@ -52,6 +64,10 @@ type Utils interface {
 	// ResolveInputs returns the OutputIDs that were referenced by the given Inputs.
 	ResolveInputs(inputs []utxo.Input) (outputIDs utxo.OutputIDs)
 
+	// Outputs retrieves the Outputs with the given OutputIDs together with their OutputMetadata in a single batch
+	// (unknown OutputIDs are missing in the results).
+	Outputs(outputIDs ...utxo.OutputID) (outputs *utxo.Outputs, outputsMetadata *OutputsMetadata)
+
 	ConflictIDsInFutureCone(conflictIDs utxo.TransactionIDs) (conflictIDsInFutureCone utxo.TransactionIDs)
 
 	ReferencedTransactions(tx utxo.Transaction) (transactionIDs utxo.TransactionIDs)
@@ -70,7 +86,13 @@ type Utils interface {
 	// with their corresponding TransactionMetadata.
 	WalkConsumingTransactionMetadata(entryPoints utxo.OutputIDs, callback func(txMetadata *TransactionMetadata, walker *walker.Walker[utxo.OutputID]))
 
+	// ConfirmedConsumer returns the Transaction that spent the Output and got accepted (or EmptyTransactionID if none of
+	// its consumers got accepted yet).
 	ConfirmedConsumer(outputID utxo.OutputID) (consumerID utxo.TransactionID)
+
+	// ExecutionTrace returns the Trace of the last processing of the Transaction with the given ID (it only exists if
+	// execution tracing is enabled and the Trace was not evicted yet).
+	ExecutionTrace(txID utxo.TransactionID) (trace *vm.Trace, exists bool)
 }
 
 type Storage interface {
@@ -105,5 +127,43 @@ type Storage interface {
 	// CachedConsumers retrieves the CachedObjects containing the named Consumers.
 	CachedConsumers(outputID utxo.OutputID) (cachedConsumers generic.CachedObjects[*Consumer])
 
-	ForEachOutputID(callback func(utxo.OutputID) bool)
+	// ForEachOutputID iterates over the IDs of the stored Outputs until the callback returns false. The iteration is
+	// aborted with the error of the context if the context is canceled.
+	ForEachOutputID(ctx context.Context, callback func(utxo.OutputID) bool) (err error)
+
+	// Snapshot returns a consistent view of the stored Outputs and their OutputMetadata that is not affected by changes
+	// that happen after it was taken (it needs to be released once it is no longer needed).
+	Snapshot() (snapshot StorageSnapshot)
+
+	// CacheStatistics returns the CacheStatistics of the underlying object storages (indexed by their name).
+	CacheStatistics() map[string]CacheStatistics
+
+	// SetCacheTime updates the duration that the objects of the named storage stay cached after they have been released.
+	SetCacheTime(storageName string, cacheTime time.Duration) (err error)
+
+	// SetCacheSize updates the maximum number of released objects of the named storage that stay cached (0 means
+	// unlimited).
+	SetCacheSize(storageName string, maxSize int) (err error)
+}
+
+// StorageSnapshot is a consistent, read-only view of the stored Outputs and their OutputMetadata, that allows long
+// running scans (like exports, audits or analytics) to not race with the concurrent processing of Transactions.
+type StorageSnapshot interface {
+	// Output returns the Output with the given ID as it existed when the snapshot was taken.
+	Output(outputID utxo.OutputID) (output utxo.Output, exists bool)
+
+	// OutputMetadata returns a copy of the OutputMetadata of the Output with the given ID as it existed when the
+	// snapshot was taken.
+	OutputMetadata(outputID utxo.OutputID) (outputMetadata *OutputMetadata, exists bool)
+
+	// ForEachOutputID iterates over the IDs of the Outputs that existed when the snapshot was taken until the callback
+	// returns false. The iteration is aborted with the error of the context if the context is canceled.
+	ForEachOutputID(ctx context.Context, callback func(utxo.OutputID) bool) (err error)
+
+	// Err returns ErrSnapshotOverflow if the snapshot recorded more changes than allowed (the results of the snapshot
+	// can not be trusted once it returns an error).
+	Err() (err error)
+
+	// Release releases the snapshot (it needs to be called once the snapshot is no longer needed).
+	Release()
 }
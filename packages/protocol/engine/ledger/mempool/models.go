@@ -1,14 +1,19 @@
 package mempool
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/core/storable"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/ds/orderedmap"
+	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/objectstorage/generic/model"
 	"github.com/iotaledger/hive.go/stringify"
@@ -42,8 +47,16 @@ type transactionMetadata struct {
 
 	// ConfirmationStateTime contains the last time the ConfirmationState was updated.
 	ConfirmationStateTime time.Time `serix:"6"`
+
+	// RejectionReason contains the reason why the Transaction was rejected.
+	RejectionReason RejectionReason `serix:"7"`
 }
 
+// transactionMetadataVersioning upgrades the stored TransactionMetadata written by older versions of the node:
+//   - version 1 added the RejectionReason to the unversioned records.
+var transactionMetadataVersioning = storable.NewVersioning(1).
+	WithUpgrade(storable.Unversioned, storable.AppendBytes([]byte{byte(NoRejectionReason)}))
+
 // NewTransactionMetadata returns new TransactionMetadata for the given TransactionID.
 func NewTransactionMetadata(txID utxo.TransactionID) (metadata *TransactionMetadata) {
 	metadata = model.NewStorable[utxo.TransactionID, TransactionMetadata](&transactionMetadata{
@@ -191,11 +204,50 @@ func (t *TransactionMetadata) ConfirmationStateTime() time.Time {
 	return t.M.ConfirmationStateTime
 }
 
+// RejectionReason returns the reason why the Transaction was rejected (or NoRejectionReason if it was not rejected).
+func (t *TransactionMetadata) RejectionReason() RejectionReason {
+	t.RLock()
+	defer t.RUnlock()
+
+	return t.M.RejectionReason
+}
+
+// SetRejectionReason sets the reason why the Transaction was rejected.
+func (t *TransactionMetadata) SetRejectionReason(rejectionReason RejectionReason) (modified bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.M.RejectionReason == rejectionReason {
+		return false
+	}
+
+	t.M.RejectionReason = rejectionReason
+	t.SetModified()
+
+	return true
+}
+
 // IsConflicting returns true if the Transaction is conflicting with another Transaction (is a Conflict).
 func (t *TransactionMetadata) IsConflicting() bool {
 	return t.ConflictIDs().Is(t.ID())
 }
 
+// FromObjectStorage restores the TransactionMetadata from its versioned serialized form.
+func (t *TransactionMetadata) FromObjectStorage(key, value []byte) (err error) {
+	if err = transactionMetadataVersioning.Decode(value, func(bytes []byte) error {
+		return t.Storable.FromObjectStorage(key, bytes)
+	}); err != nil {
+		return errors.Wrap(err, "failed to decode TransactionMetadata")
+	}
+
+	return nil
+}
+
+// ObjectStorageValue returns the versioned serialized form of the TransactionMetadata.
+func (t *TransactionMetadata) ObjectStorageValue() (value []byte) {
+	return transactionMetadataVersioning.Encode(t.Storable.ObjectStorageValue())
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region OutputMetadata ///////////////////////////////////////////////////////////////////////////////////////////////
@@ -229,8 +281,16 @@ type outputMetadata struct {
 
 	// ConfirmationStateTime contains the last time the ConfirmationState was updated.
 	ConfirmationStateTime time.Time `serix:"7"`
+
+	// ConfirmedConsumer contains the Transaction that spent the Output and got accepted.
+	ConfirmedConsumer utxo.TransactionID `serix:"8"`
 }
 
+// outputMetadataVersioning upgrades the stored OutputMetadata written by older versions of the node:
+//   - version 1 added the ConfirmedConsumer to the unversioned records.
+var outputMetadataVersioning = storable.NewVersioning(1).
+	WithUpgrade(storable.Unversioned, storable.AppendBytes(make([]byte, types.IdentifierLength)))
+
 // NewOutputMetadata returns new OutputMetadata for the given OutputID.
 func NewOutputMetadata(outputID utxo.OutputID) (metadata *OutputMetadata) {
 	metadata = model.NewStorable[utxo.OutputID, OutputMetadata](&outputMetadata{
@@ -362,6 +422,47 @@ func (o *OutputMetadata) RegisterBookedConsumer(consumer utxo.TransactionID) (is
 	return true, o.M.FirstConsumer
 }
 
+// UnregisterBookedConsumer removes the given consumer if it is the first consumer of the Output (i.e. because its
+// booking was rolled back) and returns true if it was removed.
+func (o *OutputMetadata) UnregisterBookedConsumer(consumer utxo.TransactionID) (unregistered bool) {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.M.FirstConsumer != consumer {
+		return false
+	}
+
+	o.M.FirstConsumer = utxo.EmptyTransactionID
+	o.M.FirstConsumerForked = false
+	o.SetModified()
+
+	return true
+}
+
+// ConfirmedConsumer returns the Transaction that spent the Output and got accepted (or EmptyTransactionID if none of
+// its consumers got accepted yet).
+func (o *OutputMetadata) ConfirmedConsumer() utxo.TransactionID {
+	o.RLock()
+	defer o.RUnlock()
+
+	return o.M.ConfirmedConsumer
+}
+
+// SetConfirmedConsumer sets the Transaction that spent the Output and got accepted.
+func (o *OutputMetadata) SetConfirmedConsumer(consumer utxo.TransactionID) (updated bool) {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.M.ConfirmedConsumer == consumer {
+		return false
+	}
+
+	o.M.ConfirmedConsumer = consumer
+	o.SetModified()
+
+	return true
+}
+
 // ConfirmationState returns the confirmation state of the Output.
 func (o *OutputMetadata) ConfirmationState() confirmation.State {
 	o.RLock()
@@ -402,6 +503,22 @@ func (o *OutputMetadata) IsSpent() bool {
 	return o.M.FirstConsumer != utxo.EmptyTransactionID
 }
 
+// FromObjectStorage restores the OutputMetadata from its versioned serialized form.
+func (o *OutputMetadata) FromObjectStorage(key, value []byte) (err error) {
+	if err = outputMetadataVersioning.Decode(value, func(bytes []byte) error {
+		return o.Storable.FromObjectStorage(key, bytes)
+	}); err != nil {
+		return errors.Wrap(err, "failed to decode OutputMetadata")
+	}
+
+	return nil
+}
+
+// ObjectStorageValue returns the versioned serialized form of the OutputMetadata.
+func (o *OutputMetadata) ObjectStorageValue() (value []byte) {
+	return outputMetadataVersioning.Encode(o.Storable.ObjectStorageValue())
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region OutputsMetadata //////////////////////////////////////////////////////////////////////////////////////////////
@@ -545,3 +662,35 @@ func (c *Consumer) SetBooked() (updated bool) {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RejectionReason //////////////////////////////////////////////////////////////////////////////////////////////
+
+// RejectionReason is the reason why a Transaction (and the Blocks that attach it) got rejected.
+type RejectionReason uint8
+
+const (
+	// NoRejectionReason is the RejectionReason of Transactions that were not rejected.
+	NoRejectionReason RejectionReason = iota
+
+	// LosingConflict is the RejectionReason of Transactions whose own Conflict lost against a conflicting Conflict.
+	LosingConflict
+
+	// InvalidPastCone is the RejectionReason of Transactions that depend on a rejected Conflict in their past cone.
+	InvalidPastCone
+)
+
+// String returns a human-readable version of the RejectionReason.
+func (r RejectionReason) String() string {
+	switch r {
+	case NoRejectionReason:
+		return "NoRejectionReason"
+	case LosingConflict:
+		return "LosingConflict"
+	case InvalidPastCone:
+		return "InvalidPastCone"
+	default:
+		return fmt.Sprintf("RejectionReason(%d)", uint8(r))
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
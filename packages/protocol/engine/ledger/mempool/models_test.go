@@ -0,0 +1,75 @@
+package mempool
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+// baselineTransactionMetadata contains a TransactionMetadata (booked, rejected, with one conflict and one output) as it
+// was stored by the node before the model was versioned.
+var baselineTransactionMetadata = lo.PanicOnErr(hex.DecodeString("01000000423eea7cfee91f36bc370c368919e171dea80b92d0037f392f7d831a90ce000901edf2b8d9b0aade1807000000000000000100000058a72e720ef67dc31efbc40224e24b8770c8a928a04b6400273f838093209745000001d0f9b8d9b0aade18"))
+
+// baselineOutputMetadata contains an OutputMetadata (accepted, with one conflict and one consumer) as it was stored by
+// the node before the model was versioned.
+var baselineOutputMetadata = lo.PanicOnErr(hex.DecodeString("01000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000070000000000000001000000423eea7cfee91f36bc370c368919e171dea80b92d0037f392f7d831a90ce00099d4d37f0d40d5e41b5f008b6837e78eb8789f7feb076fcbbe3d9706802fd866900034dcfbad9b0aade18"))
+
+func TestTransactionMetadata_Versioning(t *testing.T) {
+	txID := utxo.NewTransactionID([]byte("tx"))
+
+	// the first byte of the unversioned record (the number of conflicts) equals the current version
+	legacyTxMetadata := new(TransactionMetadata)
+	require.NoError(t, legacyTxMetadata.FromObjectStorage(lo.PanicOnErr(txID.Bytes()), baselineTransactionMetadata))
+	require.Equal(t, txID, legacyTxMetadata.ID())
+	require.Equal(t, utxo.NewTransactionIDs(utxo.NewTransactionID([]byte("conflict"))), legacyTxMetadata.ConflictIDs())
+	require.True(t, legacyTxMetadata.IsBooked())
+	require.Equal(t, slot.Index(7), legacyTxMetadata.InclusionSlot())
+	require.Equal(t, utxo.NewOutputIDs(utxo.NewOutputID(txID, 0)), legacyTxMetadata.OutputIDs())
+	require.Equal(t, confirmation.Rejected, legacyTxMetadata.ConfirmationState())
+	require.Equal(t, NoRejectionReason, legacyTxMetadata.RejectionReason())
+
+	// upgraded records are stored in the current version
+	legacyTxMetadata.SetRejectionReason(LosingConflict)
+	value := legacyTxMetadata.ObjectStorageValue()
+	require.Equal(t, transactionMetadataVersioning.Version(), value[0])
+
+	restoredTxMetadata := new(TransactionMetadata)
+	require.NoError(t, restoredTxMetadata.FromObjectStorage(legacyTxMetadata.ObjectStorageKey(), value))
+	require.Equal(t, legacyTxMetadata.ConflictIDs(), restoredTxMetadata.ConflictIDs())
+	require.Equal(t, legacyTxMetadata.BookingTime(), restoredTxMetadata.BookingTime())
+	require.Equal(t, LosingConflict, restoredTxMetadata.RejectionReason())
+
+	require.Error(t, new(TransactionMetadata).FromObjectStorage(legacyTxMetadata.ObjectStorageKey(), append([]byte{2}, value[1:]...)))
+	require.Error(t, new(TransactionMetadata).FromObjectStorage(legacyTxMetadata.ObjectStorageKey(), baselineTransactionMetadata[:len(baselineTransactionMetadata)-1]))
+}
+
+func TestOutputMetadata_Versioning(t *testing.T) {
+	outputID := utxo.NewOutputID(utxo.NewTransactionID([]byte("tx")), 0)
+
+	legacyOutputMetadata := new(OutputMetadata)
+	require.NoError(t, legacyOutputMetadata.FromObjectStorage(lo.PanicOnErr(outputID.Bytes()), baselineOutputMetadata))
+	require.Equal(t, outputID, legacyOutputMetadata.ID())
+	require.Equal(t, identity.ID{1}, legacyOutputMetadata.ConsensusManaPledgeID())
+	require.Equal(t, identity.ID{2}, legacyOutputMetadata.AccessManaPledgeID())
+	require.Equal(t, slot.Index(7), legacyOutputMetadata.InclusionSlot())
+	require.Equal(t, utxo.NewTransactionIDs(utxo.NewTransactionID([]byte("conflict"))), legacyOutputMetadata.ConflictIDs())
+	require.Equal(t, utxo.NewTransactionID([]byte("consumer")), legacyOutputMetadata.FirstConsumer())
+	require.Equal(t, confirmation.Accepted, legacyOutputMetadata.ConfirmationState())
+	require.Equal(t, utxo.EmptyTransactionID, legacyOutputMetadata.ConfirmedConsumer())
+
+	legacyOutputMetadata.SetConfirmedConsumer(utxo.NewTransactionID([]byte("consumer")))
+	value := legacyOutputMetadata.ObjectStorageValue()
+	require.Equal(t, outputMetadataVersioning.Version(), value[0])
+
+	restoredOutputMetadata := new(OutputMetadata)
+	require.NoError(t, restoredOutputMetadata.FromObjectStorage(legacyOutputMetadata.ObjectStorageKey(), value))
+	require.Equal(t, legacyOutputMetadata.ConflictIDs(), restoredOutputMetadata.ConflictIDs())
+	require.Equal(t, utxo.NewTransactionID([]byte("consumer")), restoredOutputMetadata.ConfirmedConsumer())
+}
@@ -126,6 +126,23 @@ func (o *OutputWithMetadata) IOTABalance() (balance uint64, exists bool) {
 	}
 }
 
+// Balances returns the balances of the Output indexed by their color (empty for outputs of VMs without colored
+// balances).
+// TODO: don't make the ledger depend on devnetvm
+func (o *OutputWithMetadata) Balances() (balances map[devnetvm.Color]uint64) {
+	o.RLock()
+	defer o.RUnlock()
+
+	switch output := o.M.Output.(type) {
+	case devnetvm.Output:
+		return output.Balances().Map()
+	case *mockedvm.MockedOutput:
+		return map[devnetvm.Color]uint64{devnetvm.ColorIOTA: output.M.Balance}
+	default:
+		return make(map[devnetvm.Color]uint64)
+	}
+}
+
 // SetOutput sets the Output field.
 func (o *OutputWithMetadata) SetOutput(output utxo.Output) {
 	o.Lock()
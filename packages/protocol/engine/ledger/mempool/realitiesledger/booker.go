@@ -52,14 +52,27 @@ func (b *booker) checkAlreadyBookedCommand(params *dataFlowParams, next dataflow
 
 // bookTransactionCommand is a ChainedCommand that books a Transaction.
 func (b *booker) bookTransactionCommand(params *dataFlowParams, next dataflow.Next[*dataFlowParams]) (err error) {
-	b.bookTransaction(params.Context, params.Transaction, params.TransactionMetadata, params.InputsMetadata, params.Consumers, params.Outputs)
+	// transactions that were unsolid when they were stored get booked without their consumers being loaded
+	if params.Consumers == nil {
+		cachedConsumers := b.ledger.storage.initConsumers(params.InputIDs, params.Transaction.ID())
+		defer cachedConsumers.Release()
+		params.Consumers = cachedConsumers.Unwrap(true)
+	}
+
+	b.bookTransaction(params)
+
+	if invariantChecksEnabled {
+		b.assertInvariants(params)
+	}
 
 	return next(params)
 }
 
 // bookTransaction books a Transaction in the RealitiesLedger and creates its Outputs.
-func (b *booker) bookTransaction(ctx context.Context, tx utxo.Transaction, txMetadata *mempool.TransactionMetadata, inputsMetadata *mempool.OutputsMetadata, consumers []*mempool.Consumer, outputs *utxo.Outputs) {
-	conflictIDs := b.inheritConflictIDs(ctx, txMetadata.ID(), inputsMetadata)
+func (b *booker) bookTransaction(params *dataFlowParams) {
+	tx, txMetadata, outputs := params.Transaction, params.TransactionMetadata, params.Outputs
+
+	conflictIDs := b.inheritConflictIDs(params.Context, txMetadata.ID(), params.InputsMetadata)
 
 	txMetadata.SetConflictIDs(conflictIDs)
 	txMetadata.SetOutputIDs(outputs.IDs())
@@ -70,7 +83,7 @@ func (b *booker) bookTransaction(ctx context.Context, tx utxo.Transaction, txMet
 		accessPledgeID = devnetTx.Essence().AccessPledgeID()
 	}
 
-	b.storeOutputs(outputs, conflictIDs, consensusPledgeID, accessPledgeID)
+	b.storeOutputs(params, conflictIDs, consensusPledgeID, accessPledgeID)
 
 	if b.ledger.conflictDAG.ConfirmationState(conflictIDs).IsRejected() {
 		b.ledger.triggerRejectedEvent(txMetadata)
@@ -78,12 +91,14 @@ func (b *booker) bookTransaction(ctx context.Context, tx utxo.Transaction, txMet
 
 	txMetadata.SetBooked(true)
 
-	lo.ForEach(consumers, func(consumer *mempool.Consumer) { consumer.SetBooked() })
+	lo.ForEach(params.Consumers, func(consumer *mempool.Consumer) { consumer.SetBooked() })
 
-	b.ledger.Events().TransactionBooked.Trigger(&mempool.TransactionBookedEvent{
-		TransactionID: txMetadata.ID(),
-		Outputs:       outputs,
-		Context:       ctx,
+	params.triggerEvent(func() {
+		b.ledger.Events().TransactionBooked.Trigger(&mempool.TransactionBookedEvent{
+			TransactionID: txMetadata.ID(),
+			Outputs:       outputs,
+			Context:       params.Context,
+		})
 	})
 }
 
@@ -109,16 +124,17 @@ func (b *booker) inheritConflictIDs(ctx context.Context, txID utxo.TransactionID
 }
 
 // storeOutputs stores the Outputs in the RealitiesLedger.
-func (b *booker) storeOutputs(outputs *utxo.Outputs, conflictIDs *advancedset.AdvancedSet[utxo.TransactionID], consensusPledgeID, accessPledgeID identity.ID) {
-	_ = outputs.ForEach(func(output utxo.Output) (err error) {
+func (b *booker) storeOutputs(params *dataFlowParams, conflictIDs *advancedset.AdvancedSet[utxo.TransactionID], consensusPledgeID, accessPledgeID identity.ID) {
+	_ = params.Outputs.ForEach(func(output utxo.Output) (err error) {
 		outputMetadata := mempool.NewOutputMetadata(output.ID())
 		outputMetadata.SetConflictIDs(conflictIDs)
 		outputMetadata.SetAccessManaPledgeID(accessPledgeID)
 		outputMetadata.SetConsensusManaPledgeID(consensusPledgeID)
-		b.ledger.Storage().OutputMetadataStorage().Store(outputMetadata).Release()
-		b.ledger.Storage().OutputStorage().Store(output).Release()
+		b.ledger.storage.storeOutput(output, outputMetadata)
 
-		b.ledger.Events().OutputCreated.Trigger(output.ID())
+		params.triggerEvent(func() {
+			b.ledger.Events().OutputCreated.Trigger(output.ID())
+		})
 
 		return nil
 	})
@@ -221,3 +237,44 @@ func (b *booker) updateConflictsAfterFork(ctx context.Context, txMetadata *mempo
 
 	return true
 }
+
+// mergeConflictToMaster removes the given merged Conflict from the ConflictIDs of the Transactions (and their Outputs)
+// that were booked into it, so that they belong to the master branch.
+func (b *booker) mergeConflictToMaster(mergedConflictID utxo.TransactionID) {
+	b.ledger.storage.CachedTransactionMetadata(mergedConflictID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		if !b.removeMergedConflict(txMetadata, mergedConflictID) {
+			return
+		}
+
+		b.ledger.Utils().WalkConsumingTransactionMetadata(txMetadata.OutputIDs(), func(consumingTxMetadata *mempool.TransactionMetadata, walker *walker.Walker[utxo.OutputID]) {
+			if b.removeMergedConflict(consumingTxMetadata, mergedConflictID) {
+				walker.PushAll(consumingTxMetadata.OutputIDs().Slice()...)
+			}
+		})
+	})
+}
+
+// removeMergedConflict removes the given merged Conflict from the ConflictIDs of the given Transaction and its Outputs.
+func (b *booker) removeMergedConflict(txMetadata *mempool.TransactionMetadata, mergedConflictID utxo.TransactionID) (updated bool) {
+	b.ledger.mutex.Lock(txMetadata.ID())
+	defer b.ledger.mutex.Unlock(txMetadata.ID())
+
+	conflictIDs := txMetadata.ConflictIDs()
+	if !conflictIDs.Delete(mergedConflictID) {
+		return false
+	}
+
+	b.ledger.Storage().CachedOutputsMetadata(txMetadata.OutputIDs()).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		outputMetadata.SetConflictIDs(conflictIDs)
+	})
+
+	txMetadata.SetConflictIDs(conflictIDs)
+
+	b.ledger.Events().TransactionConflictIDsMerged.Trigger(&mempool.TransactionConflictIDsMergedEvent{
+		TransactionID:    txMetadata.ID(),
+		MergedConflictID: mergedConflictID,
+		ConflictIDs:      conflictIDs,
+	})
+
+	return true
+}
@@ -0,0 +1,245 @@
+package realitiesledger
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+)
+
+// region bundle ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// bundle is a set of interdependent Transactions that is booked atomically (either all of its members are booked or
+// none of them).
+type bundle struct {
+	// ledger contains a reference to the RealitiesLedger that books the bundle.
+	ledger *RealitiesLedger
+
+	// members contains the Transactions of the bundle in the order in which they have to be booked.
+	members []utxo.Transaction
+
+	// memberIDs contains the identifiers of the members of the bundle.
+	memberIDs utxo.TransactionIDs
+
+	// createdOutputs contains the Outputs that are created by the members of the bundle.
+	createdOutputs map[utxo.OutputID]utxo.Output
+
+	// ancestorInputs contains the stored Outputs that are (directly or indirectly) spent to create an Output of the bundle.
+	ancestorInputs map[utxo.OutputID]utxo.OutputIDs
+}
+
+// newBundle returns a new bundle for the given Transactions (it orders the members by their dependencies).
+func newBundle(ledger *RealitiesLedger, txs []utxo.Transaction) (newBundle *bundle, err error) {
+	newBundle = &bundle{
+		ledger:         ledger,
+		memberIDs:      utxo.NewTransactionIDs(),
+		createdOutputs: make(map[utxo.OutputID]utxo.Output),
+		ancestorInputs: make(map[utxo.OutputID]utxo.OutputIDs),
+	}
+
+	txsByID := make(map[utxo.TransactionID]utxo.Transaction)
+	for _, tx := range txs {
+		if !newBundle.memberIDs.Add(tx.ID()) {
+			return nil, errors.WithMessagef(mempool.ErrBundleInvalid, "%s is contained more than once", tx.ID())
+		}
+		txsByID[tx.ID()] = tx
+	}
+
+	visited := make(map[utxo.TransactionID]bool)
+	var visit func(tx utxo.Transaction) (err error)
+	visit = func(tx utxo.Transaction) (err error) {
+		if done, seen := visited[tx.ID()]; seen {
+			if !done {
+				return errors.WithMessagef(mempool.ErrBundleInvalid, "%s is part of a dependency cycle", tx.ID())
+			}
+
+			return nil
+		}
+		visited[tx.ID()] = false
+
+		for it := ledger.utils.ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
+			if dependency, isMember := txsByID[it.Next().TransactionID]; isMember {
+				if err = visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+
+		visited[tx.ID()] = true
+		newBundle.members = append(newBundle.members, tx)
+
+		return nil
+	}
+
+	for _, tx := range txs {
+		if err = visit(tx); err != nil {
+			return nil, err
+		}
+	}
+
+	return newBundle, nil
+}
+
+// check checks that all members of the bundle can be booked, without modifying the state of the RealitiesLedger.
+func (b *bundle) check() (err error) {
+	spentOutputIDs := utxo.NewOutputIDs()
+	for _, member := range b.members {
+		if b.isBooked(member.ID()) {
+			continue
+		}
+
+		inputIDs := b.ledger.utils.ResolveInputs(member.Inputs())
+		for it := inputIDs.Iterator(); it.HasNext(); {
+			if inputID := it.Next(); !spentOutputIDs.Add(inputID) {
+				return errors.WithMessagef(vm.ErrInputsAlreadySpent, "%s double spends %s within the bundle", member.ID(), inputID)
+			}
+		}
+
+		if err = b.checkMember(member, inputIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkMember checks that the given member of the bundle can be booked and adds its Outputs to the createdOutputs.
+func (b *bundle) checkMember(member utxo.Transaction, inputIDs utxo.OutputIDs) (err error) {
+	inputs := utxo.NewOutputs()
+	ancestorInputs := utxo.NewOutputIDs()
+	for it := inputIDs.Iterator(); it.HasNext(); {
+		inputID := it.Next()
+
+		if output, created := b.createdOutputs[inputID]; created {
+			inputs.Add(output)
+			ancestorInputs.AddAll(b.ancestorInputs[inputID])
+		} else if !b.ledger.storage.CachedOutput(inputID).Consume(func(output utxo.Output) {
+			inputs.Add(output)
+			ancestorInputs.Add(inputID)
+		}) {
+			return errors.WithMessagef(mempool.ErrTransactionUnsolid, "input %s of %s is not available", inputID, member.ID())
+		}
+	}
+
+	cachedAncestorsMetadata := b.ledger.storage.CachedOutputsMetadata(ancestorInputs)
+	defer cachedAncestorsMetadata.Release()
+
+	ancestorsMetadata := mempool.NewOutputsMetadata(cachedAncestorsMetadata.Unwrap(true)...)
+	if ancestorsMetadata.Size() != len(cachedAncestorsMetadata) {
+		return errors.WithMessagef(mempool.ErrTransactionUnsolid, "failed to retrieve the metadata of all inputs of %s", member.ID())
+	} else if b.ledger.validator.outputsCausallyRelated(ancestorsMetadata) {
+		return errors.WithMessagef(vm.ErrInputsCausallyRelated, "%s is trying to spend causally related Outputs", member.ID())
+	}
+
+	outputs, _, err := b.ledger.executeTransaction(member, inputs, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to execute transaction with %s", member.ID())
+	}
+
+	for _, output := range outputs {
+		b.createdOutputs[output.ID()] = output
+		b.ancestorInputs[output.ID()] = ancestorInputs
+	}
+
+	return nil
+}
+
+// lock locks the members of the bundle in the mutex of the RealitiesLedger (in a fixed order, so that bundles that
+// share members can not deadlock), so that no other booking of a member can interleave with the check and the book.
+func (b *bundle) lock() {
+	for _, memberID := range b.sortedMemberIDs() {
+		b.ledger.mutex.Lock(memberID)
+	}
+}
+
+// unlock unlocks the members of the bundle in the mutex of the RealitiesLedger.
+func (b *bundle) unlock() {
+	for _, memberID := range b.sortedMemberIDs() {
+		b.ledger.mutex.Unlock(memberID)
+	}
+}
+
+// sortedMemberIDs returns the identifiers of the members of the bundle in the order of their bytes.
+func (b *bundle) sortedMemberIDs() (sortedMemberIDs []utxo.TransactionID) {
+	sortedMemberIDs = b.memberIDs.Slice()
+	sort.Slice(sortedMemberIDs, func(i, j int) bool {
+		return bytes.Compare(sortedMemberIDs[i].Identifier[:], sortedMemberIDs[j].Identifier[:]) < 0
+	})
+
+	return sortedMemberIDs
+}
+
+// book stores and processes the members of the bundle (while they are locked). The events of the members are buffered
+// until all members were booked and the members that were stored by the bundle are discarded again if one of them
+// could not be booked (i.e. because an input was pruned after the bundle was checked).
+func (b *bundle) book(ctx context.Context) (err error) {
+	memberEvents := new(eventBuffer)
+
+	storedMembers := make([]utxo.TransactionID, 0, len(b.members))
+	for _, member := range b.members {
+		params := newDataFlowParams(ctx, member)
+		params.EventBuffer = memberEvents
+
+		if b.ledger.storage.CachedTransactionMetadata(member.ID()).Consume(func(*mempool.TransactionMetadata) {}) {
+			err = b.ledger.dataFlow.processTransaction().Run(params)
+		} else {
+			storedMembers = append(storedMembers, member.ID())
+			err = b.ledger.dataFlow.storeAndProcessTransaction().Run(params)
+		}
+
+		if err == nil && !b.isBooked(member.ID()) {
+			err = errors.WithMessagef(mempool.ErrTransactionUnsolid, "%s could not be booked", member.ID())
+		}
+
+		if err != nil {
+			for i := len(storedMembers) - 1; i >= 0; i-- {
+				b.ledger.storage.discardTransaction(storedMembers[i])
+			}
+
+			return errors.Wrapf(err, "failed to book %s", member.ID())
+		}
+	}
+
+	memberEvents.trigger()
+
+	return nil
+}
+
+// isBooked returns true if the Transaction with the given ID is already booked.
+func (b *bundle) isBooked(txID utxo.TransactionID) (booked bool) {
+	b.ledger.storage.CachedTransactionMetadata(txID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		booked = txMetadata.IsBooked()
+	})
+
+	return booked
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region eventBuffer //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// eventBuffer collects the events of the members of a bundle, so that they are only triggered once the whole bundle was
+// booked.
+type eventBuffer struct {
+	// events contains the functions that trigger the buffered events in the order in which they were buffered.
+	events []func()
+}
+
+// add adds the function that triggers an event to the eventBuffer.
+func (e *eventBuffer) add(triggerEvent func()) {
+	e.events = append(e.events, triggerEvent)
+}
+
+// trigger triggers the buffered events.
+func (e *eventBuffer) trigger() {
+	for _, triggerEvent := range e.events {
+		triggerEvent()
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
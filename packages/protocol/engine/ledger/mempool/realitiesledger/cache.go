@@ -0,0 +1,193 @@
+package realitiesledger
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/hive.go/objectstorage/generic"
+)
+
+// region cacheOptions /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// cacheOptions contains the cache configuration of a single object storage of the RealitiesLedger.
+type cacheOptions struct {
+	// cacheTime contains the duration that objects stay cached after they have been released.
+	cacheTime time.Duration
+
+	// cacheTimeOverridden is true if the cacheTime was configured explicitly (it then takes precedence over the
+	// CacheTimeProvider).
+	cacheTimeOverridden bool
+
+	// maxSize contains the maximum number of released objects that stay cached (0 means unlimited).
+	maxSize int
+}
+
+// effectiveCacheTime returns the cache time of the storage after applying the given CacheTimeProvider.
+func (c *cacheOptions) effectiveCacheTime(cacheTimeProvider *database.CacheTimeProvider) time.Duration {
+	if c.cacheTimeOverridden {
+		return c.cacheTime
+	}
+
+	return cacheTimeProvider.Duration(c.cacheTime)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region adjustableCache //////////////////////////////////////////////////////////////////////////////////////////////
+
+// adjustableCache is the type independent interface of a retentionCache that is used to adjust its settings.
+type adjustableCache interface {
+	setCacheTime(cacheTime time.Duration)
+	setMaxSize(maxSize int)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region retentionCache ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// retentionCache retains the most recently used objects of an object storage for the configured cache time while
+// evicting the least recently used ones once more than maxSize objects are cached. The object storages themselves
+// release their objects right away, so that the cache time and the size can be adjusted while the node is running.
+type retentionCache[T generic.StorableObject] struct {
+	cacheTime time.Duration
+	maxSize   int
+	entries   map[string]*list.Element
+	lru       *list.List
+	mutex     sync.Mutex
+
+	// statistics counts the lookups and the lookups that found their object retained by the cache.
+	statistics mempool.CacheStatisticsCounter
+}
+
+// retentionCacheEntry is an object that is retained by the retentionCache.
+type retentionCacheEntry[T generic.StorableObject] struct {
+	key          string
+	cachedObject *generic.CachedObject[T]
+	lastUsed     time.Time
+}
+
+// newRetentionCache returns a new retentionCache for the given options.
+func newRetentionCache[T generic.StorableObject](opts cacheOptions, cacheTimeProvider *database.CacheTimeProvider) *retentionCache[T] {
+	return &retentionCache[T]{
+		cacheTime: opts.effectiveCacheTime(cacheTimeProvider),
+		maxSize:   opts.maxSize,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+}
+
+// lookup marks the given CachedObject as recently used and returns it. In contrast to track, it records the access as
+// a lookup of the cache (that was a hit if the object was still retained).
+func (r *retentionCache[T]) lookup(cachedObject *generic.CachedObject[T]) *generic.CachedObject[T] {
+	r.statistics.Record(r.retain(cachedObject))
+
+	return cachedObject
+}
+
+// track marks the given CachedObject as recently used and returns it.
+func (r *retentionCache[T]) track(cachedObject *generic.CachedObject[T]) *generic.CachedObject[T] {
+	r.retain(cachedObject)
+
+	return cachedObject
+}
+
+// retain marks the given CachedObject as recently used and returns true if it was already retained by the cache.
+func (r *retentionCache[T]) retain(cachedObject *generic.CachedObject[T]) (retained bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	r.evictExpired(now)
+
+	if r.cacheTime <= 0 {
+		return false
+	}
+
+	key := string(cachedObject.Key())
+	if element, exists := r.entries[key]; exists {
+		element.Value.(*retentionCacheEntry[T]).lastUsed = now
+		r.lru.MoveToFront(element)
+
+		return true
+	}
+
+	r.entries[key] = r.lru.PushFront(&retentionCacheEntry[T]{
+		key:          key,
+		cachedObject: cachedObject.Retain(),
+		lastUsed:     now,
+	})
+	r.evictExceeding()
+
+	return false
+}
+
+// settings returns the current cache time and maximum size of the cache.
+func (r *retentionCache[T]) settings() (cacheTime time.Duration, maxSize int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.cacheTime, r.maxSize
+}
+
+// setCacheTime updates the cache time (it also applies to the objects that are already retained).
+func (r *retentionCache[T]) setCacheTime(cacheTime time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cacheTime = cacheTime
+	r.evictExpired(time.Now())
+}
+
+// setMaxSize updates the maximum number of retained objects (0 means unlimited).
+func (r *retentionCache[T]) setMaxSize(maxSize int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.maxSize = maxSize
+	r.evictExceeding()
+}
+
+// size returns the number of objects that are currently retained by the cache.
+func (r *retentionCache[T]) size() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.lru.Len()
+}
+
+// releaseAll releases all retained objects (it needs to be called before the object storage is shut down or pruned).
+func (r *retentionCache[T]) releaseAll() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for r.lru.Len() > 0 {
+		r.evict(r.lru.Back())
+	}
+}
+
+// evictExpired releases the objects whose cache time has passed.
+func (r *retentionCache[T]) evictExpired(now time.Time) {
+	for element := r.lru.Back(); element != nil && now.Sub(element.Value.(*retentionCacheEntry[T]).lastUsed) >= r.cacheTime; element = r.lru.Back() {
+		r.evict(element)
+	}
+}
+
+// evictExceeding releases the least recently used objects that exceed the maximum size.
+func (r *retentionCache[T]) evictExceeding() {
+	for r.maxSize > 0 && r.lru.Len() > r.maxSize {
+		r.evict(r.lru.Back())
+	}
+}
+
+// evict releases the object of the given element.
+func (r *retentionCache[T]) evict(element *list.Element) {
+	entry := r.lru.Remove(element).(*retentionCacheEntry[T])
+	delete(r.entries, entry.key)
+
+	entry.cachedObject.Release()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -7,6 +7,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/hive.go/core/dataflow"
 )
 
@@ -45,6 +46,7 @@ func (d *dataFlow) processTransaction() (dataFlow *dataflow.DataFlow[*dataFlowPa
 // checkTransaction returns a DataFlow that checks the validity of a Transaction.
 func (d *dataFlow) checkTransaction() (dataFlow *dataflow.DataFlow[*dataFlowParams]) {
 	return dataflow.New(
+		d.ledger.validator.traceTransactionCommand,
 		d.ledger.validator.checkSolidityCommand,
 		d.ledger.validator.checkOutputsCausallyRelatedCommand,
 		d.ledger.validator.checkTransactionExecutionCommand,
@@ -53,7 +55,8 @@ func (d *dataFlow) checkTransaction() (dataFlow *dataflow.DataFlow[*dataFlowPara
 
 // handleError handles any kind of error that is encountered while processing the DataFlows.
 func (d *dataFlow) handleError(err error, params *dataFlowParams) {
-	if errors.Is(err, mempool.ErrTransactionUnsolid) {
+	// the errors of the members of a bundle are returned to the caller (that rolls back the whole bundle)
+	if errors.Is(err, mempool.ErrTransactionUnsolid) || params.EventBuffer != nil {
 		return
 	}
 
@@ -99,6 +102,16 @@ type dataFlowParams struct {
 
 	// Outputs contains the Outputs that were created by the Transaction.
 	Outputs *utxo.Outputs
+
+	// Cost contains the resources that were consumed by the execution of the Transaction.
+	Cost vm.Cost
+
+	// Trace contains the Trace that records the validation steps of the Transaction (nil if it is not traced).
+	Trace *vm.Trace
+
+	// EventBuffer buffers the events of a Transaction that is booked as a member of a bundle (nil if the events are
+	// triggered right away).
+	EventBuffer *eventBuffer
 }
 
 // newDataFlowParams returns a new dataFlowParams instance for the given Transaction.
@@ -110,4 +123,15 @@ func newDataFlowParams(ctx context.Context, tx utxo.Transaction) *dataFlowParams
 	}
 }
 
+// triggerEvent triggers the event of the Transaction or adds it to the EventBuffer if the Transaction is booked as a
+// member of a bundle.
+func (d *dataFlowParams) triggerEvent(triggerEvent func()) {
+	if d.EventBuffer == nil {
+		triggerEvent()
+		return
+	}
+
+	d.EventBuffer.add(triggerEvent)
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,182 @@
+package realitiesledger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+// ErrInvariantViolated is returned if the state of the RealitiesLedger is inconsistent after booking a Transaction.
+var ErrInvariantViolated = errors.New("ledger invariant violated")
+
+// assertInvariants validates the booking invariants of the given (freshly booked) Transaction and panics with a dump of
+// the involved entities if one of them is violated. It is only called if the node is built with the "ledgerinvariants"
+// build tag.
+func (b *booker) assertInvariants(params *dataFlowParams) {
+	if err := b.checkInvariants(params.Transaction, params.Inputs); err != nil {
+		panic(b.invariantViolationDump(params.Transaction, err))
+	}
+}
+
+// checkInvariants checks that the given booked Transaction conserves the balances of its inputs, that its outputs are
+// booked into the same conflicts as the Transaction itself and that the consumers of its inputs are consistent with
+// its conflict status.
+func (b *booker) checkInvariants(tx utxo.Transaction, inputs *utxo.Outputs) (err error) {
+	if err = b.checkBalanceConservation(tx, inputs); err != nil {
+		return err
+	}
+
+	var txMetadata *mempool.TransactionMetadata
+	if !b.ledger.storage.CachedTransactionMetadata(tx.ID()).Consume(func(metadata *mempool.TransactionMetadata) {
+		txMetadata = metadata
+	}) {
+		return errors.WithMessagef(ErrInvariantViolated, "failed to load metadata of booked %s", tx.ID())
+	}
+
+	if !txMetadata.IsBooked() {
+		return errors.WithMessagef(ErrInvariantViolated, "%s is not marked as booked", tx.ID())
+	}
+
+	if err = b.checkConflictConsistency(txMetadata); err != nil {
+		return err
+	}
+
+	return b.checkConsumers(tx, txMetadata)
+}
+
+// checkBalanceConservation checks that the outputs of a devnetvm.Transaction hold the same amount of tokens as its
+// inputs (other VMs do not necessarily follow the same balance semantics).
+func (b *booker) checkBalanceConservation(tx utxo.Transaction, inputs *utxo.Outputs) (err error) {
+	devnetTx, isDevnetTx := tx.(*devnetvm.Transaction)
+	if !isDevnetTx || inputs == nil {
+		return nil
+	}
+
+	var inputBalance uint64
+	_ = inputs.ForEach(func(input utxo.Output) error {
+		inputBalance += totalBalance(input.(devnetvm.Output))
+		return nil
+	})
+
+	var outputBalance uint64
+	for _, output := range devnetTx.Essence().Outputs() {
+		outputBalance += totalBalance(output)
+	}
+
+	if inputBalance != outputBalance {
+		return errors.WithMessagef(ErrInvariantViolated, "%s consumes %d tokens but creates %d tokens", tx.ID(), inputBalance, outputBalance)
+	}
+
+	return nil
+}
+
+// checkConflictConsistency checks that all outputs of the Transaction are booked into the same conflicts as the
+// Transaction and that the conflict of a conflicting Transaction exists in the conflictDAG.
+func (b *booker) checkConflictConsistency(txMetadata *mempool.TransactionMetadata) (err error) {
+	conflictIDs := txMetadata.ConflictIDs()
+
+	if conflictIDs.Has(txMetadata.ID()) {
+		if _, exists := b.ledger.conflictDAG.Conflict(txMetadata.ID()); !exists {
+			return errors.WithMessagef(ErrInvariantViolated, "%s is booked into its own conflict which does not exist in the ConflictDAG", txMetadata.ID())
+		}
+	}
+
+	for it := txMetadata.OutputIDs().Iterator(); it.HasNext(); {
+		outputID := it.Next()
+
+		var outputConflictIDs utxo.TransactionIDs
+		if !b.ledger.storage.CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			outputConflictIDs = outputMetadata.ConflictIDs()
+		}) {
+			return errors.WithMessagef(ErrInvariantViolated, "failed to load metadata of %s created by %s", outputID, txMetadata.ID())
+		}
+
+		if !outputConflictIDs.Equal(conflictIDs) {
+			return errors.WithMessagef(ErrInvariantViolated, "%s is booked into %s but its %s is booked into %s", txMetadata.ID(), conflictIDs, outputID, outputConflictIDs)
+		}
+	}
+
+	return nil
+}
+
+// checkConsumers checks that all inputs of the Transaction are spent, that the Transaction is a booked consumer of all
+// of them and that the Transaction is conflicting if one of its inputs has more than one booked consumer.
+func (b *booker) checkConsumers(tx utxo.Transaction, txMetadata *mempool.TransactionMetadata) (err error) {
+	for _, input := range tx.Inputs() {
+		inputID := b.ledger.optsVM.ResolveInput(input)
+
+		isSpent := false
+		b.ledger.storage.CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			isSpent = outputMetadata.IsSpent()
+		})
+		if !isSpent {
+			return errors.WithMessagef(ErrInvariantViolated, "%s is consumed by booked %s but is not marked as spent", inputID, tx.ID())
+		}
+
+		bookedConsumers, isBookedConsumer := 0, false
+		b.ledger.storage.CachedConsumers(inputID).Consume(func(consumer *mempool.Consumer) {
+			if !consumer.IsBooked() {
+				return
+			}
+
+			bookedConsumers++
+			if consumer.TransactionID() == tx.ID() {
+				isBookedConsumer = true
+			}
+		})
+
+		if !isBookedConsumer {
+			return errors.WithMessagef(ErrInvariantViolated, "%s is not registered as a booked consumer of %s", tx.ID(), inputID)
+		}
+
+		if bookedConsumers > 1 && !txMetadata.ConflictIDs().Has(tx.ID()) {
+			return errors.WithMessagef(ErrInvariantViolated, "%s has %d booked consumers but %s is not booked into its own conflict", inputID, bookedConsumers, tx.ID())
+		}
+	}
+
+	return nil
+}
+
+// invariantViolationDump returns a human-readable dump of the given Transaction and all related entities.
+func (b *booker) invariantViolationDump(tx utxo.Transaction, err error) string {
+	var dump strings.Builder
+	dump.WriteString(fmt.Sprintf("%s\n\nTransaction:\n%s\n", err, tx))
+
+	b.ledger.storage.CachedTransactionMetadata(tx.ID()).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		dump.WriteString(fmt.Sprintf("\nTransactionMetadata:\n%s\n", txMetadata))
+
+		for it := txMetadata.OutputIDs().Iterator(); it.HasNext(); {
+			b.ledger.storage.CachedOutputMetadata(it.Next()).Consume(func(outputMetadata *mempool.OutputMetadata) {
+				dump.WriteString(fmt.Sprintf("\nOutputMetadata:\n%s\n", outputMetadata))
+			})
+		}
+	})
+
+	for _, input := range tx.Inputs() {
+		inputID := b.ledger.optsVM.ResolveInput(input)
+
+		b.ledger.storage.CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			dump.WriteString(fmt.Sprintf("\nInputMetadata:\n%s\n", outputMetadata))
+		})
+		b.ledger.storage.CachedConsumers(inputID).Consume(func(consumer *mempool.Consumer) {
+			dump.WriteString(fmt.Sprintf("\nConsumer(%s -> %s, booked: %t)\n", consumer.ConsumedInput(), consumer.TransactionID(), consumer.IsBooked()))
+		})
+	}
+
+	return dump.String()
+}
+
+// totalBalance returns the sum of the balances of all colors of the given Output.
+func totalBalance(output devnetvm.Output) (balance uint64) {
+	output.Balances().ForEach(func(_ devnetvm.Color, colorBalance uint64) bool {
+		balance += colorBalance
+		return true
+	})
+
+	return balance
+}
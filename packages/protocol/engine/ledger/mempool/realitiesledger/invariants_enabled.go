@@ -0,0 +1,7 @@
+//go:build ledgerinvariants
+
+package realitiesledger
+
+// invariantChecksEnabled is true if the node was built with the "ledgerinvariants" build tag, which makes the
+// RealitiesLedger validate its booking invariants after every booked Transaction.
+const invariantChecksEnabled = true
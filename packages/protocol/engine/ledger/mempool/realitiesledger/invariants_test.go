@@ -0,0 +1,42 @@
+package realitiesledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func TestInvariants(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+	ledger := tf.Instance.(*RealitiesLedger)
+
+	tf.CreateTransaction("G", 2, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX1*", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "TX1.0", "G.1")
+
+	// TX2 is booked after its inputs become solid
+	require.ErrorIs(t, tf.IssueTransactions("TX2"), mempool.ErrTransactionUnsolid)
+	require.NoError(t, tf.IssueTransactions("G", "TX1", "TX1*"))
+	require.Eventually(t, func() bool { return tf.AllBooked("TX2") }, 5*time.Second, 10*time.Millisecond)
+
+	for _, txAlias := range []string{"G", "TX1", "TX1*", "TX2"} {
+		require.NoError(t, ledger.booker.checkInvariants(tf.Transaction(txAlias), nil), "invariants of %s are violated", txAlias)
+	}
+
+	// corrupt the conflicts of an output
+	tf.ConsumeOutputMetadata(tf.OutputID("TX2.0"), func(outputMetadata *mempool.OutputMetadata) {
+		outputMetadata.SetConflictIDs(advancedset.New[utxo.TransactionID]())
+	})
+	require.ErrorIs(t, ledger.booker.checkInvariants(tf.Transaction("TX2"), nil), ErrInvariantViolated)
+	require.Panics(t, func() {
+		ledger.booker.assertInvariants(&dataFlowParams{Transaction: tf.Transaction("TX2")})
+	})
+}
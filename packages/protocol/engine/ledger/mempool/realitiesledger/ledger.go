@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/core/module"
@@ -13,6 +15,8 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/goshimmer/packages/storage"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/walker"
@@ -44,6 +48,10 @@ type RealitiesLedger struct {
 
 	workerPool *workerpool.WorkerPool
 
+	// detachEngineEvents detaches the handlers of the engine events that are processed by the workerPool (it needs to be
+	// called before the workerPool is shut down).
+	detachEngineEvents func()
+
 	// dataFlow is a RealitiesLedger component that defines the data flow (how the different commands are chained together)
 	dataFlow *dataFlow
 
@@ -53,29 +61,57 @@ type RealitiesLedger struct {
 	// booker is a RealitiesLedger component that bundles the booking related API.
 	booker *booker
 
+	// traces is a RealitiesLedger component that retains the execution Traces of the recently processed Transactions.
+	traces *traces
+
+	// attachments is an index of the blocks that contain the Transactions of this RealitiesLedger.
+	attachments *mempool.Attachments
+
 	// optsVM contains the virtual machine that is used to execute Transactions.
 	optsVM vm.VM
 
+	// optsVMProvider contains the function that creates the virtual machine for the engine that owns the RealitiesLedger.
+	optsVMProvider func(e *engine.Engine) vm.VM
+
+	// slotTimeProvider contains the function that provides the slot timing of the engine that owns the RealitiesLedger.
+	slotTimeProvider func() *slot.TimeProvider
+
+	// conflictWeightProvider contains the function that provides the weights of the Conflicts in the engine that owns the
+	// RealitiesLedger.
+	conflictWeightProvider func(conflictID utxo.TransactionID) (weight int64)
+
+	// optsConflictWeightProvider contains the function that creates the conflictWeightProvider for the owning engine.
+	optsConflictWeightProvider func(e *engine.Engine) func(conflictID utxo.TransactionID) (weight int64)
+
+	// optsExecutionBudget contains the Budget that limits the execution of the Transactions until an upgrade changes it.
+	optsExecutionBudget vm.Budget
+
+	// optsUpgrades contains the Schedule of the protocol parameter upgrades that change the execution Budget.
+	optsUpgrades *upgrades.Schedule
+
+	// optsExecutionTraces contains the maximum number of retained execution Traces (0 disables the tracing).
+	optsExecutionTraces int
+
+	// optsMaxSnapshotRecords contains the maximum number of changes that a StorageSnapshot records (0 means unlimited).
+	optsMaxSnapshotRecords int
+
 	// optsCacheTimeProvider contains the CacheTimeProvider that overrides the local cache times.
 	optsCacheTimeProvider *database.CacheTimeProvider
 
-	// optsTransactionCacheTime contains the duration that Transaction objects stay cached after they have been
-	// released.
-	optsTransactionCacheTime time.Duration
+	// optsTransactionCache contains the cache configuration of the Transaction storage.
+	optsTransactionCache cacheOptions
 
-	// optTransactionMetadataCacheTime contains the duration that TransactionMetadata objects stay cached after they
-	// have been released.
-	optTransactionMetadataCacheTime time.Duration
+	// optsTransactionMetadataCache contains the cache configuration of the TransactionMetadata storage.
+	optsTransactionMetadataCache cacheOptions
 
-	// optsOutputCacheTime contains the duration that Output objects stay cached after they have been released.
-	optsOutputCacheTime time.Duration
+	// optsOutputCache contains the cache configuration of the Output storage.
+	optsOutputCache cacheOptions
 
-	// optsOutputMetadataCacheTime contains the duration that OutputMetadata objects stay cached after they have been
-	// released.
-	optsOutputMetadataCacheTime time.Duration
+	// optsOutputMetadataCache contains the cache configuration of the OutputMetadata storage.
+	optsOutputMetadataCache cacheOptions
 
-	// optsConsumerCacheTime contains the duration that Consumer objects stay cached after they have been released.
-	optsConsumerCacheTime time.Duration
+	// optsConsumerCache contains the cache configuration of the Consumer storage.
+	optsConsumerCache cacheOptions
 
 	// optConflictDAG contains the optionsLedger for the conflictDAG.
 	optConflictDAG []options.Option[conflictdag.ConflictDAG[utxo.TransactionID, utxo.OutputID]]
@@ -89,9 +125,20 @@ type RealitiesLedger struct {
 func NewProvider(opts ...options.Option[RealitiesLedger]) module.Provider[*engine.Engine, mempool.MemPool] {
 	return module.Provide(func(e *engine.Engine) mempool.MemPool {
 		l := New(opts...)
+		l.slotTimeProvider = e.SlotTimeProvider
+		if l.optsVMProvider != nil {
+			l.optsVM = l.optsVMProvider(e)
+		}
+		if l.optsConflictWeightProvider != nil {
+			l.conflictWeightProvider = l.optsConflictWeightProvider(e)
+		}
 
 		e.HookConstructed(func() {
 			l.Initialize(e.Workers.CreatePool("MemPool", 2), e.Storage)
+
+			l.detachEngineEvents = e.Events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+				l.conflictDAG.ResolveTimedOutConflictSets(details.Commitment.Index())
+			}, event.WithWorkerPool(l.workerPool)).Unhook
 		})
 
 		return l
@@ -100,21 +147,32 @@ func NewProvider(opts ...options.Option[RealitiesLedger]) module.Provider[*engin
 
 func New(opts ...options.Option[RealitiesLedger]) *RealitiesLedger {
 	return options.Apply(&RealitiesLedger{
-		events:                          mempool.NewEvents(),
-		optsCacheTimeProvider:           database.NewCacheTimeProvider(0),
-		optsVM:                          new(devnetvm.VM),
-		optsTransactionCacheTime:        10 * time.Second,
-		optTransactionMetadataCacheTime: 10 * time.Second,
-		optsOutputCacheTime:             10 * time.Second,
-		optsOutputMetadataCacheTime:     10 * time.Second,
-		optsConsumerCacheTime:           10 * time.Second,
-		mutex:                           syncutils.NewDAGMutex[utxo.TransactionID](),
+		events:                       mempool.NewEvents(),
+		attachments:                  mempool.NewAttachments(),
+		optsCacheTimeProvider:        database.NewCacheTimeProvider(0),
+		optsVM:                       new(devnetvm.VM),
+		optsExecutionBudget:          vm.DefaultBudget,
+		optsMaxSnapshotRecords:       1000000,
+		optsTransactionCache:         cacheOptions{cacheTime: 10 * time.Second},
+		optsTransactionMetadataCache: cacheOptions{cacheTime: 10 * time.Second},
+		optsOutputCache:              cacheOptions{cacheTime: 10 * time.Second},
+		optsOutputMetadataCache:      cacheOptions{cacheTime: 10 * time.Second},
+		optsConsumerCache:            cacheOptions{cacheTime: 10 * time.Second},
+		mutex:                        syncutils.NewDAGMutex[utxo.TransactionID](),
 	}, opts, func(l *RealitiesLedger) {
-		l.conflictDAG = conflictdag.New(l.optConflictDAG...)
+		conflictDAGOptions := []options.Option[conflictdag.ConflictDAG[utxo.TransactionID, utxo.OutputID]]{
+			conflictdag.ConflictSlotProvider[utxo.TransactionID, utxo.OutputID](l.conflictSlot),
+		}
+		if l.optsConflictWeightProvider != nil {
+			conflictDAGOptions = append(conflictDAGOptions, conflictdag.ConflictWeightProvider[utxo.TransactionID, utxo.OutputID](l.conflictWeight))
+		}
+
+		l.conflictDAG = conflictdag.New(append(conflictDAGOptions, l.optConflictDAG...)...)
 		l.events.ConflictDAG.LinkTo(l.conflictDAG.Events)
 
 		l.validator = newValidator(l)
 		l.booker = newBooker(l)
+		l.traces = newTraces(l.optsExecutionTraces)
 		l.dataFlow = newDataFlow(l)
 		l.utils = newUtils(l)
 	}, (*RealitiesLedger).TriggerConstructed)
@@ -133,6 +191,9 @@ func (l *RealitiesLedger) Initialize(workerPool *workerpool.WorkerPool, storage
 		l.propagateAcceptanceToIncludedTransactions(conflict.ID())
 	}, asyncOpt)
 	l.conflictDAG.Events.ConflictRejected.Hook(l.propagatedRejectionToTransactions, asyncOpt)
+	l.conflictDAG.Events.ConflictMerged.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		l.booker.mergeConflictToMaster(conflict.ID())
+	}, asyncOpt)
 	l.events.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
 		l.processConsumingTransactions(event.Outputs.IDs())
 	}, asyncOpt)
@@ -163,6 +224,10 @@ func (l *RealitiesLedger) VM() vm.VM {
 	return l.optsVM
 }
 
+func (l *RealitiesLedger) Attachments() *mempool.Attachments {
+	return l.attachments
+}
+
 var _ mempool.MemPool = new(RealitiesLedger)
 
 // SetTransactionInclusionSlot sets the inclusion timestamp of a Transaction.
@@ -198,6 +263,24 @@ func (l *RealitiesLedger) CheckTransaction(ctx context.Context, tx utxo.Transact
 	return l.dataFlow.checkTransaction().Run(newDataFlowParams(ctx, tx))
 }
 
+// DryRun resolves the inputs of a Transaction and executes it without storing anything, so that the validity and
+// the created Outputs of a Transaction can be determined before it is issued.
+func (l *RealitiesLedger) DryRun(ctx context.Context, tx utxo.Transaction) (result *mempool.DryRunResult) {
+	params := newDataFlowParams(ctx, tx)
+	params.Trace = vm.NewTrace(tx.ID())
+	err := l.dataFlow.checkTransaction().Run(params)
+
+	return &mempool.DryRunResult{
+		InputIDs:       params.InputIDs,
+		Inputs:         params.Inputs,
+		InputsMetadata: params.InputsMetadata,
+		Outputs:        params.Outputs,
+		Cost:           params.Cost,
+		Trace:          params.Trace,
+		Err:            err,
+	}
+}
+
 // StoreAndProcessTransaction stores and processes the given Transaction.
 func (l *RealitiesLedger) StoreAndProcessTransaction(ctx context.Context, tx utxo.Transaction) (err error) {
 	l.mutex.Lock(tx.ID())
@@ -206,14 +289,89 @@ func (l *RealitiesLedger) StoreAndProcessTransaction(ctx context.Context, tx utx
 	return l.dataFlow.storeAndProcessTransaction().Run(newDataFlowParams(ctx, tx))
 }
 
+// StoreAndProcessAtomically stores and processes the given interdependent Transactions as a bundle. The bundle is checked
+// as a whole before any of its members is stored, so that either all members are booked or none of them.
+func (l *RealitiesLedger) StoreAndProcessAtomically(ctx context.Context, txs []utxo.Transaction) (err error) {
+	txBundle, err := newBundle(l, txs)
+	if err != nil {
+		return errors.Wrap(err, "failed to create bundle")
+	}
+
+	txBundle.lock()
+	defer txBundle.unlock()
+
+	if err = txBundle.check(); err != nil {
+		return errors.Wrap(err, "failed to check bundle")
+	}
+
+	return txBundle.book(ctx)
+}
+
 // PruneTransaction removes a Transaction from the RealitiesLedger (e.g. after it was orphaned or found to be invalid). If the
 // pruneFutureCone flag is true, then we do not just remove the named Transaction but also its future cone.
 func (l *RealitiesLedger) PruneTransaction(txID utxo.TransactionID, pruneFutureCone bool) {
 	l.storage.pruneTransaction(txID, pruneFutureCone)
 }
 
+// executeTransaction executes the given Transaction with the VM of the RealitiesLedger within its execution Budget (the
+// Cost that is reported by the VM is checked again, so that a VM cannot exceed the Budget by ignoring it). The
+// validation steps and the created Outputs are recorded in the given Trace (nil disables the tracing).
+func (l *RealitiesLedger) executeTransaction(tx utxo.Transaction, inputs *utxo.Outputs, trace *vm.Trace) (outputs []utxo.Output, cost vm.Cost, err error) {
+	budget := l.optsUpgrades.ExecutionBudget(l.executionSlot(tx), l.optsExecutionBudget)
+
+	if outputs, cost, err = vm.Execute(l.optsVM, tx, inputs, budget, trace); err == nil {
+		if err = budget.Check(cost); err != nil {
+			trace.Step("execution budget", err)
+		}
+	}
+
+	if err != nil {
+		return nil, cost, vm.WrapExecutionError(err)
+	}
+
+	trace.RecordOutputs(outputs, cost)
+
+	return outputs, cost, nil
+}
+
+// executionSlot returns the slot whose protocol parameters apply to the execution of the given Transaction. It is
+// derived from the timestamp of the Transaction (and not from the local time of its arrival), so that all nodes execute
+// the Transaction with the same parameters.
+func (l *RealitiesLedger) executionSlot(tx utxo.Transaction) (index slot.Index) {
+	timestampedTransaction, isTimestamped := tx.(vm.TimestampedTransaction)
+	if !isTimestamped || l.slotTimeProvider == nil {
+		return 0
+	}
+
+	return l.slotTimeProvider().IndexFromTime(timestampedTransaction.Timestamp())
+}
+
+// conflictSlot returns the slot of the Transaction that created the Conflict with the given ID (it is derived from the
+// timestamp of the Transaction like the executionSlot).
+func (l *RealitiesLedger) conflictSlot(conflictID utxo.TransactionID) (index slot.Index) {
+	l.storage.CachedTransaction(conflictID).Consume(func(tx utxo.Transaction) {
+		index = l.executionSlot(tx)
+	})
+
+	return index
+}
+
+// conflictWeight returns the weight of the Conflict with the given ID in the engine that owns the RealitiesLedger.
+func (l *RealitiesLedger) conflictWeight(conflictID utxo.TransactionID) (weight int64) {
+	if l.conflictWeightProvider == nil {
+		return 0
+	}
+
+	return l.conflictWeightProvider(conflictID)
+}
+
 // Shutdown shuts down the stateful elements of the RealitiesLedger (the Storage and the conflictDAG).
 func (l *RealitiesLedger) Shutdown() {
+	if l.detachEngineEvents != nil {
+		l.detachEngineEvents()
+	}
+
+	l.conflictDAG.Shutdown()
 	l.workerPool.Shutdown()
 	l.workerPool.PendingTasksCounter.WaitIsZero()
 	l.storage.Shutdown()
@@ -305,6 +463,8 @@ func (l *RealitiesLedger) triggerAcceptedEvent(txMetadata *mempool.TransactionMe
 		for it := l.utils.ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
 			inputID := it.Next()
 			l.storage.CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+				outputMetadata.SetConfirmedConsumer(txMetadata.ID())
+
 				l.storage.CachedOutput(inputID).Consume(func(output utxo.Output) {
 					transactionEvent.SpentOutputs = append(transactionEvent.SpentOutputs, mempool.NewOutputWithMetadata(
 						outputMetadata.InclusionSlot(),
@@ -329,6 +489,8 @@ func (l *RealitiesLedger) triggerRejectedEvent(txMetadata *mempool.TransactionMe
 		return false
 	}
 
+	txMetadata.SetRejectionReason(l.rejectionReason(txMetadata))
+
 	for it := txMetadata.OutputIDs().Iterator(); it.HasNext(); {
 		l.storage.CachedOutputMetadata(it.Next()).Consume(func(outputMetadata *mempool.OutputMetadata) {
 			outputMetadata.SetConfirmationState(confirmation.Rejected)
@@ -341,6 +503,21 @@ func (l *RealitiesLedger) triggerRejectedEvent(txMetadata *mempool.TransactionMe
 	return true
 }
 
+// rejectionReason determines why the given Transaction was rejected: Transactions whose own Conflict lost are rejected
+// as LosingConflict, while Transactions that only inherited a rejected Conflict are rejected as InvalidPastCone.
+func (l *RealitiesLedger) rejectionReason(txMetadata *mempool.TransactionMetadata) (rejectionReason mempool.RejectionReason) {
+	if !txMetadata.IsConflicting() {
+		return mempool.InvalidPastCone
+	}
+
+	conflict, exists := l.conflictDAG.Conflict(txMetadata.ID())
+	if exists && l.conflictDAG.ConfirmationState(conflict.Parents()).IsRejected() {
+		return mempool.InvalidPastCone
+	}
+
+	return mempool.LosingConflict
+}
+
 func (l *RealitiesLedger) triggerRejectedEventLocked(txMetadata *mempool.TransactionMetadata) (triggered bool) {
 	l.mutex.Lock(txMetadata.ID())
 	defer l.mutex.Unlock(txMetadata.ID())
@@ -395,8 +572,59 @@ func WithVM(vm vm.VM) (option options.Option[RealitiesLedger]) {
 	}
 }
 
+// WithVMProvider is an Option for the RealitiesLedger that creates the VM from the engine that owns the RealitiesLedger
+// (i.e. to derive protocol parameters from its slot timing). It takes precedence over the VM of WithVM, but is only used
+// if the RealitiesLedger is created by its Provider.
+func WithVMProvider(vmProvider func(e *engine.Engine) vm.VM) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsVMProvider = vmProvider
+	}
+}
+
+// WithConflictWeightProvider is an Option for the RealitiesLedger that sets the function that creates the provider of
+// the weights of the Conflicts for the engine that owns the RealitiesLedger (the weights are used by the conflictDAG to
+// force decisions on timed out ConflictSets and to archive resolved ones).
+func WithConflictWeightProvider(weightProvider func(e *engine.Engine) func(conflictID utxo.TransactionID) (weight int64)) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsConflictWeightProvider = weightProvider
+	}
+}
+
+// WithExecutionBudget is an Option for the RealitiesLedger that overrides the Budget of the protocol (vm.DefaultBudget)
+// that limits the resources that the execution of a single Transaction is allowed to consume (Transactions that exceed
+// the Budget are invalid, so all nodes of a network have to use the same Budget).
+func WithExecutionBudget(budget vm.Budget) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsExecutionBudget = budget
+	}
+}
+
+// WithUpgrades is an Option for the RealitiesLedger that sets the Schedule of the protocol parameter upgrades that
+// change the execution Budget of the Transactions of later slots.
+func WithUpgrades(schedule *upgrades.Schedule) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsUpgrades = schedule
+	}
+}
+
+// WithMaxSnapshotRecords is an Option for the RealitiesLedger that limits the number of changes that a StorageSnapshot
+// records before it fails with mempool.ErrSnapshotOverflow (0 means unlimited).
+func WithMaxSnapshotRecords(maxSnapshotRecords int) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsMaxSnapshotRecords = maxSnapshotRecords
+	}
+}
+
+// WithExecutionTraces is an Option for the RealitiesLedger that retains the execution Traces of the given number of
+// recently processed Transactions (0 disables the tracing).
+func WithExecutionTraces(maxTraces int) (option options.Option[RealitiesLedger]) {
+	return func(l *RealitiesLedger) {
+		l.optsExecutionTraces = maxTraces
+	}
+}
+
 // WithCacheTimeProvider is an Option for the RealitiesLedger that allows to configure which CacheTimeProvider is supposed to
-// be used.
+// be used (it only affects the storages whose cache time was not configured explicitly).
 func WithCacheTimeProvider(cacheTimeProvider *database.CacheTimeProvider) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
 		options.optsCacheTimeProvider = cacheTimeProvider
@@ -404,42 +632,87 @@ func WithCacheTimeProvider(cacheTimeProvider *database.CacheTimeProvider) (optio
 }
 
 // WithTransactionCacheTime is an Option for the RealitiesLedger that allows to configure how long Transaction objects stay
-// cached after they have been released.
+// cached after they have been released (it takes precedence over the CacheTimeProvider).
 func WithTransactionCacheTime(transactionCacheTime time.Duration) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
-		options.optsTransactionCacheTime = transactionCacheTime
+		options.optsTransactionCache.cacheTime = transactionCacheTime
+		options.optsTransactionCache.cacheTimeOverridden = true
 	}
 }
 
-// WithTransactionMetadataCacheTime is an Option for the RealitiesLedger that allows to configure how long TransactionMetadata
-// objects stay cached after they have been released.
+// WithTransactionCacheSize is an Option for the RealitiesLedger that allows to configure the maximum number of released
+// Transaction objects that stay cached (0 means unlimited).
+func WithTransactionCacheSize(transactionCacheSize int) (option options.Option[RealitiesLedger]) {
+	return func(options *RealitiesLedger) {
+		options.optsTransactionCache.maxSize = transactionCacheSize
+	}
+}
+
+// WithTransactionMetadataCacheTime is an Option for the RealitiesLedger that allows to configure how long TransactionMetadata objects stay
+// cached after they have been released (it takes precedence over the CacheTimeProvider).
 func WithTransactionMetadataCacheTime(transactionMetadataCacheTime time.Duration) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
-		options.optTransactionMetadataCacheTime = transactionMetadataCacheTime
+		options.optsTransactionMetadataCache.cacheTime = transactionMetadataCacheTime
+		options.optsTransactionMetadataCache.cacheTimeOverridden = true
+	}
+}
+
+// WithTransactionMetadataCacheSize is an Option for the RealitiesLedger that allows to configure the maximum number of released
+// TransactionMetadata objects that stay cached (0 means unlimited).
+func WithTransactionMetadataCacheSize(transactionMetadataCacheSize int) (option options.Option[RealitiesLedger]) {
+	return func(options *RealitiesLedger) {
+		options.optsTransactionMetadataCache.maxSize = transactionMetadataCacheSize
 	}
 }
 
-// WithOutputCacheTime is an Option for the RealitiesLedger that allows to configure how long Output objects stay cached after
-// they have been released.
+// WithOutputCacheTime is an Option for the RealitiesLedger that allows to configure how long Output objects stay
+// cached after they have been released (it takes precedence over the CacheTimeProvider).
 func WithOutputCacheTime(outputCacheTime time.Duration) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
-		options.optsOutputCacheTime = outputCacheTime
+		options.optsOutputCache.cacheTime = outputCacheTime
+		options.optsOutputCache.cacheTimeOverridden = true
+	}
+}
+
+// WithOutputCacheSize is an Option for the RealitiesLedger that allows to configure the maximum number of released
+// Output objects that stay cached (0 means unlimited).
+func WithOutputCacheSize(outputCacheSize int) (option options.Option[RealitiesLedger]) {
+	return func(options *RealitiesLedger) {
+		options.optsOutputCache.maxSize = outputCacheSize
 	}
 }
 
 // WithOutputMetadataCacheTime is an Option for the RealitiesLedger that allows to configure how long OutputMetadata objects stay
-// cached after they have been released.
+// cached after they have been released (it takes precedence over the CacheTimeProvider).
 func WithOutputMetadataCacheTime(outputMetadataCacheTime time.Duration) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
-		options.optsOutputMetadataCacheTime = outputMetadataCacheTime
+		options.optsOutputMetadataCache.cacheTime = outputMetadataCacheTime
+		options.optsOutputMetadataCache.cacheTimeOverridden = true
 	}
 }
 
-// WithConsumerCacheTime is an Option for the RealitiesLedger that allows to configure how long Consumer objects stay cached
-// after they have been released.
+// WithOutputMetadataCacheSize is an Option for the RealitiesLedger that allows to configure the maximum number of released
+// OutputMetadata objects that stay cached (0 means unlimited).
+func WithOutputMetadataCacheSize(outputMetadataCacheSize int) (option options.Option[RealitiesLedger]) {
+	return func(options *RealitiesLedger) {
+		options.optsOutputMetadataCache.maxSize = outputMetadataCacheSize
+	}
+}
+
+// WithConsumerCacheTime is an Option for the RealitiesLedger that allows to configure how long Consumer objects stay
+// cached after they have been released (it takes precedence over the CacheTimeProvider).
 func WithConsumerCacheTime(consumerCacheTime time.Duration) (option options.Option[RealitiesLedger]) {
 	return func(options *RealitiesLedger) {
-		options.optsConsumerCacheTime = consumerCacheTime
+		options.optsConsumerCache.cacheTime = consumerCacheTime
+		options.optsConsumerCache.cacheTimeOverridden = true
+	}
+}
+
+// WithConsumerCacheSize is an Option for the RealitiesLedger that allows to configure the maximum number of released
+// Consumer objects that stay cached (0 means unlimited).
+func WithConsumerCacheSize(consumerCacheSize int) (option options.Option[RealitiesLedger]) {
+	return func(options *RealitiesLedger) {
+		options.optsConsumerCache.maxSize = consumerCacheSize
 	}
 }
 
@@ -1,19 +1,28 @@
 package realitiesledger_test
 
 import (
+	"context"
 	"fmt"
-	"math/rand"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 )
 
@@ -374,6 +383,47 @@ func TestLedger_SetConflictConfirmed(t *testing.T) {
 	}
 }
 
+func TestLedger_RejectionReasons(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 2, "Genesis")
+	tf.CreateTransaction("TXA", 1, "G.0")
+	tf.CreateTransaction("TXB", 1, "G.0")
+	tf.CreateTransaction("TXC", 1, "TXB.0")
+	tf.CreateTransaction("TXD", 1, "G.1")
+
+	require.NoError(t, tf.IssueTransactions("G", "TXA", "TXB", "TXC", "TXD"))
+	tf.AssertRejectionReasons(map[string]mempool.RejectionReason{
+		"TXA": mempool.NoRejectionReason,
+		"TXB": mempool.NoRejectionReason,
+		"TXC": mempool.NoRejectionReason,
+	})
+
+	require.True(t, tf.Instance.ConflictDAG().SetConflictAccepted(tf.Transaction("TXA").ID()))
+	workers.WaitChildren()
+
+	tf.AssertTransactionConfirmationState("TXB", confirmation.State.IsRejected)
+	tf.AssertTransactionConfirmationState("TXC", confirmation.State.IsRejected)
+	tf.AssertRejectionReasons(map[string]mempool.RejectionReason{
+		"TXA": mempool.NoRejectionReason,
+		"TXB": mempool.LosingConflict,
+		"TXC": mempool.InvalidPastCone,
+		"TXD": mempool.NoRejectionReason,
+	})
+
+	// transactions that are booked on top of a rejected conflict are rejected because of their past cone
+	tf.CreateTransaction("TXE", 1, "TXC.0")
+	require.NoError(t, tf.IssueTransactions("TXE"))
+	workers.WaitChildren()
+
+	tf.AssertRejectionReasons(map[string]mempool.RejectionReason{
+		"TXE": mempool.InvalidPastCone,
+	})
+}
+
 func TestLedger_SolidifyAndForkMultiThreaded(t *testing.T) {
 	workers := workerpool.NewGroup(t.Name())
 	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
@@ -414,25 +464,8 @@ func TestLedger_SolidifyAndForkMultiThreaded(t *testing.T) {
 		}
 	}
 
-	// Shuffle and issue all transactions created so far concurrently.
-	{
-		rand.Shuffle(len(createdAliases), func(i, j int) {
-			createdAliases[i], createdAliases[j] = createdAliases[j], createdAliases[i]
-		})
-
-		var wg sync.WaitGroup
-		for _, createdAlias := range createdAliases {
-			wg.Add(1)
-			go func(createdAlias string) {
-				defer wg.Done()
-				err := tf.IssueTransactions(createdAlias)
-				if err != nil && !errors.Is(err, mempool.ErrTransactionUnsolid) {
-					panic(err)
-				}
-			}(createdAlias)
-		}
-		wg.Wait()
-	}
+	// Issue all transactions created so far concurrently.
+	require.NoError(t, tf.IssueTransactionsConcurrently(createdAliases...))
 
 	// Create ad-hoc TX11 to mix and match conflicts propagated from the bottom layer.
 	{
@@ -541,6 +574,20 @@ func TestLedger_ForkAlreadyConflictingTransaction(t *testing.T) {
 		"G.0": {"TX1", "TX2"},
 		"G.1": {"TX1", "TX3"},
 	})
+
+	tf.AssertSpent(map[string]bool{
+		"G.0":   true,
+		"G.1":   true,
+		"G.2":   false,
+		"TX1.0": false,
+	})
+
+	tf.AssertConsumerCount(map[string]int{
+		"G.0":   2,
+		"G.1":   2,
+		"G.2":   0,
+		"TX1.0": 0,
+	})
 }
 
 func TestLedger_TransactionCausallyRelated(t *testing.T) {
@@ -553,7 +600,614 @@ func TestLedger_TransactionCausallyRelated(t *testing.T) {
 	tf.CreateTransaction("TX3", 1, "G.0", "TX2.0")
 
 	require.NoError(t, tf.IssueTransactions("G", "TX1", "TX2"))
-	require.EqualError(t, tf.IssueTransactions("TX3"), "failed to issue transaction 'TX3': TransactionID(TX3) is trying to spend causally related Outputs: transaction invalid")
+	err := tf.IssueTransactions("TX3")
+	require.EqualError(t, err, "failed to issue transaction 'TX3': TransactionID(TX3) is trying to spend causally related Outputs: inputs causally related")
+	require.ErrorIs(t, err, vm.ErrInputsCausallyRelated)
+	require.ErrorIs(t, err, mempool.ErrTransactionInvalid)
+}
+
+func TestLedger_BookUnsolidTransactionConsumers(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 1, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+
+	// TX1 is stored while its input is unknown, so it is booked once G is booked
+	require.ErrorIs(t, tf.IssueTransactions("TX1"), mempool.ErrTransactionUnsolid)
+	require.NoError(t, tf.IssueTransactions("G"))
+	require.Eventually(t, func() bool { return tf.AllBooked("TX1") }, 5*time.Second, 10*time.Millisecond)
+
+	bookedConsumers := 0
+	tf.Instance.Storage().CachedConsumers(tf.OutputID("G.0")).Consume(func(consumer *mempool.Consumer) {
+		if consumer.TransactionID() == tf.Transaction("TX1").ID() && consumer.IsBooked() {
+			bookedConsumers++
+		}
+	})
+	require.Equal(t, 1, bookedConsumers)
+}
+
+func TestLedger_StoreAndProcessAtomically(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 4, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 2, "TX1.0")
+	tf.CreateTransaction("TX3", 1, "TX2.0", "G.1")
+	tf.CreateTransaction("TX4", 1, "G.2")
+	tf.CreateTransaction("TX5", 1, "TX4.0")
+	tf.CreateTransaction("U", 1, "Genesis")
+	tf.CreateTransaction("TX6", 1, "U.0")
+	tf.CreateTransaction("TX7", 1, "TX2.1")
+	tf.CreateTransaction("TX7*", 1, "TX2.1")
+	tf.CreateTransaction("TX9", 1, "TX3.0")
+	tf.CreateTransaction("TX8", 1, "TX9.0", "TX2.0")
+
+	tf.SetTransactionBehavior("TX5", mockedvm.WithExecutionError(errors.New("execution failed")))
+
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	// the members are booked in the order of their dependencies
+	require.NoError(t, tf.IssueBundle("TX3", "TX2", "TX1"))
+
+	// an invalid member prevents the booking of the whole bundle
+	require.ErrorIs(t, tf.IssueBundle("TX4", "TX5"), mempool.ErrTransactionInvalid)
+	require.ErrorIs(t, tf.IssueBundle("TX4", "TX5"), vm.ErrValidationFailed)
+
+	// a member with missing inputs prevents the booking of the whole bundle
+	require.ErrorIs(t, tf.IssueBundle("TX6"), mempool.ErrTransactionUnsolid)
+
+	// members that double spend each other can not be booked together
+	require.ErrorIs(t, tf.IssueBundle("TX7", "TX7*"), mempool.ErrTransactionInvalid)
+	require.ErrorIs(t, tf.IssueBundle("TX7", "TX7*"), vm.ErrInputsAlreadySpent)
+
+	// members that spend causally related outputs can not be booked together
+	require.ErrorIs(t, tf.IssueBundle("TX8", "TX9"), mempool.ErrTransactionInvalid)
+
+	// members can not be contained more than once
+	require.ErrorIs(t, tf.IssueBundle("TX4", "TX4"), mempool.ErrBundleInvalid)
+
+	tf.AssertBooked(map[string]bool{
+		"G":   true,
+		"TX1": true,
+		"TX2": true,
+		"TX3": true,
+	})
+
+	tf.AssertStored(map[string]bool{
+		"TX4":  false,
+		"TX5":  false,
+		"TX6":  false,
+		"TX7":  false,
+		"TX7*": false,
+		"TX8":  false,
+		"TX9":  false,
+	})
+
+	tf.AssertSpent(map[string]bool{
+		"G.0":   true,
+		"G.1":   true,
+		"G.2":   false,
+		"G.3":   false,
+		"TX2.1": false,
+		"TX3.0": false,
+	})
+}
+
+func TestLedger_StoreAndProcessAtomicallyRollback(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 1, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "TX1.0")
+	tf.CreateTransaction("TX3", 1, "TX2.0")
+
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	// TX3 is stored before the bundle that creates its input
+	require.ErrorIs(t, tf.IssueTransactions("TX3"), mempool.ErrTransactionUnsolid)
+
+	var eventsMutex sync.Mutex
+	bookedMembers := make([]utxo.TransactionID, 0)
+	orphanedMembers := make([]utxo.TransactionID, 0)
+	tf.Instance.Events().TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+
+		// the members are only announced once the whole bundle was booked
+		require.True(t, tf.AllBooked("TX1", "TX2"))
+		bookedMembers = append(bookedMembers, event.TransactionID)
+	})
+	tf.Instance.Events().TransactionOrphaned.Hook(func(event *mempool.TransactionEvent) {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+
+		orphanedMembers = append(orphanedMembers, event.Metadata.ID())
+	})
+
+	// TX2 passes the check of the bundle but fails to execute when it is booked
+	tf.SetTransactionBehaviorScript("TX2", mockedvm.NewBehavior(), mockedvm.NewBehavior(mockedvm.WithExecutionError(errors.New("execution failed"))), mockedvm.NewBehavior())
+
+	require.ErrorIs(t, tf.IssueBundle("TX1", "TX2"), mempool.ErrTransactionInvalid)
+
+	// only the members that were stored by the bundle are removed again (without announcing them)
+	tf.AssertStored(map[string]bool{
+		"TX1": false,
+		"TX2": false,
+		"TX3": true,
+	})
+	tf.AssertBooked(map[string]bool{
+		"TX3": false,
+	})
+
+	eventsMutex.Lock()
+	require.Empty(t, bookedMembers)
+	require.Empty(t, orphanedMembers)
+	eventsMutex.Unlock()
+
+	// the bundle is issued again like it is received from the network (the discarded instances can not be stored again)
+	require.NoError(t, tf.Instance.StoreAndProcessAtomically(context.Background(), lo.Map([]string{"TX1", "TX2"}, func(txAlias string) utxo.Transaction {
+		tx := lo.PanicOnErr(tf.Instance.VM().ParseTransaction(lo.PanicOnErr(tf.Transaction(txAlias).Bytes())))
+		tx.SetID(tf.Transaction(txAlias).ID())
+
+		return tx
+	})))
+	require.Eventually(t, func() bool { return tf.AllBooked("TX3") }, 5*time.Second, 10*time.Millisecond)
+
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+
+	require.Equal(t, []utxo.TransactionID{tf.Transaction("TX1").ID(), tf.Transaction("TX2").ID(), tf.Transaction("TX3").ID()}, bookedMembers)
+	require.Empty(t, orphanedMembers)
+}
+
+func TestLedger_DryRun(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 2, "G.0")
+	tf.CreateTransaction("TX2", 1, "TX1.0")
+	tf.CreateTransaction("TX3", 1, "G.1")
+	tf.CreateTransaction("TX4", 1, "G.0")
+
+	tf.SetTransactionBehavior("TX3", mockedvm.WithExecutionError(errors.New("execution failed")))
+
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	// a valid transaction is executed but not stored
+	result := tf.Instance.DryRun(context.Background(), tf.Transaction("TX1"))
+	require.True(t, result.Solid())
+	require.True(t, result.Valid())
+	require.True(t, result.Inputs.Has(tf.OutputID("G.0")))
+	require.Equal(t, 2, result.Outputs.Size())
+	require.True(t, result.Outputs.Has(utxo.NewOutputID(tf.Transaction("TX1").ID(), 1)))
+	require.False(t, lo.Return1(result.InputsMetadata.Get(tf.OutputID("G.0"))).IsSpent())
+
+	// an unsolid transaction is reported as such
+	result = tf.Instance.DryRun(context.Background(), tf.Transaction("TX2"))
+	require.False(t, result.Solid())
+	require.ErrorIs(t, result.Err, mempool.ErrTransactionUnsolid)
+	require.Nil(t, result.Outputs)
+
+	// an invalid transaction returns the reason of the failed execution
+	result = tf.Instance.DryRun(context.Background(), tf.Transaction("TX3"))
+	require.True(t, result.Solid())
+	require.False(t, result.Valid())
+	require.ErrorIs(t, result.Err, mempool.ErrTransactionInvalid)
+
+	// a transaction that double spends a booked transaction is still valid, but its input is marked as spent
+	require.NoError(t, tf.IssueTransactions("TX1"))
+	result = tf.Instance.DryRun(context.Background(), tf.Transaction("TX4"))
+	require.True(t, result.Valid())
+	require.True(t, lo.Return1(result.InputsMetadata.Get(tf.OutputID("G.0"))).IsSpent())
+
+	tf.AssertStored(map[string]bool{
+		"TX2": false,
+		"TX3": false,
+		"TX4": false,
+	})
+}
+
+func TestLedger_Outputs(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "TX1.0")
+
+	require.NoError(t, tf.IssueTransactions("G", "TX1"))
+
+	outputs, outputsMetadata := tf.Instance.Utils().Outputs(tf.OutputID("G.0"), tf.OutputID("G.1"), tf.OutputID("TX1.0"), utxo.NewOutputID(tf.Transaction("TX2").ID(), 0))
+	require.Equal(t, 3, outputs.Size())
+	require.Equal(t, 3, outputsMetadata.Size())
+	require.True(t, outputs.Has(tf.OutputID("G.0")))
+	require.True(t, outputs.Has(tf.OutputID("G.1")))
+	require.True(t, outputs.Has(tf.OutputID("TX1.0")))
+	require.True(t, lo.Return1(outputsMetadata.Get(tf.OutputID("G.0"))).IsSpent())
+	require.False(t, lo.Return1(outputsMetadata.Get(tf.OutputID("G.1"))).IsSpent())
+
+	outputs, outputsMetadata = tf.Instance.Utils().Outputs()
+	require.Equal(t, 0, outputs.Size())
+	require.Equal(t, 0, outputsMetadata.Size())
+}
+
+func TestLedger_MockedVMBehavior(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 2, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	tf.CreateTransaction("TX3", 1, "G.2")
+
+	tf.SetTransactionBehavior("TX1", mockedvm.WithOutputBalances(100, 200))
+	tf.SetTransactionBehavior("TX2", mockedvm.WithExecutionError(errors.New("execution failed")))
+	tf.SetTransactionBehavior("TX3", mockedvm.WithExecutionDelay(100*time.Millisecond))
+
+	require.NoError(t, tf.IssueTransactions("G", "TX1"))
+	require.ErrorIs(t, tf.IssueTransactions("TX2"), mempool.ErrTransactionInvalid)
+
+	issuingStart := time.Now()
+	require.NoError(t, tf.IssueTransactions("TX3"))
+	require.GreaterOrEqual(t, time.Since(issuingStart), 100*time.Millisecond)
+
+	tf.AssertBooked(map[string]bool{
+		"G":   true,
+		"TX1": true,
+		"TX3": true,
+	})
+
+	tf.AssertSpent(map[string]bool{
+		"G.0": true,
+		"G.1": false,
+		"G.2": true,
+	})
+
+	for outputAlias, expectedBalance := range map[string]uint64{"TX1.0": 100, "TX1.1": 200} {
+		tf.ConsumeOutput(tf.OutputID(outputAlias), func(output utxo.Output) {
+			require.Equal(t, expectedBalance, output.(*mockedvm.MockedOutput).Balance())
+		})
+	}
+}
+
+func TestLedger_MockedVMBehaviorScript(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"), realitiesledger.WithVM(mockedvm.NewMockedVM(mockedvm.WithSeed(42))))
+
+	tf.CreateTransaction("G", 2, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 2, "G.1")
+
+	executionErr := errors.New("execution failed")
+	tf.SetTransactionBehaviorScript("TX1",
+		mockedvm.NewBehavior(mockedvm.WithExecutionError(executionErr)),
+		mockedvm.NewBehavior(mockedvm.WithExecutionError(executionErr)),
+		mockedvm.NewBehavior(mockedvm.WithOutputBalances(100)),
+	)
+	tf.SetTransactionBehavior("TX2", mockedvm.WithNondeterministicOutputBalances())
+
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	// bundles are checked before they are stored, so the failed executions can be retried
+	require.ErrorIs(t, tf.IssueBundle("TX1"), mempool.ErrTransactionInvalid)
+	require.ErrorIs(t, tf.IssueBundle("TX1"), mempool.ErrTransactionInvalid)
+	tf.AssertStored(map[string]bool{"TX1": false})
+
+	require.NoError(t, tf.IssueBundle("TX1"))
+	tf.AssertBooked(map[string]bool{"TX1": true})
+
+	// the successful bundle is executed once when it is checked and once when it is booked
+	executions := tf.TransactionExecutions("TX1")
+	require.Len(t, executions, 4)
+	for attempt, execution := range executions {
+		require.Equal(t, attempt, execution.Attempt)
+
+		if attempt < 2 {
+			require.ErrorIs(t, execution.Err, executionErr)
+		} else {
+			require.NoError(t, execution.Err)
+			require.Equal(t, []uint64{100}, execution.OutputBalances)
+		}
+	}
+
+	require.NoError(t, tf.IssueTransactions("TX2"))
+	require.Len(t, tf.TransactionExecutions("TX2"), 1)
+	recordedBalances := tf.TransactionExecutions("TX2")[0].OutputBalances
+
+	// a MockedVM with the same seed replays the nondeterministic execution
+	replayVM := mockedvm.NewMockedVM(mockedvm.WithSeed(42))
+	replayVM.SetBehavior(tf.Transaction("TX2").ID(), mockedvm.WithNondeterministicOutputBalances())
+	_, _, err := replayVM.ExecuteTransaction(tf.Transaction("TX2"), utxo.NewOutputs(), vm.UnlimitedBudget)
+	require.NoError(t, err)
+	require.Equal(t, recordedBalances, replayVM.Executions(tf.Transaction("TX2").ID())[0].OutputBalances)
+
+	// the next attempt of the nondeterministic execution creates different outputs
+	_, _, err = replayVM.ExecuteTransaction(tf.Transaction("TX2"), utxo.NewOutputs(), vm.UnlimitedBudget)
+	require.NoError(t, err)
+	require.NotEqual(t, recordedBalances, replayVM.Executions(tf.Transaction("TX2").ID())[1].OutputBalances)
+}
+
+func TestLedger_ExecutionBudget(t *testing.T) {
+	outputSize := uint64(len(lo.PanicOnErr(mockedvm.NewMockedOutput(utxo.EmptyTransactionID, 0, 0).Bytes())))
+
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"),
+		realitiesledger.WithExecutionBudget(vm.Budget{Gas: 1000, Size: 3 * outputSize}),
+	)
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	tf.CreateTransaction("TX3", 4, "G.2")
+
+	tf.SetTransactionBehavior("TX1", mockedvm.WithExecutionGas(1000))
+	tf.SetTransactionBehavior("TX2", mockedvm.WithExecutionGas(1001))
+
+	require.NoError(t, tf.IssueTransactions("G", "TX1"))
+	require.ErrorIs(t, tf.IssueTransactions("TX2"), vm.ErrBudgetExceeded)
+	require.ErrorIs(t, tf.IssueTransactions("TX3"), vm.ErrBudgetExceeded)
+
+	executions := tf.TransactionExecutions("TX1")
+	require.Len(t, executions, 1)
+	require.Equal(t, vm.Budget{Gas: 1000, Size: 3 * outputSize}, executions[0].Budget)
+	require.Equal(t, vm.Cost{Gas: 1000, Size: outputSize}, executions[0].Cost)
+
+	// the dry run reports the cost of the execution
+	result := tf.Instance.DryRun(context.Background(), tf.Transaction("TX2"))
+	require.ErrorIs(t, result.Err, mempool.ErrTransactionInvalid)
+	require.Equal(t, uint64(1001), result.Cost.Gas)
+
+	tf.AssertBooked(map[string]bool{
+		"G":   true,
+		"TX1": true,
+		"TX2": false,
+		"TX3": false,
+	})
+}
+
+func TestLedger_ExecutionTrace(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"), realitiesledger.WithExecutionTraces(2))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 2, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	tf.CreateTransaction("TX3", 1, "G.2")
+
+	tf.SetTransactionBehavior("TX2", mockedvm.WithExecutionError(errors.New("execution failed")))
+
+	require.NoError(t, tf.IssueTransactions("G", "TX1"))
+	require.ErrorIs(t, tf.IssueTransactions("TX2"), mempool.ErrTransactionInvalid)
+
+	trace, exists := tf.Instance.Utils().ExecutionTrace(tf.Transaction("TX1").ID())
+	require.True(t, exists)
+	require.NoError(t, trace.Err())
+	require.Equal(t, []utxo.OutputID{tf.OutputID("G.0")}, trace.InputIDs)
+	require.Len(t, trace.Inputs, 1)
+	require.Len(t, trace.Outputs, 2)
+	require.Equal(t, []string{"solidity", "causality", "budget"}, lo.Map(trace.Steps, func(step *vm.TraceStep) string { return step.Name }))
+
+	// the trace of an invalid transaction is retained after the transaction was pruned
+	trace, exists = tf.Instance.Utils().ExecutionTrace(tf.Transaction("TX2").ID())
+	require.True(t, exists)
+	require.ErrorContains(t, trace.Err(), "execution failed")
+	require.Equal(t, "behavior", trace.Steps[len(trace.Steps)-1].Name)
+	require.Empty(t, trace.Outputs)
+
+	// only the most recent traces are retained
+	_, exists = tf.Instance.Utils().ExecutionTrace(tf.Transaction("G").ID())
+	require.False(t, exists)
+
+	// dry runs are traced without retaining the trace
+	result := tf.Instance.DryRun(context.Background(), tf.Transaction("TX3"))
+	require.Len(t, result.Trace.Steps, 3)
+	_, exists = tf.Instance.Utils().ExecutionTrace(tf.Transaction("TX3").ID())
+	require.False(t, exists)
+}
+
+func TestLedger_Attachments(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 1, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	require.NoError(t, tf.IssueTransactions("G", "TX1"))
+
+	now := time.Now()
+	attachment1 := models.NewBlockID(types.NewIdentifier([]byte("attachment1")), ed25519.EmptySignature, 1)
+	attachment2 := models.NewBlockID(types.NewIdentifier([]byte("attachment2")), ed25519.EmptySignature, 2)
+	attachment3 := models.NewBlockID(types.NewIdentifier([]byte("attachment3")), ed25519.EmptySignature, 2)
+
+	attachments := tf.Instance.Attachments()
+	txID := tf.Transaction("TX1").ID()
+
+	_, exists := attachments.EarliestAttachmentTime(txID)
+	require.False(t, exists)
+
+	require.True(t, attachments.Register(txID, attachment1, now.Add(time.Second)))
+	require.True(t, attachments.Register(txID, attachment2, now))
+	require.True(t, attachments.Register(txID, attachment3, now.Add(2*time.Second)))
+	require.False(t, attachments.Register(txID, attachment3, now.Add(2*time.Second)))
+	require.Equal(t, 3, attachments.Count(txID))
+
+	earliestAttachmentTime, exists := attachments.EarliestAttachmentTime(txID)
+	require.True(t, exists)
+	require.Equal(t, now, earliestAttachmentTime)
+
+	// the reattachment replaces the orphaned earliest attachment
+	require.True(t, attachments.Unregister(txID, attachment2))
+	require.False(t, attachments.Unregister(txID, attachment2))
+	earliestAttachmentTime, _ = attachments.EarliestAttachmentTime(txID)
+	require.Equal(t, now.Add(time.Second), earliestAttachmentTime)
+
+	attachments.Evict(1)
+	require.Equal(t, models.NewBlockIDs(attachment3), attachments.Get(txID))
+
+	tf.Instance.PruneTransaction(txID, false)
+	require.Zero(t, attachments.Count(txID))
+}
+
+func TestLedger_CacheSize(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"),
+		realitiesledger.WithOutputCacheTime(time.Minute),
+		realitiesledger.WithOutputCacheSize(2),
+	)
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	tf.CreateTransaction("TX3", 1, "G.2")
+	require.NoError(t, tf.IssueTransactions("G", "TX1", "TX2", "TX3"))
+
+	for _, outputAlias := range []string{"G.0", "G.1", "G.2", "TX1.0", "TX2.0", "TX3.0"} {
+		tf.Instance.Storage().CachedOutput(tf.OutputID(outputAlias)).Release()
+	}
+
+	cacheStatistics := tf.Instance.Storage().CacheStatistics()
+	require.Equal(t, 2, cacheStatistics["output"].MaxSize)
+	require.LessOrEqual(t, cacheStatistics["output"].Size, 2)
+	require.Zero(t, cacheStatistics["outputMetadata"].MaxSize)
+
+	// the most recently used outputs are answered by the cache
+	lookups, hits := cacheStatistics["output"].Lookups, cacheStatistics["output"].Hits
+	tf.Instance.Storage().CachedOutput(tf.OutputID("TX3.0")).Release()
+	require.Equal(t, hits+1, tf.Instance.Storage().CacheStatistics()["output"].Hits)
+	require.Equal(t, lookups+1, tf.Instance.Storage().CacheStatistics()["output"].Lookups)
+}
+
+func TestLedger_AdjustCacheSettings(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"),
+		realitiesledger.WithOutputCacheTime(time.Minute),
+		realitiesledger.WithOutputCacheSize(3),
+	)
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	for _, outputAlias := range []string{"G.0", "G.1", "G.2"} {
+		tf.Instance.Storage().CachedOutput(tf.OutputID(outputAlias)).Release()
+	}
+	require.Equal(t, 3, tf.Instance.Storage().CacheStatistics()["output"].Size)
+	require.Equal(t, time.Minute, tf.Instance.Storage().CacheStatistics()["output"].CacheTime)
+
+	// shrinking the cache evicts the least recently used objects right away
+	require.NoError(t, tf.Instance.Storage().SetCacheSize("output", 1))
+	require.Equal(t, 1, tf.Instance.Storage().CacheStatistics()["output"].Size)
+	require.Equal(t, 1, tf.Instance.Storage().CacheStatistics()["output"].MaxSize)
+
+	// disabling the cache time releases the retained objects
+	require.NoError(t, tf.Instance.Storage().SetCacheTime("output", 0))
+	require.Zero(t, tf.Instance.Storage().CacheStatistics()["output"].Size)
+	tf.Instance.Storage().CachedOutput(tf.OutputID("G.0")).Release()
+	require.Zero(t, tf.Instance.Storage().CacheStatistics()["output"].Size)
+
+	require.NoError(t, tf.Instance.Storage().SetCacheTime("output", time.Minute))
+	tf.Instance.Storage().CachedOutput(tf.OutputID("G.0")).Release()
+	require.Equal(t, 1, tf.Instance.Storage().CacheStatistics()["output"].Size)
+
+	require.Error(t, tf.Instance.Storage().SetCacheSize("unknown", 1))
+	require.Error(t, tf.Instance.Storage().SetCacheTime("output", -time.Second))
+}
+
+func TestLedger_ForEachOutputIDCanceled(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	var outputIDs []utxo.OutputID
+	require.NoError(t, tf.Instance.Storage().ForEachOutputID(context.Background(), func(outputID utxo.OutputID) bool {
+		outputIDs = append(outputIDs, outputID)
+		return true
+	}))
+	require.Len(t, outputIDs, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outputIDs = nil
+	err := tf.Instance.Storage().ForEachOutputID(ctx, func(outputID utxo.OutputID) bool {
+		outputIDs = append(outputIDs, outputID)
+		cancel()
+
+		return true
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, outputIDs, 1)
+}
+
+func TestLedger_StorageSnapshot(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	require.NoError(t, tf.IssueTransactions("G", "TX2"))
+
+	snapshot := tf.Instance.Storage().Snapshot()
+	defer snapshot.Release()
+
+	// change the storage after the snapshot was taken (create, modify and delete outputs)
+	require.NoError(t, tf.IssueTransactions("TX1"))
+	tf.Instance.SetTransactionInclusionSlot(tf.Transaction("G").ID(), 5)
+	tf.Instance.PruneTransaction(tf.Transaction("TX2").ID(), false)
+
+	var outputIDs []utxo.OutputID
+	require.NoError(t, snapshot.ForEachOutputID(context.Background(), func(outputID utxo.OutputID) bool {
+		outputIDs = append(outputIDs, outputID)
+		return true
+	}))
+	require.Len(t, outputIDs, 5)
+	require.Contains(t, outputIDs, tf.OutputID("TX2.0"))
+	require.NotContains(t, outputIDs, tf.OutputID("TX1.0"))
+
+	_, exists := snapshot.Output(tf.OutputID("TX1.0"))
+	require.False(t, exists)
+	_, exists = snapshot.OutputMetadata(tf.OutputID("TX1.0"))
+	require.False(t, exists)
+
+	output, exists := snapshot.Output(tf.OutputID("TX2.0"))
+	require.True(t, exists)
+	require.Equal(t, tf.OutputID("TX2.0"), output.ID())
+	_, exists = snapshot.OutputMetadata(tf.OutputID("TX2.0"))
+	require.True(t, exists)
+	require.False(t, tf.Instance.Storage().CachedOutput(tf.OutputID("TX2.0")).Consume(func(utxo.Output) {}))
+
+	outputMetadata, exists := snapshot.OutputMetadata(tf.OutputID("G.0"))
+	require.True(t, exists)
+	require.False(t, outputMetadata.IsSpent())
+	require.Equal(t, slot.Index(0), outputMetadata.InclusionSlot())
+
+	require.True(t, tf.Instance.Storage().CachedOutputMetadata(tf.OutputID("G.0")).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		require.True(t, outputMetadata.IsSpent())
+		require.Equal(t, slot.Index(5), outputMetadata.InclusionSlot())
+	}))
+}
+
+func TestLedger_StorageSnapshotOverflow(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"), realitiesledger.WithMaxSnapshotRecords(5))
+
+	tf.CreateTransaction("G", 3, "Genesis")
+	tf.CreateTransaction("TX1", 6, "G.0")
+	require.NoError(t, tf.IssueTransactions("G"))
+
+	snapshot := tf.Instance.Storage().Snapshot()
+	defer snapshot.Release()
+	require.NoError(t, snapshot.Err())
+
+	// the transaction creates more outputs than the snapshot is allowed to record
+	require.NoError(t, tf.IssueTransactions("TX1"))
+	require.ErrorIs(t, snapshot.Err(), mempool.ErrSnapshotOverflow)
+	require.ErrorIs(t, snapshot.ForEachOutputID(context.Background(), func(utxo.OutputID) bool {
+		return true
+	}), mempool.ErrSnapshotOverflow)
 }
 
 func TestLedger_Aliases(t *testing.T) {
@@ -580,6 +1234,10 @@ func TestLedger_AcceptanceRaceCondition(t *testing.T) {
 	tf.Instance.SetTransactionInclusionSlot(tf.Transaction("TX1").ID(), 1)
 
 	tf.AssertTransactionConfirmationState("TX1", confirmation.State.IsAccepted)
+	tf.AssertConfirmedConsumers(map[string]string{
+		"Genesis": "TX1",
+		"TX1.0":   "",
+	})
 
 	require.NoError(t, tf.IssueTransactions("TX1*"))
 
@@ -603,4 +1261,95 @@ func TestLedger_AcceptanceRaceCondition(t *testing.T) {
 	tf.AssertConflicts(map[string][]string{
 		"Genesis": {"TX1", "TX1*"},
 	})
+
+	tf.AssertConfirmedConsumers(map[string]string{
+		"Genesis": "TX1",
+	})
+
+	// metadata that was stored before the confirmed consumer was recorded falls back to the accepted consumer
+	tf.ConsumeOutputMetadata(tf.OutputID("Genesis"), func(outputMetadata *mempool.OutputMetadata) {
+		outputMetadata.SetConfirmedConsumer(utxo.EmptyTransactionID)
+	})
+	require.Equal(t, tf.Transaction("TX1").ID(), tf.Instance.Utils().ConfirmedConsumer(tf.OutputID("Genesis")))
+	require.Equal(t, utxo.EmptyTransactionID, tf.Instance.Utils().ConfirmedConsumer(tf.OutputID("TX1.0")))
+}
+
+func TestLedger_MergeConflictsToMaster(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	defer workers.Shutdown()
+
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"), realitiesledger.WithConflictDAGOptions(
+		conflictdag.CompactResolvedConflicts[utxo.TransactionID, utxo.OutputID](true),
+	))
+
+	mergedTransactions := make(map[utxo.TransactionID]utxo.TransactionID)
+	var mergedTransactionsMutex sync.Mutex
+	tf.Instance.Events().TransactionConflictIDsMerged.Hook(func(event *mempool.TransactionConflictIDsMergedEvent) {
+		mergedTransactionsMutex.Lock()
+		defer mergedTransactionsMutex.Unlock()
+
+		mergedTransactions[event.TransactionID] = event.MergedConflictID
+	})
+
+	tf.CreateTransaction("G", 2, "Genesis")
+	tf.CreateTransaction("TXA", 1, "G.0")
+	tf.CreateTransaction("TXB", 1, "G.0")
+	tf.CreateTransaction("TXC", 1, "G.1")
+	tf.CreateTransaction("TXD", 1, "G.1")
+	tf.CreateTransaction("TXE", 1, "TXA.0", "TXC.0")
+
+	require.NoError(t, tf.IssueTransactions("G", "TXA", "TXB", "TXC", "TXD", "TXE"))
+
+	tf.AssertConflictIDs(map[string][]string{
+		"TXA": {"TXA"},
+		"TXC": {"TXC"},
+		"TXE": {"TXA", "TXC"},
+	})
+
+	require.True(t, tf.Instance.ConflictDAG().SetConflictAccepted(tf.Transaction("TXA").ID()))
+	workers.WaitChildren()
+
+	tf.AssertConflictIDs(map[string][]string{
+		"TXA": {},
+		"TXB": {"TXB"},
+		"TXC": {"TXC"},
+		"TXE": {"TXC"},
+	})
+
+	require.Equal(t, map[utxo.TransactionID]utxo.TransactionID{
+		tf.Transaction("TXA").ID(): tf.Transaction("TXA").ID(),
+		tf.Transaction("TXE").ID(): tf.Transaction("TXA").ID(),
+	}, mergedTransactions)
+}
+
+func TestLedger_GeneratedScenario(t *testing.T) {
+	for _, seed := range []int64{1, 42, 1337} {
+		workers := workerpool.NewGroup(t.Name())
+		tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+		scenario := tf.GenerateScenario(seed, 200, 0.2, mempool.WithMaxInputs(3), mempool.WithMaxOutputs(4))
+		require.NotEmpty(t, scenario.ConflictSets)
+
+		require.NoError(t, tf.IssueTransactions(scenario.TransactionAliases...))
+		workers.WaitChildren()
+
+		tf.AssertScenarioInvariants(scenario)
+
+		replayedTF := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("ReplayedLedgerTestFramework"))
+		replayedScenario := replayedTF.GenerateScenario(seed, 200, 0.2, mempool.WithMaxInputs(3), mempool.WithMaxOutputs(4))
+		require.Equal(t, scenario.TransactionAliases, replayedScenario.TransactionAliases)
+		require.Equal(t, scenario.Inputs, replayedScenario.Inputs)
+		require.Equal(t, scenario.ConflictSets, replayedScenario.ConflictSets)
+	}
+}
+
+func TestLedger_GeneratedScenarioConcurrently(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	scenario := tf.GenerateScenario(7, 500, 0.2)
+	require.NoError(t, tf.IssueTransactionsConcurrently(scenario.TransactionAliases...))
+	workers.WaitChildren()
+
+	tf.AssertScenarioInvariants(scenario)
 }
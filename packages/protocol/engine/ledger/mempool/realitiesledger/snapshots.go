@@ -0,0 +1,345 @@
+package realitiesledger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/objectstorage/generic"
+)
+
+// region snapshots ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// snapshots is a Storage component that manages the open storageSnapshots and records the state of the Outputs and
+// their OutputMetadata before they are changed, so that the open storageSnapshots keep seeing the state at the time
+// they were taken (copy-on-write). The number of changes that a storageSnapshot records is bounded, so that a snapshot
+// that is held for too long does not accumulate the changes of the storage indefinitely.
+type snapshots struct {
+	// open contains the storageSnapshots that were not released yet.
+	open map[*storageSnapshot]types.Empty
+
+	// maxRecords contains the maximum number of changes that a storageSnapshot records (0 means unlimited).
+	maxRecords int
+
+	// mutex is used to synchronize the access to the open storageSnapshots.
+	mutex sync.RWMutex
+}
+
+// newSnapshots returns a new snapshots instance that limits the recorded changes of a storageSnapshot to maxRecords.
+func newSnapshots(maxRecords int) *snapshots {
+	return &snapshots{
+		open:       make(map[*storageSnapshot]types.Empty),
+		maxRecords: maxRecords,
+	}
+}
+
+// register opens a new storageSnapshot of the given Storage.
+func (s *snapshots) register(storage *Storage) (snapshot *storageSnapshot) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot = newStorageSnapshot(storage, s.maxRecords)
+	s.open[snapshot] = types.Void
+
+	return snapshot
+}
+
+// unregister closes the given storageSnapshot (it stops recording changes for it).
+func (s *snapshots) unregister(snapshot *storageSnapshot) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.open, snapshot)
+}
+
+// outputCreated records that the Output with the given ID is about to be created.
+func (s *snapshots) outputCreated(outputID utxo.OutputID) {
+	s.forEachOpen(func(snapshot *storageSnapshot) {
+		snapshot.recordCreated(outputID)
+	})
+}
+
+// outputDeleted records the given Output before it is deleted.
+func (s *snapshots) outputDeleted(output utxo.Output) {
+	s.forEachOpen(func(snapshot *storageSnapshot) {
+		snapshot.recordDeleted(output)
+	})
+}
+
+// outputMetadataAccessed records the given OutputMetadata before it is handed out to a (potentially modifying) caller.
+func (s *snapshots) outputMetadataAccessed(outputMetadata *mempool.OutputMetadata) {
+	s.forEachOpen(func(snapshot *storageSnapshot) {
+		snapshot.recordOutputMetadata(outputMetadata)
+	})
+}
+
+// forEachOpen executes the callback for all open storageSnapshots and stops recording the changes for the
+// storageSnapshots that overflowed.
+func (s *snapshots) forEachOpen(callback func(snapshot *storageSnapshot)) {
+	overflowedSnapshots := make([]*storageSnapshot, 0)
+
+	s.mutex.RLock()
+	for snapshot := range s.open {
+		if callback(snapshot); snapshot.Err() != nil {
+			overflowedSnapshots = append(overflowedSnapshots, snapshot)
+		}
+	}
+	s.mutex.RUnlock()
+
+	for _, overflowedSnapshot := range overflowedSnapshots {
+		s.unregister(overflowedSnapshot)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region storageSnapshot //////////////////////////////////////////////////////////////////////////////////////////////
+
+// storageSnapshot is a consistent, read-only view of the Outputs and the OutputMetadata of a Storage at the time it
+// was taken. It reads unchanged objects from the Storage and falls back to the recorded state of the objects that
+// were changed after it was taken. If more changes happen than it is allowed to record, it drops the recorded state
+// and fails with ErrSnapshotOverflow.
+type storageSnapshot struct {
+	// storage contains a reference to the Storage that the snapshot was taken of.
+	storage *Storage
+
+	// createdOutputIDs contains the IDs of the Outputs that were created after the snapshot was taken.
+	createdOutputIDs utxo.OutputIDs
+
+	// deletedOutputs contains the Outputs that were deleted after the snapshot was taken.
+	deletedOutputs map[utxo.OutputID]utxo.Output
+
+	// outputsMetadata contains the state of the OutputMetadata that were accessed after the snapshot was taken.
+	outputsMetadata map[utxo.OutputID]*mempool.OutputMetadata
+
+	// maxRecords contains the maximum number of changes that the snapshot records (0 means unlimited).
+	maxRecords int
+
+	// err contains ErrSnapshotOverflow once the snapshot recorded more than maxRecords changes.
+	err error
+
+	// mutex is used to synchronize the access to the recorded state.
+	mutex sync.RWMutex
+
+	// releaseOnce is used to ensure that the snapshot is only released a single time.
+	releaseOnce sync.Once
+}
+
+// newStorageSnapshot returns a new storageSnapshot of the given Storage that records at most maxRecords changes.
+func newStorageSnapshot(storage *Storage, maxRecords int) *storageSnapshot {
+	return &storageSnapshot{
+		storage:          storage,
+		createdOutputIDs: utxo.NewOutputIDs(),
+		deletedOutputs:   make(map[utxo.OutputID]utxo.Output),
+		outputsMetadata:  make(map[utxo.OutputID]*mempool.OutputMetadata),
+		maxRecords:       maxRecords,
+	}
+}
+
+// Output returns the Output with the given ID as it existed when the snapshot was taken.
+func (s *storageSnapshot) Output(outputID utxo.OutputID) (output utxo.Output, exists bool) {
+	// the Storage needs to be read before the recorded state, as changes are recorded before they are applied
+	s.storage.outputStorage.Load(lo.PanicOnErr(outputID.Bytes())).Consume(func(storedOutput utxo.Output) {
+		output, exists = storedOutput, true
+	})
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if deletedOutput, deleted := s.deletedOutputs[outputID]; deleted {
+		return deletedOutput, true
+	}
+
+	if s.createdOutputIDs.Has(outputID) {
+		return nil, false
+	}
+
+	return output, exists
+}
+
+// OutputMetadata returns a copy of the OutputMetadata of the Output with the given ID as it existed when the snapshot
+// was taken.
+func (s *storageSnapshot) OutputMetadata(outputID utxo.OutputID) (outputMetadata *mempool.OutputMetadata, exists bool) {
+	// the Storage needs to be read before the recorded state, as changes are recorded before they are applied
+	s.storage.outputMetadataStorage.Load(lo.PanicOnErr(outputID.Bytes())).Consume(func(storedOutputMetadata *mempool.OutputMetadata) {
+		outputMetadata, exists = cloneOutputMetadata(storedOutputMetadata), true
+	})
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if recordedOutputMetadata, recorded := s.outputsMetadata[outputID]; recorded {
+		return cloneOutputMetadata(recordedOutputMetadata), true
+	}
+
+	if s.createdOutputIDs.Has(outputID) {
+		return nil, false
+	}
+
+	return outputMetadata, exists
+}
+
+// ForEachOutputID iterates over the IDs of the Outputs that existed when the snapshot was taken until the callback
+// returns false. The iteration is aborted with the error of the context if the context is canceled.
+func (s *storageSnapshot) ForEachOutputID(ctx context.Context, callback func(utxo.OutputID) bool) (err error) {
+	// the IDs are collected first, as the stored IDs and the recorded changes can not be merged in a single pass
+	outputIDs := utxo.NewOutputIDs()
+	if err = s.storage.ForEachOutputID(ctx, func(outputID utxo.OutputID) bool {
+		outputIDs.Add(outputID)
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to collect output IDs")
+	}
+
+	s.mutex.RLock()
+	if s.err != nil {
+		s.mutex.RUnlock()
+
+		return errors.Wrap(s.err, "failed to collect output IDs")
+	}
+	for outputID := range s.deletedOutputs {
+		outputIDs.Add(outputID)
+	}
+	for it := s.createdOutputIDs.Iterator(); it.HasNext(); {
+		if outputID := it.Next(); s.deletedOutputs[outputID] == nil {
+			outputIDs.Delete(outputID)
+		}
+	}
+	s.mutex.RUnlock()
+
+	for it := outputIDs.Iterator(); it.HasNext(); {
+		if err = ctx.Err(); err != nil {
+			return errors.Wrap(err, "iteration over outputs aborted")
+		}
+
+		if err = s.Err(); err != nil {
+			return errors.Wrap(err, "iteration over outputs aborted")
+		}
+
+		if !callback(it.Next()) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Err returns ErrSnapshotOverflow if the snapshot recorded more changes than allowed.
+func (s *storageSnapshot) Err() (err error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.err
+}
+
+// Release releases the snapshot and the recorded state (it needs to be called once the snapshot is no longer needed).
+func (s *storageSnapshot) Release() {
+	s.releaseOnce.Do(func() {
+		s.storage.snapshots.unregister(s)
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.createdOutputIDs = utxo.NewOutputIDs()
+		s.deletedOutputs = make(map[utxo.OutputID]utxo.Output)
+		s.outputsMetadata = make(map[utxo.OutputID]*mempool.OutputMetadata)
+	})
+}
+
+// recordCachedOutputsMetadata records the OutputMetadata that are currently cached, as their holders might still
+// modify them after the snapshot was taken.
+func (s *storageSnapshot) recordCachedOutputsMetadata() {
+	s.storage.outputMetadataStorage.ForEach(func(key []byte, cachedOutputMetadata *generic.CachedObject[*mempool.OutputMetadata]) bool {
+		cachedOutputMetadata.Consume(s.recordOutputMetadata)
+		return true
+	}, objectstorage.WithIteratorSkipStorage(true))
+}
+
+// recordCreated records that the Output with the given ID was created after the snapshot was taken.
+func (s *storageSnapshot) recordCreated(outputID utxo.OutputID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	if _, recorded := s.outputsMetadata[outputID]; recorded {
+		return
+	}
+	if _, deleted := s.deletedOutputs[outputID]; deleted {
+		return
+	}
+
+	s.createdOutputIDs.Add(outputID)
+	s.checkOverflow()
+}
+
+// recordDeleted records the given Output before it is deleted (unless it was created after the snapshot was taken).
+func (s *storageSnapshot) recordDeleted(output utxo.Output) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	if _, deleted := s.deletedOutputs[output.ID()]; deleted || s.createdOutputIDs.Has(output.ID()) {
+		return
+	}
+
+	s.deletedOutputs[output.ID()] = output
+	s.checkOverflow()
+}
+
+// recordOutputMetadata records a copy of the given OutputMetadata unless an earlier state was already recorded or the
+// Output was created after the snapshot was taken.
+func (s *storageSnapshot) recordOutputMetadata(outputMetadata *mempool.OutputMetadata) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	if _, recorded := s.outputsMetadata[outputMetadata.ID()]; recorded || s.createdOutputIDs.Has(outputMetadata.ID()) {
+		return
+	}
+
+	s.outputsMetadata[outputMetadata.ID()] = cloneOutputMetadata(outputMetadata)
+	s.checkOverflow()
+}
+
+// checkOverflow drops the recorded state and sets ErrSnapshotOverflow if the snapshot recorded more changes than
+// allowed (it expects the snapshot to be locked).
+func (s *storageSnapshot) checkOverflow() {
+	if s.maxRecords == 0 || s.createdOutputIDs.Size()+len(s.deletedOutputs)+len(s.outputsMetadata) <= s.maxRecords {
+		return
+	}
+
+	s.err = errors.WithMessagef(mempool.ErrSnapshotOverflow, "more than %d changes were recorded", s.maxRecords)
+	s.createdOutputIDs = utxo.NewOutputIDs()
+	s.deletedOutputs = make(map[utxo.OutputID]utxo.Output)
+	s.outputsMetadata = make(map[utxo.OutputID]*mempool.OutputMetadata)
+}
+
+// cloneOutputMetadata returns a copy of the given OutputMetadata.
+func cloneOutputMetadata(outputMetadata *mempool.OutputMetadata) (clone *mempool.OutputMetadata) {
+	clone = new(mempool.OutputMetadata)
+	if err := clone.FromObjectStorage(outputMetadata.ObjectStorageKey(), outputMetadata.ObjectStorageValue()); err != nil {
+		panic(errors.Wrapf(err, "failed to clone metadata of output %s", outputMetadata.ID()))
+	}
+
+	return clone
+}
+
+// code contract (make sure the type implements all required methods).
+var _ mempool.StorageSnapshot = new(storageSnapshot)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -3,6 +3,7 @@ package realitiesledger
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -40,6 +41,24 @@ type Storage struct {
 	// consumerStorage is an object storage used to persist Consumer objects.
 	consumerStorage *generic.ObjectStorage[*mempool.Consumer]
 
+	// transactionCache retains the recently used Transactions of the transactionStorage.
+	transactionCache *retentionCache[utxo.Transaction]
+
+	// transactionMetadataCache retains the recently used TransactionMetadata of the transactionMetadataStorage.
+	transactionMetadataCache *retentionCache[*mempool.TransactionMetadata]
+
+	// outputCache retains the recently used Outputs of the outputStorage.
+	outputCache *retentionCache[utxo.Output]
+
+	// outputMetadataCache retains the recently used OutputMetadata of the outputMetadataStorage.
+	outputMetadataCache *retentionCache[*mempool.OutputMetadata]
+
+	// consumerCache retains the recently used Consumers of the consumerStorage.
+	consumerCache *retentionCache[*mempool.Consumer]
+
+	// snapshots manages the open snapshots of the outputStorage and the outputMetadataStorage.
+	snapshots *snapshots
+
 	// ledger contains a reference to the RealitiesLedger that created the storage.
 	ledger *RealitiesLedger
 
@@ -53,34 +72,40 @@ func newStorage(l *RealitiesLedger, baseStore kvstore.KVStore) (storage *Storage
 		transactionStorage: generic.NewInterfaceStorage[utxo.Transaction](
 			lo.PanicOnErr(baseStore.WithExtendedRealm([]byte{database.PrefixLedger, PrefixTransactionStorage})),
 			transactionFactory(l.optsVM),
-			l.optsCacheTimeProvider.CacheTime(l.optsTransactionCacheTime),
+			objectstorage.CacheTime(0),
 			objectstorage.LeakDetectionEnabled(false),
 			objectstorage.StoreOnCreation(true),
 		),
 		transactionMetadataStorage: generic.NewStructStorage[mempool.TransactionMetadata](
 			lo.PanicOnErr(baseStore.WithExtendedRealm([]byte{database.PrefixLedger, PrefixTransactionMetadataStorage})),
-			l.optsCacheTimeProvider.CacheTime(l.optTransactionMetadataCacheTime),
+			objectstorage.CacheTime(0),
 			objectstorage.LeakDetectionEnabled(false),
 		),
 		outputStorage: generic.NewInterfaceStorage[utxo.Output](
 			lo.PanicOnErr(baseStore.WithExtendedRealm([]byte{database.PrefixLedger, PrefixOutputStorage})),
 			outputFactory(l.optsVM),
-			l.optsCacheTimeProvider.CacheTime(l.optsOutputCacheTime),
+			objectstorage.CacheTime(0),
 			objectstorage.LeakDetectionEnabled(false),
 			objectstorage.StoreOnCreation(true),
 		),
 		outputMetadataStorage: generic.NewStructStorage[mempool.OutputMetadata](
 			lo.PanicOnErr(baseStore.WithExtendedRealm([]byte{database.PrefixLedger, PrefixOutputMetadataStorage})),
-			l.optsCacheTimeProvider.CacheTime(l.optsOutputMetadataCacheTime),
+			objectstorage.CacheTime(0),
 			objectstorage.LeakDetectionEnabled(false),
 		),
 		consumerStorage: generic.NewStructStorage[mempool.Consumer](
 			lo.PanicOnErr(baseStore.WithExtendedRealm([]byte{database.PrefixLedger, PrefixConsumerStorage})),
-			l.optsCacheTimeProvider.CacheTime(l.optsConsumerCacheTime),
+			objectstorage.CacheTime(0),
 			objectstorage.LeakDetectionEnabled(false),
 			objectstorage.PartitionKey(new(mempool.Consumer).KeyPartitions()...),
 		),
-		ledger: l,
+		transactionCache:         newRetentionCache[utxo.Transaction](l.optsTransactionCache, l.optsCacheTimeProvider),
+		transactionMetadataCache: newRetentionCache[*mempool.TransactionMetadata](l.optsTransactionMetadataCache, l.optsCacheTimeProvider),
+		outputCache:              newRetentionCache[utxo.Output](l.optsOutputCache, l.optsCacheTimeProvider),
+		outputMetadataCache:      newRetentionCache[*mempool.OutputMetadata](l.optsOutputMetadataCache, l.optsCacheTimeProvider),
+		consumerCache:            newRetentionCache[*mempool.Consumer](l.optsConsumerCache, l.optsCacheTimeProvider),
+		snapshots:                newSnapshots(l.optsMaxSnapshotRecords),
+		ledger:                   l,
 	}
 	return storage
 }
@@ -97,36 +122,37 @@ func (s *Storage) OutputMetadataStorage() *generic.ObjectStorage[*mempool.Output
 // can be used to dynamically Construct a non-existing Transaction.
 func (s *Storage) CachedTransaction(transactionID utxo.TransactionID, computeIfAbsentCallback ...func(transactionID utxo.TransactionID) utxo.Transaction) (cachedTransaction *generic.CachedObject[utxo.Transaction]) {
 	if len(computeIfAbsentCallback) >= 1 {
-		return s.transactionStorage.ComputeIfAbsent(lo.PanicOnErr(transactionID.Bytes()), func(key []byte) utxo.Transaction {
+		return s.transactionCache.lookup(s.transactionStorage.ComputeIfAbsent(lo.PanicOnErr(transactionID.Bytes()), func(key []byte) utxo.Transaction {
 			return computeIfAbsentCallback[0](transactionID)
-		})
+		}))
 	}
 
-	return s.transactionStorage.Load(lo.PanicOnErr(transactionID.Bytes()))
+	return s.transactionCache.lookup(s.transactionStorage.Load(lo.PanicOnErr(transactionID.Bytes())))
 }
 
 // CachedTransactionMetadata retrieves the CachedObject representing the named TransactionMetadata. The optional
 // computeIfAbsentCallback can be used to dynamically Construct a non-existing TransactionMetadata.
 func (s *Storage) CachedTransactionMetadata(transactionID utxo.TransactionID, computeIfAbsentCallback ...func(transactionID utxo.TransactionID) *mempool.TransactionMetadata) (cachedTransactionMetadata *generic.CachedObject[*mempool.TransactionMetadata]) {
 	if len(computeIfAbsentCallback) >= 1 {
-		return s.transactionMetadataStorage.ComputeIfAbsent(lo.PanicOnErr(transactionID.Bytes()), func(key []byte) *mempool.TransactionMetadata {
+		return s.transactionMetadataCache.lookup(s.transactionMetadataStorage.ComputeIfAbsent(lo.PanicOnErr(transactionID.Bytes()), func(key []byte) *mempool.TransactionMetadata {
 			return computeIfAbsentCallback[0](transactionID)
-		})
+		}))
 	}
 
-	return s.transactionMetadataStorage.Load(lo.PanicOnErr(transactionID.Bytes()))
+	return s.transactionMetadataCache.lookup(s.transactionMetadataStorage.Load(lo.PanicOnErr(transactionID.Bytes())))
 }
 
 // CachedOutput retrieves the CachedObject representing the named Output. The optional computeIfAbsentCallback can be
 // used to dynamically Construct a non-existing Output.
 func (s *Storage) CachedOutput(outputID utxo.OutputID, computeIfAbsentCallback ...func(outputID utxo.OutputID) utxo.Output) (cachedOutput *generic.CachedObject[utxo.Output]) {
 	if len(computeIfAbsentCallback) >= 1 {
-		return s.outputStorage.ComputeIfAbsent(lo.PanicOnErr(outputID.Bytes()), func(key []byte) utxo.Output {
+		return s.outputCache.lookup(s.outputStorage.ComputeIfAbsent(lo.PanicOnErr(outputID.Bytes()), func(key []byte) utxo.Output {
+			s.snapshots.outputCreated(outputID)
 			return computeIfAbsentCallback[0](outputID)
-		})
+		}))
 	}
 
-	return s.outputStorage.Load(lo.PanicOnErr(outputID.Bytes()))
+	return s.outputCache.lookup(s.outputStorage.Load(lo.PanicOnErr(outputID.Bytes())))
 }
 
 // CachedOutputs retrieves the CachedObjects containing the named Outputs.
@@ -143,12 +169,20 @@ func (s *Storage) CachedOutputs(outputIDs utxo.OutputIDs) (cachedOutputs generic
 // computeIfAbsentCallback can be used to dynamically Construct a non-existing OutputMetadata.
 func (s *Storage) CachedOutputMetadata(outputID utxo.OutputID, computeIfAbsentCallback ...func(outputID utxo.OutputID) *mempool.OutputMetadata) (cachedOutputMetadata *generic.CachedObject[*mempool.OutputMetadata]) {
 	if len(computeIfAbsentCallback) >= 1 {
-		return s.outputMetadataStorage.ComputeIfAbsent(lo.PanicOnErr(outputID.Bytes()), func(key []byte) *mempool.OutputMetadata {
+		cachedOutputMetadata = s.outputMetadataStorage.ComputeIfAbsent(lo.PanicOnErr(outputID.Bytes()), func(key []byte) *mempool.OutputMetadata {
+			s.snapshots.outputCreated(outputID)
 			return computeIfAbsentCallback[0](outputID)
 		})
+	} else {
+		cachedOutputMetadata = s.outputMetadataStorage.Load(lo.PanicOnErr(outputID.Bytes()))
 	}
 
-	return s.outputMetadataStorage.Load(lo.PanicOnErr(outputID.Bytes()))
+	// the OutputMetadata is mutable, so its current state is recorded for the open snapshots before it is handed out
+	if outputMetadata, exists := cachedOutputMetadata.Unwrap(); exists {
+		s.snapshots.outputMetadataAccessed(outputMetadata)
+	}
+
+	return s.outputMetadataCache.lookup(cachedOutputMetadata)
 }
 
 // CachedOutputsMetadata retrieves the CachedObjects containing the named OutputMetadata.
@@ -164,36 +198,67 @@ func (s *Storage) CachedOutputsMetadata(outputIDs utxo.OutputIDs) (cachedOutputs
 // CachedConsumer retrieves the CachedObject representing the named Consumer. The optional computeIfAbsentCallback can
 // be used to dynamically Construct a non-existing Consumer.
 func (s *Storage) CachedConsumer(outputID utxo.OutputID, txID utxo.TransactionID, computeIfAbsentCallback ...func(outputID utxo.OutputID, txID utxo.TransactionID) *mempool.Consumer) (cachedConsumer *generic.CachedObject[*mempool.Consumer]) {
+	consumerKey := byteutils.ConcatBytes(lo.PanicOnErr(outputID.Bytes()), lo.PanicOnErr(txID.Bytes()))
 	if len(computeIfAbsentCallback) >= 1 {
-		return s.consumerStorage.ComputeIfAbsent(byteutils.ConcatBytes(lo.PanicOnErr(outputID.Bytes()), lo.PanicOnErr(txID.Bytes())), func(key []byte) *mempool.Consumer {
+		return s.consumerCache.lookup(s.consumerStorage.ComputeIfAbsent(consumerKey, func(key []byte) *mempool.Consumer {
 			return computeIfAbsentCallback[0](outputID, txID)
-		})
+		}))
 	}
 
-	return s.consumerStorage.Load(byteutils.ConcatBytes(lo.PanicOnErr(outputID.Bytes()), lo.PanicOnErr(txID.Bytes())))
+	return s.consumerCache.lookup(s.consumerStorage.Load(consumerKey))
 }
 
 // CachedConsumers retrieves the CachedObjects containing the named Consumers.
 func (s *Storage) CachedConsumers(outputID utxo.OutputID) (cachedConsumers generic.CachedObjects[*mempool.Consumer]) {
 	cachedConsumers = make(generic.CachedObjects[*mempool.Consumer], 0)
 	s.consumerStorage.ForEach(func(key []byte, cachedObject *generic.CachedObject[*mempool.Consumer]) bool {
-		cachedConsumers = append(cachedConsumers, cachedObject)
+		cachedConsumers = append(cachedConsumers, s.consumerCache.track(cachedObject))
 		return true
 	}, objectstorage.WithIteratorPrefix(lo.PanicOnErr(outputID.Bytes())))
 
 	return
 }
 
-func (s *Storage) ForEachOutputID(callback func(utxo.OutputID) bool) {
-	s.outputStorage.ForEach(func(key []byte, _ *generic.CachedObject[utxo.Output]) bool {
+// ForEachOutputID iterates over the IDs of the stored Outputs until the callback returns false. The iteration is
+// aborted with the error of the context if the context is canceled.
+func (s *Storage) ForEachOutputID(ctx context.Context, callback func(utxo.OutputID) bool) (err error) {
+	s.outputStorage.ForEachKeyOnly(func(key []byte) bool {
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+
 		outputID := new(utxo.OutputID)
 		_ = lo.PanicOnErr(outputID.FromBytes(key))
 		return callback(*outputID)
 	})
+
+	return errors.Wrap(err, "iteration over outputs aborted")
+}
+
+// Snapshot returns a consistent view of the stored Outputs and their OutputMetadata that is not affected by changes
+// that happen after it was taken (it needs to be released once it is no longer needed).
+func (s *Storage) Snapshot() (snapshot mempool.StorageSnapshot) {
+	storageSnapshot := s.snapshots.register(s)
+	storageSnapshot.recordCachedOutputsMetadata()
+
+	return storageSnapshot
+}
+
+// CacheStatistics returns the CacheStatistics of the underlying object storages (indexed by their name).
+func (s *Storage) CacheStatistics() (cacheStatistics map[string]mempool.CacheStatistics) {
+	return map[string]mempool.CacheStatistics{
+		"transaction":         storageCacheStatistics(s.transactionStorage, s.transactionCache),
+		"transactionMetadata": storageCacheStatistics(s.transactionMetadataStorage, s.transactionMetadataCache),
+		"output":              storageCacheStatistics(s.outputStorage, s.outputCache),
+		"outputMetadata":      storageCacheStatistics(s.outputMetadataStorage, s.outputMetadataCache),
+		"consumer":            storageCacheStatistics(s.consumerStorage, s.consumerCache),
+	}
 }
 
 // Prune resets the database and deletes all entities.
 func (s *Storage) Prune() (err error) {
+	s.releaseCaches()
+
 	for _, storagePrune := range []func() error{
 		s.transactionStorage.Prune,
 		s.transactionMetadataStorage.Prune,
@@ -213,6 +278,8 @@ func (s *Storage) Prune() (err error) {
 // Shutdown shuts down the KVStores used to persist data.
 func (s *Storage) Shutdown() {
 	s.shutdownOnce.Do(func() {
+		s.releaseCaches()
+
 		s.transactionStorage.Shutdown()
 		s.transactionMetadataStorage.Shutdown()
 		s.outputStorage.Shutdown()
@@ -221,6 +288,58 @@ func (s *Storage) Shutdown() {
 	})
 }
 
+// SetCacheTime updates the duration that the objects of the named storage stay cached after they have been released
+// (it also applies to the objects that are already cached).
+func (s *Storage) SetCacheTime(storageName string, cacheTime time.Duration) (err error) {
+	if cacheTime < 0 {
+		return errors.Errorf("invalid cache time %s", cacheTime)
+	}
+
+	cache, exists := s.caches()[storageName]
+	if !exists {
+		return errors.Errorf("unknown storage %s", storageName)
+	}
+	cache.setCacheTime(cacheTime)
+
+	return nil
+}
+
+// SetCacheSize updates the maximum number of released objects of the named storage that stay cached (0 means
+// unlimited) - exceeding objects are evicted right away.
+func (s *Storage) SetCacheSize(storageName string, maxSize int) (err error) {
+	if maxSize < 0 {
+		return errors.Errorf("invalid cache size %d", maxSize)
+	}
+
+	cache, exists := s.caches()[storageName]
+	if !exists {
+		return errors.Errorf("unknown storage %s", storageName)
+	}
+	cache.setMaxSize(maxSize)
+
+	return nil
+}
+
+// caches returns the caches of the object storages (indexed by the names of the storages).
+func (s *Storage) caches() (caches map[string]adjustableCache) {
+	return map[string]adjustableCache{
+		"transaction":         s.transactionCache,
+		"transactionMetadata": s.transactionMetadataCache,
+		"output":              s.outputCache,
+		"outputMetadata":      s.outputMetadataCache,
+		"consumer":            s.consumerCache,
+	}
+}
+
+// releaseCaches releases the objects that are retained by the caches of the storages.
+func (s *Storage) releaseCaches() {
+	s.transactionCache.releaseAll()
+	s.transactionMetadataCache.releaseAll()
+	s.outputCache.releaseAll()
+	s.outputMetadataCache.releaseAll()
+	s.consumerCache.releaseAll()
+}
+
 // storeTransactionCommand is a ChainedCommand that stores a Transaction.
 func (s *Storage) storeTransactionCommand(params *dataFlowParams, next dataflow.Next[*dataFlowParams]) (err error) {
 	created := false
@@ -247,13 +366,23 @@ func (s *Storage) storeTransactionCommand(params *dataFlowParams, next dataflow.
 	defer cachedConsumers.Release()
 	params.Consumers = cachedConsumers.Unwrap(true)
 
-	s.ledger.events.TransactionStored.Trigger(&mempool.TransactionStoredEvent{
-		TransactionID: params.Transaction.ID(),
+	params.triggerEvent(func() {
+		s.ledger.events.TransactionStored.Trigger(&mempool.TransactionStoredEvent{
+			TransactionID: params.Transaction.ID(),
+		})
 	})
 
 	return next(params)
 }
 
+// storeOutput stores a newly created Output together with its OutputMetadata.
+func (s *Storage) storeOutput(output utxo.Output, outputMetadata *mempool.OutputMetadata) {
+	s.snapshots.outputCreated(output.ID())
+
+	s.outputMetadataStorage.Store(outputMetadata).Release()
+	s.outputStorage.Store(output).Release()
+}
+
 // initConsumers creates the Consumers of a Transaction if they didn't exist before.
 func (s *Storage) initConsumers(outputIDs utxo.OutputIDs, txID utxo.TransactionID) (cachedConsumers generic.CachedObjects[*mempool.Consumer]) {
 	cachedConsumers = make(generic.CachedObjects[*mempool.Consumer], 0)
@@ -267,60 +396,101 @@ func (s *Storage) initConsumers(outputIDs utxo.OutputIDs, txID utxo.TransactionI
 // pruneTransaction removes a Transaction (and all of its dependencies) from the database.
 func (s *Storage) pruneTransaction(txID utxo.TransactionID, pruneFutureCone bool) {
 	for futureConeWalker := walker.New[utxo.TransactionID]().Push(txID); futureConeWalker.HasNext(); {
-		spentOutputsWithMetadata := make([]*mempool.OutputWithMetadata, 0)
-
-		currentTxID := futureConeWalker.Next()
+		orphanedEvent, deleted := s.deleteTransaction(futureConeWalker.Next())
+		if !deleted {
+			continue
+		}
 
-		s.CachedTransactionMetadata(currentTxID).Consume(func(txMetadata *mempool.TransactionMetadata) {
-			s.CachedTransaction(currentTxID).Consume(func(tx utxo.Transaction) {
-				for it := s.ledger.Utils().ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
-					inputID := it.Next()
+		s.ledger.events.TransactionOrphaned.Trigger(orphanedEvent)
 
-					s.CachedOutput(inputID).Consume(func(output utxo.Output) {
-						s.CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
-							outputWithMetadata := mempool.NewOutputWithMetadata(outputMetadata.InclusionSlot(), outputMetadata.ID(), output, outputMetadata.ConsensusManaPledgeID(), outputMetadata.AccessManaPledgeID())
-							// TODO: we need to set the spent slot here
-							spentOutputsWithMetadata = append(spentOutputsWithMetadata, outputWithMetadata)
-						})
-					})
+		if pruneFutureCone {
+			s.ledger.Utils().WalkConsumingTransactionID(orphanedEvent.Metadata.OutputIDs(), func(consumingTxID utxo.TransactionID, walker *walker.Walker[utxo.OutputID]) {
+				futureConeWalker.Push(consumingTxID)
+			})
+		}
+	}
+}
 
-					s.consumerStorage.Delete(byteutils.ConcatBytes(lo.PanicOnErr(inputID.Bytes()), lo.PanicOnErr(currentTxID.Bytes())))
-				}
-				tx.Delete()
+// discardTransaction removes a Transaction that was stored as a member of a bundle that could not be booked (its events
+// were never triggered, so it is removed without triggering the TransactionOrphaned event).
+func (s *Storage) discardTransaction(txID utxo.TransactionID) {
+	s.CachedTransaction(txID).Consume(func(tx utxo.Transaction) {
+		for it := s.ledger.Utils().ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
+			s.CachedOutputMetadata(it.Next()).Consume(func(outputMetadata *mempool.OutputMetadata) {
+				outputMetadata.UnregisterBookedConsumer(txID)
 			})
+		}
+	})
 
-			createdOutputIDs := txMetadata.OutputIDs()
-			createdOutputsWithMetadata := make([]*mempool.OutputWithMetadata, 0, createdOutputIDs.Size())
-			for it := createdOutputIDs.Iterator(); it.HasNext(); {
-				outputID := it.Next()
-				outputIDBytes := lo.PanicOnErr(outputID.Bytes())
+	s.deleteTransaction(txID)
+}
+
+// deleteTransaction removes the given Transaction, its Consumers and its created Outputs from the Storage and returns
+// the event that describes the removed Transaction.
+func (s *Storage) deleteTransaction(txID utxo.TransactionID) (orphanedEvent *mempool.TransactionEvent, deleted bool) {
+	s.CachedTransactionMetadata(txID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		spentOutputsWithMetadata := make([]*mempool.OutputWithMetadata, 0)
 
-				s.CachedOutput(outputID).Consume(func(output utxo.Output) {
-					s.CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
-						// TODO: inclusion slot is not set here
-						createdOutputsWithMetadata = append(createdOutputsWithMetadata, mempool.NewOutputWithMetadata(outputMetadata.InclusionSlot(), outputMetadata.ID(), output, outputMetadata.ConsensusManaPledgeID(), outputMetadata.AccessManaPledgeID()))
+		s.CachedTransaction(txID).Consume(func(tx utxo.Transaction) {
+			for it := s.ledger.Utils().ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
+				inputID := it.Next()
+
+				s.CachedOutput(inputID).Consume(func(output utxo.Output) {
+					s.CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+						outputWithMetadata := mempool.NewOutputWithMetadata(outputMetadata.InclusionSlot(), outputMetadata.ID(), output, outputMetadata.ConsensusManaPledgeID(), outputMetadata.AccessManaPledgeID())
+						// TODO: we need to set the spent slot here
+						spentOutputsWithMetadata = append(spentOutputsWithMetadata, outputWithMetadata)
 					})
 				})
 
-				s.outputStorage.Delete(outputIDBytes)
-				s.outputMetadataStorage.Delete(outputIDBytes)
+				s.consumerStorage.Delete(byteutils.ConcatBytes(lo.PanicOnErr(inputID.Bytes()), lo.PanicOnErr(txID.Bytes())))
 			}
+			tx.Delete()
+		})
 
-			txMetadata.Delete()
+		createdOutputIDs := txMetadata.OutputIDs()
+		createdOutputsWithMetadata := make([]*mempool.OutputWithMetadata, 0, createdOutputIDs.Size())
+		for it := createdOutputIDs.Iterator(); it.HasNext(); {
+			outputID := it.Next()
+			outputIDBytes := lo.PanicOnErr(outputID.Bytes())
 
-			s.ledger.events.TransactionOrphaned.Trigger(&mempool.TransactionEvent{
-				Metadata:       txMetadata,
-				CreatedOutputs: createdOutputsWithMetadata,
-				SpentOutputs:   spentOutputsWithMetadata,
-			})
+			s.CachedOutput(outputID).Consume(func(output utxo.Output) {
+				s.snapshots.outputDeleted(output)
 
-			if pruneFutureCone {
-				s.ledger.Utils().WalkConsumingTransactionID(createdOutputIDs, func(consumingTxID utxo.TransactionID, walker *walker.Walker[utxo.OutputID]) {
-					futureConeWalker.Push(consumingTxID)
+				s.CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+					// TODO: inclusion slot is not set here
+					createdOutputsWithMetadata = append(createdOutputsWithMetadata, mempool.NewOutputWithMetadata(outputMetadata.InclusionSlot(), outputMetadata.ID(), output, outputMetadata.ConsensusManaPledgeID(), outputMetadata.AccessManaPledgeID()))
 				})
-			}
-		})
+			})
+
+			s.outputStorage.Delete(outputIDBytes)
+			s.outputMetadataStorage.Delete(outputIDBytes)
+		}
+
+		txMetadata.Delete()
+		s.ledger.attachments.Delete(txID)
+
+		orphanedEvent, deleted = &mempool.TransactionEvent{
+			Metadata:       txMetadata,
+			CreatedOutputs: createdOutputsWithMetadata,
+			SpentOutputs:   spentOutputsWithMetadata,
+		}, true
+	})
+
+	return orphanedEvent, deleted
+}
+
+// storageCacheStatistics returns the CacheStatistics of the given object storage (the Hits are the lookups that found
+// their object in the retentionCache and the Size of size limited storages is the number of objects that it retains).
+func storageCacheStatistics[T generic.StorableObject](objectStorage *generic.ObjectStorage[T], cache *retentionCache[T]) (statistics mempool.CacheStatistics) {
+	statistics = cache.statistics.Statistics()
+	if statistics.CacheTime, statistics.MaxSize = cache.settings(); statistics.MaxSize > 0 {
+		statistics.Size = cache.size()
+	} else {
+		statistics.Size = objectStorage.GetSize()
 	}
+
+	return statistics
 }
 
 // transactionFactory represents the object factory for the Transaction type.
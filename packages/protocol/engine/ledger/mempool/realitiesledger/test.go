@@ -27,5 +27,5 @@ func NewTestLedger(t *testing.T, workers *workerpool.Group, optsLedger ...option
 }
 
 func NewDefaultTestFramework(t *testing.T, workers *workerpool.Group, optsLedger ...options.Option[RealitiesLedger]) *mempool.TestFramework {
-	return mempool.NewTestFramework(t, NewTestLedger(t, workers.CreateGroup("RealitiesLedger"), optsLedger...))
+	return mempool.NewTestFramework(t, NewTestLedger(t, workers.CreateGroup("RealitiesLedger"), optsLedger...), mempool.WithSerializationVerification(true))
 }
@@ -0,0 +1,70 @@
+package realitiesledger
+
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+)
+
+// region traces ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// traces is a RealitiesLedger component that retains the execution Traces of the most recently processed Transactions.
+// The Traces are kept independently of the Transactions, so that they are still available after an invalid
+// Transaction was pruned.
+type traces struct {
+	// maxSize contains the maximum number of retained Traces (0 disables the tracing).
+	maxSize int
+
+	// traces contains the retained Traces by the ID of their Transaction.
+	traces map[utxo.TransactionID]*vm.Trace
+
+	// order contains the IDs of the Transactions of the retained Traces in the order they were stored.
+	order []utxo.TransactionID
+
+	// mutex is used to synchronize the access to the Traces.
+	mutex sync.RWMutex
+}
+
+// newTraces returns a new traces instance that retains up to maxSize Traces.
+func newTraces(maxSize int) *traces {
+	return &traces{
+		maxSize: maxSize,
+		traces:  make(map[utxo.TransactionID]*vm.Trace),
+		order:   make([]utxo.TransactionID, 0),
+	}
+}
+
+// enabled returns true if Traces are retained.
+func (t *traces) enabled() bool {
+	return t.maxSize > 0
+}
+
+// store retains the given Trace (it replaces the previous Trace of the same Transaction and evicts the oldest Trace
+// once more than maxSize Traces are retained).
+func (t *traces) store(trace *vm.Trace) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, exists := t.traces[trace.TransactionID]; !exists {
+		t.order = append(t.order, trace.TransactionID)
+	}
+	t.traces[trace.TransactionID] = trace
+
+	for len(t.order) > t.maxSize {
+		delete(t.traces, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+// get returns the retained Trace of the Transaction with the given ID.
+func (t *traces) get(txID utxo.TransactionID) (trace *vm.Trace, exists bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	trace, exists = t.traces[txID]
+
+	return trace, exists
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
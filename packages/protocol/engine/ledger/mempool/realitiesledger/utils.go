@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/ds/set"
 	"github.com/iotaledger/hive.go/ds/walker"
@@ -67,6 +68,20 @@ func (u *Utils) ResolveInputs(inputs []utxo.Input) (outputIDs utxo.OutputIDs) {
 	return utxo.NewOutputIDs(lo.Map(inputs, u.ledger.optsVM.ResolveInput)...)
 }
 
+// Outputs retrieves the Outputs with the given OutputIDs together with their OutputMetadata in a single batch
+// (unknown OutputIDs are missing in the results).
+func (u *Utils) Outputs(outputIDs ...utxo.OutputID) (outputs *utxo.Outputs, outputsMetadata *mempool.OutputsMetadata) {
+	requestedOutputIDs := utxo.NewOutputIDs(outputIDs...)
+
+	cachedOutputs := u.ledger.storage.CachedOutputs(requestedOutputIDs)
+	defer cachedOutputs.Release()
+
+	cachedOutputsMetadata := u.ledger.storage.CachedOutputsMetadata(requestedOutputIDs)
+	defer cachedOutputsMetadata.Release()
+
+	return utxo.NewOutputs(cachedOutputs.Unwrap(true)...), mempool.NewOutputsMetadata(cachedOutputsMetadata.Unwrap(true)...)
+}
+
 // UnprocessedConsumingTransactions returns the unprocessed consuming transactions of the named OutputIDs.
 func (u *Utils) UnprocessedConsumingTransactions(outputIDs utxo.OutputIDs) (consumingTransactions utxo.TransactionIDs) {
 	consumingTransactions = utxo.NewTransactionIDs()
@@ -175,18 +190,33 @@ func (u *Utils) OutputConfirmationState(outputID utxo.OutputID) (confirmationSta
 	return
 }
 
+// ConfirmedConsumer returns the Transaction that spent the Output and got accepted (or EmptyTransactionID if none of
+// its consumers got accepted yet).
 func (u *Utils) ConfirmedConsumer(outputID utxo.OutputID) (consumerID utxo.TransactionID) {
-	// default to no consumer, i.e. Genesis
-	consumerID = utxo.EmptyTransactionID
-	u.ledger.storage.CachedConsumers(outputID).Consume(func(consumer *mempool.Consumer) {
-		if consumerID != utxo.EmptyTransactionID {
-			return
-		}
-		u.ledger.storage.CachedTransactionMetadata(consumer.TransactionID()).Consume(func(metadata *mempool.TransactionMetadata) {
-			if metadata.ConfirmationState().IsAccepted() {
-				consumerID = consumer.TransactionID()
+	u.ledger.storage.CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		consumerID = outputMetadata.ConfirmedConsumer()
+	})
+
+	// metadata that was stored before the confirmed consumer was recorded does not contain it
+	if consumerID == utxo.EmptyTransactionID {
+		u.ledger.storage.CachedConsumers(outputID).Consume(func(consumer *mempool.Consumer) {
+			if consumerID != utxo.EmptyTransactionID {
+				return
 			}
+
+			u.ledger.storage.CachedTransactionMetadata(consumer.TransactionID()).Consume(func(txMetadata *mempool.TransactionMetadata) {
+				if txMetadata.ConfirmationState().IsAccepted() {
+					consumerID = consumer.TransactionID()
+				}
+			})
 		})
-	})
-	return
+	}
+
+	return consumerID
+}
+
+// ExecutionTrace returns the Trace of the last processing of the Transaction with the given ID (it only exists if
+// execution tracing is enabled and the Trace was not evicted yet).
+func (u *Utils) ExecutionTrace(txID utxo.TransactionID) (trace *vm.Trace, exists bool) {
+	return u.ledger.traces.get(txID)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/cerrors"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/hive.go/core/dataflow"
 	"github.com/iotaledger/hive.go/ds/walker"
 )
@@ -22,6 +23,19 @@ func newValidator(ledger *RealitiesLedger) *validator {
 	}
 }
 
+// traceTransactionCommand is a ChainedCommand that records the validation steps of the Transaction in a new Trace that
+// is retained by the RealitiesLedger (if tracing is enabled and the caller did not provide its own Trace).
+func (v *validator) traceTransactionCommand(params *dataFlowParams, next dataflow.Next[*dataFlowParams]) (err error) {
+	if params.Trace != nil || !v.ledger.traces.enabled() {
+		return next(params)
+	}
+
+	params.Trace = vm.NewTrace(params.Transaction.ID())
+	defer v.ledger.traces.store(params.Trace)
+
+	return next(params)
+}
+
 // checkSolidityCommand is a ChainedCommand that aborts the DataFlow if the Transaction is not solid.
 func (v *validator) checkSolidityCommand(params *dataFlowParams, next dataflow.Next[*dataFlowParams]) (err error) {
 	if params.InputIDs.IsEmpty() {
@@ -30,9 +44,12 @@ func (v *validator) checkSolidityCommand(params *dataFlowParams, next dataflow.N
 
 	cachedInputs := v.ledger.storage.CachedOutputs(params.InputIDs)
 	defer cachedInputs.Release()
-	if params.Inputs = utxo.NewOutputs(cachedInputs.Unwrap(true)...); params.Inputs.Size() != len(cachedInputs) {
-		return errors.WithMessagef(mempool.ErrTransactionUnsolid, "not all outputs of %s available", params.Transaction.ID())
+	params.Inputs = utxo.NewOutputs(cachedInputs.Unwrap(true)...)
+	params.Trace.RecordInputs(params.InputIDs, params.Inputs)
+	if params.Inputs.Size() != len(cachedInputs) {
+		return params.Trace.Step("solidity", errors.WithMessagef(mempool.ErrTransactionUnsolid, "not all outputs of %s available", params.Transaction.ID()))
 	}
+	params.Trace.Step("solidity", nil)
 
 	return next(params)
 }
@@ -49,8 +66,9 @@ func (v *validator) checkOutputsCausallyRelatedCommand(params *dataFlowParams, n
 	}
 
 	if v.outputsCausallyRelated(params.InputsMetadata) {
-		return errors.WithMessagef(mempool.ErrTransactionInvalid, "%s is trying to spend causally related Outputs", params.Transaction.ID())
+		return params.Trace.Step("causality", errors.WithMessagef(vm.ErrInputsCausallyRelated, "%s is trying to spend causally related Outputs", params.Transaction.ID()))
 	}
+	params.Trace.Step("causality", nil)
 
 	return next(params)
 }
@@ -58,9 +76,10 @@ func (v *validator) checkOutputsCausallyRelatedCommand(params *dataFlowParams, n
 // checkTransactionExecutionCommand is a ChainedCommand that aborts the DataFlow if the Transaction could not be
 // executed (is invalid).
 func (v *validator) checkTransactionExecutionCommand(params *dataFlowParams, next dataflow.Next[*dataFlowParams]) (err error) {
-	utxoOutputs, err := v.ledger.optsVM.ExecuteTransaction(params.Transaction, params.Inputs)
+	utxoOutputs, cost, err := v.ledger.executeTransaction(params.Transaction, params.Inputs, params.Trace)
+	params.Cost = cost
 	if err != nil {
-		return errors.WithMessagef(mempool.ErrTransactionInvalid, "failed to execute transaction with %s: %s", params.Transaction.ID(), err.Error())
+		return errors.Wrapf(err, "failed to execute transaction with %s", params.Transaction.ID())
 	}
 
 	params.Outputs = utxo.NewOutputs(utxoOutputs...)
@@ -1,20 +1,26 @@
 package mempool
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/xerrors"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
 	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/runtime/options"
 )
 
 // region TestFramework ////////////////////////////////////////////////////////////////////////////////////////////////
@@ -42,18 +48,22 @@ type TestFramework struct {
 
 	// outputIDsByAliasMutex contains a mutex that is used to synchronize parallel access to the outputIDsByAlias.
 	outputIDsByAliasMutex sync.RWMutex
+
+	// optsVerifySerialization contains a flag that indicates if the serialization of the issued transactions and their
+	// inputs is verified before issuing them.
+	optsVerifySerialization bool
 }
 
 // NewTestFramework creates a new instance of the TestFramework with one default output "Genesis" which has to be
 // consumed by the first transaction.
-func NewTestFramework(test *testing.T, instance MemPool) *TestFramework {
-	t := &TestFramework{
+func NewTestFramework(test *testing.T, instance MemPool, opts ...options.Option[TestFramework]) *TestFramework {
+	t := options.Apply(&TestFramework{
 		test:                test,
 		Instance:            instance,
 		ConflictDAG:         conflictdag.NewTestFramework(test, instance.ConflictDAG()),
 		transactionsByAlias: make(map[string]*mockedvm.MockedTransaction),
 		outputIDsByAlias:    make(map[string]utxo.OutputID),
-	}
+	}, opts)
 
 	genesisOutput := mockedvm.NewMockedOutput(utxo.EmptyTransactionID, 0, 0)
 	cachedObject, stored := t.Instance.Storage().OutputStorage().StoreIfAbsent(genesisOutput)
@@ -147,9 +157,43 @@ func (t *TestFramework) CreateTransaction(txAlias string, outputCount uint16, in
 	return tx
 }
 
+// SetTransactionBehavior configures how the MockedVM executes the transaction with the given alias (i.e. to make it
+// fail, delay its execution or to assign custom balances to its outputs). Panics if the MemPool does not use a MockedVM.
+func (t *TestFramework) SetTransactionBehavior(txAlias string, opts ...options.Option[mockedvm.Behavior]) {
+	t.mockedVM().SetBehavior(t.Transaction(txAlias).ID(), opts...)
+}
+
+// SetTransactionBehaviorScript configures how the consecutive executions of the transaction with the given alias behave
+// (i.e. to make it fail a few times before it succeeds). Panics if the MemPool does not use a MockedVM.
+func (t *TestFramework) SetTransactionBehaviorScript(txAlias string, behaviors ...*mockedvm.Behavior) {
+	t.mockedVM().SetBehaviorScript(t.Transaction(txAlias).ID(), behaviors...)
+}
+
+// TransactionExecutions returns the executions of the transaction with the given alias by the MockedVM. Panics if the
+// MemPool does not use a MockedVM.
+func (t *TestFramework) TransactionExecutions(txAlias string) (executions []*mockedvm.Execution) {
+	return t.mockedVM().Executions(t.Transaction(txAlias).ID())
+}
+
+// mockedVM returns the MockedVM that is used by the MemPool (it panics if the MemPool uses a different VM).
+func (t *TestFramework) mockedVM() (mockedVM *mockedvm.MockedVM) {
+	mockedVM, isMockedVM := vm.Lookup[*mockedvm.MockedVM](t.Instance.VM())
+	if !isMockedVM {
+		panic(fmt.Sprintf("the MemPool does not use a MockedVM but %T", t.Instance.VM()))
+	}
+
+	return mockedVM
+}
+
 // IssueTransactions issues the transaction given by txAlias.
 func (t *TestFramework) IssueTransactions(txAliases ...string) (err error) {
 	for _, txAlias := range txAliases {
+		if t.optsVerifySerialization {
+			if err = t.verifySerialization(t.Transaction(txAlias)); err != nil {
+				return xerrors.Errorf("failed to verify serialization of transaction '%s': %w", txAlias, err)
+			}
+		}
+
 		if err = t.Instance.StoreAndProcessTransaction(context.Background(), t.Transaction(txAlias)); err != nil {
 			return xerrors.Errorf("failed to issue transaction '%s': %w", txAlias, err)
 		}
@@ -158,6 +202,121 @@ func (t *TestFramework) IssueTransactions(txAliases ...string) (err error) {
 	return nil
 }
 
+// IssueBundle issues the transactions given by txAliases as an atomic bundle.
+func (t *TestFramework) IssueBundle(txAliases ...string) (err error) {
+	txs := make([]utxo.Transaction, 0, len(txAliases))
+	for _, txAlias := range txAliases {
+		txs = append(txs, t.Transaction(txAlias))
+	}
+
+	return t.Instance.StoreAndProcessAtomically(context.Background(), txs)
+}
+
+// IssueTransactionsConcurrently issues the given transactions in random order from multiple goroutines and waits until
+// all of them are booked. Transactions that are unsolid at the time of their issuance are booked as soon as their
+// inputs become available, so the resulting state has to be independent of the order of issuance.
+func (t *TestFramework) IssueTransactionsConcurrently(txAliases ...string) (err error) {
+	shuffledAliases := make([]string, len(txAliases))
+	copy(shuffledAliases, txAliases)
+	rand.Shuffle(len(shuffledAliases), func(i, j int) {
+		shuffledAliases[i], shuffledAliases[j] = shuffledAliases[j], shuffledAliases[i]
+	})
+
+	var errMutex sync.Mutex
+	var wg sync.WaitGroup
+	for _, txAlias := range shuffledAliases {
+		wg.Add(1)
+		go func(txAlias string) {
+			defer wg.Done()
+
+			if issueErr := t.IssueTransactions(txAlias); issueErr != nil && !errors.Is(issueErr, ErrTransactionUnsolid) {
+				errMutex.Lock()
+				err = issueErr
+				errMutex.Unlock()
+			}
+		}(txAlias)
+	}
+	wg.Wait()
+
+	if err != nil {
+		return err
+	}
+
+	require.Eventuallyf(t.test, func() bool {
+		return t.AllBooked(txAliases...)
+	}, 30*time.Second, 100*time.Millisecond, "not all concurrently issued transactions were booked")
+
+	return nil
+}
+
+// verifySerialization serializes the given transaction and its already stored inputs, re-parses them through the VM and
+// returns an error if the re-serialized bytes differ from the original ones.
+func (t *TestFramework) verifySerialization(tx *mockedvm.MockedTransaction) (err error) {
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize %s", tx.ID())
+	}
+
+	parsedTx, err := t.Instance.VM().ParseTransaction(txBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s", tx.ID())
+	}
+
+	parsedMockedTx, isMockedTx := parsedTx.(*mockedvm.MockedTransaction)
+	if !isMockedTx {
+		return errors.Errorf("parsed %s is of unexpected type %T", tx.ID(), parsedTx)
+	}
+
+	parsedTxBytes, err := parsedMockedTx.Bytes()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize parsed %s", tx.ID())
+	}
+
+	if !bytes.Equal(txBytes, parsedTxBytes) {
+		return errors.Errorf("serialized %s changed after round-trip: %x != %x", tx.ID(), txBytes, parsedTxBytes)
+	}
+
+	if parsedTxID := utxo.NewTransactionID(parsedTxBytes); parsedTxID != tx.ID() {
+		return errors.Errorf("parsed %s has a different TransactionID %s", tx.ID(), parsedTxID)
+	}
+
+	for _, input := range tx.Inputs() {
+		// unsolid inputs are verified when the transaction that creates them gets booked and consumed
+		t.Instance.Storage().CachedOutput(t.Instance.VM().ResolveInput(input)).Consume(func(output utxo.Output) {
+			if err == nil {
+				err = t.verifyOutputSerialization(output)
+			}
+		})
+	}
+
+	return err
+}
+
+// verifyOutputSerialization serializes the given output, re-parses it through the VM and returns an error if the
+// re-serialized bytes differ from the original ones.
+func (t *TestFramework) verifyOutputSerialization(output utxo.Output) (err error) {
+	outputBytes, err := output.Bytes()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize %s", output.ID())
+	}
+
+	parsedOutput, err := t.Instance.VM().ParseOutput(outputBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s", output.ID())
+	}
+
+	parsedOutputBytes, err := parsedOutput.Bytes()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize parsed %s", output.ID())
+	}
+
+	if !bytes.Equal(outputBytes, parsedOutputBytes) {
+		return errors.Errorf("serialized %s changed after round-trip: %x != %x", output.ID(), outputBytes, parsedOutputBytes)
+	}
+
+	return nil
+}
+
 // MockOutputFromTx creates an utxo.OutputID from a given MockedTransaction and outputIndex.
 func (t *TestFramework) MockOutputFromTx(tx *mockedvm.MockedTransaction, outputIndex uint16) (mockedOutputID utxo.OutputID) {
 	return utxo.NewOutputID(tx.ID(), outputIndex)
@@ -207,6 +366,15 @@ func (t *TestFramework) AssertTransactionConfirmationState(txAlias string, valid
 	})
 }
 
+// AssertRejectionReasons asserts the reasons why the given transactions (referenced by their alias) were rejected.
+func (t *TestFramework) AssertRejectionReasons(expectedRejectionReasons map[string]RejectionReason) {
+	for txAlias, expectedRejectionReason := range expectedRejectionReasons {
+		t.ConsumeTransactionMetadata(t.Transaction(txAlias).ID(), func(txMetadata *TransactionMetadata) {
+			require.Equalf(t.test, expectedRejectionReason, txMetadata.RejectionReason(), "Transaction(%s): expected rejection reason %s but has %s", txAlias, expectedRejectionReason, txMetadata.RejectionReason())
+		})
+	}
+}
+
 // AssertBooked asserts the booking status of all given transactions.
 func (t *TestFramework) AssertBooked(expectedBookedMap map[string]bool) {
 	for txAlias, expectedBooked := range expectedBookedMap {
@@ -224,6 +392,50 @@ func (t *TestFramework) AssertBooked(expectedBookedMap map[string]bool) {
 	}
 }
 
+// AssertStored asserts that the given transactions (referenced by their alias) are stored or not stored.
+func (t *TestFramework) AssertStored(expectedStoredMap map[string]bool) {
+	for txAlias, expectedStored := range expectedStoredMap {
+		stored := t.Instance.Storage().CachedTransactionMetadata(t.Transaction(txAlias).ID()).Consume(func(*TransactionMetadata) {})
+		require.Equalf(t.test, expectedStored, stored, "Transaction(%s): expected stored(%t) but has stored(%t)", txAlias, expectedStored, stored)
+	}
+}
+
+// AssertSpent asserts that the given outputs (referenced by their alias) are spent or unspent.
+func (t *TestFramework) AssertSpent(expectedSpentMap map[string]bool) {
+	for outputAlias, expectedSpent := range expectedSpentMap {
+		t.ConsumeOutputMetadata(t.OutputID(outputAlias), func(outputMetadata *OutputMetadata) {
+			require.Equalf(t.test, expectedSpent, outputMetadata.IsSpent(), "Output(%s): expected spent(%t) but has spent(%t)", outputAlias, expectedSpent, outputMetadata.IsSpent())
+		})
+	}
+}
+
+// AssertConfirmedConsumers asserts that the given outputs (referenced by their alias) were spent by the given accepted
+// transactions (an empty alias means that none of their consumers got accepted).
+func (t *TestFramework) AssertConfirmedConsumers(expectedConfirmedConsumers map[string]string) {
+	for outputAlias, expectedConsumerAlias := range expectedConfirmedConsumers {
+		expectedConsumer := utxo.EmptyTransactionID
+		if expectedConsumerAlias != "" {
+			expectedConsumer = t.Transaction(expectedConsumerAlias).ID()
+		}
+
+		t.ConsumeOutputMetadata(t.OutputID(outputAlias), func(outputMetadata *OutputMetadata) {
+			require.Equalf(t.test, expectedConsumer, outputMetadata.ConfirmedConsumer(), "Output(%s): expected confirmed consumer %s but has %s", outputAlias, expectedConsumer, outputMetadata.ConfirmedConsumer())
+		})
+	}
+}
+
+// AssertConsumerCount asserts that the given outputs (referenced by their alias) have the expected number of consumers.
+func (t *TestFramework) AssertConsumerCount(expectedConsumerCountMap map[string]int) {
+	for outputAlias, expectedConsumerCount := range expectedConsumerCountMap {
+		actualConsumerCount := 0
+		t.Instance.Storage().CachedConsumers(t.OutputID(outputAlias)).Consume(func(_ *Consumer) {
+			actualConsumerCount++
+		})
+
+		require.Equalf(t.test, expectedConsumerCount, actualConsumerCount, "Output(%s): expected %d consumers but has %d", outputAlias, expectedConsumerCount, actualConsumerCount)
+	}
+}
+
 // AllBooked returns whether all given transactions are booked.
 func (t *TestFramework) AllBooked(txAliases ...string) (allBooked bool) {
 	for _, txAlias := range txAliases {
@@ -264,3 +476,17 @@ func (t *TestFramework) ConsumeTransactionOutputs(mockTx *mockedvm.MockedTransac
 		}
 	})
 }
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithSerializationVerification is an Option for the TestFramework that makes it serialize every issued transaction and
+// its inputs, re-parse them through the VM and compare the results byte by byte before issuing the transaction.
+func WithSerializationVerification(verify bool) options.Option[TestFramework] {
+	return func(t *TestFramework) {
+		t.optsVerifySerialization = verify
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
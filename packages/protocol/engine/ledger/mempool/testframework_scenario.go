@@ -0,0 +1,217 @@
+package mempool
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// region TestFramework ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GenerateScenario creates a reproducible random UTXO-DAG of txCount transactions that is rooted in the Genesis output.
+// The conflictRatio defines the probability of a transaction to double spend an already spent output. The same seed
+// always results in the same structure (transactions, inputs and double spends), so failing runs can be replayed.
+func (t *TestFramework) GenerateScenario(seed int64, txCount int, conflictRatio float64, opts ...options.Option[Scenario]) (scenario *Scenario) {
+	scenario = options.Apply(&Scenario{
+		Seed:               seed,
+		TransactionAliases: make([]string, 0, txCount),
+		Inputs:             make(map[string][]string),
+		ConflictSets:       make(map[string][]string),
+		pastCones:          make(map[string]map[string]bool),
+		optsMaxInputs:      2,
+		optsMaxOutputs:     3,
+	}, opts)
+
+	random := rand.New(rand.NewSource(seed))
+	unspentOutputs := make([]string, 0)
+	spentOutputs := make([]string, 0)
+	spenders := make(map[string][]string)
+
+	for i := 0; i < txCount; i++ {
+		txAlias := fmt.Sprintf("Scenario%d.TX%d", seed, i)
+
+		var inputAliases []string
+		if i == 0 {
+			inputAliases = []string{"Genesis"}
+		} else {
+			if len(unspentOutputs) == 0 || (len(spentOutputs) != 0 && random.Float64() < conflictRatio) {
+				inputAliases = append(inputAliases, spentOutputs[random.Intn(len(spentOutputs))])
+			}
+
+			for additionalInputs := random.Intn(scenario.optsMaxInputs) + 1 - len(inputAliases); additionalInputs > 0 && len(unspentOutputs) != 0; additionalInputs-- {
+				index := random.Intn(len(unspentOutputs))
+
+				// never spend the future cone of a transaction that we are conflicting with
+				if !scenario.conflictsWithPastCone(unspentOutputs[index], inputAliases, spenders) {
+					inputAliases = append(inputAliases, unspentOutputs[index])
+					unspentOutputs = append(unspentOutputs[:index], unspentOutputs[index+1:]...)
+				}
+			}
+		}
+
+		outputCount := uint16(scenario.optsMaxOutputs)
+		if i != 0 {
+			outputCount = uint16(random.Intn(scenario.optsMaxOutputs) + 1)
+		}
+
+		t.CreateTransaction(txAlias, outputCount, inputAliases...)
+
+		scenario.TransactionAliases = append(scenario.TransactionAliases, txAlias)
+		scenario.Inputs[txAlias] = inputAliases
+		scenario.pastCones[txAlias] = make(map[string]bool)
+		for _, inputAlias := range inputAliases {
+			if inputAlias == "Genesis" {
+				continue
+			}
+
+			if spenders[inputAlias] = append(spenders[inputAlias], txAlias); len(spenders[inputAlias]) == 1 {
+				spentOutputs = append(spentOutputs, inputAlias)
+			} else {
+				scenario.ConflictSets[inputAlias] = spenders[inputAlias]
+			}
+
+			producerAlias := scenario.producer(inputAlias)
+			scenario.pastCones[txAlias][producerAlias] = true
+			for pastConeAlias := range scenario.pastCones[producerAlias] {
+				scenario.pastCones[txAlias][pastConeAlias] = true
+			}
+		}
+
+		for outputIndex := 0; outputIndex < int(outputCount); outputIndex++ {
+			unspentOutputs = append(unspentOutputs, txAlias+"."+strconv.Itoa(outputIndex))
+		}
+	}
+
+	return scenario
+}
+
+// AssertScenarioInvariants asserts that the state of the MemPool is consistent with the given Scenario after all of its
+// transactions were issued. The checked invariants are independent of the order in which the transactions were issued.
+func (t *TestFramework) AssertScenarioInvariants(scenario *Scenario) {
+	require.Truef(t.test, t.AllBooked(scenario.TransactionAliases...), "not all transactions of scenario %d were booked", scenario.Seed)
+
+	t.AssertConflicts(scenario.ConflictSets)
+
+	for _, txAlias := range scenario.TransactionAliases {
+		var txConflictIDs *advancedset.AdvancedSet[utxo.TransactionID]
+		t.ConsumeTransactionMetadata(t.Transaction(txAlias).ID(), func(txMetadata *TransactionMetadata) {
+			txConflictIDs = txMetadata.ConflictIDs()
+		})
+
+		t.ConsumeTransactionOutputs(t.Transaction(txAlias), func(outputMetadata *OutputMetadata) {
+			require.Truef(t.test, txConflictIDs.Equal(outputMetadata.ConflictIDs()), "Output(%s): expected %s is not equal to actual %s", outputMetadata.ID(), txConflictIDs, outputMetadata.ConflictIDs())
+		})
+
+		if scenario.IsConflicting(txAlias) {
+			require.Truef(t.test, t.ConflictIDs(txAlias).Equal(txConflictIDs), "Transaction(%s): expected to be booked into its own conflict but is booked into %s", txAlias, txConflictIDs)
+			continue
+		}
+
+		pastConflictIDs := t.ConflictIDs(scenario.PastConflicts(txAlias)...)
+		require.Equalf(t.test, pastConflictIDs.IsEmpty(), txConflictIDs.IsEmpty(), "Transaction(%s): expected conflicts of past cone %s but is booked into %s", txAlias, pastConflictIDs, txConflictIDs)
+		_ = txConflictIDs.ForEach(func(conflictID utxo.TransactionID) (err error) {
+			require.Truef(t.test, pastConflictIDs.Has(conflictID), "Transaction(%s): booked into %s that is not part of its past cone", txAlias, conflictID)
+			return nil
+		})
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Scenario /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Scenario describes a randomly generated UTXO-DAG that was created by the TestFramework.
+type Scenario struct {
+	// Seed contains the seed that was used to generate the Scenario.
+	Seed int64
+
+	// TransactionAliases contains the aliases of the generated transactions in a valid issuing order.
+	TransactionAliases []string
+
+	// Inputs contains the aliases of the consumed outputs of every transaction.
+	Inputs map[string][]string
+
+	// ConflictSets contains the aliases of the transactions that spend the same output (indexed by the output alias).
+	ConflictSets map[string][]string
+
+	// pastCones contains the aliases of all transactions in the past cone of every transaction.
+	pastCones map[string]map[string]bool
+
+	optsMaxInputs  int
+	optsMaxOutputs int
+}
+
+// IsConflicting returns true if the given transaction spends an output that is also spent by another transaction.
+func (s *Scenario) IsConflicting(txAlias string) bool {
+	for _, inputAlias := range s.Inputs[txAlias] {
+		if _, exists := s.ConflictSets[inputAlias]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PastConflicts returns the aliases of the conflicting transactions in the past cone of the given transaction.
+func (s *Scenario) PastConflicts(txAlias string) (pastConflicts []string) {
+	for _, pastConeAlias := range s.TransactionAliases {
+		if s.pastCones[txAlias][pastConeAlias] && s.IsConflicting(pastConeAlias) {
+			pastConflicts = append(pastConflicts, pastConeAlias)
+		}
+	}
+
+	return pastConflicts
+}
+
+// producer returns the alias of the transaction that created the given output.
+func (s *Scenario) producer(outputAlias string) (txAlias string) {
+	for i := len(outputAlias) - 1; i >= 0; i-- {
+		if outputAlias[i] == '.' {
+			return outputAlias[:i]
+		}
+	}
+
+	panic(fmt.Sprintf("invalid output alias: %s", outputAlias))
+}
+
+// conflictsWithPastCone returns true if the given output is in the future cone of a transaction that spends one of the
+// given inputs.
+func (s *Scenario) conflictsWithPastCone(outputAlias string, inputAliases []string, spenders map[string][]string) bool {
+	producerAlias := s.producer(outputAlias)
+	for _, inputAlias := range inputAliases {
+		for _, spenderAlias := range spenders[inputAlias] {
+			if spenderAlias == producerAlias || s.pastCones[producerAlias][spenderAlias] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithMaxInputs is an Option for the Scenario that defines the maximum number of inputs of a generated transaction.
+func WithMaxInputs(maxInputs int) options.Option[Scenario] {
+	return func(s *Scenario) {
+		s.optsMaxInputs = maxInputs
+	}
+}
+
+// WithMaxOutputs is an Option for the Scenario that defines the maximum number of outputs (fan-out) of a generated
+// transaction.
+func WithMaxOutputs(maxOutputs int) options.Option[Scenario] {
+	return func(s *Scenario) {
+		s.optsMaxOutputs = maxOutputs
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
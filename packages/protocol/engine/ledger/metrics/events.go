@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events is a container that acts as a dictionary for the existing events of the ledger Metrics.
+type Events struct {
+	// TransactionBookingMeasured is triggered whenever the booking latency of a Transaction was measured.
+	TransactionBookingMeasured *event.Event1[*TransactionBookingMeasuredEvent]
+
+	// PendingTransactionsUpdated is triggered whenever the number of stored but not yet booked Transactions changes.
+	PendingTransactionsUpdated *event.Event1[int]
+
+	// ConflictCountUpdated is triggered whenever the number of created Conflicts changes.
+	ConflictCountUpdated *event.Event1[uint64]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		TransactionBookingMeasured: event.New1[*TransactionBookingMeasuredEvent](),
+		PendingTransactionsUpdated: event.New1[int](),
+		ConflictCountUpdated:       event.New1[uint64](),
+	}
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionBookingMeasuredEvent //////////////////////////////////////////////////////////////////////////////
+
+// TransactionBookingMeasuredEvent is a container that acts as a dictionary for the TransactionBookingMeasured event
+// related parameters.
+type TransactionBookingMeasuredEvent struct {
+	// TransactionID contains the identifier of the booked Transaction.
+	TransactionID utxo.TransactionID
+
+	// Latency contains the time that passed between storing and booking the Transaction.
+	Latency time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
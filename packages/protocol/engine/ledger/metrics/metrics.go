@@ -0,0 +1,245 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/core/ratecounter"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// region Metrics //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Metrics is a ledger component that collects statistics about the processing of Transactions in the MemPool.
+type Metrics struct {
+	// Events contains the Events of the Metrics.
+	Events *Events
+
+	// memPool contains a reference to the MemPool whose activity is measured.
+	memPool mempool.MemPool
+
+	// pendingTransactions contains the time at which the stored but not yet booked Transactions were stored.
+	pendingTransactions *shrinkingmap.ShrinkingMap[utxo.TransactionID, time.Time]
+
+	// bookedTransactions counts the booked Transactions per second within the measurement window.
+	bookedTransactions *ratecounter.RateCounter
+
+	// bookedCount contains the total number of booked Transactions.
+	bookedCount uint64
+
+	// totalBookingLatency contains the sum of the booking latencies of all booked Transactions.
+	totalBookingLatency time.Duration
+
+	// conflictCount contains the total number of created Conflicts.
+	conflictCount uint64
+
+	// optsRateWindow contains the window that is used to determine the booked Transactions per second.
+	optsRateWindow time.Duration
+
+	// optsTimeProvider contains the function that is used to retrieve the current time.
+	optsTimeProvider func() time.Time
+
+	// optsSlotTimeProvider contains the function that is used to retrieve the slot.TimeProvider of the evicted slots.
+	optsSlotTimeProvider func() *slot.TimeProvider
+
+	// mutex is used to synchronize access to the counters.
+	mutex sync.RWMutex
+}
+
+// New creates a new Metrics instance that measures the activity of the given MemPool.
+func New(memPool mempool.MemPool, opts ...options.Option[Metrics]) (metrics *Metrics) {
+	return options.Apply(&Metrics{
+		Events:              NewEvents(),
+		memPool:             memPool,
+		pendingTransactions: shrinkingmap.New[utxo.TransactionID, time.Time](),
+		optsRateWindow:      10 * time.Second,
+		optsTimeProvider:    time.Now,
+	}, opts, func(m *Metrics) {
+		m.bookedTransactions = ratecounter.New(m.optsRateWindow)
+
+		m.memPool.Events().TransactionStored.Hook(m.onTransactionStored)
+		m.memPool.Events().TransactionBooked.Hook(m.onTransactionBooked)
+		m.memPool.Events().TransactionOrphaned.Hook(m.onTransactionOrphaned)
+		m.memPool.Events().TransactionInvalid.Hook(m.onTransactionInvalid)
+		m.memPool.Events().ConflictDAG.ConflictCreated.Hook(m.onConflictCreated)
+	})
+}
+
+// BookedPerSecond returns the average number of Transactions that were booked per second within the measurement window.
+func (m *Metrics) BookedPerSecond() float64 {
+	return m.bookedTransactions.Rate(m.optsTimeProvider())
+}
+
+// BookedCount returns the total number of booked Transactions.
+func (m *Metrics) BookedCount() uint64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.bookedCount
+}
+
+// AverageBookingLatency returns the average time that passed between storing and booking a Transaction.
+func (m *Metrics) AverageBookingLatency() time.Duration {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if m.bookedCount == 0 {
+		return 0
+	}
+
+	return m.totalBookingLatency / time.Duration(m.bookedCount)
+}
+
+// PendingTransactions returns the number of Transactions that were stored but not booked, yet (e.g. because they are
+// unsolid).
+func (m *Metrics) PendingTransactions() int {
+	return m.pendingTransactions.Size()
+}
+
+// ConflictCount returns the total number of Conflicts that were created in the ConflictDAG.
+func (m *Metrics) ConflictCount() uint64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.conflictCount
+}
+
+// CacheHitRates returns the cache hit rates of the object storages of the MemPool (indexed by their name).
+func (m *Metrics) CacheHitRates() (cacheHitRates map[string]float64) {
+	cacheHitRates = make(map[string]float64)
+	for storageName, cacheStatistics := range m.memPool.Storage().CacheStatistics() {
+		cacheHitRates[storageName] = cacheStatistics.HitRate()
+	}
+
+	return cacheHitRates
+}
+
+// CacheSizes returns the number of cached objects of the object storages of the MemPool (indexed by their name).
+func (m *Metrics) CacheSizes() (cacheSizes map[string]float64) {
+	cacheSizes = make(map[string]float64)
+	for storageName, cacheStatistics := range m.memPool.Storage().CacheStatistics() {
+		cacheSizes[storageName] = float64(cacheStatistics.Size)
+	}
+
+	return cacheSizes
+}
+
+// Evict removes the pending Transactions that were stored before the end of the given slot, as they will not be booked
+// anymore once the slot was evicted.
+func (m *Metrics) Evict(index slot.Index) {
+	if m.optsSlotTimeProvider == nil {
+		return
+	}
+
+	slotEndTime := m.optsSlotTimeProvider().EndTime(index)
+
+	evictedTransactions := make([]utxo.TransactionID, 0)
+	m.pendingTransactions.ForEach(func(transactionID utxo.TransactionID, storedTime time.Time) bool {
+		if !storedTime.After(slotEndTime) {
+			evictedTransactions = append(evictedTransactions, transactionID)
+		}
+
+		return true
+	})
+
+	m.removePendingTransactions(evictedTransactions...)
+}
+
+// onTransactionStored is triggered when a Transaction was stored in the MemPool.
+func (m *Metrics) onTransactionStored(event *mempool.TransactionStoredEvent) {
+	m.pendingTransactions.Set(event.TransactionID, m.optsTimeProvider())
+
+	m.Events.PendingTransactionsUpdated.Trigger(m.pendingTransactions.Size())
+}
+
+// onTransactionBooked is triggered when a Transaction was booked in the MemPool.
+func (m *Metrics) onTransactionBooked(event *mempool.TransactionBookedEvent) {
+	now := m.optsTimeProvider()
+
+	storedTime, exists := m.pendingTransactions.Get(event.TransactionID)
+	if !exists || !m.pendingTransactions.Delete(event.TransactionID) {
+		return
+	}
+
+	latency := now.Sub(storedTime)
+
+	m.mutex.Lock()
+	m.bookedCount++
+	m.totalBookingLatency += latency
+	m.mutex.Unlock()
+
+	m.bookedTransactions.Increment(now)
+
+	m.Events.TransactionBookingMeasured.Trigger(&TransactionBookingMeasuredEvent{
+		TransactionID: event.TransactionID,
+		Latency:       latency,
+	})
+	m.Events.PendingTransactionsUpdated.Trigger(m.pendingTransactions.Size())
+}
+
+// onTransactionOrphaned is triggered when a Transaction was removed from the MemPool.
+func (m *Metrics) onTransactionOrphaned(event *mempool.TransactionEvent) {
+	m.removePendingTransactions(event.Metadata.ID())
+}
+
+// onTransactionInvalid is triggered when a Transaction was found to be invalid (it is never going to be booked).
+func (m *Metrics) onTransactionInvalid(event *mempool.TransactionInvalidEvent) {
+	m.removePendingTransactions(event.TransactionID)
+}
+
+// removePendingTransactions removes the given Transactions from the pending Transactions without measuring them.
+func (m *Metrics) removePendingTransactions(transactionIDs ...utxo.TransactionID) {
+	removed := false
+	for _, transactionID := range transactionIDs {
+		removed = m.pendingTransactions.Delete(transactionID) || removed
+	}
+
+	if removed {
+		m.Events.PendingTransactionsUpdated.Trigger(m.pendingTransactions.Size())
+	}
+}
+
+// onConflictCreated is triggered when a Conflict was created in the ConflictDAG.
+func (m *Metrics) onConflictCreated(_ *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+	m.mutex.Lock()
+	m.conflictCount++
+	conflictCount := m.conflictCount
+	m.mutex.Unlock()
+
+	m.Events.ConflictCountUpdated.Trigger(conflictCount)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithRateWindow is an Option for the Metrics that allows to configure the window that is used to determine the booked
+// Transactions per second.
+func WithRateWindow(rateWindow time.Duration) options.Option[Metrics] {
+	return func(m *Metrics) {
+		m.optsRateWindow = rateWindow
+	}
+}
+
+// WithTimeProvider is an Option for the Metrics that allows to configure the function that is used to retrieve the
+// current time.
+func WithTimeProvider(timeProvider func() time.Time) options.Option[Metrics] {
+	return func(m *Metrics) {
+		m.optsTimeProvider = timeProvider
+	}
+}
+
+// WithSlotTimeProvider is an Option for the Metrics that allows to configure the function that is used to retrieve the
+// slot.TimeProvider which determines the pending Transactions that are removed when a slot is evicted.
+func WithSlotTimeProvider(slotTimeProvider func() *slot.TimeProvider) options.Option[Metrics] {
+	return func(m *Metrics) {
+		m.optsSlotTimeProvider = slotTimeProvider
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,90 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/metrics"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func TestMetrics(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+	ledgerMetrics := metrics.New(tf.Instance)
+
+	tf.CreateTransaction("G", 2, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX1*", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "G.1")
+	tf.CreateTransaction("TX3", 1, "TX2.0")
+
+	require.NoError(t, tf.IssueTransactions("G", "TX1", "TX1*"))
+	require.Error(t, tf.IssueTransactions("TX3"))
+
+	require.EqualValues(t, 3, ledgerMetrics.BookedCount())
+	require.EqualValues(t, 2, ledgerMetrics.ConflictCount())
+	require.Equal(t, 1, ledgerMetrics.PendingTransactions())
+	require.Greater(t, ledgerMetrics.BookedPerSecond(), 0.0)
+
+	require.NoError(t, tf.IssueTransactions("TX2"))
+
+	require.Eventually(t, func() bool {
+		return ledgerMetrics.BookedCount() == 5 && ledgerMetrics.PendingTransactions() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.GreaterOrEqual(t, ledgerMetrics.AverageBookingLatency(), time.Duration(0))
+
+	cacheHitRates, cacheSizes := ledgerMetrics.CacheHitRates(), ledgerMetrics.CacheSizes()
+	for _, storageName := range []string{"transaction", "transactionMetadata", "output", "outputMetadata", "consumer"} {
+		require.Contains(t, cacheHitRates, storageName)
+		require.Contains(t, cacheSizes, storageName)
+	}
+}
+
+func TestMetrics_InvalidTransaction(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+	ledgerMetrics := metrics.New(tf.Instance)
+
+	tf.CreateTransaction("G", 1, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.SetTransactionBehavior("TX1", mockedvm.WithExecutionError(errors.New("execution failed")))
+
+	require.NoError(t, tf.IssueTransactions("G"))
+	require.ErrorIs(t, tf.IssueTransactions("TX1"), mempool.ErrTransactionInvalid)
+
+	require.EqualValues(t, 1, ledgerMetrics.BookedCount())
+	require.Zero(t, ledgerMetrics.PendingTransactions())
+}
+
+func TestMetrics_Evict(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := realitiesledger.NewDefaultTestFramework(t, workers.CreateGroup("LedgerTestFramework"))
+
+	slotTimeProvider := slot.NewTimeProvider(time.Now().Add(-time.Minute).Unix(), 10)
+	ledgerMetrics := metrics.New(tf.Instance, metrics.WithSlotTimeProvider(func() *slot.TimeProvider {
+		return slotTimeProvider
+	}))
+
+	tf.CreateTransaction("G", 1, "Genesis")
+	tf.CreateTransaction("TX1", 1, "G.0")
+	tf.CreateTransaction("TX2", 1, "TX1.0")
+
+	require.NoError(t, tf.IssueTransactions("G"))
+	require.Error(t, tf.IssueTransactions("TX2"))
+	require.Equal(t, 1, ledgerMetrics.PendingTransactions())
+
+	// the unsolid Transaction is kept until the slot that it was stored in is evicted
+	ledgerMetrics.Evict(slotTimeProvider.IndexFromTime(time.Now()) - 1)
+	require.Equal(t, 1, ledgerMetrics.PendingTransactions())
+
+	ledgerMetrics.Evict(slotTimeProvider.IndexFromTime(time.Now()))
+	require.Zero(t, ledgerMetrics.PendingTransactions())
+}
@@ -1,18 +1,41 @@
 package ledger
 
 import (
+	"context"
+
+	"github.com/celestiaorg/smt"
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/goshimmer/packages/core/module"
 	"github.com/iotaledger/goshimmer/packages/core/traits"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/runtime/options"
 )
 
+// ErrOutputNotUnspent is returned if the inclusion of an output that is not unspent is requested to be proven.
+var ErrOutputNotUnspent = errors.New("output is not unspent")
+
 // UnspentOutputs is a submodule that provides access to the unspent outputs of the ledger state.
 type UnspentOutputs interface {
 	// IDs returns the IDs of the unspent outputs.
 	IDs() *ads.Set[utxo.OutputID, *utxo.OutputID]
 
+	// ForEachUnspentOutput iterates over the unspent outputs in the order of their IDs and passes the ones that match the
+	// optional filter to the consumer. It returns a cursor that can be used to resume the iteration (or the
+	// EmptyOutputID if all unspent outputs were visited). The iteration is aborted with the error of the context if the
+	// context is canceled. Iterations with a consistent view (see WithConsistentView) are not affected by concurrent
+	// changes.
+	ForEachUnspentOutput(ctx context.Context, consumer func(output *mempool.OutputWithMetadata) bool, opts ...options.Option[IteratorOptions]) (cursor utxo.OutputID, err error)
+
+	// Statistics returns the statistics about the unspent outputs (e.g. their number and their balances by color).
+	Statistics() *UnspentOutputsStatistics
+
+	// ProveInclusion returns a compact merkle proof of the given unspent output against the root of the IDs. It returns
+	// ErrOutputNotUnspent if the output is not unspent.
+	ProveInclusion(outputID utxo.OutputID) (proof smt.SparseCompactMerkleProof, err error)
+
 	// Subscribe subscribes to changes in the unspent outputs.
 	Subscribe(UnspentOutputsSubscriber)
 
@@ -28,3 +51,71 @@ type UnspentOutputs interface {
 	// Interface embeds the required methods of the module.Interface.
 	module.Interface
 }
+
+// region IteratorOptions //////////////////////////////////////////////////////////////////////////////////////////////
+
+// IteratorOptions contains the options of an iteration over the UnspentOutputs.
+type IteratorOptions struct {
+	// Cursor contains the ID of the output after which the iteration starts (the EmptyOutputID starts at the beginning).
+	Cursor utxo.OutputID
+
+	// Limit contains the maximum number of outputs that are passed to the consumer (0 means unlimited).
+	Limit int
+
+	// Filter contains an optional filter that decides which outputs are passed to the consumer.
+	Filter func(output *mempool.OutputWithMetadata) bool
+
+	// ConsistentView contains a flag that indicates if the iteration uses a snapshot of the unspent outputs that is not
+	// affected by concurrent changes (it collects the IDs of all unspent outputs upfront, so it is meant for long
+	// running scans rather than for paginated queries).
+	ConsistentView bool
+}
+
+// NewIteratorOptions returns the IteratorOptions that result from applying the given options.
+func NewIteratorOptions(opts ...options.Option[IteratorOptions]) *IteratorOptions {
+	return options.Apply(new(IteratorOptions), opts)
+}
+
+// Matches returns true if the given output passes the Filter of the IteratorOptions.
+func (i *IteratorOptions) Matches(output *mempool.OutputWithMetadata) bool {
+	return i.Filter == nil || i.Filter(output)
+}
+
+// WithCursor resumes an iteration after the output with the given ID.
+func WithCursor(cursor utxo.OutputID) options.Option[IteratorOptions] {
+	return func(i *IteratorOptions) {
+		i.Cursor = cursor
+	}
+}
+
+// WithLimit sets the maximum number of outputs that are passed to the consumer.
+func WithLimit(limit int) options.Option[IteratorOptions] {
+	return func(i *IteratorOptions) {
+		i.Limit = limit
+	}
+}
+
+// WithFilter sets a filter that decides which outputs are passed to the consumer (multiple filters are combined).
+func WithFilter(filter func(output *mempool.OutputWithMetadata) bool) options.Option[IteratorOptions] {
+	return func(i *IteratorOptions) {
+		if previousFilter := i.Filter; previousFilter != nil {
+			i.Filter = func(output *mempool.OutputWithMetadata) bool {
+				return previousFilter(output) && filter(output)
+			}
+
+			return
+		}
+
+		i.Filter = filter
+	}
+}
+
+// WithConsistentView makes the iteration use a snapshot of the unspent outputs that is not affected by concurrent
+// changes.
+func WithConsistentView() options.Option[IteratorOptions] {
+	return func(i *IteratorOptions) {
+		i.ConsistentView = true
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
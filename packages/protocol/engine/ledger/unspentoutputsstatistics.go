@@ -0,0 +1,200 @@
+package ledger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// region UnspentOutputsStatistics /////////////////////////////////////////////////////////////////////////////////////
+
+// UnspentOutputsStatistics collects statistics about the unspent outputs of the ledger state. Since the counters are
+// signed, an instance can also be used to collect the changes of a batched state transition that are merged into the
+// statistics once the transition is committed.
+type UnspentOutputsStatistics struct {
+	// count contains the number of unspent outputs.
+	count int64
+
+	// dustCount contains the number of unspent outputs whose IOTA balance is below the dust threshold.
+	dustCount int64
+
+	// balancesByColor contains the total balance of the unspent outputs indexed by color.
+	balancesByColor map[devnetvm.Color]int64
+
+	// samples contains the number of unspent outputs at different points in time (used to determine the growth rate).
+	samples []unspentOutputsSample
+
+	// optsDustThreshold contains the IOTA balance below which an output is considered to be dust.
+	optsDustThreshold uint64
+
+	// optsGrowthWindow contains the time window that is used to determine the growth rate.
+	optsGrowthWindow time.Duration
+
+	// mutex is used to synchronize access to the counters.
+	mutex sync.RWMutex
+}
+
+// NewUnspentOutputsStatistics creates a new (empty) UnspentOutputsStatistics instance.
+func NewUnspentOutputsStatistics(opts ...options.Option[UnspentOutputsStatistics]) (statistics *UnspentOutputsStatistics) {
+	return options.Apply(&UnspentOutputsStatistics{
+		balancesByColor:   make(map[devnetvm.Color]int64),
+		optsDustThreshold: devnetvm.DustThresholdAliasOutputIOTA,
+		optsGrowthWindow:  24 * time.Hour,
+	}, opts)
+}
+
+// Add adds the given output to the statistics.
+func (u *UnspentOutputsStatistics) Add(output *mempool.OutputWithMetadata) {
+	u.update(output, 1)
+}
+
+// Remove removes the given output from the statistics.
+func (u *UnspentOutputsStatistics) Remove(output *mempool.OutputWithMetadata) {
+	u.update(output, -1)
+}
+
+// Merge adds the changes that were collected by the given statistics (e.g. during a batched state transition).
+func (u *UnspentOutputsStatistics) Merge(changes *UnspentOutputsStatistics) {
+	changes.mutex.RLock()
+	defer changes.mutex.RUnlock()
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.count += changes.count
+	u.dustCount += changes.dustCount
+	for color, balance := range changes.balancesByColor {
+		if u.balancesByColor[color] += balance; u.balancesByColor[color] == 0 {
+			delete(u.balancesByColor, color)
+		}
+	}
+}
+
+// Sample records the current number of unspent outputs at the given time. Samples that are not older than the given
+// time are replaced (e.g. when the ledger state is rolled back) and samples that are no longer needed to determine the
+// growth rate are dropped.
+func (u *UnspentOutputsStatistics) Sample(sampleTime time.Time) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	for len(u.samples) > 0 && !u.samples[len(u.samples)-1].time.Before(sampleTime) {
+		u.samples = u.samples[:len(u.samples)-1]
+	}
+	u.samples = append(u.samples, unspentOutputsSample{time: sampleTime, count: u.count})
+
+	// the newest sample that is older than the window is retained as the baseline of the growth rate
+	windowStart := sampleTime.Add(-u.optsGrowthWindow)
+	for len(u.samples) > 2 && !u.samples[1].time.After(windowStart) {
+		u.samples = u.samples[1:]
+	}
+}
+
+// Count returns the number of unspent outputs.
+func (u *UnspentOutputsStatistics) Count() uint64 {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return unsigned(u.count)
+}
+
+// DustCount returns the number of unspent outputs whose IOTA balance is below the dust threshold.
+func (u *UnspentOutputsStatistics) DustCount() uint64 {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return unsigned(u.dustCount)
+}
+
+// BalancesByColor returns the total balance of the unspent outputs indexed by color.
+func (u *UnspentOutputsStatistics) BalancesByColor() (balancesByColor map[devnetvm.Color]uint64) {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	balancesByColor = make(map[devnetvm.Color]uint64, len(u.balancesByColor))
+	for color, balance := range u.balancesByColor {
+		balancesByColor[color] = unsigned(balance)
+	}
+
+	return balancesByColor
+}
+
+// DailyGrowthRate returns the number of unspent outputs that were added per day (negative if the number of unspent
+// outputs shrinks) within the growth window. The rate is extrapolated if the recorded samples cover less than a day.
+func (u *UnspentOutputsStatistics) DailyGrowthRate() float64 {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	if len(u.samples) < 2 {
+		return 0
+	}
+
+	baseline, latest := u.samples[0], u.samples[len(u.samples)-1]
+	elapsed := latest.time.Sub(baseline.time)
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(latest.count-baseline.count) * float64(24*time.Hour) / float64(elapsed)
+}
+
+// update adds the given output to the statistics (or removes it if the sign is negative).
+func (u *UnspentOutputsStatistics) update(output *mempool.OutputWithMetadata, sign int64) {
+	balances := output.Balances()
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	// outputs without balances (i.e. of VMs without colored balances) are counted, but they are never dust
+	u.count += sign
+	if len(balances) != 0 && balances[devnetvm.ColorIOTA] < u.optsDustThreshold {
+		u.dustCount += sign
+	}
+
+	for color, balance := range balances {
+		if u.balancesByColor[color] += sign * int64(balance); u.balancesByColor[color] == 0 {
+			delete(u.balancesByColor, color)
+		}
+	}
+}
+
+// unsigned returns the given counter as an unsigned value (negative values are only valid for collected changes).
+func unsigned(value int64) uint64 {
+	if value < 0 {
+		return 0
+	}
+
+	return uint64(value)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region unspentOutputsSample /////////////////////////////////////////////////////////////////////////////////////////
+
+// unspentOutputsSample contains the number of unspent outputs at a given point in time.
+type unspentOutputsSample struct {
+	time  time.Time
+	count int64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithDustThreshold sets the IOTA balance below which an output is considered to be dust.
+func WithDustThreshold(dustThreshold uint64) options.Option[UnspentOutputsStatistics] {
+	return func(u *UnspentOutputsStatistics) {
+		u.optsDustThreshold = dustThreshold
+	}
+}
+
+// WithGrowthWindow sets the time window that is used to determine the growth rate of the unspent outputs.
+func WithGrowthWindow(growthWindow time.Duration) options.Option[UnspentOutputsStatistics] {
+	return func(u *UnspentOutputsStatistics) {
+		u.optsGrowthWindow = growthWindow
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/wasmvm"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestUnspentOutputsStatistics(t *testing.T) {
+	outputs := make([]*mempool.OutputWithMetadata, 4)
+	for i, balance := range []uint64{1000, 50, 200, 10} {
+		output := mockedvm.NewMockedOutput(utxo.NewTransactionID([]byte{byte(i)}), 0, balance)
+		outputs[i] = mempool.NewOutputWithMetadata(0, output.ID(), output, identity.ID{}, identity.ID{})
+	}
+
+	statistics := NewUnspentOutputsStatistics(WithDustThreshold(100))
+	statistics.Add(outputs[0])
+	statistics.Add(outputs[1])
+
+	genesisTime := time.Unix(0, 0)
+	statistics.Sample(genesisTime)
+
+	require.EqualValues(t, 2, statistics.Count())
+	require.EqualValues(t, 1, statistics.DustCount())
+	require.Equal(t, map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 1050}, statistics.BalancesByColor())
+	require.Zero(t, statistics.DailyGrowthRate())
+
+	// the changes of a batched state transition only become visible once they are merged
+	changes := NewUnspentOutputsStatistics(WithDustThreshold(100))
+	changes.Add(outputs[2])
+	changes.Add(outputs[3])
+	changes.Remove(outputs[1])
+	require.EqualValues(t, 2, statistics.Count())
+
+	statistics.Merge(changes)
+	statistics.Sample(genesisTime.Add(12 * time.Hour))
+
+	require.EqualValues(t, 3, statistics.Count())
+	require.EqualValues(t, 1, statistics.DustCount())
+	require.Equal(t, map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 1210}, statistics.BalancesByColor())
+	require.Equal(t, 2.0, statistics.DailyGrowthRate())
+
+	// rolling back the ledger state replaces the newer samples
+	statistics.Remove(outputs[3])
+	statistics.Sample(genesisTime.Add(6 * time.Hour))
+	require.Equal(t, 0.0, statistics.DailyGrowthRate())
+
+	// samples that are older than the growth window are dropped (except for the baseline)
+	statistics.Add(outputs[3])
+	statistics.Sample(genesisTime.Add(30 * time.Hour))
+	statistics.Add(outputs[1])
+	statistics.Sample(genesisTime.Add(36 * time.Hour))
+	require.EqualValues(t, 4, statistics.Count())
+	require.Equal(t, 2.0*24/30, statistics.DailyGrowthRate())
+}
+
+func TestUnspentOutputsStatistics_OutputsWithoutBalances(t *testing.T) {
+	outputs := make([]*mempool.OutputWithMetadata, 2)
+	for i := range outputs {
+		output := wasmvm.NewOutput(1000, nil, nil)
+		output.SetID(utxo.NewOutputID(utxo.NewTransactionID([]byte{byte(i)}), 0))
+		outputs[i] = mempool.NewOutputWithMetadata(0, output.ID(), output, identity.ID{}, identity.ID{})
+	}
+
+	statistics := NewUnspentOutputsStatistics(WithDustThreshold(100))
+	statistics.Add(outputs[0])
+	statistics.Add(outputs[1])
+	statistics.Remove(outputs[0])
+
+	require.EqualValues(t, 1, statistics.Count())
+	require.Zero(t, statistics.DustCount())
+	require.Empty(t, statistics.BalancesByColor())
+}
@@ -0,0 +1,93 @@
+package utxo
+
+import (
+	"sync"
+)
+
+// region AliasRegistry ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AliasRegistry is a thread-safe registry of human-readable aliases for identifiers that are used as a replacement for
+// the encoded identifiers in logs, the dashboard and the diagnostic output of the node.
+type AliasRegistry[IDType comparable] struct {
+	aliases map[IDType]string
+	mutex   sync.RWMutex
+}
+
+// NewAliasRegistry creates a new empty AliasRegistry.
+func NewAliasRegistry[IDType comparable]() *AliasRegistry[IDType] {
+	return &AliasRegistry[IDType]{
+		aliases: make(map[IDType]string),
+	}
+}
+
+// Register registers a human-readable alias for the given identifier (it replaces a previously registered alias).
+func (a *AliasRegistry[IDType]) Register(id IDType, alias string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.aliases[id] = alias
+}
+
+// Unregister removes the alias of the given identifier.
+func (a *AliasRegistry[IDType]) Unregister(id IDType) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	delete(a.aliases, id)
+}
+
+// Get returns the alias of the given identifier and a flag that indicates if an alias was registered.
+func (a *AliasRegistry[IDType]) Get(id IDType) (alias string, exists bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	alias, exists = a.aliases[id]
+
+	return alias, exists
+}
+
+// ForEach iterates through all registered aliases and aborts the iteration if the callback returns false.
+func (a *AliasRegistry[IDType]) ForEach(callback func(id IDType, alias string) bool) {
+	a.mutex.RLock()
+	aliasesCopy := make(map[IDType]string, len(a.aliases))
+	for id, alias := range a.aliases {
+		aliasesCopy[id] = alias
+	}
+	a.mutex.RUnlock()
+
+	for id, alias := range aliasesCopy {
+		if !callback(id, alias) {
+			return
+		}
+	}
+}
+
+// Size returns the number of registered aliases.
+func (a *AliasRegistry[IDType]) Size() int {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return len(a.aliases)
+}
+
+// Clear removes all registered aliases.
+func (a *AliasRegistry[IDType]) Clear() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.aliases = make(map[IDType]string)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Global registries ////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	// TransactionIDAliases contains the aliases of TransactionIDs (which are also used to identify conflicts).
+	TransactionIDAliases = NewAliasRegistry[TransactionID]()
+
+	// OutputIDAliases contains the aliases of OutputIDs (which are also used to identify conflict sets).
+	OutputIDAliases = NewAliasRegistry[OutputID]()
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
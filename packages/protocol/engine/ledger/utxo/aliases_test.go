@@ -0,0 +1,36 @@
+package utxo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasRegistry(t *testing.T) {
+	txID := NewTransactionID([]byte("TestAliasRegistry"))
+	outputID := NewOutputID(txID, 1)
+
+	txID.RegisterAlias("TX1")
+	defer txID.UnregisterAlias()
+	outputID.RegisterAlias("TX1.1")
+	defer outputID.UnregisterAlias()
+
+	require.Equal(t, "TransactionID(TX1)", txID.String())
+	require.Equal(t, "OutputID(TX1.1)", outputID.String())
+
+	alias, exists := TransactionIDAliases.Get(txID)
+	require.True(t, exists)
+	require.Equal(t, "TX1", alias)
+
+	collectedAliases := make(map[OutputID]string)
+	OutputIDAliases.ForEach(func(id OutputID, alias string) bool {
+		collectedAliases[id] = alias
+		return true
+	})
+	require.Equal(t, "TX1.1", collectedAliases[outputID])
+
+	txID.UnregisterAlias()
+	_, exists = TransactionIDAliases.Get(txID)
+	require.False(t, exists)
+	require.Equal(t, "TransactionID("+txID.Identifier.Alias()+")", txID.String())
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
-	"sync"
 
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
@@ -58,6 +57,26 @@ func (t TransactionID) String() (humanReadable string) {
 	return "TransactionID(" + t.Alias() + ")"
 }
 
+// RegisterAlias allows to register a human-readable alias for the TransactionID which will be used as a replacement for
+// the String method.
+func (t TransactionID) RegisterAlias(alias string) {
+	TransactionIDAliases.Register(t, alias)
+}
+
+// Alias returns the human-readable alias of the TransactionID (or the base58 encoded bytes of no alias was set).
+func (t TransactionID) Alias() (alias string) {
+	if existingAlias, exists := TransactionIDAliases.Get(t); exists {
+		return existingAlias
+	}
+
+	return t.Identifier.Alias()
+}
+
+// UnregisterAlias allows to unregister a previously registered alias.
+func (t TransactionID) UnregisterAlias() {
+	TransactionIDAliases.Unregister(t)
+}
+
 // EmptyTransactionID contains the null-value of the TransactionID type.
 var EmptyTransactionID TransactionID
 
@@ -137,18 +156,12 @@ func (o *OutputID) FromBytes(outputBytes []byte) (consumedBytes int, err error)
 // RegisterAlias allows to register a human-readable alias for the OutputID which will be used as a replacement for the
 // String method.
 func (o OutputID) RegisterAlias(alias string) {
-	_outputIDAliasesMutex.Lock()
-	defer _outputIDAliasesMutex.Unlock()
-
-	_outputIDAliases[o] = alias
+	OutputIDAliases.Register(o, alias)
 }
 
 // Alias returns the human-readable alias of the OutputID (or the base58 encoded bytes of no alias was set).
 func (o OutputID) Alias() (alias string) {
-	_outputIDAliasesMutex.RLock()
-	defer _outputIDAliasesMutex.RUnlock()
-
-	if existingAlias, exists := _outputIDAliases[o]; exists {
+	if existingAlias, exists := OutputIDAliases.Get(o); exists {
 		return existingAlias
 	}
 
@@ -157,10 +170,7 @@ func (o OutputID) Alias() (alias string) {
 
 // UnregisterAlias allows to unregister a previously registered alias.
 func (o OutputID) UnregisterAlias() {
-	_outputIDAliasesMutex.Lock()
-	defer _outputIDAliasesMutex.Unlock()
-
-	delete(_outputIDAliases, o)
+	OutputIDAliases.Unregister(o)
 }
 
 // Base58 returns a base58 encoded version of the OutputID.
@@ -181,14 +191,6 @@ func (o OutputID) String() (humanReadable string) {
 // EmptyOutputID contains the null-value of the OutputID type.
 var EmptyOutputID OutputID
 
-var (
-	// _outputIDAliases contains a dictionary of OutputIDs associated to their human-readable alias.
-	_outputIDAliases = make(map[OutputID]string)
-
-	// _outputIDAliasesMutex is the mutex that is used to synchronize access to the previous map.
-	_outputIDAliasesMutex = sync.RWMutex{}
-)
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region OutputIDs ////////////////////////////////////////////////////////////////////////////////////////////////////
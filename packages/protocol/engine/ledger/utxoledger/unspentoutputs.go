@@ -1,11 +1,14 @@
 package utxoledger
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"sync"
 
+	"github.com/celestiaorg/smt"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/core/module"
@@ -18,11 +21,15 @@ import (
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/options"
 )
 
 type UnspentOutputs struct {
-	ids *ads.Set[utxo.OutputID, *utxo.OutputID]
+	ids      *ads.Set[utxo.OutputID, *utxo.OutputID]
+	idsStore kvstore.KVStore
+	idsMutex sync.RWMutex
 
 	memPool               mempool.MemPool
 	consumers             map[ledger.UnspentOutputsSubscriber]types.Empty
@@ -30,6 +37,11 @@ type UnspentOutputs struct {
 	batchConsumers        map[ledger.UnspentOutputsSubscriber]types.Empty
 	batchCreatedOutputIDs utxo.OutputIDs
 	batchSpentOutputIDs   utxo.OutputIDs
+	batchStatistics       *ledger.UnspentOutputsStatistics
+	batchSlot             slot.Index
+	statistics            *ledger.UnspentOutputsStatistics
+	statisticsImported    bool
+	slotTimeProvider      func() *slot.TimeProvider
 
 	traits.BatchCommittable
 	module.Module
@@ -42,13 +54,22 @@ const (
 
 func NewUnspentOutputs(e *engine.Engine) (unspentOutputs *UnspentOutputs) {
 	return options.Apply(&UnspentOutputs{
-		consumers: make(map[ledger.UnspentOutputsSubscriber]types.Empty),
+		consumers:        make(map[ledger.UnspentOutputsSubscriber]types.Empty),
+		statistics:       ledger.NewUnspentOutputsStatistics(),
+		slotTimeProvider: e.SlotTimeProvider,
 	}, nil, func(u *UnspentOutputs) {
 		e.HookConstructed(func() {
 			u.BatchCommittable = traits.NewBatchCommittable(e.Storage.UnspentOutputIDs(), PrefixUnspentOutputsLatestCommittedIndex)
-			u.ids = ads.NewSet[utxo.OutputID](e.Storage.UnspentOutputIDs(PrefixUnspentOutputsIDs))
+			u.idsStore = e.Storage.UnspentOutputIDs(PrefixUnspentOutputsIDs)
+			u.ids = ads.NewSet[utxo.OutputID](u.idsStore)
 			u.memPool = e.Ledger.MemPool()
 		})
+
+		e.HookInitialized(func() {
+			if err := u.initStatistics(); err != nil {
+				e.Events.Error.Trigger(errors.Wrap(err, "failed to initialize the statistics of the unspent outputs"))
+			}
+		})
 	})
 }
 
@@ -70,6 +91,39 @@ func (u *UnspentOutputs) IDs() *ads.Set[utxo.OutputID, *utxo.OutputID] {
 	return u.ids
 }
 
+// Statistics returns the statistics about the unspent outputs (e.g. their number and their balances by color).
+func (u *UnspentOutputs) Statistics() *ledger.UnspentOutputsStatistics {
+	return u.statistics
+}
+
+// ProveInclusion returns a compact merkle proof of the given unspent output against the root of the IDs. It returns
+// ErrOutputNotUnspent if the output is not unspent.
+func (u *UnspentOutputs) ProveInclusion(outputID utxo.OutputID) (proof smt.SparseCompactMerkleProof, err error) {
+	if !u.ids.Has(outputID) {
+		return proof, errors.WithMessagef(ledger.ErrOutputNotUnspent, "%s", outputID)
+	}
+
+	outputIDBytes, err := outputID.Bytes()
+	if err != nil {
+		return proof, errors.Wrapf(err, "failed to serialize %s", outputID)
+	}
+
+	// the ads.Set does not expose its tree, so we open a read-only view on the same storage
+	root := u.ids.Root()
+	tree := smt.ImportSparseMerkleTree(
+		lo.PanicOnErr(u.idsStore.WithExtendedRealm([]byte{ads.PrefixSMTKeysStorage})),
+		lo.PanicOnErr(u.idsStore.WithExtendedRealm([]byte{ads.PrefixSMTValuesStorage})),
+		lo.PanicOnErr(blake2b.New256(nil)),
+		root[:],
+	)
+
+	if proof, err = tree.ProveCompact(outputIDBytes); err != nil {
+		return proof, errors.Wrapf(err, "failed to prove inclusion of %s", outputID)
+	}
+
+	return proof, nil
+}
+
 func (u *UnspentOutputs) Begin(newSlot slot.Index) (lastCommittedSlot slot.Index, err error) {
 	if lastCommittedSlot, err = u.BeginBatchedStateTransition(newSlot); err != nil {
 		return 0, errors.Wrap(err, "failed to begin batched state transition")
@@ -81,6 +135,8 @@ func (u *UnspentOutputs) Begin(newSlot slot.Index) (lastCommittedSlot slot.Index
 
 	u.batchCreatedOutputIDs = utxo.NewOutputIDs()
 	u.batchSpentOutputIDs = utxo.NewOutputIDs()
+	u.batchStatistics = ledger.NewUnspentOutputsStatistics()
+	u.batchSlot = newSlot
 	u.batchConsumers = make(map[ledger.UnspentOutputsSubscriber]types.Empty)
 
 	if err = u.preparePendingConsumers(lastCommittedSlot, newSlot); err != nil {
@@ -110,7 +166,10 @@ func (u *UnspentOutputs) Commit() (ctx context.Context) {
 func (u *UnspentOutputs) ApplyCreatedOutput(output *mempool.OutputWithMetadata) (err error) {
 	var targetConsumers map[ledger.UnspentOutputsSubscriber]types.Empty
 	if !u.BatchedStateTransitionStarted() {
+		u.idsMutex.Lock()
 		u.ids.Add(output.Output().ID())
+		u.idsMutex.Unlock()
+		u.statistics.Add(output)
 
 		u.importOutputIntoMemPoolStorage(output)
 
@@ -119,6 +178,7 @@ func (u *UnspentOutputs) ApplyCreatedOutput(output *mempool.OutputWithMetadata)
 		if !u.batchSpentOutputIDs.Delete(output.Output().ID()) {
 			u.batchCreatedOutputIDs.Add(output.Output().ID())
 		}
+		u.batchStatistics.Add(output)
 
 		targetConsumers = u.batchConsumers
 	}
@@ -138,6 +198,7 @@ func (u *UnspentOutputs) ApplySpentOutput(output *mempool.OutputWithMetadata) (e
 		if !u.batchCreatedOutputIDs.Delete(output.Output().ID()) {
 			u.batchSpentOutputIDs.Add(output.Output().ID())
 		}
+		u.batchStatistics.Remove(output)
 
 		targetConsumers = u.batchConsumers
 	}
@@ -154,24 +215,24 @@ func (u *UnspentOutputs) RollbackCreatedOutput(output *mempool.OutputWithMetadat
 }
 
 func (u *UnspentOutputs) RollbackSpentOutput(output *mempool.OutputWithMetadata) (err error) {
+	// outputs that become unspent again are not necessarily known to the MemPool (e.g. when importing a snapshot)
+	u.importOutputIntoMemPoolStorage(output)
+
 	return u.ApplyCreatedOutput(output)
 }
 
 func (u *UnspentOutputs) Export(writer io.WriteSeeker) (err error) {
 	if err = stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
-		var outputWithMetadata *mempool.OutputWithMetadata
-		if iterationErr := u.ids.Stream(func(outputID utxo.OutputID) bool {
-			if outputWithMetadata, err = u.outputWithMetadata(outputID); err != nil {
-				err = errors.Wrap(err, "failed to load output with metadata")
-			} else if err = stream.WriteSerializable(writer, outputWithMetadata); err != nil {
+		if _, iterationErr := u.ForEachUnspentOutput(context.Background(), func(outputWithMetadata *mempool.OutputWithMetadata) bool {
+			if err = stream.WriteSerializable(writer, outputWithMetadata); err != nil {
 				err = errors.Wrap(err, "failed to write output with metadata")
 			} else {
 				elementsCount++
 			}
 
 			return err == nil
-		}); iterationErr != nil {
-			return 0, errors.Errorf("failed to stream unspent output IDs: %s", iterationErr)
+		}, ledger.WithConsistentView()); iterationErr != nil {
+			return 0, errors.Wrap(iterationErr, "failed to iterate over unspent outputs")
 		}
 
 		return
@@ -182,6 +243,83 @@ func (u *UnspentOutputs) Export(writer io.WriteSeeker) (err error) {
 	return
 }
 
+// ForEachUnspentOutput iterates over the unspent outputs in the order of their IDs and passes the ones that match the
+// optional filter to the consumer. It returns a cursor that can be used to resume the iteration (or the EmptyOutputID if
+// all unspent outputs were visited). The iteration is aborted with the error of the context if the context is canceled.
+// Iterations with a consistent view (see WithConsistentView) are not affected by concurrent changes.
+func (u *UnspentOutputs) ForEachUnspentOutput(ctx context.Context, consumer func(output *mempool.OutputWithMetadata) bool, opts ...options.Option[ledger.IteratorOptions]) (cursor utxo.OutputID, err error) {
+	iteratorOptions := ledger.NewIteratorOptions(opts...)
+	cursorBytes := lo.PanicOnErr(iteratorOptions.Cursor.Bytes())
+
+	streamIDs, loadOutputWithMetadata := u.ids.Stream, u.outputWithMetadata
+	if iteratorOptions.ConsistentView {
+		snapshot, outputIDs, snapshotErr := u.snapshot()
+		if snapshotErr != nil {
+			return utxo.EmptyOutputID, errors.Wrap(snapshotErr, "failed to take snapshot of unspent outputs")
+		}
+		defer snapshot.Release()
+
+		streamIDs = func(callback func(outputID utxo.OutputID) bool) error {
+			for _, outputID := range outputIDs {
+				if !callback(outputID) {
+					break
+				}
+			}
+
+			return nil
+		}
+		loadOutputWithMetadata = func(outputID utxo.OutputID) (*mempool.OutputWithMetadata, error) {
+			return snapshotOutputWithMetadata(snapshot, outputID)
+		}
+	}
+
+	consumedOutputs, exhausted := 0, true
+	if streamErr := streamIDs(func(outputID utxo.OutputID) bool {
+		if err = ctx.Err(); err != nil {
+			err = errors.Wrap(err, "iteration over unspent outputs aborted")
+			return false
+		}
+
+		if iteratorOptions.Cursor != utxo.EmptyOutputID && bytes.Compare(lo.PanicOnErr(outputID.Bytes()), cursorBytes) <= 0 {
+			return true
+		}
+
+		if iteratorOptions.Limit > 0 && consumedOutputs == iteratorOptions.Limit {
+			exhausted = false
+			return false
+		}
+
+		var outputWithMetadata *mempool.OutputWithMetadata
+		if outputWithMetadata, err = loadOutputWithMetadata(outputID); err != nil {
+			err = errors.Wrapf(err, "failed to load unspent output %s", outputID)
+			return false
+		}
+
+		cursor = outputID
+		if !iteratorOptions.Matches(outputWithMetadata) {
+			return true
+		}
+
+		consumedOutputs++
+		if !consumer(outputWithMetadata) {
+			exhausted = false
+			return false
+		}
+
+		return true
+	}); streamErr != nil {
+		return utxo.EmptyOutputID, errors.Wrap(streamErr, "failed to stream unspent output IDs")
+	} else if err != nil {
+		return utxo.EmptyOutputID, err
+	}
+
+	if exhausted {
+		return utxo.EmptyOutputID, nil
+	}
+
+	return cursor, nil
+}
+
 func (u *UnspentOutputs) Import(reader io.ReadSeeker, targetSlot slot.Index) (err error) {
 	outputWithMetadata := new(mempool.OutputWithMetadata)
 	if err = stream.ReadCollection(reader, func(i int) (err error) {
@@ -197,6 +335,7 @@ func (u *UnspentOutputs) Import(reader io.ReadSeeker, targetSlot slot.Index) (er
 	}
 
 	u.SetLastCommittedSlot(targetSlot)
+	u.statisticsImported = true
 
 	u.TriggerInitialized()
 
@@ -215,6 +354,7 @@ func (u *UnspentOutputs) Consumers() (consumers []ledger.UnspentOutputsSubscribe
 }
 
 func (u *UnspentOutputs) applyBatch(waitForConsumers *sync.WaitGroup, done func()) {
+	u.idsMutex.Lock()
 	for it := u.batchCreatedOutputIDs.Iterator(); it.HasNext(); {
 		output := it.Next()
 		u.ids.Add(output)
@@ -223,6 +363,10 @@ func (u *UnspentOutputs) applyBatch(waitForConsumers *sync.WaitGroup, done func(
 		output := it.Next()
 		u.ids.Delete(output)
 	}
+	u.idsMutex.Unlock()
+
+	u.statistics.Merge(u.batchStatistics)
+	u.statistics.Sample(u.slotTimeProvider().EndTime(u.batchSlot))
 
 	waitForConsumers.Wait()
 
@@ -231,6 +375,25 @@ func (u *UnspentOutputs) applyBatch(waitForConsumers *sync.WaitGroup, done func(
 	done()
 }
 
+// initStatistics collects the statistics of the unspent outputs of a ledger state that was loaded from disk (the
+// statistics of an imported ledger state are collected while importing it).
+func (u *UnspentOutputs) initStatistics() (err error) {
+	if u.statisticsImported {
+		return nil
+	}
+
+	if _, err = u.ForEachUnspentOutput(context.Background(), func(output *mempool.OutputWithMetadata) bool {
+		u.statistics.Add(output)
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to iterate over unspent outputs")
+	}
+
+	u.statistics.Sample(u.slotTimeProvider().EndTime(u.LastCommittedSlot()))
+
+	return nil
+}
+
 func (u *UnspentOutputs) preparePendingConsumers(currentSlot, targetSlot slot.Index) (err error) {
 	for _, consumer := range u.Consumers() {
 		consumerSlot, err := consumer.BeginBatchedStateTransition(targetSlot)
@@ -270,6 +433,27 @@ func (u *UnspentOutputs) outputWithMetadata(outputID utxo.OutputID) (outputWithM
 	return
 }
 
+// snapshot collects the IDs of the unspent outputs and takes a snapshot of the MemPool storage at the same time, so that
+// the outputs can be loaded consistently while the unspent outputs keep changing.
+func (u *UnspentOutputs) snapshot() (snapshot mempool.StorageSnapshot, outputIDs []utxo.OutputID, err error) {
+	u.idsMutex.RLock()
+	defer u.idsMutex.RUnlock()
+
+	snapshot = u.memPool.Storage().Snapshot()
+
+	outputIDs = make([]utxo.OutputID, 0)
+	if err = u.ids.Stream(func(outputID utxo.OutputID) bool {
+		outputIDs = append(outputIDs, outputID)
+		return true
+	}); err != nil {
+		snapshot.Release()
+
+		return nil, nil, errors.Wrap(err, "failed to stream unspent output IDs")
+	}
+
+	return snapshot, outputIDs, nil
+}
+
 func (u *UnspentOutputs) importOutputIntoMemPoolStorage(output *mempool.OutputWithMetadata) {
 	u.memPool.Storage().CachedOutput(output.ID(), func(id utxo.OutputID) utxo.Output { return output.Output() }).Release()
 	u.memPool.Storage().CachedOutputMetadata(output.ID(), func(outputID utxo.OutputID) *mempool.OutputMetadata {
@@ -285,4 +469,23 @@ func (u *UnspentOutputs) importOutputIntoMemPoolStorage(output *mempool.OutputWi
 	u.memPool.Events().OutputCreated.Trigger(output.ID())
 }
 
+// snapshotOutputWithMetadata loads the output with the given ID and its metadata from the given snapshot.
+func snapshotOutputWithMetadata(snapshot mempool.StorageSnapshot, outputID utxo.OutputID) (outputWithMetadata *mempool.OutputWithMetadata, err error) {
+	output, exists := snapshot.Output(outputID)
+	metadata, metadataExists := snapshot.OutputMetadata(outputID)
+
+	// the snapshot needs to be checked after the reads, as it might have overflowed while they were executed
+	if err = snapshot.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to load output %s", outputID)
+	}
+	if !exists {
+		return nil, errors.Errorf("failed to load output %s", outputID)
+	}
+	if !metadataExists {
+		return nil, errors.Errorf("failed to load metadata of output %s", outputID)
+	}
+
+	return mempool.NewOutputWithMetadata(metadata.InclusionSlot(), outputID, output, metadata.ConsensusManaPledgeID(), metadata.AccessManaPledgeID()), nil
+}
+
 var _ ledger.UnspentOutputs = new(UnspentOutputs)
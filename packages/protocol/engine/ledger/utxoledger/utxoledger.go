@@ -10,7 +10,10 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/metrics"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -21,11 +24,14 @@ type UTXOLedger struct {
 	events         *ledger.Events
 	engine         *engine.Engine
 	memPool        mempool.MemPool
+	metrics        *metrics.Metrics
 	unspentOutputs *UnspentOutputs
 	stateDiffs     *StateDiffs
+	eventJournal   *ledger.EventJournal
 	mutex          sync.RWMutex
 
 	optsMemPoolProvider module.Provider[*engine.Engine, mempool.MemPool]
+	optsEventJournal    []options.Option[ledger.EventJournal]
 
 	module.Module
 }
@@ -40,6 +46,13 @@ func NewProvider(opts ...options.Option[UTXOLedger]) module.Provider[*engine.Eng
 			optsMemPoolProvider: realitiesledger.NewProvider(),
 		}, opts, func(l *UTXOLedger) {
 			l.memPool = l.optsMemPoolProvider(e)
+			l.eventJournal = ledger.NewEventJournal(e.Storage.LedgerEvents(), append([]options.Option[ledger.EventJournal]{
+				ledger.WithErrorHandler(func(err error) {
+					e.Events.Error.Trigger(errors.Wrap(err, "failed to write the event journal"))
+				}),
+			}, l.optsEventJournal...)...)
+			l.events.AttachJournal(l.eventJournal)
+			l.metrics = metrics.New(l.memPool, metrics.WithSlotTimeProvider(e.SlotTimeProvider))
 			l.events.MemPool.LinkTo(l.memPool.Events())
 
 			e.HookConstructed(func() {
@@ -51,6 +64,17 @@ func NewProvider(opts ...options.Option[UTXOLedger]) module.Provider[*engine.Eng
 				l.HookStopped(lo.Batch(
 					e.Events.Ledger.MemPool.TransactionAccepted.Hook(l.onTransactionAccepted).Unhook,
 					e.Events.Ledger.MemPool.TransactionInclusionUpdated.Hook(l.onTransactionInclusionUpdated).Unhook,
+					e.Events.Ledger.MemPool.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+						l.appendToEventJournal(ledger.TransactionBookedEntry, event.TransactionID)
+					}).Unhook,
+					e.Events.Ledger.MemPool.ConflictDAG.ConflictCreated.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+						l.appendToEventJournal(ledger.ConflictCreatedEntry, conflict.ID())
+					}).Unhook,
+					e.Events.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+						l.appendToEventJournal(ledger.TransactionAcceptedEntry, event.Metadata.ID())
+					}).Unhook,
+					e.Events.EvictionState.SlotEvicted.Hook(l.metrics.Evict).Unhook,
+					l.eventJournal.Shutdown,
 				))
 			})
 
@@ -67,6 +91,10 @@ func (l *UTXOLedger) MemPool() mempool.MemPool {
 	return l.memPool
 }
 
+func (l *UTXOLedger) Metrics() *metrics.Metrics {
+	return l.metrics
+}
+
 func (l *UTXOLedger) UnspentOutputs() ledger.UnspentOutputs {
 	return l.unspentOutputs
 }
@@ -205,6 +233,13 @@ func (l *UTXOLedger) onTransactionInclusionUpdated(inclusionUpdatedEvent *mempoo
 	}
 }
 
+// appendToEventJournal writes an entry of the given type to the EventJournal.
+func (l *UTXOLedger) appendToEventJournal(entryType ledger.JournalEntryType, transactionID utxo.TransactionID) {
+	if _, err := l.eventJournal.Append(entryType, transactionID); err != nil {
+		l.engine.Events.Error.Trigger(errors.Wrapf(err, "failed to journal %s event of %s", entryType, transactionID))
+	}
+}
+
 var _ ledger.Ledger = new(UTXOLedger)
 
 func WithMemPoolProvider(provider module.Provider[*engine.Engine, mempool.MemPool]) options.Option[UTXOLedger] {
@@ -212,3 +247,10 @@ func WithMemPoolProvider(provider module.Provider[*engine.Engine, mempool.MemPoo
 		u.optsMemPoolProvider = provider
 	}
 }
+
+// WithEventJournalOptions sets the options of the EventJournal of the ledger.
+func WithEventJournalOptions(opts ...options.Option[ledger.EventJournal]) options.Option[UTXOLedger] {
+	return func(u *UTXOLedger) {
+		u.optsEventJournal = append(u.optsEventJournal, opts...)
+	}
+}
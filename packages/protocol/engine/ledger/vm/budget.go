@@ -0,0 +1,79 @@
+package vm
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+)
+
+// region Budget ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Budget limits the resources that the execution of a single Transaction is allowed to consume (a limit of 0 disables
+// the corresponding check).
+type Budget struct {
+	// Gas contains the maximum amount of gas (the VM specific unit of computation) of the execution.
+	Gas uint64
+
+	// Size contains the maximum size (in bytes) of the serialized Outputs that are created by the execution.
+	Size uint64
+}
+
+// UnlimitedBudget is a Budget that does not limit the execution of a Transaction.
+var UnlimitedBudget = Budget{}
+
+// DefaultBudget is the Budget of the protocol that applies to the execution of every Transaction unless a scheduled
+// upgrade of the protocol parameters changes it (it has to be the same for all nodes of a network, since it decides
+// about the validity of Transactions).
+var DefaultBudget = Budget{Gas: 1000000}
+
+// GasLimit returns the gas limit of the Budget, falling back to the given default if the Budget does not limit the gas
+// (the lower limit is used if both are set).
+func (b Budget) GasLimit(defaultLimit uint64) (gasLimit uint64) {
+	if b.Gas == 0 || (defaultLimit != 0 && defaultLimit < b.Gas) {
+		return defaultLimit
+	}
+
+	return b.Gas
+}
+
+// Check returns an error annotated with ErrBudgetExceeded if the given Cost exceeds one of the limits of the Budget.
+func (b Budget) Check(cost Cost) (err error) {
+	if b.Gas != 0 && cost.Gas > b.Gas {
+		return errors.WithMessagef(ErrBudgetExceeded, "execution consumed %d gas (limit %d)", cost.Gas, b.Gas)
+	}
+
+	if b.Size != 0 && cost.Size > b.Size {
+		return errors.WithMessagef(ErrBudgetExceeded, "created outputs have a size of %d bytes (limit %d)", cost.Size, b.Size)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Cost /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Cost contains the resources that were consumed by the execution of a Transaction.
+type Cost struct {
+	// Gas contains the amount of gas (the VM specific unit of computation) that was consumed by the execution.
+	Gas uint64
+
+	// Size contains the size (in bytes) of the serialized Outputs that were created by the execution.
+	Size uint64
+}
+
+// OutputsSize returns the size (in bytes) of the given serialized Outputs.
+func OutputsSize(outputs []utxo.Output) (size uint64, err error) {
+	for _, output := range outputs {
+		outputBytes, bytesErr := output.Bytes()
+		if bytesErr != nil {
+			return 0, errors.Wrapf(bytesErr, "failed to serialize output %s", output.ID())
+		}
+
+		size += uint64(len(outputBytes))
+	}
+
+	return size, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
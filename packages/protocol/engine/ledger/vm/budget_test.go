@@ -0,0 +1,22 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	require.Equal(t, uint64(100), UnlimitedBudget.GasLimit(100))
+	require.Equal(t, uint64(50), Budget{Gas: 50}.GasLimit(100))
+	require.Equal(t, uint64(100), Budget{Gas: 500}.GasLimit(100))
+	require.Equal(t, uint64(500), Budget{Gas: 500}.GasLimit(0))
+
+	require.NoError(t, UnlimitedBudget.Check(Cost{Gas: 1 << 40, Size: 1 << 40}))
+	require.NoError(t, Budget{Gas: 10, Size: 10}.Check(Cost{Gas: 10, Size: 10}))
+
+	err := Budget{Gas: 10}.Check(Cost{Gas: 11})
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+	require.ErrorIs(t, err, ErrTransactionInvalid)
+	require.ErrorIs(t, Budget{Size: 10}.Check(Cost{Size: 11}), ErrBudgetExceeded)
+}
@@ -0,0 +1,108 @@
+package devnetvm
+
+import (
+	"github.com/pkg/errors"
+)
+
+// region DustPolicy ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DustPolicy defines the minimum amount of IOTA that an output has to hold to be created by a transaction. It protects
+// the ledger against being flooded with outputs that are not worth the storage they occupy.
+type DustPolicy interface {
+	// MinimumDeposit returns the minimum amount of IOTA that the given output has to hold.
+	MinimumDeposit(output Output) (minimumDeposit uint64, err error)
+}
+
+// DefaultDustPolicy is the DustPolicy that is used if no other DustPolicy is configured. It only requires the minimum
+// deposit for AliasOutputs and NFTOutputs, which is also enforced by their syntactic checks.
+var DefaultDustPolicy DustPolicy = NewFixedDustPolicy(DustThresholdAliasOutputIOTA)
+
+// NewDustPolicy creates the DustPolicy with the given name ("fixed" or "bytecost").
+func NewDustPolicy(name string, minimumDeposit, depositPerByte uint64) (dustPolicy DustPolicy, err error) {
+	switch name {
+	case "fixed":
+		return NewFixedDustPolicy(minimumDeposit), nil
+	case "bytecost":
+		return NewByteCostDustPolicy(minimumDeposit, depositPerByte), nil
+	default:
+		return nil, errors.Errorf("unknown dust policy %q", name)
+	}
+}
+
+// ValidateDeposits checks if the given outputs hold the minimum deposit that is required by the DustPolicy.
+func ValidateDeposits(dustPolicy DustPolicy, outputs Outputs) (err error) {
+	for i, output := range outputs {
+		minimumDeposit, err := dustPolicy.MinimumDeposit(output)
+		if err != nil {
+			return errors.Wrapf(err, "failed to determine minimum deposit of output %d", i)
+		}
+
+		if deposit, _ := output.Balances().Get(ColorIOTA); deposit < minimumDeposit {
+			return errors.Errorf("output %d holds %d IOTA which is below the minimum deposit of %d IOTA", i, deposit, minimumDeposit)
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region FixedDustPolicy //////////////////////////////////////////////////////////////////////////////////////////////
+
+// FixedDustPolicy is a DustPolicy that requires a fixed minimum deposit for the outputs that carry state (AliasOutputs
+// and NFTOutputs).
+type FixedDustPolicy struct {
+	minimumDeposit uint64
+}
+
+// NewFixedDustPolicy creates a new FixedDustPolicy with the given minimum deposit.
+func NewFixedDustPolicy(minimumDeposit uint64) *FixedDustPolicy {
+	return &FixedDustPolicy{
+		minimumDeposit: minimumDeposit,
+	}
+}
+
+// MinimumDeposit returns the minimum amount of IOTA that the given output has to hold.
+func (f *FixedDustPolicy) MinimumDeposit(output Output) (minimumDeposit uint64, err error) {
+	switch output.Type() {
+	case AliasOutputType, NFTOutputType:
+		return f.minimumDeposit, nil
+	default:
+		return 0, nil
+	}
+}
+
+var _ DustPolicy = new(FixedDustPolicy)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ByteCostDustPolicy ///////////////////////////////////////////////////////////////////////////////////////////
+
+// ByteCostDustPolicy is a DustPolicy that requires every output to hold a base deposit plus a deposit for every byte
+// of its serialized form, so that outputs pay for the storage they occupy.
+type ByteCostDustPolicy struct {
+	baseDeposit    uint64
+	depositPerByte uint64
+}
+
+// NewByteCostDustPolicy creates a new ByteCostDustPolicy with the given base deposit and deposit per byte.
+func NewByteCostDustPolicy(baseDeposit, depositPerByte uint64) *ByteCostDustPolicy {
+	return &ByteCostDustPolicy{
+		baseDeposit:    baseDeposit,
+		depositPerByte: depositPerByte,
+	}
+}
+
+// MinimumDeposit returns the minimum amount of IOTA that the given output has to hold.
+func (b *ByteCostDustPolicy) MinimumDeposit(output Output) (minimumDeposit uint64, err error) {
+	outputBytes, err := output.Bytes()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to serialize output")
+	}
+
+	return b.baseDeposit + b.depositPerByte*uint64(len(outputBytes)), nil
+}
+
+var _ DustPolicy = new(ByteCostDustPolicy)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
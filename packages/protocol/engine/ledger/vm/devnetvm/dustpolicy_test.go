@@ -0,0 +1,59 @@
+package devnetvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestDustPolicy(t *testing.T) {
+	address := NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	singleOutput := NewSigLockedSingleOutput(50, address)
+	aliasOutput := lo.PanicOnErr(NewAliasOutputMint(map[Color]uint64{ColorIOTA: 150}, address))
+
+	_, err := NewDustPolicy("unknown", 0, 0)
+	require.Error(t, err)
+
+	t.Run("CASE: fixed", func(t *testing.T) {
+		dustPolicy := lo.PanicOnErr(NewDustPolicy("fixed", 200, 0))
+
+		assert.Equal(t, uint64(0), lo.PanicOnErr(dustPolicy.MinimumDeposit(singleOutput)))
+		assert.Equal(t, uint64(200), lo.PanicOnErr(dustPolicy.MinimumDeposit(aliasOutput)))
+		assert.NoError(t, ValidateDeposits(dustPolicy, NewOutputs(singleOutput)))
+		assert.Error(t, ValidateDeposits(dustPolicy, NewOutputs(singleOutput, aliasOutput)))
+	})
+
+	t.Run("CASE: bytecost", func(t *testing.T) {
+		dustPolicy := lo.PanicOnErr(NewDustPolicy("bytecost", 10, 1))
+
+		assert.Equal(t, uint64(10+len(lo.PanicOnErr(singleOutput.Bytes()))), lo.PanicOnErr(dustPolicy.MinimumDeposit(singleOutput)))
+		assert.Error(t, ValidateDeposits(dustPolicy, NewOutputs(singleOutput)))
+		assert.NoError(t, ValidateDeposits(dustPolicy, NewOutputs(NewSigLockedSingleOutput(1000, address))))
+	})
+
+	t.Run("CASE: default", func(t *testing.T) {
+		assert.Equal(t, DefaultDustPolicy, new(VM).DustPolicy(time.Now()))
+		assert.NoError(t, ValidateDeposits(NewVM().DustPolicy(time.Now()), NewOutputs(singleOutput, aliasOutput)))
+	})
+
+	t.Run("CASE: provider", func(t *testing.T) {
+		upgradeTime := time.Now()
+		upgradedDustPolicy := NewFixedDustPolicy(1000)
+
+		vm := NewVM(WithDustPolicy(NewFixedDustPolicy(200)), WithDustPolicyProvider(func(timestamp time.Time) DustPolicy {
+			if timestamp.Before(upgradeTime) {
+				return DefaultDustPolicy
+			}
+
+			return upgradedDustPolicy
+		}))
+
+		assert.Equal(t, DefaultDustPolicy, vm.DustPolicy(upgradeTime.Add(-time.Second)))
+		assert.Equal(t, upgradedDustPolicy, vm.DustPolicy(upgradeTime))
+	})
+}
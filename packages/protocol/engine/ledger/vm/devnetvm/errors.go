@@ -1,8 +1,9 @@
 package devnetvm
 
 import (
-	"github.com/pkg/errors"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 )
 
-// ErrTransactionInvalid is returned if a Transaction or any of its building blocks is considered to be invalid.
-var ErrTransactionInvalid = errors.New("transaction invalid")
+// ErrTransactionInvalid is returned if a Transaction or any of its building blocks is considered to be invalid (the
+// reason is described by the vm.ValidationError in the chain of the error).
+var ErrTransactionInvalid = vm.ErrTransactionInvalid
@@ -0,0 +1,143 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+// region Indexer //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Balances aggregates the outputs of the given Address into AddressBalances. The aggregation is aborted with the error
+// of the context if the context is canceled.
+func (i *Indexer) Balances(ctx context.Context, address devnetvm.Address) (balances *AddressBalances, err error) {
+	cachedAddressOutputMappings, err := i.CachedAddressOutputMappings(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	balances = NewAddressBalances()
+	cachedAddressOutputMappings.Consume(func(mapping *AddressOutputMapping) {
+		if err != nil {
+			return
+		} else if err = ctx.Err(); err != nil {
+			err = errors.Wrapf(err, "aggregation of the balances of address %s aborted", address.Base58())
+			return
+		}
+
+		i.ledgerFunc().Storage().CachedOutput(mapping.OutputID()).Consume(func(output utxo.Output) {
+			devnetOutput, isDevnetOutput := output.(devnetvm.Output)
+			if !isDevnetOutput {
+				return
+			}
+
+			i.ledgerFunc().Storage().CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *mempool.OutputMetadata) {
+				spentByAccepted, spentByPending := i.consumptionState(outputMetadata)
+
+				balances.add(devnetOutput, outputMetadata.ConfirmationState(), spentByAccepted, spentByPending)
+			})
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// consumptionState returns whether the Output of the given OutputMetadata was spent by an accepted Transaction and
+// whether it is spent by Transactions that are still pending.
+func (i *Indexer) consumptionState(outputMetadata *mempool.OutputMetadata) (spentByAccepted, spentByPending bool) {
+	if outputMetadata.ConfirmedConsumer() != utxo.EmptyTransactionID {
+		return true, false
+	}
+
+	i.ledgerFunc().Storage().CachedConsumers(outputMetadata.ID()).Consume(func(consumer *mempool.Consumer) {
+		i.ledgerFunc().Storage().CachedTransactionMetadata(consumer.TransactionID()).Consume(func(transactionMetadata *mempool.TransactionMetadata) {
+			if confirmationState := transactionMetadata.ConfirmationState(); confirmationState.IsAccepted() {
+				spentByAccepted = true
+			} else if !confirmationState.IsRejected() {
+				spentByPending = true
+			}
+		})
+	})
+
+	return spentByAccepted, spentByPending && !spentByAccepted
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AddressBalances //////////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressBalances contains the balances of an Address broken down by Color. The balance that the Address is expected
+// to hold once all pending Transactions were accepted is Balances + PendingIn - PendingOut.
+type AddressBalances struct {
+	// Balances contains the balances of the accepted outputs that were not spent by an accepted Transaction.
+	Balances map[devnetvm.Color]uint64
+
+	// PendingIn contains the balances of the outputs that were neither accepted nor rejected yet.
+	PendingIn map[devnetvm.Color]uint64
+
+	// PendingOut contains the balances of the outputs that are spent by Transactions that were neither accepted nor
+	// rejected yet.
+	PendingOut map[devnetvm.Color]uint64
+}
+
+// NewAddressBalances creates new (empty) AddressBalances.
+func NewAddressBalances() *AddressBalances {
+	return &AddressBalances{
+		Balances:   make(map[devnetvm.Color]uint64),
+		PendingIn:  make(map[devnetvm.Color]uint64),
+		PendingOut: make(map[devnetvm.Color]uint64),
+	}
+}
+
+// Colors returns the ordered list of Colors that are contained in the AddressBalances.
+func (a *AddressBalances) Colors() (colors []devnetvm.Color) {
+	seenColors := make(map[devnetvm.Color]bool)
+	for _, balances := range []map[devnetvm.Color]uint64{a.Balances, a.PendingIn, a.PendingOut} {
+		for color := range balances {
+			if !seenColors[color] {
+				seenColors[color] = true
+				colors = append(colors, color)
+			}
+		}
+	}
+
+	sort.Slice(colors, func(i, j int) bool {
+		return bytes.Compare(colors[i][:], colors[j][:]) < 0
+	})
+
+	return colors
+}
+
+// add adds the balances of the given output to the AddressBalances.
+func (a *AddressBalances) add(output devnetvm.Output, confirmationState confirmation.State, spentByAccepted, spentByPending bool) {
+	if spentByAccepted || confirmationState.IsRejected() {
+		return
+	}
+
+	target := a.PendingIn
+	if confirmationState.IsAccepted() {
+		target = a.Balances
+	}
+
+	output.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+		target[color] += balance
+		if spentByPending {
+			a.PendingOut[color] += balance
+		}
+
+		return true
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
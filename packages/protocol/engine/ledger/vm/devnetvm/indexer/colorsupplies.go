@@ -0,0 +1,324 @@
+package indexer
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+// region Indexer //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ColorSupplies returns the tracker of the minted and melted supply of the colored coins.
+func (i *Indexer) ColorSupplies() *ColorSupplyTracker {
+	return i.colorSupplies
+}
+
+// InitColorSupplies (re)initializes the tracked supply of the colored coins with the balances of the given unspent
+// outputs.
+func (i *Indexer) InitColorSupplies(unspentOutputs ledger.UnspentOutputs) {
+	i.colorSupplies.Init(unspentOutputs.Statistics().BalancesByColor())
+}
+
+// OnTransactionBooked records the pending supply changes of a booked Transaction.
+func (i *Indexer) OnTransactionBooked(event *mempool.TransactionBookedEvent) {
+	memPool := i.ledgerFunc()
+
+	// transactions that are rejected while being booked never change the supply
+	var rejected bool
+	memPool.Storage().CachedTransactionMetadata(event.TransactionID).Consume(func(metadata *mempool.TransactionMetadata) {
+		rejected = memPool.ConflictDAG().ConfirmationState(metadata.ConflictIDs()).IsRejected()
+	})
+	if rejected {
+		return
+	}
+
+	memPool.Storage().CachedTransaction(event.TransactionID).Consume(func(transaction utxo.Transaction) {
+		inputs := make(devnetvm.Outputs, 0)
+		for it := memPool.Utils().ResolveInputs(transaction.Inputs()).Iterator(); it.HasNext(); {
+			memPool.Storage().CachedOutput(it.Next()).Consume(func(output utxo.Output) {
+				if typedOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+					inputs = append(inputs, typedOutput)
+				}
+			})
+		}
+
+		outputs := make(devnetvm.Outputs, 0)
+		_ = event.Outputs.ForEach(func(output utxo.Output) error {
+			if typedOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+				outputs = append(outputs, typedOutput)
+			}
+
+			return nil
+		})
+
+		i.colorSupplies.Book(event.TransactionID, NewColorSupplyChanges(inputs, outputs))
+	})
+}
+
+// OnTransactionAccepted adds the pending supply changes of an accepted Transaction to the confirmed supply.
+func (i *Indexer) OnTransactionAccepted(event *mempool.TransactionEvent) {
+	i.colorSupplies.Accept(event.Metadata.ID())
+}
+
+// OnTransactionRejected drops the pending supply changes of a rejected Transaction.
+func (i *Indexer) OnTransactionRejected(metadata *mempool.TransactionMetadata) {
+	i.colorSupplies.Discard(metadata.ID())
+}
+
+// OnTransactionOrphaned drops the pending supply changes of an orphaned Transaction.
+func (i *Indexer) OnTransactionOrphaned(event *mempool.TransactionEvent) {
+	i.colorSupplies.Discard(event.Metadata.ID())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ColorSupplyTracker ///////////////////////////////////////////////////////////////////////////////////////////
+
+// ColorSupplyTracker keeps track of the minted and melted supply of the colored coins. The changes of a Transaction are
+// recorded as pending when it is booked and are added to the confirmed supply once the Transaction is accepted (or
+// dropped if it is rejected or orphaned).
+type ColorSupplyTracker struct {
+	// supplies contains the supply of the known colors.
+	supplies map[devnetvm.Color]*ColorSupply
+
+	// pendingChanges contains the supply changes of the booked Transactions that were not accepted, yet.
+	pendingChanges map[utxo.TransactionID]*ColorSupplyChanges
+
+	// mutex is used to synchronize access to the supplies.
+	mutex sync.RWMutex
+}
+
+// NewColorSupplyTracker creates a new (empty) ColorSupplyTracker instance.
+func NewColorSupplyTracker() *ColorSupplyTracker {
+	return &ColorSupplyTracker{
+		supplies:       make(map[devnetvm.Color]*ColorSupply),
+		pendingChanges: make(map[utxo.TransactionID]*ColorSupplyChanges),
+	}
+}
+
+// Init (re)initializes the confirmed supply with the given balances of the unspent outputs (e.g. after loading the
+// ledger state from disk) and drops all previously tracked supplies. Since the history of the ledger state is not
+// available, the balances are considered to be minted.
+func (c *ColorSupplyTracker) Init(balancesByColor map[devnetvm.Color]uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.supplies = make(map[devnetvm.Color]*ColorSupply)
+	c.pendingChanges = make(map[utxo.TransactionID]*ColorSupplyChanges)
+
+	for color, balance := range balancesByColor {
+		if color != devnetvm.ColorIOTA {
+			c.supply(color).Minted += balance
+		}
+	}
+}
+
+// Book records the supply changes of the given Transaction as pending.
+func (c *ColorSupplyTracker) Book(transactionID utxo.TransactionID, changes *ColorSupplyChanges) {
+	if changes.IsEmpty() {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.pendingChanges[transactionID]; exists {
+		return
+	}
+	c.pendingChanges[transactionID] = changes
+
+	c.apply(changes, func(supply *ColorSupply, minted, melted uint64) {
+		supply.PendingMinted += minted
+		supply.PendingMelted += melted
+	})
+}
+
+// Accept adds the pending supply changes of the given Transaction to the confirmed supply.
+func (c *ColorSupplyTracker) Accept(transactionID utxo.TransactionID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	changes, exists := c.pendingChanges[transactionID]
+	if !exists {
+		return
+	}
+	delete(c.pendingChanges, transactionID)
+
+	c.apply(changes, func(supply *ColorSupply, minted, melted uint64) {
+		supply.PendingMinted -= minted
+		supply.PendingMelted -= melted
+		supply.Minted += minted
+		supply.Melted += melted
+	})
+}
+
+// Discard drops the pending supply changes of the given Transaction (e.g. because it was rejected or orphaned).
+func (c *ColorSupplyTracker) Discard(transactionID utxo.TransactionID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	changes, exists := c.pendingChanges[transactionID]
+	if !exists {
+		return
+	}
+	delete(c.pendingChanges, transactionID)
+
+	c.apply(changes, func(supply *ColorSupply, minted, melted uint64) {
+		supply.PendingMinted -= minted
+		supply.PendingMelted -= melted
+	})
+
+	c.apply(changes, func(supply *ColorSupply, _, _ uint64) {
+		if supply.IsEmpty() {
+			delete(c.supplies, supply.Color)
+		}
+	})
+}
+
+// ColorSupply returns the supply of the given color.
+func (c *ColorSupplyTracker) ColorSupply(color devnetvm.Color) (supply ColorSupply, exists bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	trackedSupply, exists := c.supplies[color]
+	if !exists {
+		return ColorSupply{Color: color}, false
+	}
+
+	return *trackedSupply, true
+}
+
+// ColorSupplies returns the supply of all known colors (ordered by color).
+func (c *ColorSupplyTracker) ColorSupplies() (supplies []ColorSupply) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	supplies = make([]ColorSupply, 0, len(c.supplies))
+	for _, supply := range c.supplies {
+		supplies = append(supplies, *supply)
+	}
+
+	sort.Slice(supplies, func(i, j int) bool {
+		return bytes.Compare(supplies[i].Color.Bytes(), supplies[j].Color.Bytes()) < 0
+	})
+
+	return supplies
+}
+
+// apply calls the given update function for every color that is affected by the given changes.
+func (c *ColorSupplyTracker) apply(changes *ColorSupplyChanges, update func(supply *ColorSupply, minted, melted uint64)) {
+	for color, minted := range changes.Minted {
+		update(c.supply(color), minted, 0)
+	}
+
+	for color, melted := range changes.Melted {
+		update(c.supply(color), 0, melted)
+	}
+}
+
+// supply returns the supply of the given color (and creates it if it doesn't exist, yet).
+func (c *ColorSupplyTracker) supply(color devnetvm.Color) (supply *ColorSupply) {
+	supply, exists := c.supplies[color]
+	if !exists {
+		supply = &ColorSupply{Color: color}
+		c.supplies[color] = supply
+	}
+
+	return supply
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ColorSupply //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ColorSupply contains the minted and melted supply of a color.
+type ColorSupply struct {
+	// Color contains the color of the supply.
+	Color devnetvm.Color
+
+	// Minted contains the amount of the color that was minted by accepted Transactions.
+	Minted uint64
+
+	// Melted contains the amount of the color that was melted by accepted Transactions.
+	Melted uint64
+
+	// PendingMinted contains the amount of the color that is minted by booked but not yet accepted Transactions.
+	PendingMinted uint64
+
+	// PendingMelted contains the amount of the color that is melted by booked but not yet accepted Transactions.
+	PendingMelted uint64
+}
+
+// Circulating returns the confirmed amount of the color that is in circulation.
+func (c ColorSupply) Circulating() uint64 {
+	if c.Melted > c.Minted {
+		return 0
+	}
+
+	return c.Minted - c.Melted
+}
+
+// IsEmpty returns true if the color was neither minted nor melted.
+func (c ColorSupply) IsEmpty() bool {
+	return c.Minted == 0 && c.Melted == 0 && c.PendingMinted == 0 && c.PendingMelted == 0
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ColorSupplyChanges ///////////////////////////////////////////////////////////////////////////////////////////
+
+// ColorSupplyChanges contains the amounts of the colors that are minted and melted by a Transaction.
+type ColorSupplyChanges struct {
+	// Minted contains the amounts of the colors that are minted.
+	Minted map[devnetvm.Color]uint64
+
+	// Melted contains the amounts of the colors that are melted (converted back to IOTA).
+	Melted map[devnetvm.Color]uint64
+}
+
+// NewColorSupplyChanges determines the amounts of the colors that are minted and melted by a Transaction that consumes
+// the given inputs and creates the given outputs (with the minting color already being replaced by the new color).
+func NewColorSupplyChanges(inputs, outputs devnetvm.Outputs) (changes *ColorSupplyChanges) {
+	balanceDiffs := make(map[devnetvm.Color]int64)
+	for _, output := range outputs {
+		output.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+			balanceDiffs[color] += int64(balance)
+			return true
+		})
+	}
+	for _, input := range inputs {
+		input.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+			balanceDiffs[color] -= int64(balance)
+			return true
+		})
+	}
+
+	changes = &ColorSupplyChanges{
+		Minted: make(map[devnetvm.Color]uint64),
+		Melted: make(map[devnetvm.Color]uint64),
+	}
+	for color, diff := range balanceDiffs {
+		switch {
+		case color == devnetvm.ColorIOTA:
+			continue
+		case diff > 0:
+			changes.Minted[color] = uint64(diff)
+		case diff < 0:
+			changes.Melted[color] = uint64(-diff)
+		}
+	}
+
+	return changes
+}
+
+// IsEmpty returns true if no colors are minted or melted.
+func (c *ColorSupplyChanges) IsEmpty() bool {
+	return len(c.Minted) == 0 && len(c.Melted) == 0
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
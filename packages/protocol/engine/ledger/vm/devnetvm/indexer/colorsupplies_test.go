@@ -0,0 +1,70 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+)
+
+func TestColorSupplyTracker(t *testing.T) {
+	address := devnetvm.NewED25519Address(ed25519.PublicKey{})
+	colorA, colorB := devnetvm.Color{1}, devnetvm.Color{2}
+
+	tracker := indexer.NewColorSupplyTracker()
+	tracker.Init(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 1000, colorA: 100})
+
+	supplyA, exists := tracker.ColorSupply(colorA)
+	require.True(t, exists)
+	require.EqualValues(t, 100, supplyA.Circulating())
+
+	// minting colorB and melting part of colorA
+	mintChanges := indexer.NewColorSupplyChanges(
+		devnetvm.NewOutputs(
+			devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 500, colorA: 100}), address),
+		),
+		devnetvm.NewOutputs(
+			devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{colorB: 300, colorA: 60}), address),
+			devnetvm.NewSigLockedColoredOutput(devnetvm.NewColoredBalances(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 240}), address),
+		),
+	)
+	require.Equal(t, map[devnetvm.Color]uint64{colorB: 300}, mintChanges.Minted)
+	require.Equal(t, map[devnetvm.Color]uint64{colorA: 40}, mintChanges.Melted)
+
+	mintTransactionID := utxo.NewTransactionID([]byte{1})
+	tracker.Book(mintTransactionID, mintChanges)
+
+	supplyB, exists := tracker.ColorSupply(colorB)
+	require.True(t, exists)
+	require.EqualValues(t, 300, supplyB.PendingMinted)
+	require.Zero(t, supplyB.Circulating())
+
+	tracker.Accept(mintTransactionID)
+
+	supplyA, _ = tracker.ColorSupply(colorA)
+	require.Equal(t, indexer.ColorSupply{Color: colorA, Minted: 100, Melted: 40}, supplyA)
+	require.EqualValues(t, 60, supplyA.Circulating())
+
+	supplyB, _ = tracker.ColorSupply(colorB)
+	require.Equal(t, indexer.ColorSupply{Color: colorB, Minted: 300}, supplyB)
+
+	// rejected transactions do not leave any traces
+	colorC := devnetvm.Color{3}
+	rejectedTransactionID := utxo.NewTransactionID([]byte{2})
+	tracker.Book(rejectedTransactionID, &indexer.ColorSupplyChanges{
+		Minted: map[devnetvm.Color]uint64{colorC: 10},
+		Melted: map[devnetvm.Color]uint64{colorB: 10},
+	})
+	tracker.Discard(rejectedTransactionID)
+
+	_, exists = tracker.ColorSupply(colorC)
+	require.False(t, exists)
+	require.Equal(t, []indexer.ColorSupply{
+		{Color: colorA, Minted: 100, Melted: 40},
+		{Color: colorB, Minted: 300},
+	}, tracker.ColorSupplies())
+}
@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events is a container that acts as a dictionary for the existing events of an Indexer.
+type Events struct {
+	// OutputIndexed is triggered after a created output was added to the index of its addresses.
+	OutputIndexed *event.Event1[*OutputEvent]
+
+	// OutputRemoved is triggered after a spent or rejected output was removed from the index of its addresses.
+	OutputRemoved *event.Event1[*OutputEvent]
+
+	// FallbackOutputExpired is triggered when the fallback deadline of an unspent ExtendedLockedOutput passed, so that
+	// the funds can be reclaimed by the fallback address.
+	FallbackOutputExpired *event.Event1[*devnetvm.ExtendedLockedOutput]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		OutputIndexed:         event.New1[*OutputEvent](),
+		OutputRemoved:         event.New1[*OutputEvent](),
+		FallbackOutputExpired: event.New1[*devnetvm.ExtendedLockedOutput](),
+	}
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OutputEvent //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputEvent is the container for the information of an Output whose index entries were changed.
+type OutputEvent struct {
+	// Output contains the indexed Output.
+	Output devnetvm.Output
+
+	// Addresses contains the addresses whose index entries were changed.
+	Addresses []devnetvm.Address
+}
+
+// newOutputEvent creates a new OutputEvent for the given Output.
+func newOutputEvent(output devnetvm.Output) *OutputEvent {
+	return &OutputEvent{
+		Output:    output,
+		Addresses: outputAddresses(output),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,24 +1,49 @@
 package indexer
 
 import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/core/cerrors"
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/objectstorage"
 	"github.com/iotaledger/hive.go/objectstorage/generic"
+	"github.com/iotaledger/hive.go/runtime/timed"
+	"github.com/iotaledger/hive.go/serializer/v2/marshalutil"
 )
 
 // region Indexer //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Indexer is a component that indexes the Outputs of a ledgerFunc for easier lookups.
 type Indexer struct {
+	// Events contains all events of the Indexer.
+	Events *Events
+
 	// addressOutputMappingStorage is an object storage used to persist AddressOutputMapping objects.
 	addressOutputMappingStorage *generic.ObjectStorage[*AddressOutputMapping]
 
+	// aliasChainMappingStorage is an object storage used to persist AliasChainMapping objects.
+	aliasChainMappingStorage *generic.ObjectStorage[*AliasChainMapping]
+
+	// unlockConditionMappingStorage is an object storage used to persist UnlockConditionMapping objects.
+	unlockConditionMappingStorage *generic.ObjectStorage[*UnlockConditionMapping]
+
+	// colorSupplies keeps track of the minted and melted supply of the colored coins.
+	colorSupplies *ColorSupplyTracker
+
+	// fallbackSweeper schedules the detection of the ExtendedLockedOutputs whose fallback deadline passed.
+	fallbackSweeper *timed.TaskExecutor[utxo.OutputID]
+
 	// ledgerFunc contains the indexed MemPool.
 	ledgerFunc func() mempool.MemPool
 
@@ -29,8 +54,11 @@ type Indexer struct {
 // New returns a new Indexer instance with the given options.
 func New(ledgerFunc func() mempool.MemPool, options ...Option) (i *Indexer) {
 	i = &Indexer{
-		ledgerFunc: ledgerFunc,
-		options:    newOptions(options...),
+		Events:          NewEvents(),
+		colorSupplies:   NewColorSupplyTracker(),
+		fallbackSweeper: timed.NewTaskExecutor[utxo.OutputID](1),
+		ledgerFunc:      ledgerFunc,
+		options:         newOptions(options...),
 	}
 
 	i.addressOutputMappingStorage = generic.NewStructStorage[AddressOutputMapping](
@@ -41,12 +69,34 @@ func New(ledgerFunc func() mempool.MemPool, options ...Option) (i *Indexer) {
 		objectstorage.PartitionKey(devnetvm.AddressLength, utxo.OutputID{}.Length()),
 	)
 
+	i.aliasChainMappingStorage = generic.NewStructStorage[AliasChainMapping](
+		generic.NewStoreWithRealm(i.options.store, database.PrefixIndexer, PrefixAliasChainMappingStorage),
+		i.options.cacheTimeProvider.CacheTime(i.options.aliasChainMappingCacheTime),
+		objectstorage.LeakDetectionEnabled(false),
+		objectstorage.StoreOnCreation(true),
+		objectstorage.PartitionKey(devnetvm.AddressLength, utxo.OutputID{}.Length()),
+	)
+
+	i.unlockConditionMappingStorage = generic.NewStructStorage[UnlockConditionMapping](
+		generic.NewStoreWithRealm(i.options.store, database.PrefixIndexer, PrefixUnlockConditionMappingStorage),
+		i.options.cacheTimeProvider.CacheTime(i.options.unlockConditionMappingCacheTime),
+		objectstorage.LeakDetectionEnabled(false),
+		objectstorage.StoreOnCreation(true),
+		objectstorage.PartitionKey(devnetvm.AddressLength, utxo.OutputID{}.Length()+2*marshalutil.Int64Size),
+	)
+
 	return i
 }
 
-// IndexOutput stores the AddressOutputMapping dependent on which type of output it is.
+// IndexOutput stores the AddressOutputMapping and the UnlockConditionMappings dependent on which type of output it is.
 func (i *Indexer) IndexOutput(output devnetvm.Output) {
 	i.updateOutput(output, i.StoreAddressOutputMapping)
+
+	for _, mapping := range unlockConditionMappings(output) {
+		if result, stored := i.unlockConditionMappingStorage.StoreIfAbsent(mapping); stored {
+			result.Release()
+		}
+	}
 }
 
 // StoreAddressOutputMapping stores the address-output mapping.
@@ -61,20 +111,234 @@ func (i *Indexer) RemoveAddressOutputMapping(address devnetvm.Address, outputID
 	i.addressOutputMappingStorage.Delete(NewAddressOutputMapping(address, outputID).ObjectStorageKey())
 }
 
-// CachedAddressOutputMappings retrieves all AddressOutputMappings for a particular address.
-func (i *Indexer) CachedAddressOutputMappings(address devnetvm.Address) (cachedAddressOutputMappings generic.CachedObjects[*AddressOutputMapping]) {
+// CachedAddressOutputMappings retrieves all AddressOutputMappings for a particular address. The lookup is aborted with
+// the error of the context if the context is canceled.
+func (i *Indexer) CachedAddressOutputMappings(ctx context.Context, address devnetvm.Address) (cachedAddressOutputMappings generic.CachedObjects[*AddressOutputMapping], err error) {
 	i.addressOutputMappingStorage.ForEach(func(key []byte, cachedObject *generic.CachedObject[*AddressOutputMapping]) bool {
+		if err = ctx.Err(); err != nil {
+			cachedObject.Release()
+			return false
+		}
+
 		cachedAddressOutputMappings = append(cachedAddressOutputMappings, cachedObject)
 		return true
 	}, objectstorage.WithIteratorPrefix(address.Bytes()))
 
-	return cachedAddressOutputMappings
+	if err != nil {
+		cachedAddressOutputMappings.Release()
+
+		return nil, errors.Wrapf(err, "lookup of the outputs of address %s aborted", address.Base58())
+	}
+
+	return cachedAddressOutputMappings, nil
+}
+
+// ForEachAddressOutputID iterates over the IDs of the Outputs on the given Address that follow the given cursor (the
+// EmptyOutputID starts at the beginning) in the order of their serialized form until the callback returns false. The
+// persisted mappings are streamed in the key order of the store (only the mappings that are not persisted yet are
+// sorted), so that a page of the Outputs only decodes the keys up to its end. The iteration is aborted with the error
+// of the context if the context is canceled.
+func (i *Indexer) ForEachAddressOutputID(ctx context.Context, address devnetvm.Address, cursor utxo.OutputID, callback func(outputID utxo.OutputID) bool) (err error) {
+	prefix, cursorBytes := address.Bytes(), lo.PanicOnErr(cursor.Bytes())
+	afterCursor := func(key []byte) bool {
+		return cursor == utxo.EmptyOutputID || bytes.Compare(key[len(prefix):], cursorBytes) > 0
+	}
+
+	// the mappings that were not persisted yet are only cached, so they are merged into the ordered keys of the store
+	cachedKeys := make([][]byte, 0)
+	i.addressOutputMappingStorage.ForEachKeyOnly(func(key []byte) bool {
+		if afterCursor(key) {
+			cachedKeys = append(cachedKeys, key)
+		}
+
+		return true
+	}, objectstorage.WithIteratorPrefix(prefix), objectstorage.WithIteratorSkipStorage(true))
+
+	sort.Slice(cachedKeys, func(a, b int) bool {
+		return bytes.Compare(cachedKeys[a], cachedKeys[b]) < 0
+	})
+
+	consume := func(key []byte) bool {
+		mapping := new(AddressOutputMapping)
+		if err = mapping.FromObjectStorage(key, nil); err != nil {
+			err = errors.Wrap(err, "failed to decode address output mapping")
+			return false
+		}
+
+		return callback(mapping.OutputID())
+	}
+
+	aborted := false
+	i.addressOutputMappingStorage.ForEachKeyOnly(func(key []byte) bool {
+		if err = ctx.Err(); err != nil {
+			aborted = true
+			return false
+		}
+
+		if !afterCursor(key) {
+			return true
+		}
+
+		for ; len(cachedKeys) > 0 && bytes.Compare(cachedKeys[0], key) <= 0; cachedKeys = cachedKeys[1:] {
+			if !bytes.Equal(cachedKeys[0], key) && !consume(cachedKeys[0]) {
+				aborted = true
+				return false
+			}
+		}
+
+		if aborted = !consume(key); aborted {
+			return false
+		}
+
+		return true
+	}, objectstorage.WithIteratorPrefix(prefix), objectstorage.WithIteratorSkipCache(true))
+
+	for ; !aborted && len(cachedKeys) > 0; cachedKeys = cachedKeys[1:] {
+		if err = ctx.Err(); err != nil || !consume(cachedKeys[0]) {
+			break
+		}
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "lookup of the outputs of address %s aborted", address.Base58())
+	}
+
+	return nil
+}
+
+// SpendableOutputs returns the unspent Outputs that the given Address can unlock at the given time (taking timelocks
+// and fallback conditions into account). The lookup is aborted with the error of the context if the context is
+// canceled.
+func (i *Indexer) SpendableOutputs(ctx context.Context, address devnetvm.Address, t time.Time) (outputs devnetvm.Outputs, err error) {
+	var outputIDs []utxo.OutputID
+	i.unlockConditionMappingStorage.ForEach(func(key []byte, cachedObject *generic.CachedObject[*UnlockConditionMapping]) bool {
+		if err = ctx.Err(); err != nil {
+			cachedObject.Release()
+			return false
+		}
+
+		cachedObject.Consume(func(mapping *UnlockConditionMapping) {
+			if mapping.UnlockableAt(t) {
+				outputIDs = append(outputIDs, mapping.OutputID())
+			}
+		})
+
+		return true
+	}, objectstorage.WithIteratorPrefix(address.Bytes()))
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "lookup of the spendable outputs of address %s aborted", address.Base58())
+	}
+
+	for _, outputID := range outputIDs {
+		i.ledgerFunc().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
+			outputs = append(outputs, o.(devnetvm.Output))
+		})
+	}
+
+	return outputs, nil
+}
+
+// StoreAliasChainMapping stores the mapping of the given AliasOutput to the chain of its AliasAddress.
+func (i *Indexer) StoreAliasChainMapping(output *devnetvm.AliasOutput) {
+	if result, stored := i.aliasChainMappingStorage.StoreIfAbsent(NewAliasChainMapping(output.GetAliasAddress(), output.ID())); stored {
+		result.Release()
+	}
+}
+
+// RemoveAliasChainMapping removes the mapping of the given AliasOutput from the chain of its AliasAddress.
+func (i *Indexer) RemoveAliasChainMapping(output *devnetvm.AliasOutput) {
+	i.aliasChainMappingStorage.Delete(NewAliasChainMapping(output.GetAliasAddress(), output.ID()).ObjectStorageKey())
+}
+
+// AliasChain returns the state transitions of the chain of the given AliasAddress ordered by their position in the
+// chain (conflicting transitions share the same position). The lookup is aborted with the error of the context if the
+// context is canceled.
+func (i *Indexer) AliasChain(ctx context.Context, aliasAddress *devnetvm.AliasAddress) (transitions []*AliasTransition, err error) {
+	transitionsByOutputID := make(map[utxo.OutputID]*AliasTransition)
+	i.aliasChainMappingStorage.ForEach(func(key []byte, cachedObject *generic.CachedObject[*AliasChainMapping]) bool {
+		if err = ctx.Err(); err != nil {
+			cachedObject.Release()
+			return false
+		}
+
+		cachedObject.Consume(func(mapping *AliasChainMapping) {
+			if transition := i.aliasTransition(aliasAddress, mapping.OutputID()); transition != nil {
+				transitionsByOutputID[transition.OutputID] = transition
+			}
+		})
+
+		return true
+	}, objectstorage.WithIteratorPrefix(aliasAddress.Bytes()))
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "lookup of the chain of alias %s aborted", aliasAddress.Base58())
+	}
+
+	transitions = make([]*AliasTransition, 0, len(transitionsByOutputID))
+	for _, transition := range transitionsByOutputID {
+		transition.Position = aliasTransitionPosition(transition, transitionsByOutputID)
+		transitions = append(transitions, transition)
+	}
+
+	sort.Slice(transitions, func(a, b int) bool {
+		if transitions[a].Position != transitions[b].Position {
+			return transitions[a].Position < transitions[b].Position
+		}
+
+		return transitions[a].OutputID.Base58() < transitions[b].OutputID.Base58()
+	})
+
+	return transitions, nil
+}
+
+// ExpiredFallbackOutputs returns the unspent (and not rejected) ExtendedLockedOutputs whose fallback deadline passed
+// and that can therefore be reclaimed by the given (fallback) address. The lookup is aborted with the error of the context if the
+// context is canceled.
+func (i *Indexer) ExpiredFallbackOutputs(ctx context.Context, address devnetvm.Address) (outputs []*devnetvm.ExtendedLockedOutput, err error) {
+	cachedAddressOutputMappings, err := i.CachedAddressOutputMappings(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	cachedAddressOutputMappings.Consume(func(mapping *AddressOutputMapping) {
+		i.ledgerFunc().Storage().CachedOutput(mapping.OutputID()).Consume(func(o utxo.Output) {
+			if output, isExtendedLockedOutput := o.(*devnetvm.ExtendedLockedOutput); isExtendedLockedOutput && fallbackExpired(output, address, now) && i.isUnspent(output.ID()) {
+				outputs = append(outputs, output)
+			}
+		})
+	})
+
+	return outputs, nil
+}
+
+// ScheduleFallbackExpirations schedules the FallbackOutputExpired events of the ExtendedLockedOutputs in the given
+// UnspentOutputs. It needs to be called when the Indexer is started, as the outputs of the snapshot and the outputs
+// that were created before a restart of the node are not passed to OnOutputCreated (the events of outputs whose
+// deadline already passed are triggered right away). The scan is aborted with the error of the context if the context
+// is canceled.
+func (i *Indexer) ScheduleFallbackExpirations(ctx context.Context, unspentOutputs ledger.UnspentOutputs) (err error) {
+	if _, err = unspentOutputs.ForEachUnspentOutput(ctx, func(output *mempool.OutputWithMetadata) bool {
+		i.scheduleFallbackExpiration(output.Output().(*devnetvm.ExtendedLockedOutput))
+
+		return true
+	}, ledger.WithConsistentView(), ledger.WithFilter(func(output *mempool.OutputWithMetadata) bool {
+		_, isExtendedLockedOutput := output.Output().(*devnetvm.ExtendedLockedOutput)
+		return isExtendedLockedOutput
+	})); err != nil {
+		return errors.Wrap(err, "failed to schedule the fallback expirations of the unspent outputs")
+	}
+
+	return nil
 }
 
 // Prune resets the database and deletes all entities.
 func (i *Indexer) Prune() (err error) {
 	for _, storagePrune := range []func() error{
 		i.addressOutputMappingStorage.Prune,
+		i.aliasChainMappingStorage.Prune,
+		i.unlockConditionMappingStorage.Prune,
 	} {
 		if err = storagePrune(); err != nil {
 			return errors.WithMessagef(cerrors.ErrFatal, "failed to prune the object storage: %s", err.Error())
@@ -86,44 +350,236 @@ func (i *Indexer) Prune() (err error) {
 
 // Shutdown shuts down the KVStore used to persist data.
 func (i *Indexer) Shutdown() {
+	i.fallbackSweeper.Shutdown(timed.CancelPendingElements)
 	i.addressOutputMappingStorage.Shutdown()
+	i.aliasChainMappingStorage.Shutdown()
+	i.unlockConditionMappingStorage.Shutdown()
 }
 
 // OnOutputCreated adds Transaction outputs to the indexer upon booking.
 func (i *Indexer) OnOutputCreated(outputID utxo.OutputID) {
 	i.ledgerFunc().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
 		i.IndexOutput(o.(devnetvm.Output))
+		i.Events.OutputIndexed.Trigger(newOutputEvent(o.(devnetvm.Output)))
+
+		switch output := o.(type) {
+		case *devnetvm.AliasOutput:
+			i.StoreAliasChainMapping(output)
+		case *devnetvm.ExtendedLockedOutput:
+			i.scheduleFallbackExpiration(output)
+		}
+	})
+}
+
+// OnOutputRejected removes rejected Transaction outputs from the indexer (including the chains of the aliases).
+func (i *Indexer) OnOutputRejected(outputID utxo.OutputID) {
+	i.OnOutputSpentRejected(outputID)
+
+	i.ledgerFunc().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
+		if aliasOutput, isAliasOutput := o.(*devnetvm.AliasOutput); isAliasOutput {
+			i.RemoveAliasChainMapping(aliasOutput)
+		}
 	})
 }
 
 // OnOutputSpentRejected removes Transaction inputs from the indexer upon transaction acceptance.
 func (i *Indexer) OnOutputSpentRejected(outputID utxo.OutputID) {
+	i.fallbackSweeper.Cancel(outputID)
+
 	i.ledgerFunc().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
 		i.updateOutput(o.(devnetvm.Output), i.RemoveAddressOutputMapping)
+
+		for _, mapping := range unlockConditionMappings(o.(devnetvm.Output)) {
+			i.unlockConditionMappingStorage.Delete(mapping.ObjectStorageKey())
+		}
+
+		i.Events.OutputRemoved.Trigger(newOutputEvent(o.(devnetvm.Output)))
 	})
 }
 
 // updateOutput applies the passed updateOperation to the provided output.
 func (i *Indexer) updateOutput(output devnetvm.Output, updateOperation func(address devnetvm.Address, outputID utxo.OutputID)) {
+	for _, address := range outputAddresses(output) {
+		updateOperation(address, output.ID())
+	}
+}
+
+// scheduleFallbackExpiration schedules the FallbackOutputExpired event of the given ExtendedLockedOutput (if it has
+// fallback options).
+func (i *Indexer) scheduleFallbackExpiration(output *devnetvm.ExtendedLockedOutput) {
+	fallbackAddress, fallbackDeadline := output.FallbackOptions()
+	if fallbackAddress == nil {
+		return
+	}
+
+	// the fallback address can only unlock the output after the deadline
+	expirationTime := fallbackDeadline.Add(time.Nanosecond)
+
+	outputID := output.ID()
+	i.fallbackSweeper.ExecuteAt(outputID, func() {
+		if i.isUnspent(outputID) {
+			i.Events.FallbackOutputExpired.Trigger(output)
+		}
+	}, expirationTime)
+}
+
+// isUnspent returns true if the Output with the given ID is neither spent nor rejected according to its OutputMetadata.
+func (i *Indexer) isUnspent(outputID utxo.OutputID) (unspent bool) {
+	i.ledgerFunc().Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		unspent = !outputMetadata.IsSpent() && !outputMetadata.ConfirmationState().IsRejected()
+	})
+
+	return unspent
+}
+
+// aliasTransition returns the AliasTransition that created the AliasOutput with the given ID.
+func (i *Indexer) aliasTransition(aliasAddress *devnetvm.AliasAddress, outputID utxo.OutputID) (transition *AliasTransition) {
+	i.ledgerFunc().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
+		aliasOutput, isAliasOutput := o.(*devnetvm.AliasOutput)
+		if !isAliasOutput {
+			return
+		}
+
+		transition = &AliasTransition{
+			OutputID:         outputID,
+			PreviousOutputID: i.previousAliasOutputID(aliasAddress, outputID.TransactionID),
+			Output:           aliasOutput,
+		}
+	})
+
+	if transition != nil {
+		i.ledgerFunc().Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			transition.ConfirmationState = outputMetadata.ConfirmationState()
+		})
+	}
+
+	return transition
+}
+
+// previousAliasOutputID returns the ID of the AliasOutput of the given chain that was consumed by the given Transaction
+// (or the EmptyOutputID if the Transaction created the origin of the chain).
+func (i *Indexer) previousAliasOutputID(aliasAddress *devnetvm.AliasAddress, transactionID utxo.TransactionID) (previousOutputID utxo.OutputID) {
+	i.ledgerFunc().Storage().CachedTransaction(transactionID).Consume(func(tx utxo.Transaction) {
+		for it := i.ledgerFunc().Utils().ResolveInputs(tx.Inputs()).Iterator(); it.HasNext(); {
+			inputID := it.Next()
+			i.ledgerFunc().Storage().CachedOutput(inputID).Consume(func(input utxo.Output) {
+				if aliasInput, isAliasOutput := input.(*devnetvm.AliasOutput); isAliasOutput && aliasInput.GetAliasAddress().Equals(aliasAddress) {
+					previousOutputID = inputID
+				}
+			})
+		}
+	})
+
+	return previousOutputID
+}
+
+// unlockConditionMappings returns the UnlockConditionMappings of the Addresses that can unlock the given Output.
+func unlockConditionMappings(output devnetvm.Output) (mappings []*UnlockConditionMapping) {
+	switch typedOutput := output.(type) {
+	case *devnetvm.AliasOutput:
+		// delegated aliases can only be transitioned after the delegation timelock
+		unlockableFrom := typedOutput.DelegationTimelock()
+
+		mappings = append(mappings, NewUnlockConditionMapping(typedOutput.GetStateAddress(), output.ID(), unlockableFrom, time.Time{}))
+		if !typedOutput.IsSelfGoverned() {
+			mappings = append(mappings, NewUnlockConditionMapping(typedOutput.GetGoverningAddress(), output.ID(), unlockableFrom, time.Time{}))
+		}
+	case *devnetvm.ExtendedLockedOutput:
+		fallbackAddress, fallbackDeadline := typedOutput.FallbackOptions()
+		if fallbackAddress == nil {
+			return append(mappings, NewUnlockConditionMapping(output.Address(), output.ID(), typedOutput.TimeLock(), time.Time{}))
+		}
+
+		// the fallback address can only unlock the output after the deadline
+		fallbackUnlockableFrom := fallbackDeadline.Add(time.Nanosecond)
+		if typedOutput.TimeLock().After(fallbackUnlockableFrom) {
+			fallbackUnlockableFrom = typedOutput.TimeLock()
+		}
+
+		mappings = append(mappings, NewUnlockConditionMapping(fallbackAddress, output.ID(), fallbackUnlockableFrom, time.Time{}))
+		if !typedOutput.TimeLock().After(fallbackDeadline) {
+			mappings = append(mappings, NewUnlockConditionMapping(output.Address(), output.ID(), typedOutput.TimeLock(), fallbackDeadline))
+		}
+	case *devnetvm.ThresholdSigOutput:
+		for _, address := range typedOutput.Addresses() {
+			mappings = append(mappings, NewUnlockConditionMapping(address, output.ID(), time.Time{}, time.Time{}))
+		}
+	default:
+		mappings = append(mappings, NewUnlockConditionMapping(output.Address(), output.ID(), time.Time{}, time.Time{}))
+	}
+
+	return mappings
+}
+
+// fallbackExpired returns true if the given ExtendedLockedOutput can be reclaimed by the given fallback address at the
+// given time.
+func fallbackExpired(output *devnetvm.ExtendedLockedOutput, fallbackAddress devnetvm.Address, now time.Time) bool {
+	outputFallbackAddress, fallbackDeadline := output.FallbackOptions()
+
+	return outputFallbackAddress != nil && outputFallbackAddress.Equals(fallbackAddress) && now.After(fallbackDeadline)
+}
+
+// aliasTransitionPosition returns the number of predecessors of the given AliasTransition.
+func aliasTransitionPosition(transition *AliasTransition, transitionsByOutputID map[utxo.OutputID]*AliasTransition) (position int) {
+	for previous, exists := transitionsByOutputID[transition.PreviousOutputID]; exists && position < len(transitionsByOutputID); previous, exists = transitionsByOutputID[previous.PreviousOutputID] {
+		position++
+	}
+
+	return position
+}
+
+// outputAddresses returns the addresses that the given output is indexed by.
+func outputAddresses(output devnetvm.Output) (addresses []devnetvm.Address) {
 	switch output.Type() {
 	case devnetvm.AliasOutputType:
 		castedOutput := output.(*devnetvm.AliasOutput)
 		// if it is an origin alias output, we don't have the AliasAddress from the parsed bytes.
 		// that happens in ledgerFunc output booking, so we calculate the alias address here
-		updateOperation(castedOutput.GetAliasAddress(), output.ID())
-		updateOperation(castedOutput.GetStateAddress(), output.ID())
+		addresses = append(addresses, castedOutput.GetAliasAddress(), castedOutput.GetStateAddress())
 		if !castedOutput.IsSelfGoverned() {
-			updateOperation(castedOutput.GetGoverningAddress(), output.ID())
+			addresses = append(addresses, castedOutput.GetGoverningAddress())
 		}
 	case devnetvm.ExtendedLockedOutputType:
 		castedOutput := output.(*devnetvm.ExtendedLockedOutput)
 		if castedOutput.FallbackAddress() != nil {
-			updateOperation(castedOutput.FallbackAddress(), output.ID())
+			addresses = append(addresses, castedOutput.FallbackAddress())
 		}
-		updateOperation(output.Address(), output.ID())
+		addresses = append(addresses, output.Address())
+	case devnetvm.ThresholdSigOutputType:
+		addresses = append(addresses, output.(*devnetvm.ThresholdSigOutput).Addresses()...)
 	default:
-		updateOperation(output.Address(), output.ID())
+		addresses = append(addresses, output.Address())
 	}
+
+	return addresses
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AliasTransition //////////////////////////////////////////////////////////////////////////////////////////////
+
+// AliasTransition represents a state or governance transition of the chain of an alias.
+type AliasTransition struct {
+	// OutputID contains the ID of the AliasOutput that was created by the transition.
+	OutputID utxo.OutputID
+
+	// PreviousOutputID contains the ID of the AliasOutput that was consumed by the transition (or the EmptyOutputID if
+	// the transition created the origin of the chain).
+	PreviousOutputID utxo.OutputID
+
+	// Position contains the position of the transition in the chain (the origin has position 0).
+	Position int
+
+	// Output contains the AliasOutput that was created by the transition.
+	Output *devnetvm.AliasOutput
+
+	// ConfirmationState contains the ConfirmationState of the AliasOutput.
+	ConfirmationState confirmation.State
+}
+
+// IsGovernanceUpdate returns true if the transition updated the governance instead of the state of the alias.
+func (a *AliasTransition) IsGovernanceUpdate() bool {
+	return a.Output.GetIsGovernanceUpdated()
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -133,6 +589,12 @@ func (i *Indexer) updateOutput(output devnetvm.Output, updateOperation func(addr
 const (
 	// PrefixAddressOutputMappingStorage defines the storage prefix for the AddressOutputMapping object storage.
 	PrefixAddressOutputMappingStorage byte = iota
+
+	// PrefixAliasChainMappingStorage defines the storage prefix for the AliasChainMapping object storage.
+	PrefixAliasChainMappingStorage
+
+	// PrefixUnlockConditionMappingStorage defines the storage prefix for the UnlockConditionMapping object storage.
+	PrefixUnlockConditionMappingStorage
 )
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,250 @@
+package indexer_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func TestIndexer_FallbackOutputExpired(t *testing.T) {
+	tf := newTestFramework(t)
+
+	expiredOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	pendingOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(time.Hour))
+	spentOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	tf.spend(spentOutputID)
+
+	tf.Instance.OnOutputCreated(expiredOutputID)
+	tf.Instance.OnOutputCreated(pendingOutputID)
+	tf.Instance.OnOutputCreated(spentOutputID)
+
+	require.Eventually(t, func() bool {
+		return tf.expiredOutputs.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the spent output does not trigger the event
+	time.Sleep(100 * time.Millisecond)
+	require.EqualValues(t, 1, tf.expiredOutputs.Load())
+	require.True(t, tf.expired(expiredOutputID))
+}
+
+func TestIndexer_ExpiredFallbackOutputs(t *testing.T) {
+	tf := newTestFramework(t)
+
+	expiredOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	pendingOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(time.Hour))
+	spentOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	rejectedOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	for _, outputID := range []utxo.OutputID{expiredOutputID, pendingOutputID, spentOutputID, rejectedOutputID} {
+		tf.Instance.IndexOutput(tf.output(outputID))
+	}
+
+	tf.spend(spentOutputID)
+	tf.reject(rejectedOutputID)
+
+	outputs, err := tf.Instance.ExpiredFallbackOutputs(context.Background(), tf.fallbackAddress)
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	require.Equal(t, expiredOutputID, outputs[0].ID())
+
+	// the outputs can only be reclaimed by their fallback address
+	outputs, err = tf.Instance.ExpiredFallbackOutputs(context.Background(), tf.address)
+	require.NoError(t, err)
+	require.Empty(t, outputs)
+}
+
+func TestIndexer_ScheduleFallbackExpirations(t *testing.T) {
+	tf := newTestFramework(t)
+
+	// the outputs are not passed to OnOutputCreated (e.g. because they were loaded from the snapshot)
+	expiredOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	spentOutputID := tf.createOutput(tf.fallbackAddress, time.Now().Add(-time.Second))
+	tf.spend(spentOutputID)
+
+	require.NoError(t, tf.Instance.ScheduleFallbackExpirations(context.Background(), &mockedUnspentOutputs{
+		outputs: []*mempool.OutputWithMetadata{
+			mempool.NewOutputWithMetadata(0, expiredOutputID, tf.output(expiredOutputID), identity.ID{}, identity.ID{}),
+			mempool.NewOutputWithMetadata(0, spentOutputID, tf.output(spentOutputID), identity.ID{}, identity.ID{}),
+			mempool.NewOutputWithMetadata(0, tf.createSigLockedOutput(), devnetvm.NewSigLockedSingleOutput(1, tf.address), identity.ID{}, identity.ID{}),
+		},
+	}))
+
+	require.Eventually(t, func() bool {
+		return tf.expired(expiredOutputID)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+	require.EqualValues(t, 1, tf.expiredOutputs.Load())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, tf.Instance.ScheduleFallbackExpirations(ctx, &mockedUnspentOutputs{}), context.Canceled)
+}
+
+// region testFramework ////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestIndexer_ForEachAddressOutputID(t *testing.T) {
+	tf := newTestFramework(t)
+
+	outputIDs := make([]utxo.OutputID, 0)
+	for i := 0; i < 10; i++ {
+		var transactionID utxo.TransactionID
+		require.NoError(t, transactionID.FromRandomness())
+
+		outputID := utxo.NewOutputID(transactionID, uint16(i))
+		tf.Instance.StoreAddressOutputMapping(tf.address, outputID)
+		outputIDs = append(outputIDs, outputID)
+	}
+	tf.Instance.StoreAddressOutputMapping(tf.fallbackAddress, tf.createSigLockedOutput())
+
+	sort.Slice(outputIDs, func(a, b int) bool {
+		return bytes.Compare(lo.PanicOnErr(outputIDs[a].Bytes()), lo.PanicOnErr(outputIDs[b].Bytes())) < 0
+	})
+
+	collect := func(cursor utxo.OutputID, limit int) (collectedOutputIDs []utxo.OutputID) {
+		require.NoError(t, tf.Instance.ForEachAddressOutputID(context.Background(), tf.address, cursor, func(outputID utxo.OutputID) bool {
+			collectedOutputIDs = append(collectedOutputIDs, outputID)
+			return len(collectedOutputIDs) < limit
+		}))
+
+		return collectedOutputIDs
+	}
+
+	require.Equal(t, outputIDs, collect(utxo.EmptyOutputID, len(outputIDs)+1))
+	require.Equal(t, outputIDs[:4], collect(utxo.EmptyOutputID, 4))
+	require.Equal(t, outputIDs[4:8], collect(outputIDs[3], 4))
+	require.Empty(t, collect(outputIDs[9], 4))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, tf.Instance.ForEachAddressOutputID(ctx, tf.address, utxo.EmptyOutputID, func(utxo.OutputID) bool {
+		return true
+	}), context.Canceled)
+}
+
+type testFramework struct {
+	Instance *indexer.Indexer
+
+	test            *testing.T
+	memPool         mempool.MemPool
+	address         devnetvm.Address
+	fallbackAddress devnetvm.Address
+	outputCount     uint16
+	expiredOutputs  atomic.Int32
+	expiredOutputID atomic.Value
+}
+
+func newTestFramework(t *testing.T) (tf *testFramework) {
+	workers := workerpool.NewGroup(t.Name())
+
+	tf = &testFramework{
+		test:            t,
+		memPool:         realitiesledger.NewTestLedger(t, workers.CreateGroup("Ledger"), realitiesledger.WithVM(devnetvm.NewVM())),
+		address:         devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey),
+		fallbackAddress: devnetvm.NewED25519Address(ed25519.GenerateKeyPair().PublicKey),
+	}
+
+	tf.Instance = indexer.New(func() mempool.MemPool { return tf.memPool })
+	t.Cleanup(tf.Instance.Shutdown)
+
+	tf.Instance.Events.FallbackOutputExpired.Hook(func(output *devnetvm.ExtendedLockedOutput) {
+		tf.expiredOutputID.Store(output.ID())
+		tf.expiredOutputs.Add(1)
+	})
+
+	return tf
+}
+
+// createOutput stores an ExtendedLockedOutput with the given fallback options (and its OutputMetadata) in the MemPool.
+func (t *testFramework) createOutput(fallbackAddress devnetvm.Address, fallbackDeadline time.Time) (outputID utxo.OutputID) {
+	return t.storeOutput(devnetvm.NewExtendedLockedOutput(map[devnetvm.Color]uint64{devnetvm.ColorIOTA: 1}, t.address).WithFallbackOptions(fallbackAddress, fallbackDeadline))
+}
+
+// createSigLockedOutput stores a SigLockedSingleOutput (and its OutputMetadata) in the MemPool.
+func (t *testFramework) createSigLockedOutput() (outputID utxo.OutputID) {
+	return t.storeOutput(devnetvm.NewSigLockedSingleOutput(1, t.address))
+}
+
+func (t *testFramework) storeOutput(output devnetvm.Output) (outputID utxo.OutputID) {
+	t.outputCount++
+	outputID = utxo.NewOutputID(utxo.TransactionID{}, t.outputCount)
+	output.SetID(outputID)
+
+	t.memPool.Storage().CachedOutput(outputID, func(utxo.OutputID) utxo.Output { return output }).Release()
+	t.memPool.Storage().CachedOutputMetadata(outputID, mempool.NewOutputMetadata).Release()
+
+	return outputID
+}
+
+func (t *testFramework) output(outputID utxo.OutputID) (output devnetvm.Output) {
+	t.memPool.Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
+		output = o.(devnetvm.Output)
+	})
+	require.NotNil(t.test, output)
+
+	return output
+}
+
+func (t *testFramework) spend(outputID utxo.OutputID) {
+	t.memPool.Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		outputMetadata.RegisterBookedConsumer(utxo.NewTransactionID([]byte("consumer")))
+	})
+}
+
+func (t *testFramework) reject(outputID utxo.OutputID) {
+	t.memPool.Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		outputMetadata.SetConfirmationState(confirmation.Rejected)
+	})
+}
+
+func (t *testFramework) expired(outputID utxo.OutputID) bool {
+	expiredOutputID, exists := t.expiredOutputID.Load().(utxo.OutputID)
+
+	return exists && expiredOutputID == outputID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region mockedUnspentOutputs /////////////////////////////////////////////////////////////////////////////////////////
+
+// mockedUnspentOutputs is an UnspentOutputs implementation that only supports the iteration over the given outputs.
+type mockedUnspentOutputs struct {
+	ledger.UnspentOutputs
+
+	outputs []*mempool.OutputWithMetadata
+}
+
+func (m *mockedUnspentOutputs) ForEachUnspentOutput(ctx context.Context, consumer func(output *mempool.OutputWithMetadata) bool, opts ...options.Option[ledger.IteratorOptions]) (cursor utxo.OutputID, err error) {
+	if err = ctx.Err(); err != nil {
+		return utxo.EmptyOutputID, err
+	}
+
+	iteratorOptions := ledger.NewIteratorOptions(opts...)
+	for _, output := range m.outputs {
+		if iteratorOptions.Matches(output) && !consumer(output) {
+			return output.Output().ID(), nil
+		}
+	}
+
+	return utxo.EmptyOutputID, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/hive.go/objectstorage/generic/model"
@@ -29,3 +31,91 @@ func (a *AddressOutputMapping) OutputID() utxo.OutputID {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AliasChainMapping ////////////////////////////////////////////////////////////////////////////////////////////
+
+// AliasChainMapping is a mapping from an AliasAddress to the OutputID of an AliasOutput of its chain. Contrary to the
+// AddressOutputMapping, it is kept after the AliasOutput was spent, so the history of the chain can be retrieved.
+type AliasChainMapping struct {
+	model.StorableReference[AliasChainMapping, *AliasChainMapping, devnetvm.Address, utxo.OutputID] `serix:"0"`
+}
+
+// NewAliasChainMapping creates a new AliasChainMapping.
+func NewAliasChainMapping(aliasAddress devnetvm.Address, outputID utxo.OutputID) *AliasChainMapping {
+	return model.NewStorableReference[AliasChainMapping](aliasAddress, outputID)
+}
+
+// AliasAddress returns the AliasAddress of the AliasChainMapping.
+func (a *AliasChainMapping) AliasAddress() devnetvm.Address {
+	return a.SourceID()
+}
+
+// OutputID returns the OutputID of the AliasChainMapping.
+func (a *AliasChainMapping) OutputID() utxo.OutputID {
+	return a.TargetID()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region UnlockConditionMapping ///////////////////////////////////////////////////////////////////////////////////////
+
+// UnlockConditionMapping is a mapping from an Address to an UnlockCondition of an unspent Output that the Address can
+// unlock. The UnlockCondition is part of the key, so the spendable Outputs of an Address can be determined without
+// loading the Outputs.
+type UnlockConditionMapping struct {
+	model.StorableReference[UnlockConditionMapping, *UnlockConditionMapping, devnetvm.Address, UnlockCondition] `serix:"0"`
+}
+
+// NewUnlockConditionMapping creates a new UnlockConditionMapping.
+func NewUnlockConditionMapping(address devnetvm.Address, outputID utxo.OutputID, unlockableFrom, unlockableUntil time.Time) *UnlockConditionMapping {
+	return model.NewStorableReference[UnlockConditionMapping](address, UnlockCondition{
+		OutputID:        outputID,
+		UnlockableFrom:  unixNano(unlockableFrom),
+		UnlockableUntil: unixNano(unlockableUntil),
+	})
+}
+
+// Address returns the Address of the UnlockConditionMapping.
+func (u *UnlockConditionMapping) Address() devnetvm.Address {
+	return u.SourceID()
+}
+
+// OutputID returns the OutputID of the UnlockConditionMapping.
+func (u *UnlockConditionMapping) OutputID() utxo.OutputID {
+	return u.TargetID().OutputID
+}
+
+// UnlockableAt returns true if the Address can unlock the Output at the given time.
+func (u *UnlockConditionMapping) UnlockableAt(t time.Time) bool {
+	return u.TargetID().UnlockableAt(t)
+}
+
+// UnlockCondition defines the time window in which an Address can unlock an Output.
+type UnlockCondition struct {
+	// OutputID contains the ID of the Output.
+	OutputID utxo.OutputID `serix:"0"`
+
+	// UnlockableFrom contains the earliest time (in unix nanoseconds) at which the Output can be unlocked (zero if it is
+	// unbounded).
+	UnlockableFrom int64 `serix:"1"`
+
+	// UnlockableUntil contains the latest time (in unix nanoseconds) at which the Output can be unlocked (zero if it is
+	// unbounded).
+	UnlockableUntil int64 `serix:"2"`
+}
+
+// UnlockableAt returns true if the Output can be unlocked at the given time.
+func (u UnlockCondition) UnlockableAt(t time.Time) bool {
+	return (u.UnlockableFrom == 0 || u.UnlockableFrom <= t.UnixNano()) && (u.UnlockableUntil == 0 || t.UnixNano() <= u.UnlockableUntil)
+}
+
+// unixNano returns the unix nanoseconds of the given time (zero for the zero time).
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
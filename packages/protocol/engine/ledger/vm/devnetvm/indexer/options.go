@@ -44,6 +44,30 @@ func WithAddressOutputMappingCacheTime(addressOutputMappingCacheTime time.Durati
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region WithAliasChainMappingCacheTime ///////////////////////////////////////////////////////////////////////////////
+
+// WithAliasChainMappingCacheTime is an Option for the Ledger that allows to configure how long AliasChainMapping
+// objects stay cached after they have been released.
+func WithAliasChainMappingCacheTime(aliasChainMappingCacheTime time.Duration) (option Option) {
+	return func(options *options) {
+		options.aliasChainMappingCacheTime = aliasChainMappingCacheTime
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region WithUnlockConditionMappingCacheTime //////////////////////////////////////////////////////////////////////////
+
+// WithUnlockConditionMappingCacheTime is an Option for the Ledger that allows to configure how long
+// UnlockConditionMapping objects stay cached after they have been released.
+func WithUnlockConditionMappingCacheTime(unlockConditionMappingCacheTime time.Duration) (option Option) {
+	return func(options *options) {
+		options.unlockConditionMappingCacheTime = unlockConditionMappingCacheTime
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region options //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // options is a container for all configurable parameters of the Indexer.
@@ -57,15 +81,25 @@ type options struct {
 	// addressOutputMappingCacheTime contains the duration that AddressOutputMapping objects stay cached after they have
 	// been released.
 	addressOutputMappingCacheTime time.Duration
+
+	// aliasChainMappingCacheTime contains the duration that AliasChainMapping objects stay cached after they have been
+	// released.
+	aliasChainMappingCacheTime time.Duration
+
+	// unlockConditionMappingCacheTime contains the duration that UnlockConditionMapping objects stay cached after they
+	// have been released.
+	unlockConditionMappingCacheTime time.Duration
 }
 
 // newOptions returns a new options object that corresponds to the handed in options and which is derived from the
 // default options.
 func newOptions(option ...Option) *options {
 	return (&options{
-		store:                         mapdb.NewMapDB(),
-		cacheTimeProvider:             database.NewCacheTimeProvider(0),
-		addressOutputMappingCacheTime: 10 * time.Second,
+		store:                           mapdb.NewMapDB(),
+		cacheTimeProvider:               database.NewCacheTimeProvider(0),
+		addressOutputMappingCacheTime:   10 * time.Second,
+		aliasChainMappingCacheTime:      10 * time.Second,
+		unlockConditionMappingCacheTime: 10 * time.Second,
 	}).apply(option...)
 }
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/cerrors"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/hive.go/ds/bitmask"
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/lo"
@@ -26,29 +27,18 @@ import (
 )
 
 func init() {
-	err := serix.DefaultAPI.RegisterTypeSettings(SigLockedSingleOutput{}, serix.TypeSettings{}.WithObjectType(uint8(new(SigLockedSingleOutput).Type())))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering SigLockedSingleOutput type settings"))
-	}
-	err = serix.DefaultAPI.RegisterTypeSettings(SigLockedColoredOutput{}, serix.TypeSettings{}.WithObjectType(uint8(new(SigLockedColoredOutput).Type())))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering SigLockedColoredOutput type settings"))
-	}
-	err = serix.DefaultAPI.RegisterTypeSettings(AliasOutput{}, serix.TypeSettings{}.WithObjectType(uint8(new(AliasOutput).Type())))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering AliasOutput type settings"))
-	}
-	err = serix.DefaultAPI.RegisterTypeSettings(ExtendedLockedOutput{}, serix.TypeSettings{}.WithObjectType(uint8(new(ExtendedLockedOutput).Type())))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering ExtendedLockedOutput type settings"))
-	}
-	err = serix.DefaultAPI.RegisterInterfaceObjects((*Output)(nil), new(SigLockedSingleOutput), new(SigLockedColoredOutput), new(AliasOutput), new(ExtendedLockedOutput))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering Output interface implementations"))
-	}
-	err = serix.DefaultAPI.RegisterInterfaceObjects((*utxo.Output)(nil), new(SigLockedSingleOutput), new(SigLockedColoredOutput), new(AliasOutput), new(ExtendedLockedOutput))
-	if err != nil {
-		panic(errors.Wrap(err, "error registering utxo.Output interface implementations"))
+	for _, err := range []error{
+		RegisterOutputType(SigLockedSingleOutputType, "SigLockedSingleOutputType", func() Output { return new(SigLockedSingleOutput) }),
+		RegisterOutputType(SigLockedColoredOutputType, "SigLockedColoredOutputType", func() Output { return new(SigLockedColoredOutput) }),
+		RegisterOutputType(AliasOutputType, "AliasOutputType", func() Output { return new(AliasOutput) }),
+		RegisterOutputType(ExtendedLockedOutputType, "ExtendedLockedOutputType", func() Output { return new(ExtendedLockedOutput) }),
+		RegisterOutputType(ThresholdSigOutputType, "ThresholdSigOutputType", func() Output { return new(ThresholdSigOutput) }, WithSyntacticValidator(validateThresholdSigOutput)),
+		RegisterOutputType(NFTOutputType, "NFTOutputType", func() Output { return new(NFTOutput) }, WithSyntacticValidator(validateNFTOutput)),
+		RegisterOutputType(VestingOutputType, "VestingOutputType", func() Output { return new(VestingOutput) }, WithSyntacticValidator(validateVestingOutput)),
+	} {
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// err = serix.DefaultAPI.RegisterValidators(OutputID{}, validateOutputIDBytes, validateOutputID)
@@ -574,8 +564,8 @@ func (s *SigLockedColoredOutput) Bytes() (bytes []byte, err error) {
 
 // region AliasOutput ///////////////////////////////////////////////////////////////////////////////////////
 
-// DustThresholdAliasOutputIOTA is minimum number of iotas enforced for the output to be correct
-// TODO protocol-wide dust threshold configuration.
+// DustThresholdAliasOutputIOTA is minimum number of iotas enforced for the output to be correct (the protocol-wide
+// minimum deposit is defined by the DustPolicy of the VM).
 const DustThresholdAliasOutputIOTA = uint64(100)
 
 // MaxOutputPayloadSize size limit on the data payload in the output.
@@ -1198,7 +1188,7 @@ func (a *AliasOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inpu
 				return false, errors.New("signature is invalid for chain output deletion")
 			}
 			// validate deletion constraint
-			if err := a.validateDestroyTransitionNow(tx.Essence().Timestamp()); err != nil {
+			if err := a.validateDestroyTransition(tx); err != nil {
 				return false, err
 			}
 		}
@@ -1232,7 +1222,7 @@ func (a *AliasOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inpu
 				return false, errors.Wrap(err, "referenced alias does not unlock alias for governance transition")
 			}
 			// validate deletion constraint
-			if err := a.validateDestroyTransitionNow(tx.Essence().Timestamp()); err != nil {
+			if err := a.validateDestroyTransition(tx); err != nil {
 				return false, err
 			}
 		}
@@ -1379,6 +1369,19 @@ func equalColoredBalances(b1, b2 *ColoredBalances) bool {
 	return true
 }
 
+// balancesCovered returns true if the given balances hold at least the required balances of every color.
+func balancesCovered(balances, required *ColoredBalances) (covered bool) {
+	covered = true
+	required.ForEach(func(color Color, requiredBalance uint64) bool {
+		balance, _ := balances.Get(color)
+		covered = balance >= requiredBalance
+
+		return covered
+	})
+
+	return covered
+}
+
 // IsAboveDustThreshold internal utility to check if balances pass dust constraint.
 func IsAboveDustThreshold(m map[Color]uint64) bool {
 	if iotas, ok := m[ColorIOTA]; ok && iotas >= DustThresholdAliasOutputIOTA {
@@ -1427,7 +1430,7 @@ func (a *AliasOutput) validateTransition(chained *AliasOutput, tx *Transaction)
 		// if delegation timelock is set and active, governance transition is invalid
 		// It means delegating party can't take funds back before timelock deadline
 		if a.IsDelegated() && a.DelegationTimeLockedNow(tx.Essence().Timestamp()) {
-			return errors.Errorf("aliasOutput: governance transition not allowed until %s, transaction timestamp is: %s",
+			return errors.WithMessagef(vm.ErrTimelocked, "aliasOutput: governance transition not allowed until %s, transaction timestamp is: %s",
 				a.delegationTimelock.String(), tx.Essence().Timestamp().String())
 		}
 		// can modify state address
@@ -1479,6 +1482,44 @@ func (a *AliasOutput) validateTransition(chained *AliasOutput, tx *Transaction)
 	return nil
 }
 
+// validateDestroyTransition checks the validity of the given Transaction that destroys the alias (does not chain it).
+// A non-delegated alias that holds more than the dust minimum can be destroyed by its governing entity if the
+// Transaction routes the remaining balances to a recovery output.
+func (a *AliasOutput) validateDestroyTransition(tx *Transaction) error {
+	if a.IsDelegated() || IsExactDustMinimum(a.balances) {
+		return a.validateDestroyTransitionNow(tx.Essence().Timestamp())
+	}
+
+	if _, exists := a.recoveryOutput(tx); !exists {
+		return errors.New("aliasOutput: destroyed alias with more tokens than the dust minimum must route its balances to a single recovery output")
+	}
+
+	return nil
+}
+
+// recoveryOutput returns the output of the given Transaction that receives the balances of the destroyed alias. It has
+// to be a non-alias output that holds at least the balances of the alias and that is not locked to the address of the
+// destroyed alias (which can never be unlocked again).
+func (a *AliasOutput) recoveryOutput(tx *Transaction) (recoveryOutput Output, exists bool) {
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == AliasOutputType || output.Address().Equals(a.GetAliasAddress()) {
+			continue
+		}
+
+		if extendedLockedOutput, isExtendedLockedOutput := output.(*ExtendedLockedOutput); isExtendedLockedOutput {
+			if fallbackAddress, _ := extendedLockedOutput.FallbackOptions(); fallbackAddress != nil && fallbackAddress.Equals(a.GetAliasAddress()) {
+				continue
+			}
+		}
+
+		if balancesCovered(output.Balances(), a.balances) {
+			return output, true
+		}
+	}
+
+	return nil, false
+}
+
 // validateDestroyTransitionNow check validity if input is not chained (destroyed).
 func (a *AliasOutput) validateDestroyTransitionNow(nowis time.Time) error {
 	if !a.IsDelegated() && !IsExactDustMinimum(a.balances) {
@@ -1486,7 +1527,7 @@ func (a *AliasOutput) validateDestroyTransitionNow(nowis time.Time) error {
 		return errors.New("aliasOutput: didn't find chained output and there are more tokens then upper limit for non-delegated alias destruction")
 	}
 	if a.IsDelegated() && a.DelegationTimeLockedNow(nowis) {
-		return errors.New("aliasOutput: didn't find expected chained output for delegated output")
+		return errors.WithMessage(vm.ErrTimelocked, "aliasOutput: didn't find expected chained output for delegated output")
 	}
 	return nil
 }
@@ -1958,3 +1999,806 @@ func (o *ExtendedLockedOutput) UnlockAddressNow(nowis time.Time) Address {
 var _ Output = new(ExtendedLockedOutput)
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdSigOutput ///////////////////////////////////////////////////////////////////////////////////////////
+
+// MaxThresholdSigAddresses defines the maximum amount of eligible signers of a ThresholdSigOutput.
+const MaxThresholdSigAddresses = 16
+
+// ThresholdSigOutput is an Output that holds colored balances and that can only be unlocked by providing valid
+// signatures of at least Threshold distinct Addresses out of its set of eligible signers (m-of-n multisig).
+type ThresholdSigOutput struct {
+	storableModel.Storable[utxo.OutputID, ThresholdSigOutput, *ThresholdSigOutput, thresholdSigOutput] `serix:"0"`
+}
+
+type thresholdSigOutput struct {
+	Balances  *ColoredBalances `serix:"0"`
+	Threshold uint8            `serix:"1"`
+	Addresses []Address        `serix:"2,lengthPrefixType=uint8"`
+}
+
+// NewThresholdSigOutput is the constructor for a ThresholdSigOutput. It returns an error if the threshold can not be
+// reached by the given Addresses or if the Addresses can not be unlocked by a signature.
+func NewThresholdSigOutput(balances *ColoredBalances, threshold uint8, addresses ...Address) (*ThresholdSigOutput, error) {
+	clonedAddresses := make([]Address, len(addresses))
+	for i, address := range addresses {
+		clonedAddresses[i] = address.Clone()
+	}
+
+	m := &thresholdSigOutput{
+		Balances:  balances,
+		Threshold: threshold,
+		Addresses: clonedAddresses,
+	}
+	if err := validateThresholdSigOutput(context.Background(), *m); err != nil {
+		return nil, err
+	}
+
+	return storableModel.NewStorable[utxo.OutputID, ThresholdSigOutput](m), nil
+}
+
+// validateThresholdSigOutput checks the syntactical validity of the threshold and the set of eligible signers.
+func validateThresholdSigOutput(_ context.Context, m thresholdSigOutput) (err error) {
+	if len(m.Addresses) == 0 || len(m.Addresses) > MaxThresholdSigAddresses {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "ThresholdSigOutput: amount of addresses (%d) must be between 1 and %d", len(m.Addresses), MaxThresholdSigAddresses)
+	}
+	if m.Threshold == 0 || int(m.Threshold) > len(m.Addresses) {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "ThresholdSigOutput: threshold (%d) must be between 1 and the amount of addresses (%d)", m.Threshold, len(m.Addresses))
+	}
+	for i, address := range m.Addresses {
+		if address.Type() == AliasAddressType {
+			return errors.WithMessagef(cerrors.ErrParseBytesFailed, "ThresholdSigOutput: address %d is an %s and can't be unlocked by a signature", i, address.Type())
+		}
+		for _, otherAddress := range m.Addresses[:i] {
+			if address.Equals(otherAddress) {
+				return errors.WithMessagef(cerrors.ErrParseBytesFailed, "ThresholdSigOutput: duplicate address %s", address.Base58())
+			}
+		}
+	}
+
+	return nil
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (t *ThresholdSigOutput) Type() OutputType {
+	return ThresholdSigOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (t *ThresholdSigOutput) Balances() *ColoredBalances {
+	return t.M.Balances
+}
+
+// Threshold returns the amount of distinct signatures that are required to unlock the Output.
+func (t *ThresholdSigOutput) Threshold() uint8 {
+	return t.M.Threshold
+}
+
+// Addresses returns the eligible signers of the Output.
+func (t *ThresholdSigOutput) Addresses() []Address {
+	return t.M.Addresses
+}
+
+// Address returns the first eligible signer of the Output. Use Addresses to retrieve all of them.
+func (t *ThresholdSigOutput) Address() Address {
+	return t.M.Addresses[0]
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// Every signature in the UnlockBlock has to belong to a different eligible signer and at least Threshold of them have
+// to be present.
+func (t *ThresholdSigOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, _ []Output) (unlockValid bool, err error) {
+	blk, isThresholdUnlockBlock := unlockBlock.(*ThresholdSignatureUnlockBlock)
+	if !isThresholdUnlockBlock {
+		return false, errors.WithMessage(cerrors.ErrParseBytesFailed, "ThresholdSigOutput: unsupported unlock block type")
+	}
+
+	txBytes, bytesErr := tx.Essence().Bytes()
+	if bytesErr != nil {
+		return false, errors.Wrap(bytesErr, "could not get essence bytes")
+	}
+
+	signed := make([]bool, len(t.M.Addresses))
+	signedCount := 0
+	for j, signature := range blk.Signatures() {
+		matched := false
+		for i, address := range t.M.Addresses {
+			if !signed[i] && signature.AddressSignatureValid(address, txBytes) {
+				signed[i] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, errors.Errorf("ThresholdSigOutput: signature %d does not belong to a remaining eligible signer", j)
+		}
+		signedCount++
+	}
+
+	return signedCount >= int(t.M.Threshold), nil
+}
+
+// Input returns an Input that references the Output.
+func (t *ThresholdSigOutput) Input() Input {
+	if t.ID() == (utxo.OutputID{}) {
+		panic("ThresholdSigOutput: Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(t.ID())
+}
+
+// Clone creates a copy of the Output.
+func (t *ThresholdSigOutput) Clone() Output {
+	cloned := lo.PanicOnErr(NewThresholdSigOutput(t.M.Balances.Clone(), t.M.Threshold, t.M.Addresses...))
+	cloned.SetID(t.ID())
+	return cloned
+}
+
+// UpdateMintingColor replaces the ColorMint in the balances of the Output with the hash of the OutputID. It returns a
+// copy of the original Output with the modified balances.
+func (t *ThresholdSigOutput) UpdateMintingColor() Output {
+	coloredBalances := t.Balances().Map()
+	if mintedCoins, mintedCoinsExist := coloredBalances[ColorMint]; mintedCoinsExist {
+		delete(coloredBalances, ColorMint)
+		coloredBalances[Color(blake2b.Sum256(lo.PanicOnErr(t.ID().Bytes())))] = mintedCoins
+	}
+	updatedOutput := lo.PanicOnErr(NewThresholdSigOutput(NewColoredBalances(coloredBalances), t.M.Threshold, t.M.Addresses...))
+	updatedOutput.SetID(t.ID())
+
+	return updatedOutput
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (t *ThresholdSigOutput) Compare(other Output) int {
+	return bytes.Compare(lo.PanicOnErr(t.Bytes()), lo.PanicOnErr(other.Bytes()))
+}
+
+func (t *ThresholdSigOutput) FromBytes(bytes []byte) (err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	_, err = serix.DefaultAPI.Decode(context.Background(), bytes, t, serix.WithValidation())
+	return
+}
+
+func (t *ThresholdSigOutput) FromObjectStorage(key, data []byte) (err error) {
+	if err = t.IDFromBytes(key); err != nil {
+		return errors.Wrap(err, "failed to decode ID")
+	}
+
+	if err = t.FromBytes(data); err != nil {
+		return errors.Wrap(err, "failed to decode Model")
+	}
+
+	return nil
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (t *ThresholdSigOutput) ObjectStorageValue() (value []byte) {
+	return lo.PanicOnErr(t.Bytes())
+}
+
+func (t *ThresholdSigOutput) Bytes() (bytes []byte, err error) {
+	t.RLock()
+	defer t.RUnlock()
+
+	return serix.DefaultAPI.Encode(context.Background(), t, serix.WithValidation())
+}
+
+// code contract (make sure the type implements all required methods).
+var _ Output = new(ThresholdSigOutput)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NFTOutput ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NFTOutput is an Output that holds a single indivisible token next to a deposit of IOTA. Like an AliasOutput it forms
+// a chain: the token is minted with ColorMint, its color becomes the NFTID of the chain and every transfer has to
+// consume the previous NFTOutput and create exactly one new NFTOutput with the same token. The minting transaction has to
+// consume an input of the issuer, so that only the issuer can mint tokens in its name. Issuer, metadata and royalty
+// settings are fixed when minting and can't be changed by subsequent transfers. If a royalty address is set, every
+// transfer to a new owner has to pay the royalty amount to it.
+type NFTOutput struct {
+	storableModel.Storable[utxo.OutputID, NFTOutput, *NFTOutput, nftOutput] `serix:"0"`
+}
+
+type nftOutput struct {
+	Balances       *ColoredBalances `serix:"0"`
+	Owner          Address          `serix:"1"`
+	Issuer         Address          `serix:"2"`
+	Metadata       []byte           `serix:"3,lengthPrefixType=uint16"`
+	RoyaltyAddress Address          `serix:"4,optional"`
+	RoyaltyAmount  uint64           `serix:"5"`
+}
+
+// NewNFTOutput is the constructor for an NFTOutput. The royaltyAddress can be nil if no royalty should be paid on
+// transfers.
+func NewNFTOutput(balances *ColoredBalances, owner, issuer Address, metadata []byte, royaltyAddress Address, royaltyAmount uint64) (*NFTOutput, error) {
+	return newNFTOutput(&nftOutput{
+		Balances:       balances,
+		Owner:          owner,
+		Issuer:         issuer,
+		Metadata:       metadata,
+		RoyaltyAddress: royaltyAddress,
+		RoyaltyAmount:  royaltyAmount,
+	})
+}
+
+// NewNFTOutputMint creates a new NFTOutput that mints a fresh token for the given owner. The deposit has to be at least
+// DustThresholdAliasOutputIOTA.
+func NewNFTOutputMint(deposit uint64, owner, issuer Address, metadata []byte) (*NFTOutput, error) {
+	return NewNFTOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: deposit, ColorMint: 1}), owner, issuer, metadata, nil, 0)
+}
+
+// WithRoyalty returns a copy of the minting NFTOutput that requires every transfer to a new owner to pay the given
+// amount of IOTA to the royalty address.
+func (n *NFTOutput) WithRoyalty(royaltyAddress Address, royaltyAmount uint64) (*NFTOutput, error) {
+	if !n.IsMint() {
+		return nil, errors.New("NFTOutput: royalty can only be set when minting")
+	}
+
+	m := n.clonedModel()
+	m.RoyaltyAddress = royaltyAddress
+	m.RoyaltyAmount = royaltyAmount
+
+	return newNFTOutput(m)
+}
+
+// NewNFTOutputNext creates the NFTOutput that transfers the token to the given owner. The immutable fields and the
+// balances are carried over from the current NFTOutput.
+func (n *NFTOutput) NewNFTOutputNext(owner Address) *NFTOutput {
+	m := n.clonedModel()
+	m.Owner = owner
+
+	return lo.PanicOnErr(newNFTOutput(m))
+}
+
+// newNFTOutput creates a new NFTOutput from the given model after checking its syntactical validity.
+func newNFTOutput(m *nftOutput) (*NFTOutput, error) {
+	if err := validateNFTOutput(context.Background(), *m); err != nil {
+		return nil, err
+	}
+
+	return storableModel.NewStorable[utxo.OutputID, NFTOutput](m), nil
+}
+
+// validateNFTOutput checks that the NFTOutput holds a single token, enough deposit and valid immutable fields.
+func validateNFTOutput(_ context.Context, m nftOutput) (err error) {
+	if m.Balances == nil || m.Owner == nil || m.Issuer == nil {
+		return errors.WithMessage(cerrors.ErrParseBytesFailed, "NFTOutput: balances, owner and issuer are mandatory")
+	}
+	if m.Balances.Size() != 2 {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "NFTOutput: expected an IOTA deposit and a single token, got %d colors", m.Balances.Size())
+	}
+	if !IsAboveDustThreshold(m.Balances.Map()) {
+		return errors.WithMessage(cerrors.ErrParseBytesFailed, "NFTOutput: deposit is below dust threshold")
+	}
+
+	var tokenValid bool
+	m.Balances.ForEach(func(color Color, balance uint64) bool {
+		if color != ColorIOTA {
+			tokenValid = balance == 1
+		}
+		return true
+	})
+	if !tokenValid {
+		return errors.WithMessage(cerrors.ErrParseBytesFailed, "NFTOutput: token is not indivisible")
+	}
+
+	if len(m.Metadata) > MaxOutputPayloadSize {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "NFTOutput: metadata size (%d bytes) is bigger than maximum allowed (%d bytes)", len(m.Metadata), MaxOutputPayloadSize)
+	}
+	if (m.RoyaltyAddress == nil) != (m.RoyaltyAmount == 0) {
+		return errors.WithMessage(cerrors.ErrParseBytesFailed, "NFTOutput: royalty address and royalty amount have to be set together")
+	}
+
+	return nil
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (n *NFTOutput) Type() OutputType {
+	return NFTOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (n *NFTOutput) Balances() *ColoredBalances {
+	return n.M.Balances
+}
+
+// Address returns the current owner of the NFT.
+func (n *NFTOutput) Address() Address {
+	return n.M.Owner
+}
+
+// Issuer returns the immutable issuer of the NFT.
+func (n *NFTOutput) Issuer() Address {
+	return n.M.Issuer
+}
+
+// Metadata returns the immutable metadata of the NFT.
+func (n *NFTOutput) Metadata() []byte {
+	return n.M.Metadata
+}
+
+// Royalty returns the address that has to be paid on every transfer and the amount of IOTA it has to receive. The
+// address is nil if no royalty is set.
+func (n *NFTOutput) Royalty() (royaltyAddress Address, royaltyAmount uint64) {
+	return n.M.RoyaltyAddress, n.M.RoyaltyAmount
+}
+
+// NFTID returns the color of the token held by the Output which identifies the NFT. It is ColorMint for an NFTOutput
+// that mints a new token.
+func (n *NFTOutput) NFTID() (nftID Color) {
+	n.M.Balances.ForEach(func(color Color, _ uint64) bool {
+		if color != ColorIOTA {
+			nftID = color
+			return false
+		}
+		return true
+	})
+
+	return nftID
+}
+
+// IsMint returns true if the Output mints a new token.
+func (n *NFTOutput) IsMint() bool {
+	return n.NFTID() == ColorMint
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// Besides the ownership, it checks that the token is either transferred to exactly one new NFTOutput with unchanged
+// immutable fields (paying the royalty if the owner changes) or burned.
+func (n *NFTOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
+	switch blk := unlockBlock.(type) {
+	case *SignatureUnlockBlock:
+		txBytes, bytesErr := tx.Essence().Bytes()
+		if bytesErr != nil {
+			return false, errors.Wrap(bytesErr, "could not get essence bytes")
+		}
+		if !blk.AddressSignatureValid(n.M.Owner, txBytes) {
+			return false, nil
+		}
+
+	case *AliasUnlockBlock:
+		if n.M.Owner.Type() != AliasAddressType {
+			return false, errors.Errorf("NFTOutput: %s address can't be unlocked by alias reference", n.M.Owner.Type().String())
+		}
+		refAliasOutput, isAlias := inputs[blk.AliasInputIndex()].(*AliasOutput)
+		if !isAlias {
+			return false, errors.New("NFTOutput: referenced input must be AliasOutput")
+		}
+		if !n.M.Owner.Equals(refAliasOutput.GetAliasAddress()) {
+			return false, errors.New("NFTOutput: wrong alias referenced")
+		}
+		if refAliasOutput.hasToBeUnlockedForGovernanceUpdate(tx) {
+			return false, nil
+		}
+
+	default:
+		return false, errors.WithMessage(cerrors.ErrParseBytesFailed, "NFTOutput: unsupported unlock block type")
+	}
+
+	if err = n.validateTransition(tx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateTransition checks that the token of the consumed NFTOutput is either transferred or burned by the Transaction.
+func (n *NFTOutput) validateTransition(tx *Transaction) error {
+	nftID := n.NFTID()
+
+	var chained *NFTOutput
+	for _, output := range tx.Essence().Outputs() {
+		if _, holdsToken := output.Balances().Get(nftID); !holdsToken {
+			continue
+		}
+
+		nftOutput, isNFTOutput := output.(*NFTOutput)
+		if !isNFTOutput {
+			return errors.Errorf("NFTOutput: token %s can only be held by an NFTOutput", nftID)
+		}
+		if chained != nil {
+			return errors.Errorf("NFTOutput: duplicated NFTOutput for token %s", nftID)
+		}
+		chained = nftOutput
+	}
+
+	// the token is burned
+	if chained == nil {
+		return nil
+	}
+
+	if !n.M.Issuer.Equals(chained.M.Issuer) || !bytes.Equal(n.M.Metadata, chained.M.Metadata) || n.M.RoyaltyAmount != chained.M.RoyaltyAmount {
+		return errors.New("NFTOutput: immutable fields can't be changed")
+	}
+	if (n.M.RoyaltyAddress == nil) != (chained.M.RoyaltyAddress == nil) || (n.M.RoyaltyAddress != nil && !n.M.RoyaltyAddress.Equals(chained.M.RoyaltyAddress)) {
+		return errors.New("NFTOutput: royalty address can't be changed")
+	}
+
+	if n.M.RoyaltyAddress == nil || n.M.Owner.Equals(chained.M.Owner) {
+		return nil
+	}
+
+	var royaltyPaid uint64
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == NFTOutputType || !output.Address().Equals(n.M.RoyaltyAddress) {
+			continue
+		}
+		if iotas, exists := output.Balances().Get(ColorIOTA); exists {
+			royaltyPaid += iotas
+		}
+	}
+	if royaltyPaid < n.M.RoyaltyAmount {
+		return errors.Errorf("NFTOutput: royalty of %d IOTA not paid to %s", n.M.RoyaltyAmount, n.M.RoyaltyAddress.Base58())
+	}
+
+	return nil
+}
+
+// Input returns an Input that references the Output.
+func (n *NFTOutput) Input() Input {
+	if n.ID() == (utxo.OutputID{}) {
+		panic("NFTOutput: Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(n.ID())
+}
+
+// Clone creates a copy of the Output.
+func (n *NFTOutput) Clone() Output {
+	cloned := lo.PanicOnErr(newNFTOutput(n.clonedModel()))
+	cloned.SetID(n.ID())
+	return cloned
+}
+
+// UpdateMintingColor replaces the ColorMint of a freshly minted token with the hash of the OutputID, which becomes the
+// NFTID of the chain.
+func (n *NFTOutput) UpdateMintingColor() Output {
+	if !n.IsMint() {
+		return n
+	}
+
+	m := n.clonedModel()
+	m.Balances = NewColoredBalances(map[Color]uint64{
+		ColorIOTA: lo.Return1(n.M.Balances.Get(ColorIOTA)),
+		Color(blake2b.Sum256(lo.PanicOnErr(n.ID().Bytes()))): 1,
+	})
+	updatedOutput := lo.PanicOnErr(newNFTOutput(m))
+	updatedOutput.SetID(n.ID())
+
+	return updatedOutput
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (n *NFTOutput) Compare(other Output) int {
+	return bytes.Compare(lo.PanicOnErr(n.Bytes()), lo.PanicOnErr(other.Bytes()))
+}
+
+func (n *NFTOutput) FromBytes(bytes []byte) (err error) {
+	n.Lock()
+	defer n.Unlock()
+
+	_, err = serix.DefaultAPI.Decode(context.Background(), bytes, n, serix.WithValidation())
+	return
+}
+
+func (n *NFTOutput) FromObjectStorage(key, data []byte) (err error) {
+	if err = n.IDFromBytes(key); err != nil {
+		return errors.Wrap(err, "failed to decode ID")
+	}
+
+	if err = n.FromBytes(data); err != nil {
+		return errors.Wrap(err, "failed to decode Model")
+	}
+
+	return nil
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (n *NFTOutput) ObjectStorageValue() (value []byte) {
+	return lo.PanicOnErr(n.Bytes())
+}
+
+func (n *NFTOutput) Bytes() (bytes []byte, err error) {
+	n.RLock()
+	defer n.RUnlock()
+
+	return serix.DefaultAPI.Encode(context.Background(), n, serix.WithValidation())
+}
+
+// clonedModel returns a deep copy of the model of the Output.
+func (n *NFTOutput) clonedModel() *nftOutput {
+	m := &nftOutput{
+		Balances:      n.M.Balances.Clone(),
+		Owner:         n.M.Owner.Clone(),
+		Issuer:        n.M.Issuer.Clone(),
+		Metadata:      lo.CopySlice(n.M.Metadata),
+		RoyaltyAmount: n.M.RoyaltyAmount,
+	}
+	if n.M.RoyaltyAddress != nil {
+		m.RoyaltyAddress = n.M.RoyaltyAddress.Clone()
+	}
+
+	return m
+}
+
+// code contract (make sure the type implements all required methods).
+var _ Output = new(NFTOutput)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VestingOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MaxVestingTranches defines the maximum amount of tranches in the schedule of a VestingOutput.
+const MaxVestingTranches = 32
+
+// VestingOutput is an Output whose IOTA balance unlocks in tranches according to an embedded vesting schedule. The
+// amount of a tranche stays locked until its time is reached: a Transaction that spends the Output has to lock at least
+// the amounts that are still vesting in VestingOutputs of the same address (with tranches that don't unlock earlier).
+// The IOTA balance that exceeds the sum of the tranches and the other colors are not vesting.
+type VestingOutput struct {
+	storableModel.Storable[utxo.OutputID, VestingOutput, *VestingOutput, vestingOutput] `serix:"0"`
+}
+
+type vestingOutput struct {
+	Balances *ColoredBalances `serix:"0"`
+	Address  Address          `serix:"1"`
+	Schedule []VestingTranche `serix:"2,lengthPrefixType=uint8"`
+}
+
+// VestingTranche is an amount of IOTA that unlocks at the given time.
+type VestingTranche struct {
+	Time   time.Time `serix:"0"`
+	Amount uint64    `serix:"1"`
+}
+
+// NewVestingOutput is the constructor for a VestingOutput. The tranches of the schedule have to be ordered by time.
+func NewVestingOutput(balances *ColoredBalances, address Address, schedule ...VestingTranche) (*VestingOutput, error) {
+	m := &vestingOutput{
+		Balances: balances,
+		Address:  address,
+		Schedule: lo.CopySlice(schedule),
+	}
+	if err := validateVestingOutput(context.Background(), *m); err != nil {
+		return nil, err
+	}
+
+	return storableModel.NewStorable[utxo.OutputID, VestingOutput](m), nil
+}
+
+// validateVestingOutput checks that the schedule of the VestingOutput is ordered and covered by its IOTA balance.
+func validateVestingOutput(_ context.Context, m vestingOutput) (err error) {
+	if m.Balances == nil || m.Address == nil {
+		return errors.WithMessage(cerrors.ErrParseBytesFailed, "VestingOutput: balances and address are mandatory")
+	}
+	if len(m.Schedule) == 0 || len(m.Schedule) > MaxVestingTranches {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "VestingOutput: schedule must contain between 1 and %d tranches, got %d", MaxVestingTranches, len(m.Schedule))
+	}
+
+	var vestingAmount uint64
+	for i, tranche := range m.Schedule {
+		if tranche.Amount == 0 {
+			return errors.WithMessagef(cerrors.ErrParseBytesFailed, "VestingOutput: tranche %d has no amount", i)
+		}
+		if i > 0 && !tranche.Time.After(m.Schedule[i-1].Time) {
+			return errors.WithMessagef(cerrors.ErrParseBytesFailed, "VestingOutput: tranche %d does not unlock after the previous one", i)
+		}
+		if vestingAmount+tranche.Amount < vestingAmount {
+			return errors.WithMessage(cerrors.ErrParseBytesFailed, "VestingOutput: vesting amount overflows")
+		}
+		vestingAmount += tranche.Amount
+	}
+
+	if iotaBalance, _ := m.Balances.Get(ColorIOTA); iotaBalance < vestingAmount {
+		return errors.WithMessagef(cerrors.ErrParseBytesFailed, "VestingOutput: IOTA balance (%d) does not cover the vesting amount (%d)", iotaBalance, vestingAmount)
+	}
+
+	return nil
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (v *VestingOutput) Type() OutputType {
+	return VestingOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (v *VestingOutput) Balances() *ColoredBalances {
+	return v.M.Balances
+}
+
+// Address returns the Address that the Output is associated to.
+func (v *VestingOutput) Address() Address {
+	return v.M.Address
+}
+
+// Schedule returns a copy of the vesting schedule of the Output.
+func (v *VestingOutput) Schedule() []VestingTranche {
+	return lo.CopySlice(v.M.Schedule)
+}
+
+// LockedAmount returns the amount of IOTA that is still vesting at the given time.
+func (v *VestingOutput) LockedAmount(nowis time.Time) (lockedAmount uint64) {
+	for _, tranche := range v.M.Schedule {
+		if tranche.Time.After(nowis) {
+			lockedAmount += tranche.Amount
+		}
+	}
+
+	return lockedAmount
+}
+
+// NextUnlock returns the next tranche that unlocks after the given time (exists is false if all tranches unlocked).
+func (v *VestingOutput) NextUnlock(nowis time.Time) (tranche VestingTranche, exists bool) {
+	for _, tranche = range v.M.Schedule {
+		if tranche.Time.After(nowis) {
+			return tranche, true
+		}
+	}
+
+	return VestingTranche{}, false
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// Besides the ownership, it checks that the amounts that are still vesting stay locked.
+func (v *VestingOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
+	switch blk := unlockBlock.(type) {
+	case *SignatureUnlockBlock:
+		txBytes, bytesErr := tx.Essence().Bytes()
+		if bytesErr != nil {
+			return false, errors.Wrap(bytesErr, "could not get essence bytes")
+		}
+		if !blk.AddressSignatureValid(v.M.Address, txBytes) {
+			return false, nil
+		}
+
+	case *AliasUnlockBlock:
+		if v.M.Address.Type() != AliasAddressType {
+			return false, errors.Errorf("VestingOutput: %s address can't be unlocked by alias reference", v.M.Address.Type().String())
+		}
+		refAliasOutput, isAlias := inputs[blk.AliasInputIndex()].(*AliasOutput)
+		if !isAlias {
+			return false, errors.New("VestingOutput: referenced input must be AliasOutput")
+		}
+		if !v.M.Address.Equals(refAliasOutput.GetAliasAddress()) {
+			return false, errors.New("VestingOutput: wrong alias referenced")
+		}
+		if refAliasOutput.hasToBeUnlockedForGovernanceUpdate(tx) {
+			return false, nil
+		}
+
+	default:
+		return false, errors.WithMessage(cerrors.ErrParseBytesFailed, "VestingOutput: unsupported unlock block type")
+	}
+
+	if err = v.validateVesting(tx, inputs); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateVesting checks that the VestingOutputs of the same address that are created by the Transaction lock at least
+// the amounts that are still vesting in the consumed VestingOutputs of that address at any point in time.
+func (v *VestingOutput) validateVesting(tx *Transaction, inputs []Output) error {
+	nowis := tx.Essence().Timestamp()
+
+	consumed := make([]*VestingOutput, 0)
+	for _, input := range inputs {
+		if vestingInput, isVesting := input.(*VestingOutput); isVesting && vestingInput.M.Address.Equals(v.M.Address) {
+			consumed = append(consumed, vestingInput)
+		}
+	}
+
+	created := make([]*VestingOutput, 0)
+	for _, output := range tx.Essence().Outputs() {
+		if vestingOutput, isVesting := output.(*VestingOutput); isVesting && vestingOutput.M.Address.Equals(v.M.Address) {
+			created = append(created, vestingOutput)
+		}
+	}
+
+	// the locked amounts only change at the times of the tranches, so it is enough to compare them at these times
+	checkpoints := []time.Time{nowis}
+	for _, vestingOutput := range append(consumed, created...) {
+		for _, tranche := range vestingOutput.M.Schedule {
+			if tranche.Time.After(nowis) {
+				checkpoints = append(checkpoints, tranche.Time)
+			}
+		}
+	}
+
+	for _, checkpoint := range checkpoints {
+		var lockedInputs, lockedOutputs uint64
+		for _, vestingInput := range consumed {
+			lockedInputs += vestingInput.LockedAmount(checkpoint)
+		}
+		for _, vestingOutput := range created {
+			lockedOutputs += vestingOutput.LockedAmount(checkpoint)
+		}
+
+		if lockedOutputs < lockedInputs {
+			return errors.WithMessagef(vm.ErrTimelocked, "VestingOutput: %d IOTA of %s are vesting at %s but only %d IOTA stay locked", lockedInputs, v.M.Address.Base58(), checkpoint, lockedOutputs)
+		}
+	}
+
+	return nil
+}
+
+// Input returns an Input that references the Output.
+func (v *VestingOutput) Input() Input {
+	if v.ID() == (utxo.OutputID{}) {
+		panic("VestingOutput: Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(v.ID())
+}
+
+// Clone creates a copy of the Output.
+func (v *VestingOutput) Clone() Output {
+	cloned := lo.PanicOnErr(NewVestingOutput(v.M.Balances.Clone(), v.M.Address.Clone(), v.M.Schedule...))
+	cloned.SetID(v.ID())
+	return cloned
+}
+
+// UpdateMintingColor replaces the ColorMint in the balances of the Output with the hash of the OutputID. It returns a
+// copy of the original Output with the modified balances.
+func (v *VestingOutput) UpdateMintingColor() Output {
+	coloredBalances := v.Balances().Map()
+	if mintedCoins, mintedCoinsExist := coloredBalances[ColorMint]; mintedCoinsExist {
+		delete(coloredBalances, ColorMint)
+		coloredBalances[Color(blake2b.Sum256(lo.PanicOnErr(v.ID().Bytes())))] = mintedCoins
+	}
+	updatedOutput := lo.PanicOnErr(NewVestingOutput(NewColoredBalances(coloredBalances), v.M.Address, v.M.Schedule...))
+	updatedOutput.SetID(v.ID())
+
+	return updatedOutput
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (v *VestingOutput) Compare(other Output) int {
+	return bytes.Compare(lo.PanicOnErr(v.Bytes()), lo.PanicOnErr(other.Bytes()))
+}
+
+func (v *VestingOutput) FromBytes(bytes []byte) (err error) {
+	v.Lock()
+	defer v.Unlock()
+
+	_, err = serix.DefaultAPI.Decode(context.Background(), bytes, v, serix.WithValidation())
+	return
+}
+
+func (v *VestingOutput) FromObjectStorage(key, data []byte) (err error) {
+	if err = v.IDFromBytes(key); err != nil {
+		return errors.Wrap(err, "failed to decode ID")
+	}
+
+	if err = v.FromBytes(data); err != nil {
+		return errors.Wrap(err, "failed to decode Model")
+	}
+
+	return nil
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (v *VestingOutput) ObjectStorageValue() (value []byte) {
+	return lo.PanicOnErr(v.Bytes())
+}
+
+func (v *VestingOutput) Bytes() (bytes []byte, err error) {
+	v.RLock()
+	defer v.RUnlock()
+
+	return serix.DefaultAPI.Encode(context.Background(), v, serix.WithValidation())
+}
+
+// code contract (make sure the type implements all required methods).
+var _ Output = new(VestingOutput)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
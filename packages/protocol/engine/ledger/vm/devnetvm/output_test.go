@@ -1179,6 +1179,64 @@ func TestAliasOutput_validateDestroyTransition(t *testing.T) {
 	})
 }
 
+func TestAliasOutput_validateDestroyTransition_FundsRecovery(t *testing.T) {
+	destroyTx := func(outputs ...Output) *Transaction {
+		return NewTransaction(NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(randOutputID())), NewOutputs(outputs...)), UnlockBlocks{NewReferenceUnlockBlock(0)})
+	}
+	fundedAlias := func() *AliasOutput {
+		alias := dummyAliasOutput()
+		alias.balances = NewColoredBalances(map[Color]uint64{ColorIOTA: 1000, {8}: 5})
+
+		return alias
+	}
+
+	t.Run("CASE: Happy path, dust minimum without recovery output", func(t *testing.T) {
+		assert.NoError(t, dummyAliasOutput().validateDestroyTransition(destroyTx(NewSigLockedSingleOutput(50, randEd25119Address()), NewSigLockedSingleOutput(50, randEd25119Address()))))
+	})
+
+	t.Run("CASE: Happy path, balances routed to recovery output", func(t *testing.T) {
+		alias := fundedAlias()
+		assert.NoError(t, alias.validateDestroyTransition(destroyTx(NewSigLockedColoredOutput(alias.Balances(), randEd25119Address()))))
+	})
+
+	t.Run("CASE: Happy path, non-empty state data", func(t *testing.T) {
+		alias := fundedAlias()
+		alias.stateData = []byte("the state controller is still using the alias")
+		assert.NoError(t, alias.validateDestroyTransition(destroyTx(NewSigLockedColoredOutput(alias.Balances(), randEd25119Address()))))
+	})
+
+	t.Run("CASE: Happy path, recovery output holds additional funds", func(t *testing.T) {
+		alias := fundedAlias()
+		balances := alias.Balances().Map()
+		balances[ColorIOTA] += 500
+		assert.NoError(t, alias.validateDestroyTransition(destroyTx(NewSigLockedColoredOutput(NewColoredBalances(balances), randEd25119Address()))))
+	})
+
+	t.Run("CASE: No recovery output", func(t *testing.T) {
+		alias := fundedAlias()
+		err := alias.validateDestroyTransition(destroyTx(
+			NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 1000}), randEd25119Address()),
+			NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{{8}: 5}), randEd25119Address()),
+		))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Recovery output locked to destroyed alias", func(t *testing.T) {
+		alias := fundedAlias()
+		assert.Error(t, alias.validateDestroyTransition(destroyTx(NewSigLockedColoredOutput(alias.Balances(), alias.GetAliasAddress()))))
+
+		fallbackOutput := NewExtendedLockedOutput(alias.Balances().Map(), randEd25119Address()).WithFallbackOptions(alias.GetAliasAddress(), time.Now().Add(time.Hour))
+		assert.Error(t, alias.validateDestroyTransition(destroyTx(fallbackOutput)))
+	})
+
+	t.Run("CASE: Recovery into other alias", func(t *testing.T) {
+		alias := fundedAlias()
+		otherAlias := dummyAliasOutput()
+		otherAlias.balances = alias.Balances()
+		assert.Error(t, alias.validateDestroyTransition(destroyTx(otherAlias)))
+	})
+}
+
 func TestAliasOutput_findChainedOutputAndCheckFork(t *testing.T) {
 	t.Run("CASE: Happy path", func(t *testing.T) {
 		prev := dummyAliasOutput()
@@ -2323,6 +2381,309 @@ func TestExtendedLockedOutput_Clone(t *testing.T) {
 
 // endregion
 
+// region ThresholdSigOutput Tests
+
+func TestNewThresholdSigOutput(t *testing.T) {
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	addresses := []Address{randEd25119Address(), randEd25119Address(), randEd25119Address()}
+
+	t.Run("CASE: Happy path", func(t *testing.T) {
+		output, err := NewThresholdSigOutput(balances, 2, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(2), output.Threshold())
+		assert.Len(t, output.Addresses(), 3)
+		assert.True(t, output.Address().Equals(addresses[0]))
+	})
+
+	t.Run("CASE: Threshold not reachable", func(t *testing.T) {
+		_, err := NewThresholdSigOutput(balances, 4, addresses...)
+		assert.Error(t, err)
+		_, err = NewThresholdSigOutput(balances, 0, addresses...)
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Duplicate address", func(t *testing.T) {
+		_, err := NewThresholdSigOutput(balances, 2, addresses[0], addresses[1], addresses[0])
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Alias address", func(t *testing.T) {
+		_, err := NewThresholdSigOutput(balances, 1, addresses[0], randAliasAddress())
+		assert.Error(t, err)
+	})
+}
+
+func TestThresholdSigOutput_Bytes(t *testing.T) {
+	output, err := NewThresholdSigOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 100}), 2, randEd25119Address(), randEd25119Address())
+	require.NoError(t, err)
+	output.SetID(randOutputID())
+
+	restored, err := OutputFromBytes(lo.PanicOnErr(output.Bytes()))
+	require.NoError(t, err)
+	restoredOutput, ok := restored.(*ThresholdSigOutput)
+	require.True(t, ok)
+	assert.Equal(t, output.Threshold(), restoredOutput.Threshold())
+	assert.Equal(t, lo.PanicOnErr(output.Bytes()), lo.PanicOnErr(restoredOutput.Bytes()))
+}
+
+func TestThresholdSigOutput_UnlockValid(t *testing.T) {
+	wallets := createWallets(4)
+	input, err := NewThresholdSigOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 100}), 2, wallets[0].address, wallets[1].address, wallets[2].address)
+	require.NoError(t, err)
+	input.SetID(randOutputID())
+
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(NewSigLockedSingleOutput(100, wallets[3].address)))
+
+	unlockWith := func(signatures ...Signature) (bool, error) {
+		unlockBlock := NewThresholdSignatureUnlockBlock(signatures...)
+		return input.UnlockValid(NewTransaction(essence, UnlockBlocks{unlockBlock}), unlockBlock, Outputs{input})
+	}
+
+	t.Run("CASE: Threshold reached", func(t *testing.T) {
+		valid, err := unlockWith(wallets[0].sign(essence), wallets[2].sign(essence))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("CASE: Threshold not reached", func(t *testing.T) {
+		valid, err := unlockWith(wallets[1].sign(essence))
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Same signer twice", func(t *testing.T) {
+		valid, err := unlockWith(wallets[1].sign(essence), wallets[1].sign(essence))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Foreign signer", func(t *testing.T) {
+		valid, err := unlockWith(wallets[0].sign(essence), wallets[3].sign(essence))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Signature unlock block", func(t *testing.T) {
+		unlockBlock := NewSignatureUnlockBlock(wallets[0].sign(essence))
+		valid, err := input.UnlockValid(NewTransaction(essence, UnlockBlocks{unlockBlock}), unlockBlock, Outputs{input})
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+}
+
+// endregion
+
+// region NFTOutput Tests
+
+func TestNFTOutput_Mint(t *testing.T) {
+	owner, issuer := randEd25119Address(), randEd25119Address()
+
+	t.Run("CASE: Happy path", func(t *testing.T) {
+		mint, err := NewNFTOutputMint(DustThresholdAliasOutputIOTA, owner, issuer, []byte("metadata"))
+		require.NoError(t, err)
+		assert.True(t, mint.IsMint())
+
+		mint.SetID(randOutputID())
+		booked := mint.UpdateMintingColor().(*NFTOutput)
+		assert.False(t, booked.IsMint())
+		assert.Equal(t, Color(blake2b.Sum256(lo.PanicOnErr(mint.ID().Bytes()))), booked.NFTID())
+		assert.True(t, booked.Issuer().Equals(issuer))
+		assert.Equal(t, []byte("metadata"), booked.Metadata())
+
+		restored, err := OutputFromBytes(lo.PanicOnErr(booked.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, booked.NFTID(), restored.(*NFTOutput).NFTID())
+	})
+
+	t.Run("CASE: Below dust threshold", func(t *testing.T) {
+		_, err := NewNFTOutputMint(DustThresholdAliasOutputIOTA-1, owner, issuer, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Divisible token", func(t *testing.T) {
+		_, err := NewNFTOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA, ColorMint: 2}), owner, issuer, nil, nil, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestNFTOutput_UnlockValid(t *testing.T) {
+	wallets := createWallets(3)
+	owner, receiver, royaltyReceiver := wallets[0], wallets[1], wallets[2]
+
+	mint, err := NewNFTOutputMint(DustThresholdAliasOutputIOTA, owner.address, randEd25119Address(), []byte("metadata"))
+	require.NoError(t, err)
+	mint, err = mint.WithRoyalty(royaltyReceiver.address, 10)
+	require.NoError(t, err)
+	mint.SetID(randOutputID())
+	input := mint.UpdateMintingColor().(*NFTOutput)
+	input.SetID(randOutputID())
+
+	unlock := func(outputs ...Output) (bool, error) {
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(outputs...))
+		unlockBlock := NewSignatureUnlockBlock(owner.sign(essence))
+		return input.UnlockValid(NewTransaction(essence, UnlockBlocks{unlockBlock}), unlockBlock, Outputs{input})
+	}
+
+	t.Run("CASE: Transfer with royalty", func(t *testing.T) {
+		valid, err := unlock(input.NewNFTOutputNext(receiver.address), NewSigLockedSingleOutput(10, royaltyReceiver.address))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("CASE: Transfer without royalty", func(t *testing.T) {
+		valid, err := unlock(input.NewNFTOutputNext(receiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Update without owner change", func(t *testing.T) {
+		valid, err := unlock(input.NewNFTOutputNext(owner.address))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("CASE: Changed metadata", func(t *testing.T) {
+		royaltyAddress, royaltyAmount := input.Royalty()
+		forged, err := NewNFTOutput(input.Balances(), receiver.address, input.Issuer(), []byte("forged"), royaltyAddress, royaltyAmount)
+		require.NoError(t, err)
+		valid, err := unlock(forged, NewSigLockedSingleOutput(10, royaltyReceiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Token moved to other output type", func(t *testing.T) {
+		valid, err := unlock(NewSigLockedColoredOutput(input.Balances(), receiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Burn", func(t *testing.T) {
+		valid, err := unlock(NewSigLockedSingleOutput(DustThresholdAliasOutputIOTA+1, owner.address))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
+
+func TestNFTInitialStateValid(t *testing.T) {
+	owner := genRandomWallet()
+
+	coloredInput := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA, {1}: 1}), owner.address)
+	coloredInput.SetID(randOutputID())
+
+	forged, err := NewNFTOutput(coloredInput.Balances(), owner.address, randEd25119Address(), nil, nil, 0)
+	require.NoError(t, err)
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(coloredInput.Input()), NewOutputs(forged))
+	assert.False(t, NFTInitialStateValid(Outputs{coloredInput}, NewTransaction(essence, owner.unlockBlocks(essence))))
+
+	mint, err := NewNFTOutputMint(DustThresholdAliasOutputIOTA, owner.address, owner.address, nil)
+	require.NoError(t, err)
+	essence = NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(coloredInput.Input()), NewOutputs(mint, NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{{1}: 1}), owner.address)))
+	assert.True(t, NFTInitialStateValid(Outputs{coloredInput}, NewTransaction(essence, owner.unlockBlocks(essence))))
+
+	// minting in the name of an issuer that does not unlock any input of the transaction is not allowed
+	impersonatingMint, err := NewNFTOutputMint(DustThresholdAliasOutputIOTA, owner.address, randEd25119Address(), nil)
+	require.NoError(t, err)
+	essence = NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(coloredInput.Input()), NewOutputs(impersonatingMint, NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{{1}: 1}), owner.address)))
+	assert.False(t, NFTInitialStateValid(Outputs{coloredInput}, NewTransaction(essence, owner.unlockBlocks(essence))))
+}
+
+// endregion
+
+// region VestingOutput Tests
+
+func TestVestingOutput(t *testing.T) {
+	owner := genRandomWallet()
+	start := time.Now()
+	schedule := []VestingTranche{
+		{Time: start.Add(time.Hour), Amount: 100},
+		{Time: start.Add(2 * time.Hour), Amount: 200},
+	}
+
+	t.Run("CASE: Invalid schedules", func(t *testing.T) {
+		_, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 299}), owner.address, schedule...)
+		assert.Error(t, err)
+
+		_, err = NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 300}), owner.address, schedule[1], schedule[0])
+		assert.Error(t, err)
+
+		_, err = NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 300}), owner.address)
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Locked amount", func(t *testing.T) {
+		output, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 500}), owner.address, schedule...)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 300, output.LockedAmount(start))
+		assert.EqualValues(t, 200, output.LockedAmount(start.Add(time.Hour)))
+		assert.EqualValues(t, 0, output.LockedAmount(start.Add(3*time.Hour)))
+
+		nextUnlock, exists := output.NextUnlock(start.Add(90 * time.Minute))
+		assert.True(t, exists)
+		assert.Equal(t, schedule[1], nextUnlock)
+
+		restored, err := OutputFromBytes(lo.PanicOnErr(output.Bytes()))
+		require.NoError(t, err)
+		assert.EqualValues(t, 200, restored.(*VestingOutput).LockedAmount(start.Add(time.Hour)))
+	})
+}
+
+func TestVestingOutput_UnlockValid(t *testing.T) {
+	owner, receiver := genRandomWallet(), genRandomWallet()
+	start := time.Now()
+
+	input, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 500}), owner.address, VestingTranche{Time: start.Add(time.Hour), Amount: 100}, VestingTranche{Time: start.Add(2 * time.Hour), Amount: 200})
+	require.NoError(t, err)
+	input.SetID(randOutputID())
+
+	unlock := func(timestamp time.Time, outputs ...Output) (bool, error) {
+		essence := NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(outputs...))
+		unlockBlock := NewSignatureUnlockBlock(owner.sign(essence))
+		return input.UnlockValid(NewTransaction(essence, UnlockBlocks{unlockBlock}), unlockBlock, Outputs{input})
+	}
+
+	t.Run("CASE: Unlocked tranche spent", func(t *testing.T) {
+		remainder, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 200}), owner.address, VestingTranche{Time: start.Add(2 * time.Hour), Amount: 200})
+		require.NoError(t, err)
+
+		valid, err := unlock(start.Add(time.Hour), remainder, NewSigLockedSingleOutput(300, receiver.address))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("CASE: Vesting tranche spent", func(t *testing.T) {
+		valid, err := unlock(start.Add(time.Hour), NewSigLockedSingleOutput(500, receiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Tranche unlocks earlier", func(t *testing.T) {
+		remainder, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 200}), owner.address, VestingTranche{Time: start.Add(90 * time.Minute), Amount: 200})
+		require.NoError(t, err)
+
+		valid, err := unlock(start.Add(time.Hour), remainder, NewSigLockedSingleOutput(300, receiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Vesting moved to other address", func(t *testing.T) {
+		remainder, err := NewVestingOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 300}), receiver.address, VestingTranche{Time: start.Add(time.Hour), Amount: 100}, VestingTranche{Time: start.Add(2 * time.Hour), Amount: 200})
+		require.NoError(t, err)
+
+		valid, err := unlock(start, remainder, NewSigLockedSingleOutput(200, receiver.address))
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Fully vested", func(t *testing.T) {
+		valid, err := unlock(start.Add(2*time.Hour), NewSigLockedSingleOutput(500, receiver.address))
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
+
+// endregion
+
 // region test utils
 
 func notSameMemory(s1, s2 []byte) bool {
@@ -2388,3 +2749,26 @@ func randOutputID() (randomOutputID utxo.OutputID) {
 }
 
 // endregion
+
+// region OutputType Tests /////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestRegisterOutputType(t *testing.T) {
+	require.Equal(t, []OutputType{SigLockedSingleOutputType, SigLockedColoredOutputType, AliasOutputType, ExtendedLockedOutputType, ThresholdSigOutputType, NFTOutputType, VestingOutputType}, RegisteredOutputTypes())
+
+	for _, outputType := range RegisteredOutputTypes() {
+		parsedOutputType, err := OutputTypeFromString(outputType.String())
+		require.NoError(t, err)
+		assert.Equal(t, outputType, parsedOutputType)
+	}
+
+	assert.Equal(t, "OutputType(200)", OutputType(200).String())
+	_, err := OutputTypeFromString("UnknownOutputType")
+	assert.Error(t, err)
+
+	assert.Error(t, RegisterOutputType(NFTOutputType, "DuplicateOutputType", func() Output { return new(NFTOutput) }))
+	assert.Error(t, RegisterOutputType(OutputType(200), "NFTOutputType", func() Output { return new(NFTOutput) }))
+	assert.Error(t, RegisterOutputType(OutputType(200), "MismatchingOutputType", func() Output { return new(NFTOutput) }))
+	assert.Len(t, RegisteredOutputTypes(), 7)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,9 +1,17 @@
 package devnetvm
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
 )
 
 // region OutputType ///////////////////////////////////////////////////////////////////////////////////////////////////
@@ -24,26 +32,32 @@ const (
 
 	// ExtendedLockedOutputType represents an Output which extends SigLockedColoredOutput with alias locking and fallback.
 	ExtendedLockedOutputType
+
+	// ThresholdSigOutputType represents an Output that gets unlocked by m-of-n signatures of its eligible signers.
+	ThresholdSigOutputType
+
+	// NFTOutputType represents an Output that holds a single indivisible token with immutable issuer and metadata.
+	NFTOutputType
+
+	// VestingOutputType represents an Output whose IOTA balance unlocks in tranches according to a vesting schedule.
+	VestingOutputType
 )
 
 // String returns a human readable representation of the OutputType.
 func (o OutputType) String() string {
-	return [...]string{
-		"SigLockedSingleOutputType",
-		"SigLockedColoredOutputType",
-		"AliasOutputType",
-		"ExtendedLockedOutputType",
-	}[o]
+	if definition, exists := OutputTypeDefinitionByType(o); exists {
+		return definition.Name
+	}
+
+	return fmt.Sprintf("OutputType(%d)", uint8(o))
 }
 
 // OutputTypeFromString returns the output type from a string.
 func OutputTypeFromString(ot string) (OutputType, error) {
-	res, ok := map[string]OutputType{
-		"SigLockedSingleOutputType":  SigLockedSingleOutputType,
-		"SigLockedColoredOutputType": SigLockedColoredOutputType,
-		"AliasOutputType":            AliasOutputType,
-		"ExtendedLockedOutputType":   ExtendedLockedOutputType,
-	}[ot]
+	outputTypesMutex.RLock()
+	defer outputTypesMutex.RUnlock()
+
+	res, ok := outputTypesByName[ot]
 	if !ok {
 		return res, errors.New(fmt.Sprintf("unsupported output type: %s", ot))
 	}
@@ -51,3 +65,115 @@ func OutputTypeFromString(ot string) (OutputType, error) {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OutputTypeDefinition /////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	// outputTypeDefinitions contains the OutputTypeDefinitions of the registered OutputTypes.
+	outputTypeDefinitions = make(map[OutputType]*OutputTypeDefinition)
+
+	// outputTypesByName contains the registered OutputTypes indexed by their name.
+	outputTypesByName = make(map[string]OutputType)
+
+	// outputTypesMutex is used to synchronize access to the registered OutputTypes.
+	outputTypesMutex sync.RWMutex
+)
+
+// OutputTypeDefinition contains everything that is required to parse and validate the Outputs of an OutputType. New
+// OutputTypes are contributed by registering their OutputTypeDefinition instead of extending the central switches.
+type OutputTypeDefinition struct {
+	// Type contains the OutputType (the first byte of the serialized Outputs).
+	Type OutputType
+
+	// Name contains the human-readable name of the OutputType.
+	Name string
+
+	// New creates an empty Output of the OutputType.
+	New func() Output
+
+	// registerValidators registers the validators of the OutputType (if any were provided).
+	registerValidators []func() error
+}
+
+// RegisterOutputType registers the OutputType with the given name and constructor, so that its Outputs can be parsed
+// from bytes and resolved by name.
+func RegisterOutputType(outputType OutputType, name string, newOutput func() Output, opts ...options.Option[OutputTypeDefinition]) (err error) {
+	outputTypesMutex.Lock()
+	defer outputTypesMutex.Unlock()
+
+	if _, exists := outputTypeDefinitions[outputType]; exists {
+		return errors.Errorf("output type %d is already registered", outputType)
+	} else if _, exists = outputTypesByName[name]; exists {
+		return errors.Errorf("output type with name %s is already registered", name)
+	}
+
+	definition := options.Apply(&OutputTypeDefinition{
+		Type: outputType,
+		Name: name,
+		New:  newOutput,
+	}, opts)
+
+	prototype := newOutput()
+	if prototype.Type() != outputType {
+		return errors.Errorf("constructor of output type %s creates outputs of type %d", name, prototype.Type())
+	}
+
+	if err = serix.DefaultAPI.RegisterTypeSettings(reflect.ValueOf(prototype).Elem().Interface(), serix.TypeSettings{}.WithObjectType(uint8(outputType))); err != nil {
+		return errors.Wrapf(err, "error registering %s type settings", name)
+	}
+	for _, registerValidators := range definition.registerValidators {
+		if err = registerValidators(); err != nil {
+			return errors.Wrapf(err, "error registering %s validators", name)
+		}
+	}
+	if err = serix.DefaultAPI.RegisterInterfaceObjects((*Output)(nil), prototype); err != nil {
+		return errors.Wrapf(err, "error registering %s as Output interface implementation", name)
+	}
+	if err = serix.DefaultAPI.RegisterInterfaceObjects((*utxo.Output)(nil), prototype); err != nil {
+		return errors.Wrapf(err, "error registering %s as utxo.Output interface implementation", name)
+	}
+
+	outputTypeDefinitions[outputType] = definition
+	outputTypesByName[name] = outputType
+
+	return nil
+}
+
+// OutputTypeDefinitionByType returns the OutputTypeDefinition of the given OutputType (if it was registered).
+func OutputTypeDefinitionByType(outputType OutputType) (definition *OutputTypeDefinition, exists bool) {
+	outputTypesMutex.RLock()
+	defer outputTypesMutex.RUnlock()
+
+	definition, exists = outputTypeDefinitions[outputType]
+
+	return definition, exists
+}
+
+// RegisteredOutputTypes returns the ordered list of registered OutputTypes.
+func RegisteredOutputTypes() (outputTypes []OutputType) {
+	outputTypesMutex.RLock()
+	defer outputTypesMutex.RUnlock()
+
+	outputTypes = make([]OutputType, 0, len(outputTypeDefinitions))
+	for outputType := range outputTypeDefinitions {
+		outputTypes = append(outputTypes, outputType)
+	}
+
+	sort.Slice(outputTypes, func(i, j int) bool {
+		return outputTypes[i] < outputTypes[j]
+	})
+
+	return outputTypes
+}
+
+// WithSyntacticValidator is an option for the registration of an OutputType that registers a validator for the given
+// model, which is executed whenever an Output of the OutputType is parsed from bytes.
+func WithSyntacticValidator[M any](validator func(ctx context.Context, model M) (err error)) options.Option[OutputTypeDefinition] {
+	return func(definition *OutputTypeDefinition) {
+		definition.registerValidators = append(definition.registerValidators, func() error {
+			return serix.DefaultAPI.RegisterValidators(*new(M), nil, validator)
+		})
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -11,6 +11,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/cerrors"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payloadtype"
 	"github.com/iotaledger/hive.go/core/model"
@@ -86,7 +87,7 @@ func validateTransaction(_ context.Context, tx Transaction) (err error) {
 	maxReferencedUnlockIndex := len(tx.Essence().Inputs()) - 1
 	for i, unlockBlock := range tx.UnlockBlocks() {
 		switch unlockBlock.Type() {
-		case SignatureUnlockBlockType:
+		case SignatureUnlockBlockType, ThresholdSignatureUnlockBlockType:
 			continue
 		case ReferenceUnlockBlockType:
 			if unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex() > uint16(maxReferencedUnlockIndex) {
@@ -224,6 +225,12 @@ func (t *Transaction) UnlockBlocks() UnlockBlocks {
 	return t.M.UnlockBlocks
 }
 
+// Timestamp returns the issuing time of the Transaction (it determines the slot whose protocol parameters apply to the
+// execution of the Transaction).
+func (t *Transaction) Timestamp() time.Time {
+	return t.Essence().Timestamp()
+}
+
 // SetOutputID assigns TransactionID to all outputs in TransactionEssence.
 func SetOutputID(essence *TransactionEssence, transactionID utxo.TransactionID) {
 	for i, output := range essence.Outputs() {
@@ -249,7 +256,7 @@ func SetOutputID(essence *TransactionEssence, transactionID utxo.TransactionID)
 // code contract (make sure the struct implements all required methods).
 var _ payload.Payload = new(Transaction)
 
-var _ utxo.Transaction = new(Transaction)
+var _ vm.TimestampedTransaction = new(Transaction)
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
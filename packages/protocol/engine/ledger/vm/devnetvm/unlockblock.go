@@ -24,6 +24,10 @@ func init() {
 	if err != nil {
 		panic(errors.Wrap(err, "error registering SignatureUnlockBlock type settings"))
 	}
+	err = serix.DefaultAPI.RegisterTypeSettings(ThresholdSignatureUnlockBlock{}, serix.TypeSettings{}.WithObjectType(uint8(new(ThresholdSignatureUnlockBlock).Type())))
+	if err != nil {
+		panic(errors.Wrap(err, "error registering ThresholdSignatureUnlockBlock type settings"))
+	}
 	err = serix.DefaultAPI.RegisterTypeSettings(UnlockBlocks{}, serix.TypeSettings{}.WithLengthPrefixType(serix.LengthPrefixTypeAsUint16).WithArrayRules(&serix.ArrayRules{
 		// TODO: Avoid failing on duplicated unlock blocks. They seem to have been wrongly generated in the old snapshot.
 		// ValidationMode: serializer.ArrayValidationModeNoDuplicates,
@@ -31,7 +35,7 @@ func init() {
 	if err != nil {
 		panic(errors.Wrap(err, "error registering SignatureUnlockBlock type settings"))
 	}
-	err = serix.DefaultAPI.RegisterInterfaceObjects((*UnlockBlock)(nil), new(AliasUnlockBlock), new(ReferenceUnlockBlock), new(SignatureUnlockBlock))
+	err = serix.DefaultAPI.RegisterInterfaceObjects((*UnlockBlock)(nil), new(AliasUnlockBlock), new(ReferenceUnlockBlock), new(SignatureUnlockBlock), new(ThresholdSignatureUnlockBlock))
 	if err != nil {
 		panic(errors.Wrap(err, "error registering UnlockBlock interface implementations"))
 	}
@@ -47,6 +51,9 @@ const (
 
 	// AliasUnlockBlockType represents the type of a AliasUnlockBlock.
 	AliasUnlockBlockType
+
+	// ThresholdSignatureUnlockBlockType represents the type of a ThresholdSignatureUnlockBlock.
+	ThresholdSignatureUnlockBlockType
 )
 
 // UnlockBlockType represents the type of the UnlockBlock. Different types of UnlockBlocks can unlock different types of
@@ -59,6 +66,7 @@ func (a UnlockBlockType) String() string {
 		"SignatureUnlockBlockType",
 		"ReferenceUnlockBlockType",
 		"AliasUnlockBlockType",
+		"ThresholdSignatureUnlockBlockType",
 	}[a]
 }
 
@@ -230,3 +238,36 @@ func (r *AliasUnlockBlock) Type() UnlockBlockType {
 var _ UnlockBlock = &AliasUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdSignatureUnlockBlock ////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdSignatureUnlockBlock represents an UnlockBlock that contains the Signatures of several eligible signers of a
+// ThresholdSigOutput.
+type ThresholdSignatureUnlockBlock struct {
+	model.Immutable[ThresholdSignatureUnlockBlock, *ThresholdSignatureUnlockBlock, thresholdSignatureUnlockBlockModel] `serix:"0"`
+}
+type thresholdSignatureUnlockBlockModel struct {
+	Signatures []Signature `serix:"0,lengthPrefixType=uint8"`
+}
+
+// NewThresholdSignatureUnlockBlock is the constructor for ThresholdSignatureUnlockBlock objects.
+func NewThresholdSignatureUnlockBlock(signatures ...Signature) *ThresholdSignatureUnlockBlock {
+	return model.NewImmutable[ThresholdSignatureUnlockBlock](&thresholdSignatureUnlockBlockModel{
+		Signatures: signatures,
+	})
+}
+
+// Signatures returns the contained signatures.
+func (t *ThresholdSignatureUnlockBlock) Signatures() []Signature {
+	return t.M.Signatures
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (t *ThresholdSignatureUnlockBlock) Type() UnlockBlockType {
+	return ThresholdSignatureUnlockBlockType
+}
+
+// code contract (make sure the type implements all required methods).
+var _ UnlockBlock = &ThresholdSignatureUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
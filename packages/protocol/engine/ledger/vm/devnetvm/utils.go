@@ -124,6 +124,47 @@ func AliasInitialStateValid(inputs Outputs, transaction *Transaction) bool {
 	return true
 }
 
+// NFTInitialStateValid is an internal utility function that checks if the NFTOutputs created by the transaction either
+// continue the chain of an NFTOutput that is consumed by the transaction or mint a new token that is authorized by its
+// issuer (by consuming an input that belongs to the issuer and therefore has to be unlocked by it). This prevents
+// wrapping an arbitrary colored coin into an NFTOutput with forged immutable fields and claiming an arbitrary issuer.
+func NFTInitialStateValid(inputs Outputs, transaction *Transaction) bool {
+	inputNFTs := make(map[Color]types.Empty)
+	for _, input := range inputs {
+		if nftOutput, isNFTOutput := input.(*NFTOutput); isNFTOutput {
+			inputNFTs[nftOutput.NFTID()] = types.Empty{}
+		}
+	}
+
+	for _, output := range transaction.Essence().Outputs() {
+		nftOutput, isNFTOutput := output.(*NFTOutput)
+		if !isNFTOutput {
+			continue
+		}
+
+		if nftOutput.IsMint() {
+			if !issuerUnlocked(inputs, nftOutput.Issuer()) {
+				return false
+			}
+		} else if _, exists := inputNFTs[nftOutput.NFTID()]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// issuerUnlocked checks if one of the inputs belongs to the given issuer.
+func issuerUnlocked(inputs Outputs, issuer Address) bool {
+	for _, input := range inputs {
+		if input.Address().Equals(issuer) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SafeAddUint64 adds two uint64 values. It returns the result and a valid flag that indicates whether the addition is
 // valid without causing an overflow.
 func SafeAddUint64(a uint64, b uint64) (result uint64, valid bool) {
@@ -202,6 +243,8 @@ func NewUnlockGraph(blocks UnlockBlocks) (*UnlockGraph, error) {
 		switch block.Type() {
 		case SignatureUnlockBlockType:
 			// no adjacent vertex as a SignatureUnlockBlockType can't reference an other one
+		case ThresholdSignatureUnlockBlockType:
+			// no adjacent vertex as a ThresholdSignatureUnlockBlockType can't reference an other one either
 		case ReferenceUnlockBlockType:
 			// a reference unlock block can not point to another reference unlock block
 			refIndex := block.(*ReferenceUnlockBlock).ReferencedIndex()
@@ -1,13 +1,61 @@
 package devnetvm
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
 )
 
-type VM struct{}
+type VM struct {
+	optsDustPolicy                 DustPolicy
+	optsDustPolicyProvider         func(timestamp time.Time) DustPolicy
+	optsAliasFundsRecovery         bool
+	optsAliasFundsRecoveryProvider func(timestamp time.Time) bool
+}
+
+// NewVM creates a new VM with the given options (the zero value of the VM uses the DefaultDustPolicy and does not allow
+// the destruction of funded aliases with funds recovery).
+func NewVM(opts ...options.Option[VM]) *VM {
+	return options.Apply(new(VM), opts)
+}
+
+// DustPolicy returns the DustPolicy that defines the minimum deposit of the outputs that are created by a Transaction
+// with the given timestamp.
+func (d *VM) DustPolicy(timestamp time.Time) DustPolicy {
+	if d.optsDustPolicyProvider != nil {
+		return d.optsDustPolicyProvider(timestamp)
+	}
+
+	if d.optsDustPolicy == nil {
+		return DefaultDustPolicy
+	}
+
+	return d.optsDustPolicy
+}
+
+// AliasFundsRecovery returns true if a Transaction with the given timestamp can destroy an alias that holds more than
+// the dust minimum by routing its balances to a recovery output.
+func (d *VM) AliasFundsRecovery(timestamp time.Time) bool {
+	if d.optsAliasFundsRecoveryProvider != nil {
+		return d.optsAliasFundsRecoveryProvider(timestamp)
+	}
+
+	return d.optsAliasFundsRecovery
+}
+
+// Types returns the types of the Transactions, Inputs and Outputs of the VM.
+func (d *VM) Types() (types vm.Types) {
+	return vm.Types{
+		TransactionType: TransactionType,
+		Input:           new(UTXOInput),
+		OutputTypes:     lo.Map(RegisteredOutputTypes(), func(outputType OutputType) uint8 { return uint8(outputType) }),
+	}
+}
 
 func (d *VM) ParseTransaction(transactionBytes []byte) (transaction utxo.Transaction, err error) {
 	tx := new(Transaction)
@@ -27,24 +75,50 @@ func (d *VM) ResolveInput(input utxo.Input) (outputID utxo.OutputID) {
 	return input.(*UTXOInput).ReferencedOutputID()
 }
 
-func (d *VM) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, _ ...uint64) (outputs []utxo.Output, err error) {
-	typedOutputs, err := d.executeTransaction(transaction.(*Transaction), OutputsFromUTXOOutputs(inputs))
+// ExecuteTransaction executes the Transaction and determines the Outputs from the given Inputs. The execution of the VM
+// is bounded by the size of the Transaction, so it does not consume gas and only checks the size of the created Outputs
+// against the Budget.
+func (d *VM) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget) (outputs []utxo.Output, cost vm.Cost, err error) {
+	return d.TraceTransaction(transaction, inputs, budget, nil)
+}
+
+// TraceTransaction executes the Transaction like ExecuteTransaction and records its validation steps in the given Trace.
+func (d *VM) TraceTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget, trace *vm.Trace) (outputs []utxo.Output, cost vm.Cost, err error) {
+	typedOutputs, err := d.executeTransaction(transaction.(*Transaction), OutputsFromUTXOOutputs(inputs), trace)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to execute transaction")
+		return nil, cost, errors.Wrap(err, "failed to execute transaction")
 	}
 
-	return typedOutputs.UTXOOutputs(), nil
+	outputs = typedOutputs.UTXOOutputs()
+	if cost.Size, err = vm.OutputsSize(outputs); err != nil {
+		return nil, cost, errors.Wrap(err, "failed to determine the size of the created outputs")
+	}
+
+	if err = trace.Step("budget", budget.Check(cost)); err != nil {
+		return nil, cost, errors.Wrap(err, "failed to execute transaction")
+	}
+
+	return outputs, cost, nil
 }
 
-func (d *VM) executeTransaction(transaction *Transaction, inputs Outputs) (outputs Outputs, err error) {
-	if !TransactionBalancesValid(inputs, transaction.Essence().Outputs()) {
-		return nil, errors.WithMessagef(ErrTransactionInvalid, "sum of consumed and spent balances is not 0")
+func (d *VM) executeTransaction(transaction *Transaction, inputs Outputs, trace *vm.Trace) (outputs Outputs, err error) {
+	if err = trace.Step("balances", balancesValid(inputs, transaction)); err != nil {
+		return nil, err
 	}
-	if !UnlockBlocksValid(inputs, transaction) {
-		return nil, errors.WithMessagef(ErrTransactionInvalid, "spending of referenced consumedOutputs is not authorized")
+	if err = trace.Step("unlock blocks", unlockBlocksValid(inputs, transaction)); err != nil {
+		return nil, err
 	}
-	if !AliasInitialStateValid(inputs, transaction) {
-		return nil, errors.WithMessagef(ErrTransactionInvalid, "initial state of created alias output is invalid")
+	if err = trace.Step("alias destruction", d.aliasDestructionsValid(inputs, transaction)); err != nil {
+		return nil, err
+	}
+	if err = trace.Step("alias state", aliasInitialStateValid(inputs, transaction)); err != nil {
+		return nil, err
+	}
+	if err = trace.Step("nft state", nftInitialStateValid(inputs, transaction)); err != nil {
+		return nil, err
+	}
+	if err = trace.Step("dust policy", d.depositsValid(transaction)); err != nil {
+		return nil, err
 	}
 
 	outputs = make(Outputs, 0, len(transaction.Essence().Outputs()))
@@ -57,4 +131,129 @@ func (d *VM) executeTransaction(transaction *Transaction, inputs Outputs) (outpu
 	return outputs, nil
 }
 
-var _ vm.VM = new(VM)
+// balancesValid checks if the consumed and created balances of the given Transaction match.
+func balancesValid(inputs Outputs, transaction *Transaction) (err error) {
+	if !TransactionBalancesValid(inputs, transaction.Essence().Outputs()) {
+		return errors.WithMessagef(vm.ErrBalanceMismatch, "sum of consumed and spent balances is not 0")
+	}
+
+	return nil
+}
+
+// unlockBlocksValid checks if the unlock blocks of the given Transaction authorize the spending of its inputs.
+func unlockBlocksValid(inputs Outputs, transaction *Transaction) (err error) {
+	if unlockValid, unlockErr := UnlockBlocksValidWithError(inputs, transaction); unlockErr != nil {
+		if errors.Is(unlockErr, vm.ErrTimelocked) {
+			return errors.Wrap(unlockErr, "spending of referenced consumedOutputs is not authorized")
+		}
+
+		return errors.WithMessagef(vm.ErrUnlockInvalid, "spending of referenced consumedOutputs is not authorized: %s", unlockErr)
+	} else if !unlockValid {
+		if timelockedOutput, timelocked := timelockedInput(inputs, transaction); timelocked {
+			return errors.WithMessagef(vm.ErrTimelocked, "spending of referenced consumedOutputs is not authorized: %s is time locked until %s", timelockedOutput.ID(), timelockedOutput.TimeLock())
+		}
+
+		return errors.WithMessagef(vm.ErrUnlockInvalid, "spending of referenced consumedOutputs is not authorized")
+	}
+
+	return nil
+}
+
+// aliasInitialStateValid checks if the created alias outputs of the given Transaction have a valid initial state.
+func aliasInitialStateValid(inputs Outputs, transaction *Transaction) (err error) {
+	if !AliasInitialStateValid(inputs, transaction) {
+		return errors.WithMessagef(vm.ErrAliasStateInvalid, "initial state of created alias output is invalid")
+	}
+
+	return nil
+}
+
+// aliasDestructionsValid checks if the aliases that are destroyed by the given Transaction can be destroyed without
+// funds recovery, unless the funds recovery is active at the timestamp of the Transaction.
+func (d *VM) aliasDestructionsValid(inputs Outputs, transaction *Transaction) (err error) {
+	if d.AliasFundsRecovery(transaction.Essence().Timestamp()) {
+		return nil
+	}
+
+	for _, input := range inputs {
+		alias, isAlias := input.(*AliasOutput)
+		if !isAlias {
+			continue
+		}
+
+		if chained, chainedErr := alias.findChainedOutputAndCheckFork(transaction); chainedErr == nil && chained == nil {
+			if err = alias.validateDestroyTransitionNow(transaction.Essence().Timestamp()); err != nil {
+				return errors.WithMessagef(vm.ErrUnlockInvalid, "destruction of alias %s is not authorized: %s", alias.ID(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// nftInitialStateValid checks if the created nft outputs of the given Transaction continue consumed nft outputs.
+func nftInitialStateValid(inputs Outputs, transaction *Transaction) (err error) {
+	if !NFTInitialStateValid(inputs, transaction) {
+		return errors.WithMessagef(vm.ErrNFTStateInvalid, "created nft output neither continues a consumed nft output nor is minted by its issuer")
+	}
+
+	return nil
+}
+
+// depositsValid checks if the created outputs of the given Transaction satisfy the DustPolicy of the VM.
+func (d *VM) depositsValid(transaction *Transaction) (err error) {
+	if err = ValidateDeposits(d.DustPolicy(transaction.Timestamp()), transaction.Essence().Outputs()); err != nil {
+		return errors.WithMessagef(vm.ErrDustPolicyViolated, "created outputs violate the dust policy: %s", err)
+	}
+
+	return nil
+}
+
+// timelockedInput returns the first input that is still time locked at the timestamp of the given Transaction.
+func timelockedInput(inputs Outputs, transaction *Transaction) (timelockedOutput *ExtendedLockedOutput, timelocked bool) {
+	for _, input := range inputs {
+		if extendedLockedOutput, isExtendedLockedOutput := input.(*ExtendedLockedOutput); isExtendedLockedOutput && extendedLockedOutput.TimeLockedNow(transaction.Essence().Timestamp()) {
+			return extendedLockedOutput, true
+		}
+	}
+
+	return nil, false
+}
+
+var (
+	_ vm.TypedVM   = new(VM)
+	_ vm.TracingVM = new(VM)
+)
+
+// WithDustPolicy sets the DustPolicy that defines the minimum deposit of the created outputs (it decides about the
+// validity of Transactions, so all nodes of a network have to use the same DustPolicy).
+func WithDustPolicy(dustPolicy DustPolicy) options.Option[VM] {
+	return func(d *VM) {
+		d.optsDustPolicy = dustPolicy
+	}
+}
+
+// WithDustPolicyProvider sets the function that determines the DustPolicy from the timestamp of a Transaction (i.e. to
+// apply the scheduled upgrades of the protocol parameters). It takes precedence over the DustPolicy of WithDustPolicy.
+func WithDustPolicyProvider(dustPolicyProvider func(timestamp time.Time) DustPolicy) options.Option[VM] {
+	return func(d *VM) {
+		d.optsDustPolicyProvider = dustPolicyProvider
+	}
+}
+
+// WithAliasFundsRecovery sets whether the governing entity of an alias can destroy it while it holds more than the dust
+// minimum (it decides about the validity of Transactions, so all nodes of a network have to use the same setting).
+func WithAliasFundsRecovery(enabled bool) options.Option[VM] {
+	return func(d *VM) {
+		d.optsAliasFundsRecovery = enabled
+	}
+}
+
+// WithAliasFundsRecoveryProvider sets the function that determines from the timestamp of a Transaction whether funded
+// aliases can be destroyed with funds recovery (i.e. to apply the scheduled upgrades of the protocol parameters). It
+// takes precedence over the setting of WithAliasFundsRecovery.
+func WithAliasFundsRecoveryProvider(aliasFundsRecoveryProvider func(timestamp time.Time) bool) options.Option[VM] {
+	return func(d *VM) {
+		d.optsAliasFundsRecoveryProvider = aliasFundsRecoveryProvider
+	}
+}
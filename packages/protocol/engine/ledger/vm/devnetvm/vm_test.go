@@ -0,0 +1,112 @@
+package devnetvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestVM_ExecuteTransaction_ValidationErrors(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	address := NewED25519Address(keyPair.PublicKey)
+	now := time.Now()
+
+	execute := func(input Output, timestamp time.Time, outputBalance uint64, signer ed25519.KeyPair) error {
+		input.SetID(randOutputID())
+		essence := NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(NewSigLockedSingleOutput(outputBalance, randEd25119Address())))
+		signature := NewED25519Signature(signer.PublicKey, signer.PrivateKey.Sign(lo.PanicOnErr(essence.Bytes())))
+
+		_, _, err := NewVM().ExecuteTransaction(NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(signature)}), utxo.NewOutputs(input), vm.UnlimitedBudget)
+
+		return err
+	}
+
+	require.NoError(t, execute(NewSigLockedSingleOutput(1000, address), now, 1000, keyPair))
+
+	err := execute(NewSigLockedSingleOutput(1000, address), now, 999, keyPair)
+	require.ErrorIs(t, err, vm.ErrBalanceMismatch)
+	require.ErrorIs(t, err, ErrTransactionInvalid)
+
+	err = execute(NewSigLockedSingleOutput(1000, address), now, 1000, ed25519.GenerateKeyPair())
+	require.ErrorIs(t, err, vm.ErrUnlockInvalid)
+
+	timelockedOutput := NewExtendedLockedOutput(map[Color]uint64{ColorIOTA: 1000}, address).WithTimeLock(now.Add(time.Hour))
+	err = execute(timelockedOutput, now, 1000, keyPair)
+	require.ErrorIs(t, err, vm.ErrTimelocked)
+
+	validationError, exists := vm.ValidationErrorFromError(err)
+	require.True(t, exists)
+	require.Equal(t, "Timelocked", validationError.String())
+
+	require.NoError(t, execute(timelockedOutput, now.Add(time.Hour), 1000, keyPair))
+}
+
+func TestVM_ExecuteTransaction_AliasDestruction(t *testing.T) {
+	governor, stateController, recipient := genRandomWallet(), genRandomWallet(), genRandomWallet()
+
+	alias := dummyAliasOutput()
+	alias.balances = NewColoredBalances(map[Color]uint64{ColorIOTA: 1000, {8}: 5})
+	alias.stateAddress = stateController.address
+	alias.governingAddress = governor.address
+
+	recoveryVM := NewVM(WithAliasFundsRecovery(true))
+	execute := func(signer wallet, outputs ...Output) error {
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(alias.Input()), NewOutputs(outputs...))
+		_, _, err := recoveryVM.ExecuteTransaction(NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(signer.sign(essence))}), utxo.NewOutputs(alias), vm.UnlimitedBudget)
+
+		return err
+	}
+
+	// the governing address recovers the funds of the alias while destroying it
+	require.NoError(t, execute(governor, NewSigLockedColoredOutput(alias.Balances(), recipient.address)))
+
+	// the funds recovery is only possible once it was activated
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(alias.Input()), NewOutputs(NewSigLockedColoredOutput(alias.Balances(), recipient.address)))
+	_, _, err := NewVM(WithAliasFundsRecoveryProvider(func(timestamp time.Time) bool {
+		return timestamp.After(essence.Timestamp())
+	})).ExecuteTransaction(NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(governor.sign(essence))}), utxo.NewOutputs(alias), vm.UnlimitedBudget)
+	require.ErrorIs(t, err, vm.ErrUnlockInvalid)
+
+	// the state controller can not destroy the alias
+	require.ErrorIs(t, execute(stateController, NewSigLockedColoredOutput(alias.Balances(), recipient.address)), vm.ErrUnlockInvalid)
+
+	// the funds have to be recovered by a single output
+	require.ErrorIs(t, execute(governor,
+		NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 1000}), recipient.address),
+		NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{{8}: 5}), recipient.address),
+	), vm.ErrUnlockInvalid)
+}
+
+func TestVM_TraceTransaction(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	input := NewSigLockedSingleOutput(1000, NewED25519Address(keyPair.PublicKey))
+	input.SetID(randOutputID())
+
+	trace := func(signer ed25519.KeyPair) *vm.Trace {
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(NewSigLockedSingleOutput(1000, randEd25119Address())))
+		signature := NewED25519Signature(signer.PublicKey, signer.PrivateKey.Sign(lo.PanicOnErr(essence.Bytes())))
+		tx := NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(signature)})
+
+		trace := vm.NewTrace(tx.ID())
+		_, _, _ = NewVM().TraceTransaction(tx, utxo.NewOutputs(input), vm.UnlimitedBudget, trace)
+
+		return trace
+	}
+
+	validTrace := trace(keyPair)
+	require.NoError(t, validTrace.Err())
+	require.Len(t, validTrace.Steps, 7)
+
+	// the trace of a rejected transaction ends with the failed step
+	invalidTrace := trace(ed25519.GenerateKeyPair())
+	require.ErrorIs(t, invalidTrace.Err(), vm.ErrUnlockInvalid)
+	require.Len(t, invalidTrace.Steps, 2)
+	require.Equal(t, "unlock blocks", invalidTrace.Steps[1].Name)
+}
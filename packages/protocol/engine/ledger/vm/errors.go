@@ -0,0 +1,162 @@
+package vm
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrTransactionInvalid is returned if a Transaction is found to be invalid. Every ValidationError matches it (i.e.
+// errors.Is(validationError, ErrTransactionInvalid) holds).
+var ErrTransactionInvalid = errors.New("transaction invalid")
+
+// region ValidationError //////////////////////////////////////////////////////////////////////////////////////////////
+
+// ValidationError is a deterministic error code that describes why a Transaction is invalid. Every node derives the
+// same ValidationError for the same Transaction, so that APIs and wallets can react to it without matching the
+// (human-readable) error messages.
+type ValidationError uint8
+
+const (
+	// ErrValidationFailed is returned if a Transaction is invalid for a reason that has no dedicated ValidationError.
+	ErrValidationFailed ValidationError = iota + 1
+
+	// ErrInputsAlreadySpent is returned if the inputs of a Transaction are spent more than once within the same bundle.
+	ErrInputsAlreadySpent
+
+	// ErrInputsCausallyRelated is returned if a Transaction spends Outputs that reference each other.
+	ErrInputsCausallyRelated
+
+	// ErrBalanceMismatch is returned if the balances of the inputs and outputs of a Transaction do not match.
+	ErrBalanceMismatch
+
+	// ErrUnlockInvalid is returned if the unlock blocks of a Transaction do not authorize the spending of its inputs.
+	ErrUnlockInvalid
+
+	// ErrTimelocked is returned if a Transaction spends funds that are still time locked.
+	ErrTimelocked
+
+	// ErrAliasStateInvalid is returned if a Transaction creates an alias output with an invalid initial state.
+	ErrAliasStateInvalid
+
+	// ErrNFTStateInvalid is returned if a Transaction creates an NFT output that does not continue a consumed one.
+	ErrNFTStateInvalid
+
+	// ErrDustPolicyViolated is returned if the outputs of a Transaction violate the dust policy.
+	ErrDustPolicyViolated
+
+	// ErrBudgetExceeded is returned if the execution of a Transaction exceeds the execution Budget of the ledger.
+	ErrBudgetExceeded
+)
+
+// validationErrorNames contains the names that are used to serialize the ValidationErrors.
+var validationErrorNames = map[ValidationError]string{
+	ErrValidationFailed:      "ValidationFailed",
+	ErrInputsAlreadySpent:    "InputsAlreadySpent",
+	ErrInputsCausallyRelated: "InputsCausallyRelated",
+	ErrBalanceMismatch:       "BalanceMismatch",
+	ErrUnlockInvalid:         "UnlockInvalid",
+	ErrTimelocked:            "Timelocked",
+	ErrAliasStateInvalid:     "AliasStateInvalid",
+	ErrNFTStateInvalid:       "NFTStateInvalid",
+	ErrDustPolicyViolated:    "DustPolicyViolated",
+	ErrBudgetExceeded:        "BudgetExceeded",
+}
+
+// validationErrorMessages contains the messages of the ValidationErrors.
+var validationErrorMessages = map[ValidationError]string{
+	ErrValidationFailed:      "validation failed",
+	ErrInputsAlreadySpent:    "inputs already spent",
+	ErrInputsCausallyRelated: "inputs causally related",
+	ErrBalanceMismatch:       "balance mismatch",
+	ErrUnlockInvalid:         "unlock invalid",
+	ErrTimelocked:            "timelocked",
+	ErrAliasStateInvalid:     "alias state invalid",
+	ErrNFTStateInvalid:       "nft state invalid",
+	ErrDustPolicyViolated:    "dust policy violated",
+	ErrBudgetExceeded:        "execution budget exceeded",
+}
+
+// ValidationErrorFromString returns the ValidationError with the given name.
+func ValidationErrorFromString(name string) (validationError ValidationError, err error) {
+	for validationError, validationErrorName := range validationErrorNames {
+		if validationErrorName == name {
+			return validationError, nil
+		}
+	}
+
+	return 0, errors.Errorf("unknown validation error %q", name)
+}
+
+// ValidationErrorFromError returns the ValidationError that is contained in the chain of the given error.
+func ValidationErrorFromError(err error) (validationError ValidationError, exists bool) {
+	return validationError, errors.As(err, &validationError)
+}
+
+// WrapExecutionError makes sure that the given error of a failed execution contains a ValidationError (it falls back
+// to ErrValidationFailed if the VM did not provide a more specific one).
+func WrapExecutionError(err error) error {
+	if _, exists := ValidationErrorFromError(err); exists {
+		return err
+	}
+
+	return errors.WithMessage(ErrValidationFailed, err.Error())
+}
+
+// WithValidationError annotates the given error with the given ValidationError (so that errors.Is matches both).
+func WithValidationError(err error, validationError ValidationError) error {
+	return &annotatedError{
+		error:           err,
+		validationError: validationError,
+	}
+}
+
+// Error returns the message of the ValidationError.
+func (v ValidationError) Error() string {
+	if message, exists := validationErrorMessages[v]; exists {
+		return message
+	}
+
+	return validationErrorMessages[ErrValidationFailed]
+}
+
+// Is returns true if the given target is ErrTransactionInvalid.
+func (v ValidationError) Is(target error) bool {
+	return target == ErrTransactionInvalid
+}
+
+// String returns the name of the ValidationError.
+func (v ValidationError) String() string {
+	if name, exists := validationErrorNames[v]; exists {
+		return name
+	}
+
+	return validationErrorNames[ErrValidationFailed]
+}
+
+// MarshalText returns the name of the ValidationError.
+func (v ValidationError) MarshalText() (text []byte, err error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText parses the name of a ValidationError.
+func (v *ValidationError) UnmarshalText(text []byte) (err error) {
+	*v, err = ValidationErrorFromString(string(text))
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region annotatedError ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// annotatedError is an error that is annotated with a ValidationError.
+type annotatedError struct {
+	error
+	validationError ValidationError
+}
+
+// Unwrap returns the annotated error and its ValidationError.
+func (a *annotatedError) Unwrap() []error {
+	return []error{a.error, a.validationError}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
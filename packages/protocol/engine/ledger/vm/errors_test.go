@@ -0,0 +1,39 @@
+package vm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	executionErr := errors.Wrap(errors.WithMessage(ErrBalanceMismatch, "sum of balances is not 0"), "failed to execute transaction")
+	require.ErrorIs(t, executionErr, ErrBalanceMismatch)
+	require.ErrorIs(t, executionErr, ErrTransactionInvalid)
+	require.NotErrorIs(t, executionErr, ErrUnlockInvalid)
+
+	validationError, exists := ValidationErrorFromError(executionErr)
+	require.True(t, exists)
+	require.Equal(t, ErrBalanceMismatch, validationError)
+
+	validationErrorJSON, err := json.Marshal(validationError)
+	require.NoError(t, err)
+	require.Equal(t, `"BalanceMismatch"`, string(validationErrorJSON))
+
+	var unmarshaledValidationError ValidationError
+	require.NoError(t, json.Unmarshal(validationErrorJSON, &unmarshaledValidationError))
+	require.Equal(t, ErrBalanceMismatch, unmarshaledValidationError)
+	require.Error(t, json.Unmarshal([]byte(`"Unknown"`), &unmarshaledValidationError))
+
+	wrappedErr := WrapExecutionError(errors.New("execution failed"))
+	require.ErrorIs(t, wrappedErr, ErrValidationFailed)
+	require.Equal(t, executionErr, WrapExecutionError(executionErr))
+
+	sentinelErr := errors.New("bad request")
+	annotatedErr := WithValidationError(errors.WithMessage(sentinelErr, "transaction invalid"), ErrTimelocked)
+	require.ErrorIs(t, annotatedErr, sentinelErr)
+	require.ErrorIs(t, annotatedErr, ErrTimelocked)
+	require.Equal(t, "transaction invalid: bad request", annotatedErr.Error())
+}
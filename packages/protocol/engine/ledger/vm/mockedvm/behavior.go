@@ -0,0 +1,121 @@
+package mockedvm
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// Behavior describes how the MockedVM executes a specific MockedTransaction.
+type Behavior struct {
+	// ExecutionError contains the error that is returned instead of the outputs of the MockedTransaction.
+	ExecutionError error
+
+	// ExecutionDelay contains the duration that the execution of the MockedTransaction is delayed by.
+	ExecutionDelay time.Duration
+
+	// OutputBalances contains the balances of the created MockedOutputs (indexed by their output index).
+	OutputBalances map[uint16]uint64
+
+	// Nondeterministic contains a flag that indicates if every execution adds a different (seeded) random amount to the
+	// output balances.
+	Nondeterministic bool
+
+	// ExecutionGas contains the gas that the execution of the MockedTransaction consumes.
+	ExecutionGas uint64
+}
+
+// NewBehavior creates a new Behavior with the given options.
+func NewBehavior(opts ...options.Option[Behavior]) *Behavior {
+	return options.Apply(&Behavior{
+		OutputBalances: make(map[uint16]uint64),
+	}, opts)
+}
+
+// outputBalance returns the balance of the MockedOutput with the given index (defaults to the index itself).
+func (b *Behavior) outputBalance(index uint16) (balance uint64) {
+	if b != nil {
+		if balance, exists := b.OutputBalances[index]; exists {
+			return balance
+		}
+	}
+
+	return uint64(index)
+}
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithExecutionError is an Option for the Behavior that makes the execution of the MockedTransaction fail with the given
+// error.
+func WithExecutionError(err error) options.Option[Behavior] {
+	return func(b *Behavior) {
+		b.ExecutionError = err
+	}
+}
+
+// WithExecutionDelay is an Option for the Behavior that delays the execution of the MockedTransaction by the given
+// duration.
+func WithExecutionDelay(delay time.Duration) options.Option[Behavior] {
+	return func(b *Behavior) {
+		b.ExecutionDelay = delay
+	}
+}
+
+// WithExecutionGas is an Option for the Behavior that makes the execution of the MockedTransaction consume the given
+// amount of gas (the execution fails with vm.ErrBudgetExceeded if it exceeds the gas limit of the Budget).
+func WithExecutionGas(gas uint64) options.Option[Behavior] {
+	return func(b *Behavior) {
+		b.ExecutionGas = gas
+	}
+}
+
+// WithOutputBalances is an Option for the Behavior that defines the balances of the created MockedOutputs (the nth
+// balance is assigned to the nth output).
+func WithOutputBalances(balances ...uint64) options.Option[Behavior] {
+	return func(b *Behavior) {
+		for index, balance := range balances {
+			b.OutputBalances[uint16(index)] = balance
+		}
+	}
+}
+
+// WithNondeterministicOutputBalances is an Option for the Behavior that makes every execution of the
+// MockedTransaction add a different random amount to the output balances (the amounts are derived from the seed of the
+// MockedVM, so they can be replayed).
+func WithNondeterministicOutputBalances() options.Option[Behavior] {
+	return func(b *Behavior) {
+		b.Nondeterministic = true
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Execution ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Execution records a single execution of a MockedTransaction by the MockedVM.
+type Execution struct {
+	// TransactionID contains the ID of the executed MockedTransaction.
+	TransactionID utxo.TransactionID
+
+	// Attempt contains the number of previous executions of the MockedTransaction.
+	Attempt int
+
+	// Behavior contains the Behavior that was used for the execution (nil if the default behavior was used).
+	Behavior *Behavior
+
+	// Err contains the error that the execution failed with.
+	Err error
+
+	// OutputBalances contains the balances of the created MockedOutputs (nil if the execution failed).
+	OutputBalances []uint64
+
+	// Budget contains the Budget that the execution was started with.
+	Budget vm.Budget
+
+	// Cost contains the Cost that was consumed by the execution.
+	Cost vm.Cost
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
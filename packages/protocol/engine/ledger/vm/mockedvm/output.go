@@ -5,6 +5,10 @@ import (
 	"github.com/iotaledger/hive.go/objectstorage/generic/model"
 )
 
+// MockedOutputType is the type of the MockedOutputs (it is outside the range of the types of the devnet VM, so that
+// both VMs can be registered in the same vm.Registry).
+const MockedOutputType uint8 = 128
+
 // MockedOutput is the container for the data produced by executing a MockedTransaction.
 type MockedOutput struct {
 	model.Storable[utxo.OutputID, MockedOutput, *MockedOutput, mockedOutput] `serix:"0"`
@@ -32,5 +36,13 @@ func NewMockedOutput(txID utxo.TransactionID, index uint16, balance uint64) (out
 	return out
 }
 
+// Balance returns the balance of the MockedOutput.
+func (m *MockedOutput) Balance() (balance uint64) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.M.Balance
+}
+
 // code contract (make sure the struct implements all required methods).
 var _ utxo.Output = new(MockedOutput)
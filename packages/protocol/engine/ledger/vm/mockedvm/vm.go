@@ -2,23 +2,49 @@ package mockedvm
 
 import (
 	"context"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
-	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payloadtype"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
 )
 
 // MockedVM is an implementation of UTXO-based VMs for testing purposes.
-type MockedVM struct{}
+type MockedVM struct {
+	// behaviorScripts contains the sequences of Behaviors that were configured for specific MockedTransactions (the nth
+	// execution of a MockedTransaction uses the nth Behavior and the last Behavior is used for all further executions).
+	behaviorScripts map[utxo.TransactionID][]*Behavior
+
+	// executions contains the Executions of the MockedTransactions (in the order they happened).
+	executions map[utxo.TransactionID][]*Execution
+
+	// behaviorsMutex contains a mutex that is used to synchronize parallel access to the behaviors and executions.
+	behaviorsMutex sync.RWMutex
+
+	// optsSeed contains the seed that is used to derive the output balances of nondeterministic executions.
+	optsSeed int64
+}
 
 // NewMockedVM creates a new MockedVM.
-func NewMockedVM() *MockedVM {
-	return new(MockedVM)
+func NewMockedVM(opts ...options.Option[MockedVM]) *MockedVM {
+	return options.Apply(new(MockedVM), opts)
+}
+
+// Types returns the types of the Transactions, Inputs and Outputs of the MockedVM.
+func (m *MockedVM) Types() (types vm.Types) {
+	return vm.Types{
+		TransactionType: MockedTransactionType,
+		Input:           new(MockedInput),
+		OutputTypes:     []uint8{MockedOutputType},
+	}
 }
 
 // ParseTransaction un-serializes a Transaction from the given sequence of bytes.
@@ -47,21 +73,174 @@ func (m *MockedVM) ResolveInput(input utxo.Input) (outputID utxo.OutputID) {
 }
 
 // ExecuteTransaction executes the Transaction and determines the Outputs from the given Inputs. It returns an error
-// if the execution fails.
-func (m *MockedVM) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, _ ...uint64) (outputs []utxo.Output, err error) {
+// if the execution fails or exceeds the given Budget (the gas of the execution is defined by its Behavior).
+func (m *MockedVM) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget) (outputs []utxo.Output, cost vm.Cost, err error) {
+	return m.TraceTransaction(transaction, inputs, budget, nil)
+}
+
+// TraceTransaction executes the Transaction like ExecuteTransaction and records its validation steps in the given Trace.
+func (m *MockedVM) TraceTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget, trace *vm.Trace) (outputs []utxo.Output, cost vm.Cost, err error) {
 	mockedTransaction := transaction.(*MockedTransaction)
 
+	execution := m.startExecution(mockedTransaction.ID(), budget)
+	if behavior := execution.Behavior; behavior != nil {
+		time.Sleep(behavior.ExecutionDelay)
+
+		if err = trace.Step("behavior", behavior.ExecutionError); err != nil {
+			m.completeExecution(execution, nil, cost, err)
+
+			return nil, cost, err
+		}
+
+		cost.Gas = behavior.ExecutionGas
+	}
+
+	outputBalances := m.outputBalances(execution, mockedTransaction.M.OutputCount)
+
 	outputs = make([]utxo.Output, mockedTransaction.M.OutputCount)
 	for i := uint16(0); i < mockedTransaction.M.OutputCount; i++ {
-		outputs[i] = NewMockedOutput(mockedTransaction.ID(), i, uint64(i))
+		outputs[i] = NewMockedOutput(mockedTransaction.ID(), i, outputBalances[i])
 		outputs[i].SetID(utxo.NewOutputID(mockedTransaction.ID(), i))
 	}
 
-	return
+	if cost.Size, err = vm.OutputsSize(outputs); err == nil {
+		err = trace.Step("budget", budget.Check(cost))
+	}
+	if err != nil {
+		m.completeExecution(execution, nil, cost, err)
+
+		return nil, cost, err
+	}
+
+	m.completeExecution(execution, outputBalances, cost, nil)
+
+	return outputs, cost, nil
+}
+
+// SetBehavior configures how the MockedTransaction with the given ID is executed (it replaces previous Behaviors).
+func (m *MockedVM) SetBehavior(txID utxo.TransactionID, opts ...options.Option[Behavior]) {
+	m.SetBehaviorScript(txID, NewBehavior(opts...))
+}
+
+// SetBehaviorScript configures how the consecutive executions of the MockedTransaction with the given ID behave (the
+// nth execution uses the nth Behavior and the last Behavior is used for all further executions). It replaces previous
+// Behaviors and restarts the script with the next execution.
+func (m *MockedVM) SetBehaviorScript(txID utxo.TransactionID, behaviors ...*Behavior) {
+	m.behaviorsMutex.Lock()
+	defer m.behaviorsMutex.Unlock()
+
+	if m.behaviorScripts == nil {
+		m.behaviorScripts = make(map[utxo.TransactionID][]*Behavior)
+	}
+
+	m.behaviorScripts[txID] = behaviors
+	delete(m.executions, txID)
+}
+
+// Behavior returns the Behavior of the next execution of the MockedTransaction with the given ID (nil if it succeeds).
+func (m *MockedVM) Behavior(txID utxo.TransactionID) (behavior *Behavior) {
+	m.behaviorsMutex.RLock()
+	defer m.behaviorsMutex.RUnlock()
+
+	return m.behavior(txID, len(m.executions[txID]))
 }
 
+// ResetBehavior removes the Behavior of the MockedTransaction with the given ID so that its execution succeeds again.
+func (m *MockedVM) ResetBehavior(txID utxo.TransactionID) {
+	m.behaviorsMutex.Lock()
+	defer m.behaviorsMutex.Unlock()
+
+	delete(m.behaviorScripts, txID)
+}
+
+// Executions returns the Executions of the MockedTransaction with the given ID (since its Behavior was last set).
+func (m *MockedVM) Executions(txID utxo.TransactionID) (executions []*Execution) {
+	m.behaviorsMutex.RLock()
+	defer m.behaviorsMutex.RUnlock()
+
+	return append(executions, m.executions[txID]...)
+}
+
+// startExecution records and returns a new Execution of the MockedTransaction with the given ID.
+func (m *MockedVM) startExecution(txID utxo.TransactionID, budget vm.Budget) (execution *Execution) {
+	m.behaviorsMutex.Lock()
+	defer m.behaviorsMutex.Unlock()
+
+	if m.executions == nil {
+		m.executions = make(map[utxo.TransactionID][]*Execution)
+	}
+
+	execution = &Execution{
+		TransactionID: txID,
+		Attempt:       len(m.executions[txID]),
+		Behavior:      m.behavior(txID, len(m.executions[txID])),
+		Budget:        budget,
+	}
+	m.executions[txID] = append(m.executions[txID], execution)
+
+	return execution
+}
+
+// completeExecution records the result of the given Execution.
+func (m *MockedVM) completeExecution(execution *Execution, outputBalances []uint64, cost vm.Cost, err error) {
+	m.behaviorsMutex.Lock()
+	defer m.behaviorsMutex.Unlock()
+
+	execution.OutputBalances = outputBalances
+	execution.Cost = cost
+	execution.Err = err
+}
+
+// behavior returns the Behavior of the given execution attempt of the MockedTransaction with the given ID.
+func (m *MockedVM) behavior(txID utxo.TransactionID, attempt int) (behavior *Behavior) {
+	behaviorScript := m.behaviorScripts[txID]
+	if len(behaviorScript) == 0 {
+		return nil
+	}
+
+	if attempt >= len(behaviorScript) {
+		return behaviorScript[len(behaviorScript)-1]
+	}
+
+	return behaviorScript[attempt]
+}
+
+// outputBalances returns the balances of the outputs that are created by the given Execution. The balances of
+// nondeterministic executions only depend on the seed of the MockedVM, the TransactionID and the attempt, so that the
+// same executions can be replayed by a MockedVM with the same seed.
+func (m *MockedVM) outputBalances(execution *Execution, outputCount uint16) (balances []uint64) {
+	var random *rand.Rand
+	if execution.Behavior != nil && execution.Behavior.Nondeterministic {
+		random = rand.New(rand.NewSource(m.optsSeed ^ int64(binary.LittleEndian.Uint64(execution.TransactionID.Identifier[:8])) ^ int64(execution.Attempt)))
+	}
+
+	balances = make([]uint64, outputCount)
+	for i := uint16(0); i < outputCount; i++ {
+		if balances[i] = execution.Behavior.outputBalance(i); random != nil {
+			balances[i] += uint64(random.Int63n(nondeterministicBalanceRange))
+		}
+	}
+
+	return balances
+}
+
+// nondeterministicBalanceRange defines the range of the random amounts that are added to the output balances of
+// nondeterministic executions.
+const nondeterministicBalanceRange = 1000
+
 // code contract (make sure the struct implements all required methods).
-var _ vm.VM = new(MockedVM)
+var (
+	_ vm.TypedVM   = new(MockedVM)
+	_ vm.TracingVM = new(MockedVM)
+)
+
+// WithSeed is an Option for the MockedVM that sets the seed that is used to derive the output balances of
+// nondeterministic executions (MockedVMs with the same seed replay the same executions).
+func WithSeed(seed int64) options.Option[MockedVM] {
+	return func(m *MockedVM) {
+		m.optsSeed = seed
+	}
+}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -76,8 +255,8 @@ func init() {
 		panic(errors.Wrap(err, "error registering Transaction as Payload interface"))
 	}
 
-	if err := serix.DefaultAPI.RegisterTypeSettings(MockedOutput{}, serix.TypeSettings{}.WithObjectType(uint8(devnetvm.ExtendedLockedOutputType+1))); err != nil {
-		panic(errors.Wrap(err, "error registering ExtendedLockedOutput type settings"))
+	if err := serix.DefaultAPI.RegisterTypeSettings(MockedOutput{}, serix.TypeSettings{}.WithObjectType(MockedOutputType)); err != nil {
+		panic(errors.Wrap(err, "error registering MockedOutput type settings"))
 	}
 
 	if err := serix.DefaultAPI.RegisterInterfaceObjects((*utxo.Output)(nil), new(MockedOutput)); err != nil {
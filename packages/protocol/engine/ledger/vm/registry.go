@@ -0,0 +1,235 @@
+package vm
+
+import (
+	"encoding/binary"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+)
+
+var (
+	// ErrTypeConflict is returned if a VM is registered whose types are already used by another registered VM.
+	ErrTypeConflict = errors.New("type is already registered")
+
+	// ErrUnknownType is returned if the Registry has no VM for the type of a Transaction, Input or Output.
+	ErrUnknownType = errors.New("no VM registered for type")
+)
+
+// region Types ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Types contains the types of the Transactions, Inputs and Outputs of a VM, that are used by the Registry to dispatch
+// to the VM.
+type Types struct {
+	// TransactionType is the payload type of the Transactions of the VM (the prefix of their serialized form).
+	TransactionType payload.Type
+
+	// Input is an instance of the Input type of the VM.
+	Input utxo.Input
+
+	// OutputTypes contains the types of the Outputs of the VM (the first byte of their serialized form).
+	OutputTypes []uint8
+}
+
+// TypedVM is a VM that can be registered in a Registry.
+type TypedVM interface {
+	VM
+
+	// Types returns the types of the Transactions, Inputs and Outputs of the VM.
+	Types() (types Types)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Registry /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Registry is a VM that dispatches to the registered VMs, based on the types of the Transactions, Inputs and Outputs.
+// It allows to run multiple VMs (i.e. the devnet VM and an experimental smart contract VM) on the same ledger.
+type Registry struct {
+	vms                  []TypedVM
+	vmsByTransactionType map[payload.Type]TypedVM
+	vmsByInputType       map[reflect.Type]TypedVM
+	vmsByOutputType      map[uint8]TypedVM
+	mutex                sync.RWMutex
+}
+
+// NewRegistry creates a new Registry with the given VMs (it panics if their types conflict).
+func NewRegistry(vms ...TypedVM) (registry *Registry) {
+	registry = &Registry{
+		vms:                  make([]TypedVM, 0),
+		vmsByTransactionType: make(map[payload.Type]TypedVM),
+		vmsByInputType:       make(map[reflect.Type]TypedVM),
+		vmsByOutputType:      make(map[uint8]TypedVM),
+	}
+
+	for _, vm := range vms {
+		if err := registry.Register(vm); err != nil {
+			panic(err)
+		}
+	}
+
+	return registry
+}
+
+// Register registers the given VM. It returns an ErrTypeConflict (and does not register the VM) if any of its types is
+// already used by another registered VM.
+func (r *Registry) Register(vm TypedVM) (err error) {
+	types := vm.Types()
+	if types.Input == nil {
+		return errors.Errorf("%T does not define its Input type", vm)
+	}
+	inputType := reflect.TypeOf(types.Input)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if registeredVM, exists := r.vmsByTransactionType[types.TransactionType]; exists {
+		return errors.WithMessagef(ErrTypeConflict, "transaction type %s of %T is used by %T", types.TransactionType, vm, registeredVM)
+	}
+	if registeredVM, exists := r.vmsByInputType[inputType]; exists {
+		return errors.WithMessagef(ErrTypeConflict, "input type %s of %T is used by %T", inputType, vm, registeredVM)
+	}
+	for _, outputType := range types.OutputTypes {
+		if registeredVM, exists := r.vmsByOutputType[outputType]; exists {
+			return errors.WithMessagef(ErrTypeConflict, "output type %d of %T is used by %T", outputType, vm, registeredVM)
+		}
+	}
+
+	r.vms = append(r.vms, vm)
+	r.vmsByTransactionType[types.TransactionType] = vm
+	r.vmsByInputType[inputType] = vm
+	for _, outputType := range types.OutputTypes {
+		r.vmsByOutputType[outputType] = vm
+	}
+
+	return nil
+}
+
+// VMs returns the registered VMs in the order of their registration.
+func (r *Registry) VMs() (vms []TypedVM) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make([]TypedVM, 0, len(r.vms)), r.vms...)
+}
+
+// VM returns the registered VM that executes the Transactions of the given type.
+func (r *Registry) VM(transactionType payload.Type) (vm TypedVM, exists bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	vm, exists = r.vmsByTransactionType[transactionType]
+
+	return vm, exists
+}
+
+// FreeOutputType returns the smallest Output type that is not used by any of the registered VMs.
+func (r *Registry) FreeOutputType() (outputType uint8, err error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for candidate := 0; candidate <= 0xff; candidate++ {
+		if _, exists := r.vmsByOutputType[uint8(candidate)]; !exists {
+			return uint8(candidate), nil
+		}
+	}
+
+	return 0, errors.New("all output types are in use")
+}
+
+// ExecuteTransaction executes the Transaction with the VM that is registered for its type.
+func (r *Registry) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget Budget) (outputs []utxo.Output, cost Cost, err error) {
+	return r.TraceTransaction(transaction, inputs, budget, nil)
+}
+
+// TraceTransaction executes the Transaction with the VM that is registered for its type and records its validation
+// steps in the given Trace (VMs that do not support tracing record their execution as a single step).
+func (r *Registry) TraceTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget Budget, trace *Trace) (outputs []utxo.Output, cost Cost, err error) {
+	typedTransaction, isTyped := transaction.(interface{ Type() payload.Type })
+	if !isTyped {
+		return nil, cost, trace.Step("transaction type", errors.Errorf("%T does not expose its type", transaction))
+	}
+
+	vm, exists := r.VM(typedTransaction.Type())
+	if !exists {
+		return nil, cost, trace.Step("transaction type", errors.WithMessagef(ErrUnknownType, "transaction type %s", typedTransaction.Type()))
+	}
+
+	return Execute(vm, transaction, inputs, budget, trace)
+}
+
+// ParseTransaction un-serializes a Transaction with the VM that is registered for the type prefix of the given bytes.
+func (r *Registry) ParseTransaction(transactionBytes []byte) (transaction utxo.Transaction, err error) {
+	if len(transactionBytes) < 4 {
+		return nil, errors.Errorf("not enough bytes to read the transaction type (%d bytes)", len(transactionBytes))
+	}
+	transactionType := payload.Type(binary.LittleEndian.Uint32(transactionBytes))
+
+	vm, exists := r.VM(transactionType)
+	if !exists {
+		return nil, errors.WithMessagef(ErrUnknownType, "transaction type %s", transactionType)
+	}
+
+	return vm.ParseTransaction(transactionBytes)
+}
+
+// ParseOutput un-serializes an Output with the VM that is registered for the type byte of the given bytes.
+func (r *Registry) ParseOutput(outputBytes []byte) (output utxo.Output, err error) {
+	if len(outputBytes) == 0 {
+		return nil, errors.New("not enough bytes to read the output type")
+	}
+
+	r.mutex.RLock()
+	vm, exists := r.vmsByOutputType[outputBytes[0]]
+	r.mutex.RUnlock()
+
+	if !exists {
+		return nil, errors.WithMessagef(ErrUnknownType, "output type %d", outputBytes[0])
+	}
+
+	return vm.ParseOutput(outputBytes)
+}
+
+// ResolveInput translates the Input into an OutputID with the VM that is registered for its type (it panics if there
+// is none, since the Input can only stem from a Transaction that was parsed by a registered VM).
+func (r *Registry) ResolveInput(input utxo.Input) (outputID utxo.OutputID) {
+	r.mutex.RLock()
+	vm, exists := r.vmsByInputType[reflect.TypeOf(input)]
+	r.mutex.RUnlock()
+
+	if !exists {
+		panic(errors.WithMessagef(ErrUnknownType, "input type %T", input))
+	}
+
+	return vm.ResolveInput(input)
+}
+
+// code contract (make sure the struct implements all required methods).
+var _ TracingVM = new(Registry)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// Lookup returns the VM of the given type, which is either the given VM itself or one of the VMs that are registered in
+// it (if it is a Registry).
+func Lookup[T VM](vm VM) (typedVM T, exists bool) {
+	if typedVM, exists = vm.(T); exists {
+		return typedVM, true
+	}
+
+	if registry, isRegistry := vm.(*Registry); isRegistry {
+		for _, registeredVM := range registry.VMs() {
+			if typedVM, exists = registeredVM.(T); exists {
+				return typedVM, true
+			}
+		}
+	}
+
+	return typedVM, false
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,92 @@
+package vm_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestRegistry(t *testing.T) {
+	devnetVM := devnetvm.NewVM()
+	mockedVM := mockedvm.NewMockedVM()
+	registry := vm.NewRegistry(devnetVM, mockedVM)
+
+	t.Run("Conflicts", func(t *testing.T) {
+		require.ErrorIs(t, registry.Register(mockedvm.NewMockedVM()), vm.ErrTypeConflict)
+		require.Len(t, registry.VMs(), 2)
+
+		require.Panics(t, func() {
+			vm.NewRegistry(devnetVM, devnetvm.NewVM())
+		})
+	})
+
+	t.Run("FreeOutputType", func(t *testing.T) {
+		freeOutputType, err := registry.FreeOutputType()
+		require.NoError(t, err)
+		require.Equal(t, uint8(len(devnetvm.RegisteredOutputTypes())), freeOutputType)
+	})
+
+	t.Run("Transactions", func(t *testing.T) {
+		genesisOutputID := utxo.NewOutputID(utxo.EmptyTransactionID, 0)
+		tx := mockedvm.NewMockedTransaction([]*mockedvm.MockedInput{mockedvm.NewMockedInput(genesisOutputID)}, 2)
+
+		parsedTx, err := registry.ParseTransaction(lo.PanicOnErr(tx.Bytes()))
+		require.NoError(t, err)
+		require.IsType(t, new(mockedvm.MockedTransaction), parsedTx)
+		parsedTx.SetID(tx.ID())
+
+		require.Equal(t, genesisOutputID, registry.ResolveInput(parsedTx.Inputs()[0]))
+
+		outputs, _, err := registry.ExecuteTransaction(parsedTx, utxo.NewOutputs(), vm.UnlimitedBudget)
+		require.NoError(t, err)
+		require.Len(t, outputs, 2)
+		require.Len(t, mockedVM.Executions(tx.ID()), 1)
+
+		devnetTx := devnetvm.NewTransaction(devnetvm.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+			devnetvm.NewInputs(devnetvm.NewUTXOInput(genesisOutputID)),
+			devnetvm.NewOutputs(devnetvm.NewSigLockedSingleOutput(1337, devnetvm.NewED25519Address(ed25519.PublicKey{}))),
+		), devnetvm.UnlockBlocks{devnetvm.NewReferenceUnlockBlock(0)})
+
+		parsedTx, err = registry.ParseTransaction(lo.PanicOnErr(devnetTx.Bytes()))
+		require.NoError(t, err)
+		require.IsType(t, new(devnetvm.Transaction), parsedTx)
+		require.Equal(t, genesisOutputID, registry.ResolveInput(parsedTx.Inputs()[0]))
+
+		_, err = registry.ParseTransaction([]byte{0xff, 0xff, 0xff, 0xff})
+		require.ErrorIs(t, err, vm.ErrUnknownType)
+	})
+
+	t.Run("Outputs", func(t *testing.T) {
+		devnetOutput := devnetvm.NewSigLockedSingleOutput(1337, devnetvm.NewED25519Address(ed25519.PublicKey{}))
+		devnetOutput.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, 1))
+
+		parsedOutput, err := registry.ParseOutput(lo.PanicOnErr(devnetOutput.Bytes()))
+		require.NoError(t, err)
+		require.IsType(t, new(devnetvm.SigLockedSingleOutput), parsedOutput)
+
+		mockedOutput := mockedvm.NewMockedOutput(utxo.EmptyTransactionID, 2, 1337)
+		parsedOutput, err = registry.ParseOutput(lo.PanicOnErr(mockedOutput.Bytes()))
+		require.NoError(t, err)
+		require.IsType(t, new(mockedvm.MockedOutput), parsedOutput)
+
+		_, err = registry.ParseOutput([]byte{0xff})
+		require.ErrorIs(t, err, vm.ErrUnknownType)
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		require.Equal(t, devnetVM, lo.Return1(vm.Lookup[*devnetvm.VM](registry)))
+		require.Equal(t, mockedVM, lo.Return1(vm.Lookup[*mockedvm.MockedVM](mockedVM)))
+
+		_, exists := vm.Lookup[*mockedvm.MockedVM](devnetVM)
+		require.False(t, exists)
+	})
+}
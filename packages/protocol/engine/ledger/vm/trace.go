@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+// region TracingVM ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TracingVM is a VM that can record the intermediate validation steps of the execution of a Transaction in a Trace.
+type TracingVM interface {
+	VM
+
+	// TraceTransaction executes the Transaction like ExecuteTransaction and records its validation steps in the given
+	// Trace (the Trace is optional, so nil disables the tracing).
+	TraceTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget Budget, trace *Trace) (outputs []utxo.Output, cost Cost, err error)
+}
+
+// Execute executes the Transaction with the given VM and records its validation steps in the given Trace (VMs that do
+// not implement the TracingVM interface record their execution as a single step).
+func Execute(vm VM, transaction utxo.Transaction, inputs *utxo.Outputs, budget Budget, trace *Trace) (outputs []utxo.Output, cost Cost, err error) {
+	if tracingVM, isTracingVM := vm.(TracingVM); isTracingVM {
+		return tracingVM.TraceTransaction(transaction, inputs, budget, trace)
+	}
+
+	outputs, cost, err = vm.ExecuteTransaction(transaction, inputs, budget)
+
+	return outputs, cost, trace.Step("execution", err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Trace ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Trace records the resolved Inputs, the intermediate validation steps and the produced Outputs of the processing of a
+// Transaction. It only contains deterministic information, so that the Traces of different nodes can be compared to
+// debug why a Transaction was rejected.
+type Trace struct {
+	// TransactionID contains the ID of the traced Transaction.
+	TransactionID utxo.TransactionID
+
+	// InputIDs contains the OutputIDs that are referenced by the Inputs of the Transaction.
+	InputIDs []utxo.OutputID
+
+	// Inputs contains the consumed Outputs that were available when the Transaction was processed.
+	Inputs []utxo.Output
+
+	// Steps contains the validation steps in the order they were executed.
+	Steps []*TraceStep
+
+	// Outputs contains the Outputs that were created by the Transaction (empty if it was not executed successfully).
+	Outputs []utxo.Output
+
+	// Cost contains the resources that were consumed by the execution of the Transaction.
+	Cost Cost
+}
+
+// NewTrace creates a new empty Trace for the Transaction with the given ID.
+func NewTrace(txID utxo.TransactionID) *Trace {
+	return &Trace{
+		TransactionID: txID,
+		InputIDs:      make([]utxo.OutputID, 0),
+		Inputs:        make([]utxo.Output, 0),
+		Steps:         make([]*TraceStep, 0),
+		Outputs:       make([]utxo.Output, 0),
+	}
+}
+
+// Step records a validation step with the given name and result and returns the result (it does nothing if the Trace
+// is nil, so that VMs can record their steps unconditionally).
+func (t *Trace) Step(name string, err error) error {
+	if t != nil {
+		t.Steps = append(t.Steps, &TraceStep{
+			Name: name,
+			Err:  err,
+		})
+	}
+
+	return err
+}
+
+// RecordInputs records the OutputIDs that are referenced by the Inputs and the consumed Outputs that are available.
+func (t *Trace) RecordInputs(inputIDs utxo.OutputIDs, inputs *utxo.Outputs) {
+	if t == nil {
+		return
+	}
+
+	t.InputIDs = inputIDs.Slice()
+	t.Inputs = make([]utxo.Output, 0, len(t.InputIDs))
+	for _, inputID := range t.InputIDs {
+		if input, exists := inputs.Get(inputID); exists {
+			t.Inputs = append(t.Inputs, input)
+		}
+	}
+}
+
+// RecordOutputs records the Outputs that were created by the execution and the Cost of the execution.
+func (t *Trace) RecordOutputs(outputs []utxo.Output, cost Cost) {
+	if t == nil {
+		return
+	}
+
+	t.Outputs = append(make([]utxo.Output, 0, len(outputs)), outputs...)
+	t.Cost = cost
+}
+
+// Err returns the error of the first failed validation step (nil if all steps succeeded).
+func (t *Trace) Err() (err error) {
+	for _, step := range t.Steps {
+		if step.Err != nil {
+			return step.Err
+		}
+	}
+
+	return nil
+}
+
+// String returns a human-readable version of the Trace.
+func (t *Trace) String() (humanReadable string) {
+	structBuilder := stringify.NewStructBuilder("Trace")
+	structBuilder.AddField(stringify.NewStructField("TransactionID", t.TransactionID))
+	structBuilder.AddField(stringify.NewStructField("InputIDs", t.InputIDs))
+	structBuilder.AddField(stringify.NewStructField("Steps", t.Steps))
+	structBuilder.AddField(stringify.NewStructField("Outputs", len(t.Outputs)))
+	structBuilder.AddField(stringify.NewStructField("Cost", t.Cost))
+
+	return structBuilder.String()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TraceStep ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TraceStep is a single validation step of the processing of a Transaction.
+type TraceStep struct {
+	// Name contains the name of the validation step.
+	Name string
+
+	// Err contains the error that the validation step failed with (nil if it succeeded).
+	Err error
+}
+
+// String returns a human-readable version of the TraceStep.
+func (t *TraceStep) String() (humanReadable string) {
+	if t.Err != nil {
+		return t.Name + ": " + t.Err.Error()
+	}
+
+	return t.Name + ": ok"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
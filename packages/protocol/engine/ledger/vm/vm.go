@@ -1,14 +1,18 @@
 package vm
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 )
 
 // VM is a generic interface for UTXO-based VMs.
 type VM interface {
 	// ExecuteTransaction executes the Transaction and determines the Outputs from the given Inputs. It returns an error
-	// if the execution fails.
-	ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, gasLimit ...uint64) (outputs []utxo.Output, err error)
+	// if the execution fails (which marks the Transaction as invalid). The execution has to be deterministic, since
+	// every node has to derive the same Outputs (or error) from the same Transaction and Inputs. The execution has to
+	// abort with ErrBudgetExceeded as soon as it exceeds the given Budget and returns the Cost that it consumed.
+	ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget Budget) (outputs []utxo.Output, cost Cost, err error)
 
 	// ParseTransaction un-serializes a Transaction from the given sequence of bytes.
 	ParseTransaction([]byte) (transaction utxo.Transaction, err error)
@@ -19,3 +23,13 @@ type VM interface {
 	// ResolveInput translates the Input into an OutputID.
 	ResolveInput(input utxo.Input) (outputID utxo.OutputID)
 }
+
+// TimestampedTransaction is implemented by the Transactions that carry their issuing time. The protocol parameters of
+// the slot of that time apply to their execution (Transactions without a timestamp are executed with the parameters of
+// the genesis slot).
+type TimestampedTransaction interface {
+	utxo.Transaction
+
+	// Timestamp returns the issuing time of the Transaction.
+	Timestamp() time.Time
+}
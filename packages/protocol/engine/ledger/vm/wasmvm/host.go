@@ -0,0 +1,183 @@
+package wasmvm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+)
+
+// ErrOutOfGas is returned if the execution of an unlock script exceeds the gas limit.
+var ErrOutOfGas = errors.New("out of gas")
+
+// hostModule contains the name of the only module that the unlock scripts can import functions from.
+const hostModule = "env"
+
+const (
+	// hostCallGas is the gas that is charged for every call of a host function.
+	hostCallGas int64 = 10
+
+	// ed25519VerifyGas is the gas that is charged for the verification of a signature (in addition to the hostCallGas).
+	ed25519VerifyGas int64 = 1000
+)
+
+// region execution ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// execution contains the context of the execution of the unlock script of a consumed Output, that is exposed to the
+// script through the host functions.
+type execution struct {
+	transaction    *Transaction
+	inputIndex     int
+	consumedOutput *Output
+	signingMessage [32]byte
+	gas            api.MutableGlobal
+}
+
+// executionKey is the key of the execution in the context of the calls of the host functions.
+type executionKey struct{}
+
+// executionFromContext returns the execution of the given context.
+func executionFromContext(ctx context.Context) *execution {
+	return ctx.Value(executionKey{}).(*execution)
+}
+
+// charge subtracts the given cost from the remaining gas of the execution (and aborts the execution if it runs out of
+// gas).
+func (e *execution) charge(cost int64) {
+	remaining := int64(e.gas.Get()) - cost
+	e.gas.Set(uint64(remaining))
+
+	if remaining < 0 {
+		panic(ErrOutOfGas)
+	}
+}
+
+// write charges the execution for the given bytes and writes them to the memory of the script at the given offset.
+func (e *execution) write(module api.Module, offset uint32, data []byte) {
+	e.charge(int64(len(data)))
+
+	if module.Memory() == nil || !module.Memory().Write(offset, data) {
+		panic(errors.Errorf("failed to write %d bytes at offset %d", len(data), offset))
+	}
+}
+
+// read charges the execution for the given number of bytes and reads them from the memory of the script.
+func (e *execution) read(module api.Module, offset, length uint32) (data []byte) {
+	e.charge(int64(length))
+
+	if module.Memory() == nil {
+		panic(errors.New("script does not export a memory"))
+	}
+
+	data, ok := module.Memory().Read(offset, length)
+	if !ok {
+		panic(errors.Errorf("failed to read %d bytes at offset %d", length, offset))
+	}
+
+	return data
+}
+
+// createdOutput returns the specification of the created Output with the given index.
+func (e *execution) createdOutput(index uint32) (output *OutputSpec) {
+	if outputs := e.transaction.Outputs(); int(index) < len(outputs) {
+		return outputs[index]
+	}
+
+	panic(errors.Errorf("output index %d out of range", index))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region host functions ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// hostFunction is a function that can be imported by the unlock scripts.
+type hostFunction struct {
+	name    string
+	params  []api.ValueType
+	results []api.ValueType
+	call    func(e *execution, module api.Module, stack []uint64)
+}
+
+var (
+	i32 = api.ValueTypeI32
+	i64 = api.ValueTypeI64
+)
+
+// hostFunctions contains the functions that can be imported by the unlock scripts (from the hostModule). They only
+// expose the Transaction and the consumed Output, so that the execution only depends on the ledger state.
+var hostFunctions = []*hostFunction{
+	{"input_index", nil, []api.ValueType{i32}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = uint64(e.inputIndex)
+	}},
+	{"witness_len", nil, []api.ValueType{i32}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = uint64(len(e.transaction.M.Inputs[e.inputIndex].Witness))
+	}},
+	{"witness_read", []api.ValueType{i32}, nil, func(e *execution, module api.Module, stack []uint64) {
+		e.write(module, uint32(stack[0]), e.transaction.M.Inputs[e.inputIndex].Witness)
+	}},
+	{"balance", nil, []api.ValueType{i64}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = e.consumedOutput.Balance()
+	}},
+	{"data_len", nil, []api.ValueType{i32}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = uint64(len(e.consumedOutput.Data()))
+	}},
+	{"data_read", []api.ValueType{i32}, nil, func(e *execution, module api.Module, stack []uint64) {
+		e.write(module, uint32(stack[0]), e.consumedOutput.Data())
+	}},
+	{"signing_message_read", []api.ValueType{i32}, nil, func(e *execution, module api.Module, stack []uint64) {
+		e.write(module, uint32(stack[0]), e.signingMessage[:])
+	}},
+	{"output_count", nil, []api.ValueType{i32}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = uint64(len(e.transaction.Outputs()))
+	}},
+	{"output_balance", []api.ValueType{i32}, []api.ValueType{i64}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = e.createdOutput(uint32(stack[0])).Balance
+	}},
+	{"output_data_len", []api.ValueType{i32}, []api.ValueType{i32}, func(e *execution, _ api.Module, stack []uint64) {
+		stack[0] = uint64(len(e.createdOutput(uint32(stack[0])).Data))
+	}},
+	{"output_data_read", []api.ValueType{i32, i32}, nil, func(e *execution, module api.Module, stack []uint64) {
+		e.write(module, uint32(stack[1]), e.createdOutput(uint32(stack[0])).Data)
+	}},
+	{"ed25519_verify", []api.ValueType{i32, i32, i32, i32}, []api.ValueType{i32}, func(e *execution, module api.Module, stack []uint64) {
+		e.charge(ed25519VerifyGas)
+
+		publicKey, _, err := ed25519.PublicKeyFromBytes(e.read(module, uint32(stack[0]), ed25519.PublicKeySize))
+		if err != nil {
+			panic(err)
+		}
+		signature, _, err := ed25519.SignatureFromBytes(e.read(module, uint32(stack[3]), ed25519.SignatureSize))
+		if err != nil {
+			panic(err)
+		}
+
+		stack[0] = 0
+		if publicKey.VerifySignature(e.read(module, uint32(stack[1]), uint32(stack[2])), signature) {
+			stack[0] = 1
+		}
+	}},
+}
+
+// instantiateHostModule instantiates the hostModule with the hostFunctions in the given runtime.
+func instantiateHostModule(ctx context.Context, runtime wazero.Runtime) (err error) {
+	builder := runtime.NewHostModuleBuilder(hostModule)
+	for _, function := range hostFunctions {
+		call := function.call
+
+		builder.NewFunctionBuilder().WithGoModuleFunction(api.GoModuleFunc(func(ctx context.Context, module api.Module, stack []uint64) {
+			e := executionFromContext(ctx)
+			e.charge(hostCallGas)
+
+			call(e, module, stack)
+		}), function.params, function.results).Export(function.name)
+	}
+
+	_, err = builder.Instantiate(ctx)
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
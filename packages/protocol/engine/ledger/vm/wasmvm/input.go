@@ -0,0 +1,40 @@
+package wasmvm
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+// Input references an Output that is consumed by a Transaction together with the witness that is passed to the unlock
+// script of the Output (i.e. a signature).
+type Input struct {
+	// OutputID contains the identifier of the consumed Output.
+	OutputID utxo.OutputID `serix:"0"`
+
+	// Witness contains the data that is passed to the unlock script of the consumed Output.
+	Witness []byte `serix:"1,lengthPrefixType=uint16"`
+}
+
+// NewInput creates a new Input that consumes the Output with the given ID.
+func NewInput(outputID utxo.OutputID, witness []byte) *Input {
+	return &Input{
+		OutputID: outputID,
+		Witness:  witness,
+	}
+}
+
+// String returns a human-readable version of the Input.
+func (i *Input) String() (humanReadable string) {
+	return stringify.Struct("Input",
+		stringify.NewStructField("OutputID", i.OutputID),
+		stringify.NewStructField("Witness", i.Witness),
+	)
+}
+
+// utxoInput type-casts the Input to a utxo.Input.
+func (i *Input) utxoInput() (input utxo.Input) {
+	return i
+}
+
+// code contract (make sure the struct implements all required methods).
+var _ utxo.Input = new(Input)
@@ -0,0 +1,502 @@
+package wasmvm
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedModule is returned if an unlock script uses a feature of WebAssembly that can not be executed
+// deterministically or that can not be metered (i.e. floating point instructions, SIMD, threads or a start function).
+var ErrUnsupportedModule = errors.New("unsupported WebAssembly module")
+
+// gasGlobalExport contains the name of the export of the global that holds the remaining gas of an execution.
+const gasGlobalExport = "__gas"
+
+const (
+	sectionCustom byte = 0
+	sectionImport byte = 2
+	sectionGlobal byte = 6
+	sectionExport byte = 7
+	sectionStart  byte = 8
+	sectionCode   byte = 10
+
+	importKindGlobal byte = 3
+	exportKindGlobal byte = 3
+
+	opUnreachable byte = 0x00
+	opBlock       byte = 0x02
+	opLoop        byte = 0x03
+	opIf          byte = 0x04
+	opEnd         byte = 0x0b
+	opGlobalGet   byte = 0x23
+	opGlobalSet   byte = 0x24
+	opI64Const    byte = 0x42
+	opI64LtS      byte = 0x53
+	opI64Sub      byte = 0x7d
+	opPrefixFC    byte = 0xfc
+
+	valueTypeI64    byte = 0x7e
+	blockTypeEmpty  byte = 0x40
+	globalMutable   byte = 0x01
+	wasmHeaderBytes      = 8
+)
+
+// sectionOrder contains the position of the (non-custom) sections in a module (the data count section precedes the
+// code section).
+var sectionOrder = map[byte]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6, 7: 7, 8: 8, 9: 9, 12: 10, 10: 11, 11: 12}
+
+// section is a section of a WebAssembly module.
+type section struct {
+	id      byte
+	payload []byte
+}
+
+// region instrumentation //////////////////////////////////////////////////////////////////////////////////////////////
+
+// instrument adds deterministic gas metering to the given WebAssembly module. It appends a mutable i64 global (exported
+// as gasGlobalExport) and charges it at the entry of every function and at the start of every loop iteration with
+// the number of instructions of the function (or loop). The module traps with an unreachable instruction as soon as the
+// remaining gas drops below zero, so that every execution (i.e. every sequence of calls and loop iterations) is bounded
+// by the gas that was set before the call.
+func instrument(module []byte) (instrumentedModule []byte, err error) {
+	if len(module) < wasmHeaderBytes || !bytes.Equal(module[:4], []byte("\x00asm")) {
+		return nil, errors.WithMessage(ErrUnsupportedModule, "invalid magic number")
+	}
+
+	sections, err := parseSections(module[wasmHeaderBytes:])
+	if err != nil {
+		return nil, err
+	}
+
+	var importedGlobals, definedGlobals uint32
+	for _, s := range sections {
+		switch s.id {
+		case sectionImport:
+			if importedGlobals, err = countImportedGlobals(s.payload); err != nil {
+				return nil, errors.Wrap(err, "failed to parse import section")
+			}
+		case sectionGlobal:
+			if definedGlobals, _, err = readU32(s.payload, 0); err != nil {
+				return nil, errors.Wrap(err, "failed to parse global section")
+			}
+		case sectionStart:
+			return nil, errors.WithMessage(ErrUnsupportedModule, "start functions are not supported")
+		}
+	}
+	gasGlobal := importedGlobals + definedGlobals
+
+	sections = ensureSection(sections, sectionGlobal)
+	sections = ensureSection(sections, sectionExport)
+
+	for _, s := range sections {
+		switch s.id {
+		case sectionGlobal:
+			s.payload, err = appendVectorEntry(s.payload, []byte{valueTypeI64, globalMutable, opI64Const, 0, opEnd})
+		case sectionExport:
+			s.payload, err = appendVectorEntry(s.payload, append(append(writeU32(nil, uint32(len(gasGlobalExport))), gasGlobalExport...), append([]byte{exportKindGlobal}, writeU32(nil, gasGlobal)...)...))
+		case sectionCode:
+			s.payload, err = instrumentCode(s.payload, gasGlobal)
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to instrument section %d", s.id)
+		}
+	}
+
+	instrumentedModule = append(make([]byte, 0, len(module)+len(module)/4), module[:wasmHeaderBytes]...)
+	for _, s := range sections {
+		instrumentedModule = append(instrumentedModule, s.id)
+		instrumentedModule = writeU32(instrumentedModule, uint32(len(s.payload)))
+		instrumentedModule = append(instrumentedModule, s.payload...)
+	}
+
+	return instrumentedModule, nil
+}
+
+// parseSections splits the given bytes (following the header of a module) into its sections.
+func parseSections(data []byte) (sections []*section, err error) {
+	for offset := 0; offset < len(data); {
+		id := data[offset]
+
+		size, next, err := readU32(data, offset+1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read section size")
+		}
+		if next+int(size) > len(data) {
+			return nil, errors.Errorf("section %d exceeds the module", id)
+		}
+
+		sections = append(sections, &section{id: id, payload: append([]byte(nil), data[next:next+int(size)]...)})
+		offset = next + int(size)
+	}
+
+	return sections, nil
+}
+
+// ensureSection inserts an empty section with the given id (at its position in the module), if it does not exist.
+func ensureSection(sections []*section, id byte) []*section {
+	position := len(sections)
+	for i, s := range sections {
+		if s.id == id {
+			return sections
+		}
+
+		if s.id != sectionCustom && sectionOrder[s.id] > sectionOrder[id] && position == len(sections) {
+			position = i
+		}
+	}
+
+	return append(sections[:position], append([]*section{{id: id, payload: []byte{0}}}, sections[position:]...)...)
+}
+
+// appendVectorEntry appends the given entry to the vector that the given payload consists of (and increases its count).
+func appendVectorEntry(payload []byte, entry []byte) (updatedPayload []byte, err error) {
+	count, offset, err := readU32(payload, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPayload = writeU32(nil, count+1)
+	updatedPayload = append(updatedPayload, payload[offset:]...)
+
+	return append(updatedPayload, entry...), nil
+}
+
+// countImportedGlobals returns the number of globals that are imported by the given import section.
+func countImportedGlobals(payload []byte) (importedGlobals uint32, err error) {
+	count, offset, err := readU32(payload, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := uint32(0); i < count; i++ {
+		for j := 0; j < 2; j++ {
+			var nameLength uint32
+			if nameLength, offset, err = readU32(payload, offset); err != nil {
+				return 0, err
+			}
+			offset += int(nameLength)
+		}
+		if offset >= len(payload) {
+			return 0, errors.New("unexpected end of import section")
+		}
+
+		kind := payload[offset]
+		offset++
+
+		switch kind {
+		case 0:
+			_, offset, err = readU32(payload, offset)
+		case 1:
+			offset, err = skipLimits(payload, offset+1)
+		case 2:
+			offset, err = skipLimits(payload, offset)
+		case importKindGlobal:
+			importedGlobals++
+			offset += 2
+		default:
+			return 0, errors.Errorf("unknown import kind %d", kind)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return importedGlobals, nil
+}
+
+// skipLimits skips the limits of a table or memory.
+func skipLimits(payload []byte, offset int) (next int, err error) {
+	if offset >= len(payload) {
+		return 0, errors.New("unexpected end of limits")
+	}
+
+	hasMaximum := payload[offset]&0x01 == 0x01
+	if _, next, err = readU32(payload, offset+1); err != nil || !hasMaximum {
+		return next, err
+	}
+
+	_, next, err = readU32(payload, next)
+
+	return next, err
+}
+
+// instrumentCode adds the gas metering to the function bodies of the given code section.
+func instrumentCode(payload []byte, gasGlobal uint32) (instrumentedPayload []byte, err error) {
+	count, offset, err := readU32(payload, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	instrumentedPayload = writeU32(nil, count)
+	for i := uint32(0); i < count; i++ {
+		var size uint32
+		if size, offset, err = readU32(payload, offset); err != nil {
+			return nil, err
+		}
+		if offset+int(size) > len(payload) {
+			return nil, errors.Errorf("body of function %d exceeds the code section", i)
+		}
+
+		body, err := instrumentBody(payload[offset:offset+int(size)], gasGlobal)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to instrument function %d", i)
+		}
+
+		instrumentedPayload = writeU32(instrumentedPayload, uint32(len(body)))
+		instrumentedPayload = append(instrumentedPayload, body...)
+		offset += int(size)
+	}
+
+	return instrumentedPayload, nil
+}
+
+// instrumentBody adds the gas metering to the given function body.
+func instrumentBody(body []byte, gasGlobal uint32) (instrumentedBody []byte, err error) {
+	localGroups, offset, err := readU32(body, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < localGroups; i++ {
+		if _, offset, err = readU32(body, offset); err != nil {
+			return nil, err
+		}
+		offset++
+	}
+	if offset > len(body) {
+		return nil, errors.New("unexpected end of locals")
+	}
+
+	instructions, err := decodeInstructions(body, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cost of a loop iteration is the number of instructions up to the end of the loop
+	loopCosts := make(map[int]int64)
+	openBlocks := make([]int, 0)
+	for i, instruction := range instructions {
+		switch body[instruction.start] {
+		case opBlock, opLoop, opIf:
+			openBlocks = append(openBlocks, i)
+		case opEnd:
+			if len(openBlocks) == 0 {
+				continue
+			}
+
+			if opening := openBlocks[len(openBlocks)-1]; body[instructions[opening].start] == opLoop {
+				loopCosts[opening] = int64(i - opening)
+			}
+			openBlocks = openBlocks[:len(openBlocks)-1]
+		}
+	}
+
+	instrumentedBody = append(make([]byte, 0, len(body)*2), body[:offset]...)
+	instrumentedBody = appendGasCharge(instrumentedBody, gasGlobal, int64(len(instructions)))
+	for i, instruction := range instructions {
+		instrumentedBody = append(instrumentedBody, body[instruction.start:instruction.end]...)
+
+		if cost, isLoop := loopCosts[i]; isLoop {
+			instrumentedBody = appendGasCharge(instrumentedBody, gasGlobal, cost)
+		}
+	}
+
+	return instrumentedBody, nil
+}
+
+// appendGasCharge appends the instructions that subtract the given cost from the gas global (and trap if the remaining
+// gas drops below zero).
+func appendGasCharge(code []byte, gasGlobal uint32, cost int64) []byte {
+	code = append(code, opGlobalGet)
+	code = writeU32(code, gasGlobal)
+	code = append(code, opI64Const)
+	code = writeS64(code, cost)
+	code = append(code, opI64Sub, opGlobalSet)
+	code = writeU32(code, gasGlobal)
+	code = append(code, opGlobalGet)
+	code = writeU32(code, gasGlobal)
+
+	return append(code, opI64Const, 0, opI64LtS, opIf, blockTypeEmpty, opUnreachable, opEnd)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region instruction decoding /////////////////////////////////////////////////////////////////////////////////////////
+
+// instruction is the position of an instruction (including its immediates) in a function body.
+type instruction struct {
+	start int
+	end   int
+}
+
+// decodeInstructions decodes the instructions of a function body (starting at the given offset).
+func decodeInstructions(body []byte, offset int) (instructions []instruction, err error) {
+	for offset < len(body) {
+		next, err := skipInstruction(body, offset)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode instruction at offset %d", offset)
+		}
+
+		instructions = append(instructions, instruction{start: offset, end: next})
+		offset = next
+	}
+
+	return instructions, nil
+}
+
+// skipInstruction returns the offset of the instruction that follows the instruction at the given offset. It rejects
+// the instructions that can not be executed deterministically (floating point arithmetic) or that are not supported.
+func skipInstruction(body []byte, offset int) (next int, err error) {
+	opcode := body[offset]
+	next = offset + 1
+
+	switch {
+	case opcode == 0x00, opcode == 0x01, opcode == 0x05, opcode == 0x0b, opcode == 0x0f, opcode == 0x1a, opcode == 0x1b,
+		opcode == 0xd1:
+		return next, nil
+	case opcode >= opBlock && opcode <= opIf:
+		return skipBlockType(body, next)
+	case opcode == 0x0c, opcode == 0x0d, opcode == 0x10, opcode == 0xd2, opcode >= 0x20 && opcode <= 0x26:
+		_, next, err = readU32(body, next)
+		return next, err
+	case opcode == 0x0e:
+		var labels uint32
+		if labels, next, err = readU32(body, next); err != nil {
+			return 0, err
+		}
+		return skipU32s(body, next, int(labels)+1)
+	case opcode == 0x11:
+		return skipU32s(body, next, 2)
+	case opcode == 0x1c:
+		var types uint32
+		if types, next, err = readU32(body, next); err != nil {
+			return 0, err
+		}
+		return next + int(types), nil
+	case opcode == 0x2a, opcode == 0x2b, opcode == 0x38, opcode == 0x39:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "floating point instruction 0x%02x", opcode)
+	case opcode >= 0x28 && opcode <= 0x3e:
+		return skipU32s(body, next, 2)
+	case opcode == 0x3f, opcode == 0x40, opcode == 0xd0:
+		return next + 1, nil
+	case opcode == 0x41, opcode == opI64Const:
+		return skipLEB128(body, next)
+	case opcode >= 0x45 && opcode <= 0x5a, opcode >= 0x67 && opcode <= 0x8a, opcode == 0xa7, opcode == 0xac,
+		opcode == 0xad, opcode >= 0xc0 && opcode <= 0xc4:
+		return next, nil
+	case opcode == 0x43, opcode == 0x44, opcode >= 0x5b && opcode <= 0x66, opcode >= 0x8b && opcode <= 0xbf:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "floating point instruction 0x%02x", opcode)
+	case opcode == opPrefixFC:
+		return skipPrefixedInstruction(body, next)
+	default:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "instruction 0x%02x", opcode)
+	}
+}
+
+// skipPrefixedInstruction skips the immediates of an instruction with the 0xfc prefix (bulk memory and table
+// instructions).
+func skipPrefixedInstruction(body []byte, offset int) (next int, err error) {
+	subOpcode, next, err := readU32(body, offset)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case subOpcode <= 7:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "floating point instruction 0xfc %d", subOpcode)
+	case subOpcode == 8:
+		if _, next, err = readU32(body, next); err != nil {
+			return 0, err
+		}
+		return next + 1, nil
+	case subOpcode == 9, subOpcode == 13, subOpcode >= 15 && subOpcode <= 17:
+		return skipU32s(body, next, 1)
+	case subOpcode == 10:
+		return next + 2, nil
+	case subOpcode == 11:
+		return next + 1, nil
+	case subOpcode == 12, subOpcode == 14:
+		return skipU32s(body, next, 2)
+	default:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "instruction 0xfc %d", subOpcode)
+	}
+}
+
+// skipBlockType skips the type of a block (which is either empty, a value type or a type index).
+func skipBlockType(body []byte, offset int) (next int, err error) {
+	if offset >= len(body) {
+		return 0, errors.New("unexpected end of block type")
+	}
+
+	switch body[offset] {
+	case blockTypeEmpty, 0x7f, valueTypeI64, 0x70, 0x6f:
+		return offset + 1, nil
+	case 0x7d, 0x7c, 0x7b:
+		return 0, errors.WithMessagef(ErrUnsupportedModule, "block type 0x%02x", body[offset])
+	default:
+		return skipLEB128(body, offset)
+	}
+}
+
+// skipU32s skips the given number of LEB128 encoded integers.
+func skipU32s(body []byte, offset int, count int) (next int, err error) {
+	for next = offset; count > 0; count-- {
+		if next, err = skipLEB128(body, next); err != nil {
+			return 0, err
+		}
+	}
+
+	return next, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region LEB128 ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// readU32 reads an unsigned LEB128 encoded uint32 at the given offset.
+func readU32(data []byte, offset int) (value uint32, next int, err error) {
+	if offset > len(data) {
+		return 0, 0, errors.New("unexpected end of data")
+	}
+
+	decoded, bytesRead := binary.Uvarint(data[offset:])
+	if bytesRead <= 0 || bytesRead > 5 || decoded > 0xffffffff {
+		return 0, 0, errors.Errorf("invalid LEB128 integer at offset %d", offset)
+	}
+
+	return uint32(decoded), offset + bytesRead, nil
+}
+
+// skipLEB128 skips a (signed or unsigned) LEB128 encoded integer at the given offset.
+func skipLEB128(data []byte, offset int) (next int, err error) {
+	for next = offset; next < len(data) && next-offset < 10; next++ {
+		if data[next]&0x80 == 0 {
+			return next + 1, nil
+		}
+	}
+
+	return 0, errors.Errorf("invalid LEB128 integer at offset %d", offset)
+}
+
+// writeU32 appends the unsigned LEB128 encoding of the given value.
+func writeU32(data []byte, value uint32) []byte {
+	return binary.AppendUvarint(data, uint64(value))
+}
+
+// writeS64 appends the signed LEB128 encoding of the given value.
+func writeS64(data []byte, value int64) []byte {
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+
+		if (value == 0 && b&0x40 == 0) || (value == -1 && b&0x40 != 0) {
+			return append(data, b)
+		}
+		data = append(data, b|0x80)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,83 @@
+package wasmvm
+
+import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/hive.go/objectstorage/generic/model"
+)
+
+// OutputType is the type of the Outputs of the WebAssembly VM (it is outside the range of the types of the devnet VM
+// and the mocked VM, so that the VMs can be registered in the same vm.Registry).
+const OutputType uint8 = 129
+
+// region Output ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Output is an Output of the WebAssembly VM that can only be consumed if its unlock script approves the spending.
+type Output struct {
+	model.Storable[utxo.OutputID, Output, *Output, output] `serix:"0"`
+}
+
+type output struct {
+	// Balance contains the balance of the Output.
+	Balance uint64 `serix:"0"`
+
+	// Script contains the WebAssembly module that decides if the Output can be consumed.
+	Script []byte `serix:"1,lengthPrefixType=uint32"`
+
+	// Data contains the data that is attached to the Output.
+	Data []byte `serix:"2,lengthPrefixType=uint32"`
+}
+
+// NewOutput creates a new Output with the given balance, unlock script and data.
+func NewOutput(balance uint64, script, data []byte) (out *Output) {
+	return model.NewStorable[utxo.OutputID, Output](&output{
+		Balance: balance,
+		Script:  script,
+		Data:    data,
+	})
+}
+
+// Balance returns the balance of the Output.
+func (o *Output) Balance() (balance uint64) {
+	o.RLock()
+	defer o.RUnlock()
+
+	return o.M.Balance
+}
+
+// Script returns the WebAssembly module that decides if the Output can be consumed (an empty script allows everybody
+// to consume the Output).
+func (o *Output) Script() (script []byte) {
+	o.RLock()
+	defer o.RUnlock()
+
+	return o.M.Script
+}
+
+// Data returns the data that is attached to the Output (i.e. the state of a prototyped contract).
+func (o *Output) Data() (data []byte) {
+	o.RLock()
+	defer o.RUnlock()
+
+	return o.M.Data
+}
+
+// code contract (make sure the struct implements all required methods).
+var _ utxo.Output = new(Output)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OutputSpec ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputSpec describes an Output that is created by a Transaction.
+type OutputSpec struct {
+	// Balance contains the balance of the Output.
+	Balance uint64 `serix:"0"`
+
+	// Script contains the WebAssembly module that decides if the Output can be consumed.
+	Script []byte `serix:"1,lengthPrefixType=uint32"`
+
+	// Data contains the data that is attached to the Output.
+	Data []byte `serix:"2,lengthPrefixType=uint32"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,75 @@
+package wasmvm
+
+import (
+	"context"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/objectstorage/generic/model"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
+)
+
+// TransactionType represents the payload Type of the Transactions of the WebAssembly VM.
+var TransactionType payload.Type
+
+// Transaction is a Transaction of the WebAssembly VM that consumes Outputs (if their unlock scripts approve it) and
+// creates new Outputs.
+type Transaction struct {
+	model.Storable[utxo.TransactionID, Transaction, *Transaction, transaction] `serix:"0"`
+}
+
+type transaction struct {
+	// Inputs contains the Inputs that reference the consumed Outputs.
+	Inputs []*Input `serix:"0,lengthPrefixType=uint16"`
+
+	// Outputs contains the specifications of the created Outputs.
+	Outputs []*OutputSpec `serix:"1,lengthPrefixType=uint16"`
+}
+
+// NewTransaction creates a new Transaction that consumes the given Inputs and creates the given Outputs.
+func NewTransaction(inputs []*Input, outputs []*OutputSpec) (tx *Transaction) {
+	tx = model.NewStorable[utxo.TransactionID, Transaction](&transaction{
+		Inputs:  inputs,
+		Outputs: outputs,
+	})
+	tx.SetID(utxo.NewTransactionID(lo.PanicOnErr(tx.Bytes())))
+
+	return tx
+}
+
+// Inputs returns the inputs of the Transaction.
+func (t *Transaction) Inputs() (inputs []utxo.Input) {
+	return lo.Map(t.M.Inputs, (*Input).utxoInput)
+}
+
+// Outputs returns the specifications of the Outputs that are created by the Transaction.
+func (t *Transaction) Outputs() (outputs []*OutputSpec) {
+	return t.M.Outputs
+}
+
+// SigningMessage returns the hash of the Transaction without the witnesses of its Inputs (the message that is signed to
+// authorize the spending of the consumed Outputs).
+func (t *Transaction) SigningMessage() (message [32]byte) {
+	essence := &transaction{
+		Inputs: lo.Map(t.M.Inputs, func(input *Input) *Input {
+			return NewInput(input.OutputID, nil)
+		}),
+		Outputs: t.M.Outputs,
+	}
+
+	return blake2b.Sum256(lo.PanicOnErr(serix.DefaultAPI.Encode(context.Background(), essence)))
+}
+
+// Type returns the type of the Transaction.
+func (t *Transaction) Type() payload.Type {
+	return TransactionType
+}
+
+// code contract (make sure the struct implements all required methods).
+var (
+	_ utxo.Transaction = new(Transaction)
+	_ payload.Payload  = new(Transaction)
+)
@@ -0,0 +1,322 @@
+package wasmvm
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payloadtype"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
+)
+
+// unlockExport contains the name of the function that an unlock script has to export. It takes no parameters and
+// returns 1 if the consumed Output can be spent by the Transaction (every other result rejects the spending).
+const unlockExport = "unlock"
+
+// VM is an experimental VM that executes WebAssembly unlock scripts that are attached to its Outputs. It allows to
+// prototype programmable Outputs on private networks: the scripts are metered deterministically, can only import the
+// functions of the hostModule and can neither use floating point instructions nor more memory than configured.
+type VM struct {
+	runtime wazero.Runtime
+
+	optsGasLimit         uint64
+	optsMemoryLimitPages uint32
+}
+
+// NewVM creates a new VM with the given options.
+func NewVM(opts ...options.Option[VM]) (newVM *VM) {
+	return options.Apply(&VM{
+		optsGasLimit:         DefaultGasLimit,
+		optsMemoryLimitPages: DefaultMemoryLimitPages,
+	}, opts, func(v *VM) {
+		v.runtime = wazero.NewRuntimeWithConfig(context.Background(), wazero.NewRuntimeConfig().
+			WithCoreFeatures(api.CoreFeaturesV2).
+			WithMemoryLimitPages(v.optsMemoryLimitPages),
+		)
+
+		if err := instantiateHostModule(context.Background(), v.runtime); err != nil {
+			panic(errors.Wrap(err, "failed to instantiate host module"))
+		}
+	})
+}
+
+// Types returns the types of the Transactions, Inputs and Outputs of the VM.
+func (v *VM) Types() (types vm.Types) {
+	return vm.Types{
+		TransactionType: TransactionType,
+		Input:           new(Input),
+		OutputTypes:     []uint8{OutputType},
+	}
+}
+
+// ParseTransaction un-serializes a Transaction from the given sequence of bytes.
+func (v *VM) ParseTransaction(transactionBytes []byte) (transaction utxo.Transaction, err error) {
+	tx := new(Transaction)
+	if _, err = serix.DefaultAPI.Decode(context.Background(), transactionBytes, tx, serix.WithValidation()); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// ParseOutput un-serializes an Output from the given sequence of bytes.
+func (v *VM) ParseOutput(outputBytes []byte) (output utxo.Output, err error) {
+	newOutput := new(Output)
+	if _, err = serix.DefaultAPI.Decode(context.Background(), outputBytes, newOutput, serix.WithValidation()); err != nil {
+		return nil, err
+	}
+
+	return newOutput, nil
+}
+
+// ResolveInput translates the Input into an OutputID.
+func (v *VM) ResolveInput(input utxo.Input) (outputID utxo.OutputID) {
+	return input.(*Input).OutputID
+}
+
+// ExecuteTransaction executes the unlock scripts of the consumed Outputs and creates the Outputs of the Transaction. The
+// gas limit of the Budget is shared by all unlock scripts of the Transaction (the lower of the gas limits of the Budget
+// and the VM is used).
+func (v *VM) ExecuteTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget) (outputs []utxo.Output, cost vm.Cost, err error) {
+	return v.TraceTransaction(transaction, inputs, budget, nil)
+}
+
+// TraceTransaction executes the Transaction like ExecuteTransaction and records its validation steps (including the
+// gas that was consumed by the individual unlock scripts) in the given Trace.
+func (v *VM) TraceTransaction(transaction utxo.Transaction, inputs *utxo.Outputs, budget vm.Budget, trace *vm.Trace) (outputs []utxo.Output, cost vm.Cost, err error) {
+	tx := transaction.(*Transaction)
+
+	consumedOutputs, err := v.consumedOutputs(tx, inputs)
+	if err = trace.Step("consumed outputs", err); err != nil {
+		return nil, cost, err
+	}
+
+	if err = trace.Step("balances", balancesValid(consumedOutputs, tx.Outputs())); err != nil {
+		return nil, cost, err
+	}
+
+	gasLimit := budget.GasLimit(v.optsGasLimit)
+	if gasLimit == 0 || gasLimit > math.MaxInt64 {
+		gasLimit = math.MaxInt64
+	}
+
+	remainingGas := gasLimit
+	for i, consumedOutput := range consumedOutputs {
+		if len(consumedOutput.Script()) == 0 {
+			continue
+		}
+
+		availableGas := remainingGas
+		remainingGas, err = v.unlock(tx, i, consumedOutput, availableGas)
+		cost.Gas = gasLimit - remainingGas
+		if errors.Is(err, ErrOutOfGas) {
+			err = vm.WithValidationError(errors.Wrapf(err, "failed to unlock input %d", i), vm.ErrBudgetExceeded)
+		} else if err != nil {
+			err = vm.WithValidationError(errors.Wrapf(err, "failed to unlock input %d", i), vm.ErrUnlockInvalid)
+		}
+
+		if err = trace.Step(fmt.Sprintf("unlock input %d (%d gas)", i, availableGas-remainingGas), err); err != nil {
+			return nil, cost, err
+		}
+	}
+
+	outputs = make([]utxo.Output, len(tx.Outputs()))
+	for i, outputSpec := range tx.Outputs() {
+		if len(outputSpec.Script) != 0 {
+			if err = v.validateScript(outputSpec.Script); err != nil {
+				return nil, cost, trace.Step(fmt.Sprintf("output script %d", i), errors.WithMessagef(vm.ErrValidationFailed, "output %d has an invalid unlock script: %s", i, err))
+			}
+		}
+
+		outputs[i] = NewOutput(outputSpec.Balance, outputSpec.Script, outputSpec.Data)
+		outputs[i].SetID(utxo.NewOutputID(tx.ID(), uint16(i)))
+	}
+
+	if cost.Size, err = vm.OutputsSize(outputs); err != nil {
+		return nil, cost, errors.Wrap(err, "failed to determine the size of the created outputs")
+	}
+
+	if err = trace.Step("budget", budget.Check(cost)); err != nil {
+		return nil, cost, err
+	}
+
+	return outputs, cost, nil
+}
+
+// Shutdown releases the resources of the WebAssembly runtime of the VM.
+func (v *VM) Shutdown() {
+	_ = v.runtime.Close(context.Background())
+}
+
+// consumedOutputs returns the Outputs that are consumed by the given Transaction (in the order of its Inputs).
+func (v *VM) consumedOutputs(tx *Transaction, inputs *utxo.Outputs) (consumedOutputs []*Output, err error) {
+	consumedOutputs = make([]*Output, len(tx.M.Inputs))
+	for i, input := range tx.M.Inputs {
+		consumedOutput, exists := inputs.Get(input.OutputID)
+		if !exists {
+			return nil, errors.WithMessagef(vm.ErrValidationFailed, "consumed output %s is missing", input.OutputID)
+		}
+
+		if consumedOutputs[i], exists = consumedOutput.(*Output); !exists {
+			return nil, errors.WithMessagef(vm.ErrValidationFailed, "consumed output %s is not an output of the WebAssembly VM", input.OutputID)
+		}
+	}
+
+	return consumedOutputs, nil
+}
+
+// unlock executes the unlock script of the consumed Output with the given index and returns the remaining gas.
+func (v *VM) unlock(tx *Transaction, inputIndex int, consumedOutput *Output, gas uint64) (remainingGas uint64, err error) {
+	ctx := context.WithValue(context.Background(), executionKey{}, &execution{
+		transaction:    tx,
+		inputIndex:     inputIndex,
+		consumedOutput: consumedOutput,
+		signingMessage: tx.SigningMessage(),
+	})
+
+	compiledModule, err := v.compile(consumedOutput.Script())
+	if err != nil {
+		return gas, errors.Wrap(err, "invalid unlock script")
+	}
+	defer compiledModule.Close(ctx)
+
+	module, err := v.runtime.InstantiateModule(ctx, compiledModule, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+	if err != nil {
+		return gas, errors.Wrap(err, "failed to instantiate unlock script")
+	}
+	defer module.Close(ctx)
+
+	e := executionFromContext(ctx)
+	e.gas = module.ExportedGlobal(gasGlobalExport).(api.MutableGlobal)
+	e.gas.Set(gas)
+
+	results, err := module.ExportedFunction(unlockExport).Call(ctx)
+	if int64(e.gas.Get()) < 0 {
+		return 0, errors.WithMessagef(ErrOutOfGas, "gas limit of %d exceeded", gas)
+	} else if err != nil {
+		return e.gas.Get(), errors.Wrap(err, "unlock script failed")
+	} else if uint32(results[0]) != 1 {
+		return e.gas.Get(), errors.Errorf("unlock script rejected the spending (result %d)", uint32(results[0]))
+	}
+
+	return e.gas.Get(), nil
+}
+
+// validateScript checks if the given unlock script can be executed by the VM.
+func (v *VM) validateScript(script []byte) (err error) {
+	compiledModule, err := v.compile(script)
+	if err != nil {
+		return err
+	}
+
+	return compiledModule.Close(context.Background())
+}
+
+// compile instruments and compiles the given unlock script and checks that it exports a valid unlock function.
+func (v *VM) compile(script []byte) (compiledModule wazero.CompiledModule, err error) {
+	instrumentedScript, err := instrument(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if compiledModule, err = v.runtime.CompileModule(context.Background(), instrumentedScript); err != nil {
+		return nil, errors.WithMessagef(ErrUnsupportedModule, "failed to compile module: %s", err)
+	}
+
+	for _, importedFunction := range compiledModule.ImportedFunctions() {
+		if moduleName, name, _ := importedFunction.Import(); moduleName != hostModule {
+			_ = compiledModule.Close(context.Background())
+			return nil, errors.WithMessagef(ErrUnsupportedModule, "import of %s.%s is not allowed", moduleName, name)
+		}
+	}
+
+	unlockFunction, exists := compiledModule.ExportedFunctions()[unlockExport]
+	if !exists || len(unlockFunction.ParamTypes()) != 0 || len(unlockFunction.ResultTypes()) != 1 || unlockFunction.ResultTypes()[0] != api.ValueTypeI32 {
+		_ = compiledModule.Close(context.Background())
+		return nil, errors.WithMessagef(ErrUnsupportedModule, "module has to export a function %s() i32", unlockExport)
+	}
+
+	return compiledModule, nil
+}
+
+// balancesValid checks if the consumed and created Outputs have the same total balance.
+func balancesValid(consumedOutputs []*Output, createdOutputs []*OutputSpec) (err error) {
+	var consumedBalance, createdBalance uint64
+	for _, consumedOutput := range consumedOutputs {
+		if consumedBalance+consumedOutput.Balance() < consumedBalance {
+			return errors.WithMessage(vm.ErrBalanceMismatch, "consumed balance overflows")
+		}
+		consumedBalance += consumedOutput.Balance()
+	}
+
+	for _, createdOutput := range createdOutputs {
+		if createdBalance+createdOutput.Balance < createdBalance {
+			return errors.WithMessage(vm.ErrBalanceMismatch, "created balance overflows")
+		}
+		createdBalance += createdOutput.Balance
+	}
+
+	if consumedBalance != createdBalance {
+		return errors.WithMessagef(vm.ErrBalanceMismatch, "consumed balance %d does not match created balance %d", consumedBalance, createdBalance)
+	}
+
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods).
+var (
+	_ vm.TypedVM   = new(VM)
+	_ vm.TracingVM = new(VM)
+)
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// DefaultGasLimit is the default gas limit of the execution of a Transaction.
+	DefaultGasLimit uint64 = 1_000_000
+
+	// DefaultMemoryLimitPages is the default maximum number of memory pages (64 KiB each) of an unlock script.
+	DefaultMemoryLimitPages uint32 = 16
+)
+
+// WithGasLimit is an Option for the VM that sets the gas limit of the execution of a Transaction (the Budget that is
+// passed to ExecuteTransaction can only lower it).
+func WithGasLimit(gasLimit uint64) options.Option[VM] {
+	return func(v *VM) {
+		v.optsGasLimit = gasLimit
+	}
+}
+
+// WithMemoryLimitPages is an Option for the VM that sets the maximum number of memory pages (64 KiB each) of an unlock
+// script.
+func WithMemoryLimitPages(memoryLimitPages uint32) options.Option[VM] {
+	return func(v *VM) {
+		v.optsMemoryLimitPages = memoryLimitPages
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	TransactionType = payload.NewType(payloadtype.WASMTransaction, "WASMTransactionType")
+	if err := serix.DefaultAPI.RegisterTypeSettings(Transaction{}, serix.TypeSettings{}.WithObjectType(uint32(new(Transaction).Type()))); err != nil {
+		panic(errors.Wrap(err, "error registering Transaction type settings"))
+	}
+	if err := serix.DefaultAPI.RegisterInterfaceObjects((*payload.Payload)(nil), new(Transaction)); err != nil {
+		panic(errors.Wrap(err, "error registering Transaction as Payload interface"))
+	}
+	if err := serix.DefaultAPI.RegisterTypeSettings(Output{}, serix.TypeSettings{}.WithObjectType(OutputType)); err != nil {
+		panic(errors.Wrap(err, "error registering Output type settings"))
+	}
+	if err := serix.DefaultAPI.RegisterInterfaceObjects((*utxo.Output)(nil), new(Output)); err != nil {
+		panic(errors.Wrap(err, "error registering utxo.Output interface implementations"))
+	}
+}
@@ -0,0 +1,232 @@
+package wasmvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/mockedvm"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func TestVM_Unlock(t *testing.T) {
+	wasmVM := NewVM()
+	defer wasmVM.Shutdown()
+
+	t.Run("Unlocked", func(t *testing.T) {
+		require.NoError(t, spend(wasmVM, 100, testModule(nil, nil, i32Const(1)...)))
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		err := spend(wasmVM, 100, testModule(nil, nil, i32Const(0)...))
+		require.ErrorIs(t, err, vm.ErrUnlockInvalid)
+	})
+
+	t.Run("BoundedLoop", func(t *testing.T) {
+		require.NoError(t, spend(wasmVM, 100, testModule(nil, []byte{1, 1, 0x7f}, countingLoop(1000)...)))
+	})
+
+	t.Run("OutOfGas", func(t *testing.T) {
+		script := testModule(nil, nil, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x41, 0x01)
+		err := spend(wasmVM, 100, script)
+		require.ErrorIs(t, err, vm.ErrBudgetExceeded)
+		require.ErrorIs(t, err, ErrOutOfGas)
+
+		// the gas limit of the Budget lowers the gas limit of the VM
+		countingScript := testModule(nil, []byte{1, 1, 0x7f}, countingLoop(1000)...)
+		require.ErrorIs(t, spend(wasmVM, 100, countingScript, vm.Budget{Gas: 100}), ErrOutOfGas)
+		require.NoError(t, spend(wasmVM, 100, countingScript, vm.Budget{Gas: 100000}))
+	})
+
+	t.Run("Signature", func(t *testing.T) {
+		keyPair := ed25519.GenerateKeyPair()
+		script := testModule([]*testImport{
+			{"data_read", []byte{0x7f}, nil},
+			{"witness_read", []byte{0x7f}, nil},
+			{"signing_message_read", []byte{0x7f}, nil},
+			{"ed25519_verify", []byte{0x7f, 0x7f, 0x7f, 0x7f}, []byte{0x7f}},
+		}, nil, concat(
+			i32Const(0), []byte{0x10, 0},
+			i32Const(32), []byte{0x10, 1},
+			i32Const(96), []byte{0x10, 2},
+			i32Const(0), i32Const(96), i32Const(32), i32Const(32), []byte{0x10, 3},
+		)...)
+
+		lockedOutput := NewOutput(100, script, lo.PanicOnErr(keyPair.PublicKey.Bytes()))
+		lockedOutput.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, 0))
+
+		unsignedTx := NewTransaction([]*Input{NewInput(lockedOutput.ID(), nil)}, []*OutputSpec{{Balance: 100}})
+		signingMessage := unsignedTx.SigningMessage()
+		signature := keyPair.PrivateKey.Sign(signingMessage[:])
+
+		signedTx := NewTransaction([]*Input{NewInput(lockedOutput.ID(), signature[:])}, []*OutputSpec{{Balance: 100}})
+		outputs, cost, err := wasmVM.ExecuteTransaction(signedTx, utxo.NewOutputs(lockedOutput), vm.UnlimitedBudget)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Greater(t, cost.Gas, uint64(ed25519VerifyGas))
+		require.Equal(t, uint64(len(lo.PanicOnErr(outputs[0].Bytes()))), cost.Size)
+		require.Equal(t, utxo.NewOutputID(signedTx.ID(), 0), outputs[0].ID())
+
+		forgedSignature := ed25519.GenerateKeyPair().PrivateKey.Sign(signingMessage[:])
+		forgedTx := NewTransaction([]*Input{NewInput(lockedOutput.ID(), forgedSignature[:])}, []*OutputSpec{{Balance: 100}})
+		_, _, err = wasmVM.ExecuteTransaction(forgedTx, utxo.NewOutputs(lockedOutput), vm.UnlimitedBudget)
+		require.ErrorIs(t, err, vm.ErrUnlockInvalid)
+	})
+}
+
+func TestVM_Validation(t *testing.T) {
+	wasmVM := NewVM()
+	defer wasmVM.Shutdown()
+
+	genesisOutput := NewOutput(100, nil, nil)
+	genesisOutput.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, 0))
+	create := func(outputs ...*OutputSpec) error {
+		_, _, err := wasmVM.ExecuteTransaction(NewTransaction([]*Input{NewInput(genesisOutput.ID(), nil)}, outputs), utxo.NewOutputs(genesisOutput), vm.Budget{Size: 1024})
+		return err
+	}
+
+	require.NoError(t, create(&OutputSpec{Balance: 60, Script: testModule(nil, nil, i32Const(1)...)}, &OutputSpec{Balance: 40}))
+	require.ErrorIs(t, create(&OutputSpec{Balance: 101}), vm.ErrBalanceMismatch)
+	require.ErrorIs(t, create(&OutputSpec{Balance: 100, Data: make([]byte, 1024)}), vm.ErrBudgetExceeded)
+
+	floatScript := testModule(nil, nil, 0x43, 0, 0, 0, 0, 0x1a, 0x41, 0x01)
+	require.ErrorIs(t, create(&OutputSpec{Balance: 100, Script: floatScript}), vm.ErrValidationFailed)
+	require.ErrorIs(t, lo.Return2(wasmVM.compile(floatScript)), ErrUnsupportedModule)
+
+	foreignImportScript := testModuleWithImportModule("wasi_snapshot_preview1", []*testImport{{"proc_exit", []byte{0x7f}, nil}}, nil, i32Const(1)...)
+	require.ErrorIs(t, lo.Return2(wasmVM.compile(foreignImportScript)), ErrUnsupportedModule)
+	require.ErrorIs(t, create(&OutputSpec{Balance: 100, Script: []byte("not a module")}), vm.ErrValidationFailed)
+
+	mockedOutput := mockedvm.NewMockedOutput(utxo.EmptyTransactionID, 1, 100)
+	_, _, err := wasmVM.ExecuteTransaction(NewTransaction([]*Input{NewInput(mockedOutput.ID(), nil)}, nil), utxo.NewOutputs(mockedOutput), vm.UnlimitedBudget)
+	require.ErrorIs(t, err, vm.ErrValidationFailed)
+}
+
+func TestVM_Serialization(t *testing.T) {
+	wasmVM := NewVM()
+	defer wasmVM.Shutdown()
+
+	registry := vm.NewRegistry(devnetvm.NewVM(), mockedvm.NewMockedVM(), wasmVM)
+
+	tx := NewTransaction([]*Input{NewInput(utxo.NewOutputID(utxo.EmptyTransactionID, 0), []byte{1, 2, 3})}, []*OutputSpec{
+		{Balance: 100, Script: testModule(nil, nil, i32Const(1)...), Data: []byte("state")},
+	})
+
+	parsedTx, err := registry.ParseTransaction(lo.PanicOnErr(tx.Bytes()))
+	require.NoError(t, err)
+	require.IsType(t, new(Transaction), parsedTx)
+	require.Equal(t, tx.SigningMessage(), parsedTx.(*Transaction).SigningMessage())
+	require.Equal(t, utxo.NewOutputID(utxo.EmptyTransactionID, 0), registry.ResolveInput(parsedTx.Inputs()[0]))
+
+	output := NewOutput(100, []byte{1}, []byte("state"))
+	output.SetID(utxo.NewOutputID(tx.ID(), 0))
+
+	parsedOutput, err := registry.ParseOutput(lo.PanicOnErr(output.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, []byte("state"), parsedOutput.(*Output).Data())
+}
+
+func TestInstrument(t *testing.T) {
+	module := testModule(nil, []byte{1, 1, 0x7f}, countingLoop(10)...)
+
+	instrumentedModule, err := instrument(module)
+	require.NoError(t, err)
+
+	// instrumenting a module twice adds a second gas global (the first one is treated like any other global)
+	_, err = instrument(instrumentedModule)
+	require.NoError(t, err)
+
+	_, err = instrument(append(module, sectionStart, 1, 0))
+	require.ErrorIs(t, err, ErrUnsupportedModule)
+}
+
+// spend executes a Transaction that spends an Output with the given balance and unlock script.
+func spend(wasmVM *VM, balance uint64, script []byte, budget ...vm.Budget) (err error) {
+	lockedOutput := NewOutput(balance, script, nil)
+	lockedOutput.SetID(utxo.NewOutputID(utxo.EmptyTransactionID, 0))
+
+	_, _, err = wasmVM.ExecuteTransaction(NewTransaction([]*Input{NewInput(lockedOutput.ID(), nil)}, []*OutputSpec{{Balance: balance}}), utxo.NewOutputs(lockedOutput), append(budget, vm.UnlimitedBudget)[0])
+
+	return err
+}
+
+// testImport is a function that is imported by a test module.
+type testImport struct {
+	name    string
+	params  []byte
+	results []byte
+}
+
+// testModule assembles a module that imports the given functions from the hostModule and exports a memory and an
+// unlock function with the given locals and code.
+func testModule(imports []*testImport, locals []byte, code ...byte) []byte {
+	return testModuleWithImportModule(hostModule, imports, locals, code...)
+}
+
+// testModuleWithImportModule assembles a module that imports the given functions from the given module.
+func testModuleWithImportModule(importModule string, imports []*testImport, locals []byte, code ...byte) []byte {
+	types := [][]byte{{0x60, 0x00, 0x01, 0x7f}}
+	importEntries := make([][]byte, 0)
+	for i, imported := range imports {
+		types = append(types, concat([]byte{0x60}, vector(bytesOf(imported.params)), vector(bytesOf(imported.results))))
+		importEntries = append(importEntries, concat(name(importModule), name(imported.name), []byte{0x00}, writeU32(nil, uint32(i+1))))
+	}
+
+	if locals == nil {
+		locals = []byte{0}
+	}
+	body := concat(locals, code, []byte{opEnd})
+
+	return concat(
+		[]byte("\x00asm\x01\x00\x00\x00"),
+		testSection(1, vector(types)),
+		testSection(2, vector(importEntries)),
+		testSection(3, vector([][]byte{{0x00}})),
+		testSection(5, vector([][]byte{{0x00, 0x01}})),
+		testSection(7, vector([][]byte{
+			concat(name(unlockExport), []byte{0x00}, writeU32(nil, uint32(len(imports)))),
+			concat(name("memory"), []byte{0x02, 0x00}),
+		})),
+		testSection(10, vector([][]byte{concat(writeU32(nil, uint32(len(body))), body)})),
+	)
+}
+
+// countingLoop returns the code of a function that counts its first local to the given limit and returns 1.
+func countingLoop(limit int32) []byte {
+	return concat(
+		[]byte{0x03, 0x40, 0x20, 0x00}, i32Const(1), []byte{0x6a, 0x22, 0x00}, i32Const(limit), []byte{0x49, 0x0d, 0x00, 0x0b},
+		i32Const(1),
+	)
+}
+
+func testSection(id byte, payload []byte) []byte {
+	return concat([]byte{id}, writeU32(nil, uint32(len(payload))), payload)
+}
+
+func i32Const(value int32) []byte {
+	return writeS64([]byte{0x41}, int64(value))
+}
+
+func name(value string) []byte {
+	return concat(writeU32(nil, uint32(len(value))), []byte(value))
+}
+
+func vector(entries [][]byte) []byte {
+	return concat(append([][]byte{writeU32(nil, uint32(len(entries)))}, entries...)...)
+}
+
+func bytesOf(values []byte) [][]byte {
+	return lo.Map(values, func(value byte) []byte { return []byte{value} })
+}
+
+func concat(parts ...[]byte) (result []byte) {
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+
+	return result
+}
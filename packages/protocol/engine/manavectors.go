@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/stream"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+// region ManaVectors //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ManaVectors contains the consensus and access mana of the identities at the target slot of a snapshot. They are
+// included in the snapshot, so that an importing node can verify that it derives the same mana from the unspent outputs
+// as the node that created the snapshot.
+type ManaVectors struct {
+	// ConsensusManaByID contains the consensus mana that is pledged to the identities.
+	ConsensusManaByID map[identity.ID]int64
+
+	// AccessManaByID contains the access mana that is pledged to the identities.
+	AccessManaByID map[identity.ID]int64
+}
+
+// NewManaVectors creates new (empty) ManaVectors.
+func NewManaVectors() *ManaVectors {
+	return &ManaVectors{
+		ConsensusManaByID: make(map[identity.ID]int64),
+		AccessManaByID:    make(map[identity.ID]int64),
+	}
+}
+
+// Export writes the ManaVectors to the given writer (ordered by identity, so that the same state always results in the
+// same bytes).
+func (m *ManaVectors) Export(writer io.WriteSeeker) (err error) {
+	return stream.WriteCollection(writer, func() (elementsCount uint64, err error) {
+		for _, id := range m.IDs() {
+			if err = stream.WriteSerializable(writer, id, identity.IDLength); err != nil {
+				return 0, errors.Wrapf(err, "failed to write identity %s", id)
+			} else if err = stream.Write(writer, m.ConsensusManaByID[id]); err != nil {
+				return 0, errors.Wrapf(err, "failed to write consensus mana of %s", id)
+			} else if err = stream.Write(writer, m.AccessManaByID[id]); err != nil {
+				return 0, errors.Wrapf(err, "failed to write access mana of %s", id)
+			}
+
+			elementsCount++
+		}
+
+		return elementsCount, nil
+	})
+}
+
+// Import reads the ManaVectors from the given reader.
+func (m *ManaVectors) Import(reader io.ReadSeeker) (err error) {
+	var id identity.ID
+
+	return stream.ReadCollection(reader, func(i int) (err error) {
+		if err = stream.ReadSerializable(reader, &id, identity.IDLength); err != nil {
+			return errors.Wrapf(err, "failed to read identity %d", i)
+		}
+
+		if m.ConsensusManaByID[id], err = stream.Read[int64](reader); err != nil {
+			return errors.Wrapf(err, "failed to read consensus mana of %s", id)
+		} else if m.AccessManaByID[id], err = stream.Read[int64](reader); err != nil {
+			return errors.Wrapf(err, "failed to read access mana of %s", id)
+		}
+
+		return nil
+	})
+}
+
+// IDs returns the ordered identities that have consensus or access mana.
+func (m *ManaVectors) IDs() (ids []identity.ID) {
+	idsWithMana := make(map[identity.ID]bool)
+	for _, manaByID := range []map[identity.ID]int64{m.ConsensusManaByID, m.AccessManaByID} {
+		for id, mana := range manaByID {
+			if mana != 0 && !idsWithMana[id] {
+				idsWithMana[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	return ids
+}
+
+// addOutput adds the IOTA balance of the given output (multiplied by the given factor) to the mana of the identities
+// that the output pledges to.
+func (m *ManaVectors) addOutput(output *mempool.OutputWithMetadata, factor int64) {
+	if balance, exists := output.IOTABalance(); exists {
+		m.ConsensusManaByID[output.ConsensusManaPledgeID()] += factor * int64(balance)
+		m.AccessManaByID[output.AccessManaPledgeID()] += factor * int64(balance)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Engine ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// manaVectors returns the ManaVectors that are pledged by the unspent outputs at the given target slot (the unspent
+// outputs of the latest commitment with the state diffs of the later slots being rolled back).
+func (e *Engine) manaVectors(targetSlot slot.Index) (manaVectors *ManaVectors, err error) {
+	manaVectors = NewManaVectors()
+
+	if _, err = e.Ledger.UnspentOutputs().ForEachUnspentOutput(context.Background(), func(output *mempool.OutputWithMetadata) bool {
+		manaVectors.addOutput(output, 1)
+		return true
+	}, ledger.WithConsistentView()); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate over unspent outputs")
+	}
+
+	for currentSlot := e.Storage.Settings.LatestCommitment().Index(); currentSlot > targetSlot; currentSlot-- {
+		if err = e.Ledger.StateDiffs().StreamCreatedOutputs(currentSlot, func(output *mempool.OutputWithMetadata) error {
+			manaVectors.addOutput(output, -1)
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to roll back created outputs of slot %d", currentSlot)
+		} else if err = e.Ledger.StateDiffs().StreamSpentOutputs(currentSlot, func(output *mempool.OutputWithMetadata) error {
+			manaVectors.addOutput(output, 1)
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to roll back spent outputs of slot %d", currentSlot)
+		}
+	}
+
+	return manaVectors, nil
+}
+
+// exportManaVectors writes the ManaVectors of the given target slot to the given writer.
+func (e *Engine) exportManaVectors(writer io.WriteSeeker, targetSlot slot.Index) (err error) {
+	manaVectors, err := e.manaVectors(targetSlot)
+	if err != nil {
+		return errors.Wrapf(err, "failed to determine mana vectors of slot %d", targetSlot)
+	}
+
+	return manaVectors.Export(writer)
+}
+
+// importManaVectors reads the ManaVectors from the given reader (snapshots that were created before the ManaVectors
+// were added to the format end before them and are imported without them).
+func (e *Engine) importManaVectors(reader io.ReadSeeker) (err error) {
+	manaVectors := NewManaVectors()
+	if err = manaVectors.Import(reader); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return err
+	}
+
+	e.importedManaVectors = manaVectors
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
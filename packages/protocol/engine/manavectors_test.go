@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+func TestManaVectors_ExportImport(t *testing.T) {
+	manaVectors := NewManaVectors()
+	manaVectors.ConsensusManaByID[identity.ID{2}] = 100
+	manaVectors.ConsensusManaByID[identity.ID{3}] = 0
+	manaVectors.AccessManaByID[identity.ID{1}] = 50
+	manaVectors.AccessManaByID[identity.ID{2}] = 50
+	require.Equal(t, []identity.ID{{1}, {2}}, manaVectors.IDs())
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "manavectors"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	require.NoError(t, manaVectors.Export(file))
+	_, err = file.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	importedManaVectors := NewManaVectors()
+	require.NoError(t, importedManaVectors.Import(file))
+	require.Equal(t, map[identity.ID]int64{{1}: 0, {2}: 100}, importedManaVectors.ConsensusManaByID)
+	require.Equal(t, map[identity.ID]int64{{1}: 50, {2}: 50}, importedManaVectors.AccessManaByID)
+
+	// snapshots without mana vectors end before them
+	require.ErrorIs(t, NewManaVectors().Import(file), io.EOF)
+}
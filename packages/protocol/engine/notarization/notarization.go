@@ -3,14 +3,22 @@ package notarization
 import (
 	"io"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/outputproof"
 	"github.com/iotaledger/goshimmer/packages/core/traits"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/crypto/identity"
 )
 
+// ErrRootsUnavailable is returned if a proof is requested before the roots of the latest commitment are known (they
+// are only kept in memory and become available with the first commitment after a restart).
+var ErrRootsUnavailable = errors.New("roots of the latest commitment are not available")
+
 type Notarization interface {
 	Events() *Events
 
@@ -29,6 +37,10 @@ type Notarization interface {
 
 	PerformLocked(perform func(m Notarization))
 
+	// ProveOutputInclusion returns a proof that the given output is unspent in the ledger state of the latest
+	// commitment.
+	ProveOutputInclusion(outputID utxo.OutputID) (proof *outputproof.Proof, err error)
+
 	module.Interface
 }
 
@@ -9,10 +9,12 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/module"
+	"github.com/iotaledger/goshimmer/packages/core/outputproof"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
@@ -37,6 +39,7 @@ type Manager struct {
 
 	storage         *storage.Storage
 	ledgerState     ledger.Ledger
+	latestRoots     *commitment.Roots
 	commitmentMutex sync.RWMutex
 
 	acceptanceTime      time.Time
@@ -243,16 +246,18 @@ func (m *Manager) createCommitment(index slot.Index) (success bool) {
 		return false
 	}
 
+	roots := commitment.NewRoots(
+		acceptedBlocks.Root(),
+		acceptedTransactions.Root(),
+		attestations.Root(),
+		m.ledgerState.UnspentOutputs().IDs().Root(),
+		m.slotMutations.weights.Root(),
+	)
+
 	newCommitment := commitment.New(
 		index,
 		latestCommitment.ID(),
-		commitment.NewRoots(
-			acceptedBlocks.Root(),
-			acceptedTransactions.Root(),
-			attestations.Root(),
-			m.ledgerState.UnspentOutputs().IDs().Root(),
-			m.slotMutations.weights.Root(),
-		).ID(),
+		roots.ID(),
 		m.storage.Settings.LatestCommitment().CumulativeWeight()+attestationsWeight,
 	)
 
@@ -265,6 +270,7 @@ func (m *Manager) createCommitment(index slot.Index) (success bool) {
 		m.events.Error.Trigger(errors.Wrap(err, "failed to store latest commitment"))
 		return false
 	}
+	m.latestRoots = roots
 
 	m.events.SlotCommitted.Trigger(&notarization.SlotCommittedDetails{
 		Commitment:           newCommitment,
@@ -282,6 +288,29 @@ func (m *Manager) createCommitment(index slot.Index) (success bool) {
 	return true
 }
 
+// ProveOutputInclusion returns a proof that the given output is unspent in the ledger state of the latest commitment.
+func (m *Manager) ProveOutputInclusion(outputID utxo.OutputID) (proof *outputproof.Proof, err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	latestCommitment := m.storage.Settings.LatestCommitment()
+	if m.latestRoots == nil {
+		return nil, errors.WithMessagef(notarization.ErrRootsUnavailable, "latest commitment is %s", latestCommitment.ID())
+	}
+
+	// the unspent outputs are only modified while committing, so they reflect the state of the latest commitment
+	if stateRoot := m.ledgerState.UnspentOutputs().IDs().Root(); stateRoot != m.latestRoots.StateRoot() {
+		return nil, errors.Errorf("state root %s does not match the state root of %s", stateRoot, latestCommitment.ID())
+	}
+
+	merkleProof, err := m.ledgerState.UnspentOutputs().ProveInclusion(outputID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to prove inclusion of %s in %s", outputID, latestCommitment.ID())
+	}
+
+	return outputproof.New(latestCommitment, m.latestRoots, outputID, merkleProof)
+}
+
 func (m *Manager) PerformLocked(perform func(m notarization.Notarization)) {
 	m.commitmentMutex.Lock()
 	defer m.commitmentMutex.Unlock()
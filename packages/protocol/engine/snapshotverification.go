@@ -0,0 +1,266 @@
+package engine
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/verifier"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/crypto/identity"
+)
+
+// region snapshotVerification /////////////////////////////////////////////////////////////////////////////////////////
+
+// snapshotVerification verifies the state of the Engine after a snapshot was imported. The individual elements are
+// verified by parallel workers while the totals are aggregated and compared once all elements were verified.
+type snapshotVerification struct {
+	engine           *Engine
+	workerCount      int
+	verifySignatures bool
+
+	consensusManaByID map[identity.ID]int64
+	accessManaByID    map[identity.ID]int64
+	totalBalance      uint64
+	totalsMutex       sync.Mutex
+}
+
+// newSnapshotVerification creates a new snapshotVerification for the given Engine.
+func newSnapshotVerification(engine *Engine, workerCount int, verifySignatures bool) *snapshotVerification {
+	return &snapshotVerification{
+		engine:            engine,
+		workerCount:       workerCount,
+		verifySignatures:  verifySignatures,
+		consensusManaByID: make(map[identity.ID]int64),
+		accessManaByID:    make(map[identity.ID]int64),
+	}
+}
+
+// Verify verifies the commitment chain, the unspent outputs, the mana totals, the mana vectors of the snapshot and (if
+// enabled) the attestation signatures of the Engine.
+func (s *snapshotVerification) Verify() (err error) {
+	if err = s.verifyCommitments(); err != nil {
+		return errors.Wrap(err, "failed to verify commitments")
+	} else if err = s.verifyUnspentOutputs(); err != nil {
+		return errors.Wrap(err, "failed to verify unspent outputs")
+	} else if err = s.verifyManaTotals(); err != nil {
+		return errors.Wrap(err, "failed to verify mana totals")
+	} else if err = s.verifyManaVectors(); err != nil {
+		return errors.Wrap(err, "failed to verify mana vectors")
+	} else if !s.verifySignatures {
+		return nil
+	} else if err = s.verifyAttestations(); err != nil {
+		return errors.Wrap(err, "failed to verify attestations")
+	}
+
+	return nil
+}
+
+// verifyCommitments checks that every imported commitment references its predecessor and that the latest commitment
+// of the settings is part of the chain.
+func (s *snapshotVerification) verifyCommitments() (err error) {
+	latestCommitment := s.engine.Storage.Settings.LatestCommitment()
+
+	commitmentVerifier := verifier.New(s.workerCount, s.verifyCommitment)
+	for index := slot.Index(0); index <= latestCommitment.Index(); index++ {
+		commitmentVerifier.Verify(index)
+	}
+
+	if err = commitmentVerifier.Wait(); err != nil {
+		return err
+	}
+
+	if storedCommitment, loadErr := s.engine.Storage.Commitments.Load(latestCommitment.Index()); loadErr != nil {
+		return errors.Wrapf(loadErr, "failed to load latest commitment of slot %d", latestCommitment.Index())
+	} else if storedCommitment.ID() != latestCommitment.ID() {
+		return errors.WithMessagef(verifier.ErrVerificationFailed, "latest commitment %s does not match the stored commitment %s", latestCommitment.ID(), storedCommitment.ID())
+	}
+
+	return nil
+}
+
+// verifyCommitment checks that the commitment of the given slot references the commitment of the previous slot.
+func (s *snapshotVerification) verifyCommitment(index slot.Index) (err error) {
+	currentCommitment, err := s.engine.Storage.Commitments.Load(index)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load commitment of slot %d", index)
+	} else if currentCommitment.Index() != index {
+		return errors.Errorf("commitment of slot %d has index %d", index, currentCommitment.Index())
+	} else if index == 0 {
+		return nil
+	}
+
+	var previousCommitment *commitment.Commitment
+	if previousCommitment, err = s.engine.Storage.Commitments.Load(index - 1); err != nil {
+		return errors.Wrapf(err, "failed to load commitment of slot %d", index-1)
+	}
+
+	if currentCommitment.PrevID() != previousCommitment.ID() {
+		return errors.Errorf("commitment of slot %d references %s instead of %s", index, currentCommitment.PrevID(), previousCommitment.ID())
+	} else if currentCommitment.CumulativeWeight() < previousCommitment.CumulativeWeight() {
+		return errors.Errorf("cumulative weight of slot %d (%d) is smaller than the one of slot %d (%d)", index, currentCommitment.CumulativeWeight(), index-1, previousCommitment.CumulativeWeight())
+	}
+
+	return nil
+}
+
+// verifyUnspentOutputs checks that all unspent outputs are stored in the MemPool and sums up their balances.
+func (s *snapshotVerification) verifyUnspentOutputs() (err error) {
+	outputVerifier := verifier.New(s.workerCount, s.verifyUnspentOutput)
+	if streamErr := s.engine.Ledger.UnspentOutputs().IDs().Stream(func(outputID utxo.OutputID) bool {
+		outputVerifier.Verify(outputID)
+		return true
+	}); streamErr != nil {
+		err = errors.Wrap(streamErr, "failed to stream unspent output IDs")
+	}
+
+	if verificationErr := outputVerifier.Wait(); err == nil {
+		err = verificationErr
+	}
+
+	return err
+}
+
+// verifyUnspentOutput checks that the given unspent output is stored in the MemPool and adds its balance to the totals.
+func (s *snapshotVerification) verifyUnspentOutput(outputID utxo.OutputID) (err error) {
+	var outputWithMetadata *mempool.OutputWithMetadata
+	if !s.engine.Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
+		if output.ID() != outputID {
+			err = errors.Errorf("output stored as %s has ID %s", outputID, output.ID())
+			return
+		}
+
+		if !s.engine.Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(metadata *mempool.OutputMetadata) {
+			outputWithMetadata = mempool.NewOutputWithMetadata(metadata.InclusionSlot(), outputID, output, metadata.ConsensusManaPledgeID(), metadata.AccessManaPledgeID())
+		}) {
+			err = errors.Errorf("failed to load metadata of %s", outputID)
+		}
+	}) {
+		return errors.Errorf("failed to load %s", outputID)
+	} else if err != nil {
+		return err
+	}
+
+	balance, exists := outputWithMetadata.IOTABalance()
+	if !exists {
+		return nil
+	}
+
+	s.totalsMutex.Lock()
+	defer s.totalsMutex.Unlock()
+
+	if s.totalBalance+balance < s.totalBalance {
+		return errors.Errorf("balance of %s overflows the total balance", outputID)
+	}
+
+	s.totalBalance += balance
+	s.consensusManaByID[outputWithMetadata.ConsensusManaPledgeID()] += int64(balance)
+	s.accessManaByID[outputWithMetadata.AccessManaPledgeID()] += int64(balance)
+
+	return nil
+}
+
+// verifyManaTotals checks that the weights of the SybilProtection and the balances of the ThroughputQuota match the
+// mana that is pledged by the unspent outputs.
+func (s *snapshotVerification) verifyManaTotals() (err error) {
+	weightsByID := make(map[identity.ID]int64)
+	if err = s.engine.SybilProtection.Weights().ForEach(func(id identity.ID, weight *sybilprotection.Weight) bool {
+		weightsByID[id] = weight.Value
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to iterate over weights")
+	}
+
+	if err = compareManaByID("consensus", s.consensusManaByID, weightsByID); err != nil {
+		return err
+	}
+
+	return compareManaByID("access", s.accessManaByID, s.engine.ThroughputQuota.BalanceByIDs())
+}
+
+// verifyManaVectors checks that the mana vectors that are included in the snapshot match the mana that is pledged by
+// the unspent outputs (snapshots without mana vectors are not checked).
+func (s *snapshotVerification) verifyManaVectors() (err error) {
+	if s.engine.importedManaVectors == nil {
+		return nil
+	}
+
+	if err = compareManaByID("consensus", s.consensusManaByID, s.engine.importedManaVectors.ConsensusManaByID); err != nil {
+		return err
+	}
+
+	return compareManaByID("access", s.accessManaByID, s.engine.importedManaVectors.AccessManaByID)
+}
+
+// verifyAttestations checks the signatures of the attestations of the latest commitment (the attestations of the genesis
+// slot are created by the snapshot creator and are not signed).
+func (s *snapshotVerification) verifyAttestations() (err error) {
+	latestCommitmentIndex := s.engine.Storage.Settings.LatestCommitment().Index()
+	if latestCommitmentIndex == 0 {
+		return nil
+	}
+
+	attestations, err := s.engine.Notarization.Attestations().Get(latestCommitmentIndex)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load attestations of slot %d", latestCommitmentIndex)
+	}
+
+	attestationVerifier := verifier.New(s.workerCount, verifyAttestation)
+	if streamErr := attestations.Stream(func(_ identity.ID, attestation *notarization.Attestation) bool {
+		attestationVerifier.Verify(attestation)
+		return true
+	}); streamErr != nil {
+		err = errors.Wrapf(streamErr, "failed to stream attestations of slot %d", latestCommitmentIndex)
+	}
+
+	if verificationErr := attestationVerifier.Wait(); err == nil {
+		err = verificationErr
+	}
+
+	return err
+}
+
+// verifyAttestation checks the signature of the given Attestation.
+func verifyAttestation(attestation *notarization.Attestation) (err error) {
+	if valid, verifyErr := attestation.VerifySignature(); verifyErr != nil {
+		return errors.Wrapf(verifyErr, "failed to verify signature of attestation of %s", attestation.IssuerID())
+	} else if !valid {
+		return errors.Errorf("invalid signature of attestation of %s", attestation.IssuerID())
+	}
+
+	return nil
+}
+
+// compareManaByID checks that the expected and the actual mana of all identities match (identities without mana are
+// ignored). The identities are compared in a deterministic order, so that the same mismatch is reported on every run.
+func compareManaByID(manaType string, expected, actual map[identity.ID]int64) (err error) {
+	ids := make([]identity.ID, 0, len(expected)+len(actual))
+	for id := range expected {
+		ids = append(ids, id)
+	}
+	for id := range actual {
+		if _, exists := expected[id]; !exists {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	for _, id := range ids {
+		if expected[id] != actual[id] {
+			return errors.WithMessagef(verifier.ErrVerificationFailed, "%s mana of %s is %d but the unspent outputs pledge %d", manaType, id, actual[id], expected[id])
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,7 @@
 package booker
 
 import (
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
@@ -17,6 +18,8 @@ type Block struct {
 	structureDetails      *markers.StructureDetails
 	addedConflictIDs      utxo.TransactionIDs
 	subtractedConflictIDs utxo.TransactionIDs
+	rejectionReason       mempool.RejectionReason
+	rejectedTransactionID utxo.TransactionID
 
 	*blockdag.Block
 }
@@ -45,6 +48,15 @@ func (b *Block) AddConflictID(conflictID utxo.TransactionID) (modified bool) {
 	return b.addedConflictIDs.Add(conflictID)
 }
 
+// DeleteAddedConflictID removes the given ConflictID from the ConflictIDs of the added Conflicts (i.e. after the
+// Conflict was merged into the master branch).
+func (b *Block) DeleteAddedConflictID(conflictID utxo.TransactionID) (modified bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.addedConflictIDs.Delete(conflictID)
+}
+
 // AddedConflictIDs returns the ConflictIDs of the added Conflicts of the Block.
 func (b *Block) AddedConflictIDs() utxo.TransactionIDs {
 	b.RLock()
@@ -90,6 +102,46 @@ func (b *Block) SetSubjectivelyInvalid(bool) (wasUpdated bool) {
 	return
 }
 
+// IsRejected returns true if the Block attaches a Transaction that got rejected or if it is in the future cone of such
+// a Block.
+func (b *Block) IsRejected() bool {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.rejectionReason != mempool.NoRejectionReason
+}
+
+// RejectionReason returns the reason why the Transaction that rejected the Block got rejected.
+func (b *Block) RejectionReason() mempool.RejectionReason {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.rejectionReason
+}
+
+// RejectedTransactionID returns the ID of the rejected Transaction that is attached by the Block or by a Block in its
+// past cone.
+func (b *Block) RejectedTransactionID() utxo.TransactionID {
+	b.RLock()
+	defer b.RUnlock()
+
+	return b.rejectedTransactionID
+}
+
+// SetRejected marks the Block as rejected because the given Transaction (attached by the Block or by a Block in its
+// past cone) got rejected for the given reason.
+func (b *Block) SetRejected(rejectedTransactionID utxo.TransactionID, rejectionReason mempool.RejectionReason) (wasUpdated bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if wasUpdated = b.rejectionReason != rejectionReason; wasUpdated {
+		b.rejectionReason = rejectionReason
+		b.rejectedTransactionID = rejectedTransactionID
+	}
+
+	return
+}
+
 func NewBlock(block *blockdag.Block, opts ...options.Option[Block]) (newBlock *Block) {
 	return options.Apply(&Block{
 		Block:                 block,
@@ -147,6 +199,8 @@ func (b *Block) String() string {
 	builder.AddField(stringify.NewStructField("StructureDetails", b.structureDetails))
 	builder.AddField(stringify.NewStructField("AddedConflictIDs", b.addedConflictIDs))
 	builder.AddField(stringify.NewStructField("SubtractedConflictIDs", b.subtractedConflictIDs))
+	builder.AddField(stringify.NewStructField("RejectionReason", b.rejectionReason))
+	builder.AddField(stringify.NewStructField("RejectedTransactionID", b.rejectedTransactionID))
 
 	return builder.String()
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
 	"github.com/iotaledger/goshimmer/packages/core/votes/sequencetracker"
 	"github.com/iotaledger/goshimmer/packages/core/votes/slottracker"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
 	"github.com/iotaledger/hive.go/runtime/event"
@@ -15,6 +16,8 @@ type Events struct {
 	AttachmentOrphaned  *event.Event1[*Block]
 	BlockConflictAdded  *event.Event1[*BlockConflictAddedEvent]
 	MarkerConflictAdded *event.Event1[*MarkerConflictAddedEvent]
+	BlockConflictMerged *event.Event1[*BlockConflictMergedEvent]
+	BlockRejected       *event.Event1[*BlockRejectedEvent]
 	Error               *event.Event1[error]
 
 	SequenceEvicted *event.Event1[markers.SequenceID]
@@ -32,6 +35,8 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		AttachmentOrphaned:  event.New1[*Block](),
 		BlockConflictAdded:  event.New1[*BlockConflictAddedEvent](),
 		MarkerConflictAdded: event.New1[*MarkerConflictAddedEvent](),
+		BlockConflictMerged: event.New1[*BlockConflictMergedEvent](),
+		BlockRejected:       event.New1[*BlockRejectedEvent](),
 		Error:               event.New1[error](),
 
 		SequenceEvicted: event.New1[markers.SequenceID](),
@@ -51,6 +56,22 @@ type MarkerConflictAddedEvent struct {
 	ParentConflictIDs utxo.TransactionIDs
 }
 
+// BlockConflictMergedEvent is triggered for the Blocks that supported a Conflict that was merged into the master branch
+// (the attachments of its Transaction and their future cone).
+type BlockConflictMergedEvent struct {
+	Block            *Block
+	MergedConflictID utxo.TransactionID
+}
+
+// BlockRejectedEvent is triggered for the attachments of a Transaction that got rejected and for the Blocks in their
+// (strong) future cone, so that Blocks that will never be part of the ledger can be told apart from Blocks that are
+// still pending.
+type BlockRejectedEvent struct {
+	Block           *Block
+	TransactionID   utxo.TransactionID
+	RejectionReason mempool.RejectionReason
+}
+
 type BlockBookedEvent struct {
 	Block       *Block
 	ConflictIDs utxo.TransactionIDs
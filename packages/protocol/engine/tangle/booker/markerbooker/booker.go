@@ -138,6 +138,12 @@ func (b *Booker) Initialize(blockDAG blockdag.BlockDAG) {
 			b.events.Error.Trigger(errors.Wrapf(err, "failed to propagate Conflict update of %s to BlockDAG", event.TransactionID))
 		}
 	})
+	b.MemPool.Events().TransactionRejected.Hook(func(txMetadata *mempool.TransactionMetadata) {
+		b.RejectAttachments(txMetadata.ID(), txMetadata.RejectionReason())
+	})
+	b.MemPool.Events().TransactionConflictIDsMerged.Hook(func(event *mempool.TransactionConflictIDsMergedEvent) {
+		b.MergeConflictToMaster(event.TransactionID, event.MergedConflictID)
+	})
 	b.MemPool.Events().TransactionBooked.Hook(func(e *mempool.TransactionBookedEvent) {
 		contextBlockID := models.BlockIDFromContext(e.Context)
 
@@ -318,6 +324,7 @@ func (b *Booker) evict(slotIndex slot.Index) {
 	defer b.evictionMutex.Unlock()
 
 	b.attachments.Evict(slotIndex)
+	b.MemPool.Attachments().Evict(slotIndex)
 	b.markerManager.Evict(slotIndex)
 	b.blocks.Evict(slotIndex)
 }
@@ -329,6 +336,7 @@ func (b *Booker) isPayloadSolid(block *booker.Block) (isPayloadSolid bool, err e
 	}
 
 	if b.attachments.Store(tx.ID(), block) {
+		b.MemPool.Attachments().Register(tx.ID(), block.ID(), block.IssuingTime())
 		b.events.AttachmentCreated.Trigger(block)
 	}
 
@@ -393,6 +401,8 @@ func (b *Booker) book(block *booker.Block) (inheritingErr error) {
 
 	b.virtualVoting.Track(block, inheritedConflitIDs)
 
+	b.rejectBookedBlock(block)
+
 	return nil
 }
 
@@ -634,6 +644,7 @@ func (b *Booker) OrphanAttachment(block *booker.Block) {
 		attachmentBlock, attachmentOrphaned, lastAttachmentOrphaned := b.attachments.AttachmentOrphaned(tx.ID(), block)
 
 		if attachmentOrphaned {
+			b.MemPool.Attachments().Unregister(tx.ID(), attachmentBlock.ID())
 			b.events.AttachmentOrphaned.Trigger(attachmentBlock)
 		}
 
@@ -794,6 +805,129 @@ func (b *Booker) forkSingleMarker(currentMarker markers.Marker, newConflictID ut
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region MERGE LOGIC //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MergeConflictToMaster removes the merged Conflict from the attachments of the given Transaction (whose ConflictIDs
+// were rewritten to the master branch by the MemPool) and from the Blocks and Markers in their future cone.
+func (b *Booker) MergeConflictToMaster(transactionID, mergedConflictID utxo.TransactionID) {
+	for _, block := range b.mergedConflictSupporters(transactionID, mergedConflictID) {
+		b.mergeConflictToMaster(block, mergedConflictID)
+	}
+}
+
+// mergedConflictSupporters returns the Blocks in the future cone of the attachments of the given Transaction that
+// support the merged Conflict (they are collected before any of them is updated, as the Conflicts of a Block can be
+// inherited through the Markers of its past cone).
+func (b *Booker) mergedConflictSupporters(transactionID, mergedConflictID utxo.TransactionID) (supporters []*booker.Block) {
+	blockWalker := walker.New[*booker.Block]()
+
+	for it := b.GetAllAttachments(transactionID).Iterator(); it.HasNext(); {
+		attachment := it.Next()
+		blockWalker.Push(attachment)
+
+		// weak and like references only vote on the parent, so the merge only needs to visit their direct children.
+		blockWalker.PushAll(b.blocksFromBlockDAGBlocks(attachment.WeakChildren())...)
+		blockWalker.PushAll(b.blocksFromBlockDAGBlocks(attachment.LikedInsteadChildren())...)
+	}
+
+	for blockWalker.HasNext() {
+		block := blockWalker.Next()
+		if !block.IsBooked() {
+			continue
+		}
+
+		if _, conflictIDs := b.BlockBookingDetails(block); conflictIDs.Has(mergedConflictID) {
+			supporters = append(supporters, block)
+			blockWalker.PushAll(b.blocksFromBlockDAGBlocks(block.StrongChildren())...)
+		}
+	}
+
+	return supporters
+}
+
+// mergeConflictToMaster removes the merged Conflict from the added ConflictIDs of the given Block and from the
+// ConflictIDs that are mapped to the Marker of the Block.
+func (b *Booker) mergeConflictToMaster(block *booker.Block, mergedConflictID utxo.TransactionID) {
+	b.bookingMutex.Lock(block.ID())
+	block.DeleteAddedConflictID(mergedConflictID)
+	b.bookingMutex.Unlock(block.ID())
+
+	if structureDetails := block.StructureDetails(); structureDetails.IsPastMarker() {
+		b.mergeConflictToMasterInMarker(structureDetails.PastMarkers().Marker(), mergedConflictID)
+	}
+
+	b.events.BlockConflictMerged.Trigger(&booker.BlockConflictMergedEvent{
+		Block:            block,
+		MergedConflictID: mergedConflictID,
+	})
+}
+
+// mergeConflictToMasterInMarker removes the merged Conflict from the ConflictIDs that are mapped to the given Marker.
+func (b *Booker) mergeConflictToMasterInMarker(marker markers.Marker, mergedConflictID utxo.TransactionID) {
+	b.markerManager.SequenceMutex.Lock(marker.SequenceID())
+	defer b.markerManager.SequenceMutex.Unlock(marker.SequenceID())
+
+	if conflictIDs := b.markerManager.ConflictIDs(marker); conflictIDs.Delete(mergedConflictID) {
+		b.markerManager.SetConflictIDs(marker, conflictIDs)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region REJECTION LOGIC //////////////////////////////////////////////////////////////////////////////////////////////
+
+// RejectAttachments marks the attachments of the given rejected Transaction and the Blocks in their strong future cone as
+// rejected for the given reason.
+func (b *Booker) RejectAttachments(transactionID utxo.TransactionID, rejectionReason mempool.RejectionReason) {
+	for it := b.GetAllAttachments(transactionID).Iterator(); it.HasNext(); {
+		b.rejectFutureCone(it.Next(), transactionID, rejectionReason)
+	}
+}
+
+// rejectBookedBlock marks a newly booked Block as rejected if its Transaction or one of its strong parents was already
+// rejected before it was booked.
+func (b *Booker) rejectBookedBlock(block *booker.Block) {
+	if tx, isTransaction := block.Transaction(); isTransaction {
+		b.MemPool.Storage().CachedTransactionMetadata(tx.ID()).Consume(func(txMetadata *mempool.TransactionMetadata) {
+			if rejectionReason := txMetadata.RejectionReason(); rejectionReason != mempool.NoRejectionReason {
+				b.rejectFutureCone(block, tx.ID(), rejectionReason)
+			}
+		})
+
+		if block.IsRejected() {
+			return
+		}
+	}
+
+	for parentID := range block.ParentsByType(models.StrongParentType) {
+		if parent, exists := b.Block(parentID); exists && parent.IsRejected() {
+			b.rejectFutureCone(block, parent.RejectedTransactionID(), parent.RejectionReason())
+			return
+		}
+	}
+}
+
+// rejectFutureCone marks the given Block and the Blocks in its strong future cone as rejected because of the given
+// Transaction.
+func (b *Booker) rejectFutureCone(block *booker.Block, transactionID utxo.TransactionID, rejectionReason mempool.RejectionReason) {
+	for blockWalker := walker.New[*booker.Block]().Push(block); blockWalker.HasNext(); {
+		currentBlock := blockWalker.Next()
+		if !currentBlock.SetRejected(transactionID, rejectionReason) {
+			continue
+		}
+
+		b.events.BlockRejected.Trigger(&booker.BlockRejectedEvent{
+			Block:           currentBlock,
+			TransactionID:   transactionID,
+			RejectionReason: rejectionReason,
+		})
+
+		blockWalker.PushAll(b.blocksFromBlockDAGBlocks(currentBlock.StrongChildren())...)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Utils //////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func (b *Booker) rLockBlockSequences(block *booker.Block) bool {
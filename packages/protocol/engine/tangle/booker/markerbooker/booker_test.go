@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/eviction"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
@@ -887,6 +889,114 @@ func Test_BlockInvalid(t *testing.T) {
 	})
 }
 
+func TestMergeConflictToMaster(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := markerbooker.NewDefaultTestFramework(t, workers.CreateGroup("BookerTestFramework"), realitiesledger.NewTestLedger(t, workers.CreateGroup("RealitiesLedger"), realitiesledger.WithConflictDAGOptions(
+		conflictdag.CompactResolvedConflicts[utxo.TransactionID, utxo.OutputID](true),
+	)))
+
+	var mergedBlocksMutex sync.Mutex
+	mergedBlocks := make(map[models.BlockID]utxo.TransactionID)
+	tf.Instance.Events().BlockConflictMerged.Hook(func(event *booker.BlockConflictMergedEvent) {
+		mergedBlocksMutex.Lock()
+		defer mergedBlocksMutex.Unlock()
+
+		mergedBlocks[event.Block.ID()] = event.MergedConflictID
+	})
+
+	tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithPayload(tf.Ledger.CreateTransaction("TX1", 1, "Genesis")))
+	tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.Ledger.CreateTransaction("TX2", 1, "TX1.0")))
+	tf.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.Ledger.CreateTransaction("TX3", 1, "TX1.0")))
+	tf.BlockDAG.CreateBlock("Block4", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")))
+
+	tf.BlockDAG.IssueBlocks("Block1", "Block2", "Block3", "Block4")
+	workers.WaitChildren()
+
+	tf.CheckConflictIDs(map[string]utxo.TransactionIDs{
+		"Block1": utxo.NewTransactionIDs(),
+		"Block2": tf.Ledger.TransactionIDs("TX2"),
+		"Block3": tf.Ledger.TransactionIDs("TX3"),
+		"Block4": tf.Ledger.TransactionIDs("TX2"),
+	})
+
+	require.True(t, tf.Ledger.Instance.ConflictDAG().SetConflictAccepted(tf.Ledger.Transaction("TX2").ID()))
+	workers.WaitChildren()
+
+	tf.CheckConflictIDs(map[string]utxo.TransactionIDs{
+		"Block1": utxo.NewTransactionIDs(),
+		"Block2": utxo.NewTransactionIDs(),
+		"Block3": tf.Ledger.TransactionIDs("TX3"),
+		"Block4": utxo.NewTransactionIDs(),
+	})
+
+	mergedBlocksMutex.Lock()
+	defer mergedBlocksMutex.Unlock()
+
+	require.Equal(t, map[models.BlockID]utxo.TransactionID{
+		tf.Block("Block2").ID(): tf.Ledger.Transaction("TX2").ID(),
+		tf.Block("Block4").ID(): tf.Ledger.Transaction("TX2").ID(),
+	}, mergedBlocks)
+}
+
+// Tests that the attachments of a rejected Transaction and the Blocks in their future cone are marked as rejected, also
+// if they are booked after the Transaction got rejected.
+func TestRejectAttachments(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	tf := markerbooker.NewDefaultTestFramework(t, workers.CreateGroup("BookerTestFramework"), realitiesledger.NewTestLedger(t, workers.CreateGroup("RealitiesLedger")))
+
+	var rejectedBlocksMutex sync.Mutex
+	rejectedBlocks := make(map[models.BlockID]utxo.TransactionID)
+	tf.Instance.Events().BlockRejected.Hook(func(event *booker.BlockRejectedEvent) {
+		rejectedBlocksMutex.Lock()
+		defer rejectedBlocksMutex.Unlock()
+
+		require.Equal(t, mempool.LosingConflict, event.RejectionReason)
+		rejectedBlocks[event.Block.ID()] = event.TransactionID
+	})
+
+	tf.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.BlockDAG.BlockIDs("Genesis")), models.WithPayload(tf.Ledger.CreateTransaction("TX1", 1, "Genesis")))
+	tf.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.Ledger.CreateTransaction("TX2", 1, "TX1.0")))
+	tf.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.Ledger.CreateTransaction("TX3", 1, "TX1.0")))
+	tf.BlockDAG.CreateBlock("Block4", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block3")))
+	tf.BlockDAG.CreateBlock("Block5", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block1")), models.WithPayload(tf.Ledger.Transaction("TX3")))
+	tf.BlockDAG.CreateBlock("Block6", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block4")))
+	tf.BlockDAG.CreateBlock("Block7", models.WithStrongParents(tf.BlockDAG.BlockIDs("Block2")), models.WithWeakParents(tf.BlockDAG.BlockIDs("Block3")))
+
+	tf.BlockDAG.IssueBlocks("Block1", "Block2", "Block3", "Block4")
+	workers.WaitChildren()
+
+	require.True(t, tf.Ledger.Instance.ConflictDAG().SetConflictAccepted(tf.Ledger.Transaction("TX2").ID()))
+	workers.WaitChildren()
+
+	tf.BlockDAG.IssueBlocks("Block5", "Block6", "Block7")
+	workers.WaitChildren()
+
+	for alias, expectedRejected := range map[string]bool{
+		"Block1": false,
+		"Block2": false,
+		"Block3": true,
+		"Block4": true,
+		"Block5": true,
+		"Block6": true,
+		"Block7": false,
+	} {
+		block, exists := tf.Instance.Block(tf.Block(alias).ID())
+		require.True(t, exists, "block %s should exist", alias)
+		require.Equal(t, expectedRejected, block.IsRejected(), "block %s has the wrong rejection state", alias)
+	}
+
+	rejectedBlocksMutex.Lock()
+	defer rejectedBlocksMutex.Unlock()
+
+	tx3ID := tf.Ledger.Transaction("TX3").ID()
+	require.Equal(t, map[models.BlockID]utxo.TransactionID{
+		tf.Block("Block3").ID(): tx3ID,
+		tf.Block("Block4").ID(): tx3ID,
+		tf.Block("Block5").ID(): tx3ID,
+		tf.Block("Block6").ID(): tx3ID,
+	}, rejectedBlocks)
+}
+
 func TestOTV_Track(t *testing.T) {
 	// TODO: extend this test to cover the following cases:
 	//  - when forking there is already a vote with higher power that should not be migrated
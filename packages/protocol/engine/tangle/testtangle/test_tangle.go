@@ -14,6 +14,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker/markerbooker"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker/markerbooker/markervirtualvoting"
 	"github.com/iotaledger/goshimmer/packages/protocol/markers"
+	"github.com/iotaledger/goshimmer/packages/storage"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -26,6 +27,7 @@ type TestTangle struct {
 	booker   *markerbooker.Booker
 
 	slotTimeProvider *slot.TimeProvider
+	storage          *storage.Storage
 	memPool          mempool.MemPool
 	evictionState    *eviction.State
 	validators       *sybilprotection.WeightedSet
@@ -39,6 +41,7 @@ func NewTestTangle(t *testing.T, workers *workerpool.Group, slotTimeProvider *sl
 	testTangle := &TestTangle{
 		events:           tangle.NewEvents(),
 		slotTimeProvider: slotTimeProvider,
+		storage:          storageInstance,
 		memPool:          memPool,
 		evictionState:    eviction.NewState(storageInstance),
 		validators:       validators,
@@ -89,6 +92,10 @@ func (t *TestTangle) SlotTimeProvider() *slot.TimeProvider {
 	return t.slotTimeProvider
 }
 
+func (t *TestTangle) Storage() *storage.Storage {
+	return t.storage
+}
+
 func (t *TestTangle) Validators() *sybilprotection.WeightedSet {
 	return t.validators
 }
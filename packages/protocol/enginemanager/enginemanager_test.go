@@ -1,6 +1,7 @@
 package enginemanager_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
@@ -156,6 +158,22 @@ func TestEngineManager_ForkEngineAtSlot(t *testing.T) {
 			return true
 		}))
 
+		// Paged iteration over the imported LedgerState
+		var expectedOutputIDs, iteratedOutputIDs []utxo.OutputID
+		require.NoError(t, tf.Instance.Ledger.UnspentOutputs().IDs().Stream(func(outputID utxo.OutputID) bool {
+			expectedOutputIDs = append(expectedOutputIDs, outputID)
+			return true
+		}))
+		for cursor, pages := utxo.EmptyOutputID, 0; pages == 0 || cursor != utxo.EmptyOutputID; pages++ {
+			cursor, err = tf2.Instance.Ledger.UnspentOutputs().ForEachUnspentOutput(context.Background(), func(output *mempool.OutputWithMetadata) bool {
+				iteratedOutputIDs = append(iteratedOutputIDs, output.ID())
+				return true
+			}, ledger.WithCursor(cursor), ledger.WithLimit(2))
+			require.NoError(t, err)
+			require.LessOrEqual(t, pages, len(expectedOutputIDs)/2+1)
+		}
+		require.Equal(t, expectedOutputIDs, iteratedOutputIDs)
+
 		// SybilProtection
 		require.Equal(t, lo.PanicOnErr(tf.Instance.SybilProtection.Weights().Map()), lo.PanicOnErr(tf2.Instance.SybilProtection.Weights().Map()))
 		require.Equal(t, tf.Instance.SybilProtection.Weights().TotalWeight(), tf2.Instance.SybilProtection.Weights().TotalWeight())
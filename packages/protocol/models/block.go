@@ -21,6 +21,7 @@ import (
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/objectstorage/generic/model"
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2"
 	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
 	"github.com/iotaledger/hive.go/stringify"
@@ -118,6 +119,17 @@ func (b *Block) ContentHash() (contentHash types.Identifier, err error) {
 	return blake2b.Sum256(blkBytes[:len(blkBytes)-ed25519.SignatureSize]), nil
 }
 
+// PoWContent returns the serialized block up to (but excluding) the nonce, which is the content that is covered by the
+// PoW of the block.
+func (b *Block) PoWContent() (powContent []byte, err error) {
+	blkBytes, err := b.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create block bytes")
+	}
+
+	return blkBytes[:len(blkBytes)-ed25519.SignatureSize-serializer.UInt64ByteSize], nil
+}
+
 func (b *Block) Sign(pair *ed25519.KeyPair) error {
 	b.M.IssuerPublicKey = pair.PublicKey
 
@@ -263,6 +275,12 @@ func (b *Block) Nonce() uint64 {
 	return b.M.Nonce
 }
 
+// SetNonce sets the Nonce of the block.
+func (b *Block) SetNonce(nonce uint64) {
+	b.M.Nonce = nonce
+	b.InvalidateBytesCache()
+}
+
 // Commitment returns the Commitment of the block.
 func (b *Block) Commitment() *commitment.Commitment {
 	return b.M.SlotCommitment
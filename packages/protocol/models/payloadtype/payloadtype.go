@@ -12,4 +12,7 @@ const (
 
 	// FaucetRequest is the faucet request payload type.
 	FaucetRequest
+
+	// WASMTransaction is the transaction payload type of the experimental WebAssembly VM.
+	WASMTransaction
 )
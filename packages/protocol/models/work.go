@@ -0,0 +1,71 @@
+package models
+
+import (
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// WorkUnitSize defines the number of bytes that are accounted as a single unit of work by the SizeWorkPolicy.
+const WorkUnitSize = 1024
+
+// WorkPolicy defines how the work that is required to process a Block is accounted.
+type WorkPolicy uint8
+
+const (
+	// CountWorkPolicy accounts a single unit of work for every Block (independent of its size).
+	CountWorkPolicy WorkPolicy = iota
+
+	// SizeWorkPolicy accounts a unit of work for every started WorkUnitSize bytes of a Block.
+	SizeWorkPolicy
+)
+
+// Work returns the units of work that are required to process the given Block.
+func (w WorkPolicy) Work(block *Block) int {
+	if w != SizeWorkPolicy {
+		return block.Work()
+	}
+
+	return (block.Size() + WorkUnitSize - 1) / WorkUnitSize
+}
+
+// MaxWork returns the maximum units of work that a single Block can require.
+func (w WorkPolicy) MaxWork() int {
+	if w != SizeWorkPolicy {
+		return MaxBlockWork
+	}
+
+	return (MaxBlockSize + WorkUnitSize - 1) / WorkUnitSize
+}
+
+// PoWDifficulty returns the PoW difficulty (in leading zero bits) that is required for the given Block.
+//
+// Every doubling of the work of the Block increases the difficulty by one bit, so the effort to create the PoW of a
+// Block grows proportional to its work.
+func (w WorkPolicy) PoWDifficulty(block *Block, baseDifficulty int) int {
+	return baseDifficulty + bits.Len(uint(w.Work(block)-1))
+}
+
+// String returns a human-readable representation of the WorkPolicy.
+func (w WorkPolicy) String() string {
+	switch w {
+	case CountWorkPolicy:
+		return "count"
+	case SizeWorkPolicy:
+		return "size"
+	default:
+		return "unknown"
+	}
+}
+
+// WorkPolicyFromString parses a string and returns the WorkPolicy it defines.
+func WorkPolicyFromString(workPolicy string) (WorkPolicy, error) {
+	switch workPolicy {
+	case "count":
+		return CountWorkPolicy, nil
+	case "size":
+		return SizeWorkPolicy, nil
+	default:
+		return 0, errors.Errorf("unknown work policy %q", workPolicy)
+	}
+}
@@ -2,6 +2,7 @@ package tipmanager
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -220,6 +221,32 @@ func (t *TipManager) TipCount() int {
 	return t.tips.Size()
 }
 
+// OldestTips returns up to count tips that pass the TSC check ordered by their issuing time (oldest first). Referencing
+// them keeps the Tangle from fanning out and prevents the tips from aging out during periods of low traffic.
+func (t *TipManager) OldestTips(count int) (oldestTips []*scheduler.Block) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	oldestTips = make([]*scheduler.Block, 0, t.tips.Size())
+	t.tips.ForEach(func(_ models.BlockID, tip *scheduler.Block) bool {
+		if t.isPastConeTimestampCorrect(tip.Block) {
+			oldestTips = append(oldestTips, tip)
+		}
+
+		return true
+	})
+
+	sort.Slice(oldestTips, func(i, j int) bool {
+		return oldestTips[i].IssuingTime().Before(oldestTips[j].IssuingTime())
+	})
+
+	if len(oldestTips) > count {
+		oldestTips = oldestTips[:count]
+	}
+
+	return oldestTips
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region TSC to prevent lazy tips /////////////////////////////////////////////////////////////////////////////////////
@@ -106,6 +106,44 @@ func TestTipManager_DataBlockTips(t *testing.T) {
 	}
 }
 
+// Tests that OldestTips returns the tips ordered by their issuing time (oldest first).
+func TestTipManager_OldestTips(t *testing.T) {
+	workers := workerpool.NewGroup(t.Name())
+	now := time.Now()
+	tf := NewTestFramework(t,
+		workers.CreateGroup("TipManagerTestFramework"),
+		WithGenesisUnixTime(now.Add(-time.Minute).Unix()),
+	)
+
+	tipManager := tf.Instance
+
+	tf.Tangle.BlockDAG.CreateBlock("Block0", models.WithIssuingTime(now.Add(-30*time.Second)))
+	tf.Tangle.BlockDAG.CreateBlock("Block1", models.WithStrongParents(tf.Tangle.BlockDAG.BlockIDs("Block0")), models.WithIssuingTime(now.Add(-20*time.Second)))
+	tf.Tangle.BlockDAG.CreateBlock("Block2", models.WithStrongParents(tf.Tangle.BlockDAG.BlockIDs("Block0")), models.WithIssuingTime(now))
+	tf.Tangle.BlockDAG.CreateBlock("Block3", models.WithStrongParents(tf.Tangle.BlockDAG.BlockIDs("Block0")), models.WithIssuingTime(now.Add(-10*time.Second)))
+	tf.Tangle.BlockDAG.IssueBlocks("Block0")
+	workers.WaitChildren()
+	tf.Tangle.BlockDAG.IssueBlocks("Block1", "Block2", "Block3")
+	workers.WaitChildren()
+
+	tf.AssertTipCount(3)
+
+	oldestTipIDs := func(count int) (blockIDs []models.BlockID) {
+		for _, tip := range tipManager.OldestTips(count) {
+			blockIDs = append(blockIDs, tip.ID())
+		}
+
+		return blockIDs
+	}
+
+	require.Equal(t, []models.BlockID{tf.Tangle.BlockDAG.Block("Block1").ID(), tf.Tangle.BlockDAG.Block("Block3").ID()}, oldestTipIDs(2))
+	require.Equal(t, []models.BlockID{
+		tf.Tangle.BlockDAG.Block("Block1").ID(),
+		tf.Tangle.BlockDAG.Block("Block3").ID(),
+		tf.Tangle.BlockDAG.Block("Block2").ID(),
+	}, oldestTipIDs(models.MaxParentsCount))
+}
+
 // Test based on packages/tangle/images/TSC_test_scenario.png except nothing is confirmed.
 func TestTipManager_TimeSinceConfirmation_Unconfirmed(t *testing.T) {
 	workers := workerpool.NewGroup(t.Name())
@@ -0,0 +1,285 @@
+package upgrades
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/types"
+)
+
+// ErrInvalidSchedule is returned if a Schedule contains Upgrades that are out of order or that set invalid values.
+var ErrInvalidSchedule = errors.New("invalid upgrade schedule")
+
+// region Schedule /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Schedule contains the Upgrades of the protocol parameters that were agreed on by the operators of a network. Every
+// node that has the same Schedule configured derives the same parameters for the same slot, so that the parameters of
+// a private network can be changed without restarting all of its nodes at the same time.
+//
+// A nil Schedule is valid and always returns the given default values.
+type Schedule struct {
+	upgrades []*Upgrade
+	id       types.Identifier
+}
+
+// NewSchedule creates a new Schedule from the given Upgrades (they have to be ordered by their activation slot).
+func NewSchedule(upgrades ...*Upgrade) (schedule *Schedule, err error) {
+	for i, upgrade := range upgrades {
+		if err = upgrade.validate(); err != nil {
+			return nil, errors.WithMessagef(err, "upgrade %d", i)
+		}
+
+		if i > 0 && upgrade.Slot <= upgrades[i-1].Slot {
+			return nil, errors.WithMessagef(ErrInvalidSchedule, "upgrade %d activates at slot %d which is not after slot %d", i, upgrade.Slot, upgrades[i-1].Slot)
+		}
+	}
+
+	serializedUpgrades, err := json.Marshal(upgrades)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize upgrades")
+	}
+
+	return &Schedule{
+		upgrades: upgrades,
+		id:       blake2b.Sum256(serializedUpgrades),
+	}, nil
+}
+
+// ScheduleFromFile loads the Schedule from the JSON file with the given path (which contains the list of Upgrades).
+func ScheduleFromFile(path string) (schedule *Schedule, err error) {
+	serializedUpgrades, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read upgrade schedule %s", path)
+	}
+
+	var upgrades []*Upgrade
+	if err = json.Unmarshal(serializedUpgrades, &upgrades); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse upgrade schedule %s", path)
+	}
+
+	return NewSchedule(upgrades...)
+}
+
+// ID returns a hash of the Schedule that can be used to verify that nodes share the same Schedule.
+func (s *Schedule) ID() types.Identifier {
+	if s == nil {
+		return types.Identifier{}
+	}
+
+	return s.id
+}
+
+// Upgrades returns the Upgrades of the Schedule.
+func (s *Schedule) Upgrades() []*Upgrade {
+	if s == nil {
+		return nil
+	}
+
+	return s.upgrades
+}
+
+// PoWDifficulty returns the PoW difficulty that is active in the given slot.
+func (s *Schedule) PoWDifficulty(index slot.Index, defaultValue int) (powDifficulty int) {
+	powDifficulty = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.PoWDifficulty != nil {
+			powDifficulty = *upgrade.PoWDifficulty
+		}
+	})
+
+	return powDifficulty
+}
+
+// MaxStrongParentsCount returns the maximum number of strong parents that is active in the given slot.
+func (s *Schedule) MaxStrongParentsCount(index slot.Index, defaultValue int) (maxStrongParentsCount int) {
+	maxStrongParentsCount = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.MaxStrongParentsCount != nil {
+			maxStrongParentsCount = *upgrade.MaxStrongParentsCount
+		}
+	})
+
+	return maxStrongParentsCount
+}
+
+// MarkerAcceptanceThreshold returns the marker acceptance threshold that is active in the given slot.
+func (s *Schedule) MarkerAcceptanceThreshold(index slot.Index, defaultValue float64) (threshold float64) {
+	threshold = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.MarkerAcceptanceThreshold != nil {
+			threshold = *upgrade.MarkerAcceptanceThreshold
+		}
+	})
+
+	return threshold
+}
+
+// MarkerConfirmationThreshold returns the marker confirmation threshold that is active in the given slot.
+func (s *Schedule) MarkerConfirmationThreshold(index slot.Index, defaultValue float64) (threshold float64) {
+	threshold = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.MarkerConfirmationThreshold != nil {
+			threshold = *upgrade.MarkerConfirmationThreshold
+		}
+	})
+
+	return threshold
+}
+
+// ExecutionBudget returns the Budget that limits the execution of the Transactions of the given slot.
+func (s *Schedule) ExecutionBudget(index slot.Index, defaultValue vm.Budget) (budget vm.Budget) {
+	budget = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.ExecutionBudget != nil {
+			budget = vm.Budget{Gas: upgrade.ExecutionBudget.Gas, Size: upgrade.ExecutionBudget.Size}
+		}
+	})
+
+	return budget
+}
+
+// DustPolicy returns the DustPolicy that defines the minimum deposit of the outputs that are created in the given slot.
+func (s *Schedule) DustPolicy(index slot.Index, defaultValue devnetvm.DustPolicy) (dustPolicy devnetvm.DustPolicy) {
+	dustPolicy = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.DustPolicy != nil {
+			dustPolicy = upgrade.DustPolicy.dustPolicy
+		}
+	})
+
+	return dustPolicy
+}
+
+// AliasFundsRecovery returns true if the governing entity of an alias can destroy it while it holds more than the dust
+// minimum (by routing its balances to a recovery output) in the given slot.
+func (s *Schedule) AliasFundsRecovery(index slot.Index, defaultValue bool) (enabled bool) {
+	enabled = defaultValue
+	s.forEachActive(index, func(upgrade *Upgrade) {
+		if upgrade.AliasFundsRecovery != nil {
+			enabled = *upgrade.AliasFundsRecovery
+		}
+	})
+
+	return enabled
+}
+
+// forEachActive calls the callback for all Upgrades that are active in the given slot (in the order of activation).
+func (s *Schedule) forEachActive(index slot.Index, callback func(upgrade *Upgrade)) {
+	if s == nil {
+		return
+	}
+
+	for _, upgrade := range s.upgrades[:sort.Search(len(s.upgrades), func(i int) bool { return s.upgrades[i].Slot > index })] {
+		callback(upgrade)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Upgrade //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Upgrade contains the protocol parameters that change at the given slot (parameters that are not set keep their
+// previous value).
+type Upgrade struct {
+	// Slot is the first slot in which the upgraded parameters are active.
+	Slot slot.Index `json:"slot"`
+
+	// PoWDifficulty is the PoW difficulty (in leading zero bits) of blocks with a single unit of work.
+	PoWDifficulty *int `json:"powDifficulty,omitempty"`
+
+	// MaxStrongParentsCount is the maximum number of strong parents of a block.
+	MaxStrongParentsCount *int `json:"maxStrongParentsCount,omitempty"`
+
+	// MarkerAcceptanceThreshold is the share of the active weight that a marker needs to be accepted.
+	MarkerAcceptanceThreshold *float64 `json:"markerAcceptanceThreshold,omitempty"`
+
+	// MarkerConfirmationThreshold is the share of the total weight that a marker needs to be confirmed.
+	MarkerConfirmationThreshold *float64 `json:"markerConfirmationThreshold,omitempty"`
+
+	// ExecutionBudget is the Budget that limits the execution of a single transaction.
+	ExecutionBudget *ExecutionBudget `json:"executionBudget,omitempty"`
+
+	// DustPolicy is the policy that defines the minimum deposit of the created outputs.
+	DustPolicy *DustPolicy `json:"dustPolicy,omitempty"`
+
+	// AliasFundsRecovery enables the destruction of funded aliases by their governing entity with funds recovery.
+	AliasFundsRecovery *bool `json:"aliasFundsRecovery,omitempty"`
+}
+
+// validate checks if the values of the Upgrade are within their valid ranges.
+func (u *Upgrade) validate() error {
+	if u.Slot <= 0 {
+		return errors.WithMessagef(ErrInvalidSchedule, "activation slot %d is not positive", u.Slot)
+	}
+
+	if u.PoWDifficulty != nil && *u.PoWDifficulty < 0 {
+		return errors.WithMessagef(ErrInvalidSchedule, "PoW difficulty %d is negative", *u.PoWDifficulty)
+	}
+
+	if u.MaxStrongParentsCount != nil && (*u.MaxStrongParentsCount < models.MinStrongParentsCount || *u.MaxStrongParentsCount > models.MaxParentsCount) {
+		return errors.WithMessagef(ErrInvalidSchedule, "maximum number of strong parents %d is not within [%d, %d]", *u.MaxStrongParentsCount, models.MinStrongParentsCount, models.MaxParentsCount)
+	}
+
+	for name, threshold := range map[string]*float64{
+		"marker acceptance threshold":   u.MarkerAcceptanceThreshold,
+		"marker confirmation threshold": u.MarkerConfirmationThreshold,
+	} {
+		if threshold != nil && (*threshold <= 0.5 || *threshold >= 1) {
+			return errors.WithMessagef(ErrInvalidSchedule, "%s %f is not within (0.5, 1)", name, *threshold)
+		}
+	}
+
+	if u.DustPolicy != nil {
+		dustPolicy, err := devnetvm.NewDustPolicy(u.DustPolicy.Name, u.DustPolicy.MinimumDeposit, u.DustPolicy.DepositPerByte)
+		if err != nil {
+			return errors.WithMessagef(ErrInvalidSchedule, "invalid dust policy: %s", err)
+		}
+		u.DustPolicy.dustPolicy = dustPolicy
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ExecutionBudget //////////////////////////////////////////////////////////////////////////////////////////////
+
+// ExecutionBudget contains the limits of the resources that the execution of a single transaction is allowed to consume
+// (a limit of 0 disables the corresponding check).
+type ExecutionBudget struct {
+	// Gas is the maximum amount of gas that the execution of a transaction is allowed to consume.
+	Gas uint64 `json:"gas"`
+
+	// Size is the maximum size in bytes of the serialized outputs that a transaction is allowed to create.
+	Size uint64 `json:"size"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DustPolicy ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DustPolicy contains the configuration of the minimum deposit that the created outputs have to hold.
+type DustPolicy struct {
+	// Name is the name of the dust policy ("fixed" requires the minimum deposit for alias and nft outputs, "bytecost"
+	// requires it for all outputs plus a deposit per byte).
+	Name string `json:"name"`
+
+	// MinimumDeposit is the (base) minimum deposit of an output in IOTA.
+	MinimumDeposit uint64 `json:"minimumDeposit"`
+
+	// DepositPerByte is the deposit in IOTA that is required per byte of a serialized output ("bytecost" only).
+	DepositPerByte uint64 `json:"depositPerByte,omitempty"`
+
+	// dustPolicy contains the devnetvm.DustPolicy that was created from the configuration.
+	dustPolicy devnetvm.DustPolicy
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
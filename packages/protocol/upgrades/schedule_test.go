@@ -0,0 +1,86 @@
+package upgrades
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+func TestSchedule(t *testing.T) {
+	schedule, err := NewSchedule(
+		&Upgrade{Slot: 10, PoWDifficulty: ptr(12), MaxStrongParentsCount: ptr(4)},
+		&Upgrade{Slot: 20, PoWDifficulty: ptr(14), MarkerConfirmationThreshold: ptr(0.75), ExecutionBudget: &ExecutionBudget{Gas: 500, Size: 1024}},
+		&Upgrade{Slot: 30, DustPolicy: &DustPolicy{Name: "bytecost", MinimumDeposit: 10, DepositPerByte: 1}, AliasFundsRecovery: ptr(true)},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, schedule.PoWDifficulty(9, 1))
+	require.Equal(t, 12, schedule.PoWDifficulty(10, 1))
+	require.Equal(t, 12, schedule.PoWDifficulty(19, 1))
+	require.Equal(t, 14, schedule.PoWDifficulty(20, 1))
+
+	require.Equal(t, 8, schedule.MaxStrongParentsCount(9, 8))
+	require.Equal(t, 4, schedule.MaxStrongParentsCount(25, 8))
+
+	require.Equal(t, 0.67, schedule.MarkerConfirmationThreshold(19, 0.67))
+	require.Equal(t, 0.75, schedule.MarkerConfirmationThreshold(20, 0.67))
+	require.Equal(t, 0.67, schedule.MarkerAcceptanceThreshold(20, 0.67))
+
+	require.Equal(t, vm.DefaultBudget, schedule.ExecutionBudget(19, vm.DefaultBudget))
+	require.Equal(t, vm.Budget{Gas: 500, Size: 1024}, schedule.ExecutionBudget(20, vm.DefaultBudget))
+
+	require.Equal(t, devnetvm.DefaultDustPolicy, schedule.DustPolicy(29, devnetvm.DefaultDustPolicy))
+	require.Equal(t, devnetvm.NewByteCostDustPolicy(10, 1), schedule.DustPolicy(30, devnetvm.DefaultDustPolicy))
+
+	require.False(t, schedule.AliasFundsRecovery(29, false))
+	require.True(t, schedule.AliasFundsRecovery(30, false))
+
+	var nilSchedule *Schedule
+	require.Equal(t, 1, nilSchedule.PoWDifficulty(20, 1))
+	require.Empty(t, nilSchedule.Upgrades())
+}
+
+func TestSchedule_Invalid(t *testing.T) {
+	_, err := NewSchedule(&Upgrade{Slot: 20}, &Upgrade{Slot: 20})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = NewSchedule(&Upgrade{Slot: 0})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = NewSchedule(&Upgrade{Slot: 10, PoWDifficulty: ptr(-1)})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = NewSchedule(&Upgrade{Slot: 10, MaxStrongParentsCount: ptr(9)})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = NewSchedule(&Upgrade{Slot: 10, MarkerAcceptanceThreshold: ptr(0.5)})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	_, err = NewSchedule(&Upgrade{Slot: 10, DustPolicy: &DustPolicy{Name: "unknown"}})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+}
+
+func TestScheduleFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "upgrades.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"slot": 10, "powDifficulty": 12}, {"slot": 20, "markerAcceptanceThreshold": 0.75, "executionBudget": {"gas": 500}}]`), 0o600))
+
+	schedule, err := ScheduleFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, schedule.Upgrades(), 2)
+	require.Equal(t, 12, schedule.PoWDifficulty(20, 0))
+	require.Equal(t, 0.75, schedule.MarkerAcceptanceThreshold(20, 0.67))
+	require.Equal(t, vm.Budget{Gas: 500}, schedule.ExecutionBudget(20, vm.DefaultBudget))
+
+	expectedSchedule, err := NewSchedule(&Upgrade{Slot: 10, PoWDifficulty: ptr(12)}, &Upgrade{Slot: 20, MarkerAcceptanceThreshold: ptr(0.75), ExecutionBudget: &ExecutionBudget{Gas: 500}})
+	require.NoError(t, err)
+	require.Equal(t, expectedSchedule.ID(), schedule.ID())
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -80,6 +80,35 @@ func (c *Commitments) Export(writer io.WriteSeeker, targetSlot slot.Index) (err
 	return nil
 }
 
+// Backup writes a copy of the commitments up to the given slot to the given file and verifies it.
+func (c *Commitments) Backup(filePath string, targetSlot slot.Index) (err error) {
+	backup := NewCommitments(filePath)
+	defer func() {
+		if closeErr := backup.Close(); closeErr != nil && err == nil {
+			err = errors.Wrapf(closeErr, "failed to close commitments file %s", filePath)
+		}
+	}()
+
+	for slotIndex := slot.Index(0); slotIndex <= targetSlot; slotIndex++ {
+		commitment, loadErr := c.Load(slotIndex)
+		if loadErr != nil {
+			return errors.Wrapf(loadErr, "failed to load commitment for slot %d", slotIndex)
+		}
+
+		if err = backup.Store(commitment); err != nil {
+			return errors.Wrapf(err, "failed to write commitment for slot %d", slotIndex)
+		}
+
+		if backupCommitment, readErr := backup.Load(slotIndex); readErr != nil {
+			return errors.Wrapf(readErr, "failed to read back commitment for slot %d", slotIndex)
+		} else if backupCommitment.ID() != commitment.ID() {
+			return errors.Errorf("verification of commitment for slot %d failed", slotIndex)
+		}
+	}
+
+	return nil
+}
+
 func (c *Commitments) Import(reader io.ReadSeeker) (err error) {
 	var slotBoundary int64
 	if err = binary.Read(reader, binary.LittleEndian, &slotBoundary); err != nil {
@@ -15,6 +15,7 @@ const (
 	consensusWeightsPrefix
 	attestationsPrefix
 	throughputQuotaPrefix
+	ledgerEventsPrefix
 )
 
 type Permanent struct {
@@ -26,6 +27,7 @@ type Permanent struct {
 	attestations     kvstore.KVStore
 	sybilProtection  kvstore.KVStore
 	throughputQuota  kvstore.KVStore
+	ledgerEvents     kvstore.KVStore
 }
 
 // New returns a new permanent storage instance.
@@ -39,6 +41,7 @@ func New(dir *utils.Directory, db *database.Manager) (p *Permanent) {
 		attestations:     lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{attestationsPrefix})),
 		sybilProtection:  lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{consensusWeightsPrefix})),
 		throughputQuota:  lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{throughputQuotaPrefix})),
+		ledgerEvents:     lo.PanicOnErr(db.PermanentStorage().WithExtendedRealm([]byte{ledgerEventsPrefix})),
 	}
 }
 
@@ -69,6 +72,15 @@ func (p *Permanent) SybilProtection(optRealm ...byte) kvstore.KVStore {
 	return lo.PanicOnErr(p.sybilProtection.WithExtendedRealm(optRealm))
 }
 
+// LedgerEvents returns the "ledger events" storage (or a specialized sub-storage if a realm is provided).
+func (p *Permanent) LedgerEvents(optRealm ...byte) kvstore.KVStore {
+	if len(optRealm) == 0 {
+		return p.ledgerEvents
+	}
+
+	return lo.PanicOnErr(p.ledgerEvents.WithExtendedRealm(optRealm))
+}
+
 // ThroughputQuota returns the throughput quota storage (or a specialized sub-storage if a realm is provided).
 func (p *Permanent) ThroughputQuota(optRealm ...byte) kvstore.KVStore {
 	if len(optRealm) == 0 {
@@ -1,9 +1,11 @@
 package permanent
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"io"
+	"os"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -218,6 +220,29 @@ func (s *Settings) Export(writer io.WriteSeeker) (err error) {
 	return nil
 }
 
+// Backup writes a copy of the settings to the given file and verifies it.
+func (s *Settings) Backup(filePath string) (err error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if err = s.ToFile(filePath); err != nil {
+		return errors.Wrapf(err, "failed to write settings to %s", filePath)
+	}
+
+	settingsBytes, err := s.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to convert settings to bytes")
+	}
+
+	if writtenBytes, readErr := os.ReadFile(filePath); readErr != nil {
+		return errors.Wrapf(readErr, "failed to read back settings from %s", filePath)
+	} else if !bytes.Equal(writtenBytes, settingsBytes) {
+		return errors.Errorf("verification of settings in %s failed", filePath)
+	}
+
+	return nil
+}
+
 func (s *Settings) Import(reader io.ReadSeeker) (err error) {
 	if err = s.tryImport(reader); err != nil {
 		return errors.Wrap(err, "failed to import settings")
@@ -3,6 +3,8 @@ package storage
 import (
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
 	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/storage/permanent"
@@ -63,6 +65,28 @@ func (s *Storage) PermanentDatabaseSize() int64 {
 	return s.Permanent.SettingsAndCommitmentsSize() + s.databaseManager.PermanentStorageSize()
 }
 
+// Backup writes a verified copy of the storage to the given directory (which can be used as the directory of a new
+// storage instance) without interrupting the node. The databases are copied through checkpoints, so the caller has to
+// make sure that no slot is committed while the backup is written (see Engine.Backup), so that the settings and
+// commitments of the backup describe the content of its databases.
+func (s *Storage) Backup(targetDir string) (err error) {
+	directory := utils.NewDirectory(targetDir, true)
+
+	if err = s.Permanent.Settings.Backup(directory.Path("settings.bin")); err != nil {
+		return errors.Wrap(err, "failed to backup settings")
+	}
+
+	if err = s.Permanent.Commitments.Backup(directory.Path("commitments.bin"), s.Permanent.Settings.LatestCommitment().Index()); err != nil {
+		return errors.Wrap(err, "failed to backup commitments")
+	}
+
+	if err = s.databaseManager.Backup(targetDir); err != nil {
+		return errors.Wrap(err, "failed to backup databases")
+	}
+
+	return nil
+}
+
 // Shutdown shuts down the storage.
 func (s *Storage) Shutdown() {
 	s.shutdownOnce.Do(func() {
@@ -2,15 +2,19 @@ package storage
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/core/commitment"
+	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/lo"
 )
 
@@ -41,3 +45,93 @@ func Test(t *testing.T) {
 
 	storage.Shutdown()
 }
+
+func TestStorage_Backup(t *testing.T) {
+	storageDirectory := t.TempDir()
+	backupDirectory := t.TempDir()
+
+	dbProvider := newSharedMemDBProvider()
+
+	slotTimeProvider := slot.NewTimeProvider(time.Now().Unix(), 10)
+	emptyBlock := models.NewBlock(models.WithStrongParents(models.NewBlockIDs(models.EmptyBlockID)))
+	require.NoError(t, emptyBlock.DetermineID(slotTimeProvider))
+
+	storage := New(storageDirectory, 1, database.WithDBProvider(dbProvider.newDB))
+	genesisCommitment := commitment.New(0, commitment.ID{}, types.Identifier{}, 0)
+	latestCommitment := commitment.New(1, genesisCommitment.ID(), types.Identifier{}, 0)
+	require.NoError(t, storage.Commitments.Store(latestCommitment))
+	require.NoError(t, storage.Settings.SetLatestCommitment(latestCommitment))
+	require.NoError(t, storage.UnspentOutputIDs().Set([]byte("output"), []byte{1}))
+	require.NoError(t, storage.Blocks.Store(emptyBlock))
+
+	require.NoError(t, storage.Backup(backupDirectory))
+	storage.Shutdown()
+
+	backup := New(backupDirectory, 1, database.WithDBProvider(dbProvider.newDB))
+	defer backup.Shutdown()
+
+	require.Equal(t, latestCommitment.ID(), backup.Settings.LatestCommitment().ID())
+	require.Equal(t, latestCommitment.ID(), lo.PanicOnErr(backup.Commitments.Load(1)).ID())
+	require.Equal(t, []byte{1}, lo.PanicOnErr(backup.UnspentOutputIDs().Get([]byte("output"))))
+
+	backupBlock, err := backup.Blocks.Load(emptyBlock.ID())
+	require.NoError(t, err)
+	require.Equal(t, emptyBlock.ID(), backupBlock.ID())
+}
+
+// sharedMemDBProvider is a database.DBProvider that keeps the in-memory DBs of closed instances, so that they can be
+// reopened (like a DB on disk).
+type sharedMemDBProvider struct {
+	stores map[string]kvstore.KVStore
+	mutex  sync.Mutex
+}
+
+func newSharedMemDBProvider() *sharedMemDBProvider {
+	return &sharedMemDBProvider{
+		stores: make(map[string]kvstore.KVStore),
+	}
+}
+
+func (s *sharedMemDBProvider) newDB(directory string) (database.DB, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.stores[directory]; !exists {
+		s.stores[directory] = mapdb.NewMapDB()
+	}
+
+	return &sharedMemDB{store: s.stores[directory], provider: s}, nil
+}
+
+// sharedMemDB is a DB that is created by the sharedMemDBProvider.
+type sharedMemDB struct {
+	store    kvstore.KVStore
+	provider *sharedMemDBProvider
+}
+
+func (s *sharedMemDB) NewStore() kvstore.KVStore {
+	return s.store
+}
+
+func (s *sharedMemDB) Close() error {
+	return nil
+}
+
+func (s *sharedMemDB) Checkpoint(targetDir string) error {
+	checkpoint, err := s.provider.newDB(targetDir)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		return checkpoint.NewStore().Set(key, value) == nil
+	})
+}
+
+func (s *sharedMemDB) RequiresGC() bool {
+	return false
+}
+
+func (s *sharedMemDB) GC() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package activity
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
+)
+
+// lastBlockAttached contains the time (in unix nanoseconds) at which the latest block was attached to the Tangle.
+var lastBlockAttached atomic.Int64
+
+// heartbeatEnabled returns true if any of the heartbeat thresholds is configured.
+func heartbeatEnabled() bool {
+	return Parameters.Heartbeat.IdleThreshold > 0 || Parameters.Heartbeat.TipAgeThreshold > 0
+}
+
+// trackAttachedBlocks keeps track of the time at which the latest block was attached to the Tangle.
+func trackAttachedBlocks() {
+	lastBlockAttached.Store(time.Now().UnixNano())
+
+	deps.Protocol.Events.Engine.Tangle.BlockDAG.BlockAttached.Hook(func(*blockdag.Block) {
+		lastBlockAttached.Store(time.Now().UnixNano())
+	})
+}
+
+// heartbeatReason returns the reason why a heartbeat block needs to be issued (or an empty string if it is not
+// needed) together with the tips that the heartbeat block should reference.
+func heartbeatReason() (reason string, oldestTips models.BlockIDs) {
+	oldestTips = models.NewBlockIDs()
+	for _, tip := range deps.Protocol.TipManager.OldestTips(models.MaxParentsCount) {
+		oldestTips.Add(tip.ID())
+
+		if reason == "" && Parameters.Heartbeat.TipAgeThreshold > 0 && time.Since(tip.IssuingTime()) >= Parameters.Heartbeat.TipAgeThreshold {
+			reason = "tip " + tip.ID().Base58() + " is about to age out"
+		}
+	}
+
+	if reason == "" && Parameters.Heartbeat.IdleThreshold > 0 && time.Since(time.Unix(0, lastBlockAttached.Load())) >= Parameters.Heartbeat.IdleThreshold {
+		reason = "no block was attached within " + Parameters.Heartbeat.IdleThreshold.String()
+	}
+
+	return reason, oldestTips
+}
+
+// issueHeartbeatBlock issues an empty block that strongly references the oldest tips if the Tangle was idle for too
+// long or if a tip is about to age out, which keeps the confirmation of the Tangle alive during periods of low traffic.
+func issueHeartbeatBlock() {
+	reason, oldestTips := heartbeatReason()
+	if reason == "" {
+		return
+	}
+
+	references := models.NewParentBlockIDs()
+	if len(oldestTips) > 0 {
+		references.AddAll(models.StrongParentType, oldestTips)
+	}
+
+	block, err := deps.BlockIssuer.CreateBlockWithReferences(payload.NewGenericDataPayload([]byte{}), references, models.MaxParentsCount)
+	if err != nil {
+		Plugin.LogWarnf("error creating heartbeat block: %s", err)
+		return
+	}
+
+	if err = deps.BlockIssuer.IssueBlockAndAwaitBlockToBeScheduled(block, Parameters.Heartbeat.CheckInterval); err != nil {
+		Plugin.LogWarnf("error issuing heartbeat block: %s", err)
+		return
+	}
+
+	Plugin.LogDebugf("issued heartbeat block %s because %s", block.ID(), reason)
+}
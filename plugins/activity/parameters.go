@@ -14,6 +14,16 @@ type ParametersDefinition struct {
 	ParentsCount int `default:"8" usage:"the number of parents that node will choose for its activity blocks"`
 	// DelayOffset is the maximum for random initial time delay before sending the activity block.
 	DelayOffset time.Duration `default:"1ms" usage:"the maximum for random initial time delay before sending the activity block"`
+
+	// Heartbeat contains the configuration of the heartbeat blocks that keep the Tangle alive during low traffic.
+	Heartbeat struct {
+		// CheckInterval is the interval at which the node checks whether a heartbeat block is needed.
+		CheckInterval time.Duration `default:"1s" usage:"the interval at which the node checks whether a heartbeat block is needed"`
+		// IdleThreshold is the time without any new block after which the node issues a heartbeat block.
+		IdleThreshold time.Duration `default:"0s" usage:"the time without any new block after which the node issues a heartbeat block (0 disables it)"`
+		// TipAgeThreshold is the age of the oldest tip after which the node issues a heartbeat block that references it.
+		TipAgeThreshold time.Duration `default:"0s" usage:"the age of the oldest tip after which the node issues a heartbeat block that references it (0 disables it, should be below the TSC threshold)"`
+	}
 }
 
 // Parameters contains the configuration parameters of the activity plugin.
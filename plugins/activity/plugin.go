@@ -29,11 +29,15 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin("Activity", deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin("Activity", deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
 }
 
 func configure(plugin *node.Plugin) {
 	plugin.LogInfof("starting node with activity plugin")
+
+	if heartbeatEnabled() {
+		trackAttachedBlocks()
+	}
 }
 
 // broadcastActivityBlock broadcasts a sync beacon via communication layer.
@@ -72,9 +76,13 @@ func run(_ *node.Plugin) {
 			timeutil.NewTicker(broadcastActivityBlock, Parameters.BroadcastInterval, ctx)
 		}
 
+		if heartbeatEnabled() {
+			timeutil.NewTicker(issueHeartbeatBlock, Parameters.Heartbeat.CheckInterval, ctx)
+		}
+
 		// Wait before terminating, so we get correct log blocks from the daemon regarding the shutdown order.
 		<-ctx.Done()
-	}, shutdown.PriorityActivity); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
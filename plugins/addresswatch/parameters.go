@@ -0,0 +1,26 @@
+package addresswatch
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the address watch plugin.
+type ParametersDefinition struct {
+	// Addresses defines the base58 encoded addresses whose confirmed outputs are reported.
+	Addresses []string `usage:"the base58 encoded addresses whose confirmed outputs are reported"`
+	// WebhookURL defines the URL that the notifications are posted to.
+	WebhookURL string `default:"" usage:"the URL that the notifications about confirmed outputs are posted to"`
+	// Timeout defines the timeout of the webhook requests.
+	Timeout time.Duration `default:"5s" usage:"the timeout of the webhook requests"`
+	// DigestInterval defines the interval in which the confirmations of each address are coalesced into a digest.
+	DigestInterval time.Duration `default:"0s" usage:"the interval in which the confirmations of each address are posted as a digest (0 posts a notification per confirmed output)"`
+}
+
+// Parameters contains the configuration parameters of the address watch plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "addressWatch")
+}
@@ -0,0 +1,79 @@
+package addresswatch
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/addresswatch"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// PluginName is the name of the address watch plugin.
+const PluginName = "AddressWatch"
+
+var (
+	// Plugin is the plugin instance of the address watch plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	watcher *addresswatch.Watcher
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
+}
+
+func configure(plugin *node.Plugin) {
+	if Parameters.WebhookURL == "" {
+		plugin.LogFatalfAndExitf("no webhook URL was configured")
+	}
+
+	webhook := addresswatch.NewWebhook(Parameters.WebhookURL, Parameters.Timeout)
+	watcher = addresswatch.New(func(notifications []*addresswatch.Notification) {
+		if err := webhook.Deliver(context.Background(), notifications); err != nil {
+			plugin.LogWarnf("failed to deliver %d notifications: %s", len(notifications), err)
+		}
+	}, addresswatch.WithDigestInterval(Parameters.DigestInterval))
+
+	for _, encodedAddress := range Parameters.Addresses {
+		address, err := devnetvm.AddressFromBase58EncodedString(encodedAddress)
+		if err != nil {
+			plugin.LogFatalfAndExitf("invalid address %s: %s", encodedAddress, err)
+		}
+
+		watcher.Watch(address)
+	}
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		for _, createdOutput := range event.CreatedOutputs {
+			if output, isDevnetOutput := createdOutput.Output().(devnetvm.Output); isDevnetOutput {
+				watcher.OutputConfirmed(output)
+			}
+		}
+	}, event.WithWorkerPool(plugin.WorkerPool))
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		watcher.Start()
+
+		<-ctx.Done()
+
+		watcher.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
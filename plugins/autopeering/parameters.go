@@ -12,6 +12,8 @@ type ParametersDefinition struct {
 	R int `default:"40" usage:"R parameter"`
 	// Ro defines the config flag of Ro.
 	Ro float64 `default:"2.0" usage:"Ro parameter"`
+	// AllowWithProxy defines the config flag that allows to run the autopeering while the p2p connections are proxied.
+	AllowWithProxy bool `default:"false" usage:"run the autopeering (which exposes the IP of the node) even if p2p connections are routed through a proxy"`
 }
 
 // Parameters contains the configuration parameters of the autopeering plugin.
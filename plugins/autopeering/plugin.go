@@ -12,6 +12,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1/manamodels"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/discovery"
+	p2pplugin "github.com/iotaledger/goshimmer/plugins/p2p"
 	"github.com/iotaledger/hive.go/app/daemon"
 	"github.com/iotaledger/hive.go/autopeering/discover"
 	"github.com/iotaledger/hive.go/autopeering/peer"
@@ -44,7 +45,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentAutopeering).Consumes(shutdown.ComponentPeerDatabase, shutdown.ComponentP2P)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		if err := event.Container.Provide(discovery.CreatePeerDisc); err != nil {
@@ -61,9 +62,7 @@ func init() {
 			Plugin.Panic(err)
 		}
 
-		if err := event.Container.Provide(func() *UDPConnTraffic {
-			return &UDPConnTraffic{}
-		}); err != nil {
+		if err := event.Container.Provide(newUDPConnTraffic); err != nil {
 			Plugin.Panic(err)
 		}
 	})
@@ -72,6 +71,11 @@ func init() {
 func configure(plugin *node.Plugin) {
 	var err error
 
+	// the autopeering uses UDP which can not be routed through the SOCKS5 proxy of the gossip layer
+	if p2pplugin.Parameters.Proxy.Address != "" && !Parameters.AllowWithProxy {
+		Plugin.LogFatalfAndExitf("autopeering would expose the IP of the node as it can not be routed through the p2p proxy: disable the %s plugin or set autoPeering.allowWithProxy", PluginName)
+	}
+
 	// resolve the bind address
 	localAddr, err = net.ResolveUDPAddr("udp", Parameters.BindAddress)
 	if err != nil {
@@ -90,7 +94,7 @@ func configure(plugin *node.Plugin) {
 }
 
 func run(*node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, start, shutdown.PriorityAutopeering); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, start, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -4,13 +4,25 @@ import (
 	"net"
 
 	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/network/ipfilter"
 )
 
-// UDPConnTraffic is a wrapper of a UDPConn that keeps track of RX and TX bytes.
+// UDPConnTraffic is a wrapper of a UDPConn that keeps track of RX and TX bytes and that drops packets of IPs that are
+// not allowed by the network filter of the node.
 type UDPConnTraffic struct {
 	*net.UDPConn
-	rxBytes atomic.Uint64
-	txBytes atomic.Uint64
+	filter          *ipfilter.Filter
+	rxBytes         atomic.Uint64
+	txBytes         atomic.Uint64
+	rejectedPackets atomic.Uint64
+}
+
+// newUDPConnTraffic creates a new UDPConnTraffic that enforces the given filter once its UDPConn is set.
+func newUDPConnTraffic(filter *ipfilter.Filter) *UDPConnTraffic {
+	return &UDPConnTraffic{
+		filter: filter,
+	}
 }
 
 // RXBytes returns the RX bytes.
@@ -23,11 +35,23 @@ func (nc *UDPConnTraffic) TXBytes() uint64 {
 	return nc.txBytes.Load()
 }
 
-// ReadFromUDP acts like ReadFrom but returns a UDPAddr.
+// RejectedPackets returns the amount of packets that were dropped because their sender is not allowed by the filter.
+func (nc *UDPConnTraffic) RejectedPackets() uint64 {
+	return nc.rejectedPackets.Load()
+}
+
+// ReadFromUDP acts like ReadFrom but returns a UDPAddr. Packets of senders that are not allowed are dropped.
 func (nc *UDPConnTraffic) ReadFromUDP(b []byte) (int, *net.UDPAddr, error) {
-	n, addr, err := nc.UDPConn.ReadFromUDP(b)
-	nc.rxBytes.Add(uint64(n))
-	return n, addr, err
+	for {
+		n, addr, err := nc.UDPConn.ReadFromUDP(b)
+		nc.rxBytes.Add(uint64(n))
+		if err != nil || nc.filter == nil || nc.filter.Allowed(addr.IP) {
+			return n, addr, err
+		}
+
+		nc.rejectedPackets.Inc()
+		Plugin.LogDebugf("Rejected autopeering packet from %s", addr.IP)
+	}
 }
 
 // WriteToUDP acts like WriteTo but takes a UDPAddr.
@@ -0,0 +1,43 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the backup plugin.
+type ParametersDefinition struct {
+	// Directory defines the directory in which the backups are written.
+	Directory string `default:"./backups" usage:"the directory in which the backups are written"`
+	// Interval defines the interval in which backups are created (0 only creates backups on request).
+	Interval time.Duration `default:"0s" usage:"the interval in which backups are created (0 disables scheduled backups)"`
+	// Retention defines the number of backups that are kept in the Directory (0 keeps all backups).
+	Retention int `default:"3" usage:"the number of local backups to keep (0 keeps all backups)"`
+	// S3
+	S3 struct {
+		// Enabled defines whether the backups are uploaded to an S3-compatible object storage.
+		Enabled bool `default:"false" usage:"whether to upload backups to an S3-compatible object storage"`
+		// Endpoint defines the endpoint of the object storage (empty uses AWS S3).
+		Endpoint string `default:"" usage:"the endpoint of the S3-compatible object storage (empty uses AWS S3)"`
+		// Region defines the region of the bucket.
+		Region string `default:"us-east-1" usage:"the region of the bucket"`
+		// Bucket defines the bucket that the backups are uploaded to.
+		Bucket string `default:"" usage:"the bucket that the backups are uploaded to"`
+		// Prefix defines the prefix of the object keys of the backups.
+		Prefix string `default:"goshimmer/" usage:"the prefix of the object keys of the backups"`
+		// AccessKeyID defines the access key used to authenticate (empty uses the default credential chain).
+		AccessKeyID string `default:"" usage:"the access key id (empty uses the default AWS credential chain)"`
+		// SecretAccessKey defines the secret key used to authenticate.
+		SecretAccessKey string `default:"" usage:"the secret access key"`
+		// ForcePathStyle defines whether the bucket is addressed in the path instead of the host name (i.e. for MinIO).
+		ForcePathStyle bool `default:"false" usage:"whether to use path-style addressing of the bucket (i.e. for MinIO)"`
+	}
+}
+
+// Parameters contains the configuration used by the backup plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "backup")
+}
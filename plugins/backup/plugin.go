@@ -0,0 +1,175 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/runtime/timeutil"
+)
+
+// PluginName is the name of the backup plugin.
+const PluginName = "Backup"
+
+// backupNameFormat is the (sortable) time format that is used to name the backups.
+const backupNameFormat = "20060102T150405Z"
+
+// ErrBackupInProgress is returned if a backup is requested while another backup is still being written.
+var ErrBackupInProgress = errors.New("another backup is in progress")
+
+var (
+	// Plugin is the plugin instance of the backup plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+
+	// uploader uploads the backups to the object storage (nil if uploads are disabled).
+	uploader *s3Uploader
+
+	// backupMutex makes sure that only a single backup is written at a time.
+	backupMutex sync.Mutex
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
+}
+
+func configure(plugin *node.Plugin) {
+	if Parameters.Interval < 0 {
+		plugin.LogFatalfAndExitf("the backup interval must not be negative")
+	}
+
+	if Parameters.S3.Enabled {
+		var err error
+		if uploader, err = newS3Uploader(); err != nil {
+			plugin.LogFatalfAndExitf("failed to configure backup uploads: %s", err)
+		}
+	}
+}
+
+func run(plugin *node.Plugin) {
+	if Parameters.Interval == 0 {
+		return
+	}
+
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		defer plugin.LogInfof("Stopping %s ... done", PluginName)
+
+		ticker := timeutil.NewTicker(func() {
+			result, err := Create(ctx)
+			if err != nil {
+				plugin.LogErrorf("failed to create scheduled backup: %s", err)
+				return
+			}
+
+			plugin.LogInfof("created scheduled backup in %s", result)
+		}, Parameters.Interval, ctx)
+
+		<-ctx.Done()
+
+		ticker.Shutdown()
+		ticker.WaitForShutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		plugin.Logger().Panicf("Failed to start daemon: %s", err)
+	}
+}
+
+// Create writes a verified backup of the storage of the current engine to the configured directory, uploads it to the
+// object storage (if enabled) and removes the local backups that exceed the retention.
+func Create(ctx context.Context) (result *Result, err error) {
+	if !backupMutex.TryLock() {
+		return nil, ErrBackupInProgress
+	}
+	defer backupMutex.Unlock()
+
+	result = &Result{
+		Directory: filepath.Join(Parameters.Directory, time.Now().UTC().Format(backupNameFormat)),
+	}
+
+	if err = deps.Protocol.Engine().Backup(result.Directory); err != nil {
+		if removeErr := os.RemoveAll(result.Directory); removeErr != nil {
+			Plugin.LogErrorf("failed to remove incomplete backup in %s: %s", result.Directory, removeErr)
+		}
+
+		return nil, errors.Wrapf(err, "failed to write backup to %s", result.Directory)
+	}
+
+	if uploader != nil {
+		if result.Location, err = uploader.upload(ctx, result.Directory); err != nil {
+			return result, errors.Wrapf(err, "failed to upload backup in %s", result.Directory)
+		}
+	}
+
+	if err = pruneBackups(); err != nil {
+		Plugin.LogErrorf("failed to remove old backups: %s", err)
+	}
+
+	return result, nil
+}
+
+// pruneBackups removes the oldest local backups that exceed the retention.
+func pruneBackups() (err error) {
+	if Parameters.Retention <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(Parameters.Directory)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read directory %s", Parameters.Directory)
+	}
+
+	backups := make([]string, 0)
+	for _, entry := range entries {
+		if _, parseErr := time.Parse(backupNameFormat, entry.Name()); parseErr == nil && entry.IsDir() {
+			backups = append(backups, entry.Name())
+		}
+	}
+	sort.Strings(backups)
+
+	for len(backups) > Parameters.Retention {
+		if err = os.RemoveAll(filepath.Join(Parameters.Directory, backups[0])); err != nil {
+			return errors.Wrapf(err, "failed to remove backup %s", backups[0])
+		}
+		backups = backups[1:]
+	}
+
+	return nil
+}
+
+// region Result ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Result contains the details of a created backup.
+type Result struct {
+	// Directory contains the local directory of the backup.
+	Directory string
+
+	// Location contains the location of the uploaded backup (empty if uploads are disabled).
+	Location string
+}
+
+// String returns a human-readable version of the Result.
+func (r *Result) String() string {
+	if r.Location == "" {
+		return r.Directory
+	}
+
+	return r.Directory + " (uploaded to " + r.Location + ")"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
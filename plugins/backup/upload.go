@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pkg/errors"
+)
+
+// s3Uploader uploads backups as compressed archives to an S3-compatible object storage.
+type s3Uploader struct {
+	uploader *s3manager.Uploader
+}
+
+// newS3Uploader creates a new s3Uploader from the configured parameters.
+func newS3Uploader() (newUploader *s3Uploader, err error) {
+	if Parameters.S3.Bucket == "" {
+		return nil, errors.New("no bucket was configured")
+	}
+
+	config := aws.NewConfig().WithRegion(Parameters.S3.Region).WithS3ForcePathStyle(Parameters.S3.ForcePathStyle)
+	if Parameters.S3.Endpoint != "" {
+		config = config.WithEndpoint(Parameters.S3.Endpoint)
+	}
+	if Parameters.S3.AccessKeyID != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(Parameters.S3.AccessKeyID, Parameters.S3.SecretAccessKey, ""))
+	}
+
+	s3Session, err := session.NewSession(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+
+	return &s3Uploader{
+		uploader: s3manager.NewUploader(s3Session),
+	}, nil
+}
+
+// upload streams the backup in the given directory as a tar.gz archive to the object storage (using a multipart upload)
+// and returns the location of the uploaded object.
+func (s *s3Uploader) upload(ctx context.Context, directory string) (location string, err error) {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeArchive(writer, directory))
+	}()
+	defer reader.Close()
+
+	output, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(Parameters.S3.Bucket),
+		Key:    aws.String(Parameters.S3.Prefix + filepath.Base(directory) + ".tar.gz"),
+		Body:   reader,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to upload archive")
+	}
+
+	return output.Location, nil
+}
+
+// writeArchive writes the content of the given directory as a tar.gz archive to the given writer.
+func writeArchive(writer io.Writer, directory string) (err error) {
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err = filepath.WalkDir(directory, func(path string, entry fs.DirEntry, walkErr error) (err error) {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return errors.Wrapf(err, "failed to read file info of %s", path)
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return errors.Wrapf(err, "failed to create header for %s", path)
+		}
+
+		relativePath, err := filepath.Rel(filepath.Dir(directory), path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to determine relative path of %s", path)
+		}
+		header.Name = filepath.ToSlash(relativePath)
+
+		if err = tarWriter.WriteHeader(header); err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		return copyFile(tarWriter, path)
+	}); err != nil {
+		return errors.Wrapf(err, "failed to archive %s", directory)
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return errors.Wrap(err, "failed to close archive")
+	}
+
+	return gzipWriter.Close()
+}
+
+// copyFile copies the content of the file at the given path to the given writer.
+func copyFile(writer io.Writer, path string) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer file.Close()
+
+	if _, err = io.Copy(writer, file); err != nil {
+		return errors.Wrapf(err, "failed to copy %s", path)
+	}
+
+	return nil
+}
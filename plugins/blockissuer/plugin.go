@@ -8,8 +8,11 @@ import (
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer/ratesetter"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	protocolParams "github.com/iotaledger/goshimmer/plugins/protocol"
 	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/event"
 )
 
@@ -44,19 +47,26 @@ func configure(plugin *node.Plugin) {
 	}, event.WithWorkerPool(plugin.WorkerPool))
 }
 
-func createBlockIssuer(local *peer.Local, protocol *protocol.Protocol) *blockissuer.BlockIssuer {
+func createBlockIssuer(local *peer.Local, protocol *protocol.Protocol, schedule *upgrades.Schedule) *blockissuer.BlockIssuer {
 	rateSetterMode := ratesetter.ParseRateSetterMode(Parameters.RateSetter.Mode)
+	// the work policy is validated by the protocol plugin
+	workPolicy := lo.PanicOnErr(models.WorkPolicyFromString(protocolParams.Parameters.WorkPolicy))
+
 	rateSetter := ratesetter.New(local.ID(), protocol,
 		ratesetter.WithMode(rateSetterMode),
 		ratesetter.WithInitialRate(Parameters.RateSetter.Initial),
 		ratesetter.WithPause(Parameters.RateSetter.Pause),
 		ratesetter.WithSchedulerRate(protocolParams.SchedulerParameters.Rate),
+		ratesetter.WithWorkPolicy(workPolicy),
 	)
 
 	return blockissuer.New(protocol, local.LocalIdentity(),
 		blockissuer.WithBlockFactoryOptions(
 			blockfactory.WithTipSelectionRetryInterval(Parameters.BlockFactory.TipSelectionRetryInterval),
 			blockfactory.WithTipSelectionTimeout(Parameters.BlockFactory.TipSelectionTimeout),
+			blockfactory.WithPoWDifficulty(protocolParams.Parameters.PoWDifficulty),
+			blockfactory.WithWorkPolicy(workPolicy),
+			blockfactory.WithUpgrades(schedule),
 		),
 		blockissuer.WithRateSetter(rateSetter),
 		blockissuer.WithIgnoreBootstrappedFlag(Parameters.IgnoreBootstrappedFlag),
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"github.com/iotaledger/hive.go/app/configuration"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// Events defines the events of the plugin.
+var Events *EventsStruct
+
+type EventsStruct struct {
+	// Reloaded is fired with the freshly loaded config after the config has been reloaded (e.g. after receiving a
+	// SIGHUP). The bound parameters are not updated, so plugins have to read the settings that support being
+	// hot-reloaded from the given config.
+	Reloaded *event.Event1[*configuration.Configuration]
+}
+
+func newEvents() *EventsStruct {
+	return &EventsStruct{
+		Reloaded: event.New1[*configuration.Configuration](),
+	}
+}
+
+func init() {
+	Events = newEvents()
+}
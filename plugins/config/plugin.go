@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	flag "github.com/spf13/pflag"
 	"go.uber.org/dig"
@@ -16,7 +18,7 @@ const PluginName = "Config"
 
 var (
 	// Plugin is the plugin instance of the config plugin.
-	Plugin = node.NewPlugin(PluginName, nil, node.Enabled)
+	Plugin = node.NewPlugin(PluginName, nil, node.Enabled, configure)
 
 	// flags
 	defaultConfigName   = "config.json"
@@ -53,6 +55,44 @@ func init() {
 	})
 }
 
+func configure(plugin *node.Plugin) {
+	reloadRequests := make(chan os.Signal, 1)
+	signal.Notify(reloadRequests, syscall.SIGHUP)
+
+	go func() {
+		for range reloadRequests {
+			if err := Reload(); err != nil {
+				plugin.LogErrorf("Failed to reload config: %s", err)
+				continue
+			}
+			plugin.LogInfo("Reloaded config")
+		}
+	}()
+}
+
+// Reload reads the config file, the environment variables and the flags again into a new config and triggers the
+// Reloaded event with it, so that plugins can apply the settings that support being hot-reloaded. The bound parameters
+// are left untouched.
+func Reload() error {
+	reloadedConfig := configuration.New()
+	if err := reloadedConfig.LoadFile(*configFilePath); err != nil && hasFlag(defaultConfigName) {
+		return err
+	}
+	if err := reloadedConfig.LoadFlagSet(flag.CommandLine); err != nil {
+		return err
+	}
+	if err := reloadedConfig.LoadEnvironmentVars(""); err != nil {
+		return err
+	}
+	if err := reloadedConfig.LoadFlagSet(flag.CommandLine); err != nil {
+		return err
+	}
+
+	Events.Reloaded.Trigger(reloadedConfig)
+
+	return nil
+}
+
 // fetch fetches config values from a configFilePath (or the current working dir if not set).
 //
 // It automatically reads in a single config file starting with "config" (can be changed via the --config CLI flag)
@@ -110,6 +150,12 @@ func BindParameters(p any, namespace string) {
 	_node.BindParameters(flag.CommandLine, namespace, p)
 }
 
+// ParameterPath returns the path of the bound parameter that the given pointer points to (e.g. to look it up in the
+// config of the Reloaded event).
+func ParameterPath(parameter any) string {
+	return _node.GetParameterPath(parameter)
+}
+
 func hasFlag(name string) bool {
 	has := false
 	flag.Visit(func(f *flag.Flag) {
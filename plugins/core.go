@@ -2,15 +2,19 @@ package plugins
 
 import (
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/addresswatch"
 	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/backup"
 	"github.com/iotaledger/goshimmer/plugins/banner"
 	"github.com/iotaledger/goshimmer/plugins/blockissuer"
 	"github.com/iotaledger/goshimmer/plugins/cli"
 	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/dashboardmetrics"
 	"github.com/iotaledger/goshimmer/plugins/faucet"
+	"github.com/iotaledger/goshimmer/plugins/firehose"
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
 	"github.com/iotaledger/goshimmer/plugins/indexer"
+	"github.com/iotaledger/goshimmer/plugins/journal"
 	"github.com/iotaledger/goshimmer/plugins/logger"
 	"github.com/iotaledger/goshimmer/plugins/manainitializer"
 	"github.com/iotaledger/goshimmer/plugins/manualpeering"
@@ -21,6 +25,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/profiling"
 	"github.com/iotaledger/goshimmer/plugins/profilingrecorder"
 	"github.com/iotaledger/goshimmer/plugins/protocol"
+	"github.com/iotaledger/goshimmer/plugins/rebroadcaster"
 	"github.com/iotaledger/goshimmer/plugins/retainer"
 	"github.com/iotaledger/goshimmer/plugins/spammer"
 	"github.com/iotaledger/goshimmer/plugins/warpsync"
@@ -39,6 +44,7 @@ var Core = node.Plugins(
 	manualpeering.Plugin,
 	profiling.Plugin,
 	profilingrecorder.Plugin,
+	backup.Plugin,
 	p2p.Plugin,
 	protocol.Plugin,
 	retainer.Plugin,
@@ -50,4 +56,8 @@ var Core = node.Plugins(
 	spammer.Plugin,
 	manainitializer.Plugin,
 	blockissuer.Plugin,
+	rebroadcaster.Plugin,
+	addresswatch.Plugin,
+	firehose.Plugin,
+	journal.Plugin,
 )
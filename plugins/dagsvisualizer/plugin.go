@@ -37,7 +37,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentProtocol)
 }
 
 func configure(plugin *node.Plugin) {
@@ -63,7 +63,7 @@ func run(plugin *node.Plugin) {
 	runVisualizer(plugin)
 
 	plugin.LogInfof("Starting %s ...", PluginName)
-	if err := daemon.BackgroundWorker(PluginName, worker, shutdown.PriorityDashboard); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, worker, Plugin.ShutdownOrder()); err != nil {
 		plugin.Panicf("Error starting as daemon: %s", err)
 	}
 }
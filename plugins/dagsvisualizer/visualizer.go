@@ -12,7 +12,6 @@ import (
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/app/retainer"
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/core/votes/conflicttracker"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
@@ -44,7 +43,7 @@ func runVisualizer(plugin *node.Plugin) {
 		<-ctx.Done()
 		log.Info("Stopping DAGs Visualizer ...")
 		log.Info("Stopping DAGs Visualizer ... done")
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
@@ -34,14 +33,18 @@ type conflictSet struct {
 
 type conflictSetJSON struct {
 	ConflictSetID string        `json:"conflictSetID"`
+	Alias         string        `json:"alias,omitempty"`
 	ArrivalTime   int64         `json:"arrivalTime"`
 	Resolved      bool          `json:"resolved"`
 	TimeToResolve time.Duration `json:"timeToResolve"`
 }
 
 func (c *conflictSet) ToJSON() *conflictSetJSON {
+	alias, _ := utxo.OutputIDAliases.Get(c.ConflictSetID)
+
 	return &conflictSetJSON{
 		ConflictSetID: c.ConflictSetID.Base58(),
+		Alias:         alias,
 		ArrivalTime:   c.ArrivalTime.Unix(),
 		Resolved:      c.Resolved,
 		TimeToResolve: c.TimeToResolve,
@@ -59,6 +62,7 @@ type conflict struct {
 
 type conflictJSON struct {
 	ConflictID        string             `json:"conflictID"`
+	Alias             string             `json:"alias,omitempty"`
 	ConflictSetIDs    []string           `json:"conflictSetIDs"`
 	ConfirmationState confirmation.State `json:"confirmationState"`
 	IssuingTime       int64              `json:"issuingTime"`
@@ -66,8 +70,11 @@ type conflictJSON struct {
 }
 
 func (c *conflict) ToJSON() *conflictJSON {
+	alias, _ := utxo.TransactionIDAliases.Get(c.ConflictID)
+
 	return &conflictJSON{
 		ConflictID: c.ConflictID.Base58(),
+		Alias:      alias,
 		ConflictSetIDs: func() (conflictSetIDsStr []string) {
 			for it := c.ConflictSetIDs.Iterator(); it.HasNext(); {
 				conflictSetID := it.Next()
@@ -108,7 +115,7 @@ func runConflictLiveFeed(plugin *node.Plugin) {
 		log.Info("Stopping Dashboard[ConflictsLiveFeed] ...")
 		unhook()
 		log.Info("Stopping Dashboard[ConflictsLiveFeed] ... done")
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -0,0 +1,184 @@
+package dashboard
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/plugins/faucet"
+	"github.com/iotaledger/goshimmer/plugins/spammer"
+)
+
+// SpammerControlRequest is the request to start or stop the spammer.
+type SpammerControlRequest struct {
+	Cmd     string `json:"cmd"`
+	Profile string `json:"profile"`
+}
+
+// SpammerControlResponse contains the state of the spammer and the profiles that it can be started with.
+type SpammerControlResponse struct {
+	Profiles            []*SpammerProfile             `json:"profiles"`
+	ActiveProfile       string                        `json:"activeProfile,omitempty"`
+	BlockSpammerRunning bool                          `json:"blockSpammerRunning"`
+	ValueSpammer        *jsonmodels.ValueSpammerStats `json:"valueSpammer"`
+}
+
+// SpammerProfile is a predefined configuration of the spammer.
+type SpammerProfile struct {
+	Name         string  `json:"name"`
+	Mode         string  `json:"mode"`
+	Rate         int     `json:"rate"`
+	Unit         string  `json:"unit"`
+	IMIF         string  `json:"imif,omitempty"`
+	PayloadSize  uint64  `json:"payloadSize,omitempty"`
+	ConflictRate float64 `json:"conflictRate,omitempty"`
+}
+
+// FaucetControlResponse contains the state of the queue of the faucet.
+type FaucetControlResponse struct {
+	QueueDepth    int `json:"queueDepth"`
+	QueueCapacity int `json:"queueCapacity"`
+}
+
+// FaucetPaymentRequest is the request to send a test payment to an address.
+type FaucetPaymentRequest struct {
+	Address string `json:"address"`
+}
+
+// FaucetPaymentResponse is the response to a FaucetPaymentRequest.
+type FaucetPaymentResponse struct {
+	Address    string `json:"address"`
+	QueueDepth int    `json:"queueDepth"`
+}
+
+// setupControlRoutes registers the routes that control the spammer and the faucet of the node. They can only be used
+// if the dashboard is protected by basic auth.
+func setupControlRoutes(routeGroup *echo.Group) {
+	controlRoutes := routeGroup.Group("/control", requireBasicAuth)
+
+	controlRoutes.GET("/spammer", func(c echo.Context) (err error) {
+		if err = requirePlugin(spammer.Plugin); err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, spammerControlResponse())
+	})
+
+	controlRoutes.POST("/spammer", func(c echo.Context) (err error) {
+		if err = requirePlugin(spammer.Plugin); err != nil {
+			return err
+		}
+
+		var request SpammerControlRequest
+		if err = c.Bind(&request); err != nil {
+			return errors.WithMessagef(ErrInvalidParameter, "failed to parse request: %s", err)
+		}
+
+		switch request.Cmd {
+		case "start":
+			if err = spammer.StartProfile(request.Profile); err != nil {
+				return errors.WithMessagef(ErrInvalidParameter, "failed to start spammer: %s", err)
+			}
+		case "stop":
+			spammer.Stop()
+		default:
+			return errors.WithMessagef(ErrInvalidParameter, "invalid cmd '%s' [start, stop]", request.Cmd)
+		}
+
+		return c.JSON(http.StatusOK, spammerControlResponse())
+	})
+
+	controlRoutes.GET("/faucet", func(c echo.Context) (err error) {
+		if err = requirePlugin(faucet.Plugin); err != nil {
+			return err
+		}
+
+		depth, capacity := faucet.QueueDepth()
+
+		return c.JSON(http.StatusOK, &FaucetControlResponse{QueueDepth: depth, QueueCapacity: capacity})
+	})
+
+	controlRoutes.POST("/faucet/payment", func(c echo.Context) (err error) {
+		if err = requirePlugin(faucet.Plugin); err != nil {
+			return err
+		}
+
+		var request FaucetPaymentRequest
+		if err = c.Bind(&request); err != nil {
+			return errors.WithMessagef(ErrInvalidParameter, "failed to parse request: %s", err)
+		}
+
+		address, err := devnetvm.AddressFromBase58EncodedString(request.Address)
+		if err != nil {
+			return errors.WithMessagef(ErrInvalidParameter, "invalid address '%s': %s", request.Address, err)
+		}
+
+		if err = faucet.RequestTestPayment(address); err != nil {
+			return errors.WithMessagef(echo.NewHTTPError(http.StatusServiceUnavailable, "test payment failed"), "%s", err)
+		}
+
+		depth, _ := faucet.QueueDepth()
+
+		return c.JSON(http.StatusOK, &FaucetPaymentResponse{Address: address.Base58(), QueueDepth: depth})
+	})
+}
+
+// requireBasicAuth is a middleware that rejects all requests if the dashboard is not protected by basic auth.
+func requireBasicAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !Parameters.BasicAuth.Enabled {
+			return errors.WithMessage(ErrForbidden, "the control panel requires basic auth to be enabled")
+		}
+
+		return next(c)
+	}
+}
+
+// requirePlugin returns an error if the given plugin is not enabled.
+func requirePlugin(plugin *node.Plugin) error {
+	if node.IsSkipped(plugin) {
+		return errors.WithMessagef(ErrForbidden, "the %s plugin is not enabled", plugin.Name)
+	}
+
+	return nil
+}
+
+func spammerControlResponse() *SpammerControlResponse {
+	response := &SpammerControlResponse{
+		Profiles: make([]*SpammerProfile, len(spammer.Profiles)),
+	}
+	response.ActiveProfile, response.BlockSpammerRunning, response.ValueSpammer = spammer.Status()
+
+	for i, profile := range spammer.Profiles {
+		response.Profiles[i] = &SpammerProfile{
+			Name:         profile.Name,
+			Mode:         profile.Mode,
+			Rate:         profile.Rate,
+			Unit:         profileUnit(profile),
+			IMIF:         profile.IMIF,
+			PayloadSize:  profile.PayloadSize,
+			ConflictRate: profile.ConflictRate,
+		}
+	}
+
+	return response
+}
+
+// profileUnit returns the unit of the rate of the given profile (e.g. BPS or TPM).
+func profileUnit(profile *spammer.Profile) string {
+	unit := "B"
+	if profile.Mode == spammer.ProfileModeValue {
+		unit = "T"
+	}
+
+	if profile.TimeUnit == time.Minute {
+		return unit + "PM"
+	}
+
+	return unit + "PS"
+}
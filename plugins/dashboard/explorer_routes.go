@@ -1,6 +1,7 @@
 package dashboard
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -199,7 +200,7 @@ func setupExplorerRoutes(routeGroup *echo.Group) {
 	})
 
 	routeGroup.GET("/address/:id", func(c echo.Context) error {
-		addr, err := findAddress(c.Param("id"))
+		addr, err := findAddress(c.Request().Context(), c.Param("id"))
 		if err != nil {
 			return err
 		}
@@ -241,7 +242,7 @@ func setupExplorerRoutes(routeGroup *echo.Group) {
 			result.Block = blk
 
 		case false:
-			addr, err := findAddress(search)
+			addr, err := findAddress(c.Request().Context(), search)
 			if err != nil {
 				return fmt.Errorf("can't find address %s: %w", search, err)
 			}
@@ -263,7 +264,7 @@ func findBlock(blockID models.BlockID) (explorerBlk *ExplorerBlock, err error) {
 	return
 }
 
-func findAddress(strAddress string) (*ExplorerAddress, error) {
+func findAddress(ctx context.Context, strAddress string) (*ExplorerAddress, error) {
 	address, err := devnetvm.AddressFromBase58EncodedString(strAddress)
 	if err != nil {
 		return nil, errors.WithMessagef(ErrNotFound, "address %s", strAddress)
@@ -272,8 +273,16 @@ func findAddress(strAddress string) (*ExplorerAddress, error) {
 	outputs := make([]ExplorerOutput, 0)
 
 	// get outputids by address
-	// deps.Indexer.CachedOutputsOnAddress(address).Consume(func(output ledgerstate.Output) {
-	deps.Indexer.CachedAddressOutputMappings(address).Consume(func(addressOutputMapping *indexer.AddressOutputMapping) {
+	cachedAddressOutputMappings, err := deps.Indexer.CachedAddressOutputMappings(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedAddressOutputMappings.Consume(func(addressOutputMapping *indexer.AddressOutputMapping) {
+		if ctx.Err() != nil {
+			return
+		}
+
 		var metaData *mempool.OutputMetadata
 		var timestamp int64
 
@@ -294,13 +303,10 @@ func findAddress(strAddress string) (*ExplorerAddress, error) {
 					}
 				})
 
-				// obtain information about the consumer of the output being considered
-				confirmedConsumerID := deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer(output.ID())
-
 				outputs = append(outputs, ExplorerOutput{
 					ID:                jsonmodels.NewOutputID(output.ID()),
 					Output:            jsonmodels.NewOutput(output),
-					Metadata:          jsonmodels.NewOutputMetadata(metaData, confirmedConsumerID),
+					Metadata:          jsonmodels.NewOutputMetadata(metaData, deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer(output.ID())),
 					TxTimestamp:       int(timestamp),
 					ConfirmationState: metaData.ConfirmationState(),
 				})
@@ -308,6 +314,10 @@ func findAddress(strAddress string) (*ExplorerAddress, error) {
 		})
 	})
 
+	if err = ctx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "lookup of the outputs of address %s aborted", strAddress)
+	}
+
 	if len(outputs) == 0 {
 		return nil, errors.WithMessagef(ErrNotFound, "address %s", strAddress)
 	}
@@ -3,7 +3,6 @@ package dashboard
 import (
 	"context"
 
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
 	"github.com/iotaledger/hive.go/app/daemon"
@@ -19,7 +18,7 @@ func runLiveFeed(plugin *node.Plugin) {
 		log.Info("Stopping Dashboard[BlkUpdater] ...")
 		hook.Unhook()
 		log.Info("Stopping Dashboard[BlkUpdater] ... done")
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
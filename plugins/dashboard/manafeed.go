@@ -9,7 +9,6 @@ import (
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
 
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/throughputquota/mana1/manamodels"
@@ -48,7 +47,7 @@ func runManaFeed(plugin *node.Plugin) {
 				plugin.WorkerPool.Submit(sendManaMapOnline)
 			}
 		}
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -63,7 +63,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentProtocol, shutdown.ComponentP2P, shutdown.ComponentDashboardMetrics)
 }
 
 func configure(plugin *node.Plugin) {
@@ -116,7 +116,7 @@ func run(plugin *node.Plugin) {
 	runSlotsLiveFeed(plugin)
 
 	log.Infof("Starting %s ...", PluginName)
-	if err := daemon.BackgroundWorker(PluginName, worker, shutdown.PriorityProfiling); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, worker, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Error starting as daemon: %s", err)
 	}
 }
@@ -3,7 +3,6 @@ package dashboard
 import (
 	"context"
 
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/hive.go/app/daemon"
@@ -25,7 +24,7 @@ func runSlotsLiveFeed(plugin *node.Plugin) {
 		log.Info("Stopping Dashboard[SlotsLiveFeed] ...")
 		hook.Unhook()
 		log.Info("Stopping Dashboard[SlotsLiveFeed] ... done")
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
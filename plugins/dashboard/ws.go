@@ -10,7 +10,6 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/goshimmer/packages/app/collector"
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/dashboardmetrics"
 	"github.com/iotaledger/hive.go/app/daemon"
@@ -88,7 +87,7 @@ func runWebSocketStreams(plugin *node.Plugin) {
 		log.Info("Stopping Dashboard[StatusUpdate] ...")
 		unhook()
 		log.Info("Stopping Dashboard[StatusUpdate] ... done")
-	}, shutdown.PriorityDashboard); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
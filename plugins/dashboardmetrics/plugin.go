@@ -42,7 +42,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentDashboardMetrics).Consumes(shutdown.ComponentProtocol)
 }
 
 func configure(_ *node.Plugin) {
@@ -64,7 +64,7 @@ func run(plugin *node.Plugin) {
 
 		// Wait before terminating so we get correct log blocks from the daemon regarding the shutdown order.
 		<-ctx.Done()
-	}, shutdown.PriorityMetrics); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -1,6 +1,8 @@
 package faucet
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/client/wallet"
@@ -32,7 +34,12 @@ func (f *Connector) UnspentOutputs(addresses ...address.Address) (unspentOutputs
 	unspentOutputs = make(map[address.Address]map[utxo.OutputID]*wallet.Output)
 
 	for _, addr := range addresses {
-		f.indexer.CachedAddressOutputMappings(addr.Address()).Consume(func(mapping *indexer.AddressOutputMapping) {
+		cachedAddressOutputMappings, mappingsErr := f.indexer.CachedAddressOutputMappings(context.Background(), addr.Address())
+		if mappingsErr != nil {
+			return nil, mappingsErr
+		}
+
+		cachedAddressOutputMappings.Consume(func(mapping *indexer.AddressOutputMapping) {
 			f.protocol.Engine().Ledger.MemPool().Storage().CachedOutput(mapping.OutputID()).Consume(func(output utxo.Output) {
 				if typedOutput, ok := output.(devnetvm.Output); ok {
 					f.protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(typedOutput.ID()).Consume(func(outputMetadata *mempool.OutputMetadata) {
@@ -2,12 +2,14 @@ package faucet
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/iotaledger/goshimmer/client/wallet"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/consolidateoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
@@ -21,21 +23,35 @@ import (
 
 type Faucet struct {
 	*wallet.Wallet
+
+	connector      *Connector
+	multiSigPolicy *faucet.MultiSigPolicy
+	coSigners      []faucet.CoSigner
+
+	// issuanceMutex serializes the issuance of the funding and the consolidation transactions, so that they never select
+	// the same outputs.
+	issuanceMutex sync.Mutex
 }
 
-// NewFaucet creates a new Faucet instance.
-func NewFaucet(faucetSeed *seed.Seed, p *protocol.Protocol, issuer *blockissuer.BlockIssuer, indexer *indexer.Indexer) (f *Faucet) {
+// NewFaucet creates a new Faucet instance. If a MultiSigPolicy is given, the funding outputs are controlled by the
+// multisig and the funding transactions are co-signed by the given remote signers.
+func NewFaucet(faucetSeed *seed.Seed, p *protocol.Protocol, issuer *blockissuer.BlockIssuer, indexer *indexer.Indexer, multiSigPolicy *faucet.MultiSigPolicy, coSigners ...faucet.CoSigner) (f *Faucet) {
 	connector := NewConnector(p, issuer, indexer)
 
-	f = &Faucet{wallet.New(
-		wallet.GenericConnector(connector),
-		wallet.Import(faucetSeed, 0, []bitmask.BitMask{}, nil),
-		wallet.ReusableAddress(true),
-		wallet.FaucetPowDifficulty(Parameters.PowDifficulty),
-		wallet.ConfirmationTimeout(Parameters.MaxAwait),
-		wallet.ConfirmationPollingInterval(500*time.Millisecond),
-		wallet.Stateless(true),
-	)}
+	f = &Faucet{
+		Wallet: wallet.New(
+			wallet.GenericConnector(connector),
+			wallet.Import(faucetSeed, 0, []bitmask.BitMask{}, nil),
+			wallet.ReusableAddress(true),
+			wallet.FaucetPowDifficulty(Parameters.PowDifficulty),
+			wallet.ConfirmationTimeout(Parameters.MaxAwait),
+			wallet.ConfirmationPollingInterval(500*time.Millisecond),
+			wallet.Stateless(true),
+		),
+		connector:      connector,
+		multiSigPolicy: multiSigPolicy,
+		coSigners:      coSigners,
+	}
 	// We use index 1 as a proxy address from which we send the funds to the requester.
 	f.Wallet.NewReceiveAddress()
 
@@ -43,7 +59,18 @@ func NewFaucet(faucetSeed *seed.Seed, p *protocol.Protocol, issuer *blockissuer.
 }
 
 // Start starts the faucet to fulfill faucet requests.
-func (f *Faucet) Start(ctx context.Context, requestChan <-chan *faucet.Payload) {
+func (f *Faucet) Start(ctx context.Context, requestChan <-chan *fundingRequest) {
+	if Parameters.Consolidation.Enabled {
+		var consolidationWorker sync.WaitGroup
+		consolidationWorker.Add(1)
+		go func() {
+			defer consolidationWorker.Done()
+
+			f.runConsolidation(ctx, requestChan)
+		}()
+		defer consolidationWorker.Wait()
+	}
+
 	for {
 		select {
 		case p := <-requestChan:
@@ -60,8 +87,44 @@ func (f *Faucet) Start(ctx context.Context, requestChan <-chan *faucet.Payload)
 	}
 }
 
+// runConsolidation periodically consolidates the dust outputs of the faucet. It runs in its own worker, so that waiting
+// for the confirmation of the consolidation transactions does not delay the funding requests.
+func (f *Faucet) runConsolidation(ctx context.Context, requestChan <-chan *fundingRequest) {
+	ticker := time.NewTicker(Parameters.Consolidation.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// only consolidate during low-load periods, so we don't compete with the funding requests
+			if len(requestChan) != 0 || !f.isLowLoad() {
+				continue
+			}
+
+			txs, err := f.consolidateDust(ctx)
+			if err != nil {
+				Plugin.LogDebugf("skipped consolidation of dust outputs: %v", err)
+				continue
+			}
+			for _, tx := range txs {
+				Plugin.LogInfof("consolidated dust outputs: TXID: %s", tx.ID().Base58())
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // handleFaucetRequest sends funds to the requested address and waits for the transaction to become accepted.
-func (f *Faucet) handleFaucetRequest(p *faucet.Payload, ctx context.Context) (*devnetvm.Transaction, error) {
+func (f *Faucet) handleFaucetRequest(p *fundingRequest, ctx context.Context) (*devnetvm.Transaction, error) {
+	f.issuanceMutex.Lock()
+	defer f.issuanceMutex.Unlock()
+
+	if f.multiSigPolicy != nil {
+		return f.handleMultiSigFaucetRequest(p, ctx)
+	}
+
 	_, err := f.SendFunds(
 		sendoptions.Sources(f.Seed().Address(0)),                                          // we only reuse the address at index 0 for the wallet
 		sendoptions.Destination(f.Seed().Address(1), uint64(Parameters.TokensPerRequest)), // we send the funds to address at index 1 so that we can be sure the correct output is sent to a requester
@@ -85,3 +148,57 @@ func (f *Faucet) handleFaucetRequest(p *faucet.Payload, ctx context.Context) (*d
 	)
 	return tx, errors.Wrapf(err, "failed to send second transaction from %s to %s", f.Seed().Address(1).Base58(), p.Address().Base58())
 }
+
+// consolidateDust merges the dust outputs of the faucet into larger outputs and waits for the transactions to become
+// accepted.
+func (f *Faucet) consolidateDust(ctx context.Context) ([]*devnetvm.Transaction, error) {
+	txs, err := f.issueConsolidation()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		if err = f.WaitForTxAcceptance(tx.ID(), ctx); err != nil {
+			return nil, errors.Wrapf(err, "consolidation transaction %s was not accepted", tx.ID().Base58())
+		}
+	}
+
+	return txs, nil
+}
+
+// issueConsolidation issues the transactions that merge the dust outputs of the faucet. It only waits for the
+// transactions to be booked (which marks the consumed outputs as spent), so the funding requests are blocked only
+// while the transactions are issued.
+func (f *Faucet) issueConsolidation() ([]*devnetvm.Transaction, error) {
+	f.issuanceMutex.Lock()
+	defer f.issuanceMutex.Unlock()
+
+	localID := deps.Local.ID().EncodeBase58()
+
+	accessManaPledgeID := Parameters.Consolidation.AccessManaPledgeID
+	if accessManaPledgeID == "" {
+		accessManaPledgeID = localID
+	}
+
+	consensusManaPledgeID := Parameters.Consolidation.ConsensusManaPledgeID
+	if consensusManaPledgeID == "" {
+		consensusManaPledgeID = localID
+	}
+
+	return f.ConsolidateFunds(
+		consolidateoptions.DustThreshold(Parameters.Consolidation.DustThreshold),
+		consolidateoptions.MinOutputCount(Parameters.Consolidation.MinOutputCount),
+		consolidateoptions.AccessManaPledgeID(accessManaPledgeID),
+		consolidateoptions.ConsensusManaPledgeID(consensusManaPledgeID),
+	)
+}
+
+// isLowLoad returns true if the usage of the scheduler buffer is below the configured threshold.
+func (f *Faucet) isLowLoad() bool {
+	scheduler := deps.Protocol.CongestionControl.Scheduler()
+	if scheduler.MaxBufferSize() == 0 {
+		return true
+	}
+
+	return float64(scheduler.BufferSize())/float64(scheduler.MaxBufferSize()) <= Parameters.Consolidation.MaxSchedulerBufferUsage
+}
@@ -0,0 +1,186 @@
+package faucet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/client"
+	"github.com/iotaledger/goshimmer/packages/app/faucet"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+)
+
+var (
+	// coSignRecords persists the inputs and faucet requests that were co-signed by the node.
+	coSignRecords *faucet.CoSignRecords
+
+	// coSignMutex serializes the co-signing, so that the rate limit and the records are applied atomically.
+	coSignMutex sync.Mutex
+
+	// lastCoSign contains the time of the last co-signature of the node.
+	lastCoSign time.Time
+)
+
+// newMultiSigPolicy creates the MultiSigPolicy that is defined by the parameters of the plugin.
+func newMultiSigPolicy() (policy *faucet.MultiSigPolicy, err error) {
+	if Parameters.MultiSig.Threshold <= 0 || Parameters.MultiSig.Threshold > devnetvm.MaxThresholdSigAddresses {
+		return nil, errors.Errorf("threshold must be between 1 and %d", devnetvm.MaxThresholdSigAddresses)
+	}
+
+	addresses := make([]devnetvm.Address, len(Parameters.MultiSig.Addresses))
+	for i, base58Address := range Parameters.MultiSig.Addresses {
+		if addresses[i], err = devnetvm.AddressFromBase58EncodedString(base58Address); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse address %s", base58Address)
+		}
+	}
+
+	return faucet.NewMultiSigPolicy(uint8(Parameters.MultiSig.Threshold), uint64(Parameters.TokensPerRequest), addresses...)
+}
+
+// newCoSigners creates the remote signers that are defined by the parameters of the plugin.
+func newCoSigners() (coSigners []faucet.CoSigner) {
+	coSigners = make([]faucet.CoSigner, len(Parameters.MultiSig.CoSigners))
+	for i, url := range Parameters.MultiSig.CoSigners {
+		coSigners[i] = &remoteCoSigner{api: client.NewGoShimmerAPI(url)}
+	}
+
+	return coSigners
+}
+
+// handleMultiSigFaucetRequest sends funds from the outputs that are controlled by the multisig to the requested
+// address and waits for the transaction to become accepted.
+func (f *Faucet) handleMultiSigFaucetRequest(p *fundingRequest, ctx context.Context) (*devnetvm.Transaction, error) {
+	signerAddress := f.Seed().Address(0)
+
+	unspentOutputs, err := f.connector.UnspentOutputs(signerAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve the unspent outputs of the faucet")
+	}
+
+	fundingOutputs := make(devnetvm.Outputs, 0)
+	for _, output := range unspentOutputs[signerAddress] {
+		if output.ConfirmationStateReached && f.multiSigPolicy.IsFundingOutput(output.Object) {
+			fundingOutputs = append(fundingOutputs, output.Object)
+		}
+	}
+
+	selectedOutputs, err := faucet.SelectFundingOutputs(fundingOutputs, uint64(Parameters.TokensPerRequest))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to select funding outputs")
+	}
+
+	essence, err := f.multiSigPolicy.FundingTransactionEssence(selectedOutputs, p.Address(), uint64(Parameters.TokensPerRequest), p.AccessManaPledgeID(), p.ConsensusManaPledgeID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create funding transaction")
+	}
+
+	coSignRequest, err := faucet.SignCoSignRequest(essence, p.Payload, *f.Seed().KeyPair(signerAddress.Index))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create co-sign request")
+	}
+
+	coSignCtx, cancel := context.WithTimeout(ctx, Parameters.MultiSig.CoSignTimeout)
+	defer cancel()
+
+	signatureUnlockBlock, err := faucet.CollectSignatures(coSignCtx, f.multiSigPolicy, coSignRequest, f.SignEssence(essence, signerAddress), f.coSigners...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to collect co-signatures")
+	}
+
+	// all inputs are controlled by the same multisig, so they can reference the first unlock block
+	unlockBlocks := devnetvm.UnlockBlocks{signatureUnlockBlock}
+	for range essence.Inputs()[1:] {
+		unlockBlocks = append(unlockBlocks, devnetvm.NewReferenceUnlockBlock(0))
+	}
+
+	tx := devnetvm.NewTransaction(essence, unlockBlocks)
+	if err = f.connector.SendTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, f.WaitForTxAcceptance(tx.ID(), ctx)
+}
+
+// OnCoSignRequest signs the essence of the funding transaction of another faucet if the request is signed by an
+// eligible signer of the multisig, fulfills a faucet request with a valid PoW, follows the rules of the multisig and
+// neither spends an input nor fulfills a faucet request that was already co-signed as part of another transaction.
+// Co-signatures are rate limited to one per CoSignInterval.
+func OnCoSignRequest(request *faucet.CoSignRequest) (devnetvm.Signature, error) {
+	if !isCoSigner() {
+		return nil, errors.New("the node is not configured as a co-signer of the faucet")
+	}
+	if !initDone.Load() || _faucet == nil {
+		return nil, errors.New("faucet plugin is not done initializing")
+	}
+
+	if !isFaucetRequestPoWValid(request.FaucetRequest(), request.FaucetRequest().Address()) {
+		return nil, errors.New("PoW requirement of the faucet request is not satisfied")
+	}
+
+	inputs := make(devnetvm.Outputs, 0, len(request.Essence().Inputs()))
+	for _, input := range request.Essence().Inputs() {
+		utxoInput, isUTXOInput := input.(*devnetvm.UTXOInput)
+		if !isUTXOInput {
+			return nil, errors.Errorf("unsupported input type %s", input.Type())
+		}
+
+		if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(utxoInput.ReferencedOutputID()).Consume(func(output utxo.Output) {
+			if typedOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+				inputs = append(inputs, typedOutput)
+			}
+		}) {
+			return nil, errors.Errorf("unknown input %s", utxoInput.ReferencedOutputID())
+		}
+	}
+
+	signerAddress := _faucet.Seed().Address(0)
+	if err := _faucet.multiSigPolicy.ValidateCoSignRequest(request, inputs, signerAddress.Address()); err != nil {
+		return nil, errors.Wrap(err, "invalid co-sign request")
+	}
+
+	coSignMutex.Lock()
+	defer coSignMutex.Unlock()
+
+	if coSignRecords == nil {
+		return nil, errors.New("faucet plugin is not done initializing")
+	}
+
+	if nextCoSign := lastCoSign.Add(Parameters.MultiSig.CoSignInterval); time.Now().Before(nextCoSign) {
+		return nil, errors.Errorf("co-signing is rate limited until %s", nextCoSign.Format(time.RFC3339Nano))
+	}
+
+	if err := coSignRecords.Register(request); err != nil {
+		return nil, errors.Wrap(err, "failed to record co-sign request")
+	}
+	lastCoSign = time.Now()
+
+	return _faucet.SignEssence(request.Essence(), signerAddress), nil
+}
+
+// initCoSignRecords opens the database in which the co-signed inputs and faucet requests are recorded.
+func initCoSignRecords() (records *faucet.CoSignRecords, db database.DB, err error) {
+	if db, err = database.NewDB(Parameters.MultiSig.CoSignDBDirectory); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open co-sign database")
+	}
+
+	store, err := db.NewStore().WithExtendedRealm([]byte{database.PrefixFaucet})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create co-sign store")
+	}
+
+	return faucet.NewCoSignRecords(store), db, nil
+}
+
+// remoteCoSigner is a CoSigner that requests the signatures from the web API of a remote node.
+type remoteCoSigner struct {
+	api *client.GoShimmerAPI
+}
+
+// CoSign returns the signature of the remote node for the essence of the funding transaction of the given request.
+func (r *remoteCoSigner) CoSign(ctx context.Context, request *faucet.CoSignRequest) (devnetvm.Signature, error) {
+	return r.api.CoSignFaucetTransaction(ctx, request)
+}
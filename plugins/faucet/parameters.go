@@ -23,6 +23,61 @@ type ParametersDefinition struct {
 
 	// MaxWaitAttempts defines the maximum time to wait for a transaction to be accepted.
 	MaxAwait time.Duration `default:"60s" usage:"the maximum time to wait for a transaction to be accepted"`
+
+	// Consolidation defines the parameters of the background service that consolidates the dust outputs of the faucet.
+	Consolidation struct {
+		// Enabled defines whether the dust outputs of the faucet are consolidated in the background.
+		Enabled bool `default:"false" usage:"whether the dust outputs of the faucet are consolidated in the background"`
+
+		// Interval defines the interval in which the faucet checks if the dust outputs should be consolidated.
+		Interval time.Duration `default:"1m" usage:"the interval in which the faucet checks if the dust outputs should be consolidated"`
+
+		// DustThreshold defines the amount of tokens below which an output is considered to be dust.
+		DustThreshold uint64 `default:"1000000" usage:"the amount of tokens below which an output is considered to be dust"`
+
+		// MinOutputCount defines the minimum number of dust outputs that triggers a consolidation.
+		MinOutputCount int `default:"100" usage:"the minimum number of dust outputs that triggers a consolidation"`
+
+		// MaxSchedulerBufferUsage defines the maximum usage of the scheduler buffer (in the range [0, 1]) up to which
+		// the node is considered to be in a low-load period.
+		MaxSchedulerBufferUsage float64 `default:"0.1" usage:"the maximum usage of the scheduler buffer (in the range [0, 1]) up to which a consolidation is issued"`
+
+		// AccessManaPledgeID defines the node to pledge the access mana of the consolidation transactions to.
+		AccessManaPledgeID string `usage:"the node to pledge the access mana of the consolidation transactions to (defaults to the local node)"`
+
+		// ConsensusManaPledgeID defines the node to pledge the consensus mana of the consolidation transactions to.
+		ConsensusManaPledgeID string `usage:"the node to pledge the consensus mana of the consolidation transactions to (defaults to the local node)"`
+	}
+
+	// MultiSig defines the parameters of the operation mode in which the funding outputs of the faucet are controlled by
+	// an m-of-n multisig.
+	MultiSig struct {
+		// Enabled defines whether the funding outputs of the faucet are controlled by a multisig.
+		Enabled bool `default:"false" usage:"whether the funding outputs of the faucet are controlled by an m-of-n multisig"`
+
+		// Threshold defines the number of signatures that are required to spend the funding outputs.
+		Threshold int `default:"2" usage:"the number of signatures that are required to spend the funding outputs"`
+
+		// Addresses defines the base58 encoded addresses of the eligible signers (including the faucet itself).
+		Addresses []string `usage:"the base58 encoded addresses of the eligible signers of the multisig (including the faucet itself)"`
+
+		// CoSigners defines the web API URLs of the remote signers that are asked to co-sign the funding transactions.
+		CoSigners []string `usage:"the web API URLs of the remote signers that are asked to co-sign the funding transactions"`
+
+		// CoSignTimeout defines the time to wait for the co-signatures of a funding transaction.
+		CoSignTimeout time.Duration `default:"10s" usage:"the time to wait for the co-signatures of a funding transaction"`
+
+		// CoSigner defines whether the node only co-signs the funding transactions of another faucet instead of issuing
+		// funding transactions itself.
+		CoSigner bool `default:"false" usage:"whether the node only co-signs the funding transactions of another faucet"`
+
+		// CoSignInterval defines the minimum time between two co-signatures of the node.
+		CoSignInterval time.Duration `default:"1s" usage:"the minimum time between two co-signatures of funding transactions"`
+
+		// CoSignDBDirectory defines the path to the database in which a co-signer records the co-signed inputs and faucet
+		// requests.
+		CoSignDBDirectory string `default:"faucetdb" usage:"path to the database in which a co-signer records the co-signed inputs and faucet requests"`
+	}
 }
 
 // Parameters contains the configuration parameters of the faucet plugin.
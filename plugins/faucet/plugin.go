@@ -19,6 +19,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/crypto/identity"
 	"github.com/iotaledger/hive.go/runtime/event"
 )
@@ -34,7 +35,7 @@ var (
 	_faucet             *Faucet
 	powVerifier         = pow.New()
 	requestChanSize     = 300
-	requestChan         = make(chan *faucet.Payload, requestChanSize)
+	requestChan         = make(chan *fundingRequest, requestChanSize)
 	targetPoWDifficulty int
 
 	// signals that the faucet has initialized itself and can start funding requests.
@@ -45,13 +46,15 @@ var (
 type dependencies struct {
 	dig.In
 
+	Local       *peer.Local
 	Protocol    *protocol.Protocol
 	Indexer     *indexer.Indexer
 	BlockIssuer *blockissuer.BlockIssuer
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Provides(shutdown.ComponentFaucet).Consumes(shutdown.ComponentProtocol)
+	Plugin.AddPayloadTypes(faucet.RequestType.String())
 }
 
 // newFaucet gets the faucet component instance the faucet plugin has initialized.
@@ -70,12 +73,38 @@ func newFaucet() *Faucet {
 		Plugin.LogFatalfAndExitf("the max transaction booked await time must be more than 0")
 	}
 
-	return NewFaucet(walletseed.NewSeed(seedBytes), deps.Protocol, deps.BlockIssuer, deps.Indexer)
+	faucetSeed := walletseed.NewSeed(seedBytes)
+	if !Parameters.MultiSig.Enabled {
+		return NewFaucet(faucetSeed, deps.Protocol, deps.BlockIssuer, deps.Indexer, nil)
+	}
+
+	multiSigPolicy, err := newMultiSigPolicy()
+	if err != nil {
+		Plugin.LogFatalfAndExitf("configured multisig of the faucet is invalid: %s", err)
+	}
+	if !multiSigPolicy.IsEligibleSigner(faucetSeed.Address(0).Address()) {
+		Plugin.LogFatalfAndExitf("the address %s of the faucet seed is not an eligible signer of the multisig", faucetSeed.Address(0).Base58())
+	}
+	if !Parameters.MultiSig.CoSigner && len(Parameters.MultiSig.CoSigners) < int(multiSigPolicy.Threshold())-1 {
+		Plugin.LogFatalfAndExitf("at least %d co-signers are required to reach the threshold of the multisig", multiSigPolicy.Threshold()-1)
+	}
+
+	return NewFaucet(faucetSeed, deps.Protocol, deps.BlockIssuer, deps.Indexer, multiSigPolicy, newCoSigners()...)
+}
+
+// isCoSigner returns true if the node only co-signs the funding transactions of another faucet.
+func isCoSigner() bool {
+	return Parameters.MultiSig.Enabled && Parameters.MultiSig.CoSigner
 }
 
 func configure(plugin *node.Plugin) {
 	targetPoWDifficulty = Parameters.PowDifficulty
 
+	// co-signers don't fulfill any funding requests themselves
+	if isCoSigner() {
+		return
+	}
+
 	deps.Protocol.Events.Engine.Tangle.Booker.VirtualVoting.BlockTracked.Hook(onBlockProcessed, event.WithWorkerPool(plugin.WorkerPool))
 }
 
@@ -86,10 +115,28 @@ func run(plugin *node.Plugin) {
 		initDone.Store(true)
 
 		_faucet = newFaucet()
-		_faucet.Start(ctx, requestChan)
+		if isCoSigner() {
+			records, db, err := initCoSignRecords()
+			if err != nil {
+				plugin.LogFatalfAndExitf("failed to initialize the co-sign records: %s", err)
+			}
+			defer func() {
+				if closeErr := db.Close(); closeErr != nil {
+					plugin.LogErrorf("failed to close the co-sign database: %s", closeErr)
+				}
+			}()
+
+			coSignMutex.Lock()
+			coSignRecords = records
+			coSignMutex.Unlock()
+
+			<-ctx.Done()
+		} else {
+			_faucet.Start(ctx, requestChan)
+		}
 
 		close(requestChan)
-	}, shutdown.PriorityFaucet); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		plugin.Logger().Panicf("Failed to start daemon: %s", err)
 	}
 }
@@ -102,6 +149,9 @@ func OnWebAPIRequest(fundingRequest *faucet.Payload) error {
 	if !initDone.Load() {
 		return errors.New("faucet plugin is not done initializing")
 	}
+	if isCoSigner() {
+		return errors.New("the faucet only co-signs the funding transactions of another faucet")
+	}
 
 	if err := handleFaucetRequest(fundingRequest); err != nil {
 		return err
@@ -110,6 +160,30 @@ func OnWebAPIRequest(fundingRequest *faucet.Payload) error {
 	return nil
 }
 
+// QueueDepth returns the number of funding requests that are waiting to be processed and the capacity of the queue.
+func QueueDepth() (depth, capacity int) {
+	return len(requestChan), cap(requestChan)
+}
+
+// RequestTestPayment enqueues a funding request for the given address that pledges the mana to the local node. It
+// bypasses the PoW requirement and fails instead of blocking if the queue of the faucet is full.
+func RequestTestPayment(address devnetvm.Address) error {
+	if !initDone.Load() {
+		return errors.New("faucet plugin is not done initializing")
+	}
+	if isCoSigner() {
+		return errors.New("the faucet only co-signs the funding transactions of another faucet")
+	}
+
+	select {
+	case requestChan <- newFundingRequest(faucet.NewRequest(address, deps.Local.ID(), deps.Local.ID(), 0)):
+		Plugin.LogInfof("enqueued test payment for address %s", address.Base58())
+		return nil
+	default:
+		return errors.Errorf("the queue of the faucet is full (%d requests)", cap(requestChan))
+	}
+}
+
 func onBlockProcessed(block *booker.Block) {
 	// Do not start picking up request while waiting for initialization.
 	// If faucet nodes crashes, and you restart with a clean db, all previous faucet req blks will be enqueued
@@ -145,21 +219,53 @@ func handleFaucetRequest(fundingRequest *faucet.Payload, pledge ...identity.ID)
 		return errors.New("PoW requirement is not satisfied")
 	}
 
+	// finally add it to the faucet to be processed
+	requestChan <- newFundingRequest(fundingRequest, pledge...)
+
+	Plugin.LogInfof("enqueued funding request for address %s", addr.Base58())
+	return nil
+}
+
+// fundingRequest is a faucet request that is queued to be fulfilled. The faucet request is kept as it was issued (so
+// that the co-signers of the multisig can verify its PoW), while the mana pledges that were left empty by the requester
+// are resolved separately.
+type fundingRequest struct {
+	*faucet.Payload
+
+	accessManaPledgeID    identity.ID
+	consensusManaPledgeID identity.ID
+}
+
+// newFundingRequest creates a new fundingRequest that uses the given pledges (access and consensus) for the mana
+// pledges that were left empty by the requester.
+func newFundingRequest(faucetRequest *faucet.Payload, pledge ...identity.ID) *fundingRequest {
+	f := &fundingRequest{
+		Payload:               faucetRequest,
+		accessManaPledgeID:    faucetRequest.AccessManaPledgeID(),
+		consensusManaPledgeID: faucetRequest.ConsensusManaPledgeID(),
+	}
+
 	emptyID := identity.ID{}
 	if len(pledge) == 2 {
-		if fundingRequest.AccessManaPledgeID() == emptyID {
-			fundingRequest.SetAccessManaPledgeID(pledge[0])
+		if f.accessManaPledgeID == emptyID {
+			f.accessManaPledgeID = pledge[0]
 		}
-		if fundingRequest.ConsensusManaPledgeID() == emptyID {
-			fundingRequest.SetConsensusManaPledgeID(pledge[1])
+		if f.consensusManaPledgeID == emptyID {
+			f.consensusManaPledgeID = pledge[1]
 		}
 	}
 
-	// finally add it to the faucet to be processed
-	requestChan <- fundingRequest
+	return f
+}
 
-	Plugin.LogInfof("enqueued funding request for address %s", addr.Base58())
-	return nil
+// AccessManaPledgeID returns the node to pledge the access mana of the funding transaction to.
+func (f *fundingRequest) AccessManaPledgeID() identity.ID {
+	return f.accessManaPledgeID
+}
+
+// ConsensusManaPledgeID returns the node to pledge the consensus mana of the funding transaction to.
+func (f *fundingRequest) ConsensusManaPledgeID() identity.ID {
+	return f.consensusManaPledgeID
 }
 
 func isFaucetRequestPoWValid(fundingRequest *faucet.Payload, addr devnetvm.Address) bool {
@@ -0,0 +1,26 @@
+package firehose
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the firehose plugin.
+type ParametersDefinition struct {
+	// Directory defines the directory that the firehose files are written to.
+	Directory string `default:"firehose" usage:"the directory that the firehose files are written to"`
+	// MaxFileSize defines the size in bytes after which the current file is rotated.
+	MaxFileSize int64 `default:"104857600" usage:"the size in bytes after which the current file is rotated (0 disables size based rotation)"`
+	// RotationInterval defines the age after which the current file is rotated.
+	RotationInterval time.Duration `default:"1h" usage:"the age after which the current file is rotated (0 disables time based rotation)"`
+	// MaxFiles defines the number of files that are retained.
+	MaxFiles int `default:"0" usage:"the number of files that are retained (0 retains all files)"`
+}
+
+// Parameters contains the configuration parameters of the firehose plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "firehose")
+}
@@ -0,0 +1,71 @@
+package firehose
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/firehose"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// PluginName is the name of the firehose plugin.
+const PluginName = "Firehose"
+
+var (
+	// Plugin is the plugin instance of the firehose plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	sink *firehose.Sink
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
+}
+
+func configure(plugin *node.Plugin) {
+	sink = firehose.New(Parameters.Directory,
+		firehose.WithMaxFileSize(Parameters.MaxFileSize),
+		firehose.WithRotationInterval(Parameters.RotationInterval),
+		firehose.WithMaxFiles(Parameters.MaxFiles),
+	)
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+		writeRecords(firehose.TransactionBookedRecords(event, time.Now()))
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		writeRecords(firehose.TransactionAcceptedRecords(event, time.Now()))
+	}, event.WithWorkerPool(plugin.WorkerPool))
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+
+		if err := sink.Close(); err != nil {
+			Plugin.LogErrorf("failed to close firehose: %s", err)
+		}
+	}, Plugin.ShutdownOrder()); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+func writeRecords(records []*firehose.Record) {
+	if err := sink.Write(records...); err != nil {
+		Plugin.LogErrorf("failed to write %d records: %s", len(records), err)
+	}
+}
@@ -1,11 +1,15 @@
 package indexer
 
 import (
+	"context"
+
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
 	"github.com/iotaledger/hive.go/runtime/event"
 )
@@ -48,5 +52,37 @@ func provide(protocol *protocol.Protocol) (i *indexer.Indexer) {
 func configure(plugin *node.Plugin) {
 	deps.Protocol.Events.Engine.Ledger.MemPool.OutputCreated.Hook(deps.Indexer.OnOutputCreated, event.WithWorkerPool(plugin.WorkerPool))
 	deps.Protocol.Events.Engine.Ledger.MemPool.OutputSpent.Hook(deps.Indexer.OnOutputSpentRejected, event.WithWorkerPool(plugin.WorkerPool))
-	deps.Protocol.Events.Engine.Ledger.MemPool.OutputRejected.Hook(deps.Indexer.OnOutputSpentRejected, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.OutputRejected.Hook(deps.Indexer.OnOutputRejected, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionBooked.Hook(deps.Indexer.OnTransactionBooked, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(deps.Indexer.OnTransactionAccepted, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionRejected.Hook(deps.Indexer.OnTransactionRejected, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionOrphaned.Hook(deps.Indexer.OnTransactionOrphaned, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Indexer.Events.FallbackOutputExpired.Hook(func(output *devnetvm.ExtendedLockedOutput) {
+		plugin.LogDebugf("fallback deadline of output %s passed", output.ID())
+	})
+
+	// the outputs of the snapshot (or of a previous run) are not announced by the OutputCreated event
+	deps.Protocol.Engine().HookInitialized(func() {
+		initEngineState(plugin, deps.Protocol.Engine())
+	})
+	deps.Protocol.Events.MainEngineSwitched.Hook(func(e *engine.Engine) {
+		initEngineState(plugin, e)
+	})
+}
+
+// initEngineState initializes the color supplies and schedules the fallback expirations of the unspent outputs of the
+// given engine.
+func initEngineState(plugin *node.Plugin, e *engine.Engine) {
+	deps.Indexer.InitColorSupplies(e.Ledger.UnspentOutputs())
+	scheduleFallbackExpirations(plugin, e)
+}
+
+// scheduleFallbackExpirations schedules the fallback expirations of the unspent outputs of the given engine on the
+// worker pool of the plugin (that also processes the OutputCreated events).
+func scheduleFallbackExpirations(plugin *node.Plugin, e *engine.Engine) {
+	plugin.WorkerPool.Submit(func() {
+		if err := deps.Indexer.ScheduleFallbackExpirations(context.Background(), e.Ledger.UnspentOutputs()); err != nil {
+			plugin.LogErrorf("failed to schedule the fallback expirations: %s", err)
+		}
+	})
 }
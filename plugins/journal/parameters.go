@@ -0,0 +1,22 @@
+package journal
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the journal plugin.
+type ParametersDefinition struct {
+	// Directory defines the directory of the database that the journal is written to.
+	Directory string `default:"journal" usage:"the directory of the database that the journal is written to"`
+	// Retention defines the number of entries that are retained by the journal.
+	Retention uint64 `default:"1000000" usage:"the number of entries that are retained by the journal (0 retains all entries)"`
+	// MaxReplayEntries defines the maximum number of entries that are returned by a single webapi request.
+	MaxReplayEntries int `default:"1000" usage:"the maximum number of entries that are returned by a single webapi request"`
+}
+
+// Parameters contains the configuration parameters of the journal plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "journal")
+}
@@ -0,0 +1,189 @@
+package journal
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/journal"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// PluginName is the name of the journal plugin.
+const PluginName = "Journal"
+
+const (
+	// EntryTypeBlockConfirmed is the type of the entries of confirmed blocks.
+	EntryTypeBlockConfirmed = "blockConfirmed"
+
+	// EntryTypeTransactionAccepted is the type of the entries of accepted transactions.
+	EntryTypeTransactionAccepted = "transactionAccepted"
+
+	// EntryTypeConflictCreated is the type of the entries of created conflicts.
+	EntryTypeConflictCreated = "conflictCreated"
+
+	// EntryTypeConflictAccepted is the type of the entries of accepted conflicts.
+	EntryTypeConflictAccepted = "conflictAccepted"
+
+	// EntryTypeConflictRejected is the type of the entries of rejected conflicts.
+	EntryTypeConflictRejected = "conflictRejected"
+
+	// EntryTypeManaUpdated is the type of the entries of the consensus mana changes of a slot.
+	EntryTypeManaUpdated = "manaUpdated"
+)
+
+var (
+	// Plugin is the plugin instance of the journal plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	db              database.DB
+	manaUpdatedHook *event.Hook[func(*sybilprotection.WeightsBatch)]
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+	Server   *echo.Echo
+	Journal  *journal.Journal
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
+	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
+		if err := event.Container.Provide(func() *journal.Journal {
+			var err error
+			if db, err = database.NewDB(Parameters.Directory); err != nil {
+				Plugin.Panicf("failed to open journal database: %s", err)
+			}
+
+			return journal.New(db.NewStore(), journal.WithEventJournalOptions(
+				ledger.WithRetention(Parameters.Retention),
+				ledger.WithErrorHandler(func(err error) {
+					Plugin.LogErrorf("failed to write journal: %s", err)
+				}),
+			))
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	})
+}
+
+// configure hooks the journal to the events without a worker pool, so that the entries are appended (and numbered) in
+// the order in which the events are triggered. Appending only buffers the entries, so the hooks do not wait for the
+// storage.
+func configure(*node.Plugin) {
+	deps.Protocol.Events.Engine.Consensus.BlockGadget.BlockConfirmed.Hook(func(block *blockgadget.Block) {
+		appendEntry(EntryTypeBlockConfirmed, &blockEntry{BlockID: block.ID().Base58(), Slot: int64(block.ID().Index())})
+	})
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		appendEntry(EntryTypeTransactionAccepted, &transactionEntry{TransactionID: event.Metadata.ID().Base58(), InclusionSlot: int64(event.Metadata.InclusionSlot())})
+	})
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictCreated.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		appendEntry(EntryTypeConflictCreated, newConflictEntry(conflict))
+	})
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictAccepted.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		appendEntry(EntryTypeConflictAccepted, newConflictEntry(conflict))
+	})
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictRejected.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		appendEntry(EntryTypeConflictRejected, newConflictEntry(conflict))
+	})
+
+	// the weights are not part of the engine events, so we need to follow the engine switches manually
+	hookManaUpdated(deps.Protocol.Engine())
+	deps.Protocol.Events.MainEngineSwitched.Hook(hookManaUpdated)
+
+	configureWebAPI()
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+
+		deps.Journal.Close()
+
+		if err := db.Close(); err != nil {
+			Plugin.LogErrorf("failed to close journal database: %s", err)
+		}
+	}, Plugin.ShutdownOrder()); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+func hookManaUpdated(engine *engine.Engine) {
+	if manaUpdatedHook != nil {
+		manaUpdatedHook.Unhook()
+	}
+
+	manaUpdatedHook = engine.SybilProtection.Weights().Events.WeightsUpdated.Hook(func(batch *sybilprotection.WeightsBatch) {
+		entry := &manaEntry{Slot: int64(batch.TargetSlot()), TotalDiff: batch.TotalDiff(), Diffs: make(map[string]int64)}
+		batch.ForEach(func(id identity.ID, diff int64) {
+			entry.Diffs[id.EncodeBase58()] = diff
+		})
+
+		appendEntry(EntryTypeManaUpdated, entry)
+	})
+}
+
+func appendEntry(entryType string, payload any) {
+	if _, err := deps.Journal.Append(entryType, payload); err != nil {
+		Plugin.LogErrorf("failed to append %s entry: %s", entryType, err)
+	}
+}
+
+// region entry payloads ///////////////////////////////////////////////////////////////////////////////////////////////
+
+type blockEntry struct {
+	BlockID string `json:"blockID"`
+	Slot    int64  `json:"slot"`
+}
+
+type transactionEntry struct {
+	TransactionID string `json:"transactionID"`
+	InclusionSlot int64  `json:"inclusionSlot"`
+}
+
+type conflictEntry struct {
+	ConflictID        string   `json:"conflictID"`
+	ParentConflictIDs []string `json:"parentConflictIDs"`
+}
+
+func newConflictEntry(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) (entry *conflictEntry) {
+	entry = &conflictEntry{
+		ConflictID:        conflict.ID().Base58(),
+		ParentConflictIDs: make([]string, 0),
+	}
+
+	for it := conflict.Parents().Iterator(); it.HasNext(); {
+		entry.ParentConflictIDs = append(entry.ParentConflictIDs, it.Next().Base58())
+	}
+
+	return entry
+}
+
+type manaEntry struct {
+	Slot      int64            `json:"slot"`
+	TotalDiff int64            `json:"totalDiff"`
+	Diffs     map[string]int64 `json:"diffs"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,62 @@
+package journal
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/journal"
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+// RouteJournal defines the HTTP path for the journal endpoint.
+const RouteJournal = "journal"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteJournal, getEntriesHandler)
+}
+
+// getEntriesHandler returns the entries of the journal that start at the sequence number given by the "from" query
+// parameter (consumers continue with the sequence number that follows the last returned entry).
+func getEntriesHandler(c echo.Context) (err error) {
+	var fromSequence uint64
+	if fromParam := c.QueryParam("from"); fromParam != "" {
+		if fromSequence, err = strconv.ParseUint(fromParam, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.GetJournalEntriesResponse{Error: errors.Errorf("invalid from: %s", fromParam).Error()})
+		}
+	}
+
+	limit := Parameters.MaxReplayEntries
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.GetJournalEntriesResponse{Error: errors.Errorf("invalid limit: %s", limitParam).Error()})
+		}
+	}
+	if limit > Parameters.MaxReplayEntries {
+		limit = Parameters.MaxReplayEntries
+	}
+
+	response := jsonmodels.GetJournalEntriesResponse{
+		Entries:       make([]*journal.Entry, 0),
+		FirstSequence: deps.Journal.FirstSequence(),
+		LastSequence:  deps.Journal.LastSequence(),
+	}
+
+	if err = deps.Journal.Replay(fromSequence, func(entry *journal.Entry) bool {
+		response.Entries = append(response.Entries, entry)
+
+		return len(response.Entries) < limit
+	}); err != nil {
+		response.Error = err.Error()
+
+		if errors.Is(err, journal.ErrEntriesPruned) {
+			return c.JSON(http.StatusGone, response)
+		}
+
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -35,7 +35,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentManualpeering).Consumes(shutdown.ComponentP2P)
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		newManager := func(lPeer *peer.Local, p2pMgr *p2p.Manager) *manualpeering.Manager {
 			return manualpeering.NewManager(p2pMgr, lPeer, event.Plugin.WorkerPool, logger.NewLogger(PluginName))
@@ -52,7 +52,7 @@ func configure(_ *node.Plugin) {
 }
 
 func run(*node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, startManager, shutdown.PriorityManualpeering); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, startManager, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
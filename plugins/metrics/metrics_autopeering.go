@@ -18,6 +18,8 @@ const (
 	neighborConnectionLifetimeSec = "neighbor_connection_lifetime_seconds_total"
 	trafficInboundBytes           = "traffic_inbound_total_bytes"
 	trafficOutboundBytes          = "traffic_outbound_total_bytes"
+	rejectedPacketsCount          = "rejected_packets_total"
+	rejectedConnectionsCount      = "rejected_connections_total"
 )
 
 // AutopeeringMetrics is the collection of metrics for autopeering component.
@@ -77,4 +79,21 @@ var AutopeeringMetrics = collector.NewCollection(autopeeringNamespace,
 			return collector.SingleValue(deps.AutopeeringConnMetric.TXBytes())
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(rejectedPacketsCount,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of autopeering packets dropped by the network filter"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.AutopeeringConnMetric.RejectedPackets())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(rejectedConnectionsCount,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of inbound gossip connections rejected by the network filter"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			if deps.ConnectionGater == nil {
+				return collector.SingleValue(0)
+			}
+			return collector.SingleValue(deps.ConnectionGater.RejectedConnections())
+		}),
+	)),
 )
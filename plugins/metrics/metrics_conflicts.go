@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/app/collector"
@@ -15,6 +16,12 @@ const (
 	resolutionTime        = "resolution_time_seconds_total"
 	allConflictCounts     = "created_total"
 	resolvedConflictCount = "resolved_total"
+
+	openConflictSets          = "open_conflict_sets"
+	createdConflictsPerMinute = "created_per_minute"
+	resolvedConflictPerMinute = "resolved_per_minute"
+	setResolutionTime         = "set_resolution_time_seconds"
+	setResolutionTimeBucket   = "set_resolution_time_seconds_bucket"
 )
 
 var ConflictMetrics = collector.NewCollection(conflictNamespace,
@@ -48,4 +55,53 @@ var ConflictMetrics = collector.NewCollection(conflictNamespace,
 			}, event.WithWorkerPool(Plugin.WorkerPool))
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(openConflictSets,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflict sets that still have pending members"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Metrics.OpenConflictSets())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(createdConflictsPerMinute,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflicts created within the last minute"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Metrics.ConflictsCreatedPerMinute())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(resolvedConflictPerMinute,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflicts resolved (accepted, rejected or not conflicting) within the last minute"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Metrics.ConflictsResolvedPerMinute())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(setResolutionTime,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("type"),
+		collector.WithHelp("Sum and count of the times from the creation to the resolution of the conflict sets"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			resolutionTimes := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Metrics.ResolutionTimes()
+			return map[string]float64{
+				"sum":   resolutionTimes.Sum.Seconds(),
+				"count": float64(resolutionTimes.Count),
+			}
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(setResolutionTimeBucket,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("le"),
+		collector.WithHelp("Number of conflict sets that were resolved within the given number of seconds after their creation"),
+		collector.WithCollectFunc(func() map[string]float64 {
+			resolutionTimes := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Metrics.ResolutionTimes()
+
+			res := make(map[string]float64)
+			for i, cumulativeCount := range resolutionTimes.CumulativeCounts() {
+				res[strconv.FormatFloat(resolutionTimes.Buckets[i].Seconds(), 'f', -1, 64)] = float64(cumulativeCount)
+			}
+			res["+Inf"] = float64(resolutionTimes.Count)
+
+			return res
+		}),
+	)),
 )
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/collector"
+)
+
+const (
+	ledgerNamespace = "ledger"
+
+	bookedPerSecond       = "booked_transactions_per_second"
+	bookedTransactions    = "booked_transactions_total"
+	averageBookingLatency = "average_booking_latency_seconds"
+	pendingTransactions   = "pending_transactions"
+	ledgerConflicts       = "conflicts_total"
+	cacheHitRate          = "cache_hit_rate"
+	cacheSize             = "cache_size"
+	unspentOutputs        = "unspent_outputs"
+	dustOutputs           = "dust_outputs"
+	unspentBalances       = "unspent_balance"
+	unspentOutputsGrowth  = "unspent_outputs_daily_growth"
+)
+
+var LedgerMetrics = collector.NewCollection(ledgerNamespace,
+	collector.WithMetric(collector.NewMetric(bookedPerSecond,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of transactions booked per second."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.Metrics().BookedPerSecond())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(bookedTransactions,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of transactions booked since the start of the node."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.Metrics().BookedCount())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(averageBookingLatency,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Average time between storing and booking a transaction (in seconds)."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.Metrics().AverageBookingLatency().Seconds())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(pendingTransactions,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of stored transactions that were not booked, yet."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.Metrics().PendingTransactions())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(ledgerConflicts,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflicts created since the start of the node."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.Metrics().ConflictCount())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(cacheHitRate,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("storage"),
+		collector.WithHelp("Ratio of lookups answered by the cache for each storage of the mempool."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return deps.Protocol.Engine().Ledger.Metrics().CacheHitRates()
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(cacheSize,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("storage"),
+		collector.WithHelp("Number of objects held in the cache of each storage of the mempool."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return deps.Protocol.Engine().Ledger.Metrics().CacheSizes()
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(unspentOutputs,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of unspent outputs in the ledger state."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.UnspentOutputs().Statistics().Count())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(dustOutputs,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of unspent outputs whose IOTA balance is below the dust threshold."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.UnspentOutputs().Statistics().DustCount())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(unspentBalances,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("color"),
+		collector.WithHelp("Total balance of the unspent outputs for each color."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			balances := make(map[string]float64)
+			for color, balance := range deps.Protocol.Engine().Ledger.UnspentOutputs().Statistics().BalancesByColor() {
+				balances[color.Base58()] = float64(balance)
+			}
+
+			return balances
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(unspentOutputsGrowth,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of unspent outputs that were added to the ledger state per day."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.Engine().Ledger.UnspentOutputs().Statistics().DailyGrowthRate())
+		}),
+	)),
+)
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/goshimmer/packages/app/rebroadcaster"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+const (
+	rebroadcasterNamespace = "rebroadcaster"
+
+	trackedBlocks     = "tracked_blocks"
+	rebroadcastBlocks = "rebroadcast_blocks_total"
+	recoveredBlocks   = "recovered_blocks_total"
+	lostBlocks        = "lost_blocks_total"
+)
+
+var RebroadcasterMetrics = collector.NewCollection(rebroadcasterNamespace,
+	collector.WithMetric(collector.NewMetric(trackedBlocks,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of own blocks that were not referenced by other nodes, yet."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Rebroadcaster.TrackedCount())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(rebroadcastBlocks,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of times an own block was rebroadcast because it was not referenced in time."),
+		collector.WithInitFunc(func() {
+			deps.Rebroadcaster.Events.BlockRebroadcast.Hook(func(_ *rebroadcaster.BlockRebroadcastEvent) {
+				deps.Collector.Increment(rebroadcasterNamespace, rebroadcastBlocks)
+			}, event.WithWorkerPool(Plugin.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(recoveredBlocks,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of rebroadcast blocks that were referenced by other nodes afterwards."),
+		collector.WithInitFunc(func() {
+			deps.Rebroadcaster.Events.BlockRecovered.Hook(func(_ *models.Block) {
+				deps.Collector.Increment(rebroadcasterNamespace, recoveredBlocks)
+			}, event.WithWorkerPool(Plugin.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(lostBlocks,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of own blocks that were not referenced after the maximum number of rebroadcast attempts."),
+		collector.WithInitFunc(func() {
+			deps.Rebroadcaster.Events.BlockLost.Hook(func(_ *models.Block) {
+				deps.Collector.Increment(rebroadcasterNamespace, lostBlocks)
+			}, event.WithWorkerPool(Plugin.WorkerPool))
+		}),
+	)),
+)
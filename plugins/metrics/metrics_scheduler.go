@@ -12,6 +12,9 @@ const (
 	bufferReadyBlockCount = "buffer_ready_block_total"
 	bufferTotalSize       = "buffer_size_bytes_total"
 	bufferMaxSize         = "buffer_max_size"
+	futureBufferSize      = "future_buffer_block_total"
+	futureBufferMaxSize   = "future_buffer_max_size"
+	futureBufferMaxWait   = "future_buffer_max_wait_seconds"
 	deficit               = "deficit"
 	rate                  = "rate"
 )
@@ -64,6 +67,27 @@ var SchedulerMetrics = collector.NewCollection(schedulerNamespace,
 			return collector.SingleValue(deps.Protocol.CongestionControl.Scheduler().BufferSize())
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(futureBufferSize,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of blocks from the future that wait for their issuing time to be reached."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.CongestionControl.Scheduler().FutureBufferSize())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(futureBufferMaxSize,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Maximum number of blocks from the future that can wait for their issuing time to be reached."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.CongestionControl.Scheduler().MaxFutureBufferSize())
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(futureBufferMaxWait,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Longest time (in seconds) that a block from the future waited for its issuing time to be reached."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			return collector.SingleValue(deps.Protocol.CongestionControl.Scheduler().FutureBufferMaxWait().Seconds())
+		}),
+	)),
 	collector.WithMetric(collector.NewMetric(deficit,
 		collector.WithType(collector.Gauge),
 		collector.WithHelp("Current deficit of the scheduler."),
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
+	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+const (
+	webapiNamespace = "webapi"
+
+	trackedArtifacts = "artifacts"
+	expiredArtifacts = "expired_artifacts_total"
+)
+
+// WebAPIMetrics is the collection of metrics for the node-local artifacts that were created through the web API.
+var WebAPIMetrics = collector.NewCollection(webapiNamespace,
+	collector.WithMetric(collector.NewMetric(trackedArtifacts,
+		collector.WithType(collector.GaugeVec),
+		collector.WithLabels("kind"),
+		collector.WithHelp("Number of tracked artifacts that were created through the web API for each kind."),
+		collector.WithCollectFunc(func() map[string]float64 {
+			trackedCount := make(map[string]float64)
+			for kind, count := range deps.Artifacts.TrackedCount() {
+				trackedCount[string(kind)] = float64(count)
+			}
+
+			return trackedCount
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(expiredArtifacts,
+		collector.WithType(collector.CounterVec),
+		collector.WithLabels("kind"),
+		collector.WithHelp("Number of artifacts that were discarded because their time to live elapsed for each kind."),
+		collector.WithInitFunc(func() {
+			deps.Artifacts.Events.ArtifactExpired.Hook(func(artifact *artifacts.Artifact) {
+				deps.Collector.Increment(webapiNamespace, expiredArtifacts, string(artifact.Kind))
+			}, event.WithWorkerPool(Plugin.WorkerPool))
+		}),
+	)),
+)
@@ -20,14 +20,17 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
 	"github.com/iotaledger/goshimmer/packages/app/collector"
+	"github.com/iotaledger/goshimmer/packages/app/rebroadcaster"
 	"github.com/iotaledger/goshimmer/packages/app/retainer"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	p2pplugin "github.com/iotaledger/goshimmer/plugins/p2p"
 	"github.com/iotaledger/hive.go/app/daemon"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/selection"
@@ -52,16 +55,19 @@ type dependencies struct {
 	Local                 *peer.Local
 	Protocol              *protocol.Protocol
 	BlockIssuer           *blockissuer.BlockIssuer
-	P2Pmgr                *p2p.Manager        `optional:"true"`
-	Selection             *selection.Protocol `optional:"true"`
-	Retainer              *retainer.Retainer  `optional:"true"`
+	P2Pmgr                *p2p.Manager                 `optional:"true"`
+	Selection             *selection.Protocol          `optional:"true"`
+	Retainer              *retainer.Retainer           `optional:"true"`
+	Rebroadcaster         *rebroadcaster.Rebroadcaster `optional:"true"`
 	AutopeeringConnMetric *autopeering.UDPConnTraffic
+	ConnectionGater       *p2pplugin.ConnectionGater `optional:"true"`
+	Artifacts             *artifacts.Registry        `optional:"true"`
 
 	Collector *collector.Collector
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		if err := event.Container.Provide(createCollector); err != nil {
@@ -130,7 +136,7 @@ func run(*node.Plugin) {
 			cancel()
 		}
 		log.Info("Stopping Prometheus exporter ... done")
-	}, shutdown.PriorityPrometheus); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panic(err)
 	}
 }
@@ -142,6 +148,7 @@ func createCollector() *collector.Collector {
 func registerMetrics() {
 	deps.Collector.RegisterCollection(TangleMetrics)
 	deps.Collector.RegisterCollection(ConflictMetrics)
+	deps.Collector.RegisterCollection(LedgerMetrics)
 	deps.Collector.RegisterCollection(InfoMetrics)
 	deps.Collector.RegisterCollection(DBMetrics)
 	deps.Collector.RegisterCollection(ManaMetrics)
@@ -151,5 +158,11 @@ func registerMetrics() {
 	deps.Collector.RegisterCollection(CommitmentsMetrics)
 	deps.Collector.RegisterCollection(SlotMetrics)
 	deps.Collector.RegisterCollection(WorkerPoolMetrics)
+	if deps.Rebroadcaster != nil {
+		deps.Collector.RegisterCollection(RebroadcasterMetrics)
+	}
+	if deps.Artifacts != nil {
+		deps.Collector.RegisterCollection(WebAPIMetrics)
+	}
 
 }
@@ -0,0 +1,71 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	libp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/network/ipfilter"
+)
+
+// ConnectionGater is a libp2p ConnectionGater that rejects inbound p2p connections from IPs that are not allowed by
+// the network filter of the node.
+type ConnectionGater struct {
+	filter   *ipfilter.Filter
+	rejected atomic.Uint64
+}
+
+// newConnectionGater creates a new ConnectionGater that enforces the given filter.
+func newConnectionGater(filter *ipfilter.Filter) *ConnectionGater {
+	return &ConnectionGater{
+		filter: filter,
+	}
+}
+
+// RejectedConnections returns the amount of inbound connections that were rejected so far.
+func (c *ConnectionGater) RejectedConnections() uint64 {
+	return c.rejected.Load()
+}
+
+// InterceptAccept rejects inbound connections from IPs that are not allowed by the filter.
+func (c *ConnectionGater) InterceptAccept(addrs network.ConnMultiaddrs) (allow bool) {
+	ip, err := manet.ToIP(addrs.RemoteMultiaddr())
+	if err != nil {
+		// connections that are not IP based (e.g. relayed ones) can not be filtered
+		return true
+	}
+
+	if allow = c.filter.Allowed(ip); !allow {
+		c.rejected.Inc()
+		Plugin.LogDebugf("Rejected inbound connection from %s", ip)
+	}
+
+	return allow
+}
+
+// InterceptPeerDial allows all outbound dials.
+func (c *ConnectionGater) InterceptPeerDial(libp2ppeer.ID) (allow bool) {
+	return true
+}
+
+// InterceptAddrDial allows all outbound dials.
+func (c *ConnectionGater) InterceptAddrDial(libp2ppeer.ID, multiaddr.Multiaddr) (allow bool) {
+	return true
+}
+
+// InterceptSecured allows all connections that passed InterceptAccept.
+func (c *ConnectionGater) InterceptSecured(network.Direction, libp2ppeer.ID, network.ConnMultiaddrs) (allow bool) {
+	return true
+}
+
+// InterceptUpgraded allows all connections that passed InterceptAccept.
+func (c *ConnectionGater) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
+	return true, 0
+}
+
+// code contract (make sure the type implements all required methods).
+var _ connmgr.ConnectionGater = &ConnectionGater{}
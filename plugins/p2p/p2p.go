@@ -6,8 +6,10 @@ import (
 	"net"
 
 	"github.com/libp2p/go-libp2p"
+	"github.com/multiformats/go-multiaddr"
 
 	"github.com/iotaledger/goshimmer/packages/core/libp2putil"
+	"github.com/iotaledger/goshimmer/packages/core/libp2putil/socks5"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
@@ -15,7 +17,7 @@ import (
 
 var localAddr *net.TCPAddr
 
-func createManager(lPeer *peer.Local) *p2p.Manager {
+func createManager(lPeer *peer.Local, gater *ConnectionGater) *p2p.Manager {
 	var err error
 
 	// resolve the bind address
@@ -33,11 +35,11 @@ func createManager(lPeer *peer.Local) *p2p.Manager {
 	if err != nil {
 		Plugin.LogFatalfAndExitf("Could not build libp2p identity from local peer: %s", err)
 	}
-	libp2pHost, err := libp2p.New(
+	libp2pHost, err := libp2p.New(append(transportOptions(),
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/%s/tcp/%d", localAddr.IP, localAddr.Port)),
+		libp2p.ConnectionGater(gater),
 		libp2pIdentity,
-		libp2p.NATPortMap(),
-	)
+	)...)
 	if err != nil {
 		Plugin.LogFatalfAndExitf("Couldn't create libp2p host: %s", err)
 	}
@@ -45,6 +47,32 @@ func createManager(lPeer *peer.Local) *p2p.Manager {
 	return p2p.NewManager(libp2pHost, lPeer, Plugin.Logger())
 }
 
+// transportOptions returns the libp2p options that define how the node connects to and is reached by other peers.
+func transportOptions() (opts []libp2p.Option) {
+	if len(Parameters.ExternalAddresses) != 0 {
+		externalAddresses := make([]multiaddr.Multiaddr, 0, len(Parameters.ExternalAddresses))
+		for _, externalAddress := range Parameters.ExternalAddresses {
+			addr, err := multiaddr.NewMultiaddr(externalAddress)
+			if err != nil {
+				Plugin.LogFatalfAndExitf("external address '%s' is invalid: %s", externalAddress, err)
+			}
+			externalAddresses = append(externalAddresses, addr)
+		}
+
+		// only advertise the external addresses, so the bind address is not revealed to other peers
+		opts = append(opts, libp2p.AddrsFactory(func([]multiaddr.Multiaddr) []multiaddr.Multiaddr {
+			return externalAddresses
+		}))
+	}
+
+	if Parameters.Proxy.Address == "" {
+		return append(opts, libp2p.NATPortMap())
+	}
+
+	// the NAT port mapping is disabled as it would expose the public IP of the node to the gateway
+	return append(opts, libp2p.Transport(socks5.NewTransport(Parameters.Proxy.Address, Parameters.Proxy.Username, Parameters.Proxy.Password)))
+}
+
 func start(ctx context.Context) {
 	defer Plugin.LogInfo("Stopping " + PluginName + " ... done")
 	defer deps.P2PMgr.Stop()
@@ -54,7 +82,11 @@ func start(ctx context.Context) {
 		}
 	}()
 
-	Plugin.LogInfof("%s started: bind-address=%s", PluginName, localAddr.String())
+	if Parameters.Proxy.Address != "" {
+		Plugin.LogInfof("%s started: bind-address=%s, proxy=%s, external-addresses=%s", PluginName, localAddr.String(), Parameters.Proxy.Address, Parameters.ExternalAddresses)
+	} else {
+		Plugin.LogInfof("%s started: bind-address=%s", PluginName, localAddr.String())
+	}
 
 	<-ctx.Done()
 	Plugin.LogInfo("Stopping " + PluginName + " ...")
@@ -6,6 +6,17 @@ import "github.com/iotaledger/goshimmer/plugins/config"
 type ParametersDefinition struct {
 	// BindAddress defines on which address the p2p service should listen.
 	BindAddress string `default:"0.0.0.0:14666" usage:"the bind address for p2p connections"`
+	// ExternalAddresses defines the multiaddresses that are advertised to other peers instead of the bind address.
+	ExternalAddresses []string `usage:"the multiaddresses that are advertised to other peers instead of the bind address (e.g. /onion3/<address>:14666)"`
+	// Proxy defines the SOCKS5 proxy that outgoing p2p connections are routed through.
+	Proxy struct {
+		// Address defines the address of the SOCKS5 proxy (an empty address disables the proxy).
+		Address string `default:"" usage:"the address of the SOCKS5 proxy that outgoing p2p connections are routed through (e.g. 127.0.0.1:9050 for Tor)"`
+		// Username defines the username that is used to authenticate at the SOCKS5 proxy.
+		Username string `default:"" usage:"the username that is used to authenticate at the SOCKS5 proxy"`
+		// Password defines the password that is used to authenticate at the SOCKS5 proxy.
+		Password string `default:"" usage:"the password that is used to authenticate at the SOCKS5 proxy"`
+	}
 }
 
 // Parameters contains the configuration parameters of the p2p plugin.
@@ -29,9 +29,13 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentP2P).Consumes(shutdown.ComponentPeerDatabase, shutdown.ComponentProtocol, shutdown.ComponentWarpsync)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
+		if err := event.Container.Provide(newConnectionGater); err != nil {
+			Plugin.Panic(err)
+		}
+
 		if err := event.Container.Provide(createManager); err != nil {
 			Plugin.Panic(err)
 		}
@@ -52,7 +56,7 @@ func configure(plugin *node.Plugin) {
 }
 
 func run(plugin *node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, start, shutdown.PriorityP2P); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, start, Plugin.ShutdownOrder()); err != nil {
 		plugin.Logger().Panicf("Failed to start as daemon: %s", err)
 	}
 }
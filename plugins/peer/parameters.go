@@ -12,6 +12,10 @@ type ParametersDefinition struct {
 	ExternalAddress string `default:"auto" usage:"external IP address under which the node is reachable; or 'auto' to determine it automatically"`
 	// PeerDBDirectory defines the path to the peer database.
 	PeerDBDirectory string `default:"peerdb" usage:"path to the peer database directory"`
+	// AllowedNetworks defines the IPs and CIDR networks that are allowed to connect to the node.
+	AllowedNetworks []string `usage:"IPs and CIDR networks that are allowed to connect via gossip and autopeering (all if empty); reloaded on SIGHUP"`
+	// DeniedNetworks defines the IPs and CIDR networks that are not allowed to connect to the node.
+	DeniedNetworks []string `usage:"IPs and CIDR networks that are denied to connect via gossip and autopeering; reloaded on SIGHUP"`
 }
 
 // Parameters contains the configuration parameters of the local peer's network.
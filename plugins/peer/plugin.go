@@ -15,8 +15,11 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/core/database"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/network/ipfilter"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/protocol"
+	"github.com/iotaledger/hive.go/app/configuration"
 	"github.com/iotaledger/hive.go/app/daemon"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
@@ -46,12 +49,16 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, run).Provides(shutdown.ComponentPeerDatabase)
 
 	Plugin.Events.Init.Hook(func(e *node.InitEvent) {
 		if err := e.Container.Provide(configureLocalPeer); err != nil {
 			Plugin.Panic(err)
 		}
+
+		if err := e.Container.Provide(createIPFilter); err != nil {
+			Plugin.Panic(err)
+		}
 	})
 }
 
@@ -64,7 +71,7 @@ func run(_ *node.Plugin) {
 			return
 		}
 		Plugin.Logger().Infof("saved identity %s", prvKey.Public().String())
-	}, shutdown.PriorityPeerDatabase); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Logger().Fatalf("Failed to start as daemon: %s", err)
 	}
 }
@@ -126,6 +133,28 @@ func configureLocalPeer() peerOut {
 	}
 }
 
+// createIPFilter creates the filter that restricts which IPs can connect via gossip and autopeering. The filter picks
+// up changes of the allowed and denied networks whenever the config is reloaded.
+func createIPFilter() *ipfilter.Filter {
+	filter, err := ipfilter.New(Parameters.AllowedNetworks, Parameters.DeniedNetworks)
+	if err != nil {
+		Plugin.LogFatalfAndExitf("invalid network filter: %s", err)
+	}
+
+	config.Events.Reloaded.Hook(func(reloadedConfig *configuration.Configuration) {
+		allowedNetworks := reloadedConfig.Strings(config.ParameterPath(&Parameters.AllowedNetworks))
+		deniedNetworks := reloadedConfig.Strings(config.ParameterPath(&Parameters.DeniedNetworks))
+
+		if updateErr := filter.Update(allowedNetworks, deniedNetworks); updateErr != nil {
+			Plugin.LogErrorf("Failed to update network filter, keeping the previous one: %s", updateErr)
+			return
+		}
+		Plugin.LogInfof("Updated network filter: allowed=%s, denied=%s", allowedNetworks, deniedNetworks)
+	})
+
+	return filter
+}
+
 // checks whether the seed from the cfg corresponds to the one in the peer database.
 func checkCfgSeedAgainstDB(cfgSeed []byte, peerDB *peer.DB) error {
 	prvKeyDB, err := peerDB.LocalPrivateKey()
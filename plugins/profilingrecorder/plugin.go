@@ -28,7 +28,7 @@ var (
 )
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, nil, node.Disabled, run)
+	Plugin = node.NewPlugin(PluginName, nil, node.Disabled, run).ShutdownPriority(shutdown.PriorityProfiling)
 }
 
 func run(*node.Plugin) {
@@ -72,7 +72,7 @@ func run(*node.Plugin) {
 
 		ticker.Shutdown()
 		ticker.WaitForShutdown()
-	}, shutdown.PriorityProfiling); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		panic(err)
 	}
 }
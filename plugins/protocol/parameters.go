@@ -16,23 +16,71 @@ type ParametersDefinition struct {
 	ValidatorActivityWindow time.Duration `default:"30s" usage:"define period of inactivity after which validator is removed from the set of active validators"`
 	// BootstrapWindow defines the time window in which the node considers itself as synced according to TangleTime.
 	BootstrapWindow time.Duration `default:"20s" usage:"the time window in which the node considers itself as bootstrapped according to AcceptanceTime"`
+	// MaxConflictDepth defines the maximum depth of nested conflicts (deeper conflicts are rejected, 0 disables the limit).
+	MaxConflictDepth int `default:"0" usage:"the maximum depth of nested conflicts (deeper conflicts are rejected, 0 disables the limit)"`
+	// ConflictResolutionTimeout defines the number of slots after the slot of a conflict at whose commitment a decision is forced on it (0 disables the timeout).
+	ConflictResolutionTimeout int64 `default:"0" usage:"the number of slots after the slot of a conflict at whose commitment a decision is forced on it (0 disables the timeout)"`
+	// ConflictResolutionTimeoutPolicy defines the decision that is forced on conflicts whose resolution timed out.
+	ConflictResolutionTimeoutPolicy string `default:"heaviest" usage:"the decision that is forced on conflicts whose resolution timed out (heaviest or reject-all)"`
+	// CompactResolvedConflicts defines whether resolved conflicts are removed from the ConflictDAG (accepted conflicts are merged into the master branch).
+	CompactResolvedConflicts bool `default:"false" usage:"whether resolved conflicts are removed from the ConflictDAG and accepted conflicts are merged into the master branch"`
+	// BlockGadget defines the name of the block gadget implementation that is used to accept and confirm blocks.
+	BlockGadget string `default:"threshold" usage:"the name of the block gadget implementation that is used to accept and confirm blocks (threshold or slot)"`
+	// ConfirmationDowngrade defines whether the confirmation of non-final blocks is reverted when their approval weight drops.
+	ConfirmationDowngrade bool `default:"false" usage:"whether the confirmation of non-final blocks is reverted when their approval weight drops below the threshold"`
 	// Snapshot contains snapshots related configuration parameters.
 	Snapshot struct {
-		// Path is the path to the snapshot file.
-		Path string `default:"./snapshot.bin" usage:"the path of the snapshot file"`
+		// Path is the path to the snapshot file (or an s3:// or gs:// URL of a snapshot in an object storage).
+		Path string `default:"./snapshot.bin" usage:"the path of the snapshot file (or an s3:// or gs:// URL)"`
 		// Depth defines how many slot diffs are stored in the snapshot, starting from the full ledgerstate.
 		Depth int `default:"5" usage:"defines how many slot diffs are stored in the snapshot, starting from the full ledgerstate"`
+		// VerificationWorkers defines how many parallel workers verify an imported snapshot.
+		VerificationWorkers int `default:"4" usage:"the number of parallel workers that verify an imported snapshot (0 disables the verification)"`
+		// S3 contains the configuration of the S3-compatible object storage that is used for s3:// URLs.
+		S3 struct {
+			// Endpoint defines the endpoint of the object storage (empty uses AWS S3).
+			Endpoint string `default:"" usage:"the endpoint of the S3-compatible object storage (empty uses AWS S3)"`
+			// Region defines the region of the object storage.
+			Region string `default:"us-east-1" usage:"the region of the S3-compatible object storage"`
+			// AccessKeyID defines the access key of the object storage (empty uses the default credential chain).
+			AccessKeyID string `default:"" usage:"the access key of the S3-compatible object storage (empty uses the default credential chain)"`
+			// SecretAccessKey defines the secret key of the object storage.
+			SecretAccessKey string `default:"" usage:"the secret key of the S3-compatible object storage"`
+			// ForcePathStyle defines whether buckets are addressed by their path instead of a subdomain.
+			ForcePathStyle bool `default:"false" usage:"whether buckets are addressed by their path instead of a subdomain"`
+		}
+		// GCS contains the configuration of Google Cloud Storage that is used for gs:// URLs.
+		GCS struct {
+			// AccessKeyID defines the HMAC access key (empty uses the default credential chain).
+			AccessKeyID string `default:"" usage:"the HMAC access key of Google Cloud Storage (empty uses the default credential chain)"`
+			// SecretAccessKey defines the HMAC secret.
+			SecretAccessKey string `default:"" usage:"the HMAC secret of Google Cloud Storage"`
+		}
+		// PartSize defines the size of the parts of multipart uploads to an object storage.
+		PartSize int64 `default:"16777216" usage:"the size (in bytes) of the parts of multipart uploads to an object storage"`
 	}
 	// ForkDetectionMinimumDepth defines the minimum depth a fork has to have to be detected.
 	ForkDetectionMinimumDepth int64 `default:"3" usage:"the minimum depth a fork has to have to be detected"`
 	// MaxAllowedClockDrift defines the maximum drift our wall clock can have to future blocks being received from the network.
 	MaxAllowedClockDrift time.Duration `default:"5s" usage:"the maximum drift our wall clock can have to future blocks being received from the network"`
+	// WorkPolicy defines how the work of blocks is accounted by the PoW and the scheduler.
+	WorkPolicy string `default:"count" usage:"how the work of blocks is accounted by the PoW and the scheduler. Possible options are count or size."`
+	// ExecutionTraces defines the number of recently processed transactions whose execution traces are retained for debugging.
+	ExecutionTraces int `default:"0" usage:"the number of recently processed transactions whose execution traces are retained for debugging (0 disables the tracing)"`
+	// MaxSnapshotRecords defines the maximum number of changes that a consistent view of the ledger storage records.
+	MaxSnapshotRecords int `default:"1000000" usage:"the maximum number of changes that a consistent view of the ledger storage records before it fails (0 means unlimited)"`
+	// PoWDifficulty defines the PoW difficulty (in leading zero bits) of blocks with a single unit of work.
+	PoWDifficulty int `default:"0" usage:"the PoW difficulty of blocks with a single unit of work (0 disables the PoW)"`
+	// Upgrades defines the path of the JSON file that contains the scheduled upgrades of the protocol parameters.
+	Upgrades string `default:"" usage:"the path of the JSON file that contains the scheduled upgrades of the protocol parameters (empty disables the upgrades)"`
 }
 
 // SchedulerParametersDefinition contains the definition of the parameters used by the Scheduler.
 type SchedulerParametersDefinition struct {
 	// MaxBufferSize defines the maximum buffer size (in number of blocks).
 	MaxBufferSize int `default:"10000" usage:"maximum buffer size (in number of blocks)"` // 300 blocks
+	// MaxFutureBufferSize defines the maximum number of blocks that wait for their issuing time to be reached.
+	MaxFutureBufferSize int `default:"1000" usage:"maximum number of blocks from the future that wait for their issuing time to be reached"`
 	// Rate defines the frequency to schedule a block.
 	Rate time.Duration `default:"5ms" usage:"block scheduling interval [time duration string]"` // 200 blocks per second
 	// ConfirmedBlockThreshold time threshold after which confirmed blocks are not scheduled [time duration string]
@@ -61,7 +109,20 @@ type DatabaseParametersDefinition struct {
 
 	// ForceCacheTime is a new global cache time in seconds for object storage.
 	ForceCacheTime time.Duration `default:"-1s" usage:"interval of time for which objects should remain in memory. Zero time means no caching, negative value means use defaults"`
-	Settings       struct {
+
+	// LedgerCacheSize defines the maximum number of released objects that stay cached in the storages of the ledger.
+	LedgerCacheSize struct {
+		Transaction         int `default:"0" usage:"maximum number of cached transactions (0 means unlimited)"`
+		TransactionMetadata int `default:"0" usage:"maximum number of cached transaction metadata (0 means unlimited)"`
+		Output              int `default:"0" usage:"maximum number of cached outputs (0 means unlimited)"`
+		OutputMetadata      int `default:"0" usage:"maximum number of cached output metadata (0 means unlimited)"`
+		Consumer            int `default:"0" usage:"maximum number of cached consumers (0 means unlimited)"`
+	}
+
+	// LedgerEventJournalRetention defines the number of ledger events that are kept for subscribers that replay missed events.
+	LedgerEventJournalRetention uint64 `default:"1000000" usage:"number of ledger events that are retained in the event journal (0 means unlimited)"`
+
+	Settings struct {
 		// Path is the path to the settings file.
 		FileName string `default:"settings.bin" usage:"the file name of the settings file, relative to the database directory"`
 	}
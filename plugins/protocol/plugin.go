@@ -2,10 +2,12 @@ package protocol
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/core/remotestorage"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/p2p"
@@ -15,14 +17,23 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol"
 	"github.com/iotaledger/goshimmer/packages/protocol/congestioncontrol/icca/scheduler"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/blockgadget/tresholdblockgadget"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/consensus/tangleconsensus"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/filter/blockfilter"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxoledger"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization/slotnotarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/sybilprotection/dpos"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tsc"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 	"github.com/iotaledger/goshimmer/packages/protocol/tipmanager"
+	"github.com/iotaledger/goshimmer/packages/protocol/upgrades"
 	"github.com/iotaledger/hive.go/app/daemon"
 	"github.com/iotaledger/hive.go/core/slot"
 	"github.com/iotaledger/hive.go/runtime/event"
@@ -46,17 +57,61 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configureLogging, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configureLogging, run).Provides(shutdown.ComponentProtocol)
+	Plugin.AddPayloadTypes(devnetvm.TransactionType.String(), payload.GenericDataPayloadType.String())
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
+		if err := event.Container.Provide(provideUpgrades); err != nil {
+			Plugin.Panic(err)
+		}
+
 		if err := event.Container.Provide(provide); err != nil {
 			Plugin.Panic(err)
 		}
 	})
 }
 
-func provide(n *p2p.Manager) (p *protocol.Protocol) {
+// provideUpgrades loads the Schedule of the parameter upgrades (it returns nil if no upgrades are configured).
+func provideUpgrades() (schedule *upgrades.Schedule) {
+	if Parameters.Upgrades == "" {
+		return nil
+	}
+
+	schedule, err := upgrades.ScheduleFromFile(Parameters.Upgrades)
+	if err != nil {
+		Plugin.LogFatalfAndExitf("invalid upgrade schedule: %s", err)
+	}
+
+	Plugin.LogInfof("Loaded upgrade schedule %s", schedule.ID())
+	for _, upgrade := range schedule.Upgrades() {
+		Plugin.LogInfof("Parameter upgrade scheduled for slot %d", upgrade.Slot)
+	}
+
+	return schedule
+}
+
+func provide(n *p2p.Manager, schedule *upgrades.Schedule) (p *protocol.Protocol) {
 	cacheTimeProvider := database.NewCacheTimeProvider(DatabaseParameters.ForceCacheTime)
 
+	workPolicy, err := models.WorkPolicyFromString(Parameters.WorkPolicy)
+	if err != nil {
+		Plugin.LogFatalfAndExitf("invalid work policy: %s", err)
+	}
+
+	resolutionTimeoutPolicy, err := conflictdag.ResolutionTimeoutPolicyFromString(Parameters.ConflictResolutionTimeoutPolicy)
+	if err != nil {
+		Plugin.LogFatalfAndExitf("invalid conflict resolution timeout policy: %s", err)
+	}
+
+	tangleconsensus.RegisterBlockGadgetProvider(tangleconsensus.DefaultBlockGadget, tresholdblockgadget.NewProvider(
+		tresholdblockgadget.WithConfirmationDowngrade(Parameters.ConfirmationDowngrade),
+		tresholdblockgadget.WithUpgrades(schedule),
+	))
+
+	blockGadgetProvider, err := tangleconsensus.BlockGadgetProvider(Parameters.BlockGadget)
+	if err != nil {
+		Plugin.LogFatalfAndExitf("invalid block gadget: %s", err)
+	}
+
 	var dbProvider database.DBProvider
 	if DatabaseParameters.InMemory {
 		dbProvider = database.NewMemDB
@@ -70,10 +125,40 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 			utxoledger.NewProvider(
 				utxoledger.WithMemPoolProvider(
 					realitiesledger.NewProvider(
-						realitiesledger.WithVM(new(devnetvm.VM)),
+						realitiesledger.WithVMProvider(func(e *engine.Engine) vm.VM {
+							return vm.NewRegistry(devnetvm.NewVM(
+								devnetvm.WithDustPolicyProvider(func(timestamp time.Time) devnetvm.DustPolicy {
+									return schedule.DustPolicy(e.SlotTimeProvider().IndexFromTime(timestamp), devnetvm.DefaultDustPolicy)
+								}),
+								devnetvm.WithAliasFundsRecoveryProvider(func(timestamp time.Time) bool {
+									return schedule.AliasFundsRecovery(e.SlotTimeProvider().IndexFromTime(timestamp), false)
+								}),
+							))
+						}),
+						realitiesledger.WithUpgrades(schedule),
+						realitiesledger.WithExecutionTraces(Parameters.ExecutionTraces),
+						realitiesledger.WithMaxSnapshotRecords(Parameters.MaxSnapshotRecords),
 						realitiesledger.WithCacheTimeProvider(cacheTimeProvider),
+						realitiesledger.WithTransactionCacheSize(DatabaseParameters.LedgerCacheSize.Transaction),
+						realitiesledger.WithTransactionMetadataCacheSize(DatabaseParameters.LedgerCacheSize.TransactionMetadata),
+						realitiesledger.WithOutputCacheSize(DatabaseParameters.LedgerCacheSize.Output),
+						realitiesledger.WithOutputMetadataCacheSize(DatabaseParameters.LedgerCacheSize.OutputMetadata),
+						realitiesledger.WithConsumerCacheSize(DatabaseParameters.LedgerCacheSize.Consumer),
+						realitiesledger.WithConflictWeightProvider(func(e *engine.Engine) func(conflictID utxo.TransactionID) int64 {
+							return func(conflictID utxo.TransactionID) int64 {
+								return e.Tangle.Booker().VirtualVoting().ConflictVotersTotalWeight(conflictID)
+							}
+						}),
+						realitiesledger.WithConflictDAGOptions(
+							conflictdag.MaxConflictDepth[utxo.TransactionID, utxo.OutputID](Parameters.MaxConflictDepth),
+							conflictdag.ResolutionTimeout[utxo.TransactionID, utxo.OutputID](slot.Index(Parameters.ConflictResolutionTimeout), resolutionTimeoutPolicy),
+							conflictdag.CompactResolvedConflicts[utxo.TransactionID, utxo.OutputID](Parameters.CompactResolvedConflicts),
+						),
 					),
 				),
+				utxoledger.WithEventJournalOptions(
+					ledger.WithRetention(DatabaseParameters.LedgerEventJournalRetention),
+				),
 			),
 		),
 		protocol.WithFilterProvider(
@@ -81,6 +166,14 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 				blockfilter.WithMinCommittableSlotAge(slot.Index(NotarizationParameters.MinSlotCommittableAge)),
 				blockfilter.WithMaxAllowedWallClockDrift(Parameters.MaxAllowedClockDrift),
 				blockfilter.WithSignatureValidation(true),
+				blockfilter.WithPoWDifficulty(Parameters.PoWDifficulty),
+				blockfilter.WithWorkPolicy(workPolicy),
+				blockfilter.WithUpgrades(schedule),
+			),
+		),
+		protocol.WithConsensusProvider(
+			tangleconsensus.NewProvider(
+				tangleconsensus.WithBlockGadgetProvider(blockGadgetProvider),
 			),
 		),
 		protocol.WithSybilProtectionProvider(
@@ -99,6 +192,16 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 				tsc.WithTimeSinceConfirmationThreshold(Parameters.TimeSinceConfirmationThreshold),
 			),
 			engine.WithSnapshotDepth(Parameters.Snapshot.Depth),
+			engine.WithSnapshotVerification(Parameters.Snapshot.VerificationWorkers),
+			engine.WithSnapshotSignatureVerification(true),
+			engine.WithSnapshotStorage(remotestorage.New(
+				remotestorage.WithS3Endpoint(Parameters.Snapshot.S3.Endpoint),
+				remotestorage.WithS3Region(Parameters.Snapshot.S3.Region),
+				remotestorage.WithS3Credentials(Parameters.Snapshot.S3.AccessKeyID, Parameters.Snapshot.S3.SecretAccessKey),
+				remotestorage.WithS3ForcePathStyle(Parameters.Snapshot.S3.ForcePathStyle),
+				remotestorage.WithGCSCredentials(Parameters.Snapshot.GCS.AccessKeyID, Parameters.Snapshot.GCS.SecretAccessKey),
+				remotestorage.WithPartSize(Parameters.Snapshot.PartSize),
+			)),
 		),
 		protocol.WithChainManagerOptions(
 			chainmanager.WithForkDetectionMinimumDepth(Parameters.ForkDetectionMinimumDepth),
@@ -110,9 +213,11 @@ func provide(n *p2p.Manager) (p *protocol.Protocol) {
 		protocol.WithCongestionControlOptions(
 			congestioncontrol.WithSchedulerOptions(
 				scheduler.WithMaxBufferSize(SchedulerParameters.MaxBufferSize),
+				scheduler.WithMaxFutureBufferSize(SchedulerParameters.MaxFutureBufferSize),
 				scheduler.WithAcceptedBlockScheduleThreshold(SchedulerParameters.ConfirmedBlockThreshold),
 				scheduler.WithRate(SchedulerParameters.Rate),
 				scheduler.WithMaxDeficit(SchedulerParameters.MaxDeficit),
+				scheduler.WithWorkPolicy(workPolicy),
 			),
 		),
 		protocol.WithBaseDirectory(DatabaseParameters.Directory),
@@ -163,6 +268,10 @@ func configureLogging(plugin *node.Plugin) {
 	// 	fmt.Println(">>>>>>> BlockRequesterTick", blockID)
 	// }))
 
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictDepthExceeded.Hook(func(event *conflictdag.ConflictDepthExceededEvent[utxo.TransactionID]) {
+		Plugin.LogWarnf("Rejected conflict %s with depth %d (maximum conflict depth: %d)", event.ConflictID, event.Depth, event.MaxDepth)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
 	deps.Protocol.Events.Network.Error.Hook(func(errorEvent *network.ErrorEvent) {
 		Plugin.LogErrorf("Error in Network: %s (source: %s)", errorEvent.Error, errorEvent.Source.String())
 	}, event.WithWorkerPool(plugin.WorkerPool))
@@ -180,7 +289,7 @@ func run(plugin *node.Plugin) {
 		<-ctx.Done()
 		plugin.LogInfo("Gracefully shutting down the Protocol...")
 		deps.Protocol.Shutdown()
-	}, shutdown.PriorityTangle); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Panicf("Error starting as daemon: %s", err)
 	}
 }
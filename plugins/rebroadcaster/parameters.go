@@ -0,0 +1,24 @@
+package rebroadcaster
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the rebroadcaster plugin.
+type ParametersDefinition struct {
+	// Timeout defines the time after which an own block that was not referenced by another node is rebroadcast.
+	Timeout time.Duration `default:"10s" usage:"the time after which an own block that was not referenced by another node is rebroadcast"`
+	// Interval defines the interval in which the own blocks are checked for references.
+	Interval time.Duration `default:"1s" usage:"the interval in which the own blocks are checked for references"`
+	// MaxAttempts defines how often a block is rebroadcast before it is considered lost.
+	MaxAttempts int `default:"3" usage:"how often a block is rebroadcast before it is considered lost"`
+}
+
+// Parameters contains the configuration parameters of the rebroadcaster plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "rebroadcaster")
+}
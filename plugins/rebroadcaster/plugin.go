@@ -0,0 +1,87 @@
+package rebroadcaster
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
+	"github.com/iotaledger/goshimmer/packages/app/rebroadcaster"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/blockdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// PluginName is the name of the rebroadcaster plugin.
+const PluginName = "Rebroadcaster"
+
+var (
+	// Plugin is the plugin instance of the rebroadcaster plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol      *protocol.Protocol
+	BlockIssuer   *blockissuer.BlockIssuer
+	Rebroadcaster *rebroadcaster.Rebroadcaster
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentProtocol)
+
+	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
+		if err := event.Container.Provide(createRebroadcaster); err != nil {
+			Plugin.Panic(err)
+		}
+	})
+}
+
+func createRebroadcaster(local *peer.Local, protocol *protocol.Protocol) *rebroadcaster.Rebroadcaster {
+	return rebroadcaster.New(local.ID(), func(block *models.Block) {
+		protocol.Network().SendBlock(block)
+	},
+		rebroadcaster.WithTimeout(Parameters.Timeout),
+		rebroadcaster.WithInterval(Parameters.Interval),
+		rebroadcaster.WithMaxAttempts(Parameters.MaxAttempts),
+	)
+}
+
+func configure(plugin *node.Plugin) {
+	deps.BlockIssuer.Events.BlockIssued.Hook(deps.Rebroadcaster.Track, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Protocol.Events.Engine.Tangle.BlockDAG.BlockAttached.Hook(func(block *blockdag.Block) {
+		deps.Rebroadcaster.Observe(block.ModelsBlock)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Rebroadcaster.Events.BlockRebroadcast.Hook(func(event *rebroadcaster.BlockRebroadcastEvent) {
+		Plugin.LogDebugf("Rebroadcast block %s (attempt %d)", event.Block.ID(), event.Attempt)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Rebroadcaster.Events.BlockRecovered.Hook(func(block *models.Block) {
+		Plugin.LogInfof("Recovered block %s after rebroadcasting it", block.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Rebroadcaster.Events.BlockLost.Hook(func(block *models.Block) {
+		Plugin.LogWarnf("Block %s was not referenced by any other node after %d rebroadcast attempts", block.ID(), Parameters.MaxAttempts)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		deps.Rebroadcaster.Start()
+
+		<-ctx.Done()
+
+		deps.Rebroadcaster.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
@@ -50,7 +50,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentRemoteLog).ShutdownPriority(shutdown.PriorityRemoteLog)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		if err := event.Container.Provide(func() *RemoteLoggerConn {
@@ -91,7 +91,7 @@ func run(plugin *node.Plugin) {
 		plugin.LogInfof("Stopping %s ...", PluginName)
 		hook.Unhook()
 		plugin.LogInfof("Stopping %s ... done", PluginName)
-	}, shutdown.PriorityRemoteLog); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
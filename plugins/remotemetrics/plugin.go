@@ -26,8 +26,9 @@ import (
 )
 
 const (
-	syncUpdateTime           = 500 * time.Millisecond
-	schedulerQueryUpdateTime = 5 * time.Second
+	syncUpdateTime            = 500 * time.Millisecond
+	schedulerQueryUpdateTime  = 5 * time.Second
+	saturationQueryUpdateTime = 5 * time.Second
 )
 
 const (
@@ -61,7 +62,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentRemoteLog, shutdown.ComponentProtocol)
 }
 
 func configure(plugin *node.Plugin) {
@@ -77,6 +78,7 @@ func configure(plugin *node.Plugin) {
 	configureBlockScheduledMetrics(plugin)
 	configureMissingBlockMetrics(plugin)
 	configureSchedulerQueryMetrics(plugin)
+	configureSaturationQueryMetrics(plugin)
 }
 
 func run(plugin *node.Plugin) {
@@ -95,10 +97,13 @@ func run(plugin *node.Plugin) {
 		timeutil.NewTicker(func() {
 			remotemetrics.Events.SchedulerQuery.Trigger(&remotemetrics.SchedulerQueryEvent{Time: time.Now()})
 		}, schedulerQueryUpdateTime, ctx)
+		timeutil.NewTicker(func() {
+			remotemetrics.Events.SaturationQuery.Trigger(&remotemetrics.SaturationQueryEvent{Time: time.Now()})
+		}, saturationQueryUpdateTime, ctx)
 
 		// Wait before terminating so we get correct log blocks from the daemon regarding the shutdown order.
 		<-ctx.Done()
-	}, shutdown.PriorityRemoteLog); err != nil {
+	}, Plugin.ShutdownOrder()); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -124,6 +129,14 @@ func configureSchedulerQueryMetrics(plugin *node.Plugin) {
 	remotemetrics.Events.SchedulerQuery.Hook(func(event *remotemetrics.SchedulerQueryEvent) { obtainSchedulerStats(event.Time) }, event.WithWorkerPool(plugin.WorkerPool))
 }
 
+func configureSaturationQueryMetrics(plugin *node.Plugin) {
+	if Parameters.MetricsLevel > Info {
+		return
+	}
+
+	remotemetrics.Events.SaturationQuery.Hook(func(event *remotemetrics.SaturationQueryEvent) { obtainSaturationStats(event.Time) }, event.WithWorkerPool(plugin.WorkerPool))
+}
+
 func configureConflictConfirmationMetrics(plugin *node.Plugin) {
 	if Parameters.MetricsLevel > Info {
 		return
@@ -0,0 +1,95 @@
+package remotemetrics
+
+import (
+	"runtime/debug"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/app/remotemetrics"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+// saturationComponents maps the name of a sampled component to the worker group or pool of the engine that runs it.
+var saturationComponents = map[string]string{
+	"booker":     "Booker",
+	"solidifier": "BlockDAG",
+	"scheduler":  "Scheduler",
+}
+
+func obtainSaturationStats(timestamp time.Time) {
+	var myID string
+	if deps.Local != nil {
+		myID = deps.Local.Identity.ID().String()
+	}
+
+	record := remotemetrics.SaturationMetrics{
+		Type:         "saturationSample",
+		NodeID:       myID,
+		Synced:       deps.Protocol.Engine().IsSynced(),
+		MetricsLevel: Parameters.MetricsLevel,
+		Components:   make(map[string]*remotemetrics.WorkerPoolSaturation, len(saturationComponents)),
+		Timestamp:    timestamp,
+	}
+
+	for _, pool := range deps.Protocol.Workers.Pools() {
+		record.EventLoopQueueLength += uint32(pool.Queue.Size())
+		record.EventLoopPendingTasks += uint32(pool.PendingTasksCounter.Get())
+	}
+
+	engineWorkers := deps.Protocol.Engine().Workers
+	for component, name := range saturationComponents {
+		record.Components[component] = workerPoolSaturation(componentPools(engineWorkers, name))
+	}
+
+	var gcStats debug.GCStats
+	gcStats.PauseQuantiles = make([]time.Duration, 5)
+	debug.ReadGCStats(&gcStats)
+	record.NumGC = gcStats.NumGC
+	record.GCPauseTotal = gcStats.PauseTotal.Nanoseconds()
+	if len(gcStats.Pause) > 0 {
+		record.GCPauseLast = gcStats.Pause[0].Nanoseconds()
+	}
+	record.GCPauseMax = gcStats.PauseQuantiles[len(gcStats.PauseQuantiles)-1].Nanoseconds()
+
+	_ = deps.RemoteLogger.Send(record)
+}
+
+// componentPools returns the worker pools that belong to the group or pool with the given name.
+func componentPools(group *workerpool.Group, name string) (pools []*workerpool.WorkerPool) {
+	if componentGroup, exists := group.Group(name); exists {
+		for _, pool := range componentGroup.Pools() {
+			pools = append(pools, pool)
+		}
+	}
+
+	if pool, exists := group.Pool(name); exists {
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+func workerPoolSaturation(pools []*workerpool.WorkerPool) (saturation *remotemetrics.WorkerPoolSaturation) {
+	saturation = new(remotemetrics.WorkerPoolSaturation)
+
+	var busyWorkers int
+	for _, pool := range pools {
+		workerCount := pool.WorkerCount()
+		pendingTasks := pool.PendingTasksCounter.Get()
+
+		saturation.WorkerCount += uint32(workerCount)
+		saturation.PendingTasks += uint32(pendingTasks)
+		saturation.QueueLength += uint32(pool.Queue.Size())
+
+		if pendingTasks > workerCount {
+			busyWorkers += workerCount
+		} else {
+			busyWorkers += pendingTasks
+		}
+	}
+
+	if saturation.WorkerCount > 0 {
+		saturation.Utilization = float64(busyWorkers) / float64(saturation.WorkerCount)
+	}
+
+	return saturation
+}
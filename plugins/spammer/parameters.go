@@ -0,0 +1,29 @@
+package spammer
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the spammer plugin.
+type ParametersDefinition struct {
+	// ValueSpam defines the parameters of the spammer that issues value transactions.
+	ValueSpam struct {
+		// AddressCount defines the number of addresses that the funds of the faucet are cycled among.
+		AddressCount uint64 `default:"10" usage:"the number of addresses that the funds of the faucet are cycled among"`
+
+		// FaucetPowDifficulty defines the PoW difficulty of the faucet request that funds the value spammer.
+		FaucetPowDifficulty int `default:"22" usage:"the PoW difficulty of the faucet request that funds the value spammer"`
+
+		// FundingTimeout defines the time to wait for the funds of the faucet.
+		FundingTimeout time.Duration `default:"1m" usage:"the time to wait for the funds of the faucet"`
+	}
+}
+
+// Parameters contains the configuration parameters of the spammer plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "spammer")
+}
@@ -2,19 +2,33 @@ package spammer
 
 import (
 	"context"
+	"runtime"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
+	"github.com/iotaledger/goshimmer/packages/app/faucet"
 	"github.com/iotaledger/goshimmer/packages/app/spammer"
+	"github.com/iotaledger/goshimmer/packages/core/pow"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
 	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/runtime/event"
 )
 
-var blockSpammer *spammer.Spammer
+var (
+	blockSpammer *spammer.Spammer
+	valueSpammer *spammer.ValueSpammer
+)
 
 // PluginName is the name of the spammer plugin.
 const PluginName = "Spammer"
@@ -29,18 +43,37 @@ var (
 type dependencies struct {
 	dig.In
 
+	Local       *peer.Local
+	Protocol    *protocol.Protocol
+	Indexer     *indexer.Indexer
 	BlockIssuer *blockissuer.BlockIssuer
 	Server      *echo.Echo
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Consumes(shutdown.ComponentProtocol)
 }
 
-func configure(_ *node.Plugin) {
+func configure(plugin *node.Plugin) {
 	log = logger.NewLogger(PluginName)
 
 	blockSpammer = spammer.New(deps.BlockIssuer.IssuePayload, log, deps.BlockIssuer.Estimate)
+	valueSpammer = spammer.NewValueSpammer(deps.BlockIssuer.IssuePayload, requestFunds, spendableOutputs, deps.BlockIssuer.Estimate, log,
+		spammer.WithAddressCount(Parameters.ValueSpam.AddressCount),
+		spammer.WithFundingTimeout(Parameters.ValueSpam.FundingTimeout),
+		spammer.WithPledgeID(deps.Local.ID()),
+	)
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+		valueSpammer.TransactionBooked(event.TransactionID)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		valueSpammer.TransactionAccepted(event.Metadata.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionRejected.Hook(func(transactionMetadata *mempool.TransactionMetadata) {
+		valueSpammer.TransactionRejected(transactionMetadata.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
 	deps.Server.GET("spammer", handleRequest)
 }
 
@@ -49,7 +82,32 @@ func run(*node.Plugin) {
 		<-ctx.Done()
 
 		blockSpammer.Shutdown()
-	}, shutdown.PrioritySpammer); err != nil {
+		valueSpammer.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
+
+// requestFunds issues a faucet request that funds the given address.
+func requestFunds(address devnetvm.Address) (err error) {
+	faucetRequest := faucet.NewRequest(address, deps.Local.ID(), deps.Local.ID(), 0)
+
+	requestBytes, err := faucetRequest.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize faucet request")
+	}
+
+	nonce, err := pow.New(runtime.GOMAXPROCS(0)).Mine(context.Background(), requestBytes[:len(requestBytes)-pow.NonceBytes], Parameters.ValueSpam.FaucetPowDifficulty)
+	if err != nil {
+		return errors.Wrap(err, "failed to compute faucet PoW")
+	}
+
+	_, err = deps.BlockIssuer.IssuePayload(faucet.NewRequest(address, deps.Local.ID(), deps.Local.ID(), nonce))
+
+	return err
+}
+
+// spendableOutputs returns the outputs that the given address can currently spend.
+func spendableOutputs(address devnetvm.Address) (outputs devnetvm.Outputs, err error) {
+	return deps.Indexer.SpendableOutputs(context.Background(), address, time.Now())
+}
@@ -0,0 +1,142 @@
+package spammer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	// ProfileModeBlocks is the mode of the profiles that spam data blocks.
+	ProfileModeBlocks = "blocks"
+
+	// ProfileModeValue is the mode of the profiles that spam value transactions.
+	ProfileModeValue = "value"
+)
+
+// ErrUnknownProfile is returned if a spammer profile is started that does not exist.
+var ErrUnknownProfile = errors.New("unknown spammer profile")
+
+var (
+	// activeProfile contains the name of the profile that the spammer was last started with.
+	activeProfile      string
+	activeProfileMutex sync.Mutex
+)
+
+// region Profile //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Profile is a predefined configuration of the spammer.
+type Profile struct {
+	// Name is the name of the profile.
+	Name string
+
+	// Mode is the mode of the profile (ProfileModeBlocks or ProfileModeValue).
+	Mode string
+
+	// Rate is the number of blocks (or transactions) that are issued per TimeUnit.
+	Rate int
+
+	// TimeUnit is the time unit of the Rate.
+	TimeUnit time.Duration
+
+	// IMIF is the inter block issuing function of the block spammer (uniform or poisson).
+	IMIF string
+
+	// PayloadSize is the size of the data payload of the spammed blocks.
+	PayloadSize uint64
+
+	// ConflictRate is the share of double spends among the spammed transactions.
+	ConflictRate float64
+}
+
+// Profiles contains the predefined profiles that the spammer can be started with.
+var Profiles = []*Profile{
+	{Name: "light", Mode: ProfileModeBlocks, Rate: 1, TimeUnit: time.Second, IMIF: "uniform", PayloadSize: 5},
+	{Name: "medium", Mode: ProfileModeBlocks, Rate: 10, TimeUnit: time.Second, IMIF: "poisson", PayloadSize: 64},
+	{Name: "heavy", Mode: ProfileModeBlocks, Rate: 50, TimeUnit: time.Second, IMIF: "poisson", PayloadSize: 512},
+	{Name: "value", Mode: ProfileModeValue, Rate: 1, TimeUnit: time.Second},
+	{Name: "conflicts", Mode: ProfileModeValue, Rate: 1, TimeUnit: time.Second, ConflictRate: 0.2},
+}
+
+// ProfileByName returns the predefined profile with the given name.
+func ProfileByName(name string) (profile *Profile, exists bool) {
+	for _, profile = range Profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+
+	return nil, false
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Control //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// StartProfile stops the running spammers and starts the spammer with the profile of the given name.
+func StartProfile(name string) (err error) {
+	profile, exists := ProfileByName(name)
+	if !exists {
+		return errors.WithMessagef(ErrUnknownProfile, "profile '%s'", name)
+	}
+
+	activeProfileMutex.Lock()
+	defer activeProfileMutex.Unlock()
+
+	blockSpammer.Shutdown()
+	valueSpammer.Shutdown()
+
+	switch profile.Mode {
+	case ProfileModeBlocks:
+		blockSpammer.Start(profile.Rate, profile.PayloadSize, profile.TimeUnit, profile.IMIF)
+	case ProfileModeValue:
+		valueSpammer.Start(profile.Rate, profile.TimeUnit, profile.ConflictRate)
+	default:
+		return errors.Errorf("profile '%s' has an invalid mode '%s'", name, profile.Mode)
+	}
+	activeProfile = profile.Name
+
+	log.Infof("Started spammer with profile '%s'", profile.Name)
+
+	return nil
+}
+
+// Stop stops the block and the value spammer.
+func Stop() {
+	activeProfileMutex.Lock()
+	defer activeProfileMutex.Unlock()
+
+	blockSpammer.Shutdown()
+	valueSpammer.Shutdown()
+	activeProfile = ""
+
+	log.Info("Stopped spammer")
+}
+
+// clearActiveProfile resets the active profile if the spammer is started with custom parameters.
+func clearActiveProfile() {
+	activeProfileMutex.Lock()
+	defer activeProfileMutex.Unlock()
+
+	activeProfile = ""
+}
+
+// Status returns the name of the profile that the spammer was last started with (if it is still running), whether the
+// block spammer is running and the statistics of the value spammer.
+func Status() (profile string, blockSpammerRunning bool, valueStats *jsonmodels.ValueSpammerStats) {
+	activeProfileMutex.Lock()
+	defer activeProfileMutex.Unlock()
+
+	blockSpammerRunning = blockSpammer.IsRunning()
+	valueStats = valueSpammerStats()
+	if blockSpammerRunning || valueStats.Running {
+		profile = activeProfile
+	}
+
+	return profile, blockSpammerRunning, valueStats
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -16,6 +16,10 @@ func handleRequest(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: err.Error()})
 	}
 
+	if request.Mode == "value" {
+		return handleValueRequest(c, request)
+	}
+
 	switch request.Cmd {
 	case "start":
 		if request.Rate == 0 {
@@ -45,6 +49,7 @@ func handleRequest(c echo.Context) error {
 			request.PayloadSize = 5
 		}
 
+		clearActiveProfile()
 		blockSpammer.Shutdown()
 		blockSpammer.Start(request.Rate, request.PayloadSize, timeUnit, request.IMIF)
 		log.Infof("Started spamming blocks with %d %s and %s inter-block issuing function", request.Rate, strings.ReplaceAll(request.Unit, "\n", ""), strings.ReplaceAll(request.IMIF, "\n", ""))
@@ -57,3 +62,57 @@ func handleRequest(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: "invalid cmd in request"})
 	}
 }
+
+func handleValueRequest(c echo.Context, request jsonmodels.SpammerRequest) error {
+	switch request.Cmd {
+	case "start":
+		if request.Rate == 0 {
+			log.Infof("Requesting invalid spamming at rate 0 TPS. Setting it to 1 TPS")
+			request.Rate = 1
+		}
+
+		if request.ConflictRate < 0 || request.ConflictRate > 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: "conflictRate must be in the range [0, 1]"})
+		}
+
+		var timeUnit time.Duration
+		switch request.Unit {
+		case "TPM":
+			timeUnit = time.Minute
+		default:
+			request.Unit = "TPS"
+			timeUnit = time.Second
+		}
+
+		clearActiveProfile()
+		valueSpammer.Shutdown()
+		valueSpammer.Start(request.Rate, timeUnit, request.ConflictRate)
+		log.Infof("Started spamming value transactions with %d %s and a conflict rate of %.2f", request.Rate, request.Unit, request.ConflictRate)
+		return c.JSON(http.StatusOK, jsonmodels.SpammerResponse{Block: "started spamming value transactions"})
+	case "stop":
+		valueSpammer.Shutdown()
+		log.Info("Stopped spamming value transactions")
+		return c.JSON(http.StatusOK, jsonmodels.SpammerResponse{Block: "stopped spamming value transactions", Stats: valueSpammerStats()})
+	case "stats":
+		return c.JSON(http.StatusOK, jsonmodels.SpammerResponse{Stats: valueSpammerStats()})
+	default:
+		return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: "invalid cmd in request"})
+	}
+}
+
+// valueSpammerStats returns the statistics of the value spammer.
+func valueSpammerStats() *jsonmodels.ValueSpammerStats {
+	stats := valueSpammer.Stats()
+
+	return &jsonmodels.ValueSpammerStats{
+		Running:     stats.Running,
+		Issued:      stats.Issued,
+		Booked:      stats.Booked,
+		Accepted:    stats.Accepted,
+		Rejected:    stats.Rejected,
+		Conflicts:   stats.Conflicts,
+		IssuedTPS:   stats.IssuedTPS(),
+		BookedTPS:   stats.BookedTPS(),
+		AcceptedTPS: stats.AcceptedTPS(),
+	}
+}
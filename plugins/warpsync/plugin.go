@@ -32,7 +32,7 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run).Provides(shutdown.ComponentWarpsync).Consumes(shutdown.ComponentProtocol)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		if err := event.Container.Provide(func(p *protocol.Protocol, p2pManager *p2p.Manager) *warpsync.Manager {
@@ -76,7 +76,7 @@ func start(ctx context.Context) {
 }
 
 func run(plugin *node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, start, shutdown.PriorityWarpsync); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, start, Plugin.ShutdownOrder()); err != nil {
 		plugin.Logger().Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -4,10 +4,12 @@ import (
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
 	"github.com/iotaledger/goshimmer/plugins/webapi/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/webapi/backup"
 	"github.com/iotaledger/goshimmer/plugins/webapi/block"
 	"github.com/iotaledger/goshimmer/plugins/webapi/data"
 	"github.com/iotaledger/goshimmer/plugins/webapi/faucet"
 	"github.com/iotaledger/goshimmer/plugins/webapi/faucetrequest"
+	"github.com/iotaledger/goshimmer/plugins/webapi/graphql"
 	"github.com/iotaledger/goshimmer/plugins/webapi/healthz"
 	"github.com/iotaledger/goshimmer/plugins/webapi/info"
 	"github.com/iotaledger/goshimmer/plugins/webapi/ledgerstate"
@@ -16,6 +18,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/webapi/scheduler"
 	"github.com/iotaledger/goshimmer/plugins/webapi/slot"
 	"github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
+	"github.com/iotaledger/goshimmer/plugins/webapi/subscriptions"
 	"github.com/iotaledger/goshimmer/plugins/webapi/weightprovider"
 )
 
@@ -32,7 +35,10 @@ var WebAPI = node.Plugins(
 	slot.Plugin,
 	mana.Plugin,
 	ledgerstate.Plugin,
+	subscriptions.Plugin,
+	graphql.Plugin,
 	snapshot.Plugin,
+	backup.Plugin,
 	weightprovider.Plugin,
 	ratesetter.Plugin,
 	scheduler.Plugin,
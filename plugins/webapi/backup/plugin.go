@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/backup"
+)
+
+var (
+	// Plugin is the plugin instance of the web API backup endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server *echo.Echo
+}
+
+func init() {
+	Plugin = node.NewPlugin("WebAPIBackup", deps, node.Disabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	deps.Server.POST("backup", CreateBackup)
+}
+
+// CreateBackup creates a verified online backup of the database of the node (and uploads it if configured).
+func CreateBackup(c echo.Context) (err error) {
+	if node.IsSkipped(backup.Plugin) {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.CreateBackupResponse{Error: "backup plugin is not enabled"})
+	}
+
+	result, err := backup.Create(c.Request().Context())
+	if err != nil {
+		Plugin.LogErrorf("failed to create backup: %s", err)
+
+		status := http.StatusInternalServerError
+		if errors.Is(err, backup.ErrBackupInProgress) {
+			status = http.StatusConflict
+		}
+
+		response := jsonmodels.CreateBackupResponse{Error: err.Error()}
+		if result != nil {
+			response.Directory = result.Directory
+		}
+
+		return c.JSON(status, response)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.CreateBackupResponse{Directory: result.Directory, Location: result.Location})
+}
@@ -2,6 +2,7 @@ package block
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -17,6 +18,20 @@ import (
 	"github.com/iotaledger/goshimmer/packages/protocol/models/payload"
 )
 
+const (
+	// defaultChildrenLimit contains the number of approvers that are returned if no limit is requested.
+	defaultChildrenLimit = 100
+
+	// maxChildrenLimit contains the maximum number of approvers that are returned by a single request.
+	maxChildrenLimit = 1000
+
+	// maxChildrenDepth contains the maximum depth up to which the approvers of a block can be traversed.
+	maxChildrenDepth = 10
+
+	// maxChildrenOffset contains the maximum offset of a page of approvers (it bounds the number of traversed blocks).
+	maxChildrenOffset = 10000
+)
+
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 var (
@@ -41,6 +56,7 @@ func init() {
 func configure(_ *node.Plugin) {
 	deps.Server.GET("blocks/:blockID", GetBlock)
 	deps.Server.GET("blocks/:blockID/metadata", GetBlockMetadata)
+	deps.Server.GET("blocks/:blockID/children", GetBlockChildren)
 	deps.Server.POST("blocks/payload", PostPayload)
 
 	// TODO: add markers to be retained by the retainer
@@ -166,6 +182,63 @@ func GetBlockMetadata(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetBlockChildren /////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetBlockChildren is the handler for the /blocks/:blockID/children endpoint. It returns a page of the approvers of the
+// block that are reachable within the requested depth (ordered by depth, slot index and ID).
+func GetBlockChildren(c echo.Context) (err error) {
+	blockID, err := blockIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	var childTypes []models.ParentsType
+	switch typeParam := c.QueryParam("type"); typeParam {
+	case "":
+	case jsonmodels.ChildTypeStrong:
+		childTypes = append(childTypes, models.StrongParentType)
+	case jsonmodels.ChildTypeWeak:
+		childTypes = append(childTypes, models.WeakParentType)
+	case jsonmodels.ChildTypeLikedInstead:
+		childTypes = append(childTypes, models.ShallowLikeParentType)
+	default:
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid type: %s", typeParam)))
+	}
+
+	depth := 1
+	if depthParam := c.QueryParam("depth"); depthParam != "" {
+		if depth, err = strconv.Atoi(depthParam); err != nil || depth < 1 || depth > maxChildrenDepth {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid depth: %s [1-%d]", depthParam, maxChildrenDepth)))
+		}
+	}
+
+	offset := 0
+	if offsetParam := c.QueryParam("offset"); offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err != nil || offset < 0 || offset > maxChildrenOffset {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid offset: %s [0-%d]", offsetParam, maxChildrenOffset)))
+		}
+	}
+
+	limit := defaultChildrenLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitParam)))
+		}
+	}
+	if limit > maxChildrenLimit {
+		limit = maxChildrenLimit
+	}
+
+	children, hasMore, exists := deps.Retainer.Children(blockID, depth, offset, limit, childTypes...)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load BlockMetadata with %s", blockID)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetBlockChildrenResponse(blockID, children, hasMore))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostPayload //////////////////////////////////////////////////////////////////////////////////////////////////
 
 // PostPayload is the handler for the /blocks/payload endpoint.
@@ -186,7 +259,10 @@ func PostPayload(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, jsonmodels.NewPostPayloadResponse(blk))
+	response := jsonmodels.NewPostPayloadResponse(blk)
+	response.Advisory = jsonmodels.NewIssuanceAdvisory(deps.BlockIssuer.Advisory())
+
+	return c.JSON(http.StatusOK, response)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -77,5 +77,8 @@ func broadcastData(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, jsonmodels.DataResponse{Error: err.Error()})
 	}
 
-	return c.JSON(http.StatusOK, jsonmodels.DataResponse{ID: constructedBlock.ID().Base58()})
+	return c.JSON(http.StatusOK, jsonmodels.DataResponse{
+		ID:       constructedBlock.ID().Base58(),
+		Advisory: jsonmodels.NewIssuanceAdvisory(deps.BlockIssuer.Advisory()),
+	})
 }
@@ -4,9 +4,18 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
 )
 
 // IndexRequest returns INDEX
 func IndexRequest(c echo.Context) error {
 	return c.String(http.StatusOK, "INDEX")
 }
+
+// GetArtifacts is the handler for the /artifacts endpoint. It returns the node-local artifacts that were created through
+// the API (optionally restricted to the given kind) together with their expiration time.
+func GetArtifacts(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewGetArtifactsResponse(deps.Artifacts.Artifacts(artifacts.Kind(c.QueryParam("kind")))))
+}
@@ -0,0 +1,100 @@
+package webapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/goshimmer/packages/app/evidence"
+)
+
+// region signed routes ////////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	// signedRoutes contains the (normalized) paths of the routes whose responses are signed by the node identity.
+	signedRoutes = make(map[string]bool)
+
+	// signedRoutesMutex is used to synchronize access to the signedRoutes.
+	signedRoutesMutex sync.RWMutex
+)
+
+// SignRoute marks the route with the given (unversioned) path, so that its responses are signed by the node identity
+// (if the evidence of responses is enabled).
+func SignRoute(path string) {
+	signedRoutesMutex.Lock()
+	defer signedRoutesMutex.Unlock()
+
+	signedRoutes["/"+strings.TrimPrefix(path, "/")] = true
+}
+
+// IsSignedRoute returns true if the responses of the route with the given (unversioned) path are signed.
+func IsSignedRoute(path string) bool {
+	signedRoutesMutex.RLock()
+	defer signedRoutesMutex.RUnlock()
+
+	return signedRoutes["/"+strings.TrimPrefix(path, "/")]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region middlewares //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// responseEvidence is a middleware that buffers the responses of the signed routes and announces an Evidence of the
+// node identity in their headers, which covers the request, the status code, the body and the time of the response.
+func responseEvidence(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !IsSignedRoute(c.Path()) {
+			return next(c)
+		}
+
+		response := c.Response()
+		recorder := &responseRecorder{ResponseWriter: response.Writer, statusCode: http.StatusOK}
+
+		response.Writer = recorder
+		err := next(c)
+		response.Writer = recorder.ResponseWriter
+
+		if recorder.wroteHeader {
+			request := c.Request()
+			evidence.New(deps.Local, request.Method, request.RequestURI, recorder.statusCode, recorder.body.Bytes(), time.Now()).WriteHeaders(response.Header())
+
+			response.Writer.WriteHeader(recorder.statusCode)
+			if _, writeErr := response.Writer.Write(recorder.body.Bytes()); writeErr != nil {
+				log.Warnf("Failed to send signed response: %s", writeErr)
+			}
+		}
+
+		return err
+	}
+}
+
+// responseRecorder is an http.ResponseWriter that holds back the status code and the body of a response until it was
+// signed.
+type responseRecorder struct {
+	http.ResponseWriter
+
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// WriteHeader records the status code of the response.
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+}
+
+// Write records the given part of the body of the response.
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+
+	return r.body.Write(data)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
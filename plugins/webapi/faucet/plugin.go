@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mr-tron/base58"
 	"go.uber.org/dig"
 
 	faucetpkg "github.com/iotaledger/goshimmer/packages/app/faucet"
@@ -33,6 +34,7 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	deps.Server.POST("faucet", processFaucetRequest)
+	deps.Server.POST("faucet/cosign", processFaucetCoSignRequest)
 }
 
 // processFaucetRequest processes the faucet request received via the web API.
@@ -75,3 +77,45 @@ func processFaucetRequest(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, jsonmodels.FaucetAPIResponse{Success: true})
 }
+
+// processFaucetCoSignRequest signs the essence of a funding transaction of another faucet if the node is configured as
+// a co-signer of its multisig and the request is valid.
+func processFaucetCoSignRequest(c echo.Context) error {
+	var request jsonmodels.FaucetCoSignRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: err.Error()})
+	}
+
+	essenceBytes, err := base58.Decode(request.Essence)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: "Invalid essence"})
+	}
+
+	essence, _, err := devnetvm.TransactionEssenceFromBytes(essenceBytes)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: err.Error()})
+	}
+
+	faucetRequestBytes, err := base58.Decode(request.FaucetRequest)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: "Invalid faucet request"})
+	}
+
+	faucetRequest, _, err := faucetpkg.FromBytes(faucetRequestBytes)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: err.Error()})
+	}
+
+	requestSignature, err := devnetvm.SignatureFromBase58EncodedString(request.Signature)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: "Invalid signature"})
+	}
+
+	signature, err := faucet.OnCoSignRequest(faucetpkg.NewCoSignRequest(essence, faucetRequest, requestSignature))
+	if err != nil {
+		Plugin.LogInfof("Refused to co-sign funding transaction: %s", err)
+		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetCoSignResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.FaucetCoSignResponse{Signature: signature.Base58()})
+}
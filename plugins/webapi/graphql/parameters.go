@@ -0,0 +1,20 @@
+package graphql
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// ParametersDefinition contains the definition of configuration parameters used by the GraphQL endpoint plugin.
+type ParametersDefinition struct {
+	// MaxDepth defines the maximum nesting depth of the selections of a query.
+	MaxDepth int `default:"10" usage:"the maximum nesting depth of the selections of a GraphQL query"`
+	// MaxQuerySize defines the maximum size (in bytes) of a query.
+	MaxQuerySize int `default:"16384" usage:"the maximum size (in bytes) of a GraphQL query"`
+}
+
+// Parameters contains the configuration parameters of the GraphQL endpoint plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	config.BindParameters(Parameters, "graphQL")
+}
@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"net/http"
+
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
+)
+
+// PluginName is the name of the web API GraphQL endpoint plugin.
+const PluginName = "WebAPIGraphQLEndpoint"
+
+type dependencies struct {
+	dig.In
+
+	Server   *echo.Echo
+	Protocol *protocol.Protocol
+	Retainer *retainer.Retainer
+	Indexer  *indexer.Indexer
+}
+
+var (
+	// Plugin is the plugin instance of the web API GraphQL endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	// schema contains the GraphQL schema that is served by the endpoint.
+	schema graphqlgo.Schema
+)
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure).Consumes(shutdown.ComponentWebAPI, shutdown.ComponentProtocol)
+}
+
+func configure(plugin *node.Plugin) {
+	var err error
+	if schema, err = newSchema(); err != nil {
+		plugin.LogFatalfAndExitf("failed to create GraphQL schema: %s", err)
+	}
+
+	deps.Server.GET("graphql", Query)
+	deps.Server.POST("graphql", Query)
+}
+
+// region Query ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Query is the handler for the /graphql endpoint. It executes the GraphQL query that is either posted as a
+// GraphQLRequest or passed in the "query" query parameter. Errors of the query are reported in the "errors" field of
+// the response (as defined by the GraphQL specification), so only malformed requests are answered with an error status.
+func Query(c echo.Context) (err error) {
+	request := &jsonmodels.GraphQLRequest{
+		Query:         c.QueryParam("query"),
+		OperationName: c.QueryParam("operationName"),
+	}
+	if c.Request().Method == http.MethodPost {
+		if err = c.Bind(request); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+	}
+
+	if err = validateQuery(request.Query); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, graphqlgo.Do(graphqlgo.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        c.Request().Context(),
+	}))
+}
+
+// validateQuery checks that the given query does not exceed the configured size and nesting depth (the relationships
+// can be traversed indefinitely, so the depth bounds the number of storage accesses of a query).
+func validateQuery(query string) (err error) {
+	if query == "" {
+		return errors.New("no query was given")
+	}
+
+	if len(query) > Parameters.MaxQuerySize {
+		return errors.Errorf("query has a size of %d bytes [max %d]", len(query), Parameters.MaxQuerySize)
+	}
+
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return errors.Wrap(err, "failed to parse query")
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, definition := range document.Definitions {
+		if fragment, isFragment := definition.(*ast.FragmentDefinition); isFragment {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, definition := range document.Definitions {
+		if operation, isOperation := definition.(*ast.OperationDefinition); isOperation {
+			if depth := selectionDepth(operation.SelectionSet, fragments, make(map[string]bool)); depth > Parameters.MaxDepth {
+				return errors.Errorf("query has a depth of %d [max %d]", depth, Parameters.MaxDepth)
+			}
+		}
+	}
+
+	return nil
+}
+
+// selectionDepth returns the maximum nesting depth of the fields of the given SelectionSet (the fragments that are
+// currently expanded are tracked, so that cyclic fragments are not followed).
+func selectionDepth(selectionSet *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition, expandedFragments map[string]bool) (depth int) {
+	if selectionSet == nil {
+		return 0
+	}
+
+	for _, selection := range selectionSet.Selections {
+		var selectionDepthValue int
+
+		switch typedSelection := selection.(type) {
+		case *ast.Field:
+			selectionDepthValue = 1 + selectionDepth(typedSelection.SelectionSet, fragments, expandedFragments)
+		case *ast.InlineFragment:
+			selectionDepthValue = selectionDepth(typedSelection.SelectionSet, fragments, expandedFragments)
+		case *ast.FragmentSpread:
+			fragment, exists := fragments[typedSelection.Name.Value]
+			if !exists || expandedFragments[typedSelection.Name.Value] {
+				continue
+			}
+
+			expandedFragments[typedSelection.Name.Value] = true
+			selectionDepthValue = selectionDepth(fragment.SelectionSet, fragments, expandedFragments)
+			delete(expandedFragments, typedSelection.Name.Value)
+		}
+
+		if selectionDepthValue > depth {
+			depth = selectionDepthValue
+		}
+	}
+
+	return depth
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
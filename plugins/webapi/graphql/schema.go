@@ -0,0 +1,589 @@
+package graphql
+
+import (
+	"strconv"
+
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/crypto/identity"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+const (
+	// defaultOutputsLimit contains the number of outputs of an address that are returned if no limit is requested.
+	defaultOutputsLimit = 100
+
+	// maxOutputsLimit contains the maximum number of outputs of an address that are returned by a single field.
+	maxOutputsLimit = 1000
+)
+
+// region Schema ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// newSchema creates the GraphQL schema that exposes the blocks, transactions, outputs, addresses, conflicts and mana
+// of the node. The types reference each other, so that related entities can be traversed in a single query.
+func newSchema() (schema graphqlgo.Schema, err error) {
+	longType := graphqlgo.NewScalar(graphqlgo.ScalarConfig{
+		Name:        "Long",
+		Description: "A 64-bit integer.",
+		Serialize: func(value interface{}) interface{} {
+			return value
+		},
+		ParseValue: func(value interface{}) interface{} {
+			return value
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			if intValue, isIntValue := valueAST.(*ast.IntValue); isIntValue {
+				if parsedValue, parseErr := strconv.ParseInt(intValue.Value, 10, 64); parseErr == nil {
+					return parsedValue
+				}
+			}
+
+			return nil
+		},
+	})
+
+	var blockType, transactionType, outputType, addressType, conflictType *graphqlgo.Object
+
+	balanceType := graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Balance",
+		Fields: graphqlgo.Fields{
+			"color":      &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String)},
+			"balance":    &graphqlgo.Field{Type: graphqlgo.NewNonNull(longType)},
+			"pendingIn":  &graphqlgo.Field{Type: longType},
+			"pendingOut": &graphqlgo.Field{Type: longType},
+		},
+	})
+
+	manaType := graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Mana",
+		Fields: graphqlgo.Fields{
+			"issuerID": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+				return p.Source.(identity.ID).EncodeBase58(), nil
+			}},
+			"access": &graphqlgo.Field{Type: graphqlgo.NewNonNull(longType), Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+				return lo.Return1(deps.Protocol.Engine().ThroughputQuota.Balance(p.Source.(identity.ID))), nil
+			}},
+			"consensus": &graphqlgo.Field{Type: graphqlgo.NewNonNull(longType), Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+				if weight, exists := deps.Protocol.Engine().SybilProtection.Weights().Get(p.Source.(identity.ID)); exists {
+					return weight.Value, nil
+				}
+
+				return int64(0), nil
+			}},
+		},
+	})
+
+	blockType = graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Block",
+		Fields: graphqlgo.FieldsThunk(func() graphqlgo.Fields {
+			return graphqlgo.Fields{
+				"id": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.ID().Base58()
+				})},
+				"issuer": &graphqlgo.Field{Type: manaType, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Block.IssuerID()
+				})},
+				"issuingTime": &graphqlgo.Field{Type: graphqlgo.DateTime, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Block.IssuingTime()
+				})},
+				"sequenceNumber": &graphqlgo.Field{Type: longType, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Block.SequenceNumber()
+				})},
+				"slotIndex": &graphqlgo.Field{Type: longType, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return int64(blockMetadata.M.Block.ID().Index())
+				})},
+				"payloadType": &graphqlgo.Field{Type: graphqlgo.String, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Block.Payload().Type().String()
+				})},
+				"transaction": &graphqlgo.Field{Type: transactionType, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					if transaction, isTransaction := blockMetadata.M.Block.Payload().(*devnetvm.Transaction); isTransaction {
+						return transaction
+					}
+
+					return nil
+				})},
+				"strongParents": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blocks(blockMetadata.M.Block.ParentsByType(models.StrongParentType))
+				})},
+				"weakParents": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blocks(blockMetadata.M.Block.ParentsByType(models.WeakParentType))
+				})},
+				"shallowLikeParents": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blocks(blockMetadata.M.Block.ParentsByType(models.ShallowLikeParentType))
+				})},
+				"strongChildren": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blocks(blockMetadata.M.StrongChildren)
+				})},
+				"weakChildren": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blocks(blockMetadata.M.WeakChildren)
+				})},
+				"conflicts": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return conflicts(blockMetadata.M.ConflictIDs)
+				})},
+				"booked": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Booked
+				})},
+				"scheduled": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Scheduled
+				})},
+				"accepted": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Accepted
+				})},
+				"confirmed": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Confirmed || blockMetadata.M.ConfirmedBySlot
+				})},
+				"orphaned": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveBlock(func(blockMetadata *retainer.BlockMetadata) interface{} {
+					return blockMetadata.M.Orphaned
+				})},
+			}
+		}),
+	})
+
+	transactionType = graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphqlgo.FieldsThunk(func() graphqlgo.Fields {
+			return graphqlgo.Fields{
+				"id": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					return transaction.ID().Base58()
+				})},
+				"timestamp": &graphqlgo.Field{Type: graphqlgo.DateTime, Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					return transaction.Essence().Timestamp()
+				})},
+				"accessPledge": &graphqlgo.Field{Type: manaType, Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					return transaction.Essence().AccessPledgeID()
+				})},
+				"consensusPledge": &graphqlgo.Field{Type: manaType, Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					return transaction.Essence().ConsensusPledgeID()
+				})},
+				"inputs": &graphqlgo.Field{Type: graphqlgo.NewList(outputType), Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					inputs := make([]interface{}, 0, len(transaction.Essence().Inputs()))
+					for _, input := range transaction.Essence().Inputs() {
+						if utxoInput, isUTXOInput := input.(*devnetvm.UTXOInput); isUTXOInput {
+							if output, exists := outputByID(utxoInput.ReferencedOutputID()); exists {
+								inputs = append(inputs, output)
+							}
+						}
+					}
+
+					return inputs
+				})},
+				"outputs": &graphqlgo.Field{Type: graphqlgo.NewList(outputType), Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					outputs := make([]interface{}, 0, len(transaction.Essence().Outputs()))
+					for i := range transaction.Essence().Outputs() {
+						if output, exists := outputByID(utxo.NewOutputID(transaction.ID(), uint16(i))); exists {
+							outputs = append(outputs, output)
+						}
+					}
+
+					return outputs
+				})},
+				"booked": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveTransactionMetadata(func(transactionMetadata *mempool.TransactionMetadata) interface{} {
+					return transactionMetadata.IsBooked()
+				})},
+				"confirmationState": &graphqlgo.Field{Type: graphqlgo.String, Resolve: resolveTransactionMetadata(func(transactionMetadata *mempool.TransactionMetadata) interface{} {
+					return transactionMetadata.ConfirmationState().String()
+				})},
+				"inclusionSlot": &graphqlgo.Field{Type: longType, Resolve: resolveTransactionMetadata(func(transactionMetadata *mempool.TransactionMetadata) interface{} {
+					return int64(transactionMetadata.InclusionSlot())
+				})},
+				"conflicts": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveTransactionMetadata(func(transactionMetadata *mempool.TransactionMetadata) interface{} {
+					return conflicts(transactionMetadata.ConflictIDs())
+				})},
+				"attachments": &graphqlgo.Field{Type: graphqlgo.NewList(blockType), Resolve: resolveTransaction(func(transaction *devnetvm.Transaction) interface{} {
+					blockIDs := models.NewBlockIDs()
+					_ = deps.Protocol.Engine().Tangle.Booker().GetAllAttachments(transaction.ID()).ForEach(func(attachment *booker.Block) error {
+						blockIDs.Add(attachment.ID())
+						return nil
+					})
+
+					return blocks(blockIDs)
+				})},
+			}
+		}),
+	})
+
+	outputType = graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Output",
+		Fields: graphqlgo.FieldsThunk(func() graphqlgo.Fields {
+			return graphqlgo.Fields{
+				"id": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					return output.ID().Base58()
+				})},
+				"type": &graphqlgo.Field{Type: graphqlgo.String, Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					return output.Type().String()
+				})},
+				"address": &graphqlgo.Field{Type: addressType, Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					return output.Address()
+				})},
+				"balances": &graphqlgo.Field{Type: graphqlgo.NewList(balanceType), Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					balances := make([]*jsonmodels.ColorBalance, 0)
+					output.Balances().ForEach(func(color devnetvm.Color, balance uint64) bool {
+						balances = append(balances, &jsonmodels.ColorBalance{Color: color.Base58(), Balance: balance})
+						return true
+					})
+
+					return balances
+				})},
+				"transaction": &graphqlgo.Field{Type: transactionType, Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					if transaction, exists := transactionByID(output.ID().TransactionID); exists {
+						return transaction
+					}
+
+					return nil
+				})},
+				"spent": &graphqlgo.Field{Type: graphqlgo.Boolean, Resolve: resolveOutputMetadata(func(outputMetadata *mempool.OutputMetadata) interface{} {
+					return outputMetadata.IsSpent()
+				})},
+				"confirmationState": &graphqlgo.Field{Type: graphqlgo.String, Resolve: resolveOutputMetadata(func(outputMetadata *mempool.OutputMetadata) interface{} {
+					return outputMetadata.ConfirmationState().String()
+				})},
+				"conflicts": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveOutputMetadata(func(outputMetadata *mempool.OutputMetadata) interface{} {
+					return conflicts(outputMetadata.ConflictIDs())
+				})},
+				"consumers": &graphqlgo.Field{Type: graphqlgo.NewList(transactionType), Resolve: resolveOutput(func(output devnetvm.Output) interface{} {
+					consumers := make([]interface{}, 0)
+					deps.Protocol.Engine().Ledger.MemPool().Storage().CachedConsumers(output.ID()).Consume(func(consumer *mempool.Consumer) {
+						if transaction, exists := transactionByID(consumer.TransactionID()); exists {
+							consumers = append(consumers, transaction)
+						}
+					})
+
+					return consumers
+				})},
+			}
+		}),
+	})
+
+	addressType = graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Address",
+		Fields: graphqlgo.FieldsThunk(func() graphqlgo.Fields {
+			return graphqlgo.Fields{
+				"address": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					return p.Source.(devnetvm.Address).Base58(), nil
+				}},
+				"type": &graphqlgo.Field{Type: graphqlgo.String, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					return p.Source.(devnetvm.Address).Type().String(), nil
+				}},
+				"balances": &graphqlgo.Field{Type: graphqlgo.NewList(balanceType), Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					address := p.Source.(devnetvm.Address)
+
+					balances, err := deps.Indexer.Balances(p.Context, address)
+					if err != nil {
+						return nil, err
+					}
+
+					return jsonmodels.NewGetAddressBalancesResponse(address, balances).Balances, nil
+				}},
+				"outputs": &graphqlgo.Field{
+					Type:        graphqlgo.NewList(outputType),
+					Description: "The outputs of the address in the order of their IDs.",
+					Args: graphqlgo.FieldConfigArgument{
+						"spent":  &graphqlgo.ArgumentConfig{Type: graphqlgo.Boolean, Description: "only return spent (true) or unspent (false) outputs"},
+						"cursor": &graphqlgo.ArgumentConfig{Type: graphqlgo.String, Description: "the ID of the output after which the outputs start"},
+						"limit":  &graphqlgo.ArgumentConfig{Type: graphqlgo.Int, DefaultValue: defaultOutputsLimit, Description: "the maximum number of returned outputs"},
+					},
+					Resolve: resolveAddressOutputs,
+				},
+			}
+		}),
+	})
+
+	conflictType = graphqlgo.NewObject(graphqlgo.ObjectConfig{
+		Name: "Conflict",
+		Fields: graphqlgo.FieldsThunk(func() graphqlgo.Fields {
+			return graphqlgo.Fields{
+				"id": &graphqlgo.Field{Type: graphqlgo.NewNonNull(graphqlgo.String), Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					return conflict.ID().Base58()
+				})},
+				"confirmationState": &graphqlgo.Field{Type: graphqlgo.String, Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					return conflict.ConfirmationState().String()
+				})},
+				"voterWeight": &graphqlgo.Field{Type: longType, Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					return deps.Protocol.Engine().Tangle.Booker().VirtualVoting().ConflictVotersTotalWeight(conflict.ID())
+				})},
+				"transaction": &graphqlgo.Field{Type: transactionType, Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					if transaction, exists := transactionByID(conflict.ID()); exists {
+						return transaction
+					}
+
+					return nil
+				})},
+				"parents": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					return conflicts(conflict.Parents())
+				})},
+				"children": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					children := make([]interface{}, 0)
+					for it := conflict.Children().Iterator(); it.HasNext(); {
+						children = append(children, it.Next())
+					}
+
+					return children
+				})},
+				"conflictingConflicts": &graphqlgo.Field{Type: graphqlgo.NewList(conflictType), Resolve: resolveConflict(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{} {
+					conflictingConflicts := make([]interface{}, 0)
+					conflict.ForEachConflictingConflict(func(conflictingConflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) bool {
+						conflictingConflicts = append(conflictingConflicts, conflictingConflict)
+						return true
+					})
+
+					return conflictingConflicts
+				})},
+			}
+		}),
+	})
+
+	idArgument := graphqlgo.FieldConfigArgument{
+		"id": &graphqlgo.ArgumentConfig{Type: graphqlgo.NewNonNull(graphqlgo.String), Description: "the base58 encoded identifier"},
+	}
+
+	return graphqlgo.NewSchema(graphqlgo.SchemaConfig{
+		Query: graphqlgo.NewObject(graphqlgo.ObjectConfig{
+			Name: "Query",
+			Fields: graphqlgo.Fields{
+				"block": &graphqlgo.Field{Type: blockType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					var blockID models.BlockID
+					if err := blockID.FromBase58(p.Args["id"].(string)); err != nil {
+						return nil, errors.Wrap(err, "invalid block ID")
+					}
+
+					if blockMetadata, exists := blockByID(blockID); exists {
+						return blockMetadata, nil
+					}
+
+					return nil, nil
+				}},
+				"transaction": &graphqlgo.Field{Type: transactionType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					var transactionID utxo.TransactionID
+					if err := transactionID.FromBase58(p.Args["id"].(string)); err != nil {
+						return nil, errors.Wrap(err, "invalid transaction ID")
+					}
+
+					if transaction, exists := transactionByID(transactionID); exists {
+						return transaction, nil
+					}
+
+					return nil, nil
+				}},
+				"output": &graphqlgo.Field{Type: outputType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					var outputID utxo.OutputID
+					if err := outputID.FromBase58(p.Args["id"].(string)); err != nil {
+						return nil, errors.Wrap(err, "invalid output ID")
+					}
+
+					if output, exists := outputByID(outputID); exists {
+						return output, nil
+					}
+
+					return nil, nil
+				}},
+				"address": &graphqlgo.Field{Type: addressType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					address, err := devnetvm.AddressFromBase58EncodedString(p.Args["id"].(string))
+					if err != nil {
+						return nil, errors.Wrap(err, "invalid address")
+					}
+
+					return address, nil
+				}},
+				"conflict": &graphqlgo.Field{Type: conflictType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					var conflictID utxo.TransactionID
+					if err := conflictID.FromBase58(p.Args["id"].(string)); err != nil {
+						return nil, errors.Wrap(err, "invalid conflict ID")
+					}
+
+					if conflict, exists := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Conflict(conflictID); exists {
+						return conflict, nil
+					}
+
+					return nil, nil
+				}},
+				"mana": &graphqlgo.Field{Type: manaType, Args: idArgument, Resolve: func(p graphqlgo.ResolveParams) (interface{}, error) {
+					issuerID, err := identity.DecodeIDBase58(p.Args["id"].(string))
+					if err != nil {
+						return nil, errors.Wrap(err, "invalid issuer ID")
+					}
+
+					return issuerID, nil
+				}},
+			},
+		}),
+	})
+}
+
+// resolveAddressOutputs resolves a page of the outputs of an address.
+func resolveAddressOutputs(p graphqlgo.ResolveParams) (interface{}, error) {
+	var cursor utxo.OutputID
+	if encodedCursor, exists := p.Args["cursor"].(string); exists && encodedCursor != "" {
+		if err := cursor.FromBase58(encodedCursor); err != nil {
+			return nil, errors.Wrap(err, "invalid cursor")
+		}
+	}
+
+	limit := p.Args["limit"].(int)
+	if limit <= 0 || limit > maxOutputsLimit {
+		return nil, errors.Errorf("limit must be between 1 and %d", maxOutputsLimit)
+	}
+
+	spentFilter, filterSpent := p.Args["spent"].(bool)
+
+	outputs := make([]interface{}, 0)
+	if err := deps.Indexer.ForEachAddressOutputID(p.Context, p.Source.(devnetvm.Address), cursor, func(outputID utxo.OutputID) bool {
+		if filterSpent {
+			outputMetadata, exists := outputMetadataByID(outputID)
+			if !exists || outputMetadata.IsSpent() != spentFilter {
+				return true
+			}
+		}
+
+		if output, exists := outputByID(outputID); exists {
+			outputs = append(outputs, output)
+		}
+
+		return len(outputs) < limit
+	}); err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Resolvers ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// resolveBlock returns a resolver that derives the value of a field from the source block.
+func resolveBlock(resolver func(blockMetadata *retainer.BlockMetadata) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (interface{}, error) {
+		blockMetadata := p.Source.(*retainer.BlockMetadata)
+		if blockMetadata.M.Block == nil {
+			return nil, errors.Errorf("block %s is not available", blockMetadata.ID())
+		}
+
+		return resolver(blockMetadata), nil
+	}
+}
+
+// resolveTransaction returns a resolver that derives the value of a field from the source transaction.
+func resolveTransaction(resolver func(transaction *devnetvm.Transaction) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (interface{}, error) {
+		return resolver(p.Source.(*devnetvm.Transaction)), nil
+	}
+}
+
+// resolveTransactionMetadata returns a resolver that derives the value of a field from the metadata of the source
+// transaction (the field is null if the metadata is not available).
+func resolveTransactionMetadata(resolver func(transactionMetadata *mempool.TransactionMetadata) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (value interface{}, err error) {
+		deps.Protocol.Engine().Ledger.MemPool().Storage().CachedTransactionMetadata(p.Source.(*devnetvm.Transaction).ID()).Consume(func(transactionMetadata *mempool.TransactionMetadata) {
+			value = resolver(transactionMetadata)
+		})
+
+		return value, nil
+	}
+}
+
+// resolveOutput returns a resolver that derives the value of a field from the source output.
+func resolveOutput(resolver func(output devnetvm.Output) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (interface{}, error) {
+		return resolver(p.Source.(devnetvm.Output)), nil
+	}
+}
+
+// resolveOutputMetadata returns a resolver that derives the value of a field from the metadata of the source output
+// (the field is null if the metadata is not available).
+func resolveOutputMetadata(resolver func(outputMetadata *mempool.OutputMetadata) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (interface{}, error) {
+		if outputMetadata, exists := outputMetadataByID(p.Source.(devnetvm.Output).ID()); exists {
+			return resolver(outputMetadata), nil
+		}
+
+		return nil, nil
+	}
+}
+
+// resolveConflict returns a resolver that derives the value of a field from the source conflict.
+func resolveConflict(resolver func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) interface{}) graphqlgo.FieldResolveFn {
+	return func(p graphqlgo.ResolveParams) (interface{}, error) {
+		return resolver(p.Source.(*conflictdag.Conflict[utxo.TransactionID, utxo.OutputID])), nil
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Loaders //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// blockByID returns the retained metadata of the block with the given ID.
+func blockByID(blockID models.BlockID) (blockMetadata *retainer.BlockMetadata, exists bool) {
+	if blockMetadata, exists = deps.Retainer.BlockMetadata(blockID); !exists || blockMetadata.M.Block == nil {
+		return nil, false
+	}
+
+	return blockMetadata, true
+}
+
+// blocks returns the retained metadata of the blocks with the given IDs (unknown blocks are skipped).
+func blocks(blockIDs models.BlockIDs) (blocksMetadata []interface{}) {
+	blocksMetadata = make([]interface{}, 0, len(blockIDs))
+	for blockID := range blockIDs {
+		if blockMetadata, exists := blockByID(blockID); exists {
+			blocksMetadata = append(blocksMetadata, blockMetadata)
+		}
+	}
+
+	return blocksMetadata
+}
+
+// transactionByID returns the transaction with the given ID.
+func transactionByID(transactionID utxo.TransactionID) (transaction *devnetvm.Transaction, exists bool) {
+	deps.Protocol.Engine().Ledger.MemPool().Storage().CachedTransaction(transactionID).Consume(func(storedTransaction utxo.Transaction) {
+		transaction, exists = storedTransaction.(*devnetvm.Transaction)
+	})
+
+	return transaction, exists
+}
+
+// outputByID returns the output with the given ID.
+func outputByID(outputID utxo.OutputID) (output devnetvm.Output, exists bool) {
+	deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(storedOutput utxo.Output) {
+		output, exists = storedOutput.(devnetvm.Output)
+	})
+
+	return output, exists
+}
+
+// outputMetadataByID returns the metadata of the output with the given ID.
+func outputMetadataByID(outputID utxo.OutputID) (outputMetadata *mempool.OutputMetadata, exists bool) {
+	deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(storedOutputMetadata *mempool.OutputMetadata) {
+		outputMetadata, exists = storedOutputMetadata, true
+	})
+
+	return outputMetadata, exists
+}
+
+// conflicts returns the conflicts with the given IDs (unknown conflicts are skipped).
+func conflicts(conflictIDs *advancedset.AdvancedSet[utxo.TransactionID]) (conflictsList []interface{}) {
+	conflictsList = make([]interface{}, 0)
+	if conflictIDs == nil {
+		return conflictsList
+	}
+
+	for it := conflictIDs.Iterator(); it.HasNext(); {
+		if conflict, exists := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Conflict(it.Next()); exists {
+			conflictsList = append(conflictsList, conflict)
+		}
+	}
+
+	return conflictsList
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
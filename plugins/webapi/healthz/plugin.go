@@ -7,7 +7,6 @@ import (
 	"github.com/labstack/echo/v4"
 	"go.uber.org/dig"
 
-	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/hive.go/app/daemon"
@@ -30,7 +29,7 @@ var (
 )
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(node.AllComponents)
 }
 
 func configure(_ *node.Plugin) {
@@ -38,7 +37,7 @@ func configure(_ *node.Plugin) {
 }
 
 func run(plugin *node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, worker, shutdown.PriorityHealthz); err != nil {
+	if err := daemon.BackgroundWorker(PluginName, worker, Plugin.ShutdownOrder()); err != nil {
 		plugin.Panicf("Failed to start as daemon: %s", err)
 	}
 }
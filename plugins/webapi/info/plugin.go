@@ -98,6 +98,14 @@ func run(plugin *node.Plugin) {
 //			"RemoteLog",
 //			"Spammer",
 //			"WebAPIAuth"
+//		],
+//		"plugins":[
+//			{
+//				"name":"WebAPIInfoEndpoint",
+//				"version":"v0.2.0",
+//				"endpoints":["GET /info"]
+//			},
+//			...
 //		]
 //	}
 func getInfo(c echo.Context) error {
@@ -167,6 +175,7 @@ func getInfo(c echo.Context) error {
 		TotalBlockCount:       int(dashboardmetrics.BlockCountSinceStartPerComponentGrafana()[collector.Attached]),
 		EnabledPlugins:        enabledPlugins,
 		DisabledPlugins:       disabledPlugins,
+		Plugins:               pluginInfos(),
 		Mana:                  nodeMana,
 		Scheduler: jsonmodels.Scheduler{
 			Running:           scheduler.IsRunning(),
@@ -182,3 +191,43 @@ func getInfo(c echo.Context) error {
 		},
 	})
 }
+
+// pluginInfos returns the versions and capabilities of the enabled plugins (sorted by their name). The endpoints of the
+// web API are attributed to the plugin that is defined in the same package as the handler of the route.
+func pluginInfos() (infos []jsonmodels.PluginInfo) {
+	pluginsByPackagePath := make(map[string]*node.Plugin)
+	endpointsByPlugin := make(map[*node.Plugin][]string)
+	for _, plugin := range node.GetPlugins() {
+		if !node.IsSkipped(plugin) {
+			pluginsByPackagePath[plugin.PackagePath()] = plugin
+			endpointsByPlugin[plugin] = plugin.Capabilities().Endpoints
+		}
+	}
+
+	for _, route := range deps.Server.Routes() {
+		if plugin, exists := pluginsByPackagePath[node.FunctionPackagePath(route.Name)]; exists {
+			endpointsByPlugin[plugin] = append(endpointsByPlugin[plugin], route.Method+" "+route.Path)
+		}
+	}
+
+	for plugin, endpoints := range endpointsByPlugin {
+		version := plugin.Version
+		if version == "" {
+			version = banner.AppVersion
+		}
+		sort.Strings(endpoints)
+
+		infos = append(infos, jsonmodels.PluginInfo{
+			Name:         plugin.Name,
+			Version:      version,
+			Endpoints:    endpoints,
+			PayloadTypes: plugin.Capabilities().PayloadTypes,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	return infos
+}
@@ -1,33 +1,51 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/app/addressfeed"
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
 	"github.com/iotaledger/goshimmer/packages/app/blockissuer"
+	"github.com/iotaledger/goshimmer/packages/app/confirmationfeed"
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/mempoolview"
+	"github.com/iotaledger/goshimmer/packages/app/retainer"
+	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm/indexer"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/notarization"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/tangle/booker"
 	"github.com/iotaledger/goshimmer/packages/protocol/models"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
 	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/advancedset"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
 )
 
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -36,6 +54,44 @@ import (
 const (
 	PluginName                       = "WebAPILedgerstateEndpoint"
 	DoubleSpendFilterCleanupInterval = 10 * time.Second
+
+	// defaultUnspentOutputsLimit contains the number of unspent outputs that are returned if no limit is requested.
+	defaultUnspentOutputsLimit = 100
+
+	// maxUnspentOutputsLimit contains the maximum number of unspent outputs that are returned by a single request.
+	maxUnspentOutputsLimit = 1000
+
+	// maxAddressOutputsLimit contains the maximum number of outputs of an address that are returned by a single request
+	// (it is also used if no limit is requested).
+	maxAddressOutputsLimit = 1000
+
+	// defaultPendingConflictsLimit contains the number of pending conflict sets that are returned if no limit is requested.
+	defaultPendingConflictsLimit = 100
+
+	// maxPendingConflictsLimit contains the maximum number of pending conflict sets that are returned by a single request.
+	maxPendingConflictsLimit = 1000
+
+	// defaultTransactionOutcomesLimit contains the number of simulated outcomes that are returned if no limit is requested.
+	defaultTransactionOutcomesLimit = 64
+
+	// maxTransactionOutcomesLimit contains the maximum number of simulated outcomes that are returned by a single request.
+	maxTransactionOutcomesLimit = 1024
+
+	// defaultMempoolLimit contains the number of pending transactions that are returned if no limit is requested.
+	defaultMempoolLimit = 100
+
+	// maxMempoolLimit contains the maximum number of pending transactions that are returned by a single request.
+	maxMempoolLimit = 1000
+
+	// maxOutputIDs contains the maximum number of outputs that can be requested by a single bulk lookup.
+	maxOutputIDs = 1000
+
+	// subscriptionWriteTimeout contains the timeout for writing an event to the websocket of an address subscription.
+	subscriptionWriteTimeout = 3 * time.Second
+
+	// confirmedStreamKeepAliveInterval contains the interval in which comments are written to idle confirmed
+	// transaction streams (failing writes reveal disconnected clients).
+	confirmedStreamKeepAliveInterval = 15 * time.Second
 )
 
 type dependencies struct {
@@ -45,6 +101,8 @@ type dependencies struct {
 	Protocol    *protocol.Protocol
 	BlockIssuer *blockissuer.BlockIssuer
 	Indexer     *indexer.Indexer
+	Retainer    *retainer.Retainer
+	Artifacts   *artifacts.Registry
 }
 
 var (
@@ -65,11 +123,26 @@ var (
 	// Hook to the transaction confirmation event.
 	onTransactionAccepted *event.Hook[func(*mempool.TransactionEvent)]
 
+	// addressFeed distributes the activity of the addresses to their subscriptions.
+	addressFeed = addressfeed.New()
+
+	// confirmationFeed distributes the confirmed transactions to the confirmed transaction streams.
+	confirmationFeed *confirmationfeed.Feed
+
+	// mempoolView keeps track of the transactions that are neither accepted nor rejected yet.
+	mempoolView *mempoolview.View
+
+	// subscriptionUpgrader upgrades the connections of the address subscriptions to websockets.
+	subscriptionUpgrader = websocket.Upgrader{
+		HandshakeTimeout: subscriptionWriteTimeout,
+		CheckOrigin:      func(r *http.Request) bool { return true },
+	}
+
 	log *logger.Logger
 )
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentWebAPI, shutdown.ComponentProtocol)
 }
 
 // Filter returns the double spend filter singleton.
@@ -93,6 +166,11 @@ func FilterHasConflict(outputs devnetvm.Inputs) (bool, utxo.TransactionID) {
 func FilterAdd(tx *devnetvm.Transaction) {
 	if filterEnabled {
 		doubleSpendFilter.Add(tx)
+
+		txID := tx.ID()
+		deps.Artifacts.Track(artifacts.KindLock, txID.Base58(), DoubleSpendFilterCleanupInterval, func() {
+			doubleSpendFilter.Remove(txID)
+		})
 	}
 }
 
@@ -100,6 +178,7 @@ func FilterAdd(tx *devnetvm.Transaction) {
 func FilterRemove(txID utxo.TransactionID) {
 	if filterEnabled {
 		doubleSpendFilter.Remove(txID)
+		deps.Artifacts.Remove(artifacts.KindLock, txID.Base58())
 	}
 }
 
@@ -111,31 +190,161 @@ func configure(plugin *node.Plugin) {
 		}, event.WithWorkerPool(plugin.WorkerPool))
 	}
 
+	deps.Indexer.Events.OutputIndexed.Hook(func(event *indexer.OutputEvent) {
+		addressFeed.OutputCreated(event.Output, event.Addresses...)
+	})
+	deps.Indexer.Events.OutputRemoved.Hook(func(event *indexer.OutputEvent) {
+		addressFeed.OutputRemoved(event.Output, event.Addresses...)
+	})
+	deps.Indexer.Events.FallbackOutputExpired.Hook(func(output *devnetvm.ExtendedLockedOutput) {
+		addressFeed.FallbackExpired(output, output.FallbackAddress())
+	})
+
+	mempoolView = mempoolview.New(mempoolview.WithMaxSize(webapi.Parameters.MempoolSize))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+		deps.Protocol.Engine().Ledger.MemPool().Storage().CachedTransaction(event.TransactionID).Consume(func(transaction utxo.Transaction) {
+			mempoolView.Add(transaction.(*devnetvm.Transaction), time.Now())
+		})
+	})
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		mempoolView.Accepted(event.Metadata.ID())
+	})
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionRejected.Hook(func(transactionMetadata *mempool.TransactionMetadata) {
+		mempoolView.Rejected(transactionMetadata.ID())
+	})
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionOrphaned.Hook(func(event *mempool.TransactionEvent) {
+		mempoolView.Orphaned(event.Metadata.ID())
+	})
+
+	configureConfirmationFeed(plugin)
+
 	log = logger.NewLogger(PluginName)
 }
 
+// configureConfirmationFeed creates the confirmationFeed and feeds it with the transactions that reach the configured
+// grade of finality.
+func configureConfirmationFeed(plugin *node.Plugin) {
+	var requireSlotConfirmation bool
+	switch webapi.Parameters.ConfirmedTransactions.ConfirmationState {
+	case "accepted":
+	case "confirmed":
+		requireSlotConfirmation = true
+	default:
+		plugin.LogFatalfAndExitf("invalid confirmation state '%s' of the confirmed transactions stream (accepted or confirmed)", webapi.Parameters.ConfirmedTransactions.ConfirmationState)
+	}
+
+	confirmationFeed = confirmationfeed.New(
+		confirmationfeed.WithMaxEvents(webapi.Parameters.ConfirmedTransactions.MaxEvents),
+		confirmationfeed.WithMaxPendingEvents(webapi.Parameters.ConfirmedTransactions.MaxPendingEvents),
+		confirmationfeed.WithRequireSlotConfirmation(requireSlotConfirmation),
+	)
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		transactionID := event.Metadata.ID()
+		confirmationFeed.TransactionAccepted(transactionID, event.Metadata.InclusionSlot(), transactionAddresses(transactionID))
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Consensus.SlotGadget.SlotConfirmed.Hook(func(index slot.Index) {
+		confirmationFeed.SlotConfirmed(index)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+}
+
+// transactionAddresses returns the base58 encoded addresses of the inputs and outputs of the given transaction.
+func transactionAddresses(transactionID utxo.TransactionID) (addresses []string) {
+	addressSet := make(map[string]bool)
+
+	memPoolStorage := deps.Protocol.Engine().Ledger.MemPool().Storage()
+	memPoolStorage.CachedTransaction(transactionID).Consume(func(transaction utxo.Transaction) {
+		devnetTransaction, isDevnetTransaction := transaction.(*devnetvm.Transaction)
+		if !isDevnetTransaction {
+			return
+		}
+
+		for _, input := range devnetTransaction.Essence().Inputs() {
+			if utxoInput, isUTXOInput := input.(*devnetvm.UTXOInput); isUTXOInput {
+				memPoolStorage.CachedOutput(utxoInput.ReferencedOutputID()).Consume(func(output utxo.Output) {
+					if devnetOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+						addressSet[devnetOutput.Address().Base58()] = true
+					}
+				})
+			}
+		}
+
+		for _, output := range devnetTransaction.Essence().Outputs() {
+			addressSet[output.Address().Base58()] = true
+		}
+	})
+
+	addresses = make([]string, 0, len(addressSet))
+	for address := range addressSet {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
 func run(*node.Plugin) {
 	if filterEnabled {
-		if err := daemon.BackgroundWorker("WebAPIDoubleSpendFilter", worker, shutdown.PriorityWebAPI); err != nil {
+		if err := daemon.BackgroundWorker("WebAPIDoubleSpendFilter", worker, Plugin.ShutdownOrder()); err != nil {
 			log.Panicf("Failed to start as daemon: %s", err)
 		}
 	}
 
+	if err := daemon.BackgroundWorker("WebAPIAddressFeed", func(ctx context.Context) {
+		<-ctx.Done()
+
+		addressFeed.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+
+	if err := daemon.BackgroundWorker("WebAPIConfirmationFeed", func(ctx context.Context) {
+		<-ctx.Done()
+
+		confirmationFeed.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+
 	// register endpoints
 	deps.Server.GET("ledgerstate/addresses/:address", GetAddress)
+	deps.Server.GET("ledgerstate/addresses/:address/spendable", GetAddressSpendableOutputs)
+	deps.Server.GET("ledgerstate/addresses/:address/expiredFallbackOutputs", GetAddressExpiredFallbackOutputs)
+	deps.Server.GET("ledgerstate/addresses/:address/balances", GetAddressBalances)
+	deps.Server.GET("ledgerstate/addresses/:address/subscribe", SubscribeAddress)
 	deps.Server.POST("ledgerstate/addresses/unspentOutputs", PostAddressUnspentOutputs)
+	deps.Server.GET("ledgerstate/mempool", GetMempool)
+	deps.Server.GET("ledgerstate/conflicts/pending", GetPendingConflicts)
+	deps.Server.GET("ledgerstate/conflicts/dot", GetConflictsDOT)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID", GetConflict)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID/children", GetConflictChildren)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID/conflicts", GetConflictConflicts)
+	deps.Server.GET("ledgerstate/conflicts/:conflictID/liked", GetConflictLiked)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID/voters", GetConflictVoters)
 	deps.Server.GET("ledgerstate/conflicts/:conflictID/sequenceids", GetConflictSequenceIDs)
+	deps.Server.GET("ledgerstate/outputs/unspent", GetLedgerUnspentOutputs)
+	deps.Server.GET("ledgerstate/outputs/unspent/export", ExportLedgerUnspentOutputs)
+	deps.Server.POST("ledgerstate/outputs", PostOutputs)
 	deps.Server.GET("ledgerstate/outputs/:outputID", GetOutput)
 	deps.Server.GET("ledgerstate/outputs/:outputID/consumers", GetOutputConsumers)
 	deps.Server.GET("ledgerstate/outputs/:outputID/metadata", GetOutputMetadata)
+	deps.Server.GET("ledgerstate/outputs/:outputID/vesting", GetOutputVesting)
+	deps.Server.GET("ledgerstate/outputs/:outputID/proof", GetOutputProof)
+	deps.Server.POST("ledgerstate/outputs/minimum-deposit", GetOutputMinimumDeposit)
+	deps.Server.GET("ledgerstate/transactions/confirmed/stream", StreamConfirmedTransactions)
 	deps.Server.GET("ledgerstate/transactions/:transactionID", GetTransaction)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/metadata", GetTransactionMetadata)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/attachments", GetTransactionAttachments)
+	deps.Server.POST("ledgerstate/transactions/:transactionID/simulate-outcomes", SimulateTransactionOutcomes)
 	deps.Server.POST("ledgerstate/transactions", PostTransaction)
+	deps.Server.POST("ledgerstate/transactions/dryrun", DryRunTransaction)
+	deps.Server.GET("ledgerstate/caches", GetLedgerCaches)
+	deps.Server.PUT("ledgerstate/caches/:storageName", PutLedgerCache)
+	deps.Server.GET("ledgerstate/stats", GetLedgerStats)
+	deps.Server.GET("ledgerstate/colors", GetColorSupplies)
+	deps.Server.GET("ledgerstate/alias-chains/:aliasAddress", GetAliasChain)
+	deps.Server.GET("ledgerstate/aliases", GetAliases)
+	deps.Server.POST("ledgerstate/aliases", PostAlias)
 }
 
 func worker(ctx context.Context) {
@@ -159,41 +368,284 @@ func worker(ctx context.Context) {
 	}
 }
 
-func outputsOnAddress(address devnetvm.Address) (outputs devnetvm.Outputs) {
-	deps.Indexer.CachedAddressOutputMappings(address).Consume(func(mapping *indexer.AddressOutputMapping) {
+// outputsOnAddress returns the outputs on the given address. The lookup is aborted with the error of the context if the
+// context is canceled (e.g. because the request timed out or the client disconnected).
+func outputsOnAddress(ctx context.Context, address devnetvm.Address) (outputs devnetvm.Outputs, err error) {
+	cachedAddressOutputMappings, err := deps.Indexer.CachedAddressOutputMappings(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedAddressOutputMappings.Consume(func(mapping *indexer.AddressOutputMapping) {
+		if err != nil {
+			return
+		} else if err = ctx.Err(); err != nil {
+			err = errors.Wrapf(err, "lookup of the outputs of address %s aborted", address.Base58())
+			return
+		}
+
 		deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(mapping.OutputID()).Consume(func(output utxo.Output) {
 			if typedOutput, ok := output.(devnetvm.Output); ok {
 				outputs = append(outputs, typedOutput)
 			}
 		})
 	})
-	return
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// storageWalkFailed returns the error response of a request whose storage walk failed.
+func storageWalkFailed(c echo.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region GetAddress ///////////////////////////////////////////////////////////////////////////////////////////////////
 
-// GetAddress is the handler for the /ledgerstate/addresses/:address endpoint.
+// GetAddress is the handler for the /ledgerstate/addresses/:address endpoint. It returns a page of the outputs of the
+// address (optionally filtered by their spent status and together with their metadata) and a cursor that can be passed
+// to the next request to resume the iteration.
 func GetAddress(c echo.Context) error {
 	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	outputs := outputsOnAddress(address)
-	spentOutputs, unspentOutputs := devnetvm.Outputs{}, devnetvm.Outputs{}
+	cursor, limit, spentFilter, includeMetadata, err := addressOutputsQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	spentOutputs, unspentOutputs, outputsMetadata := devnetvm.Outputs{}, devnetvm.Outputs{}, make([]*mempool.OutputMetadata, 0)
+	lastOutputID, nextCursor := utxo.EmptyOutputID, utxo.EmptyOutputID
+	if err = deps.Indexer.ForEachAddressOutputID(c.Request().Context(), address, cursor, func(outputID utxo.OutputID) bool {
+		// the cursor is only returned if there are outputs left
+		if len(spentOutputs)+len(unspentOutputs) == limit {
+			nextCursor = lastOutputID
+			return false
+		}
+
+		lastOutputID = outputID
+		deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
+			deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+				typedOutput, ok := output.(devnetvm.Output)
+				if !ok || (spentFilter != nil && outputMetadata.IsSpent() != *spentFilter) {
+					return
+				}
+
+				if outputMetadata.IsSpent() {
+					spentOutputs = append(spentOutputs, typedOutput)
+				} else {
+					unspentOutputs = append(unspentOutputs, typedOutput)
+				}
+
+				if includeMetadata {
+					outputsMetadata = append(outputsMetadata, outputMetadata)
+				}
+			})
+		})
+
+		return true
+	}); err != nil {
+		return storageWalkFailed(c, err)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressResponse(address, spentOutputs, unspentOutputs, outputsMetadata, deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer, nextCursor))
+}
+
+// addressOutputsQuery parses the cursor, the limit, the optional spent filter and the includeMetadata flag of an
+// address outputs request.
+func addressOutputsQuery(c echo.Context) (cursor utxo.OutputID, limit int, spentFilter *bool, includeMetadata bool, err error) {
+	if cursorParam := c.QueryParam("cursor"); cursorParam != "" {
+		if err = cursor.FromBase58(cursorParam); err != nil {
+			return utxo.EmptyOutputID, 0, nil, false, errors.Wrapf(err, "invalid cursor: %s", cursorParam)
+		}
+	}
+
+	limit = maxAddressOutputsLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return utxo.EmptyOutputID, 0, nil, false, errors.Errorf("invalid limit: %s", limitParam)
+		}
+	}
+	if limit > maxAddressOutputsLimit {
+		limit = maxAddressOutputsLimit
+	}
+
+	if spentParam := c.QueryParam("spent"); spentParam != "" {
+		spent, parseErr := strconv.ParseBool(spentParam)
+		if parseErr != nil {
+			return utxo.EmptyOutputID, 0, nil, false, errors.Errorf("invalid spent filter: %s", spentParam)
+		}
+		spentFilter = &spent
+	}
+
+	if includeMetadataParam := c.QueryParam("includeMetadata"); includeMetadataParam != "" {
+		if includeMetadata, err = strconv.ParseBool(includeMetadataParam); err != nil {
+			return utxo.EmptyOutputID, 0, nil, false, errors.Errorf("invalid includeMetadata flag: %s", includeMetadataParam)
+		}
+	}
+
+	return cursor, limit, spentFilter, includeMetadata, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressSpendableOutputs ///////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressSpendableOutputs is the handler for the /ledgerstate/addresses/:address/spendable endpoint. It returns the
+// unspent outputs that the address can unlock at the given time.
+func GetAddressSpendableOutputs(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	nowis := time.Now()
+	if timeParam := c.QueryParam("time"); timeParam != "" {
+		unixTime, parseErr := strconv.ParseInt(timeParam, 10, 64)
+		if parseErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid time: %s", timeParam)))
+		}
+		nowis = time.Unix(unixTime, 0)
+	}
+
+	outputs, err := deps.Indexer.SpendableOutputs(c.Request().Context(), address, nowis)
+	if err != nil {
+		return storageWalkFailed(c, err)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressSpendableOutputsResponse(address, nowis, outputs))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressExpiredFallbackOutputs /////////////////////////////////////////////////////////////////////////////
+
+// GetAddressExpiredFallbackOutputs is the handler for the /ledgerstate/addresses/:address/expiredFallbackOutputs
+// endpoint. It returns the unspent outputs whose fallback deadline passed and that can be reclaimed by the address.
+func GetAddressExpiredFallbackOutputs(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	outputs, err := deps.Indexer.ExpiredFallbackOutputs(c.Request().Context(), address)
+	if err != nil {
+		return storageWalkFailed(c, err)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressExpiredFallbackOutputsResponse(address, outputs))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAddressBalances ///////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressBalances is the handler for the /ledgerstate/addresses/:address/balances endpoint. It returns the balances
+// of the address per color together with the amounts that are pending in and out.
+func GetAddressBalances(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	balances, err := deps.Indexer.Balances(c.Request().Context(), address)
+	if err != nil {
+		return storageWalkFailed(c, err)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressBalancesResponse(address, balances))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SubscribeAddress /////////////////////////////////////////////////////////////////////////////////////////////
+
+// SubscribeAddress is the handler for the /ledgerstate/addresses/:address/subscribe endpoint. It upgrades the connection
+// to a websocket that streams the unspent outputs of the address followed by the outputs that are created and removed
+// afterwards and the outputs whose fallback deadline passed (every event carries a sequence number, so that clients can
+// detect gaps and resubscribe).
+func SubscribeAddress(c echo.Context) error {
+	address, err := devnetvm.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	subscription, err := addressFeed.Subscribe(address, func() (devnetvm.Outputs, error) {
+		return unspentOutputsOnAddress(c.Request().Context(), address)
+	})
+	if err != nil {
+		return storageWalkFailed(c, err)
+	}
+	defer subscription.Close()
+
+	ws, err := subscriptionUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return nil
+	}
+	defer ws.Close()
+
+	// the stream outlives the timeout of the request, so it is only stopped by the client or the subscription
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, readErr := ws.NextReader(); readErr != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		event, nextErr := subscription.Next(ctx)
+		if nextErr != nil {
+			if errors.Is(nextErr, addressfeed.ErrSubscriptionOverflow) {
+				_ = ws.SetWriteDeadline(time.Now().Add(subscriptionWriteTimeout))
+				_ = ws.WriteJSON(&jsonmodels.AddressActivityEvent{Error: nextErr.Error()})
+			}
+
+			return nil
+		}
+
+		if err = ws.SetWriteDeadline(time.Now().Add(subscriptionWriteTimeout)); err != nil {
+			return nil
+		}
+		if err = ws.WriteJSON(jsonmodels.NewAddressActivityEvent(event)); err != nil {
+			return nil
+		}
+	}
+}
+
+// unspentOutputsOnAddress returns the unspent outputs on the given address.
+func unspentOutputsOnAddress(ctx context.Context, address devnetvm.Address) (unspentOutputs devnetvm.Outputs, err error) {
+	outputs, err := outputsOnAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	unspentOutputs = make(devnetvm.Outputs, 0, len(outputs))
 	for _, output := range outputs {
 		deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *mempool.OutputMetadata) {
-			if outputMetadata.IsSpent() {
-				spentOutputs = append(spentOutputs, output)
-				return
+			if !outputMetadata.IsSpent() {
+				unspentOutputs = append(unspentOutputs, output)
 			}
-			unspentOutputs = append(unspentOutputs, output)
 		})
 	}
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetAddressResponse(address, spentOutputs, unspentOutputs))
+	return unspentOutputs, nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -220,7 +672,11 @@ func PostAddressUnspentOutputs(c echo.Context) error {
 	}
 	for i, addy := range addresses {
 		res.UnspentOutputs[i] = new(jsonmodels.WalletOutputsOnAddress)
-		outputs := outputsOnAddress(addy)
+		outputs, err := outputsOnAddress(c.Request().Context(), addy)
+		if err != nil {
+			return storageWalkFailed(c, err)
+		}
+
 		res.UnspentOutputs[i].Address = jsonmodels.Address{
 			Type:   addy.Type().String(),
 			Base58: addy.Base58(),
@@ -320,97 +776,377 @@ func GetConflictConflicts(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// region GetConflictVoters ///////////////////////////////////////////////////////////////////////////////////////////////
+// region GetConflictLiked /////////////////////////////////////////////////////////////////////////////////////////////
 
-// GetConflictVoters is the handler for the /ledgerstate/conflicts/:conflictID/voters endpoint.
-func GetConflictVoters(c echo.Context) (err error) {
+// GetConflictLiked is the handler for the /ledgerstate/conflicts/:conflictID/liked endpoint. It returns the members of
+// the conflict sets of the given conflict that are currently liked by the node (even if they are not accepted yet).
+func GetConflictLiked(c echo.Context) (err error) {
 	conflictID, err := conflictIDFromContext(c)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	voters := deps.Protocol.Engine().Tangle.Booker().VirtualVoting().ConflictVoters(conflictID)
-	defer voters.Detach()
+	conflict, exists := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Conflict(conflictID)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Conflict with %s", conflictID)))
+	}
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetConflictVotersResponse(conflictID, voters))
-}
+	conflictResolver := deps.Protocol.Engine().Consensus.ConflictResolver()
+	response := &jsonmodels.GetConflictLikedResponse{
+		ConflictID:   conflictID.Base58(),
+		Liked:        conflictResolver.ConflictLiked(conflict),
+		ConflictSets: make([]*jsonmodels.LikedConflictSet, 0),
+	}
 
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+	for it := conflict.ConflictSets().Iterator(); it.HasNext(); {
+		conflictSetID := it.Next().ID()
 
-// region GetConflictSequenceIDs /////////////////////////////////////////////////////////////////////////////////////////
+		likedConflictSet := &jsonmodels.LikedConflictSet{
+			ConflictSetID:     conflictSetID.Base58(),
+			ConfirmationState: confirmation.Pending,
+		}
 
-// GetConflictSequenceIDs is the handler for the /ledgerstate/conflict/:conflictID endpoint.
-func GetConflictSequenceIDs(c echo.Context) (err error) {
-	// conflictID, err := conflictIDFromContext(c)
-	// if err != nil {
-	//	return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
-	// }
-	//
-	// sequenceIDs := make([]string, 0)
-	// deps.Tangle.booker.MarkersManager.SequenceAliasMapping(markers.NewSequenceAlias(conflictID.Bytes())).Consume(func(sequenceAliasMapping *markers.SequenceAliasMapping) {
-	//	sequenceAliasMapping.ForEachSequenceID(func(sequenceID markers.SequenceID) bool {
-	//		sequenceIDs = append(sequenceIDs, strconv.FormatUint(uint64(sequenceID), 10))
-	//		return true
-	//	})
-	// })
+		if likedConflictID, liked := conflictResolver.LikedConflict(conflictSetID); liked {
+			likedConflictSet.LikedConflictID = likedConflictID.Base58()
+			likedConflictSet.ConfirmationState = deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().ConfirmationState(advancedset.New(likedConflictID))
+			likedConflictSet.Final = likedConflictSet.ConfirmationState.IsAccepted()
+		}
 
-	return c.JSON(http.StatusOK, "ok")
+		response.ConflictSets = append(response.ConflictSets, likedConflictSet)
+	}
+
+	return c.JSON(http.StatusOK, response)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// region GetOutput ////////////////////////////////////////////////////////////////////////////////////////////////////
+// region GetPendingConflicts //////////////////////////////////////////////////////////////////////////////////////////
 
-// GetOutput is the handler for the /ledgerstate/outputs/:outputID endpoint.
-func GetOutput(c echo.Context) (err error) {
-	var outputID utxo.OutputID
-	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+// GetPendingConflicts is the handler for the /ledgerstate/conflicts/pending endpoint. It returns a page of the unresolved
+// conflict sets (ordered by their creation time) with the current approval weight of their members.
+func GetPendingConflicts(c echo.Context) (err error) {
+	offset := 0
+	if offsetParam := c.QueryParam("offset"); offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err != nil || offset < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid offset: %s", offsetParam)))
+		}
 	}
 
-	if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
-		err = c.JSON(http.StatusOK, jsonmodels.NewOutput(output.(devnetvm.Output)))
-	}) {
-		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Output with %s", outputID)))
+	limit := defaultPendingConflictsLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitParam)))
+		}
+	}
+	if limit > maxPendingConflictsLimit {
+		limit = maxPendingConflictsLimit
 	}
 
-	return
-}
-
-// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// region GetOutputConsumers ///////////////////////////////////////////////////////////////////////////////////////////
+	pendingConflictSets, total := deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().Utils.PendingConflicts(offset, limit)
 
-// GetOutputConsumers is the handler for the /ledgerstate/outputs/:outputID/consumers endpoint.
-func GetOutputConsumers(c echo.Context) (err error) {
-	var outputID utxo.OutputID
-	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	// the approval weight is tracked by the virtual voting of the tangle
+	virtualVoting := deps.Protocol.Engine().Tangle.Booker().VirtualVoting()
+	for _, pendingConflictSet := range pendingConflictSets {
+		for _, member := range pendingConflictSet.Members {
+			member.Weight = virtualVoting.ConflictVotersTotalWeight(member.ID)
+		}
 	}
 
-	cachedConsumers := deps.Protocol.Engine().Ledger.MemPool().Storage().CachedConsumers(outputID)
-	defer cachedConsumers.Release()
-
-	return c.JSON(http.StatusOK, jsonmodels.NewGetOutputConsumersResponse(outputID, cachedConsumers.Unwrap()))
+	return c.JSON(http.StatusOK, jsonmodels.NewGetPendingConflictsResponse(pendingConflictSets, total))
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// region GetOutputMetadata ////////////////////////////////////////////////////////////////////////////////////////////
+// region GetMempool ///////////////////////////////////////////////////////////////////////////////////////////////////
 
-// GetOutputMetadata is the handler for the /ledgerstate/outputs/:outputID/metadata endpoint.
-func GetOutputMetadata(c echo.Context) (err error) {
-	var outputID utxo.OutputID
-	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+// GetMempool is the handler for the /ledgerstate/mempool endpoint. It returns a page of the transactions that were
+// booked but neither accepted nor rejected yet, optionally filtered by the address of their outputs.
+func GetMempool(c echo.Context) (err error) {
+	offset := 0
+	if offsetParam := c.QueryParam("offset"); offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err != nil || offset < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid offset: %s", offsetParam)))
+		}
 	}
 
-	if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
-		confirmedConsumerID := deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer(outputID)
+	limit := defaultMempoolLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitParam)))
+		}
+	}
+	if limit > maxMempoolLimit {
+		limit = maxMempoolLimit
+	}
+
+	if addressParam := c.QueryParam("address"); addressParam != "" {
+		address, addressErr := devnetvm.AddressFromBase58EncodedString(addressParam)
+		if addressErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(addressErr))
+		}
+
+		addressTransactions := mempoolView.TransactionsByAddress(address)
+		if offset > len(addressTransactions) {
+			offset = len(addressTransactions)
+		}
+		page := addressTransactions[offset:]
+		if len(page) > limit {
+			page = page[:limit]
+		}
+
+		return c.JSON(http.StatusOK, jsonmodels.NewGetMempoolResponse(page, len(addressTransactions), mempoolView.Metrics()))
+	}
+
+	pendingTransactions, total := mempoolView.Transactions(offset, limit)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetMempoolResponse(pendingTransactions, total, mempoolView.Metrics()))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetConflictsDOT //////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetConflictsDOT is the handler for the /ledgerstate/conflicts/dot endpoint. It returns a graphviz (DOT) representation
+// of the ConflictDAG that is optionally restricted to the future cone of the comma separated conflict IDs in "roots".
+func GetConflictsDOT(c echo.Context) (err error) {
+	rootConflictIDs := make([]utxo.TransactionID, 0)
+	if rootsParam := c.QueryParam("roots"); rootsParam != "" {
+		for _, rootParam := range strings.Split(rootsParam, ",") {
+			var rootConflictID utxo.TransactionID
+			if err = rootConflictID.FromBase58(strings.TrimSpace(rootParam)); err != nil {
+				return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrapf(err, "invalid root conflict ID: %s", rootParam)))
+			}
+			rootConflictIDs = append(rootConflictIDs, rootConflictID)
+		}
+	}
+
+	var dot bytes.Buffer
+	if err = deps.Protocol.Engine().Ledger.MemPool().ConflictDAG().ExportDOT(&dot, rootConflictIDs...); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.Blob(http.StatusOK, "text/vnd.graphviz", dot.Bytes())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetConflictVoters ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetConflictVoters is the handler for the /ledgerstate/conflicts/:conflictID/voters endpoint.
+func GetConflictVoters(c echo.Context) (err error) {
+	conflictID, err := conflictIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	voters := deps.Protocol.Engine().Tangle.Booker().VirtualVoting().ConflictVoters(conflictID)
+	defer voters.Detach()
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetConflictVotersResponse(conflictID, voters))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetConflictSequenceIDs /////////////////////////////////////////////////////////////////////////////////////////
+
+// GetConflictSequenceIDs is the handler for the /ledgerstate/conflict/:conflictID endpoint.
+func GetConflictSequenceIDs(c echo.Context) (err error) {
+	// conflictID, err := conflictIDFromContext(c)
+	// if err != nil {
+	//	return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	// }
+	//
+	// sequenceIDs := make([]string, 0)
+	// deps.Tangle.booker.MarkersManager.SequenceAliasMapping(markers.NewSequenceAlias(conflictID.Bytes())).Consume(func(sequenceAliasMapping *markers.SequenceAliasMapping) {
+	//	sequenceAliasMapping.ForEachSequenceID(func(sequenceID markers.SequenceID) bool {
+	//		sequenceIDs = append(sequenceIDs, strconv.FormatUint(uint64(sequenceID), 10))
+	//		return true
+	//	})
+	// })
+
+	return c.JSON(http.StatusOK, "ok")
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetLedgerUnspentOutputs //////////////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerUnspentOutputs is the handler for the /ledgerstate/outputs/unspent endpoint. It returns a page of the unspent
+// outputs of the ledger and a cursor that can be passed to the next request to resume the iteration.
+func GetLedgerUnspentOutputs(c echo.Context) (err error) {
+	cursor, filters, err := unspentOutputsQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	limit := defaultUnspentOutputsLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitParam)))
+		}
+	}
+	if limit > maxUnspentOutputsLimit {
+		limit = maxUnspentOutputsLimit
+	}
+
+	outputs := make([]*jsonmodels.UnspentOutput, 0)
+	if cursor, err = deps.Protocol.Engine().Ledger.UnspentOutputs().ForEachUnspentOutput(c.Request().Context(), func(output *mempool.OutputWithMetadata) bool {
+		outputs = append(outputs, jsonmodels.NewUnspentOutput(output))
+		return true
+	}, append(filters, ledger.WithCursor(cursor), ledger.WithLimit(limit))...); err != nil {
+		return storageWalkFailed(c, err)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetLedgerUnspentOutputsResponse(outputs, cursor))
+}
+
+// ExportLedgerUnspentOutputs is the handler for the /ledgerstate/outputs/unspent/export endpoint. It streams the
+// unspent outputs of the ledger as newline delimited JSON objects. The outputs are read in pages, so that the ledger is
+// not blocked by slow clients.
+func ExportLedgerUnspentOutputs(c echo.Context) (err error) {
+	cursor, filters, err := unspentOutputsQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	c.Response().WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Response())
+	for {
+		page := make([]*jsonmodels.UnspentOutput, 0, maxUnspentOutputsLimit)
+		if cursor, err = deps.Protocol.Engine().Ledger.UnspentOutputs().ForEachUnspentOutput(c.Request().Context(), func(output *mempool.OutputWithMetadata) bool {
+			page = append(page, jsonmodels.NewUnspentOutput(output))
+			return true
+		}, append(filters, ledger.WithCursor(cursor), ledger.WithLimit(maxUnspentOutputsLimit))...); err != nil {
+			// the status code was already sent, so we can only abort the stream
+			return errors.Wrap(err, "failed to export unspent outputs")
+		}
 
-		jsonOutputMetadata := jsonmodels.NewOutputMetadata(outputMetadata, confirmedConsumerID)
+		for _, output := range page {
+			if err = encoder.Encode(output); err != nil {
+				return errors.Wrap(err, "failed to write unspent output")
+			}
+		}
+		c.Response().Flush()
+
+		if cursor == utxo.EmptyOutputID {
+			return nil
+		}
+	}
+}
+
+// unspentOutputsQuery parses the cursor and the optional address and type filters of an unspent outputs request.
+func unspentOutputsQuery(c echo.Context) (cursor utxo.OutputID, filters []options.Option[ledger.IteratorOptions], err error) {
+	if cursorParam := c.QueryParam("cursor"); cursorParam != "" {
+		if err = cursor.FromBase58(cursorParam); err != nil {
+			return utxo.EmptyOutputID, nil, errors.Wrapf(err, "invalid cursor: %s", cursorParam)
+		}
+	}
+
+	if addressParam := c.QueryParam("address"); addressParam != "" {
+		address, addressErr := devnetvm.AddressFromBase58EncodedString(addressParam)
+		if addressErr != nil {
+			return utxo.EmptyOutputID, nil, errors.Wrapf(addressErr, "invalid address: %s", addressParam)
+		}
+
+		filters = append(filters, ledger.WithFilter(func(output *mempool.OutputWithMetadata) bool {
+			return output.Output().(devnetvm.Output).Address().Equals(address)
+		}))
+	}
+
+	if typeParam := c.QueryParam("type"); typeParam != "" {
+		outputType, typeErr := devnetvm.OutputTypeFromString(typeParam)
+		if typeErr != nil {
+			return utxo.EmptyOutputID, nil, errors.Wrapf(typeErr, "invalid output type: %s", typeParam)
+		}
+
+		filters = append(filters, ledger.WithFilter(func(output *mempool.OutputWithMetadata) bool {
+			return output.Output().(devnetvm.Output).Type() == outputType
+		}))
+	}
+
+	return cursor, filters, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-		err = c.JSON(http.StatusOK, jsonOutputMetadata)
+// region GetOutput ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutput is the handler for the /ledgerstate/outputs/:outputID endpoint.
+func GetOutput(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
+		err = c.JSON(http.StatusOK, jsonmodels.NewOutput(output.(devnetvm.Output)))
+	}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Output with %s", outputID)))
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostOutputs //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOutputs is the handler for the /ledgerstate/outputs endpoint. It looks up the outputs with the requested IDs (and
+// their metadata) in a single batch, so that clients don't need a round trip per output.
+func PostOutputs(c echo.Context) (err error) {
+	var request jsonmodels.PostOutputsRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if len(request.OutputIDs) > maxOutputIDs {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("too many output IDs: %d [max %d]", len(request.OutputIDs), maxOutputIDs)))
+	}
+
+	outputIDs := make([]utxo.OutputID, len(request.OutputIDs))
+	for i, base58OutputID := range request.OutputIDs {
+		if err = outputIDs[i].FromBase58(base58OutputID); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrapf(err, "invalid output ID: %s", base58OutputID)))
+		}
+	}
+
+	outputs, outputsMetadata := deps.Protocol.Engine().Ledger.MemPool().Utils().Outputs(outputIDs...)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewPostOutputsResponse(outputIDs, outputs, outputsMetadata, deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetOutputConsumers ///////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputConsumers is the handler for the /ledgerstate/outputs/:outputID/consumers endpoint.
+func GetOutputConsumers(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	cachedConsumers := deps.Protocol.Engine().Ledger.MemPool().Storage().CachedConsumers(outputID)
+	defer cachedConsumers.Release()
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetOutputConsumersResponse(outputID, cachedConsumers.Unwrap()))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetOutputMetadata ////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputMetadata is the handler for the /ledgerstate/outputs/:outputID/metadata endpoint.
+func GetOutputMetadata(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		err = c.JSON(http.StatusOK, jsonmodels.NewOutputMetadata(outputMetadata, deps.Protocol.Engine().Ledger.MemPool().Utils().ConfirmedConsumer(outputID)))
 	}) {
 		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load OutputMetadata with %s", outputID)))
 	}
@@ -419,6 +1155,202 @@ func GetOutputMetadata(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetOutputVesting /////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputVesting is the handler for the /ledgerstate/outputs/:outputID/vesting endpoint. It returns the amount of a
+// VestingOutput that is still locked at the time given in the "time" query parameter (or at the current time).
+func GetOutputVesting(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	nowis := time.Now()
+	if timeParam := c.QueryParam("time"); timeParam != "" {
+		unixTime, parseErr := strconv.ParseInt(timeParam, 10, 64)
+		if parseErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid time: %s", timeParam)))
+		}
+		nowis = time.Unix(unixTime, 0)
+	}
+
+	if !deps.Protocol.Engine().Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
+		vestingOutput, isVesting := output.(*devnetvm.VestingOutput)
+		if !isVesting {
+			err = c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("Output with %s is not a VestingOutput", outputID)))
+			return
+		}
+
+		err = c.JSON(http.StatusOK, jsonmodels.NewGetOutputVestingResponse(vestingOutput, nowis))
+	}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Output with %s", outputID)))
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetOutputProof ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputProof is the handler for the /ledgerstate/outputs/:outputID/proof endpoint. It returns a proof that the
+// output is unspent in the ledger state of the latest commitment.
+func GetOutputProof(c echo.Context) (err error) {
+	var outputID utxo.OutputID
+	if err = outputID.FromBase58(c.Param("outputID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	proof, err := deps.Protocol.Engine().Notarization.ProveOutputInclusion(outputID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ledger.ErrOutputNotUnspent):
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+		case errors.Is(err, notarization.ErrRootsUnavailable):
+			return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(err))
+		default:
+			return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+		}
+	}
+
+	response, err := jsonmodels.NewGetOutputProofResponse(proof)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetOutputMinimumDeposit //////////////////////////////////////////////////////////////////////////////////////
+
+// GetOutputMinimumDeposit is the handler for the ledgerstate/outputs/minimum-deposit endpoint. It returns the minimum
+// deposit that the given prospective output has to hold according to the dust policy that is currently active.
+func GetOutputMinimumDeposit(c echo.Context) (err error) {
+	request := new(jsonmodels.Output)
+	if err = c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	// prospective outputs do not have an ID, yet
+	if request.OutputID == nil {
+		request.OutputID = jsonmodels.NewOutputID(utxo.EmptyOutputID)
+	}
+
+	output, err := request.ToLedgerstateOutput()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	dustPolicy := devnetvm.DefaultDustPolicy
+	if devnetVM, isDevnetVM := vm.Lookup[*devnetvm.VM](deps.Protocol.Engine().Ledger.MemPool().VM()); isDevnetVM {
+		dustPolicy = devnetVM.DustPolicy(time.Now())
+	}
+
+	minimumDeposit, err := dustPolicy.MinimumDeposit(output)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	deposit, _ := output.Balances().Get(devnetvm.ColorIOTA)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetOutputMinimumDepositResponse(minimumDeposit, deposit))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region StreamConfirmedTransactions //////////////////////////////////////////////////////////////////////////////////
+
+// StreamConfirmedTransactions is the handler for the /ledgerstate/transactions/confirmed/stream endpoint. It streams the
+// transactions that reach the configured grade of finality as server-sent events, optionally filtered by the addresses
+// of their inputs and outputs. Clients resume after the last processed event by passing its ID in the Last-Event-ID
+// header (or the lastEventID query parameter), and are answered with 410 Gone if the following events are no longer
+// retained.
+func StreamConfirmedTransactions(c echo.Context) error {
+	addresses := make([]string, 0)
+	for _, addressParam := range c.QueryParams()["address"] {
+		address, err := devnetvm.AddressFromBase58EncodedString(addressParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid address '%s'", addressParam)))
+		}
+		addresses = append(addresses, address.Base58())
+	}
+
+	lastEventID := c.Request().Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.QueryParam("lastEventID")
+	}
+
+	subscription, err := confirmationFeed.Subscribe(lastEventID, addresses...)
+	if err != nil {
+		if errors.Is(err, confirmationfeed.ErrEventsPruned) || errors.Is(err, confirmationfeed.ErrUnknownEventID) {
+			return c.JSON(http.StatusGone, jsonmodels.NewErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	defer subscription.Close()
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	// the stream outlives the timeout of the request, so it is only stopped by failing writes or the subscription
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), confirmedStreamKeepAliveInterval)
+		confirmedEvent, nextErr := subscription.Next(ctx)
+		cancel()
+
+		switch {
+		case errors.Is(nextErr, context.DeadlineExceeded):
+			if _, err = fmt.Fprint(response, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		case nextErr != nil:
+			if errors.Is(nextErr, confirmationfeed.ErrSubscriptionOverflow) {
+				_ = writeServerSentEvent(response, "", "error", jsonmodels.NewErrorResponse(nextErr))
+			}
+
+			return nil
+		default:
+			if err = writeServerSentEvent(response, confirmedEvent.ID, "transaction", jsonmodels.NewConfirmedTransactionEvent(confirmedEvent)); err != nil {
+				return nil
+			}
+		}
+
+		response.Flush()
+	}
+}
+
+// writeServerSentEvent writes the given data as a server-sent event of the given type (the ID is omitted if empty).
+func writeServerSentEvent(response *echo.Response, id string, eventType string, data interface{}) (err error) {
+	encodedData, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+
+	var buffer bytes.Buffer
+	if id != "" {
+		buffer.WriteString("id: " + id + "\n")
+	}
+	buffer.WriteString("event: " + eventType + "\n")
+	buffer.WriteString("data: ")
+	buffer.Write(encodedData)
+	buffer.WriteString("\n\n")
+
+	if _, err = response.Write(buffer.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to write event")
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetTransaction ///////////////////////////////////////////////////////////////////////////////////////////////
 
 // GetTransaction is the handler for the /ledgerstate/transactions/:transactionID endpoint.
@@ -463,20 +1395,125 @@ func GetTransactionMetadata(c echo.Context) (err error) {
 
 // region GetTransactionAttachments ////////////////////////////////////////////////////////////////////////////////////
 
-// GetTransactionAttachments is the handler for the ledgerstate/transactions/:transactionID/attachments endpoint.
+// GetTransactionAttachments is the handler for the ledgerstate/transactions/:transactionID/attachments endpoint. If the
+// includeGoF query parameter is set, the response additionally contains the confirmation and scheduler status of every
+// attachment.
 func GetTransactionAttachments(c echo.Context) (err error) {
 	var transactionID utxo.TransactionID
 	if err = transactionID.FromBase58(c.Param("transactionID")); err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
+	includeGoF := false
+	if includeGoFParam := c.QueryParam("includeGoF"); includeGoFParam != "" {
+		if includeGoF, err = strconv.ParseBool(includeGoFParam); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid includeGoF parameter '%s'", includeGoFParam)))
+		}
+	}
+
 	blockIDs := models.NewBlockIDs()
 	_ = deps.Protocol.Engine().Tangle.Booker().GetAllAttachments(transactionID).ForEach(func(attachment *booker.Block) error {
 		blockIDs.Add(attachment.ID())
 		return nil
 	})
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetTransactionAttachmentsResponse(transactionID, blockIDs))
+	response := jsonmodels.NewGetTransactionAttachmentsResponse(transactionID, blockIDs)
+	if includeGoF {
+		response.Attachments = make([]*jsonmodels.TransactionAttachment, 0, len(blockIDs))
+		for blockID := range blockIDs {
+			response.Attachments = append(response.Attachments, transactionAttachment(blockID))
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// transactionAttachment returns the confirmation and scheduler status of the attachment with the given BlockID.
+func transactionAttachment(blockID models.BlockID) (attachment *jsonmodels.TransactionAttachment) {
+	attachment = &jsonmodels.TransactionAttachment{
+		BlockID:           blockID.Base58(),
+		ConfirmationState: confirmation.Pending,
+		SchedulerStatus:   "unknown",
+	}
+
+	blockMetadata, exists := deps.Retainer.BlockMetadata(blockID)
+	if !exists {
+		return attachment
+	}
+
+	switch {
+	case blockMetadata.M.Confirmed || blockMetadata.M.ConfirmedBySlot:
+		attachment.ConfirmationState = confirmation.Confirmed
+	case blockMetadata.M.Accepted:
+		attachment.ConfirmationState = confirmation.Accepted
+	case blockMetadata.M.Orphaned || blockMetadata.M.Invalid:
+		attachment.ConfirmationState = confirmation.Rejected
+	}
+
+	switch {
+	case blockMetadata.M.Scheduled:
+		attachment.SchedulerStatus = "scheduled"
+	case blockMetadata.M.Skipped:
+		attachment.SchedulerStatus = "skipped"
+	case blockMetadata.M.Dropped:
+		attachment.SchedulerStatus = "dropped"
+	default:
+		attachment.SchedulerStatus = "pending"
+	}
+	attachment.Orphaned = blockMetadata.M.Orphaned
+
+	return attachment
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SimulateTransactionOutcomes /////////////////////////////////////////////////////////////////////////////////
+
+// SimulateTransactionOutcomes is the handler for the ledgerstate/transactions/:transactionID/simulate-outcomes endpoint.
+// It enumerates the possible resolutions of the pending conflict sets that the transaction depends on and reports for
+// each of them whether the transaction is accepted, which of its inputs still exist (and by whom they are spent) and
+// which outputs are created.
+func SimulateTransactionOutcomes(c echo.Context) (err error) {
+	var transactionID utxo.TransactionID
+	if err = transactionID.FromBase58(c.Param("transactionID")); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	limit := defaultTransactionOutcomesLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitParam)))
+		}
+	}
+	if limit > maxTransactionOutcomesLimit {
+		limit = maxTransactionOutcomesLimit
+	}
+
+	memPool := deps.Protocol.Engine().Ledger.MemPool()
+
+	var inputIDs []utxo.OutputID
+	var outputIDs utxo.OutputIDs
+	conflictIDSets := make([]*advancedset.AdvancedSet[utxo.TransactionID], 1)
+	memPool.Utils().WithTransactionAndMetadata(transactionID, func(tx utxo.Transaction, txMetadata *mempool.TransactionMetadata) {
+		inputIDs = memPool.Utils().ResolveInputs(tx.Inputs()).Slice()
+		outputIDs = txMetadata.OutputIDs()
+		conflictIDSets[0] = txMetadata.ConflictIDs()
+	})
+	if conflictIDSets[0] == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Transaction with %s", transactionID)))
+	}
+
+	for _, inputID := range inputIDs {
+		inputConflictIDs := advancedset.New[utxo.TransactionID]()
+		memPool.Storage().CachedOutputMetadata(inputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			inputConflictIDs = outputMetadata.ConflictIDs()
+		})
+		conflictIDSets = append(conflictIDSets, inputConflictIDs)
+	}
+
+	outcomes, truncated := memPool.ConflictDAG().Utils.ResolutionOutcomes(limit, conflictIDSets...)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewSimulateTransactionOutcomesResponse(transactionID, inputIDs, outputIDs, outcomes, truncated))
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -499,24 +1536,226 @@ func conflictIDFromContext(c echo.Context) (conflictID utxo.TransactionID, err e
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetLedgerCaches //////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerCaches is the handler for the /ledgerstate/caches endpoint. It returns the settings and statistics of the
+// caches of the storages of the ledger (indexed by the names of the storages).
+func GetLedgerCaches(c echo.Context) (err error) {
+	response := &jsonmodels.GetLedgerCachesResponse{Caches: make(map[string]*jsonmodels.LedgerCache)}
+	for storageName, cacheStatistics := range deps.Protocol.Engine().Ledger.MemPool().Storage().CacheStatistics() {
+		response.Caches[storageName] = jsonmodels.NewLedgerCache(cacheStatistics)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PutLedgerCache ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// PutLedgerCache is the handler for the /ledgerstate/caches/:storageName endpoint. It adjusts the cache time and size
+// of the named storage of the ledger while the node is running (the changes also apply to the objects that are already
+// cached, but they are not persisted and are lost when the node restarts or switches its engine).
+func PutLedgerCache(c echo.Context) (err error) {
+	var request jsonmodels.PutLedgerCacheRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.LedgerCache{Error: err.Error()})
+	}
+
+	storageName := c.Param("storageName")
+	storage := deps.Protocol.Engine().Ledger.MemPool().Storage()
+	if _, exists := storage.CacheStatistics()[storageName]; !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.LedgerCache{Error: fmt.Sprintf("unknown storage %s", storageName)})
+	}
+
+	if request.CacheTime != "" {
+		cacheTime, parseErr := time.ParseDuration(request.CacheTime)
+		if parseErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.LedgerCache{Error: errors.Wrapf(parseErr, "invalid cache time %s", request.CacheTime).Error()})
+		}
+
+		if err = storage.SetCacheTime(storageName, cacheTime); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.LedgerCache{Error: err.Error()})
+		}
+	}
+
+	if request.MaxSize != nil {
+		if err = storage.SetCacheSize(storageName, *request.MaxSize); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.LedgerCache{Error: err.Error()})
+		}
+	}
+
+	cacheStatistics := storage.CacheStatistics()[storageName]
+	log.Infof("updated cache of the %s storage (cacheTime=%s, maxSize=%d)", storageName, cacheStatistics.CacheTime, cacheStatistics.MaxSize)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewLedgerCache(cacheStatistics))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetLedgerStats ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetLedgerStats is the handler for the /ledgerstate/stats endpoint. It returns the statistics about the unspent outputs
+// of the ledger state.
+func GetLedgerStats(c echo.Context) (err error) {
+	statistics := deps.Protocol.Engine().Ledger.UnspentOutputs().Statistics()
+
+	response := &jsonmodels.GetLedgerStatsResponse{
+		UnspentOutputs:  statistics.Count(),
+		DustOutputs:     statistics.DustCount(),
+		BalancesByColor: make(map[string]uint64),
+		DailyGrowthRate: statistics.DailyGrowthRate(),
+	}
+	for color, balance := range statistics.BalancesByColor() {
+		response.BalancesByColor[color.Base58()] = balance
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetColorSupplies /////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetColorSupplies is the handler for the GET /ledgerstate/colors endpoint.
+func GetColorSupplies(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewGetColorSuppliesResponse(deps.Indexer.ColorSupplies().ColorSupplies()))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAliasChain ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAliasChain is the handler for the GET /ledgerstate/alias-chains/:aliasAddress endpoint.
+func GetAliasChain(c echo.Context) error {
+	aliasAddress, err := devnetvm.AliasAddressFromBase58EncodedString(c.Param("aliasAddress"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	transitions, err := deps.Indexer.AliasChain(c.Request().Context(), aliasAddress)
+	if err != nil {
+		return storageWalkFailed(c, err)
+	}
+	if len(transitions) == 0 {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("no chain found for alias %s", aliasAddress.Base58())))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAliasChainResponse(aliasAddress, transitions))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetAliases ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAliases is the handler for the GET /ledgerstate/aliases endpoint.
+func GetAliases(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewGetAliasesResponse())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostAlias ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostAlias is the handler for the POST /ledgerstate/aliases endpoint that registers (or unregisters if the alias is
+// empty) the human-readable alias of a transaction or output.
+func PostAlias(c echo.Context) error {
+	var request jsonmodels.PostAliasRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	switch request.Type {
+	case jsonmodels.AliasTypeTransaction:
+		var txID utxo.TransactionID
+		if err := txID.FromBase58(request.ID); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to parse TransactionID %s", request.ID)))
+		}
+
+		if request.Alias == "" {
+			txID.UnregisterAlias()
+			deps.Artifacts.Remove(artifacts.KindAlias, request.ID)
+		} else {
+			txID.RegisterAlias(request.Alias)
+			deps.Artifacts.Track(artifacts.KindAlias, request.ID, 0, txID.UnregisterAlias)
+		}
+	case jsonmodels.AliasTypeOutput:
+		var outputID utxo.OutputID
+		if err := outputID.FromBase58(request.ID); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrapf(err, "failed to parse OutputID %s", request.ID)))
+		}
+
+		if request.Alias == "" {
+			outputID.UnregisterAlias()
+			deps.Artifacts.Remove(artifacts.KindAlias, request.ID)
+		} else {
+			outputID.RegisterAlias(request.Alias)
+			deps.Artifacts.Track(artifacts.KindAlias, request.ID, 0, outputID.UnregisterAlias)
+		}
+	default:
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("unknown alias type '%s'", request.Type)))
+	}
+
+	return c.JSON(http.StatusOK, &request)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DryRunTransaction ////////////////////////////////////////////////////////////////////////////////////////////
+
+// DryRunTransaction is the handler for the ledgerstate/transactions/dryrun endpoint. It resolves the inputs of the
+// given transaction and executes it without issuing or storing it, so that wallets can validate a transaction (and
+// inspect the outputs that it would create) before they broadcast it.
+func DryRunTransaction(c echo.Context) error {
+	var request jsonmodels.PostTransactionRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	tx := new(devnetvm.Transaction)
+	if err := tx.FromBytes(request.TransactionBytes); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewDryRunTransactionResponse(tx, deps.Protocol.Engine().Ledger.MemPool().DryRun(c.Request().Context(), tx)))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostTransaction //////////////////////////////////////////////////////////////////////////////////////////////
 
-const maxBookedAwaitTime = 5 * time.Second
+const (
+	maxBookedAwaitTime = 5 * time.Second
+
+	// defaultTransactionAwaitTimeout contains the time that is waited for the requested state of a submitted
+	// transaction if no timeout is requested.
+	defaultTransactionAwaitTimeout = 30 * time.Second
+
+	// maxTransactionAwaitTimeout contains the maximum time that is waited for the requested state of a submitted
+	// transaction.
+	maxTransactionAwaitTimeout = 5 * time.Minute
+)
 
 // ErrNotAllowedToPledgeManaToNode defines an unsupported node to pledge mana to.
 var ErrNotAllowedToPledgeManaToNode = errors.New("not allowed to pledge mana to node")
 
-// PostTransaction sends a transaction.
+// PostTransaction sends a transaction. If the waitFor query parameter is set, the response is only sent once the
+// transaction reached the requested state (booked, gofLow or gofHigh), was rejected or orphaned, or the timeout (timeout
+// query parameter) expired.
 func PostTransaction(c echo.Context) error {
 	var request jsonmodels.PostTransactionRequest
 	if err := c.Bind(&request); err != nil {
 		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
 	}
 
+	waitFor, awaitTimeout, err := transactionAwaitQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
+	}
+
 	// parse tx
 	tx := new(devnetvm.Transaction)
-	err := tx.FromBytes(request.TransactionBytes)
-	if err != nil {
+	if err = tx.FromBytes(request.TransactionBytes); err != nil {
 		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
 	}
 
@@ -529,7 +1768,7 @@ func PostTransaction(c echo.Context) error {
 
 	// check transaction validity
 	if transactionErr := deps.Protocol.Engine().Ledger.MemPool().CheckTransaction(context.Background(), tx); transactionErr != nil {
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: transactionErr.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewPostTransactionErrorResponse(transactionErr))
 	}
 
 	// TODO: check if transaction is too old
@@ -557,7 +1796,159 @@ func PostTransaction(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.PostTransactionResponse{Error: err.Error()})
 	}
 
-	return c.JSON(http.StatusOK, &jsonmodels.PostTransactionResponse{TransactionID: tx.ID().Base58(), BlockID: block.ID().Base58()})
+	response := &jsonmodels.PostTransactionResponse{
+		TransactionID: tx.ID().Base58(),
+		BlockID:       block.ID().Base58(),
+		Advisory:      jsonmodels.NewIssuanceAdvisory(deps.BlockIssuer.Advisory()),
+	}
+	if waitFor != "" {
+		var state transactionState
+		state, response.RejectionReason, response.TimedOut = awaitTransactionState(tx.ID(), waitFor, awaitTimeout)
+		response.State = string(state)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// transactionAwaitQuery parses the waitFor and timeout query parameters of the PostTransaction endpoint.
+func transactionAwaitQuery(c echo.Context) (waitFor transactionState, timeout time.Duration, err error) {
+	if waitForParam := c.QueryParam("waitFor"); waitForParam != "" {
+		if waitFor = transactionState(waitForParam); waitFor.rank() == 0 {
+			return "", 0, errors.Errorf("invalid waitFor parameter '%s' (booked, gofLow or gofHigh)", waitForParam)
+		}
+	}
+
+	timeout = defaultTransactionAwaitTimeout
+	if timeoutParam := c.QueryParam("timeout"); timeoutParam != "" {
+		if timeout, err = time.ParseDuration(timeoutParam); err != nil || timeout <= 0 {
+			return "", 0, errors.Errorf("invalid timeout parameter '%s'", timeoutParam)
+		}
+		if timeout > maxTransactionAwaitTimeout {
+			return "", 0, errors.Errorf("timeout of %s exceeds the maximum of %s", timeout, maxTransactionAwaitTimeout)
+		}
+	}
+
+	return waitFor, timeout, nil
+}
+
+// awaitTransactionState blocks until the transaction with the given ID reached the given state, was rejected or
+// orphaned, or the timeout expired. It returns the last observed state of the transaction together with the reason of
+// its rejection.
+func awaitTransactionState(transactionID utxo.TransactionID, waitFor transactionState, timeout time.Duration) (state transactionState, rejectionReason string, timedOut bool) {
+	updated := make(chan struct{}, 1)
+	signal := func() {
+		select {
+		case updated <- struct{}{}:
+		default:
+		}
+	}
+
+	var orphaned bool
+	var orphanedMutex sync.Mutex
+
+	// the hooks are registered before the state is checked, so that no update in between is missed
+	memPoolEvents := deps.Protocol.Events.Engine.Ledger.MemPool
+	acceptedHook := memPoolEvents.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		if event.Metadata.ID() == transactionID {
+			signal()
+		}
+	})
+	defer acceptedHook.Unhook()
+	rejectedHook := memPoolEvents.TransactionRejected.Hook(func(transactionMetadata *mempool.TransactionMetadata) {
+		if transactionMetadata.ID() == transactionID {
+			signal()
+		}
+	})
+	defer rejectedHook.Unhook()
+	orphanedHook := memPoolEvents.TransactionOrphaned.Hook(func(event *mempool.TransactionEvent) {
+		if event.Metadata.ID() == transactionID {
+			orphanedMutex.Lock()
+			orphaned = true
+			orphanedMutex.Unlock()
+
+			signal()
+		}
+	})
+	defer orphanedHook.Unhook()
+	slotConfirmedHook := deps.Protocol.Events.Engine.Consensus.SlotGadget.SlotConfirmed.Hook(func(slot.Index) {
+		signal()
+	})
+	defer slotConfirmedHook.Unhook()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		state, rejectionReason = currentTransactionState(transactionID)
+
+		orphanedMutex.Lock()
+		if orphaned && state != transactionStateRejected {
+			state = transactionStateOrphaned
+		}
+		orphanedMutex.Unlock()
+
+		if state.rank() >= waitFor.rank() || state == transactionStateRejected || state == transactionStateOrphaned {
+			return state, rejectionReason, false
+		}
+
+		select {
+		case <-updated:
+		case <-timer.C:
+			return state, rejectionReason, true
+		}
+	}
+}
+
+// currentTransactionState returns the current state of the transaction with the given ID together with the reason of
+// its rejection.
+func currentTransactionState(transactionID utxo.TransactionID) (state transactionState, rejectionReason string) {
+	deps.Protocol.Engine().Ledger.MemPool().Storage().CachedTransactionMetadata(transactionID).Consume(func(transactionMetadata *mempool.TransactionMetadata) {
+		switch {
+		case transactionMetadata.ConfirmationState().IsRejected() || transactionMetadata.RejectionReason() != mempool.NoRejectionReason:
+			state = transactionStateRejected
+			rejectionReason = lo.Cond(transactionMetadata.RejectionReason() != mempool.NoRejectionReason, transactionMetadata.RejectionReason().String(), "")
+		case transactionMetadata.ConfirmationState().IsAccepted():
+			state = lo.Cond(transactionMetadata.InclusionSlot() <= deps.Protocol.Engine().LastConfirmedSlot(), transactionStateGoFHigh, transactionStateGoFLow)
+		case transactionMetadata.IsBooked():
+			state = transactionStateBooked
+		}
+	})
+
+	return state, rejectionReason
+}
+
+// transactionState is the state of a submitted transaction that is reported by the PostTransaction endpoint.
+type transactionState string
+
+const (
+	// transactionStateBooked is the state of a transaction that was booked.
+	transactionStateBooked transactionState = "booked"
+
+	// transactionStateGoFLow is the state of a transaction that was accepted.
+	transactionStateGoFLow transactionState = "gofLow"
+
+	// transactionStateGoFHigh is the state of an accepted transaction whose inclusion slot was confirmed.
+	transactionStateGoFHigh transactionState = "gofHigh"
+
+	// transactionStateRejected is the state of a transaction that was rejected.
+	transactionStateRejected transactionState = "rejected"
+
+	// transactionStateOrphaned is the state of a transaction that was orphaned.
+	transactionStateOrphaned transactionState = "orphaned"
+)
+
+// rank returns the order of the states that can be waited for (0 for all other states).
+func (t transactionState) rank() int {
+	switch t {
+	case transactionStateBooked:
+		return 1
+	case transactionStateGoFLow:
+		return 2
+	case transactionStateGoFHigh:
+		return 3
+	default:
+		return 0
+	}
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,10 @@
 package webapi
 
-import "github.com/iotaledger/goshimmer/plugins/config"
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
 
 // ParametersDefinition contains the definition of the parameters used by the webAPI plugin.
 type ParametersDefinition struct {
@@ -15,6 +19,47 @@ type ParametersDefinition struct {
 		// Password defines the password used by the basic HTTP authentication.
 		Password string `default:"goshimmer" usage:"HTTP basic auth password"`
 	}
+	// LegacyRoutes
+	LegacyRoutes struct {
+		// Enabled defines whether the unversioned routes are served next to the versioned routes.
+		Enabled bool `default:"true" usage:"whether to serve the deprecated unversioned routes next to the versioned /api routes"`
+		// Sunset defines the date (RFC3339) after which the unversioned routes are going to be removed.
+		Sunset string `default:"" usage:"the date (RFC3339) after which the unversioned routes are going to be removed"`
+	}
+	// RequestTimeout defines the duration after which the storage accesses of a request are aborted (0 disables the timeout).
+	RequestTimeout time.Duration `default:"0s" usage:"the duration after which the storage accesses of a request are aborted (0 disables the timeout)"`
+	// Artifacts
+	Artifacts struct {
+		// TTL defines the duration after which the node-local artifacts that were created through the API are discarded.
+		TTL time.Duration `default:"1h" usage:"the duration after which the node-local artifacts that were created through the API are discarded"`
+		// Interval defines the interval in which the expired artifacts are discarded.
+		Interval time.Duration `default:"1m" usage:"the interval in which the expired artifacts are discarded"`
+	}
+	// Evidence
+	Evidence struct {
+		// Enabled defines whether the responses of the signed routes are signed by the node identity.
+		Enabled bool `default:"false" usage:"whether to sign the responses of the signed routes with the node identity"`
+		// Routes defines the (unversioned) paths of the routes whose responses are signed.
+		Routes []string `default:"ledgerstate/addresses/:address,ledgerstate/addresses/:address/balances,ledgerstate/addresses/:address/spendable,ledgerstate/outputs/:outputID/metadata,ledgerstate/transactions/:transactionID/metadata,blocks/:blockID/metadata" usage:"the (unversioned) paths of the routes whose responses are signed"`
+	}
+	// Subscriptions
+	Subscriptions struct {
+		// MaxTopics defines the maximum number of topics that a single websocket subscriber can subscribe to.
+		MaxTopics int `default:"1000" usage:"the maximum number of topics that a single websocket subscriber can subscribe to"`
+		// MaxPendingNotifications defines the maximum number of notifications that are queued for a websocket subscriber.
+		MaxPendingNotifications int `default:"1024" usage:"the maximum number of notifications that are queued for a websocket subscriber before it is disconnected"`
+	}
+	// ConfirmedTransactions
+	ConfirmedTransactions struct {
+		// ConfirmationState defines the grade of finality (accepted or confirmed) at which transactions are streamed.
+		ConfirmationState string `default:"accepted" usage:"the grade of finality (accepted or confirmed) at which transactions are streamed by the confirmed transactions stream"`
+		// MaxEvents defines the number of streamed transactions that are retained, so that clients can resume after them.
+		MaxEvents int `default:"10000" usage:"the number of streamed transactions that are retained, so that clients can resume after them"`
+		// MaxPendingEvents defines the maximum number of transactions that are queued for a client of the stream.
+		MaxPendingEvents int `default:"1024" usage:"the maximum number of transactions that are queued for a client of the confirmed transactions stream before it is disconnected"`
+	}
+	// MempoolSize defines the maximum number of pending transactions that are served by the mempool endpoint.
+	MempoolSize int `default:"10000" usage:"the maximum number of pending transactions that are served by the mempool endpoint"`
 	// EnableDSFilter determines if the DoubleSpendFilter should be enabled.
 	EnableDSFilter bool `default:"false" usage:"whether to enable double spend filter"`
 }
@@ -11,9 +11,11 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/app/artifacts"
 	"github.com/iotaledger/goshimmer/packages/core/shutdown"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/logger"
 )
 
@@ -31,11 +33,13 @@ var (
 type dependencies struct {
 	dig.In
 
-	Server *echo.Echo
+	Server    *echo.Echo
+	Artifacts *artifacts.Registry
+	Local     *peer.Local
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Provides(shutdown.ComponentWebAPI).Consumes(shutdown.ComponentProtocol, shutdown.ComponentP2P, shutdown.ComponentAutopeering, shutdown.ComponentManualpeering, shutdown.ComponentFaucet)
 
 	Plugin.Events.Init.Hook(func(event *node.InitEvent) {
 		if err := event.Container.Provide(func() *echo.Echo {
@@ -44,12 +48,19 @@ func init() {
 		}); err != nil {
 			Plugin.Panic(err)
 		}
+
+		if err := event.Container.Provide(func() *artifacts.Registry {
+			return artifacts.New(artifacts.WithTTL(Parameters.Artifacts.TTL), artifacts.WithInterval(Parameters.Artifacts.Interval))
+		}); err != nil {
+			Plugin.Panic(err)
+		}
 	})
 }
 
 // newServer creates a server instance.
 func newServer() *echo.Echo {
 	server := echo.New()
+	server.Pre(versionedRouting)
 	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		Skipper:      middleware.DefaultSkipper,
 		AllowOrigins: []string{"*"},
@@ -67,6 +78,19 @@ func newServer() *echo.Echo {
 		}))
 	}
 
+	// announce the deprecation details of the matched routes
+	server.Use(routeDeprecation)
+
+	// if enabled, abort the storage accesses of requests that exceed the request timeout
+	if Parameters.RequestTimeout > 0 {
+		server.Use(requestTimeout(Parameters.RequestTimeout))
+	}
+
+	// if enabled, sign the responses of the signed routes with the node identity
+	if Parameters.Evidence.Enabled {
+		server.Use(responseEvidence)
+	}
+
 	server.HTTPErrorHandler = func(err error, c echo.Context) {
 		log.Warnf("Request failed: %s", err)
 
@@ -110,15 +134,30 @@ func newServer() *echo.Echo {
 
 func configure(*node.Plugin) {
 	log = logger.NewLogger(PluginName)
+
+	if Parameters.LegacyRoutes.Sunset != "" {
+		sunset, err := time.Parse(time.RFC3339, Parameters.LegacyRoutes.Sunset)
+		if err != nil {
+			log.Fatalf("Invalid sunset date of the legacy routes: %s", err)
+		}
+		legacySunset = sunset
+	}
+
+	for _, path := range Parameters.Evidence.Routes {
+		SignRoute(path)
+	}
+
 	// configure the server
 	deps.Server.HideBanner = true
 	deps.Server.HidePort = true
 	deps.Server.GET("/", IndexRequest)
+	deps.Server.GET("artifacts", GetArtifacts)
 }
 
 func run(*node.Plugin) {
 	log.Infof("Starting %s ...", PluginName)
-	if err := daemon.BackgroundWorker("WebAPIServer", worker, shutdown.PriorityWebAPI); err != nil {
+	deps.Artifacts.Start()
+	if err := daemon.BackgroundWorker("WebAPIServer", worker, Plugin.ShutdownOrder()); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
@@ -150,4 +189,20 @@ func worker(ctx context.Context) {
 	if err := deps.Server.Shutdown(ctx); err != nil {
 		log.Errorf("Error stopping: %s", err)
 	}
+	deps.Artifacts.Shutdown()
+}
+
+// requestTimeout returns a middleware that cancels the context of a request once the given timeout is exceeded, so that
+// the storage accesses of the handlers that respect the context of the request are aborted.
+func requestTimeout(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
 }
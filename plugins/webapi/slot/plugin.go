@@ -2,6 +2,7 @@ package slot
 
 import (
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -49,6 +50,7 @@ func configure(_ *node.Plugin) {
 	deps.Server.GET("slots/:index/utxos", GetUTXOs)
 	deps.Server.GET("slots/:index/blocks", GetBlocks)
 	deps.Server.GET("slots/:index/transactions", GetTransactions)
+	deps.Server.GET("slots/:index/activity", GetActivity)
 	// deps.Server.GET("slots/:index/voters-weight", getVotersWeight)
 
 	deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(e *notarization.SlotCommittedDetails) {
@@ -153,6 +155,38 @@ func GetTransactions(c echo.Context) error {
 	return c.JSON(http.StatusOK, jsonmodels.SlotTransactionsResponse{Transactions: txs})
 }
 
+// GetActivity returns the issuers that issued blocks in the slot with the given index.
+func GetActivity(c echo.Context) error {
+	index, err := getIndex(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.SlotActivityResponse{Error: err.Error()})
+	}
+
+	response := jsonmodels.SlotActivityResponse{
+		Index:   uint64(index),
+		Issuers: make([]*jsonmodels.IssuerActivity, 0),
+	}
+	for _, activity := range deps.Retainer.IssuerActivities(index) {
+		issuerActivity := &jsonmodels.IssuerActivity{
+			IssuerID:         activity.M.ID.IssuerID.EncodeBase58(),
+			Blocks:           activity.M.Blocks,
+			Weight:           activity.M.Weight,
+			WeightedActivity: activity.WeightedActivity(),
+		}
+
+		response.Blocks += issuerActivity.Blocks
+		response.ActiveWeight += issuerActivity.Weight
+		response.WeightedActivity += issuerActivity.WeightedActivity
+		response.Issuers = append(response.Issuers, issuerActivity)
+	}
+
+	sort.Slice(response.Issuers, func(i, j int) bool {
+		return response.Issuers[i].WeightedActivity > response.Issuers[j].WeightedActivity || (response.Issuers[i].WeightedActivity == response.Issuers[j].WeightedActivity && response.Issuers[i].IssuerID < response.Issuers[j].IssuerID)
+	})
+
+	return c.JSON(http.StatusOK, response)
+}
+
 func getIndex(c echo.Context) (slot.Index, error) {
 	indexText := c.Param("index")
 	indexNumber, err := strconv.Atoi(indexText)
@@ -6,9 +6,11 @@ import (
 	"path/filepath"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/core/remotestorage"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/protocol"
 	"github.com/iotaledger/hive.go/core/slot"
@@ -42,14 +44,23 @@ func configure(_ *node.Plugin) {
 
 // region DumpCurrentLedger ///////////////////////////////////////////////////////////////////////////////////////////////////
 
-// GetSnapshot dumps a snapshot (all unspent UTXO and all of the access mana) from now.
+// GetSnapshot dumps a snapshot (all unspent UTXO and all of the access mana) from now. If a target URL (s3:// or gs://)
+// is provided, the snapshot is streamed directly to the object storage instead of being returned.
 func GetSnapshot(c echo.Context) (err error) {
 	var request jsonmodels.GetSnapshotRequest
 	if err = c.Bind(&request); err != nil {
 		Plugin.LogInfo(err.Error())
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
+
 	snapshotFilePath := filepath.Join(os.TempDir(), "snapshot.bin")
+	if request.Target != "" {
+		if !remotestorage.IsRemoteURL(request.Target) {
+			return c.JSON(http.StatusBadRequest, jsonmodels.GetSnapshotResponse{Error: errors.WithMessagef(remotestorage.ErrUnsupportedURL, "invalid target %s", request.Target).Error()})
+		}
+		snapshotFilePath = request.Target
+	}
+
 	if c.QueryParam("index") == "" {
 		err = deps.Protocol.Engine().WriteSnapshot(snapshotFilePath)
 	} else {
@@ -60,6 +71,10 @@ func GetSnapshot(c echo.Context) (err error) {
 		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
 	}
 
+	if request.Target != "" {
+		return c.JSON(http.StatusOK, jsonmodels.GetSnapshotResponse{Target: request.Target})
+	}
+
 	return c.Attachment(snapshotFilePath, snapshotFilePath)
 }
 
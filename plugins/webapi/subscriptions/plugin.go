@@ -0,0 +1,299 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/app/subscriptions"
+	"github.com/iotaledger/goshimmer/packages/core/shutdown"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/conflictdag"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/app/daemon"
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// PluginName is the name of the web API subscriptions endpoint plugin.
+const PluginName = "WebAPISubscriptionsEndpoint"
+
+const (
+	// writeTimeout contains the timeout for writing a message to the websocket of a subscriber.
+	writeTimeout = 3 * time.Second
+
+	// maxRequestSize contains the maximum size (in bytes) of a request that is sent by a subscriber.
+	maxRequestSize = 1 << 20
+)
+
+type dependencies struct {
+	dig.In
+
+	Server   *echo.Echo
+	Protocol *protocol.Protocol
+}
+
+var (
+	// Plugin is the plugin instance of the web API subscriptions endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+
+	// hub distributes the notifications to the subscribers of the websocket connections.
+	hub *subscriptions.Hub
+
+	// upgrader upgrades the connections of the subscribers to websockets.
+	upgrader = websocket.Upgrader{
+		HandshakeTimeout: writeTimeout,
+		CheckOrigin:      func(r *http.Request) bool { return true },
+	}
+)
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run).Consumes(shutdown.ComponentWebAPI, shutdown.ComponentProtocol)
+}
+
+func configure(plugin *node.Plugin) {
+	hub = subscriptions.New(
+		subscriptions.WithMaxTopics(webapi.Parameters.Subscriptions.MaxTopics),
+		subscriptions.WithMaxPendingNotifications(webapi.Parameters.Subscriptions.MaxPendingNotifications),
+	)
+
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionBooked.Hook(func(event *mempool.TransactionBookedEvent) {
+		publishTransactionNotification(subscriptions.TransactionBooked, event.TransactionID)
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionAccepted.Hook(func(event *mempool.TransactionEvent) {
+		publishTransactionNotification(subscriptions.TransactionAccepted, event.Metadata.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.TransactionRejected.Hook(func(transactionMetadata *mempool.TransactionMetadata) {
+		publishTransactionNotification(subscriptions.TransactionRejected, transactionMetadata.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictAccepted.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		publishConflictNotification(subscriptions.ConflictAccepted, conflict.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+	deps.Protocol.Events.Engine.Ledger.MemPool.ConflictDAG.ConflictRejected.Hook(func(conflict *conflictdag.Conflict[utxo.TransactionID, utxo.OutputID]) {
+		publishConflictNotification(subscriptions.ConflictRejected, conflict.ID())
+	}, event.WithWorkerPool(plugin.WorkerPool))
+
+	deps.Server.GET("ws/subscriptions", Subscribe)
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+
+		hub.Shutdown()
+	}, Plugin.ShutdownOrder()); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// region Subscribe ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscribe is the handler for the /ws/subscriptions endpoint. It upgrades the connection to a websocket over which
+// the client sends SubscriptionRequests to change its topics (addresses, transactions and conflicts) and receives the
+// notifications about the booking, acceptance and rejection of the transactions and conflicts that concern them.
+func Subscribe(c echo.Context) error {
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return nil
+	}
+	defer ws.Close()
+	ws.SetReadLimit(maxRequestSize)
+
+	subscriber := hub.Connect()
+	defer subscriber.Close()
+
+	// the requests are processed concurrently to the notifications, so the writes to the websocket are synchronized
+	var writeMutex sync.Mutex
+	write := func(message *jsonmodels.SubscriptionMessage) (err error) {
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+
+		if err = ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return err
+		}
+
+		return ws.WriteJSON(message)
+	}
+
+	// the stream outlives the timeout of the request, so it is only stopped by the client or the subscriber
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		defer cancel()
+
+		for {
+			request := new(jsonmodels.SubscriptionRequest)
+			if readErr := ws.ReadJSON(request); readErr != nil {
+				if !isDecodingError(readErr) {
+					return
+				}
+
+				if writeErr := write(jsonmodels.NewSubscriptionErrorMessage(readErr)); writeErr != nil {
+					return
+				}
+				continue
+			}
+
+			if writeErr := write(processRequest(subscriber, request)); writeErr != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		message, nextErr := subscriber.Next(ctx)
+		if nextErr != nil {
+			if errors.Is(nextErr, subscriptions.ErrSubscriberOverflow) {
+				_ = write(jsonmodels.NewSubscriptionErrorMessage(nextErr))
+			}
+
+			return nil
+		}
+
+		if err = write(jsonmodels.NewSubscriptionMessage(message)); err != nil {
+			return nil
+		}
+	}
+}
+
+// processRequest applies the given SubscriptionRequest to the given Subscriber and returns the SubscriptionMessage
+// that acknowledges it (or reports why it failed).
+func processRequest(subscriber *subscriptions.Subscriber, request *jsonmodels.SubscriptionRequest) (message *jsonmodels.SubscriptionMessage) {
+	topics, err := requestedTopics(request)
+	if err != nil {
+		return jsonmodels.NewSubscriptionErrorMessage(err)
+	}
+
+	switch request.Action {
+	case jsonmodels.SubscriptionActionSubscribe:
+		if err = subscriber.Subscribe(topics...); err != nil {
+			return jsonmodels.NewSubscriptionErrorMessage(err)
+		}
+	case jsonmodels.SubscriptionActionUnsubscribe:
+		subscriber.Unsubscribe(topics...)
+	default:
+		return jsonmodels.NewSubscriptionErrorMessage(errors.Errorf("unknown action '%s'", request.Action))
+	}
+
+	return jsonmodels.NewSubscriptionTopicsMessage(subscriber.Topics())
+}
+
+// isDecodingError returns true if the given error was caused by an invalid request (rather than by the connection).
+func isDecodingError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+// requestedTopics returns the validated Topics of the given SubscriptionRequest.
+func requestedTopics(request *jsonmodels.SubscriptionRequest) (topics []subscriptions.Topic, err error) {
+	topics = make([]subscriptions.Topic, 0, len(request.Addresses)+len(request.TransactionIDs)+len(request.ConflictIDs))
+
+	for _, encodedAddress := range request.Addresses {
+		address, parseErr := devnetvm.AddressFromBase58EncodedString(encodedAddress)
+		if parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "failed to parse address %s", encodedAddress)
+		}
+		topics = append(topics, subscriptions.AddressTopic(address.Base58()))
+	}
+
+	for _, encodedTransactionID := range request.TransactionIDs {
+		var transactionID utxo.TransactionID
+		if parseErr := transactionID.FromBase58(encodedTransactionID); parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "failed to parse transaction ID %s", encodedTransactionID)
+		}
+		topics = append(topics, subscriptions.TransactionTopic(transactionID))
+	}
+
+	for _, encodedConflictID := range request.ConflictIDs {
+		var conflictID utxo.TransactionID
+		if parseErr := conflictID.FromBase58(encodedConflictID); parseErr != nil {
+			return nil, errors.Wrapf(parseErr, "failed to parse conflict ID %s", encodedConflictID)
+		}
+		topics = append(topics, subscriptions.ConflictTopic(conflictID))
+	}
+
+	return topics, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Notifications ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// publishTransactionNotification publishes a Notification of the given type for the Transaction with the given ID to
+// the subscribers of the Transaction, its Conflicts and the addresses of its Inputs and Outputs.
+func publishTransactionNotification(notificationType subscriptions.NotificationType, transactionID utxo.TransactionID) {
+	hub.Publish(&subscriptions.Notification{
+		Type:          notificationType,
+		TransactionID: transactionID,
+		Topics:        transactionTopics(transactionID),
+	})
+}
+
+// publishConflictNotification publishes a Notification of the given type for the Conflict with the given ID.
+func publishConflictNotification(notificationType subscriptions.NotificationType, conflictID utxo.TransactionID) {
+	hub.Publish(&subscriptions.Notification{
+		Type:          notificationType,
+		TransactionID: conflictID,
+		Topics:        []subscriptions.Topic{subscriptions.ConflictTopic(conflictID)},
+	})
+}
+
+// transactionTopics returns the Topics that a Notification of the Transaction with the given ID concerns.
+func transactionTopics(transactionID utxo.TransactionID) (topics []subscriptions.Topic) {
+	topics = []subscriptions.Topic{subscriptions.TransactionTopic(transactionID)}
+
+	memPoolStorage := deps.Protocol.Engine().Ledger.MemPool().Storage()
+	memPoolStorage.CachedTransactionMetadata(transactionID).Consume(func(transactionMetadata *mempool.TransactionMetadata) {
+		for it := transactionMetadata.ConflictIDs().Iterator(); it.HasNext(); {
+			topics = append(topics, subscriptions.ConflictTopic(it.Next()))
+		}
+	})
+
+	addresses := make(map[string]bool)
+	memPoolStorage.CachedTransaction(transactionID).Consume(func(transaction utxo.Transaction) {
+		devnetTransaction, isDevnetTransaction := transaction.(*devnetvm.Transaction)
+		if !isDevnetTransaction {
+			return
+		}
+
+		for _, input := range devnetTransaction.Essence().Inputs() {
+			utxoInput, isUTXOInput := input.(*devnetvm.UTXOInput)
+			if !isUTXOInput {
+				continue
+			}
+
+			memPoolStorage.CachedOutput(utxoInput.ReferencedOutputID()).Consume(func(output utxo.Output) {
+				if devnetOutput, isDevnetOutput := output.(devnetvm.Output); isDevnetOutput {
+					addresses[devnetOutput.Address().Base58()] = true
+				}
+			})
+		}
+
+		for _, output := range devnetTransaction.Essence().Outputs() {
+			addresses[output.Address().Base58()] = true
+		}
+	})
+
+	for address := range addresses {
+		topics = append(topics, subscriptions.AddressTopic(address))
+	}
+
+	return topics
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,143 @@
+package webapi
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	// APIVersion contains the current version of the web API.
+	APIVersion = "v1"
+
+	// APIPrefix contains the path prefix of the routes of the current version of the web API.
+	APIPrefix = "/api/" + APIVersion
+
+	// apiRoot contains the path prefix that is shared by all versions of the web API.
+	apiRoot = "/api/"
+
+	// HeaderDeprecation contains the name of the header that marks a deprecated route.
+	HeaderDeprecation = "Deprecation"
+
+	// HeaderSunset contains the name of the header that announces the removal of a deprecated route.
+	HeaderSunset = "Sunset"
+
+	// HeaderLink contains the name of the header that references the successor of a deprecated route.
+	HeaderLink = "Link"
+)
+
+// region Deprecation //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Deprecation contains the details that are announced to the clients of a deprecated route.
+type Deprecation struct {
+	// Since contains the time at which the route was deprecated (the zero value only marks the route as deprecated).
+	Since time.Time
+
+	// Sunset contains the time after which the route will be removed (the zero value announces no removal date).
+	Sunset time.Time
+
+	// Successor contains the path of the route that replaces the deprecated route.
+	Successor string
+}
+
+// writeHeaders announces the Deprecation in the headers of the given response.
+func (d *Deprecation) writeHeaders(header http.Header) {
+	if d.Since.IsZero() {
+		header.Set(HeaderDeprecation, "true")
+	} else {
+		header.Set(HeaderDeprecation, d.Since.UTC().Format(http.TimeFormat))
+	}
+
+	if !d.Sunset.IsZero() {
+		header.Set(HeaderSunset, d.Sunset.UTC().Format(http.TimeFormat))
+	}
+
+	if d.Successor != "" {
+		header.Add(HeaderLink, "<"+d.Successor+">; rel=\"successor-version\"")
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region deprecated routes ////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	// deprecatedRoutes contains the Deprecations of the routes, indexed by their method and path.
+	deprecatedRoutes = make(map[string]*Deprecation)
+
+	// deprecatedRoutesMutex is used to synchronize access to the deprecatedRoutes.
+	deprecatedRoutesMutex sync.RWMutex
+
+	// legacySunset contains the time after which the unversioned routes will be removed.
+	legacySunset time.Time
+)
+
+// DeprecateRoute marks the route with the given method and (unversioned) path as deprecated, so that all responses of
+// the route announce the given Deprecation to the clients.
+func DeprecateRoute(method, path string, deprecation *Deprecation) {
+	deprecatedRoutesMutex.Lock()
+	defer deprecatedRoutesMutex.Unlock()
+
+	deprecatedRoutes[routeKey(method, path)] = deprecation
+}
+
+// RouteDeprecation returns the Deprecation of the route with the given method and (unversioned) path.
+func RouteDeprecation(method, path string) (deprecation *Deprecation, exists bool) {
+	deprecatedRoutesMutex.RLock()
+	defer deprecatedRoutesMutex.RUnlock()
+
+	deprecation, exists = deprecatedRoutes[routeKey(method, path)]
+
+	return deprecation, exists
+}
+
+// routeKey returns the key of the route with the given method and path in the deprecatedRoutes.
+func routeKey(method, path string) string {
+	return method + " /" + strings.TrimPrefix(path, "/")
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region middlewares //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// versionedRouting is a pre-routing middleware that serves the routes of the current version under the APIPrefix. The
+// unversioned paths are kept as a compatibility layer for existing clients and get marked as deprecated (or rejected if
+// the legacy routes are disabled).
+func versionedRouting(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		request := c.Request()
+
+		switch path := request.URL.Path; {
+		case path == APIPrefix || strings.HasPrefix(path, APIPrefix+"/"):
+			request.URL.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(path, APIPrefix), "/")
+			request.URL.RawPath = ""
+		case strings.HasPrefix(path, apiRoot):
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("unsupported API version in %s", path)))
+		case !Parameters.LegacyRoutes.Enabled:
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("unversioned routes are disabled, use %s%s", APIPrefix, path)))
+		default:
+			(&Deprecation{Sunset: legacySunset, Successor: APIPrefix + path}).writeHeaders(c.Response().Header())
+		}
+
+		return next(c)
+	}
+}
+
+// routeDeprecation is a middleware that announces the Deprecation of the matched route to the clients.
+func routeDeprecation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if deprecation, exists := RouteDeprecation(c.Request().Method, c.Path()); exists {
+			deprecation.writeHeaders(c.Response().Header())
+		}
+
+		return next(c)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
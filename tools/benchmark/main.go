@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/client"
+)
+
+const (
+	cfgBaseline       = "baseline"
+	cfgCandidate      = "candidate"
+	cfgWorkload       = "workload"
+	cfgArgs           = "args"
+	cfgAPI            = "api"
+	cfgProfiling      = "profiling"
+	cfgLegacyRoutes   = "legacyRoutes"
+	cfgSpeed          = "speed"
+	cfgSettle         = "settle"
+	cfgStartupTimeout = "startupTimeout"
+	cfgSampleInterval = "sampleInterval"
+	cfgOutput         = "output"
+
+	// journalPluginName is the name of the plugin that records the confirmations of the issued blocks.
+	journalPluginName = "Journal"
+
+	// journalPageSize is the number of journal entries that are requested from a node at once.
+	journalPageSize = 1000
+)
+
+func init() {
+	flag.String(cfgBaseline, "", "the path of the node binary that the candidate is compared to")
+	flag.String(cfgCandidate, "", "the path of the node binary that is benchmarked")
+	flag.String(cfgWorkload, "", "the journal directory of a node that recorded the replayed workload")
+	flag.StringSlice(cfgArgs, nil, "the arguments that both node binaries are started with (i.e. the config and the snapshot)")
+	flag.String(cfgAPI, "http://127.0.0.1:8080", "the URI of the web API of the started nodes")
+	flag.String(cfgProfiling, "http://127.0.0.1:6061", "the URI of the profiling server of the started nodes")
+	flag.Bool(cfgLegacyRoutes, false, "use the unversioned routes of the web API (for binaries that don't serve the versioned routes)")
+	flag.Float64(cfgSpeed, 1, "the speed with which the workload is replayed (2 replays it in half of the recorded time)")
+	flag.Duration(cfgSettle, 30*time.Second, "the time that the harness waits for the confirmation of the issued blocks")
+	flag.Duration(cfgStartupTimeout, 5*time.Minute, "the time that the harness waits for a started node to become synced")
+	flag.Duration(cfgSampleInterval, time.Second, "the interval in which the memory statistics of the nodes are sampled")
+	flag.String(cfgOutput, "", "the path of a file that the report is written to as JSON (optional)")
+}
+
+func main() {
+	// example usage:
+	//   go run ./tools/benchmark --baseline=./goshimmer-main --candidate=./goshimmer-pr --workload=./journal \
+	//     --args=--config=config.json,--protocol.snapshot.path=/tmp/snapshot.bin --speed=2
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	if err := benchmark(); err != nil {
+		fmt.Fprintf(os.Stderr, "benchmark failed: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// benchmark runs the workload against the baseline and the candidate (one after the other, so that they don't compete
+// for resources) and prints the comparative report.
+func benchmark() (err error) {
+	baseline, candidate := viper.GetString(cfgBaseline), viper.GetString(cfgCandidate)
+	if baseline == "" || candidate == "" {
+		return errors.New("both a baseline and a candidate binary have to be given")
+	}
+
+	speed := viper.GetFloat64(cfgSpeed)
+	if speed <= 0 {
+		return errors.Errorf("invalid speed %f [>0]", speed)
+	}
+
+	workload, err := loadWorkload(viper.GetString(cfgWorkload))
+	if err != nil {
+		return err
+	}
+	workload = workload.Scale(speed)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	report := &Report{
+		Workload: viper.GetString(cfgWorkload),
+		Speed:    speed,
+		Blocks:   len(workload.Offsets),
+	}
+
+	fmt.Printf("replaying %d blocks over %s against each binary\n", len(workload.Offsets), workload.Duration().Round(time.Second))
+
+	if report.Baseline, err = run(ctx, baseline, workload); err != nil {
+		return errors.Wrap(err, "baseline run failed")
+	}
+
+	if report.Candidate, err = run(ctx, candidate, workload); err != nil {
+		return errors.Wrap(err, "candidate run failed")
+	}
+
+	for _, result := range []*Result{report.Baseline, report.Candidate} {
+		if result.Failed > 0 {
+			report.Notes = append(report.Notes, fmt.Sprintf("%s failed to issue %d blocks - the results are not comparable", result.Binary, result.Failed))
+		}
+		if result.PeakSys == 0 {
+			report.Notes = append(report.Notes, fmt.Sprintf("no memory statistics for %s - is the profiling plugin enabled?", result.Binary))
+		}
+	}
+
+	report.Print(os.Stdout)
+
+	if output := viper.GetString(cfgOutput); output != "" {
+		return report.WriteJSON(output)
+	}
+
+	return nil
+}
+
+// run starts the given binary, replays the workload against it and measures the confirmation of the issued blocks
+// (using the journal of the node) and the memory usage (using the profiling server of the node).
+func run(ctx context.Context, binary string, workload *Workload) (result *Result, err error) {
+	var apiOptions []client.Option
+	if viper.GetBool(cfgLegacyRoutes) {
+		apiOptions = append(apiOptions, client.WithLegacyRoutes())
+	}
+
+	startupCtx, cancelStartup := context.WithTimeout(ctx, viper.GetDuration(cfgStartupTimeout))
+	defer cancelStartup()
+
+	fmt.Printf("starting %s\n", binary)
+	node, err := startNode(startupCtx, binary, append(viper.GetStringSlice(cfgArgs), "--node.enablePlugins="+journalPluginName), viper.GetString(cfgAPI), viper.GetString(cfgProfiling), apiOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		node.Stop()
+
+		if cleanupErr := node.Cleanup(); cleanupErr != nil {
+			fmt.Fprintf(os.Stderr, "failed to clean up after %s: %s\n", binary, cleanupErr)
+		}
+	}()
+
+	fromSequence, err := lastJournalSequence(node)
+	if err != nil {
+		return nil, err
+	}
+
+	samplerCtx, stopSampler := context.WithCancel(ctx)
+	sampler := startMemSampler(samplerCtx, node, viper.GetDuration(cfgSampleInterval))
+
+	fmt.Printf("replaying workload against %s\n", binary)
+	start := time.Now()
+	issueTimes, failed := replay(ctx, node, workload, start)
+
+	select {
+	case <-ctx.Done():
+	case <-node.Exited():
+	case <-time.After(viper.GetDuration(cfgSettle)):
+	}
+
+	stopSampler()
+	sampler.Wait(node)
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	latencies, lastConfirmation, err := confirmationLatencies(node, fromSequence, issueTimes)
+	if err != nil {
+		return nil, err
+	}
+
+	var duration time.Duration
+	if len(latencies) > 0 {
+		duration = lastConfirmation.Sub(start)
+	}
+
+	return newResult(binary, len(issueTimes)+failed, failed, latencies, duration, sampler), nil
+}
+
+// replay issues a data block at every offset of the workload and returns the times at which the blocks were issued.
+func replay(ctx context.Context, node *Node, workload *Workload, start time.Time) (issueTimes map[string]time.Time, failed int) {
+	issueTimes = make(map[string]time.Time)
+
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	defer waitGroup.Wait()
+
+	for i, offset := range workload.Offsets {
+		select {
+		case <-ctx.Done():
+			return issueTimes, failed
+		case <-node.Exited():
+			waitGroup.Wait()
+
+			return issueTimes, failed + len(workload.Offsets) - i
+		case <-time.After(time.Until(start.Add(offset))):
+		}
+
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+
+			issueTime := time.Now()
+			blockID, err := node.API.Data([]byte(fmt.Sprintf("benchmark-%d", i)))
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				failed++
+				return
+			}
+			issueTimes[blockID] = issueTime
+		}(i)
+	}
+	waitGroup.Wait()
+
+	return issueTimes, failed
+}
+
+// lastJournalSequence returns the sequence number of the latest journal entry of the node.
+func lastJournalSequence(node *Node) (sequence uint64, err error) {
+	response, err := node.API.GetJournalEntries(0, 1)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read journal - is the journal webapi of the binary available?")
+	}
+
+	return response.LastSequence, nil
+}
+
+// confirmationLatencies reads the journal of the node (starting at the given sequence number) and returns the
+// confirmation latencies of the issued blocks together with the time of the last confirmation.
+func confirmationLatencies(node *Node, fromSequence uint64, issueTimes map[string]time.Time) (latencies []time.Duration, lastConfirmation time.Time, err error) {
+	for sequence := fromSequence + 1; ; {
+		response, err := node.API.GetJournalEntries(sequence, journalPageSize)
+		if err != nil {
+			return nil, time.Time{}, errors.Wrap(err, "failed to read journal")
+		}
+
+		for _, entry := range response.Entries {
+			sequence = entry.Sequence + 1
+
+			if entry.Type != entryTypeBlockConfirmed {
+				continue
+			}
+
+			var confirmedBlock struct {
+				BlockID string `json:"blockID"`
+			}
+			if err = json.Unmarshal(entry.Payload, &confirmedBlock); err != nil {
+				return nil, time.Time{}, errors.Wrapf(err, "failed to parse journal entry %d", entry.Sequence)
+			}
+
+			if issueTime, issued := issueTimes[confirmedBlock.BlockID]; issued {
+				latencies = append(latencies, entry.Time.Sub(issueTime))
+				if entry.Time.After(lastConfirmation) {
+					lastConfirmation = entry.Time
+				}
+			}
+		}
+
+		if len(response.Entries) == 0 || sequence > response.LastSequence {
+			return latencies, lastConfirmation, nil
+		}
+	}
+}
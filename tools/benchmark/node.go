@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/client"
+)
+
+// Node is a node process that is started from a binary in its own (empty) working directory, so that every run starts
+// with a fresh database.
+type Node struct {
+	// API is the client of the web API of the Node.
+	API *client.GoShimmerAPI
+
+	binary       string
+	workDir      string
+	profilingURL string
+	command      *exec.Cmd
+	exited       chan struct{}
+}
+
+// startNode starts the given node binary with the given arguments and waits until its web API is synced.
+func startNode(ctx context.Context, binary string, args []string, apiURL, profilingURL string, apiOptions []client.Option) (node *Node, err error) {
+	workDir, err := os.MkdirTemp("", "benchmark-"+filepath.Base(binary)+"-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create working directory")
+	}
+
+	logFile, err := os.Create(filepath.Join(workDir, "node.log"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create log file")
+	}
+
+	node = &Node{
+		API:          client.NewGoShimmerAPI(apiURL, apiOptions...),
+		binary:       binary,
+		workDir:      workDir,
+		profilingURL: profilingURL,
+		command:      exec.Command(binary, args...),
+		exited:       make(chan struct{}),
+	}
+	node.command.Dir = workDir
+	node.command.Stdout = logFile
+	node.command.Stderr = logFile
+
+	if err = node.command.Start(); err != nil {
+		_ = logFile.Close()
+		return nil, errors.Wrapf(err, "failed to start %s", binary)
+	}
+
+	go func() {
+		_ = node.command.Wait()
+		_ = logFile.Close()
+		close(node.exited)
+	}()
+
+	if err = node.waitSynced(ctx); err != nil {
+		node.Stop()
+		return nil, errors.Wrapf(err, "%s did not become ready (see %s)", binary, logFile.Name())
+	}
+
+	return node, nil
+}
+
+// MemStats returns the memory statistics of the Go runtime of the Node (read from the heap profile of the profiling
+// plugin).
+func (n *Node) MemStats() (memStats *MemStats, err error) {
+	response, err := http.Get(strings.TrimSuffix(n.profilingURL, "/") + "/debug/pprof/heap?debug=1")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request heap profile")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to request heap profile: %s", response.Status)
+	}
+
+	memStats = new(MemStats)
+	for scanner := bufio.NewScanner(response.Body); scanner.Scan(); {
+		name, value, found := strings.Cut(strings.TrimPrefix(scanner.Text(), "# "), " = ")
+		if !found {
+			continue
+		}
+
+		target, exists := map[string]*uint64{
+			"HeapAlloc":  &memStats.HeapAlloc,
+			"Sys":        &memStats.Sys,
+			"TotalAlloc": &memStats.TotalAlloc,
+			"Mallocs":    &memStats.Mallocs,
+			"NumGC":      &memStats.NumGC,
+		}[name]
+		if !exists {
+			continue
+		}
+
+		if *target, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", name)
+		}
+	}
+
+	return memStats, nil
+}
+
+// Exited returns a channel that is closed when the process of the Node exits.
+func (n *Node) Exited() <-chan struct{} {
+	return n.exited
+}
+
+// Stop shuts the Node down gracefully (it is killed if it does not exit in time).
+func (n *Node) Stop() {
+	_ = n.command.Process.Signal(syscall.SIGTERM)
+
+	select {
+	case <-n.exited:
+	case <-time.After(time.Minute):
+		_ = n.command.Process.Kill()
+		<-n.exited
+	}
+}
+
+// Cleanup removes the working directory of the Node.
+func (n *Node) Cleanup() error {
+	return os.RemoveAll(n.workDir)
+}
+
+// waitSynced polls the info endpoint of the Node until it reports to be synced.
+func (n *Node) waitSynced(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.exited:
+			return errors.New("node exited")
+		case <-ticker.C:
+			if info, err := n.API.Info(); err == nil && info.TangleTime.Synced {
+				return nil
+			}
+		}
+	}
+}
+
+// region MemStats /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MemStats contains the subset of the runtime.MemStats of a Node that is compared by the benchmark.
+type MemStats struct {
+	HeapAlloc  uint64
+	Sys        uint64
+	TotalAlloc uint64
+	Mallocs    uint64
+	NumGC      uint64
+}
+
+// memSampler periodically samples the MemStats of a Node and keeps track of the peak values.
+type memSampler struct {
+	first     *MemStats
+	last      *MemStats
+	peakHeap  uint64
+	peakSys   uint64
+	failures  int
+	mutex     sync.Mutex
+	waitGroup sync.WaitGroup
+}
+
+// startMemSampler starts sampling the MemStats of the given Node in the given interval until the context is done.
+func startMemSampler(ctx context.Context, node *Node, interval time.Duration) (sampler *memSampler) {
+	sampler = new(memSampler)
+	sampler.sample(node)
+
+	sampler.waitGroup.Add(1)
+	go func() {
+		defer sampler.waitGroup.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sampler.sample(node)
+			}
+		}
+	}()
+
+	return sampler
+}
+
+// Wait waits until the sampler stopped and takes a final sample.
+func (m *memSampler) Wait(node *Node) {
+	m.waitGroup.Wait()
+	m.sample(node)
+}
+
+func (m *memSampler) sample(node *Node) {
+	memStats, err := node.MemStats()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err != nil {
+		m.failures++
+		return
+	}
+
+	if m.first == nil {
+		m.first = memStats
+	}
+	m.last = memStats
+
+	if memStats.HeapAlloc > m.peakHeap {
+		m.peakHeap = memStats.HeapAlloc
+	}
+	if memStats.Sys > m.peakSys {
+		m.peakSys = memStats.Sys
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
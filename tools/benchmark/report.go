@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// region Result ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Result contains the measurements of a single benchmark run of a node binary.
+type Result struct {
+	Binary         string        `json:"binary"`
+	Issued         int           `json:"issued"`
+	Failed         int           `json:"failed"`
+	Confirmed      int           `json:"confirmed"`
+	Duration       time.Duration `json:"duration"`
+	TPS            float64       `json:"tps"`
+	LatencyP50     time.Duration `json:"latencyP50"`
+	LatencyP90     time.Duration `json:"latencyP90"`
+	LatencyP99     time.Duration `json:"latencyP99"`
+	LatencyMax     time.Duration `json:"latencyMax"`
+	PeakHeapAlloc  uint64        `json:"peakHeapAlloc"`
+	PeakSys        uint64        `json:"peakSys"`
+	AllocatedBytes uint64        `json:"allocatedBytes"`
+	Allocations    uint64        `json:"allocations"`
+	GCCycles       uint64        `json:"gcCycles"`
+}
+
+// newResult creates a Result from the raw measurements of a run.
+func newResult(binary string, issued, failed int, latencies []time.Duration, duration time.Duration, sampler *memSampler) (result *Result) {
+	result = &Result{
+		Binary:    binary,
+		Issued:    issued,
+		Failed:    failed,
+		Confirmed: len(latencies),
+		Duration:  duration,
+	}
+
+	if duration > 0 {
+		result.TPS = float64(len(latencies)) / duration.Seconds()
+	}
+
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		result.LatencyP50 = percentile(latencies, 0.5)
+		result.LatencyP90 = percentile(latencies, 0.9)
+		result.LatencyP99 = percentile(latencies, 0.99)
+		result.LatencyMax = latencies[len(latencies)-1]
+	}
+
+	if sampler.first != nil {
+		result.PeakHeapAlloc = sampler.peakHeap
+		result.PeakSys = sampler.peakSys
+		result.AllocatedBytes = sampler.last.TotalAlloc - sampler.first.TotalAlloc
+		result.Allocations = sampler.last.Mallocs - sampler.first.Mallocs
+		result.GCCycles = sampler.last.NumGC - sampler.first.NumGC
+	}
+
+	return result
+}
+
+// percentile returns the given percentile of the sorted durations (using the nearest-rank method).
+func percentile(sortedDurations []time.Duration, p float64) time.Duration {
+	rank := int(p*float64(len(sortedDurations))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sortedDurations) {
+		rank = len(sortedDurations) - 1
+	}
+
+	return sortedDurations[rank]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Report ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Report compares the Results of the baseline and the candidate binary.
+type Report struct {
+	Workload  string   `json:"workload"`
+	Speed     float64  `json:"speed"`
+	Blocks    int      `json:"blocks"`
+	Baseline  *Result  `json:"baseline"`
+	Candidate *Result  `json:"candidate"`
+	Notes     []string `json:"notes,omitempty"`
+}
+
+// Print writes the Report as a human-readable table to the given writer.
+func (r *Report) Print(writer io.Writer) {
+	fmt.Fprintf(writer, "workload: %s (%d blocks, speed %.2fx)\n", r.Workload, r.Blocks, r.Speed)
+	fmt.Fprintf(writer, "baseline: %s\ncandidate: %s\n\n", r.Baseline.Binary, r.Candidate.Binary)
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(table, "metric\tbaseline\tcandidate\tchange\t")
+
+	row := func(name string, baseline, candidate float64, format func(float64) string) {
+		change := "n/a"
+		if baseline != 0 {
+			change = fmt.Sprintf("%+.1f%%", (candidate-baseline)/baseline*100)
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t\n", name, format(baseline), format(candidate), change)
+	}
+
+	count := func(value float64) string { return fmt.Sprintf("%.0f", value) }
+	rate := func(value float64) string { return fmt.Sprintf("%.2f", value) }
+	duration := func(value float64) string { return time.Duration(value).Round(time.Millisecond).String() }
+	bytes := func(value float64) string { return fmt.Sprintf("%.1f MiB", value/(1<<20)) }
+
+	row("issued blocks", float64(r.Baseline.Issued), float64(r.Candidate.Issued), count)
+	row("failed blocks", float64(r.Baseline.Failed), float64(r.Candidate.Failed), count)
+	row("confirmed blocks", float64(r.Baseline.Confirmed), float64(r.Candidate.Confirmed), count)
+	row("confirmed TPS", r.Baseline.TPS, r.Candidate.TPS, rate)
+	row("latency p50", float64(r.Baseline.LatencyP50), float64(r.Candidate.LatencyP50), duration)
+	row("latency p90", float64(r.Baseline.LatencyP90), float64(r.Candidate.LatencyP90), duration)
+	row("latency p99", float64(r.Baseline.LatencyP99), float64(r.Candidate.LatencyP99), duration)
+	row("latency max", float64(r.Baseline.LatencyMax), float64(r.Candidate.LatencyMax), duration)
+	row("peak heap", float64(r.Baseline.PeakHeapAlloc), float64(r.Candidate.PeakHeapAlloc), bytes)
+	row("peak sys", float64(r.Baseline.PeakSys), float64(r.Candidate.PeakSys), bytes)
+	row("allocated", float64(r.Baseline.AllocatedBytes), float64(r.Candidate.AllocatedBytes), bytes)
+	row("allocations", float64(r.Baseline.Allocations), float64(r.Candidate.Allocations), count)
+	row("GC cycles", float64(r.Baseline.GCCycles), float64(r.Candidate.GCCycles), count)
+
+	_ = table.Flush()
+
+	for _, note := range r.Notes {
+		fmt.Fprintf(writer, "\nnote: %s", note)
+	}
+	fmt.Fprintln(writer)
+}
+
+// WriteJSON writes the Report as JSON to the file with the given path.
+func (r *Report) WriteJSON(path string) error {
+	serializedReport, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize report")
+	}
+
+	if err = os.WriteFile(path, serializedReport, 0o644); err != nil {
+		return errors.Wrapf(err, "failed to write report to %s", path)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
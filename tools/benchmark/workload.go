@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/journal"
+	"github.com/iotaledger/goshimmer/packages/core/database"
+)
+
+// entryTypeBlockConfirmed is the type of the journal entries of confirmed blocks (see plugins/journal).
+const entryTypeBlockConfirmed = "blockConfirmed"
+
+// Workload contains the points in time (relative to the start of the recording) at which the recorded node confirmed
+// blocks. Replaying it issues a block at each of these offsets, so that both node versions are exposed to the same
+// load profile.
+type Workload struct {
+	// Offsets contains the (ascending) offsets at which blocks are issued.
+	Offsets []time.Duration
+}
+
+// loadWorkload reads the Workload from the journal database in the given directory.
+func loadWorkload(directory string) (workload *Workload, err error) {
+	// opening the database creates it if the directory does not exist, so we make sure that we don't create a new journal
+	if _, err = os.Stat(directory); err != nil {
+		return nil, errors.Wrapf(err, "failed to access workload %s", directory)
+	}
+
+	db, err := database.NewDB(directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open workload %s", directory)
+	}
+	defer db.Close()
+
+	recording := journal.New(db.NewStore())
+	defer recording.Close()
+
+	workload = new(Workload)
+	var start time.Time
+	if err = recording.Replay(recording.FirstSequence(), func(entry *journal.Entry) bool {
+		if entry.Type != entryTypeBlockConfirmed {
+			return true
+		}
+
+		if start.IsZero() {
+			start = entry.Time
+		}
+		workload.Offsets = append(workload.Offsets, entry.Time.Sub(start))
+
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to replay workload %s", directory)
+	}
+
+	if len(workload.Offsets) == 0 {
+		return nil, errors.Errorf("workload %s does not contain any %s entries", directory, entryTypeBlockConfirmed)
+	}
+
+	return workload, nil
+}
+
+// Scale returns a copy of the Workload that is replayed with the given speed (e.g. a speed of 2 replays the Workload
+// in half of the recorded time).
+func (w *Workload) Scale(speed float64) (scaled *Workload) {
+	scaled = &Workload{Offsets: make([]time.Duration, len(w.Offsets))}
+	for i, offset := range w.Offsets {
+		scaled.Offsets[i] = time.Duration(float64(offset) / speed)
+	}
+
+	return scaled
+}
+
+// Duration returns the time that it takes to replay the Workload.
+func (w *Workload) Duration() time.Duration {
+	return w.Offsets[len(w.Offsets)-1]
+}
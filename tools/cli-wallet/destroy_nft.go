@@ -19,6 +19,7 @@ func execDestroyNFTCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	nftIDPtr := command.String("id", "", "unique identifier of the nft that should be destroyed")
 	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
 	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+	remainderAddressPtr := command.String("remainder-address", "", "address that receives the remaining funds of the nft (optional)")
 
 	err := command.Parse(os.Args[2:])
 	if err != nil {
@@ -37,12 +38,17 @@ func execDestroyNFTCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	if err != nil {
 		printUsage(command, err.Error())
 	}
-	fmt.Println("Destroying NFT...")
-	_, err = cliWallet.DestroyNFT(
+	options := []destroynftoptions.DestroyNFTOption{
 		destroynftoptions.Alias(aliasID.Base58()),
 		destroynftoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
 		destroynftoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
-	)
+	}
+	if *remainderAddressPtr != "" {
+		options = append(options, destroynftoptions.RemainderAddress(*remainderAddressPtr))
+	}
+
+	fmt.Println("Destroying NFT...")
+	_, err = cliWallet.DestroyNFT(options...)
 	if err != nil {
 		printUsage(command, err.Error())
 	}
@@ -0,0 +1,101 @@
+// client-gen generates the typed client SDK and the OpenAPI specification of the web API from the endpoint definitions
+// in jsonmodels.Endpoints.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	// sdkFileName contains the name of the file that contains the generated client SDK.
+	sdkFileName = "sdk_generated.go"
+
+	// specFileName contains the name of the file that contains the generated OpenAPI specification.
+	specFileName = "openapi.json"
+)
+
+func main() {
+	outputDirectory := flag.String("output", "client", "the directory that the client SDK and the OpenAPI specification are written to")
+	flag.Parse()
+
+	if err := generate(*outputDirectory, jsonmodels.Endpoints); err != nil {
+		log.Fatalf("failed to generate the client SDK: %s", err)
+	}
+}
+
+// generate writes the client SDK and the OpenAPI specification of the given endpoints to the output directory.
+func generate(outputDirectory string, endpoints []*jsonmodels.Endpoint) (err error) {
+	for _, endpoint := range endpoints {
+		if err = validateEndpoint(endpoint); err != nil {
+			return errors.Wrapf(err, "invalid endpoint %s", endpoint.Name)
+		}
+	}
+
+	sdk, err := generateSDK(endpoints)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate the client SDK")
+	}
+
+	formattedSDK, err := format.Source(sdk)
+	if err != nil {
+		return errors.Wrap(err, "failed to format the client SDK")
+	}
+
+	if err = os.WriteFile(filepath.Join(outputDirectory, sdkFileName), formattedSDK, 0o644); err != nil {
+		return errors.Wrap(err, "failed to write the client SDK")
+	}
+
+	var spec bytes.Buffer
+	encoder := json.NewEncoder(&spec)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(generateSpec(endpoints)); err != nil {
+		return errors.Wrap(err, "failed to encode the OpenAPI specification")
+	}
+
+	if err = os.WriteFile(filepath.Join(outputDirectory, specFileName), spec.Bytes(), 0o644); err != nil {
+		return errors.Wrap(err, "failed to write the OpenAPI specification")
+	}
+
+	return nil
+}
+
+// validateEndpoint checks that the path parameters of the given endpoint match the placeholders of its route.
+func validateEndpoint(endpoint *jsonmodels.Endpoint) (err error) {
+	pathParameters := make(map[string]bool)
+	for _, parameter := range endpoint.Parameters {
+		switch parameter.In {
+		case jsonmodels.ParameterInPath:
+			pathParameters[parameter.Name] = true
+		case jsonmodels.ParameterInQuery:
+		default:
+			return errors.Errorf("parameter %s has an unknown location '%s'", parameter.Name, parameter.In)
+		}
+	}
+
+	for _, segment := range routeSegments(endpoint.Route) {
+		if segment.parameter == "" {
+			continue
+		}
+
+		if !pathParameters[segment.parameter] {
+			return errors.Errorf("route placeholder :%s is not defined as a path parameter", segment.parameter)
+		}
+		delete(pathParameters, segment.parameter)
+	}
+
+	for name := range pathParameters {
+		return errors.Errorf("path parameter %s is not part of the route %s", name, endpoint.Route)
+	}
+
+	return nil
+}
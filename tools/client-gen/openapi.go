@@ -0,0 +1,237 @@
+package main
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+const (
+	// specVersion contains the version of the OpenAPI specification that is generated.
+	specVersion = "3.0.3"
+
+	// apiPrefixPath contains the path prefix of the versioned web API that the routes of the endpoints are relative to.
+	apiPrefixPath = "/api/v1/"
+)
+
+// schema is the JSON model of a schema of the OpenAPI specification.
+type schema map[string]any
+
+// generateSpec returns the OpenAPI specification of the given endpoints.
+func generateSpec(endpoints []*jsonmodels.Endpoint) (spec map[string]any) {
+	schemas := make(map[string]schema)
+	schemaNames := make(map[reflect.Type]string)
+
+	paths := make(map[string]map[string]any)
+	for _, endpoint := range endpoints {
+		path := specPath(endpoint.Route)
+		if paths[path] == nil {
+			paths[path] = make(map[string]any)
+		}
+
+		paths[path][strings.ToLower(endpoint.Method)] = specOperation(endpoint, schemas, schemaNames)
+	}
+
+	return map[string]any{
+		"openapi": specVersion,
+		"info": map[string]any{
+			"title":       "GoShimmer web API",
+			"description": "The web API of a GoShimmer node (generated by client-gen from jsonmodels.Endpoints).",
+			"version":     strings.TrimSuffix(strings.TrimPrefix(apiPrefixPath, "/api/"), "/"),
+		},
+		"servers": []any{
+			map[string]any{"url": strings.TrimSuffix(apiPrefixPath, "/")},
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+		},
+	}
+}
+
+// specPath returns the OpenAPI path of the given route (path parameters are enclosed in curly braces).
+func specPath(route string) (path string) {
+	segments := make([]string, 0)
+	for _, segment := range routeSegments(route) {
+		if segment.parameter != "" {
+			segments = append(segments, "{"+segment.parameter+"}")
+		} else {
+			segments = append(segments, segment.literal)
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+// specOperation returns the OpenAPI operation of the given endpoint.
+func specOperation(endpoint *jsonmodels.Endpoint, schemas map[string]schema, schemaNames map[reflect.Type]string) (operation map[string]any) {
+	operation = map[string]any{
+		"operationId": endpoint.Name,
+		"summary":     endpoint.Name + " " + endpoint.Description,
+	}
+
+	if len(endpoint.Parameters) > 0 {
+		parameters := make([]any, 0, len(endpoint.Parameters))
+		for _, parameter := range endpoint.Parameters {
+			parameters = append(parameters, map[string]any{
+				"name":        parameter.Name,
+				"in":          string(parameter.In),
+				"description": parameter.Description,
+				"required":    parameter.In == jsonmodels.ParameterInPath,
+				"schema":      schema{"type": string(parameter.Type)},
+			})
+		}
+		operation["parameters"] = parameters
+	}
+
+	if endpoint.Request != nil {
+		operation["requestBody"] = map[string]any{
+			"required": true,
+			"content": map[string]any{
+				"application/json": map[string]any{
+					"schema": typeSchema(reflect.TypeOf(endpoint.Request), schemas, schemaNames),
+				},
+			},
+		}
+	}
+
+	successResponse := map[string]any{"description": http.StatusText(http.StatusOK)}
+	if endpoint.Response != nil {
+		successResponse["content"] = map[string]any{
+			"application/json": map[string]any{
+				"schema": typeSchema(reflect.TypeOf(endpoint.Response), schemas, schemaNames),
+			},
+		}
+	}
+
+	errorResponse := map[string]any{
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": typeSchema(reflect.TypeOf(new(jsonmodels.ErrorResponse)), schemas, schemaNames),
+			},
+		},
+	}
+
+	operation["responses"] = map[string]any{
+		"200": successResponse,
+		"400": withDescription(errorResponse, http.StatusBadRequest),
+		"404": withDescription(errorResponse, http.StatusNotFound),
+		"500": withDescription(errorResponse, http.StatusInternalServerError),
+	}
+
+	return operation
+}
+
+// withDescription returns a copy of the given response that is described by the text of the given status code.
+func withDescription(response map[string]any, statusCode int) (describedResponse map[string]any) {
+	describedResponse = map[string]any{"description": http.StatusText(statusCode)}
+	for key, value := range response {
+		describedResponse[key] = value
+	}
+
+	return describedResponse
+}
+
+// typeSchema returns the OpenAPI schema of the given type (named structs are added to the schemas and referenced).
+func typeSchema(modelType reflect.Type, schemas map[string]schema, schemaNames map[reflect.Type]string) (modelSchema schema) {
+	for modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+
+	switch modelType {
+	case reflect.TypeOf(time.Time{}):
+		return schema{"type": "string", "format": "date-time"}
+	case reflect.TypeOf(time.Duration(0)):
+		return schema{"type": "integer", "format": "int64", "description": "a duration in nanoseconds"}
+	}
+
+	switch modelType.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return schema{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if modelType.Elem().Kind() == reflect.Uint8 {
+			return schema{"type": "string", "format": "byte"}
+		}
+
+		return schema{"type": "array", "items": typeSchema(modelType.Elem(), schemas, schemaNames)}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": typeSchema(modelType.Elem(), schemas, schemaNames)}
+	case reflect.Struct:
+		if modelType.Name() == "" {
+			return structSchema(modelType, schemas, schemaNames)
+		}
+
+		return schema{"$ref": "#/components/schemas/" + namedStructSchema(modelType, schemas, schemaNames)}
+	default:
+		return schema{}
+	}
+}
+
+// namedStructSchema adds the schema of the given named struct to the schemas (if necessary) and returns its name.
+func namedStructSchema(modelType reflect.Type, schemas map[string]schema, schemaNames map[reflect.Type]string) (name string) {
+	if name, exists := schemaNames[modelType]; exists {
+		return name
+	}
+
+	name = modelType.Name()
+	if _, exists := schemas[name]; exists {
+		name = modelType.PkgPath()[strings.LastIndex(modelType.PkgPath(), "/")+1:] + "." + name
+	}
+
+	// the name is registered before the fields are resolved, so that recursive types terminate
+	schemaNames[modelType] = name
+	schemas[name] = schema{}
+	schemas[name] = structSchema(modelType, schemas, schemaNames)
+
+	return name
+}
+
+// structSchema returns the OpenAPI schema of the exported fields of the given struct.
+func structSchema(modelType reflect.Type, schemas map[string]schema, schemaNames map[reflect.Type]string) (fieldsSchema schema) {
+	properties := make(map[string]any)
+	required := make([]string, 0)
+
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embeddedSchema := structSchema(field.Type, schemas, schemaNames)
+			for propertyName, propertySchema := range embeddedSchema["properties"].(map[string]any) {
+				properties[propertyName] = propertySchema
+			}
+			required = append(required, embeddedSchema["required"].([]string)...)
+
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = typeSchema(field.Type, schemas, schemaNames)
+		if !strings.Contains(options, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			required = append(required, name)
+		}
+	}
+
+	return schema{"type": "object", "properties": properties, "required": required}
+}
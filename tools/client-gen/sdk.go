@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/app/jsonmodels"
+)
+
+// reservedNames contains the names of the variables of the generated methods that can not be used for parameters.
+var reservedNames = map[string]bool{"ctx": true, "request": true, "route": true, "query": true, "res": true, "err": true}
+
+// sdkHeader contains the part of the client SDK that precedes the generated methods.
+const sdkHeader = `// Code generated by client-gen. DO NOT EDIT.
+
+package client
+
+import (
+%s)
+
+// SDK is the typed client of the web API that is generated from the endpoint definitions in jsonmodels.Endpoints.
+type SDK struct {
+	api *GoShimmerAPI
+}
+
+// SDK returns the typed client SDK of the web API.
+func (api *GoShimmerAPI) SDK() *SDK {
+	return &SDK{api: api}
+}
+`
+
+// generateSDK returns the (unformatted) source code of the client SDK of the given endpoints.
+func generateSDK(endpoints []*jsonmodels.Endpoint) (sdk []byte, err error) {
+	imports := map[string]bool{"context": true, "net/http": true}
+
+	var methods bytes.Buffer
+	for _, endpoint := range endpoints {
+		if err = writeMethod(&methods, endpoint, imports); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate the method of endpoint %s", endpoint.Name)
+		}
+	}
+
+	importPaths := make([]string, 0, len(imports))
+	for importPath := range imports {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	// the standard library packages are grouped before the other packages
+	var standardImports, otherImports strings.Builder
+	for _, importPath := range importPaths {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			otherImports.WriteString("\t" + strconv.Quote(importPath) + "\n")
+		} else {
+			standardImports.WriteString("\t" + strconv.Quote(importPath) + "\n")
+		}
+	}
+
+	importBlock := standardImports.String()
+	if otherImports.Len() > 0 {
+		importBlock += "\n" + otherImports.String()
+	}
+
+	return append([]byte(fmt.Sprintf(sdkHeader, importBlock)), methods.Bytes()...), nil
+}
+
+// writeMethod writes the client method of the given endpoint and registers the packages that it uses in imports.
+func writeMethod(buffer *bytes.Buffer, endpoint *jsonmodels.Endpoint, imports map[string]bool) (err error) {
+	method, err := httpMethodConstant(endpoint.Method)
+	if err != nil {
+		return err
+	}
+
+	arguments := []string{"ctx context.Context"}
+	for _, parameter := range endpoint.Parameters {
+		goType, typeErr := parameterGoType(parameter)
+		if typeErr != nil {
+			return typeErr
+		}
+
+		arguments = append(arguments, parameterGoName(parameter)+" "+goType)
+	}
+
+	requestArgument := "nil"
+	if endpoint.Request != nil {
+		requestType, typeErr := modelGoType(endpoint.Request, imports)
+		if typeErr != nil {
+			return typeErr
+		}
+
+		arguments = append(arguments, "request "+requestType)
+		requestArgument = "request"
+	}
+
+	results := "error"
+	var responseType string
+	if endpoint.Response != nil {
+		if responseType, err = modelGoType(endpoint.Response, imports); err != nil {
+			return err
+		}
+
+		results = "(" + responseType + ", error)"
+	}
+
+	fmt.Fprintf(buffer, "\n// %s %s\n", endpoint.Name, endpoint.Description)
+	fmt.Fprintf(buffer, "func (s *SDK) %s(%s) %s {\n", endpoint.Name, strings.Join(arguments, ", "), results)
+	fmt.Fprintf(buffer, "\troute := %s\n", routeExpression(endpoint.Route, imports))
+	writeQuery(buffer, endpoint, imports)
+
+	if endpoint.Response == nil {
+		fmt.Fprintf(buffer, "\n\treturn s.api.doWithContext(ctx, %s, route, %s, nil)\n}\n", method, requestArgument)
+
+		return nil
+	}
+
+	fmt.Fprintf(buffer, "\n\tres := &%s{}\n", strings.TrimPrefix(responseType, "*"))
+	fmt.Fprintf(buffer, "\tif err := s.api.doWithContext(ctx, %s, route, %s, res); err != nil {\n\t\treturn nil, err\n\t}\n\n", method, requestArgument)
+	fmt.Fprintf(buffer, "\treturn res, nil\n}\n")
+
+	return nil
+}
+
+// writeQuery writes the code that appends the (non-zero) query parameters of the given endpoint to the route.
+func writeQuery(buffer *bytes.Buffer, endpoint *jsonmodels.Endpoint, imports map[string]bool) {
+	var queryParameters []*jsonmodels.Parameter
+	for _, parameter := range endpoint.Parameters {
+		if parameter.In == jsonmodels.ParameterInQuery {
+			queryParameters = append(queryParameters, parameter)
+		}
+	}
+
+	if len(queryParameters) == 0 {
+		return
+	}
+
+	imports["net/url"] = true
+	fmt.Fprintf(buffer, "\n\tquery := make(url.Values)\n")
+	for _, parameter := range queryParameters {
+		name := parameterGoName(parameter)
+
+		switch parameter.Type {
+		case jsonmodels.ParameterTypeInteger:
+			imports["strconv"] = true
+			fmt.Fprintf(buffer, "\tif %s != 0 {\n\t\tquery.Set(%q, strconv.Itoa(%s))\n\t}\n", name, parameter.Name, name)
+		case jsonmodels.ParameterTypeBoolean:
+			imports["strconv"] = true
+			fmt.Fprintf(buffer, "\tif %s {\n\t\tquery.Set(%q, strconv.FormatBool(%s))\n\t}\n", name, parameter.Name, name)
+		default:
+			fmt.Fprintf(buffer, "\tif %s != \"\" {\n\t\tquery.Set(%q, %s)\n\t}\n", name, parameter.Name, name)
+		}
+	}
+	fmt.Fprintf(buffer, "\tif len(query) > 0 {\n\t\troute += \"?\" + query.Encode()\n\t}\n")
+}
+
+// routeExpression returns the Go expression that builds the route of an endpoint from its path parameters.
+func routeExpression(route string, imports map[string]bool) (expression string) {
+	var parts []string
+	var literal strings.Builder
+	for i, segment := range routeSegments(route) {
+		if i > 0 {
+			literal.WriteString("/")
+		}
+
+		if segment.parameter == "" {
+			literal.WriteString(segment.literal)
+			continue
+		}
+
+		if literal.Len() > 0 {
+			parts = append(parts, strconv.Quote(literal.String()))
+			literal.Reset()
+		}
+
+		imports["net/url"] = true
+		parts = append(parts, "url.PathEscape("+safeGoName(segment.parameter)+")")
+	}
+
+	if literal.Len() > 0 {
+		parts = append(parts, strconv.Quote(literal.String()))
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+// routeSegment is a segment of the route of an endpoint that is either a literal or a path parameter.
+type routeSegment struct {
+	literal   string
+	parameter string
+}
+
+// routeSegments splits the given route into its segments.
+func routeSegments(route string) (segments []routeSegment) {
+	for _, segment := range strings.Split(strings.Trim(route, "/"), "/") {
+		if strings.HasPrefix(segment, ":") {
+			segments = append(segments, routeSegment{parameter: strings.TrimPrefix(segment, ":")})
+		} else {
+			segments = append(segments, routeSegment{literal: segment})
+		}
+	}
+
+	return segments
+}
+
+// httpMethodConstant returns the name of the constant of the net/http package that represents the given method.
+func httpMethodConstant(method string) (constant string, err error) {
+	switch method {
+	case "GET", "POST", "PUT", "DELETE", "PATCH":
+		return "http.Method" + method[:1] + strings.ToLower(method[1:]), nil
+	default:
+		return "", errors.Errorf("unsupported HTTP method '%s'", method)
+	}
+}
+
+// parameterGoType returns the Go type that is used for the given parameter in the client SDK.
+func parameterGoType(parameter *jsonmodels.Parameter) (goType string, err error) {
+	switch parameter.Type {
+	case jsonmodels.ParameterTypeString:
+		return "string", nil
+	case jsonmodels.ParameterTypeInteger:
+		if parameter.In == jsonmodels.ParameterInPath {
+			return "", errors.Errorf("path parameter %s must be a string", parameter.Name)
+		}
+
+		return "int", nil
+	case jsonmodels.ParameterTypeBoolean:
+		if parameter.In == jsonmodels.ParameterInPath {
+			return "", errors.Errorf("path parameter %s must be a string", parameter.Name)
+		}
+
+		return "bool", nil
+	default:
+		return "", errors.Errorf("parameter %s has an unknown type '%s'", parameter.Name, parameter.Type)
+	}
+}
+
+// parameterGoName returns the name of the argument of the given parameter in the client SDK.
+func parameterGoName(parameter *jsonmodels.Parameter) (name string) {
+	return safeGoName(parameter.Name)
+}
+
+// safeGoName returns the given name or - if it is a Go keyword or the name of a variable of the generated methods - the
+// name with a "Param" suffix.
+func safeGoName(name string) (safeName string) {
+	if token.IsKeyword(name) || reservedNames[name] {
+		return name + "Param"
+	}
+
+	return name
+}
+
+// modelGoType returns the Go type of the given JSON model and registers its package in imports.
+func modelGoType(model any, imports map[string]bool) (goType string, err error) {
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() != reflect.Pointer || modelType.Elem().Name() == "" || modelType.Elem().PkgPath() == "" {
+		return "", errors.Errorf("model %s must be a pointer to a named type", modelType)
+	}
+
+	imports[modelType.Elem().PkgPath()] = true
+
+	return modelType.String(), nil
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool"
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/utxo"
+	"github.com/iotaledger/goshimmer/packages/protocol/models"
+	"github.com/iotaledger/hive.go/core/slot"
+	"github.com/iotaledger/hive.go/ds/advancedset"
+)
+
+// settings prints the settings of the engine.
+func (s *shell) settings(_ []string) (err error) {
+	settings := s.storage.Settings
+
+	s.printf("SnapshotImported:        %t\n", settings.SnapshotImported())
+	s.printf("GenesisUnixTime:         %d\n", settings.GenesisUnixTime())
+	s.printf("SlotDuration:            %d\n", settings.SlotDuration())
+	s.printf("ChainID:                 %s\n", settings.ChainID())
+	s.printf("LatestCommitment:        %s\n", settings.LatestCommitment())
+	s.printf("LatestStateMutationSlot: %d\n", settings.LatestStateMutationSlot())
+	s.printf("LatestConfirmedSlot:     %d\n", settings.LatestConfirmedSlot())
+
+	return nil
+}
+
+// commitment prints the commitment of the given slot.
+func (s *shell) commitment(args []string) (err error) {
+	index, err := parseSlotIndex(args[0])
+	if err != nil {
+		return err
+	}
+
+	loadedCommitment, err := s.storage.Commitments.Load(index)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the commitment of slot %d", index)
+	}
+
+	s.print("Commitment "+loadedCommitment.ID().String(), loadedCommitment)
+
+	return nil
+}
+
+// block decodes and prints the given block.
+func (s *shell) block(args []string) (err error) {
+	var blockID models.BlockID
+	if err = blockID.FromBase58(args[0]); err != nil {
+		return errors.Wrapf(err, "invalid block ID '%s'", args[0])
+	}
+
+	block, err := s.storage.Blocks.Load(blockID)
+	if err != nil {
+		return err
+	} else if block == nil {
+		return errors.Errorf("block %s not found", blockID)
+	}
+
+	s.print("Block "+blockID.Base58(), block)
+
+	return nil
+}
+
+// blocks prints the IDs of the blocks that are stored for the given slot.
+func (s *shell) blocks(args []string) (err error) {
+	index, err := parseSlotIndex(args[0])
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	if err = s.storage.Blocks.ForEachBlockInSlot(index, func(blockID models.BlockID) bool {
+		s.printf("%s\n", blockID.Base58())
+		count++
+
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to iterate over the blocks of slot %d", index)
+	}
+
+	s.printf("%d blocks\n", count)
+
+	return nil
+}
+
+// output prints the given output and its metadata.
+func (s *shell) output(args []string) (err error) {
+	outputID, err := parseOutputID(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !s.ledger.Storage().CachedOutput(outputID).Consume(func(output utxo.Output) {
+		s.print("Output "+outputID.Base58(), output)
+	}) {
+		return errors.Errorf("output %s not found", outputID)
+	}
+
+	if !s.ledger.Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+		s.print("OutputMetadata", outputMetadata)
+	}) {
+		s.printf("OutputMetadata: not found\n")
+	}
+
+	return nil
+}
+
+// consumers prints the transactions that consume the given output.
+func (s *shell) consumers(args []string) (err error) {
+	outputID, err := parseOutputID(args[0])
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	s.ledger.Storage().CachedConsumers(outputID).Consume(func(consumer *mempool.Consumer) {
+		s.printf("%s (booked: %t)\n", consumer.TransactionID().Base58(), consumer.IsBooked())
+		count++
+	})
+
+	s.printf("%d consumers\n", count)
+
+	return nil
+}
+
+// transaction prints the given transaction and its metadata.
+func (s *shell) transaction(args []string) (err error) {
+	txID, err := parseTransactionID(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !s.ledger.Storage().CachedTransaction(txID).Consume(func(tx utxo.Transaction) {
+		s.print("Transaction "+txID.Base58(), tx)
+	}) {
+		return errors.Errorf("transaction %s not found", txID)
+	}
+
+	if !s.ledger.Storage().CachedTransactionMetadata(txID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		s.print("TransactionMetadata", txMetadata)
+	}) {
+		s.printf("TransactionMetadata: not found\n")
+	}
+
+	return nil
+}
+
+// conflicts prints the tree of conflicts that the given transaction is booked on. The conflict DAG is not persisted,
+// so the tree is reconstructed from the conflict IDs of the stored transaction and output metadata.
+func (s *shell) conflicts(args []string) (err error) {
+	txID, err := parseTransactionID(args[0])
+	if err != nil {
+		return err
+	}
+
+	conflictIDs, err := s.bookedConflictIDs(txID)
+	if err != nil {
+		return err
+	}
+
+	s.printf("%s\n", txID)
+
+	return s.printConflictTree(conflictIDs, 1, advancedset.New[utxo.TransactionID]())
+}
+
+// printConflictTree prints the given conflicts and (recursively) their parents. The path contains the conflicts of the
+// current branch of the tree and is used to detect cycles, which can only exist in corrupted databases.
+func (s *shell) printConflictTree(conflictIDs *advancedset.AdvancedSet[utxo.TransactionID], depth int, path *advancedset.AdvancedSet[utxo.TransactionID]) (err error) {
+	indentation := strings.Repeat("  ", depth)
+
+	if conflictIDs.IsEmpty() {
+		s.printf("%sMasterConflict\n", indentation)
+		return nil
+	}
+
+	return conflictIDs.ForEach(func(conflictID utxo.TransactionID) (err error) {
+		if path.Has(conflictID) {
+			s.printf("%s%s (cycle detected)\n", indentation, conflictID)
+			return nil
+		}
+
+		confirmationState := "unknown"
+		s.ledger.Storage().CachedTransactionMetadata(conflictID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+			confirmationState = txMetadata.ConfirmationState().String()
+		})
+		s.printf("%s%s (%s)\n", indentation, conflictID, confirmationState)
+
+		parentConflictIDs, err := s.parentConflictIDs(conflictID)
+		if err != nil {
+			s.printf("%s  error: %s\n", indentation, err)
+			return nil
+		}
+
+		path.Add(conflictID)
+		defer path.Delete(conflictID)
+
+		return s.printConflictTree(parentConflictIDs, depth+1, path)
+	})
+}
+
+// bookedConflictIDs returns the conflicts that the given transaction is booked on.
+func (s *shell) bookedConflictIDs(txID utxo.TransactionID) (conflictIDs *advancedset.AdvancedSet[utxo.TransactionID], err error) {
+	if !s.ledger.Storage().CachedTransactionMetadata(txID).Consume(func(txMetadata *mempool.TransactionMetadata) {
+		conflictIDs = txMetadata.ConflictIDs()
+	}) {
+		return nil, errors.Errorf("transaction metadata of %s not found", txID)
+	}
+
+	return conflictIDs, nil
+}
+
+// parentConflictIDs returns the parents of the given conflict (the conflicts that its inputs are booked on).
+func (s *shell) parentConflictIDs(conflictID utxo.TransactionID) (parentConflictIDs *advancedset.AdvancedSet[utxo.TransactionID], err error) {
+	var inputs []utxo.Input
+	if !s.ledger.Storage().CachedTransaction(conflictID).Consume(func(tx utxo.Transaction) {
+		inputs = tx.Inputs()
+	}) {
+		return nil, errors.Errorf("transaction %s not found", conflictID)
+	}
+
+	parentConflictIDs = advancedset.New[utxo.TransactionID]()
+	for _, input := range inputs {
+		outputID := s.ledger.VM().ResolveInput(input)
+		if !s.ledger.Storage().CachedOutputMetadata(outputID).Consume(func(outputMetadata *mempool.OutputMetadata) {
+			parentConflictIDs.AddAll(outputMetadata.ConflictIDs())
+		}) {
+			return nil, errors.Errorf("output metadata of input %s not found", outputID)
+		}
+	}
+
+	return parentConflictIDs, nil
+}
+
+// parseSlotIndex parses the given slot index.
+func parseSlotIndex(value string) (index slot.Index, err error) {
+	parsedIndex, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid slot index '%s'", value)
+	}
+
+	return slot.Index(parsedIndex), nil
+}
+
+// parseOutputID parses the given base58 encoded OutputID.
+func parseOutputID(value string) (outputID utxo.OutputID, err error) {
+	if err = outputID.FromBase58(value); err != nil {
+		return outputID, errors.Wrapf(err, "invalid output ID '%s'", value)
+	}
+
+	return outputID, nil
+}
+
+// parseTransactionID parses the given base58 encoded TransactionID.
+func parseTransactionID(value string) (txID utxo.TransactionID, err error) {
+	if err = txID.FromBase58(value); err != nil {
+		return txID, errors.Wrapf(err, "invalid transaction ID '%s'", value)
+	}
+
+	return txID, nil
+}
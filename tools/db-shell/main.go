@@ -0,0 +1,81 @@
+// db-shell is an interactive shell that opens the database of a (stopped) node and allows to inspect its content, which
+// is useful for debugging corrupted states. Like the node itself, it needs to be built with the rocksdb build tag:
+//
+//	go run -tags rocksdb ./tools/db-shell --db <path to the database directory> [command]
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/goshimmer/packages/core/database"
+	"github.com/iotaledger/goshimmer/packages/protocol"
+	"github.com/iotaledger/goshimmer/packages/storage"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+)
+
+// engineInfoFile contains the name of the file in the database directory that references the directory of the active
+// engine.
+const engineInfoFile = "info"
+
+// engineInfo is the content of the engineInfoFile.
+type engineInfo struct {
+	Name string `json:"name"`
+}
+
+func main() {
+	databaseDirectory := flag.String("db", "db", "the database directory of the node (or the directory of one of its engines)")
+	granularity := flag.Int64("granularity", 1, "how many slots are contained in a single DB instance (must match the node's setting)")
+	flag.Parse()
+
+	engineDirectory, err := resolveEngineDirectory(*databaseDirectory)
+	if err != nil {
+		log.Fatalf("failed to resolve the engine directory: %s", err)
+	}
+
+	chainStorage := storage.New(engineDirectory, protocol.DatabaseVersion, database.WithDBProvider(database.NewDB), database.WithGranularity(*granularity))
+
+	s := newShell(chainStorage, os.Stdout)
+	defer s.Shutdown()
+
+	// the remaining arguments are executed as a single command instead of starting the interactive shell
+	if flag.NArg() > 0 {
+		if err = s.Execute(strings.Join(flag.Args(), " ")); err != nil && !errors.Is(err, errExit) {
+			log.Printf("command failed: %s", err)
+		}
+
+		return
+	}
+
+	s.Run(os.Stdin)
+}
+
+// resolveEngineDirectory returns the directory of the active engine if the given directory is the database directory
+// of a node, or the given directory itself otherwise.
+func resolveEngineDirectory(directory string) (engineDirectory string, err error) {
+	if exists, isDirectory, existsErr := ioutils.PathExists(directory); existsErr != nil {
+		return "", errors.Wrapf(existsErr, "failed to access %s", directory)
+	} else if !exists || !isDirectory {
+		return "", errors.Errorf("%s is not a directory", directory)
+	}
+
+	info := new(engineInfo)
+	if err = ioutils.ReadJSONFromFile(filepath.Join(directory, engineInfoFile), info); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return directory, nil
+		}
+
+		return "", errors.Wrap(err, "failed to read the engine info file")
+	}
+
+	if info.Name == "" {
+		return "", errors.Errorf("the engine info file of %s does not reference an engine", directory)
+	}
+
+	return filepath.Join(directory, info.Name), nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
+)
+
+// print prints the given object below the given title.
+func (s *shell) print(title string, object any) {
+	s.printf("%s:\n%s\n", title, prettyPrint(object))
+}
+
+// prettyPrint returns the indented JSON representation of the given object if it is known to serix, and its
+// human-readable version otherwise.
+func prettyPrint(object any) (prettyPrinted string) {
+	encoded, err := serix.DefaultAPI.JSONEncode(context.Background(), serializableModel(object))
+	if err != nil || bytes.Equal(encoded, []byte("{}")) {
+		return fmt.Sprint(object)
+	}
+
+	var indented bytes.Buffer
+	if err = json.Indent(&indented, encoded, "", "  "); err != nil {
+		return fmt.Sprint(object)
+	}
+
+	return indented.String()
+}
+
+// serializableModel returns the inner model of the given object if it is a storable or immutable model (their fields
+// are only known to serix through the inner model), and the object itself otherwise.
+func serializableModel(object any) (model any) {
+	if object == nil {
+		return nil
+	}
+
+	innerModel := reflect.ValueOf(object).MethodByName("InnerModel")
+	if !innerModel.IsValid() || innerModel.Type().NumIn() != 0 || innerModel.Type().NumOut() != 1 {
+		return object
+	}
+
+	return innerModel.Call(nil)[0].Interface()
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/mempool/realitiesledger"
+	"github.com/iotaledger/goshimmer/packages/storage"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+// prompt is the prompt that is printed before every command of the interactive shell.
+const prompt = "db> "
+
+// errExit is returned by the exit command to end the interactive shell.
+var errExit = errors.New("exit")
+
+// command is a command of the shell.
+type command struct {
+	// usage contains the arguments that the command expects.
+	usage string
+
+	// description contains a short description of the command.
+	description string
+
+	// handler executes the command with the given arguments.
+	handler func(s *shell, args []string) (err error)
+}
+
+// commands contains the commands of the shell indexed by their name.
+var commands map[string]*command
+
+func init() {
+	commands = map[string]*command{
+		"help":        {"", "show the available commands", (*shell).help},
+		"exit":        {"", "close the database and exit the shell", (*shell).exit},
+		"quit":        {"", "close the database and exit the shell", (*shell).exit},
+		"settings":    {"", "show the settings of the engine", (*shell).settings},
+		"commitment":  {"<slotIndex>", "show the commitment of a slot", (*shell).commitment},
+		"block":       {"<blockID>", "decode and show a stored block", (*shell).block},
+		"blocks":      {"<slotIndex>", "list the IDs of the stored blocks of a slot", (*shell).blocks},
+		"output":      {"<outputID>", "show an output and its metadata", (*shell).output},
+		"consumers":   {"<outputID>", "list the consumers of an output", (*shell).consumers},
+		"transaction": {"<transactionID>", "show a transaction and its metadata", (*shell).transaction},
+		"conflicts":   {"<transactionID>", "show the tree of conflicts that a transaction is booked on", (*shell).conflicts},
+	}
+}
+
+// shell executes the commands against the storage of an engine.
+type shell struct {
+	storage *storage.Storage
+	ledger  *realitiesledger.RealitiesLedger
+	workers *workerpool.Group
+	writer  io.Writer
+}
+
+// newShell returns a new shell that operates on the given storage and writes its results to the given writer.
+func newShell(chainStorage *storage.Storage, writer io.Writer) (newShell *shell) {
+	newShell = &shell{
+		storage: chainStorage,
+		ledger:  realitiesledger.New(),
+		workers: workerpool.NewGroup("db-shell"),
+		writer:  writer,
+	}
+
+	newShell.ledger.Initialize(newShell.workers.CreatePool("Ledger", 1), chainStorage)
+
+	return newShell
+}
+
+// Run reads the commands from the given input and executes them until the input is closed or the shell is exited.
+func (s *shell) Run(input io.Reader) {
+	scanner := bufio.NewScanner(input)
+	for s.printf(prompt); scanner.Scan(); s.printf(prompt) {
+		if err := s.Execute(scanner.Text()); err != nil {
+			if errors.Is(err, errExit) {
+				return
+			}
+
+			s.printf("error: %s\n", err)
+		}
+	}
+
+	s.printf("\n")
+}
+
+// Execute parses and executes the given command line.
+func (s *shell) Execute(line string) (err error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	cmd, exists := commands[fields[0]]
+	if !exists {
+		return errors.Errorf("unknown command '%s' (type 'help' to list the available commands)", fields[0])
+	}
+
+	if arguments := fields[1:]; len(arguments) != len(strings.Fields(cmd.usage)) {
+		return errors.Errorf("usage: %s %s", fields[0], cmd.usage)
+	}
+
+	return cmd.handler(s, fields[1:])
+}
+
+// Shutdown shuts down the ledger and closes the storage.
+func (s *shell) Shutdown() {
+	s.ledger.Shutdown()
+	s.workers.Shutdown()
+	s.storage.Shutdown()
+}
+
+// help prints the available commands.
+func (s *shell) help(_ []string) (err error) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s.printf("  %-30s %s\n", strings.TrimSpace(name+" "+commands[name].usage), commands[name].description)
+	}
+
+	return nil
+}
+
+// exit ends the interactive shell.
+func (s *shell) exit(_ []string) (err error) {
+	return errExit
+}
+
+// printf writes the formatted string to the writer of the shell.
+func (s *shell) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(s.writer, format, args...)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -61,7 +62,7 @@ func main() {
 }
 
 func parseFlags() (opt []options.Option[snapshotcreator.Options], conf string, diagnose bool) {
-	filename := flag.String("filename", "", "the name of the generated snapshot file")
+	filename := flag.String("filename", "", "the name of the generated snapshot file (or an s3:// or gs:// URL)")
 	checkValidity := flag.BoolP("diagnose", "d", false, "check the validity of the generated snapshot file")
 	config := flag.String("config", "", "use ready config: devnet, feature, docker")
 	genesisTokenAmount := flag.Uint64("token-amount", 0, "the amount of tokens to add to the genesis output")
@@ -117,14 +118,21 @@ func diagnosticPrintSnapshotFromFile(filePath string, ledgerProvider module.Prov
 	fmt.Printf("%+v\n", lo.PanicOnErr(s.Commitments.Load(0)))
 
 	fmt.Println("--- Ledgerstate ---")
-	e.Ledger.MemPool().Storage().ForEachOutputID(func(outputID utxo.OutputID) bool {
-		e.Ledger.MemPool().Storage().CachedOutput(outputID).Consume(func(o utxo.Output) {
-			e.Ledger.MemPool().Storage().CachedOutputMetadata(outputID).Consume(func(m *mempool.OutputMetadata) {
+	ledgerSnapshot := e.Ledger.MemPool().Storage().Snapshot()
+	defer ledgerSnapshot.Release()
+	if err := ledgerSnapshot.ForEachOutputID(context.Background(), func(outputID utxo.OutputID) bool {
+		if o, exists := ledgerSnapshot.Output(outputID); exists {
+			if m, exists := ledgerSnapshot.OutputMetadata(outputID); exists {
 				fmt.Printf("%+v\n%#v\n", o, m)
-			})
-		})
+			}
+		}
 		return true
-	})
+	}); err != nil {
+		panic(err)
+	}
+	if err := ledgerSnapshot.Err(); err != nil {
+		panic(err)
+	}
 
 	fmt.Println("--- SEPs ---")
 	if err := e.Storage.RootBlocks.Stream(0, func(blockID models.BlockID, commitmentID commitment.ID) (err error) {
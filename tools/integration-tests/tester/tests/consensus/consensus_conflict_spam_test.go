@@ -14,6 +14,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/core/confirmation"
 	"github.com/iotaledger/goshimmer/packages/protocol/engine/ledger/vm/devnetvm"
 	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/framework"
+	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/framework/config"
 	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/tests"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/lo"
@@ -107,6 +108,74 @@ func TestConflictSpamAndMergeToMaster(t *testing.T) {
 	require.Empty(t, blockMetadata.M.ConflictIDs)
 }
 
+// TestCompactResolvedConflicts enables the compaction of resolved conflicts in the node configuration and makes sure
+// that the accepted side of a double spend is merged into the MasterConflict on all nodes.
+func TestCompactResolvedConflicts(t *testing.T) {
+	ctx, cancel := tests.Context(context.Background(), t)
+	defer cancel()
+	snapshotOptions := tests.EqualSnapshotOptions
+	snapshotInfo := snapshotcreator.NewOptions(snapshotOptions...)
+	n, err := f.CreateNetwork(ctx, t.Name(), 4, framework.CreateNetworkConfig{
+		Faucet:      true,
+		StartSynced: false,
+		Activity:    false,
+		Snapshot:    snapshotOptions,
+	}, tests.CommonSnapshotConfigFunc(t, snapshotInfo, func(peerIndex int, isPeerMaster bool, conf config.GoShimmer) config.GoShimmer {
+		conf.Protocol.CompactResolvedConflicts = true
+		return conf
+	}))
+	require.NoError(t, err)
+	defer tests.ShutdownNetwork(ctx, t, n)
+
+	log.Println("Bootstrapping network...")
+	tests.BootstrapNetwork(t, n)
+	log.Println("Bootstrapping network... done")
+
+	faucet, peer1, peer2 := n.Peers()[0], n.Peers()[1], n.Peers()[2]
+	tokensPerRequest = faucet.Config().TokensPerRequest
+
+	const delayBetweenDataBlocks = 100 * time.Millisecond
+	dataBlocksAmount := len(n.Peers()) * 10
+
+	t.Logf("Sending %d data blocks to confirm Faucet Outputs", dataBlocksAmount)
+	tests.SendDataBlocksWithDelay(t, n.Peers(), dataBlocksAmount, delayBetweenDataBlocks)
+
+	fundingAddress := peer1.Address(0)
+	tests.SendFaucetRequest(t, peer1, fundingAddress)
+
+	t.Logf("Sending %d data blocks to confirm Faucet Funds", dataBlocksAmount)
+	tests.SendDataBlocksWithDelay(t, n.Peers(), dataBlocksAmount, delayBetweenDataBlocks)
+
+	require.Eventually(t, func() bool {
+		return tests.Balance(t, peer1, fundingAddress, devnetvm.ColorIOTA) >= uint64(tokensPerRequest)
+	}, tests.Timeout, tests.Tick)
+
+	outputs := getOutputsControlledBy(t, peer1, fundingAddress)
+	fundingKeyPair := map[string]*ed25519.KeyPair{fundingAddress.String(): peer1.KeyPair(0)}
+
+	tx1 := tests.CreateTransactionFromOutputs(t, peer1.ID(), []devnetvm.Address{peer2.Address(0)}, fundingKeyPair, outputs[0])
+	tx2 := tests.CreateTransactionFromOutputs(t, peer2.ID(), []devnetvm.Address{peer2.Address(1)}, fundingKeyPair, outputs[0])
+	postTransactions(t, n.Peers(), 1, "double spend", tx1, tx2)
+
+	t.Logf("Sending data %d blocks to resolve the double spend", dataBlocksAmount*2)
+	tests.SendDataBlocksWithDelay(t, n.Peers(), dataBlocksAmount*2, delayBetweenDataBlocks)
+
+	verifyConfirmationsOnPeers(t, n.Peers(), []*devnetvm.Transaction{tx1, tx2})
+
+	for _, peer := range n.Peers() {
+		require.Eventually(t, func() bool {
+			for _, tx := range []*devnetvm.Transaction{tx1, tx2} {
+				metadata, err := peer.GetTransactionMetadata(tx.ID().Base58())
+				if err == nil && metadata.ConfirmationState.IsAccepted() && len(metadata.ConflictIDs) == 0 {
+					return true
+				}
+			}
+
+			return false
+		}, tests.Timeout, tests.Tick, "the accepted transaction was not merged into the MasterConflict on peer %s", peer.Name())
+	}
+}
+
 // determineOutputSlice will extract sub-slices from outputs of a certain size.
 // For each increment of i it will take the next sub-slice so there would be no overlaps with previous sub-slices.
 func determineOutputSlice(outputs devnetvm.Outputs, i int, size int) devnetvm.Outputs {